@@ -19,16 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Accounts_FullMethodName             = "/cosmos.auth.v1beta1.Query/Accounts"
-	Query_Account_FullMethodName              = "/cosmos.auth.v1beta1.Query/Account"
-	Query_AccountAddressByID_FullMethodName   = "/cosmos.auth.v1beta1.Query/AccountAddressByID"
-	Query_Params_FullMethodName               = "/cosmos.auth.v1beta1.Query/Params"
-	Query_ModuleAccounts_FullMethodName       = "/cosmos.auth.v1beta1.Query/ModuleAccounts"
-	Query_ModuleAccountByName_FullMethodName  = "/cosmos.auth.v1beta1.Query/ModuleAccountByName"
-	Query_Bech32Prefix_FullMethodName         = "/cosmos.auth.v1beta1.Query/Bech32Prefix"
-	Query_AddressBytesToString_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressBytesToString"
-	Query_AddressStringToBytes_FullMethodName = "/cosmos.auth.v1beta1.Query/AddressStringToBytes"
-	Query_AccountInfo_FullMethodName          = "/cosmos.auth.v1beta1.Query/AccountInfo"
+	Query_Accounts_FullMethodName              = "/cosmos.auth.v1beta1.Query/Accounts"
+	Query_Account_FullMethodName               = "/cosmos.auth.v1beta1.Query/Account"
+	Query_AccountAddressByID_FullMethodName    = "/cosmos.auth.v1beta1.Query/AccountAddressByID"
+	Query_Params_FullMethodName                = "/cosmos.auth.v1beta1.Query/Params"
+	Query_ModuleAccounts_FullMethodName        = "/cosmos.auth.v1beta1.Query/ModuleAccounts"
+	Query_ModuleAccountByName_FullMethodName   = "/cosmos.auth.v1beta1.Query/ModuleAccountByName"
+	Query_Bech32Prefix_FullMethodName          = "/cosmos.auth.v1beta1.Query/Bech32Prefix"
+	Query_AddressBytesToString_FullMethodName  = "/cosmos.auth.v1beta1.Query/AddressBytesToString"
+	Query_AddressStringToBytes_FullMethodName  = "/cosmos.auth.v1beta1.Query/AddressStringToBytes"
+	Query_AccountInfo_FullMethodName           = "/cosmos.auth.v1beta1.Query/AccountInfo"
+	Query_FeeMarketParams_FullMethodName       = "/cosmos.auth.v1beta1.Query/FeeMarketParams"
+	Query_BaseGasPrice_FullMethodName          = "/cosmos.auth.v1beta1.Query/BaseGasPrice"
+	Query_AccountAuthenticators_FullMethodName = "/cosmos.auth.v1beta1.Query/AccountAuthenticators"
+	Query_UnorderedNonces_FullMethodName       = "/cosmos.auth.v1beta1.Query/UnorderedNonces"
+	Query_UnorderedNonce_FullMethodName        = "/cosmos.auth.v1beta1.Query/UnorderedNonce"
+	Query_UnorderedNoncesStats_FullMethodName  = "/cosmos.auth.v1beta1.Query/UnorderedNoncesStats"
 )
 
 // QueryClient is the client API for Query service.
@@ -60,6 +66,21 @@ type QueryClient interface {
 	AddressStringToBytes(ctx context.Context, in *AddressStringToBytesRequest, opts ...grpc.CallOption) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// FeeMarketParams queries the parameters of the fee market.
+	FeeMarketParams(ctx context.Context, in *QueryFeeMarketParamsRequest, opts ...grpc.CallOption) (*QueryFeeMarketParamsResponse, error)
+	// BaseGasPrice queries the current base gas price of the fee market.
+	BaseGasPrice(ctx context.Context, in *QueryBaseGasPriceRequest, opts ...grpc.CallOption) (*QueryBaseGasPriceResponse, error)
+	// AccountAuthenticators queries the authenticators an account opted into.
+	AccountAuthenticators(ctx context.Context, in *QueryAccountAuthenticatorsRequest, opts ...grpc.CallOption) (*QueryAccountAuthenticatorsResponse, error)
+	// UnorderedNonces queries the nonces of the unordered transactions which did
+	// not expire yet, optionally filtered by sender and timeout window.
+	UnorderedNonces(ctx context.Context, in *QueryUnorderedNoncesRequest, opts ...grpc.CallOption) (*QueryUnorderedNoncesResponse, error)
+	// UnorderedNonce queries whether a sender already used the given timeout for
+	// an unordered transaction which did not expire yet.
+	UnorderedNonce(ctx context.Context, in *QueryUnorderedNonceRequest, opts ...grpc.CallOption) (*QueryUnorderedNonceResponse, error)
+	// UnorderedNoncesStats queries aggregate statistics of the nonces of the
+	// unordered transactions which did not expire yet.
+	UnorderedNoncesStats(ctx context.Context, in *QueryUnorderedNoncesStatsRequest, opts ...grpc.CallOption) (*QueryUnorderedNoncesStatsResponse, error)
 }
 
 type queryClient struct {
@@ -170,6 +191,66 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) FeeMarketParams(ctx context.Context, in *QueryFeeMarketParamsRequest, opts ...grpc.CallOption) (*QueryFeeMarketParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFeeMarketParamsResponse)
+	err := c.cc.Invoke(ctx, Query_FeeMarketParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseGasPrice(ctx context.Context, in *QueryBaseGasPriceRequest, opts ...grpc.CallOption) (*QueryBaseGasPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBaseGasPriceResponse)
+	err := c.cc.Invoke(ctx, Query_BaseGasPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountAuthenticators(ctx context.Context, in *QueryAccountAuthenticatorsRequest, opts ...grpc.CallOption) (*QueryAccountAuthenticatorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryAccountAuthenticatorsResponse)
+	err := c.cc.Invoke(ctx, Query_AccountAuthenticators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnorderedNonces(ctx context.Context, in *QueryUnorderedNoncesRequest, opts ...grpc.CallOption) (*QueryUnorderedNoncesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryUnorderedNoncesResponse)
+	err := c.cc.Invoke(ctx, Query_UnorderedNonces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnorderedNonce(ctx context.Context, in *QueryUnorderedNonceRequest, opts ...grpc.CallOption) (*QueryUnorderedNonceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryUnorderedNonceResponse)
+	err := c.cc.Invoke(ctx, Query_UnorderedNonce_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnorderedNoncesStats(ctx context.Context, in *QueryUnorderedNoncesStatsRequest, opts ...grpc.CallOption) (*QueryUnorderedNoncesStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryUnorderedNoncesStatsResponse)
+	err := c.cc.Invoke(ctx, Query_UnorderedNoncesStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -199,6 +280,21 @@ type QueryServer interface {
 	AddressStringToBytes(context.Context, *AddressStringToBytesRequest) (*AddressStringToBytesResponse, error)
 	// AccountInfo queries account info which is common to all account types.
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// FeeMarketParams queries the parameters of the fee market.
+	FeeMarketParams(context.Context, *QueryFeeMarketParamsRequest) (*QueryFeeMarketParamsResponse, error)
+	// BaseGasPrice queries the current base gas price of the fee market.
+	BaseGasPrice(context.Context, *QueryBaseGasPriceRequest) (*QueryBaseGasPriceResponse, error)
+	// AccountAuthenticators queries the authenticators an account opted into.
+	AccountAuthenticators(context.Context, *QueryAccountAuthenticatorsRequest) (*QueryAccountAuthenticatorsResponse, error)
+	// UnorderedNonces queries the nonces of the unordered transactions which did
+	// not expire yet, optionally filtered by sender and timeout window.
+	UnorderedNonces(context.Context, *QueryUnorderedNoncesRequest) (*QueryUnorderedNoncesResponse, error)
+	// UnorderedNonce queries whether a sender already used the given timeout for
+	// an unordered transaction which did not expire yet.
+	UnorderedNonce(context.Context, *QueryUnorderedNonceRequest) (*QueryUnorderedNonceResponse, error)
+	// UnorderedNoncesStats queries aggregate statistics of the nonces of the
+	// unordered transactions which did not expire yet.
+	UnorderedNoncesStats(context.Context, *QueryUnorderedNoncesStatsRequest) (*QueryUnorderedNoncesStatsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -239,6 +335,24 @@ func (UnimplementedQueryServer) AddressStringToBytes(context.Context, *AddressSt
 func (UnimplementedQueryServer) AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (UnimplementedQueryServer) FeeMarketParams(context.Context, *QueryFeeMarketParamsRequest) (*QueryFeeMarketParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeMarketParams not implemented")
+}
+func (UnimplementedQueryServer) BaseGasPrice(context.Context, *QueryBaseGasPriceRequest) (*QueryBaseGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseGasPrice not implemented")
+}
+func (UnimplementedQueryServer) AccountAuthenticators(context.Context, *QueryAccountAuthenticatorsRequest) (*QueryAccountAuthenticatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountAuthenticators not implemented")
+}
+func (UnimplementedQueryServer) UnorderedNonces(context.Context, *QueryUnorderedNoncesRequest) (*QueryUnorderedNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnorderedNonces not implemented")
+}
+func (UnimplementedQueryServer) UnorderedNonce(context.Context, *QueryUnorderedNonceRequest) (*QueryUnorderedNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnorderedNonce not implemented")
+}
+func (UnimplementedQueryServer) UnorderedNoncesStats(context.Context, *QueryUnorderedNoncesStatsRequest) (*QueryUnorderedNoncesStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnorderedNoncesStats not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -440,6 +554,114 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeMarketParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeMarketParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeMarketParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeMarketParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeMarketParams(ctx, req.(*QueryFeeMarketParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BaseGasPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseGasPrice(ctx, req.(*QueryBaseGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountAuthenticators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountAuthenticatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountAuthenticators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountAuthenticators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountAuthenticators(ctx, req.(*QueryAccountAuthenticatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnorderedNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnorderedNoncesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnorderedNonces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnorderedNonces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnorderedNonces(ctx, req.(*QueryUnorderedNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnorderedNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnorderedNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnorderedNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnorderedNonce_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnorderedNonce(ctx, req.(*QueryUnorderedNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnorderedNoncesStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnorderedNoncesStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnorderedNoncesStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnorderedNoncesStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnorderedNoncesStats(ctx, req.(*QueryUnorderedNoncesStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -487,6 +709,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "FeeMarketParams",
+			Handler:    _Query_FeeMarketParams_Handler,
+		},
+		{
+			MethodName: "BaseGasPrice",
+			Handler:    _Query_BaseGasPrice_Handler,
+		},
+		{
+			MethodName: "AccountAuthenticators",
+			Handler:    _Query_AccountAuthenticators_Handler,
+		},
+		{
+			MethodName: "UnorderedNonces",
+			Handler:    _Query_UnorderedNonces_Handler,
+		},
+		{
+			MethodName: "UnorderedNonce",
+			Handler:    _Query_UnorderedNonce_Handler,
+		},
+		{
+			MethodName: "UnorderedNoncesStats",
+			Handler:    _Query_UnorderedNoncesStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -19,7 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_UpdateParams_FullMethodName = "/cosmos.auth.v1beta1.Msg/UpdateParams"
+	Msg_UpdateParams_FullMethodName          = "/cosmos.auth.v1beta1.Msg/UpdateParams"
+	Msg_UpdateFeeMarketParams_FullMethodName = "/cosmos.auth.v1beta1.Msg/UpdateFeeMarketParams"
+	Msg_RotatePubKey_FullMethodName          = "/cosmos.auth.v1beta1.Msg/RotatePubKey"
+	Msg_AddAuthenticator_FullMethodName      = "/cosmos.auth.v1beta1.Msg/AddAuthenticator"
+	Msg_RemoveAuthenticator_FullMethodName   = "/cosmos.auth.v1beta1.Msg/RemoveAuthenticator"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +35,23 @@ type MsgClient interface {
 	// UpdateParams defines a (governance) operation for updating the x/auth module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateFeeMarketParams defines a (governance) operation for updating the
+	// x/auth fee market parameters. The authority defaults to the x/gov module
+	// account.
+	UpdateFeeMarketParams(ctx context.Context, in *MsgUpdateFeeMarketParams, opts ...grpc.CallOption) (*MsgUpdateFeeMarketParamsResponse, error)
+	// RotatePubKey defines a method for replacing the public key of an account
+	// while keeping its address. It must be signed by the current public key of
+	// the account, the subsequent txs of the account having to be signed by the
+	// new one.
+	RotatePubKey(ctx context.Context, in *MsgRotatePubKey, opts ...grpc.CallOption) (*MsgRotatePubKeyResponse, error)
+	// AddAuthenticator defines a method for an account to opt into an
+	// authenticator. Once an account has authenticators, its txs are
+	// authenticated by them instead of its public key.
+	AddAuthenticator(ctx context.Context, in *MsgAddAuthenticator, opts ...grpc.CallOption) (*MsgAddAuthenticatorResponse, error)
+	// RemoveAuthenticator defines a method for an account to remove one of its
+	// authenticators. An account without authenticators is authenticated by its
+	// public key.
+	RemoveAuthenticator(ctx context.Context, in *MsgRemoveAuthenticator, opts ...grpc.CallOption) (*MsgRemoveAuthenticatorResponse, error)
 }
 
 type msgClient struct {
@@ -51,6 +72,46 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateFeeMarketParams(ctx context.Context, in *MsgUpdateFeeMarketParams, opts ...grpc.CallOption) (*MsgUpdateFeeMarketParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateFeeMarketParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateFeeMarketParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotatePubKey(ctx context.Context, in *MsgRotatePubKey, opts ...grpc.CallOption) (*MsgRotatePubKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRotatePubKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RotatePubKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAuthenticator(ctx context.Context, in *MsgAddAuthenticator, opts ...grpc.CallOption) (*MsgAddAuthenticatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgAddAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, Msg_AddAuthenticator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAuthenticator(ctx context.Context, in *MsgRemoveAuthenticator, opts ...grpc.CallOption) (*MsgRemoveAuthenticatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRemoveAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveAuthenticator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -60,6 +121,23 @@ type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the x/auth module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateFeeMarketParams defines a (governance) operation for updating the
+	// x/auth fee market parameters. The authority defaults to the x/gov module
+	// account.
+	UpdateFeeMarketParams(context.Context, *MsgUpdateFeeMarketParams) (*MsgUpdateFeeMarketParamsResponse, error)
+	// RotatePubKey defines a method for replacing the public key of an account
+	// while keeping its address. It must be signed by the current public key of
+	// the account, the subsequent txs of the account having to be signed by the
+	// new one.
+	RotatePubKey(context.Context, *MsgRotatePubKey) (*MsgRotatePubKeyResponse, error)
+	// AddAuthenticator defines a method for an account to opt into an
+	// authenticator. Once an account has authenticators, its txs are
+	// authenticated by them instead of its public key.
+	AddAuthenticator(context.Context, *MsgAddAuthenticator) (*MsgAddAuthenticatorResponse, error)
+	// RemoveAuthenticator defines a method for an account to remove one of its
+	// authenticators. An account without authenticators is authenticated by its
+	// public key.
+	RemoveAuthenticator(context.Context, *MsgRemoveAuthenticator) (*MsgRemoveAuthenticatorResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -73,6 +151,18 @@ type UnimplementedMsgServer struct{}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) UpdateFeeMarketParams(context.Context, *MsgUpdateFeeMarketParams) (*MsgUpdateFeeMarketParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeeMarketParams not implemented")
+}
+func (UnimplementedMsgServer) RotatePubKey(context.Context, *MsgRotatePubKey) (*MsgRotatePubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotatePubKey not implemented")
+}
+func (UnimplementedMsgServer) AddAuthenticator(context.Context, *MsgAddAuthenticator) (*MsgAddAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAuthenticator not implemented")
+}
+func (UnimplementedMsgServer) RemoveAuthenticator(context.Context, *MsgRemoveAuthenticator) (*MsgRemoveAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAuthenticator not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -112,6 +202,78 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateFeeMarketParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeeMarketParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeeMarketParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateFeeMarketParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeeMarketParams(ctx, req.(*MsgUpdateFeeMarketParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotatePubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotatePubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotatePubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RotatePubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotatePubKey(ctx, req.(*MsgRotatePubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAuthenticator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddAuthenticator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAuthenticator(ctx, req.(*MsgAddAuthenticator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAuthenticator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveAuthenticator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAuthenticator(ctx, req.(*MsgRemoveAuthenticator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -123,6 +285,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateFeeMarketParams",
+			Handler:    _Msg_UpdateFeeMarketParams_Handler,
+		},
+		{
+			MethodName: "RotatePubKey",
+			Handler:    _Msg_RotatePubKey_Handler,
+		},
+		{
+			MethodName: "AddAuthenticator",
+			Handler:    _Msg_AddAuthenticator_Handler,
+		},
+		{
+			MethodName: "RemoveAuthenticator",
+			Handler:    _Msg_RemoveAuthenticator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/tx.proto",
@@ -22,6 +22,8 @@ const (
 	Query_Allowance_FullMethodName           = "/cosmos.feegrant.v1beta1.Query/Allowance"
 	Query_Allowances_FullMethodName          = "/cosmos.feegrant.v1beta1.Query/Allowances"
 	Query_AllowancesByGranter_FullMethodName = "/cosmos.feegrant.v1beta1.Query/AllowancesByGranter"
+	Query_Sponsorship_FullMethodName         = "/cosmos.feegrant.v1beta1.Query/Sponsorship"
+	Query_SponsorshipUsage_FullMethodName    = "/cosmos.feegrant.v1beta1.Query/SponsorshipUsage"
 )
 
 // QueryClient is the client API for Query service.
@@ -30,12 +32,17 @@ const (
 //
 // Query defines the gRPC querier service.
 type QueryClient interface {
-	// Allowance returns granted allwance to the grantee by the granter.
+	// Allowance returns granted allowance to the grantee by the granter.
 	Allowance(ctx context.Context, in *QueryAllowanceRequest, opts ...grpc.CallOption) (*QueryAllowanceResponse, error)
 	// Allowances returns all the grants for the given grantee address.
 	Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// Sponsorship returns the sponsorship of a granter.
+	Sponsorship(ctx context.Context, in *QuerySponsorshipRequest, opts ...grpc.CallOption) (*QuerySponsorshipResponse, error)
+	// SponsorshipUsage returns the fees paid by the sponsorship of a granter for a
+	// grantee in the current day.
+	SponsorshipUsage(ctx context.Context, in *QuerySponsorshipUsageRequest, opts ...grpc.CallOption) (*QuerySponsorshipUsageResponse, error)
 }
 
 type queryClient struct {
@@ -76,18 +83,43 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) Sponsorship(ctx context.Context, in *QuerySponsorshipRequest, opts ...grpc.CallOption) (*QuerySponsorshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySponsorshipResponse)
+	err := c.cc.Invoke(ctx, Query_Sponsorship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SponsorshipUsage(ctx context.Context, in *QuerySponsorshipUsageRequest, opts ...grpc.CallOption) (*QuerySponsorshipUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySponsorshipUsageResponse)
+	err := c.cc.Invoke(ctx, Query_SponsorshipUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
 //
 // Query defines the gRPC querier service.
 type QueryServer interface {
-	// Allowance returns granted allwance to the grantee by the granter.
+	// Allowance returns granted allowance to the grantee by the granter.
 	Allowance(context.Context, *QueryAllowanceRequest) (*QueryAllowanceResponse, error)
 	// Allowances returns all the grants for the given grantee address.
 	Allowances(context.Context, *QueryAllowancesRequest) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// Sponsorship returns the sponsorship of a granter.
+	Sponsorship(context.Context, *QuerySponsorshipRequest) (*QuerySponsorshipResponse, error)
+	// SponsorshipUsage returns the fees paid by the sponsorship of a granter for a
+	// grantee in the current day.
+	SponsorshipUsage(context.Context, *QuerySponsorshipUsageRequest) (*QuerySponsorshipUsageResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -107,6 +139,12 @@ func (UnimplementedQueryServer) Allowances(context.Context, *QueryAllowancesRequ
 func (UnimplementedQueryServer) AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (UnimplementedQueryServer) Sponsorship(context.Context, *QuerySponsorshipRequest) (*QuerySponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sponsorship not implemented")
+}
+func (UnimplementedQueryServer) SponsorshipUsage(context.Context, *QuerySponsorshipUsageRequest) (*QuerySponsorshipUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorshipUsage not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -182,6 +220,42 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Sponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Sponsorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sponsorship(ctx, req.(*QuerySponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SponsorshipUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorshipUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsorshipUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SponsorshipUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsorshipUsage(ctx, req.(*QuerySponsorshipUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +275,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "Sponsorship",
+			Handler:    _Query_Sponsorship_Handler,
+		},
+		{
+			MethodName: "SponsorshipUsage",
+			Handler:    _Query_SponsorshipUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
@@ -19,15 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Constitution_FullMethodName = "/cosmos.gov.v1.Query/Constitution"
-	Query_Proposal_FullMethodName     = "/cosmos.gov.v1.Query/Proposal"
-	Query_Proposals_FullMethodName    = "/cosmos.gov.v1.Query/Proposals"
-	Query_Vote_FullMethodName         = "/cosmos.gov.v1.Query/Vote"
-	Query_Votes_FullMethodName        = "/cosmos.gov.v1.Query/Votes"
-	Query_Params_FullMethodName       = "/cosmos.gov.v1.Query/Params"
-	Query_Deposit_FullMethodName      = "/cosmos.gov.v1.Query/Deposit"
-	Query_Deposits_FullMethodName     = "/cosmos.gov.v1.Query/Deposits"
-	Query_TallyResult_FullMethodName  = "/cosmos.gov.v1.Query/TallyResult"
+	Query_Constitution_FullMethodName        = "/cosmos.gov.v1.Query/Constitution"
+	Query_Proposal_FullMethodName            = "/cosmos.gov.v1.Query/Proposal"
+	Query_Proposals_FullMethodName           = "/cosmos.gov.v1.Query/Proposals"
+	Query_Vote_FullMethodName                = "/cosmos.gov.v1.Query/Vote"
+	Query_Votes_FullMethodName               = "/cosmos.gov.v1.Query/Votes"
+	Query_Params_FullMethodName              = "/cosmos.gov.v1.Query/Params"
+	Query_Deposit_FullMethodName             = "/cosmos.gov.v1.Query/Deposit"
+	Query_Deposits_FullMethodName            = "/cosmos.gov.v1.Query/Deposits"
+	Query_TallyResult_FullMethodName         = "/cosmos.gov.v1.Query/TallyResult"
+	Query_MessageBasedParams_FullMethodName  = "/cosmos.gov.v1.Query/MessageBasedParams"
+	Query_ProposalTallyParams_FullMethodName = "/cosmos.gov.v1.Query/ProposalTallyParams"
+	Query_Governor_FullMethodName            = "/cosmos.gov.v1.Query/Governor"
+	Query_Governors_FullMethodName           = "/cosmos.gov.v1.Query/Governors"
+	Query_GovernorDelegations_FullMethodName = "/cosmos.gov.v1.Query/GovernorDelegations"
+	Query_GovernorDelegation_FullMethodName  = "/cosmos.gov.v1.Query/GovernorDelegation"
 )
 
 // QueryClient is the client API for Query service.
@@ -54,6 +60,18 @@ type QueryClient interface {
 	Deposits(ctx context.Context, in *QueryDepositsRequest, opts ...grpc.CallOption) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(ctx context.Context, in *QueryTallyResultRequest, opts ...grpc.CallOption) (*QueryTallyResultResponse, error)
+	// MessageBasedParams queries the message based params of a message type.
+	MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error)
+	// ProposalTallyParams queries the tally params applying to a proposal.
+	ProposalTallyParams(ctx context.Context, in *QueryProposalTallyParamsRequest, opts ...grpc.CallOption) (*QueryProposalTallyParamsResponse, error)
+	// Governor queries a governor and its voting power.
+	Governor(ctx context.Context, in *QueryGovernorRequest, opts ...grpc.CallOption) (*QueryGovernorResponse, error)
+	// Governors queries all the governors.
+	Governors(ctx context.Context, in *QueryGovernorsRequest, opts ...grpc.CallOption) (*QueryGovernorsResponse, error)
+	// GovernorDelegations queries the delegations of a governor.
+	GovernorDelegations(ctx context.Context, in *QueryGovernorDelegationsRequest, opts ...grpc.CallOption) (*QueryGovernorDelegationsResponse, error)
+	// GovernorDelegation queries the governor delegation of a delegator.
+	GovernorDelegation(ctx context.Context, in *QueryGovernorDelegationRequest, opts ...grpc.CallOption) (*QueryGovernorDelegationResponse, error)
 }
 
 type queryClient struct {
@@ -154,6 +172,66 @@ func (c *queryClient) TallyResult(ctx context.Context, in *QueryTallyResultReque
 	return out, nil
 }
 
+func (c *queryClient) MessageBasedParams(ctx context.Context, in *QueryMessageBasedParamsRequest, opts ...grpc.CallOption) (*QueryMessageBasedParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMessageBasedParamsResponse)
+	err := c.cc.Invoke(ctx, Query_MessageBasedParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProposalTallyParams(ctx context.Context, in *QueryProposalTallyParamsRequest, opts ...grpc.CallOption) (*QueryProposalTallyParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryProposalTallyParamsResponse)
+	err := c.cc.Invoke(ctx, Query_ProposalTallyParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Governor(ctx context.Context, in *QueryGovernorRequest, opts ...grpc.CallOption) (*QueryGovernorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGovernorResponse)
+	err := c.cc.Invoke(ctx, Query_Governor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Governors(ctx context.Context, in *QueryGovernorsRequest, opts ...grpc.CallOption) (*QueryGovernorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGovernorsResponse)
+	err := c.cc.Invoke(ctx, Query_Governors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GovernorDelegations(ctx context.Context, in *QueryGovernorDelegationsRequest, opts ...grpc.CallOption) (*QueryGovernorDelegationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGovernorDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_GovernorDelegations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GovernorDelegation(ctx context.Context, in *QueryGovernorDelegationRequest, opts ...grpc.CallOption) (*QueryGovernorDelegationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGovernorDelegationResponse)
+	err := c.cc.Invoke(ctx, Query_GovernorDelegation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -178,6 +256,18 @@ type QueryServer interface {
 	Deposits(context.Context, *QueryDepositsRequest) (*QueryDepositsResponse, error)
 	// TallyResult queries the tally of a proposal vote.
 	TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error)
+	// MessageBasedParams queries the message based params of a message type.
+	MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error)
+	// ProposalTallyParams queries the tally params applying to a proposal.
+	ProposalTallyParams(context.Context, *QueryProposalTallyParamsRequest) (*QueryProposalTallyParamsResponse, error)
+	// Governor queries a governor and its voting power.
+	Governor(context.Context, *QueryGovernorRequest) (*QueryGovernorResponse, error)
+	// Governors queries all the governors.
+	Governors(context.Context, *QueryGovernorsRequest) (*QueryGovernorsResponse, error)
+	// GovernorDelegations queries the delegations of a governor.
+	GovernorDelegations(context.Context, *QueryGovernorDelegationsRequest) (*QueryGovernorDelegationsResponse, error)
+	// GovernorDelegation queries the governor delegation of a delegator.
+	GovernorDelegation(context.Context, *QueryGovernorDelegationRequest) (*QueryGovernorDelegationResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -215,6 +305,24 @@ func (UnimplementedQueryServer) Deposits(context.Context, *QueryDepositsRequest)
 func (UnimplementedQueryServer) TallyResult(context.Context, *QueryTallyResultRequest) (*QueryTallyResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TallyResult not implemented")
 }
+func (UnimplementedQueryServer) MessageBasedParams(context.Context, *QueryMessageBasedParamsRequest) (*QueryMessageBasedParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MessageBasedParams not implemented")
+}
+func (UnimplementedQueryServer) ProposalTallyParams(context.Context, *QueryProposalTallyParamsRequest) (*QueryProposalTallyParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposalTallyParams not implemented")
+}
+func (UnimplementedQueryServer) Governor(context.Context, *QueryGovernorRequest) (*QueryGovernorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Governor not implemented")
+}
+func (UnimplementedQueryServer) Governors(context.Context, *QueryGovernorsRequest) (*QueryGovernorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Governors not implemented")
+}
+func (UnimplementedQueryServer) GovernorDelegations(context.Context, *QueryGovernorDelegationsRequest) (*QueryGovernorDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovernorDelegations not implemented")
+}
+func (UnimplementedQueryServer) GovernorDelegation(context.Context, *QueryGovernorDelegationRequest) (*QueryGovernorDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovernorDelegation not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -398,6 +506,114 @@ func _Query_TallyResult_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MessageBasedParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMessageBasedParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MessageBasedParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MessageBasedParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MessageBasedParams(ctx, req.(*QueryMessageBasedParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProposalTallyParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProposalTallyParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProposalTallyParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProposalTallyParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProposalTallyParams(ctx, req.(*QueryProposalTallyParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Governor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Governor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Governor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Governor(ctx, req.(*QueryGovernorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Governors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Governors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Governors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Governors(ctx, req.(*QueryGovernorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GovernorDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernorDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GovernorDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GovernorDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GovernorDelegations(ctx, req.(*QueryGovernorDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GovernorDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGovernorDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GovernorDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GovernorDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GovernorDelegation(ctx, req.(*QueryGovernorDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -441,6 +657,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TallyResult",
 			Handler:    _Query_TallyResult_Handler,
 		},
+		{
+			MethodName: "MessageBasedParams",
+			Handler:    _Query_MessageBasedParams_Handler,
+		},
+		{
+			MethodName: "ProposalTallyParams",
+			Handler:    _Query_ProposalTallyParams_Handler,
+		},
+		{
+			MethodName: "Governor",
+			Handler:    _Query_Governor_Handler,
+		},
+		{
+			MethodName: "Governors",
+			Handler:    _Query_Governors_Handler,
+		},
+		{
+			MethodName: "GovernorDelegations",
+			Handler:    _Query_GovernorDelegations_Handler,
+		},
+		{
+			MethodName: "GovernorDelegation",
+			Handler:    _Query_GovernorDelegation_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/query.proto",
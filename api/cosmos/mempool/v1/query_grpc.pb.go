@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: cosmos/mempool/v1/query.proto
+
+package mempoolv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Query_PendingTxs_FullMethodName = "/cosmos.mempool.v1.Query/PendingTxs"
+	Query_SenderTxs_FullMethodName  = "/cosmos.mempool.v1.Query/SenderTxs"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Query defines the gRPC querier service inspecting the application-side
+// mempool of a node. Its results are local to the queried node.
+type QueryClient interface {
+	// PendingTxs queries the txs pending in the mempool, ordered by sender then
+	// by nonce. It is only supported by mempools keeping a queue of txs per
+	// sender, such as the PriorityNonceMempool and the SenderNonceMempool.
+	PendingTxs(ctx context.Context, in *QueryPendingTxsRequest, opts ...grpc.CallOption) (*QueryPendingTxsResponse, error)
+	// SenderTxs queries the nonce ordered queue of txs of a sender. It is only
+	// supported by mempools keeping a queue of txs per sender, such as the
+	// PriorityNonceMempool and the SenderNonceMempool.
+	SenderTxs(ctx context.Context, in *QuerySenderTxsRequest, opts ...grpc.CallOption) (*QuerySenderTxsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) PendingTxs(ctx context.Context, in *QueryPendingTxsRequest, opts ...grpc.CallOption) (*QueryPendingTxsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPendingTxsResponse)
+	err := c.cc.Invoke(ctx, Query_PendingTxs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SenderTxs(ctx context.Context, in *QuerySenderTxsRequest, opts ...grpc.CallOption) (*QuerySenderTxsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySenderTxsResponse)
+	err := c.cc.Invoke(ctx, Query_SenderTxs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility.
+//
+// Query defines the gRPC querier service inspecting the application-side
+// mempool of a node. Its results are local to the queried node.
+type QueryServer interface {
+	// PendingTxs queries the txs pending in the mempool, ordered by sender then
+	// by nonce. It is only supported by mempools keeping a queue of txs per
+	// sender, such as the PriorityNonceMempool and the SenderNonceMempool.
+	PendingTxs(context.Context, *QueryPendingTxsRequest) (*QueryPendingTxsResponse, error)
+	// SenderTxs queries the nonce ordered queue of txs of a sender. It is only
+	// supported by mempools keeping a queue of txs per sender, such as the
+	// PriorityNonceMempool and the SenderNonceMempool.
+	SenderTxs(context.Context, *QuerySenderTxsRequest) (*QuerySenderTxsResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQueryServer struct{}
+
+func (UnimplementedQueryServer) PendingTxs(context.Context, *QueryPendingTxsRequest) (*QueryPendingTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingTxs not implemented")
+}
+func (UnimplementedQueryServer) SenderTxs(context.Context, *QuerySenderTxsRequest) (*QuerySenderTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SenderTxs not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+func (UnimplementedQueryServer) testEmbeddedByValue()               {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	// If the following call pancis, it indicates UnimplementedQueryServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_PendingTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingTxs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingTxs(ctx, req.(*QueryPendingTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SenderTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySenderTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SenderTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SenderTxs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SenderTxs(ctx, req.(*QuerySenderTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.mempool.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PendingTxs",
+			Handler:    _Query_PendingTxs_Handler,
+		},
+		{
+			MethodName: "SenderTxs",
+			Handler:    _Query_SenderTxs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/mempool/v1/query.proto",
+}
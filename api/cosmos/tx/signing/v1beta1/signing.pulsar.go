@@ -4,6 +4,7 @@ package signingv1beta1
 import (
 	v1beta1 "cosmossdk.io/api/cosmos/crypto/multisig/v1beta1"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
@@ -1071,9 +1072,10 @@ func (x *fastReflection_SignatureDescriptor) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_SignatureDescriptor_Data        protoreflect.MessageDescriptor
-	fd_SignatureDescriptor_Data_single protoreflect.FieldDescriptor
-	fd_SignatureDescriptor_Data_multi  protoreflect.FieldDescriptor
+	md_SignatureDescriptor_Data           protoreflect.MessageDescriptor
+	fd_SignatureDescriptor_Data_single    protoreflect.FieldDescriptor
+	fd_SignatureDescriptor_Data_multi     protoreflect.FieldDescriptor
+	fd_SignatureDescriptor_Data_aggregate protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1081,6 +1083,7 @@ func init() {
 	md_SignatureDescriptor_Data = File_cosmos_tx_signing_v1beta1_signing_proto.Messages().ByName("SignatureDescriptor").Messages().ByName("Data")
 	fd_SignatureDescriptor_Data_single = md_SignatureDescriptor_Data.Fields().ByName("single")
 	fd_SignatureDescriptor_Data_multi = md_SignatureDescriptor_Data.Fields().ByName("multi")
+	fd_SignatureDescriptor_Data_aggregate = md_SignatureDescriptor_Data.Fields().ByName("aggregate")
 }
 
 var _ protoreflect.Message = (*fastReflection_SignatureDescriptor_Data)(nil)
@@ -1162,6 +1165,12 @@ func (x *fastReflection_SignatureDescriptor_Data) Range(f func(protoreflect.Fiel
 			if !f(fd_SignatureDescriptor_Data_multi, value) {
 				return
 			}
+		case *SignatureDescriptor_Data_Aggregate_:
+			v := o.Aggregate
+			value := protoreflect.ValueOfMessage(v.ProtoReflect())
+			if !f(fd_SignatureDescriptor_Data_aggregate, value) {
+				return
+			}
 		}
 	}
 }
@@ -1195,6 +1204,14 @@ func (x *fastReflection_SignatureDescriptor_Data) Has(fd protoreflect.FieldDescr
 		} else {
 			return false
 		}
+	case "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.aggregate":
+		if x.Sum == nil {
+			return false
+		} else if _, ok := x.Sum.(*SignatureDescriptor_Data_Aggregate_); ok {
+			return true
+		} else {
+			return false
+		}
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data"))
@@ -1215,6 +1232,8 @@ func (x *fastReflection_SignatureDescriptor_Data) Clear(fd protoreflect.FieldDes
 		x.Sum = nil
 	case "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.multi":
 		x.Sum = nil
+	case "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.aggregate":
+		x.Sum = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data"))
@@ -1247,6 +1266,14 @@ func (x *fastReflection_SignatureDescriptor_Data) Get(descriptor protoreflect.Fi
 		} else {
 			return protoreflect.ValueOfMessage((*SignatureDescriptor_Data_Multi)(nil).ProtoReflect())
 		}
+	case "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.aggregate":
+		if x.Sum == nil {
+			return protoreflect.ValueOfMessage((*SignatureDescriptor_Data_Aggregate)(nil).ProtoReflect())
+		} else if v, ok := x.Sum.(*SignatureDescriptor_Data_Aggregate_); ok {
+			return protoreflect.ValueOfMessage(v.Aggregate.ProtoReflect())
+		} else {
+			return protoreflect.ValueOfMessage((*SignatureDescriptor_Data_Aggregate)(nil).ProtoReflect())
+		}
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data"))
@@ -1273,6 +1300,9 @@ func (x *fastReflection_SignatureDescriptor_Data) Set(fd protoreflect.FieldDescr
 	case "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.multi":
 		cv := value.Message().Interface().(*SignatureDescriptor_Data_Multi)
 		x.Sum = &SignatureDescriptor_Data_Multi_{Multi: cv}
+	case "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.aggregate":
+		cv := value.Message().Interface().(*SignatureDescriptor_Data_Aggregate)
+		x.Sum = &SignatureDescriptor_Data_Aggregate_{Aggregate: cv}
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data"))
@@ -1325,6 +1355,22 @@ func (x *fastReflection_SignatureDescriptor_Data) Mutable(fd protoreflect.FieldD
 			x.Sum = oneofValue
 			return protoreflect.ValueOfMessage(value.ProtoReflect())
 		}
+	case "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.aggregate":
+		if x.Sum == nil {
+			value := &SignatureDescriptor_Data_Aggregate{}
+			oneofValue := &SignatureDescriptor_Data_Aggregate_{Aggregate: value}
+			x.Sum = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
+		switch m := x.Sum.(type) {
+		case *SignatureDescriptor_Data_Aggregate_:
+			return protoreflect.ValueOfMessage(m.Aggregate.ProtoReflect())
+		default:
+			value := &SignatureDescriptor_Data_Aggregate{}
+			oneofValue := &SignatureDescriptor_Data_Aggregate_{Aggregate: value}
+			x.Sum = oneofValue
+			return protoreflect.ValueOfMessage(value.ProtoReflect())
+		}
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data"))
@@ -1344,6 +1390,9 @@ func (x *fastReflection_SignatureDescriptor_Data) NewField(fd protoreflect.Field
 	case "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.multi":
 		value := &SignatureDescriptor_Data_Multi{}
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.aggregate":
+		value := &SignatureDescriptor_Data_Aggregate{}
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data"))
@@ -1366,6 +1415,8 @@ func (x *fastReflection_SignatureDescriptor_Data) WhichOneof(d protoreflect.Oneo
 			return x.Descriptor().Fields().ByName("single")
 		case *SignatureDescriptor_Data_Multi_:
 			return x.Descriptor().Fields().ByName("multi")
+		case *SignatureDescriptor_Data_Aggregate_:
+			return x.Descriptor().Fields().ByName("aggregate")
 		}
 	default:
 		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.signing.v1beta1.SignatureDescriptor.Data", d.FullName()))
@@ -1436,6 +1487,12 @@ func (x *fastReflection_SignatureDescriptor_Data) ProtoMethods() *protoiface.Met
 			}
 			l = options.Size(x.Multi)
 			n += 1 + l + runtime.Sov(uint64(l))
+		case *SignatureDescriptor_Data_Aggregate_:
+			if x == nil {
+				break
+			}
+			l = options.Size(x.Aggregate)
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1493,6 +1550,19 @@ func (x *fastReflection_SignatureDescriptor_Data) ProtoMethods() *protoiface.Met
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
+		case *SignatureDescriptor_Data_Aggregate_:
+			encoded, err := options.Marshal(x.Aggregate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -1613,6 +1683,41 @@ func (x *fastReflection_SignatureDescriptor_Data) ProtoMethods() *protoiface.Met
 				}
 				x.Sum = &SignatureDescriptor_Data_Multi_{v}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Aggregate", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				v := &SignatureDescriptor_Data_Aggregate{}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], v); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				x.Sum = &SignatureDescriptor_Data_Aggregate_{v}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2691,204 +2796,683 @@ func (x *fastReflection_SignatureDescriptor_Data_Multi) ProtoMethods() *protoifa
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: cosmos/tx/signing/v1beta1/signing.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// SignMode represents a signing mode with its own security guarantees.
-//
-// This enum should be considered a registry of all known sign modes
-// in the Cosmos ecosystem. Apps are not expected to support all known
-// sign modes. Apps that would like to support custom  sign modes are
-// encouraged to open a small PR against this file to add a new case
-// to this SignMode enum describing their sign mode so that different
-// apps have a consistent version of this enum.
-type SignMode int32
-
-const (
-	// SIGN_MODE_UNSPECIFIED specifies an unknown signing mode and will be
-	// rejected.
-	SignMode_SIGN_MODE_UNSPECIFIED SignMode = 0
-	// SIGN_MODE_DIRECT specifies a signing mode which uses SignDoc and is
-	// verified with raw bytes from Tx.
-	SignMode_SIGN_MODE_DIRECT SignMode = 1
-	// SIGN_MODE_TEXTUAL is a future signing mode that will verify some
-	// human-readable textual representation on top of the binary representation
-	// from SIGN_MODE_DIRECT.
-	//
-	// Since: cosmos-sdk 0.50
-	SignMode_SIGN_MODE_TEXTUAL SignMode = 2
-	// SIGN_MODE_DIRECT_AUX specifies a signing mode which uses
-	// SignDocDirectAux. As opposed to SIGN_MODE_DIRECT, this sign mode does not
-	// require signers signing over other signers' `signer_info`.
-	//
-	// Since: cosmos-sdk 0.46
-	SignMode_SIGN_MODE_DIRECT_AUX SignMode = 3
-	// SIGN_MODE_LEGACY_AMINO_JSON is a backwards compatibility mode which uses
-	// Amino JSON and will be removed in the future.
-	SignMode_SIGN_MODE_LEGACY_AMINO_JSON SignMode = 127
-	// SIGN_MODE_EIP_191 specifies the sign mode for EIP 191 signing on the Cosmos
-	// SDK. Ref: https://eips.ethereum.org/EIPS/eip-191
-	//
-	// Currently, SIGN_MODE_EIP_191 is registered as a SignMode enum variant,
-	// but is not implemented on the SDK by default. To enable EIP-191, you need
-	// to pass a custom `TxConfig` that has an implementation of
-	// `SignModeHandler` for EIP-191. The SDK may decide to fully support
-	// EIP-191 in the future.
-	//
-	// Since: cosmos-sdk 0.45.2
-	SignMode_SIGN_MODE_EIP_191 SignMode = 191
-)
-
-// Enum value maps for SignMode.
 var (
-	SignMode_name = map[int32]string{
-		0:   "SIGN_MODE_UNSPECIFIED",
-		1:   "SIGN_MODE_DIRECT",
-		2:   "SIGN_MODE_TEXTUAL",
-		3:   "SIGN_MODE_DIRECT_AUX",
-		127: "SIGN_MODE_LEGACY_AMINO_JSON",
-		191: "SIGN_MODE_EIP_191",
-	}
-	SignMode_value = map[string]int32{
-		"SIGN_MODE_UNSPECIFIED":       0,
-		"SIGN_MODE_DIRECT":            1,
-		"SIGN_MODE_TEXTUAL":           2,
-		"SIGN_MODE_DIRECT_AUX":        3,
-		"SIGN_MODE_LEGACY_AMINO_JSON": 127,
-		"SIGN_MODE_EIP_191":           191,
-	}
+	md_SignatureDescriptor_Data_Aggregate           protoreflect.MessageDescriptor
+	fd_SignatureDescriptor_Data_Aggregate_mode      protoreflect.FieldDescriptor
+	fd_SignatureDescriptor_Data_Aggregate_signature protoreflect.FieldDescriptor
 )
 
-func (x SignMode) Enum() *SignMode {
-	p := new(SignMode)
-	*p = x
-	return p
-}
-
-func (x SignMode) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (SignMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_cosmos_tx_signing_v1beta1_signing_proto_enumTypes[0].Descriptor()
-}
-
-func (SignMode) Type() protoreflect.EnumType {
-	return &file_cosmos_tx_signing_v1beta1_signing_proto_enumTypes[0]
-}
-
-func (x SignMode) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func init() {
+	file_cosmos_tx_signing_v1beta1_signing_proto_init()
+	md_SignatureDescriptor_Data_Aggregate = File_cosmos_tx_signing_v1beta1_signing_proto.Messages().ByName("SignatureDescriptor").Messages().ByName("Data").Messages().ByName("Aggregate")
+	fd_SignatureDescriptor_Data_Aggregate_mode = md_SignatureDescriptor_Data_Aggregate.Fields().ByName("mode")
+	fd_SignatureDescriptor_Data_Aggregate_signature = md_SignatureDescriptor_Data_Aggregate.Fields().ByName("signature")
 }
 
-// Deprecated: Use SignMode.Descriptor instead.
-func (SignMode) EnumDescriptor() ([]byte, []int) {
-	return file_cosmos_tx_signing_v1beta1_signing_proto_rawDescGZIP(), []int{0}
-}
+var _ protoreflect.Message = (*fastReflection_SignatureDescriptor_Data_Aggregate)(nil)
 
-// SignatureDescriptors wraps multiple SignatureDescriptor's.
-type SignatureDescriptors struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type fastReflection_SignatureDescriptor_Data_Aggregate SignatureDescriptor_Data_Aggregate
 
-	// signatures are the signature descriptors
-	Signatures []*SignatureDescriptor `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+func (x *SignatureDescriptor_Data_Aggregate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SignatureDescriptor_Data_Aggregate)(x)
 }
 
-func (x *SignatureDescriptors) Reset() {
-	*x = SignatureDescriptors{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_signing_v1beta1_signing_proto_msgTypes[0]
+func (x *SignatureDescriptor_Data_Aggregate) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_signing_v1beta1_signing_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *SignatureDescriptors) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SignatureDescriptors) ProtoMessage() {}
+var _fastReflection_SignatureDescriptor_Data_Aggregate_messageType fastReflection_SignatureDescriptor_Data_Aggregate_messageType
+var _ protoreflect.MessageType = fastReflection_SignatureDescriptor_Data_Aggregate_messageType{}
 
-// Deprecated: Use SignatureDescriptors.ProtoReflect.Descriptor instead.
-func (*SignatureDescriptors) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_signing_v1beta1_signing_proto_rawDescGZIP(), []int{0}
-}
+type fastReflection_SignatureDescriptor_Data_Aggregate_messageType struct{}
 
-func (x *SignatureDescriptors) GetSignatures() []*SignatureDescriptor {
-	if x != nil {
-		return x.Signatures
-	}
-	return nil
+func (x fastReflection_SignatureDescriptor_Data_Aggregate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SignatureDescriptor_Data_Aggregate)(nil)
 }
-
-// SignatureDescriptor is a convenience type which represents the full data for
-// a signature including the public key of the signer, signing modes and the
-// signature itself. It is primarily used for coordinating signatures between
-// clients.
-type SignatureDescriptor struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// public_key is the public key of the signer
-	PublicKey *anypb.Any                `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Data      *SignatureDescriptor_Data `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	// sequence is the sequence of the account, which describes the
-	// number of committed transactions signed by a given address. It is used to prevent
-	// replay attacks.
-	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+func (x fastReflection_SignatureDescriptor_Data_Aggregate_messageType) New() protoreflect.Message {
+	return new(fastReflection_SignatureDescriptor_Data_Aggregate)
 }
-
-func (x *SignatureDescriptor) Reset() {
-	*x = SignatureDescriptor{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_signing_v1beta1_signing_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x fastReflection_SignatureDescriptor_Data_Aggregate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignatureDescriptor_Data_Aggregate
 }
 
-func (x *SignatureDescriptor) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SignatureDescriptor_Data_Aggregate) Descriptor() protoreflect.MessageDescriptor {
+	return md_SignatureDescriptor_Data_Aggregate
 }
 
-func (*SignatureDescriptor) ProtoMessage() {}
-
-// Deprecated: Use SignatureDescriptor.ProtoReflect.Descriptor instead.
-func (*SignatureDescriptor) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_signing_v1beta1_signing_proto_rawDescGZIP(), []int{1}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SignatureDescriptor_Data_Aggregate) Type() protoreflect.MessageType {
+	return _fastReflection_SignatureDescriptor_Data_Aggregate_messageType
 }
 
-func (x *SignatureDescriptor) GetPublicKey() *anypb.Any {
-	if x != nil {
-		return x.PublicKey
-	}
-	return nil
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SignatureDescriptor_Data_Aggregate) New() protoreflect.Message {
+	return new(fastReflection_SignatureDescriptor_Data_Aggregate)
 }
 
-func (x *SignatureDescriptor) GetData() *SignatureDescriptor_Data {
-	if x != nil {
-		return x.Data
-	}
-	return nil
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SignatureDescriptor_Data_Aggregate) Interface() protoreflect.ProtoMessage {
+	return (*SignatureDescriptor_Data_Aggregate)(x)
 }
 
-func (x *SignatureDescriptor) GetSequence() uint64 {
-	if x != nil {
-		return x.Sequence
-	}
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SignatureDescriptor_Data_Aggregate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Mode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Mode))
+		if !f(fd_SignatureDescriptor_Data_Aggregate_mode, value) {
+			return
+		}
+	}
+	if len(x.Signature) != 0 {
+		value := protoreflect.ValueOfBytes(x.Signature)
+		if !f(fd_SignatureDescriptor_Data_Aggregate_signature, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SignatureDescriptor_Data_Aggregate) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate.mode":
+		return x.Mode != 0
+	case "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate.signature":
+		return len(x.Signature) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignatureDescriptor_Data_Aggregate) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate.mode":
+		x.Mode = 0
+	case "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate.signature":
+		x.Signature = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SignatureDescriptor_Data_Aggregate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate.mode":
+		value := x.Mode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate.signature":
+		value := x.Signature
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignatureDescriptor_Data_Aggregate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate.mode":
+		x.Mode = (SignMode)(value.Enum())
+	case "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate.signature":
+		x.Signature = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignatureDescriptor_Data_Aggregate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate.mode":
+		panic(fmt.Errorf("field mode of message cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate is not mutable"))
+	case "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate.signature":
+		panic(fmt.Errorf("field signature of message cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SignatureDescriptor_Data_Aggregate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate.mode":
+		return protoreflect.ValueOfEnum(0)
+	case "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate.signature":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SignatureDescriptor_Data_Aggregate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SignatureDescriptor_Data_Aggregate) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SignatureDescriptor_Data_Aggregate) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SignatureDescriptor_Data_Aggregate) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SignatureDescriptor_Data_Aggregate) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SignatureDescriptor_Data_Aggregate)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Mode != 0 {
+			n += 1 + runtime.Sov(uint64(x.Mode))
+		}
+		l = len(x.Signature)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SignatureDescriptor_Data_Aggregate)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signature) > 0 {
+			i -= len(x.Signature)
+			copy(dAtA[i:], x.Signature)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signature)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Mode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Mode))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SignatureDescriptor_Data_Aggregate)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignatureDescriptor_Data_Aggregate: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SignatureDescriptor_Data_Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+				}
+				x.Mode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Mode |= SignMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signature = append(x.Signature[:0], dAtA[iNdEx:postIndex]...)
+				if x.Signature == nil {
+					x.Signature = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: cosmos/tx/signing/v1beta1/signing.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SignMode represents a signing mode with its own security guarantees.
+//
+// This enum should be considered a registry of all known sign modes
+// in the Cosmos ecosystem. Apps are not expected to support all known
+// sign modes. Apps that would like to support custom  sign modes are
+// encouraged to open a small PR against this file to add a new case
+// to this SignMode enum describing their sign mode so that different
+// apps have a consistent version of this enum.
+type SignMode int32
+
+const (
+	// SIGN_MODE_UNSPECIFIED specifies an unknown signing mode and will be
+	// rejected.
+	SignMode_SIGN_MODE_UNSPECIFIED SignMode = 0
+	// SIGN_MODE_DIRECT specifies a signing mode which uses SignDoc and is
+	// verified with raw bytes from Tx.
+	SignMode_SIGN_MODE_DIRECT SignMode = 1
+	// SIGN_MODE_TEXTUAL is a future signing mode that will verify some
+	// human-readable textual representation on top of the binary representation
+	// from SIGN_MODE_DIRECT.
+	//
+	// Since: cosmos-sdk 0.50
+	SignMode_SIGN_MODE_TEXTUAL SignMode = 2
+	// SIGN_MODE_DIRECT_AUX specifies a signing mode which uses
+	// SignDocDirectAux. As opposed to SIGN_MODE_DIRECT, this sign mode does not
+	// require signers signing over other signers' `signer_info`.
+	//
+	// Since: cosmos-sdk 0.46
+	SignMode_SIGN_MODE_DIRECT_AUX SignMode = 3
+	// SIGN_MODE_LEGACY_AMINO_JSON is a backwards compatibility mode which uses
+	// Amino JSON and will be removed in the future.
+	SignMode_SIGN_MODE_LEGACY_AMINO_JSON SignMode = 127
+	// SIGN_MODE_EIP_191 specifies the sign mode for EIP 191 signing on the Cosmos
+	// SDK. Ref: https://eips.ethereum.org/EIPS/eip-191
+	//
+	// Currently, SIGN_MODE_EIP_191 is registered as a SignMode enum variant,
+	// but is not implemented on the SDK by default. To enable EIP-191, you need
+	// to pass a custom `TxConfig` that has an implementation of
+	// `SignModeHandler` for EIP-191. The SDK may decide to fully support
+	// EIP-191 in the future.
+	//
+	// Since: cosmos-sdk 0.45.2
+	SignMode_SIGN_MODE_EIP_191 SignMode = 191
+	// SIGN_MODE_EIP_712 specifies the sign mode for EIP-712 signing on the
+	// Cosmos SDK, which maps the tx body and auth info into EIP-712 typed data
+	// that Ethereum wallets can sign.
+	// Ref: https://eips.ethereum.org/EIPS/eip-712
+	//
+	// Since: cosmos-sdk 0.54
+	SignMode_SIGN_MODE_EIP_712 SignMode = 712
+)
+
+// Enum value maps for SignMode.
+var (
+	SignMode_name = map[int32]string{
+		0:   "SIGN_MODE_UNSPECIFIED",
+		1:   "SIGN_MODE_DIRECT",
+		2:   "SIGN_MODE_TEXTUAL",
+		3:   "SIGN_MODE_DIRECT_AUX",
+		127: "SIGN_MODE_LEGACY_AMINO_JSON",
+		191: "SIGN_MODE_EIP_191",
+		712: "SIGN_MODE_EIP_712",
+	}
+	SignMode_value = map[string]int32{
+		"SIGN_MODE_UNSPECIFIED":       0,
+		"SIGN_MODE_DIRECT":            1,
+		"SIGN_MODE_TEXTUAL":           2,
+		"SIGN_MODE_DIRECT_AUX":        3,
+		"SIGN_MODE_LEGACY_AMINO_JSON": 127,
+		"SIGN_MODE_EIP_191":           191,
+		"SIGN_MODE_EIP_712":           712,
+	}
+)
+
+func (x SignMode) Enum() *SignMode {
+	p := new(SignMode)
+	*p = x
+	return p
+}
+
+func (x SignMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SignMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_cosmos_tx_signing_v1beta1_signing_proto_enumTypes[0].Descriptor()
+}
+
+func (SignMode) Type() protoreflect.EnumType {
+	return &file_cosmos_tx_signing_v1beta1_signing_proto_enumTypes[0]
+}
+
+func (x SignMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SignMode.Descriptor instead.
+func (SignMode) EnumDescriptor() ([]byte, []int) {
+	return file_cosmos_tx_signing_v1beta1_signing_proto_rawDescGZIP(), []int{0}
+}
+
+// SignatureDescriptors wraps multiple SignatureDescriptor's.
+type SignatureDescriptors struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// signatures are the signature descriptors
+	Signatures []*SignatureDescriptor `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (x *SignatureDescriptors) Reset() {
+	*x = SignatureDescriptors{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_tx_signing_v1beta1_signing_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignatureDescriptors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignatureDescriptors) ProtoMessage() {}
+
+// Deprecated: Use SignatureDescriptors.ProtoReflect.Descriptor instead.
+func (*SignatureDescriptors) Descriptor() ([]byte, []int) {
+	return file_cosmos_tx_signing_v1beta1_signing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SignatureDescriptors) GetSignatures() []*SignatureDescriptor {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+// SignatureDescriptor is a convenience type which represents the full data for
+// a signature including the public key of the signer, signing modes and the
+// signature itself. It is primarily used for coordinating signatures between
+// clients.
+type SignatureDescriptor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// public_key is the public key of the signer
+	PublicKey *anypb.Any                `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Data      *SignatureDescriptor_Data `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// sequence is the sequence of the account, which describes the
+	// number of committed transactions signed by a given address. It is used to prevent
+	// replay attacks.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *SignatureDescriptor) Reset() {
+	*x = SignatureDescriptor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_tx_signing_v1beta1_signing_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignatureDescriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignatureDescriptor) ProtoMessage() {}
+
+// Deprecated: Use SignatureDescriptor.ProtoReflect.Descriptor instead.
+func (*SignatureDescriptor) Descriptor() ([]byte, []int) {
+	return file_cosmos_tx_signing_v1beta1_signing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SignatureDescriptor) GetPublicKey() *anypb.Any {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *SignatureDescriptor) GetData() *SignatureDescriptor_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SignatureDescriptor) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
 	return 0
 }
 
@@ -2901,9 +3485,9 @@ type SignatureDescriptor_Data struct {
 	// sum is the oneof that specifies whether this represents single or multi-signature data
 	//
 	// Types that are assignable to Sum:
-	//
 	//	*SignatureDescriptor_Data_Single_
 	//	*SignatureDescriptor_Data_Multi_
+	//	*SignatureDescriptor_Data_Aggregate_
 	Sum isSignatureDescriptor_Data_Sum `protobuf_oneof:"sum"`
 }
 
@@ -2948,6 +3532,13 @@ func (x *SignatureDescriptor_Data) GetMulti() *SignatureDescriptor_Data_Multi {
 	return nil
 }
 
+func (x *SignatureDescriptor_Data) GetAggregate() *SignatureDescriptor_Data_Aggregate {
+	if x, ok := x.GetSum().(*SignatureDescriptor_Data_Aggregate_); ok {
+		return x.Aggregate
+	}
+	return nil
+}
+
 type isSignatureDescriptor_Data_Sum interface {
 	isSignatureDescriptor_Data_Sum()
 }
@@ -2962,10 +3553,18 @@ type SignatureDescriptor_Data_Multi_ struct {
 	Multi *SignatureDescriptor_Data_Multi `protobuf:"bytes,2,opt,name=multi,proto3,oneof"`
 }
 
+type SignatureDescriptor_Data_Aggregate_ struct {
+	// aggregate represents a BLS12-381 signer whose signature is aggregated
+	// with the ones of the other aggregate signers of the tx
+	Aggregate *SignatureDescriptor_Data_Aggregate `protobuf:"bytes,3,opt,name=aggregate,proto3,oneof"`
+}
+
 func (*SignatureDescriptor_Data_Single_) isSignatureDescriptor_Data_Sum() {}
 
 func (*SignatureDescriptor_Data_Multi_) isSignatureDescriptor_Data_Sum() {}
 
+func (*SignatureDescriptor_Data_Aggregate_) isSignatureDescriptor_Data_Sum() {}
+
 // Single is the signature data for a single signer
 type SignatureDescriptor_Data_Single struct {
 	state         protoimpl.MessageState
@@ -3058,6 +3657,54 @@ func (x *SignatureDescriptor_Data_Multi) GetSignatures() []*SignatureDescriptor_
 	return nil
 }
 
+// Aggregate is the signature data for a BLS12-381 signer whose signature
+// is aggregated with the ones of the other aggregate signers of the tx
+type SignatureDescriptor_Data_Aggregate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// mode is the signing mode of the signer
+	Mode SignMode `protobuf:"varint,1,opt,name=mode,proto3,enum=cosmos.tx.signing.v1beta1.SignMode" json:"mode,omitempty"`
+	// signature is the aggregate signature of the tx when carried by this
+	// signer, and is empty otherwise
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *SignatureDescriptor_Data_Aggregate) Reset() {
+	*x = SignatureDescriptor_Data_Aggregate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_tx_signing_v1beta1_signing_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignatureDescriptor_Data_Aggregate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignatureDescriptor_Data_Aggregate) ProtoMessage() {}
+
+// Deprecated: Use SignatureDescriptor_Data_Aggregate.ProtoReflect.Descriptor instead.
+func (*SignatureDescriptor_Data_Aggregate) Descriptor() ([]byte, []int) {
+	return file_cosmos_tx_signing_v1beta1_signing_proto_rawDescGZIP(), []int{1, 0, 2}
+}
+
+func (x *SignatureDescriptor_Data_Aggregate) GetMode() SignMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SignMode_SIGN_MODE_UNSPECIFIED
+}
+
+func (x *SignatureDescriptor_Data_Aggregate) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
 var File_cosmos_tx_signing_v1beta1_signing_proto protoreflect.FileDescriptor
 
 var file_cosmos_tx_signing_v1beta1_signing_proto_rawDesc = []byte{
@@ -3069,64 +3716,82 @@ var file_cosmos_tx_signing_v1beta1_signing_proto_rawDesc = []byte{
 	0x70, 0x74, 0x6f, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x62,
 	0x65, 0x74, 0x61, 0x31, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x66,
-	0x0a, 0x14, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x4e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0xf5, 0x04, 0x0a, 0x13, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x33,
-	0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x4b, 0x65, 0x79, 0x12, 0x47, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x33, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x73, 0x69,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x66, 0x0a, 0x14, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72,
+	0x73, 0x12, 0x4e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74,
+	0x78, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x73, 0x22, 0xe2, 0x06, 0x0a, 0x13, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x41, 0x6e, 0x79, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x47,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x1a, 0xb0, 0x05, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x54, 0x0a, 0x06,
+	0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x06, 0x73, 0x69, 0x6e, 0x67,
+	0x6c, 0x65, 0x12, 0x51, 0x0a, 0x05, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x39, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x73, 0x69,
 	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69,
 	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
-	0x72, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08,
-	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
-	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0xc3, 0x03, 0x0a, 0x04, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x54, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x3a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x73, 0x69,
+	0x72, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x48, 0x00, 0x52, 0x05,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x12, 0x72, 0x0a, 0x09, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x74, 0x78, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x42, 0x13, 0xda, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x35, 0x34, 0x48, 0x00, 0x52, 0x09,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x1a, 0x5f, 0x0a, 0x06, 0x53, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x73, 0x69,
 	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f,
-	0x72, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x48, 0x00, 0x52,
-	0x06, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x51, 0x0a, 0x05, 0x6d, 0x75, 0x6c, 0x74, 0x69,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x74, 0x78, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x48, 0x00, 0x52, 0x05, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x1a, 0x5f, 0x0a, 0x06, 0x53, 0x69,
-	0x6e, 0x67, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x73,
-	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53,
-	0x69, 0x67, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0xa9, 0x01, 0x0a, 0x05,
-	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x12, 0x4b, 0x0a, 0x08, 0x62, 0x69, 0x74, 0x61, 0x72, 0x72, 0x61,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74,
-	0x42, 0x69, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x08, 0x62, 0x69, 0x74, 0x61, 0x72, 0x72,
-	0x61, 0x79, 0x12, 0x53, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x74, 0x78, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x42, 0x05, 0x0a, 0x03, 0x73, 0x75, 0x6d, 0x2a, 0xa5,
-	0x01, 0x0a, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x53,
-	0x49, 0x47, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x4d,
-	0x4f, 0x44, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11,
-	0x53, 0x49, 0x47, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x55, 0x41,
-	0x4c, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
-	0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x55, 0x58, 0x10, 0x03, 0x12, 0x1f, 0x0a,
-	0x1b, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4c, 0x45, 0x47, 0x41, 0x43,
-	0x59, 0x5f, 0x41, 0x4d, 0x49, 0x4e, 0x4f, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x7f, 0x12, 0x16,
+	0x67, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0xa9, 0x01, 0x0a, 0x05, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x12, 0x4b, 0x0a, 0x08, 0x62, 0x69, 0x74, 0x61, 0x72, 0x72, 0x61, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x2e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x42,
+	0x69, 0x74, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x08, 0x62, 0x69, 0x74, 0x61, 0x72, 0x72, 0x61,
+	0x79, 0x12, 0x53, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74,
+	0x78, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x1a, 0x77, 0x0a, 0x09, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x35, 0x34, 0x42,
+	0x05, 0x0a, 0x03, 0x73, 0x75, 0x6d, 0x2a, 0xbd, 0x01, 0x0a, 0x08, 0x53, 0x69, 0x67, 0x6e, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14,
+	0x0a, 0x10, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x45,
+	0x43, 0x54, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x55, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x53,
+	0x49, 0x47, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x5f,
+	0x41, 0x55, 0x58, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x4c, 0x45, 0x47, 0x41, 0x43, 0x59, 0x5f, 0x41, 0x4d, 0x49, 0x4e, 0x4f, 0x5f,
+	0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x7f, 0x12, 0x16, 0x0a, 0x11, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x45, 0x49, 0x50, 0x5f, 0x31, 0x39, 0x31, 0x10, 0xbf, 0x01, 0x12, 0x16,
 	0x0a, 0x11, 0x53, 0x49, 0x47, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x49, 0x50, 0x5f,
-	0x31, 0x39, 0x31, 0x10, 0xbf, 0x01, 0x42, 0xef, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
+	0x37, 0x31, 0x32, 0x10, 0xc8, 0x05, 0x42, 0xef, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
 	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x69, 0x6e,
 	0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
@@ -3157,31 +3822,34 @@ func file_cosmos_tx_signing_v1beta1_signing_proto_rawDescGZIP() []byte {
 }
 
 var file_cosmos_tx_signing_v1beta1_signing_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_cosmos_tx_signing_v1beta1_signing_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_cosmos_tx_signing_v1beta1_signing_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_cosmos_tx_signing_v1beta1_signing_proto_goTypes = []interface{}{
-	(SignMode)(0),                           // 0: cosmos.tx.signing.v1beta1.SignMode
-	(*SignatureDescriptors)(nil),            // 1: cosmos.tx.signing.v1beta1.SignatureDescriptors
-	(*SignatureDescriptor)(nil),             // 2: cosmos.tx.signing.v1beta1.SignatureDescriptor
-	(*SignatureDescriptor_Data)(nil),        // 3: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data
-	(*SignatureDescriptor_Data_Single)(nil), // 4: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Single
-	(*SignatureDescriptor_Data_Multi)(nil),  // 5: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Multi
-	(*anypb.Any)(nil),                       // 6: google.protobuf.Any
-	(*v1beta1.CompactBitArray)(nil),         // 7: cosmos.crypto.multisig.v1beta1.CompactBitArray
+	(SignMode)(0),                              // 0: cosmos.tx.signing.v1beta1.SignMode
+	(*SignatureDescriptors)(nil),               // 1: cosmos.tx.signing.v1beta1.SignatureDescriptors
+	(*SignatureDescriptor)(nil),                // 2: cosmos.tx.signing.v1beta1.SignatureDescriptor
+	(*SignatureDescriptor_Data)(nil),           // 3: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data
+	(*SignatureDescriptor_Data_Single)(nil),    // 4: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Single
+	(*SignatureDescriptor_Data_Multi)(nil),     // 5: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Multi
+	(*SignatureDescriptor_Data_Aggregate)(nil), // 6: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate
+	(*anypb.Any)(nil),                          // 7: google.protobuf.Any
+	(*v1beta1.CompactBitArray)(nil),            // 8: cosmos.crypto.multisig.v1beta1.CompactBitArray
 }
 var file_cosmos_tx_signing_v1beta1_signing_proto_depIdxs = []int32{
-	2, // 0: cosmos.tx.signing.v1beta1.SignatureDescriptors.signatures:type_name -> cosmos.tx.signing.v1beta1.SignatureDescriptor
-	6, // 1: cosmos.tx.signing.v1beta1.SignatureDescriptor.public_key:type_name -> google.protobuf.Any
-	3, // 2: cosmos.tx.signing.v1beta1.SignatureDescriptor.data:type_name -> cosmos.tx.signing.v1beta1.SignatureDescriptor.Data
-	4, // 3: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.single:type_name -> cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Single
-	5, // 4: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.multi:type_name -> cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Multi
-	0, // 5: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Single.mode:type_name -> cosmos.tx.signing.v1beta1.SignMode
-	7, // 6: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Multi.bitarray:type_name -> cosmos.crypto.multisig.v1beta1.CompactBitArray
-	3, // 7: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Multi.signatures:type_name -> cosmos.tx.signing.v1beta1.SignatureDescriptor.Data
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	2,  // 0: cosmos.tx.signing.v1beta1.SignatureDescriptors.signatures:type_name -> cosmos.tx.signing.v1beta1.SignatureDescriptor
+	7,  // 1: cosmos.tx.signing.v1beta1.SignatureDescriptor.public_key:type_name -> google.protobuf.Any
+	3,  // 2: cosmos.tx.signing.v1beta1.SignatureDescriptor.data:type_name -> cosmos.tx.signing.v1beta1.SignatureDescriptor.Data
+	4,  // 3: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.single:type_name -> cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Single
+	5,  // 4: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.multi:type_name -> cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Multi
+	6,  // 5: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.aggregate:type_name -> cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate
+	0,  // 6: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Single.mode:type_name -> cosmos.tx.signing.v1beta1.SignMode
+	8,  // 7: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Multi.bitarray:type_name -> cosmos.crypto.multisig.v1beta1.CompactBitArray
+	3,  // 8: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Multi.signatures:type_name -> cosmos.tx.signing.v1beta1.SignatureDescriptor.Data
+	0,  // 9: cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate.mode:type_name -> cosmos.tx.signing.v1beta1.SignMode
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_tx_signing_v1beta1_signing_proto_init() }
@@ -3250,10 +3918,23 @@ func file_cosmos_tx_signing_v1beta1_signing_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_tx_signing_v1beta1_signing_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignatureDescriptor_Data_Aggregate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_cosmos_tx_signing_v1beta1_signing_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*SignatureDescriptor_Data_Single_)(nil),
 		(*SignatureDescriptor_Data_Multi_)(nil),
+		(*SignatureDescriptor_Data_Aggregate_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3261,7 +3942,7 @@ func file_cosmos_tx_signing_v1beta1_signing_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_tx_signing_v1beta1_signing_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -5,9 +5,11 @@ import (
 	v2 "cosmossdk.io/api/cometbft/types/v2"
 	v1beta11 "cosmossdk.io/api/cosmos/base/abci/v1beta1"
 	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
+	v1beta12 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
@@ -2449,9 +2451,10 @@ func (x *fastReflection_BroadcastTxResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_SimulateRequest          protoreflect.MessageDescriptor
-	fd_SimulateRequest_tx       protoreflect.FieldDescriptor
-	fd_SimulateRequest_tx_bytes protoreflect.FieldDescriptor
+	md_SimulateRequest            protoreflect.MessageDescriptor
+	fd_SimulateRequest_tx         protoreflect.FieldDescriptor
+	fd_SimulateRequest_tx_bytes   protoreflect.FieldDescriptor
+	fd_SimulateRequest_state_diff protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2459,6 +2462,7 @@ func init() {
 	md_SimulateRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("SimulateRequest")
 	fd_SimulateRequest_tx = md_SimulateRequest.Fields().ByName("tx")
 	fd_SimulateRequest_tx_bytes = md_SimulateRequest.Fields().ByName("tx_bytes")
+	fd_SimulateRequest_state_diff = md_SimulateRequest.Fields().ByName("state_diff")
 }
 
 var _ protoreflect.Message = (*fastReflection_SimulateRequest)(nil)
@@ -2538,6 +2542,12 @@ func (x *fastReflection_SimulateRequest) Range(f func(protoreflect.FieldDescript
 			return
 		}
 	}
+	if x.StateDiff != false {
+		value := protoreflect.ValueOfBool(x.StateDiff)
+		if !f(fd_SimulateRequest_state_diff, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2557,6 +2567,8 @@ func (x *fastReflection_SimulateRequest) Has(fd protoreflect.FieldDescriptor) bo
 		return x.Tx != nil
 	case "cosmos.tx.v1beta1.SimulateRequest.tx_bytes":
 		return len(x.TxBytes) != 0
+	case "cosmos.tx.v1beta1.SimulateRequest.state_diff":
+		return x.StateDiff != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateRequest"))
@@ -2577,6 +2589,8 @@ func (x *fastReflection_SimulateRequest) Clear(fd protoreflect.FieldDescriptor)
 		x.Tx = nil
 	case "cosmos.tx.v1beta1.SimulateRequest.tx_bytes":
 		x.TxBytes = nil
+	case "cosmos.tx.v1beta1.SimulateRequest.state_diff":
+		x.StateDiff = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateRequest"))
@@ -2599,6 +2613,9 @@ func (x *fastReflection_SimulateRequest) Get(descriptor protoreflect.FieldDescri
 	case "cosmos.tx.v1beta1.SimulateRequest.tx_bytes":
 		value := x.TxBytes
 		return protoreflect.ValueOfBytes(value)
+	case "cosmos.tx.v1beta1.SimulateRequest.state_diff":
+		value := x.StateDiff
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateRequest"))
@@ -2623,6 +2640,8 @@ func (x *fastReflection_SimulateRequest) Set(fd protoreflect.FieldDescriptor, va
 		x.Tx = value.Message().Interface().(*Tx)
 	case "cosmos.tx.v1beta1.SimulateRequest.tx_bytes":
 		x.TxBytes = value.Bytes()
+	case "cosmos.tx.v1beta1.SimulateRequest.state_diff":
+		x.StateDiff = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateRequest"))
@@ -2650,6 +2669,8 @@ func (x *fastReflection_SimulateRequest) Mutable(fd protoreflect.FieldDescriptor
 		return protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
 	case "cosmos.tx.v1beta1.SimulateRequest.tx_bytes":
 		panic(fmt.Errorf("field tx_bytes of message cosmos.tx.v1beta1.SimulateRequest is not mutable"))
+	case "cosmos.tx.v1beta1.SimulateRequest.state_diff":
+		panic(fmt.Errorf("field state_diff of message cosmos.tx.v1beta1.SimulateRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateRequest"))
@@ -2668,6 +2689,8 @@ func (x *fastReflection_SimulateRequest) NewField(fd protoreflect.FieldDescripto
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "cosmos.tx.v1beta1.SimulateRequest.tx_bytes":
 		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.tx.v1beta1.SimulateRequest.state_diff":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateRequest"))
@@ -2745,6 +2768,9 @@ func (x *fastReflection_SimulateRequest) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.StateDiff {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2774,6 +2800,16 @@ func (x *fastReflection_SimulateRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.StateDiff {
+			i--
+			if x.StateDiff {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
 		if len(x.TxBytes) > 0 {
 			i -= len(x.TxBytes)
 			copy(dAtA[i:], x.TxBytes)
@@ -2914,6 +2950,26 @@ func (x *fastReflection_SimulateRequest) ProtoMethods() *protoiface.Methods {
 					x.TxBytes = []byte{}
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StateDiff", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.StateDiff = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2950,9 +3006,10 @@ func (x *fastReflection_SimulateRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SimulateResponse          protoreflect.MessageDescriptor
-	fd_SimulateResponse_gas_info protoreflect.FieldDescriptor
-	fd_SimulateResponse_result   protoreflect.FieldDescriptor
+	md_SimulateResponse            protoreflect.MessageDescriptor
+	fd_SimulateResponse_gas_info   protoreflect.FieldDescriptor
+	fd_SimulateResponse_result     protoreflect.FieldDescriptor
+	fd_SimulateResponse_state_diff protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2960,6 +3017,7 @@ func init() {
 	md_SimulateResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("SimulateResponse")
 	fd_SimulateResponse_gas_info = md_SimulateResponse.Fields().ByName("gas_info")
 	fd_SimulateResponse_result = md_SimulateResponse.Fields().ByName("result")
+	fd_SimulateResponse_state_diff = md_SimulateResponse.Fields().ByName("state_diff")
 }
 
 var _ protoreflect.Message = (*fastReflection_SimulateResponse)(nil)
@@ -3039,6 +3097,12 @@ func (x *fastReflection_SimulateResponse) Range(f func(protoreflect.FieldDescrip
 			return
 		}
 	}
+	if x.StateDiff != nil {
+		value := protoreflect.ValueOfMessage(x.StateDiff.ProtoReflect())
+		if !f(fd_SimulateResponse_state_diff, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3058,6 +3122,8 @@ func (x *fastReflection_SimulateResponse) Has(fd protoreflect.FieldDescriptor) b
 		return x.GasInfo != nil
 	case "cosmos.tx.v1beta1.SimulateResponse.result":
 		return x.Result != nil
+	case "cosmos.tx.v1beta1.SimulateResponse.state_diff":
+		return x.StateDiff != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateResponse"))
@@ -3078,6 +3144,8 @@ func (x *fastReflection_SimulateResponse) Clear(fd protoreflect.FieldDescriptor)
 		x.GasInfo = nil
 	case "cosmos.tx.v1beta1.SimulateResponse.result":
 		x.Result = nil
+	case "cosmos.tx.v1beta1.SimulateResponse.state_diff":
+		x.StateDiff = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateResponse"))
@@ -3100,6 +3168,9 @@ func (x *fastReflection_SimulateResponse) Get(descriptor protoreflect.FieldDescr
 	case "cosmos.tx.v1beta1.SimulateResponse.result":
 		value := x.Result
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.tx.v1beta1.SimulateResponse.state_diff":
+		value := x.StateDiff
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateResponse"))
@@ -3124,6 +3195,8 @@ func (x *fastReflection_SimulateResponse) Set(fd protoreflect.FieldDescriptor, v
 		x.GasInfo = value.Message().Interface().(*v1beta11.GasInfo)
 	case "cosmos.tx.v1beta1.SimulateResponse.result":
 		x.Result = value.Message().Interface().(*v1beta11.Result)
+	case "cosmos.tx.v1beta1.SimulateResponse.state_diff":
+		x.StateDiff = value.Message().Interface().(*StateDiff)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateResponse"))
@@ -3154,6 +3227,11 @@ func (x *fastReflection_SimulateResponse) Mutable(fd protoreflect.FieldDescripto
 			x.Result = new(v1beta11.Result)
 		}
 		return protoreflect.ValueOfMessage(x.Result.ProtoReflect())
+	case "cosmos.tx.v1beta1.SimulateResponse.state_diff":
+		if x.StateDiff == nil {
+			x.StateDiff = new(StateDiff)
+		}
+		return protoreflect.ValueOfMessage(x.StateDiff.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateResponse"))
@@ -3173,6 +3251,9 @@ func (x *fastReflection_SimulateResponse) NewField(fd protoreflect.FieldDescript
 	case "cosmos.tx.v1beta1.SimulateResponse.result":
 		m := new(v1beta11.Result)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.tx.v1beta1.SimulateResponse.state_diff":
+		m := new(StateDiff)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.SimulateResponse"))
@@ -3250,6 +3331,10 @@ func (x *fastReflection_SimulateResponse) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Result)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.StateDiff != nil {
+			l = options.Size(x.StateDiff)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3279,6 +3364,20 @@ func (x *fastReflection_SimulateResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.StateDiff != nil {
+			encoded, err := options.Marshal(x.StateDiff)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.Result != nil {
 			encoded, err := options.Marshal(x.Result)
 			if err != nil {
@@ -3428,6 +3527,42 @@ func (x *fastReflection_SimulateResponse) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StateDiff", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StateDiff == nil {
+					x.StateDiff = &StateDiff{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StateDiff); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3463,26 +3598,130 @@ func (x *fastReflection_SimulateResponse) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_StateDiff_1_list)(nil)
+
+type _StateDiff_1_list struct {
+	list *[]*StoreDiff
+}
+
+func (x *_StateDiff_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StateDiff_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_StateDiff_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StoreDiff)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StateDiff_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StoreDiff)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StateDiff_1_list) AppendMutable() protoreflect.Value {
+	v := new(StoreDiff)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StateDiff_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StateDiff_1_list) NewElement() protoreflect.Value {
+	v := new(StoreDiff)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StateDiff_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_StateDiff_2_list)(nil)
+
+type _StateDiff_2_list struct {
+	list *[]*BalanceDelta
+}
+
+func (x *_StateDiff_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StateDiff_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_StateDiff_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BalanceDelta)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StateDiff_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BalanceDelta)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StateDiff_2_list) AppendMutable() protoreflect.Value {
+	v := new(BalanceDelta)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StateDiff_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StateDiff_2_list) NewElement() protoreflect.Value {
+	v := new(BalanceDelta)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StateDiff_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GetTxRequest      protoreflect.MessageDescriptor
-	fd_GetTxRequest_hash protoreflect.FieldDescriptor
+	md_StateDiff          protoreflect.MessageDescriptor
+	fd_StateDiff_stores   protoreflect.FieldDescriptor
+	fd_StateDiff_balances protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_GetTxRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("GetTxRequest")
-	fd_GetTxRequest_hash = md_GetTxRequest.Fields().ByName("hash")
+	md_StateDiff = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("StateDiff")
+	fd_StateDiff_stores = md_StateDiff.Fields().ByName("stores")
+	fd_StateDiff_balances = md_StateDiff.Fields().ByName("balances")
 }
 
-var _ protoreflect.Message = (*fastReflection_GetTxRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_StateDiff)(nil)
 
-type fastReflection_GetTxRequest GetTxRequest
+type fastReflection_StateDiff StateDiff
 
-func (x *GetTxRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GetTxRequest)(x)
+func (x *StateDiff) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StateDiff)(x)
 }
 
-func (x *GetTxRequest) slowProtoReflect() protoreflect.Message {
+func (x *StateDiff) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3494,43 +3733,43 @@ func (x *GetTxRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GetTxRequest_messageType fastReflection_GetTxRequest_messageType
-var _ protoreflect.MessageType = fastReflection_GetTxRequest_messageType{}
+var _fastReflection_StateDiff_messageType fastReflection_StateDiff_messageType
+var _ protoreflect.MessageType = fastReflection_StateDiff_messageType{}
 
-type fastReflection_GetTxRequest_messageType struct{}
+type fastReflection_StateDiff_messageType struct{}
 
-func (x fastReflection_GetTxRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GetTxRequest)(nil)
+func (x fastReflection_StateDiff_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StateDiff)(nil)
 }
-func (x fastReflection_GetTxRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_GetTxRequest)
+func (x fastReflection_StateDiff_messageType) New() protoreflect.Message {
+	return new(fastReflection_StateDiff)
 }
-func (x fastReflection_GetTxRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetTxRequest
+func (x fastReflection_StateDiff_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StateDiff
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GetTxRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetTxRequest
+func (x *fastReflection_StateDiff) Descriptor() protoreflect.MessageDescriptor {
+	return md_StateDiff
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GetTxRequest) Type() protoreflect.MessageType {
-	return _fastReflection_GetTxRequest_messageType
+func (x *fastReflection_StateDiff) Type() protoreflect.MessageType {
+	return _fastReflection_StateDiff_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GetTxRequest) New() protoreflect.Message {
-	return new(fastReflection_GetTxRequest)
+func (x *fastReflection_StateDiff) New() protoreflect.Message {
+	return new(fastReflection_StateDiff)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GetTxRequest) Interface() protoreflect.ProtoMessage {
-	return (*GetTxRequest)(x)
+func (x *fastReflection_StateDiff) Interface() protoreflect.ProtoMessage {
+	return (*StateDiff)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3538,10 +3777,16 @@ func (x *fastReflection_GetTxRequest) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GetTxRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Hash != "" {
-		value := protoreflect.ValueOfString(x.Hash)
-		if !f(fd_GetTxRequest_hash, value) {
+func (x *fastReflection_StateDiff) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Stores) != 0 {
+		value := protoreflect.ValueOfList(&_StateDiff_1_list{list: &x.Stores})
+		if !f(fd_StateDiff_stores, value) {
+			return
+		}
+	}
+	if len(x.Balances) != 0 {
+		value := protoreflect.ValueOfList(&_StateDiff_2_list{list: &x.Balances})
+		if !f(fd_StateDiff_balances, value) {
 			return
 		}
 	}
@@ -3558,15 +3803,17 @@ func (x *fastReflection_GetTxRequest) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GetTxRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_StateDiff) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxRequest.hash":
-		return x.Hash != ""
+	case "cosmos.tx.v1beta1.StateDiff.stores":
+		return len(x.Stores) != 0
+	case "cosmos.tx.v1beta1.StateDiff.balances":
+		return len(x.Balances) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.StateDiff"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.StateDiff does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3576,15 +3823,17 @@ func (x *fastReflection_GetTxRequest) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_StateDiff) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxRequest.hash":
-		x.Hash = ""
+	case "cosmos.tx.v1beta1.StateDiff.stores":
+		x.Stores = nil
+	case "cosmos.tx.v1beta1.StateDiff.balances":
+		x.Balances = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.StateDiff"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.StateDiff does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3594,16 +3843,25 @@ func (x *fastReflection_GetTxRequest) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GetTxRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StateDiff) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.GetTxRequest.hash":
-		value := x.Hash
-		return protoreflect.ValueOfString(value)
+	case "cosmos.tx.v1beta1.StateDiff.stores":
+		if len(x.Stores) == 0 {
+			return protoreflect.ValueOfList(&_StateDiff_1_list{})
+		}
+		listValue := &_StateDiff_1_list{list: &x.Stores}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.tx.v1beta1.StateDiff.balances":
+		if len(x.Balances) == 0 {
+			return protoreflect.ValueOfList(&_StateDiff_2_list{})
+		}
+		listValue := &_StateDiff_2_list{list: &x.Balances}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.StateDiff"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.StateDiff does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3617,15 +3875,21 @@ func (x *fastReflection_GetTxRequest) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_StateDiff) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxRequest.hash":
-		x.Hash = value.Interface().(string)
+	case "cosmos.tx.v1beta1.StateDiff.stores":
+		lv := value.List()
+		clv := lv.(*_StateDiff_1_list)
+		x.Stores = *clv.list
+	case "cosmos.tx.v1beta1.StateDiff.balances":
+		lv := value.List()
+		clv := lv.(*_StateDiff_2_list)
+		x.Balances = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.StateDiff"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.StateDiff does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3639,40 +3903,54 @@ func (x *fastReflection_GetTxRequest) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StateDiff) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxRequest.hash":
-		panic(fmt.Errorf("field hash of message cosmos.tx.v1beta1.GetTxRequest is not mutable"))
+	case "cosmos.tx.v1beta1.StateDiff.stores":
+		if x.Stores == nil {
+			x.Stores = []*StoreDiff{}
+		}
+		value := &_StateDiff_1_list{list: &x.Stores}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.tx.v1beta1.StateDiff.balances":
+		if x.Balances == nil {
+			x.Balances = []*BalanceDelta{}
+		}
+		value := &_StateDiff_2_list{list: &x.Balances}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.StateDiff"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.StateDiff does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GetTxRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StateDiff) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxRequest.hash":
-		return protoreflect.ValueOfString("")
+	case "cosmos.tx.v1beta1.StateDiff.stores":
+		list := []*StoreDiff{}
+		return protoreflect.ValueOfList(&_StateDiff_1_list{list: &list})
+	case "cosmos.tx.v1beta1.StateDiff.balances":
+		list := []*BalanceDelta{}
+		return protoreflect.ValueOfList(&_StateDiff_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.StateDiff"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.StateDiff does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GetTxRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_StateDiff) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.GetTxRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.StateDiff", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3680,7 +3958,7 @@ func (x *fastReflection_GetTxRequest) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GetTxRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_StateDiff) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3691,7 +3969,7 @@ func (x *fastReflection_GetTxRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_StateDiff) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3703,7 +3981,7 @@ func (x *fastReflection_GetTxRequest) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GetTxRequest) IsValid() bool {
+func (x *fastReflection_StateDiff) IsValid() bool {
 	return x != nil
 }
 
@@ -3713,9 +3991,9 @@ func (x *fastReflection_GetTxRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GetTxRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_StateDiff) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GetTxRequest)
+		x := input.Message.Interface().(*StateDiff)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3727,9 +4005,17 @@ func (x *fastReflection_GetTxRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Hash)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Stores) > 0 {
+			for _, e := range x.Stores {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Balances) > 0 {
+			for _, e := range x.Balances {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3741,7 +4027,7 @@ func (x *fastReflection_GetTxRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GetTxRequest)
+		x := input.Message.Interface().(*StateDiff)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3760,25 +4046,50 @@ func (x *fastReflection_GetTxRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Hash) > 0 {
-			i -= len(x.Hash)
-			copy(dAtA[i:], x.Hash)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
+		if len(x.Balances) > 0 {
+			for iNdEx := len(x.Balances) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Balances[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Stores) > 0 {
+			for iNdEx := len(x.Stores) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Stores[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GetTxRequest)
+		x := input.Message.Interface().(*StateDiff)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3810,17 +4121,17 @@ func (x *fastReflection_GetTxRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StateDiff: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StateDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3830,23 +4141,59 @@ func (x *fastReflection_GetTxRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Hash = string(dAtA[iNdEx:postIndex])
+				x.Stores = append(x.Stores, &StoreDiff{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stores[len(x.Stores)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Balances = append(x.Balances, &BalanceDelta{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Balances[len(x.Balances)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3883,28 +4230,79 @@ func (x *fastReflection_GetTxRequest) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_StoreDiff_2_list)(nil)
+
+type _StoreDiff_2_list struct {
+	list *[]*KVWrite
+}
+
+func (x *_StoreDiff_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StoreDiff_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_StoreDiff_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KVWrite)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StoreDiff_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KVWrite)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StoreDiff_2_list) AppendMutable() protoreflect.Value {
+	v := new(KVWrite)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StoreDiff_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StoreDiff_2_list) NewElement() protoreflect.Value {
+	v := new(KVWrite)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StoreDiff_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GetTxResponse             protoreflect.MessageDescriptor
-	fd_GetTxResponse_tx          protoreflect.FieldDescriptor
-	fd_GetTxResponse_tx_response protoreflect.FieldDescriptor
+	md_StoreDiff            protoreflect.MessageDescriptor
+	fd_StoreDiff_store_name protoreflect.FieldDescriptor
+	fd_StoreDiff_writes     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_GetTxResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("GetTxResponse")
-	fd_GetTxResponse_tx = md_GetTxResponse.Fields().ByName("tx")
-	fd_GetTxResponse_tx_response = md_GetTxResponse.Fields().ByName("tx_response")
+	md_StoreDiff = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("StoreDiff")
+	fd_StoreDiff_store_name = md_StoreDiff.Fields().ByName("store_name")
+	fd_StoreDiff_writes = md_StoreDiff.Fields().ByName("writes")
 }
 
-var _ protoreflect.Message = (*fastReflection_GetTxResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_StoreDiff)(nil)
 
-type fastReflection_GetTxResponse GetTxResponse
+type fastReflection_StoreDiff StoreDiff
 
-func (x *GetTxResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GetTxResponse)(x)
+func (x *StoreDiff) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StoreDiff)(x)
 }
 
-func (x *GetTxResponse) slowProtoReflect() protoreflect.Message {
+func (x *StoreDiff) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3916,43 +4314,43 @@ func (x *GetTxResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GetTxResponse_messageType fastReflection_GetTxResponse_messageType
-var _ protoreflect.MessageType = fastReflection_GetTxResponse_messageType{}
+var _fastReflection_StoreDiff_messageType fastReflection_StoreDiff_messageType
+var _ protoreflect.MessageType = fastReflection_StoreDiff_messageType{}
 
-type fastReflection_GetTxResponse_messageType struct{}
+type fastReflection_StoreDiff_messageType struct{}
 
-func (x fastReflection_GetTxResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GetTxResponse)(nil)
+func (x fastReflection_StoreDiff_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StoreDiff)(nil)
 }
-func (x fastReflection_GetTxResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_GetTxResponse)
+func (x fastReflection_StoreDiff_messageType) New() protoreflect.Message {
+	return new(fastReflection_StoreDiff)
 }
-func (x fastReflection_GetTxResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetTxResponse
+func (x fastReflection_StoreDiff_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StoreDiff
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GetTxResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetTxResponse
+func (x *fastReflection_StoreDiff) Descriptor() protoreflect.MessageDescriptor {
+	return md_StoreDiff
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GetTxResponse) Type() protoreflect.MessageType {
-	return _fastReflection_GetTxResponse_messageType
+func (x *fastReflection_StoreDiff) Type() protoreflect.MessageType {
+	return _fastReflection_StoreDiff_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GetTxResponse) New() protoreflect.Message {
-	return new(fastReflection_GetTxResponse)
+func (x *fastReflection_StoreDiff) New() protoreflect.Message {
+	return new(fastReflection_StoreDiff)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GetTxResponse) Interface() protoreflect.ProtoMessage {
-	return (*GetTxResponse)(x)
+func (x *fastReflection_StoreDiff) Interface() protoreflect.ProtoMessage {
+	return (*StoreDiff)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3960,16 +4358,16 @@ func (x *fastReflection_GetTxResponse) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GetTxResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Tx != nil {
-		value := protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
-		if !f(fd_GetTxResponse_tx, value) {
+func (x *fastReflection_StoreDiff) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StoreName != "" {
+		value := protoreflect.ValueOfString(x.StoreName)
+		if !f(fd_StoreDiff_store_name, value) {
 			return
 		}
 	}
-	if x.TxResponse != nil {
-		value := protoreflect.ValueOfMessage(x.TxResponse.ProtoReflect())
-		if !f(fd_GetTxResponse_tx_response, value) {
+	if len(x.Writes) != 0 {
+		value := protoreflect.ValueOfList(&_StoreDiff_2_list{list: &x.Writes})
+		if !f(fd_StoreDiff_writes, value) {
 			return
 		}
 	}
@@ -3986,17 +4384,17 @@ func (x *fastReflection_GetTxResponse) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GetTxResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_StoreDiff) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxResponse.tx":
-		return x.Tx != nil
-	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
-		return x.TxResponse != nil
+	case "cosmos.tx.v1beta1.StoreDiff.store_name":
+		return x.StoreName != ""
+	case "cosmos.tx.v1beta1.StoreDiff.writes":
+		return len(x.Writes) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.StoreDiff"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.StoreDiff does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4006,17 +4404,17 @@ func (x *fastReflection_GetTxResponse) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_StoreDiff) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxResponse.tx":
-		x.Tx = nil
-	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
-		x.TxResponse = nil
+	case "cosmos.tx.v1beta1.StoreDiff.store_name":
+		x.StoreName = ""
+	case "cosmos.tx.v1beta1.StoreDiff.writes":
+		x.Writes = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.StoreDiff"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.StoreDiff does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4026,19 +4424,22 @@ func (x *fastReflection_GetTxResponse) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GetTxResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StoreDiff) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.GetTxResponse.tx":
-		value := x.Tx
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
-		value := x.TxResponse
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.tx.v1beta1.StoreDiff.store_name":
+		value := x.StoreName
+		return protoreflect.ValueOfString(value)
+	case "cosmos.tx.v1beta1.StoreDiff.writes":
+		if len(x.Writes) == 0 {
+			return protoreflect.ValueOfList(&_StoreDiff_2_list{})
+		}
+		listValue := &_StoreDiff_2_list{list: &x.Writes}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.StoreDiff"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.StoreDiff does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4052,17 +4453,19 @@ func (x *fastReflection_GetTxResponse) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_StoreDiff) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxResponse.tx":
-		x.Tx = value.Message().Interface().(*Tx)
-	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
-		x.TxResponse = value.Message().Interface().(*v1beta11.TxResponse)
+	case "cosmos.tx.v1beta1.StoreDiff.store_name":
+		x.StoreName = value.Interface().(string)
+	case "cosmos.tx.v1beta1.StoreDiff.writes":
+		lv := value.List()
+		clv := lv.(*_StoreDiff_2_list)
+		x.Writes = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.StoreDiff"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.StoreDiff does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4076,52 +4479,49 @@ func (x *fastReflection_GetTxResponse) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StoreDiff) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxResponse.tx":
-		if x.Tx == nil {
-			x.Tx = new(Tx)
-		}
-		return protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
-		if x.TxResponse == nil {
-			x.TxResponse = new(v1beta11.TxResponse)
+	case "cosmos.tx.v1beta1.StoreDiff.writes":
+		if x.Writes == nil {
+			x.Writes = []*KVWrite{}
 		}
-		return protoreflect.ValueOfMessage(x.TxResponse.ProtoReflect())
+		value := &_StoreDiff_2_list{list: &x.Writes}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.tx.v1beta1.StoreDiff.store_name":
+		panic(fmt.Errorf("field store_name of message cosmos.tx.v1beta1.StoreDiff is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.StoreDiff"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.StoreDiff does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GetTxResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StoreDiff) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetTxResponse.tx":
-		m := new(Tx)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
-		m := new(v1beta11.TxResponse)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.tx.v1beta1.StoreDiff.store_name":
+		return protoreflect.ValueOfString("")
+	case "cosmos.tx.v1beta1.StoreDiff.writes":
+		list := []*KVWrite{}
+		return protoreflect.ValueOfList(&_StoreDiff_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.StoreDiff"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.StoreDiff does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GetTxResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_StoreDiff) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.GetTxResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.StoreDiff", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4129,7 +4529,7 @@ func (x *fastReflection_GetTxResponse) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GetTxResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_StoreDiff) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4140,7 +4540,7 @@ func (x *fastReflection_GetTxResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetTxResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_StoreDiff) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4152,7 +4552,7 @@ func (x *fastReflection_GetTxResponse) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GetTxResponse) IsValid() bool {
+func (x *fastReflection_StoreDiff) IsValid() bool {
 	return x != nil
 }
 
@@ -4162,9 +4562,9 @@ func (x *fastReflection_GetTxResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GetTxResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_StoreDiff) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GetTxResponse)
+		x := input.Message.Interface().(*StoreDiff)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4176,13 +4576,15 @@ func (x *fastReflection_GetTxResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Tx != nil {
-			l = options.Size(x.Tx)
+		l = len(x.StoreName)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.TxResponse != nil {
-			l = options.Size(x.TxResponse)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Writes) > 0 {
+			for _, e := range x.Writes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4194,7 +4596,7 @@ func (x *fastReflection_GetTxResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GetTxResponse)
+		x := input.Message.Interface().(*StoreDiff)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4213,33 +4615,28 @@ func (x *fastReflection_GetTxResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.TxResponse != nil {
-			encoded, err := options.Marshal(x.TxResponse)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Writes) > 0 {
+			for iNdEx := len(x.Writes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Writes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		}
+		if len(x.StoreName) > 0 {
+			i -= len(x.StoreName)
+			copy(dAtA[i:], x.StoreName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StoreName)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if x.Tx != nil {
-			encoded, err := options.Marshal(x.Tx)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4252,7 +4649,7 @@ func (x *fastReflection_GetTxResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GetTxResponse)
+		x := input.Message.Interface().(*StoreDiff)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4284,17 +4681,17 @@ func (x *fastReflection_GetTxResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreDiff: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StoreDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StoreName", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4304,31 +4701,27 @@ func (x *fastReflection_GetTxResponse) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Tx == nil {
-					x.Tx = &Tx{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tx); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.StoreName = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxResponse", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Writes", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4355,10 +4748,8 @@ func (x *fastReflection_GetTxResponse) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.TxResponse == nil {
-					x.TxResponse = &v1beta11.TxResponse{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TxResponse); err != nil {
+				x.Writes = append(x.Writes, &KVWrite{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Writes[len(x.Writes)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4398,27 +4789,33 @@ func (x *fastReflection_GetTxResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_GetBlockWithTxsRequest            protoreflect.MessageDescriptor
-	fd_GetBlockWithTxsRequest_height     protoreflect.FieldDescriptor
-	fd_GetBlockWithTxsRequest_pagination protoreflect.FieldDescriptor
+	md_KVWrite               protoreflect.MessageDescriptor
+	fd_KVWrite_key           protoreflect.FieldDescriptor
+	fd_KVWrite_value         protoreflect.FieldDescriptor
+	fd_KVWrite_delete        protoreflect.FieldDescriptor
+	fd_KVWrite_collection    protoreflect.FieldDescriptor
+	fd_KVWrite_decoded_value protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_GetBlockWithTxsRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("GetBlockWithTxsRequest")
-	fd_GetBlockWithTxsRequest_height = md_GetBlockWithTxsRequest.Fields().ByName("height")
-	fd_GetBlockWithTxsRequest_pagination = md_GetBlockWithTxsRequest.Fields().ByName("pagination")
+	md_KVWrite = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("KVWrite")
+	fd_KVWrite_key = md_KVWrite.Fields().ByName("key")
+	fd_KVWrite_value = md_KVWrite.Fields().ByName("value")
+	fd_KVWrite_delete = md_KVWrite.Fields().ByName("delete")
+	fd_KVWrite_collection = md_KVWrite.Fields().ByName("collection")
+	fd_KVWrite_decoded_value = md_KVWrite.Fields().ByName("decoded_value")
 }
 
-var _ protoreflect.Message = (*fastReflection_GetBlockWithTxsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_KVWrite)(nil)
 
-type fastReflection_GetBlockWithTxsRequest GetBlockWithTxsRequest
+type fastReflection_KVWrite KVWrite
 
-func (x *GetBlockWithTxsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GetBlockWithTxsRequest)(x)
+func (x *KVWrite) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_KVWrite)(x)
 }
 
-func (x *GetBlockWithTxsRequest) slowProtoReflect() protoreflect.Message {
+func (x *KVWrite) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4430,43 +4827,43 @@ func (x *GetBlockWithTxsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GetBlockWithTxsRequest_messageType fastReflection_GetBlockWithTxsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_GetBlockWithTxsRequest_messageType{}
+var _fastReflection_KVWrite_messageType fastReflection_KVWrite_messageType
+var _ protoreflect.MessageType = fastReflection_KVWrite_messageType{}
 
-type fastReflection_GetBlockWithTxsRequest_messageType struct{}
+type fastReflection_KVWrite_messageType struct{}
 
-func (x fastReflection_GetBlockWithTxsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GetBlockWithTxsRequest)(nil)
+func (x fastReflection_KVWrite_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_KVWrite)(nil)
 }
-func (x fastReflection_GetBlockWithTxsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_GetBlockWithTxsRequest)
+func (x fastReflection_KVWrite_messageType) New() protoreflect.Message {
+	return new(fastReflection_KVWrite)
 }
-func (x fastReflection_GetBlockWithTxsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetBlockWithTxsRequest
+func (x fastReflection_KVWrite_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_KVWrite
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GetBlockWithTxsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetBlockWithTxsRequest
+func (x *fastReflection_KVWrite) Descriptor() protoreflect.MessageDescriptor {
+	return md_KVWrite
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GetBlockWithTxsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_GetBlockWithTxsRequest_messageType
+func (x *fastReflection_KVWrite) Type() protoreflect.MessageType {
+	return _fastReflection_KVWrite_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GetBlockWithTxsRequest) New() protoreflect.Message {
-	return new(fastReflection_GetBlockWithTxsRequest)
+func (x *fastReflection_KVWrite) New() protoreflect.Message {
+	return new(fastReflection_KVWrite)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GetBlockWithTxsRequest) Interface() protoreflect.ProtoMessage {
-	return (*GetBlockWithTxsRequest)(x)
+func (x *fastReflection_KVWrite) Interface() protoreflect.ProtoMessage {
+	return (*KVWrite)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4474,16 +4871,34 @@ func (x *fastReflection_GetBlockWithTxsRequest) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GetBlockWithTxsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Height != int64(0) {
-		value := protoreflect.ValueOfInt64(x.Height)
-		if !f(fd_GetBlockWithTxsRequest_height, value) {
+func (x *fastReflection_KVWrite) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Key) != 0 {
+		value := protoreflect.ValueOfBytes(x.Key)
+		if !f(fd_KVWrite_key, value) {
 			return
 		}
 	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_GetBlockWithTxsRequest_pagination, value) {
+	if len(x.Value) != 0 {
+		value := protoreflect.ValueOfBytes(x.Value)
+		if !f(fd_KVWrite_value, value) {
+			return
+		}
+	}
+	if x.Delete != false {
+		value := protoreflect.ValueOfBool(x.Delete)
+		if !f(fd_KVWrite_delete, value) {
+			return
+		}
+	}
+	if x.Collection != "" {
+		value := protoreflect.ValueOfString(x.Collection)
+		if !f(fd_KVWrite_collection, value) {
+			return
+		}
+	}
+	if x.DecodedValue != "" {
+		value := protoreflect.ValueOfString(x.DecodedValue)
+		if !f(fd_KVWrite_decoded_value, value) {
 			return
 		}
 	}
@@ -4500,17 +4915,23 @@ func (x *fastReflection_GetBlockWithTxsRequest) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GetBlockWithTxsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_KVWrite) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
-		return x.Height != int64(0)
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
-		return x.Pagination != nil
+	case "cosmos.tx.v1beta1.KVWrite.key":
+		return len(x.Key) != 0
+	case "cosmos.tx.v1beta1.KVWrite.value":
+		return len(x.Value) != 0
+	case "cosmos.tx.v1beta1.KVWrite.delete":
+		return x.Delete != false
+	case "cosmos.tx.v1beta1.KVWrite.collection":
+		return x.Collection != ""
+	case "cosmos.tx.v1beta1.KVWrite.decoded_value":
+		return x.DecodedValue != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.KVWrite"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.KVWrite does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4520,17 +4941,23 @@ func (x *fastReflection_GetBlockWithTxsRequest) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetBlockWithTxsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_KVWrite) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
-		x.Height = int64(0)
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
-		x.Pagination = nil
+	case "cosmos.tx.v1beta1.KVWrite.key":
+		x.Key = nil
+	case "cosmos.tx.v1beta1.KVWrite.value":
+		x.Value = nil
+	case "cosmos.tx.v1beta1.KVWrite.delete":
+		x.Delete = false
+	case "cosmos.tx.v1beta1.KVWrite.collection":
+		x.Collection = ""
+	case "cosmos.tx.v1beta1.KVWrite.decoded_value":
+		x.DecodedValue = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.KVWrite"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.KVWrite does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4540,19 +4967,28 @@ func (x *fastReflection_GetBlockWithTxsRequest) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GetBlockWithTxsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_KVWrite) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
-		value := x.Height
-		return protoreflect.ValueOfInt64(value)
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.tx.v1beta1.KVWrite.key":
+		value := x.Key
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.tx.v1beta1.KVWrite.value":
+		value := x.Value
+		return protoreflect.ValueOfBytes(value)
+	case "cosmos.tx.v1beta1.KVWrite.delete":
+		value := x.Delete
+		return protoreflect.ValueOfBool(value)
+	case "cosmos.tx.v1beta1.KVWrite.collection":
+		value := x.Collection
+		return protoreflect.ValueOfString(value)
+	case "cosmos.tx.v1beta1.KVWrite.decoded_value":
+		value := x.DecodedValue
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.KVWrite"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.KVWrite does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4566,17 +5002,23 @@ func (x *fastReflection_GetBlockWithTxsRequest) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetBlockWithTxsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_KVWrite) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
-		x.Height = value.Int()
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "cosmos.tx.v1beta1.KVWrite.key":
+		x.Key = value.Bytes()
+	case "cosmos.tx.v1beta1.KVWrite.value":
+		x.Value = value.Bytes()
+	case "cosmos.tx.v1beta1.KVWrite.delete":
+		x.Delete = value.Bool()
+	case "cosmos.tx.v1beta1.KVWrite.collection":
+		x.Collection = value.Interface().(string)
+	case "cosmos.tx.v1beta1.KVWrite.decoded_value":
+		x.DecodedValue = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.KVWrite"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.KVWrite does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4590,48 +5032,56 @@ func (x *fastReflection_GetBlockWithTxsRequest) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetBlockWithTxsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_KVWrite) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
-		panic(fmt.Errorf("field height of message cosmos.tx.v1beta1.GetBlockWithTxsRequest is not mutable"))
+	case "cosmos.tx.v1beta1.KVWrite.key":
+		panic(fmt.Errorf("field key of message cosmos.tx.v1beta1.KVWrite is not mutable"))
+	case "cosmos.tx.v1beta1.KVWrite.value":
+		panic(fmt.Errorf("field value of message cosmos.tx.v1beta1.KVWrite is not mutable"))
+	case "cosmos.tx.v1beta1.KVWrite.delete":
+		panic(fmt.Errorf("field delete of message cosmos.tx.v1beta1.KVWrite is not mutable"))
+	case "cosmos.tx.v1beta1.KVWrite.collection":
+		panic(fmt.Errorf("field collection of message cosmos.tx.v1beta1.KVWrite is not mutable"))
+	case "cosmos.tx.v1beta1.KVWrite.decoded_value":
+		panic(fmt.Errorf("field decoded_value of message cosmos.tx.v1beta1.KVWrite is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.KVWrite"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.KVWrite does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GetBlockWithTxsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_KVWrite) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.tx.v1beta1.KVWrite.key":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.tx.v1beta1.KVWrite.value":
+		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.tx.v1beta1.KVWrite.delete":
+		return protoreflect.ValueOfBool(false)
+	case "cosmos.tx.v1beta1.KVWrite.collection":
+		return protoreflect.ValueOfString("")
+	case "cosmos.tx.v1beta1.KVWrite.decoded_value":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.KVWrite"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.KVWrite does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GetBlockWithTxsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_KVWrite) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.GetBlockWithTxsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.KVWrite", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4639,7 +5089,7 @@ func (x *fastReflection_GetBlockWithTxsRequest) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GetBlockWithTxsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_KVWrite) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4650,7 +5100,7 @@ func (x *fastReflection_GetBlockWithTxsRequest) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetBlockWithTxsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_KVWrite) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4662,7 +5112,7 @@ func (x *fastReflection_GetBlockWithTxsRequest) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GetBlockWithTxsRequest) IsValid() bool {
+func (x *fastReflection_KVWrite) IsValid() bool {
 	return x != nil
 }
 
@@ -4672,9 +5122,9 @@ func (x *fastReflection_GetBlockWithTxsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GetBlockWithTxsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_KVWrite) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GetBlockWithTxsRequest)
+		x := input.Message.Interface().(*KVWrite)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4686,11 +5136,23 @@ func (x *fastReflection_GetBlockWithTxsRequest) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		if x.Height != 0 {
-			n += 1 + runtime.Sov(uint64(x.Height))
+		l = len(x.Key)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		l = len(x.Value)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Delete {
+			n += 2
+		}
+		l = len(x.Collection)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DecodedValue)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4703,7 +5165,7 @@ func (x *fastReflection_GetBlockWithTxsRequest) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GetBlockWithTxsRequest)
+		x := input.Message.Interface().(*KVWrite)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4722,24 +5184,43 @@ func (x *fastReflection_GetBlockWithTxsRequest) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.DecodedValue) > 0 {
+			i -= len(x.DecodedValue)
+			copy(dAtA[i:], x.DecodedValue)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DecodedValue)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Collection) > 0 {
+			i -= len(x.Collection)
+			copy(dAtA[i:], x.Collection)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Collection)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Delete {
+			i--
+			if x.Delete {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Value) > 0 {
+			i -= len(x.Value)
+			copy(dAtA[i:], x.Value)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if x.Height != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+		if len(x.Key) > 0 {
+			i -= len(x.Key)
+			copy(dAtA[i:], x.Key)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Key)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4752,7 +5233,7 @@ func (x *fastReflection_GetBlockWithTxsRequest) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GetBlockWithTxsRequest)
+		x := input.Message.Interface().(*KVWrite)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4784,17 +5265,17 @@ func (x *fastReflection_GetBlockWithTxsRequest) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetBlockWithTxsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KVWrite: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetBlockWithTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KVWrite: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 				}
-				x.Height = 0
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4804,16 +5285,31 @@ func (x *fastReflection_GetBlockWithTxsRequest) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Height |= int64(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Key = append(x.Key[:0], dAtA[iNdEx:postIndex]...)
+				if x.Key == nil {
+					x.Key = []byte{}
+				}
+				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4823,45 +5319,127 @@ func (x *fastReflection_GetBlockWithTxsRequest) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Value = append(x.Value[:0], dAtA[iNdEx:postIndex]...)
+				if x.Value == nil {
+					x.Value = []byte{}
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.Delete = bool(v != 0)
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx += skippy
-			}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Collection = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecodedValue", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DecodedValue = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
 		}
 
 		if iNdEx > l {
@@ -4880,83 +5458,132 @@ func (x *fastReflection_GetBlockWithTxsRequest) ProtoMethods() *protoiface.Metho
 	}
 }
 
-var _ protoreflect.List = (*_GetBlockWithTxsResponse_1_list)(nil)
+var _ protoreflect.List = (*_BalanceDelta_2_list)(nil)
 
-type _GetBlockWithTxsResponse_1_list struct {
-	list *[]*Tx
+type _BalanceDelta_2_list struct {
+	list *[]*v1beta12.Coin
 }
 
-func (x *_GetBlockWithTxsResponse_1_list) Len() int {
+func (x *_BalanceDelta_2_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_GetBlockWithTxsResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_BalanceDelta_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_GetBlockWithTxsResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_BalanceDelta_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Tx)
+	concreteValue := valueUnwrapped.Interface().(*v1beta12.Coin)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_GetBlockWithTxsResponse_1_list) Append(value protoreflect.Value) {
+func (x *_BalanceDelta_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Tx)
+	concreteValue := valueUnwrapped.Interface().(*v1beta12.Coin)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_GetBlockWithTxsResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Tx)
+func (x *_BalanceDelta_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta12.Coin)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_GetBlockWithTxsResponse_1_list) Truncate(n int) {
+func (x *_BalanceDelta_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_GetBlockWithTxsResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Tx)
+func (x *_BalanceDelta_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta12.Coin)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_GetBlockWithTxsResponse_1_list) IsValid() bool {
+func (x *_BalanceDelta_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_BalanceDelta_3_list)(nil)
+
+type _BalanceDelta_3_list struct {
+	list *[]*v1beta12.Coin
+}
+
+func (x *_BalanceDelta_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BalanceDelta_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_BalanceDelta_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta12.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BalanceDelta_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta12.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BalanceDelta_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta12.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BalanceDelta_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BalanceDelta_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta12.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_BalanceDelta_3_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_GetBlockWithTxsResponse            protoreflect.MessageDescriptor
-	fd_GetBlockWithTxsResponse_txs        protoreflect.FieldDescriptor
-	fd_GetBlockWithTxsResponse_block_id   protoreflect.FieldDescriptor
-	fd_GetBlockWithTxsResponse_block      protoreflect.FieldDescriptor
-	fd_GetBlockWithTxsResponse_pagination protoreflect.FieldDescriptor
+	md_BalanceDelta          protoreflect.MessageDescriptor
+	fd_BalanceDelta_address  protoreflect.FieldDescriptor
+	fd_BalanceDelta_sent     protoreflect.FieldDescriptor
+	fd_BalanceDelta_received protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_GetBlockWithTxsResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("GetBlockWithTxsResponse")
-	fd_GetBlockWithTxsResponse_txs = md_GetBlockWithTxsResponse.Fields().ByName("txs")
-	fd_GetBlockWithTxsResponse_block_id = md_GetBlockWithTxsResponse.Fields().ByName("block_id")
-	fd_GetBlockWithTxsResponse_block = md_GetBlockWithTxsResponse.Fields().ByName("block")
-	fd_GetBlockWithTxsResponse_pagination = md_GetBlockWithTxsResponse.Fields().ByName("pagination")
+	md_BalanceDelta = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("BalanceDelta")
+	fd_BalanceDelta_address = md_BalanceDelta.Fields().ByName("address")
+	fd_BalanceDelta_sent = md_BalanceDelta.Fields().ByName("sent")
+	fd_BalanceDelta_received = md_BalanceDelta.Fields().ByName("received")
 }
 
-var _ protoreflect.Message = (*fastReflection_GetBlockWithTxsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_BalanceDelta)(nil)
 
-type fastReflection_GetBlockWithTxsResponse GetBlockWithTxsResponse
+type fastReflection_BalanceDelta BalanceDelta
 
-func (x *GetBlockWithTxsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GetBlockWithTxsResponse)(x)
+func (x *BalanceDelta) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BalanceDelta)(x)
 }
 
-func (x *GetBlockWithTxsResponse) slowProtoReflect() protoreflect.Message {
+func (x *BalanceDelta) slowProtoReflect() protoreflect.Message {
 	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4968,43 +5595,43 @@ func (x *GetBlockWithTxsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_GetBlockWithTxsResponse_messageType fastReflection_GetBlockWithTxsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_GetBlockWithTxsResponse_messageType{}
+var _fastReflection_BalanceDelta_messageType fastReflection_BalanceDelta_messageType
+var _ protoreflect.MessageType = fastReflection_BalanceDelta_messageType{}
 
-type fastReflection_GetBlockWithTxsResponse_messageType struct{}
+type fastReflection_BalanceDelta_messageType struct{}
 
-func (x fastReflection_GetBlockWithTxsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GetBlockWithTxsResponse)(nil)
+func (x fastReflection_BalanceDelta_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BalanceDelta)(nil)
 }
-func (x fastReflection_GetBlockWithTxsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_GetBlockWithTxsResponse)
+func (x fastReflection_BalanceDelta_messageType) New() protoreflect.Message {
+	return new(fastReflection_BalanceDelta)
 }
-func (x fastReflection_GetBlockWithTxsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetBlockWithTxsResponse
+func (x fastReflection_BalanceDelta_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BalanceDelta
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_GetBlockWithTxsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_GetBlockWithTxsResponse
+func (x *fastReflection_BalanceDelta) Descriptor() protoreflect.MessageDescriptor {
+	return md_BalanceDelta
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GetBlockWithTxsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_GetBlockWithTxsResponse_messageType
+func (x *fastReflection_BalanceDelta) Type() protoreflect.MessageType {
+	return _fastReflection_BalanceDelta_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GetBlockWithTxsResponse) New() protoreflect.Message {
-	return new(fastReflection_GetBlockWithTxsResponse)
+func (x *fastReflection_BalanceDelta) New() protoreflect.Message {
+	return new(fastReflection_BalanceDelta)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_GetBlockWithTxsResponse) Interface() protoreflect.ProtoMessage {
-	return (*GetBlockWithTxsResponse)(x)
+func (x *fastReflection_BalanceDelta) Interface() protoreflect.ProtoMessage {
+	return (*BalanceDelta)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5012,28 +5639,22 @@ func (x *fastReflection_GetBlockWithTxsResponse) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_GetBlockWithTxsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Txs) != 0 {
-		value := protoreflect.ValueOfList(&_GetBlockWithTxsResponse_1_list{list: &x.Txs})
-		if !f(fd_GetBlockWithTxsResponse_txs, value) {
-			return
-		}
-	}
-	if x.BlockId != nil {
-		value := protoreflect.ValueOfMessage(x.BlockId.ProtoReflect())
-		if !f(fd_GetBlockWithTxsResponse_block_id, value) {
+func (x *fastReflection_BalanceDelta) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_BalanceDelta_address, value) {
 			return
 		}
 	}
-	if x.Block != nil {
-		value := protoreflect.ValueOfMessage(x.Block.ProtoReflect())
-		if !f(fd_GetBlockWithTxsResponse_block, value) {
+	if len(x.Sent) != 0 {
+		value := protoreflect.ValueOfList(&_BalanceDelta_2_list{list: &x.Sent})
+		if !f(fd_BalanceDelta_sent, value) {
 			return
 		}
 	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_GetBlockWithTxsResponse_pagination, value) {
+	if len(x.Received) != 0 {
+		value := protoreflect.ValueOfList(&_BalanceDelta_3_list{list: &x.Received})
+		if !f(fd_BalanceDelta_received, value) {
 			return
 		}
 	}
@@ -5050,21 +5671,19 @@ func (x *fastReflection_GetBlockWithTxsResponse) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_GetBlockWithTxsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_BalanceDelta) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
-		return len(x.Txs) != 0
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
-		return x.BlockId != nil
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
-		return x.Block != nil
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
-		return x.Pagination != nil
+	case "cosmos.tx.v1beta1.BalanceDelta.address":
+		return x.Address != ""
+	case "cosmos.tx.v1beta1.BalanceDelta.sent":
+		return len(x.Sent) != 0
+	case "cosmos.tx.v1beta1.BalanceDelta.received":
+		return len(x.Received) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BalanceDelta"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.BalanceDelta does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5074,21 +5693,19 @@ func (x *fastReflection_GetBlockWithTxsResponse) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetBlockWithTxsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_BalanceDelta) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
-		x.Txs = nil
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
-		x.BlockId = nil
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
-		x.Block = nil
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
-		x.Pagination = nil
+	case "cosmos.tx.v1beta1.BalanceDelta.address":
+		x.Address = ""
+	case "cosmos.tx.v1beta1.BalanceDelta.sent":
+		x.Sent = nil
+	case "cosmos.tx.v1beta1.BalanceDelta.received":
+		x.Received = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BalanceDelta"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.BalanceDelta does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5098,28 +5715,28 @@ func (x *fastReflection_GetBlockWithTxsResponse) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GetBlockWithTxsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BalanceDelta) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
-		if len(x.Txs) == 0 {
-			return protoreflect.ValueOfList(&_GetBlockWithTxsResponse_1_list{})
+	case "cosmos.tx.v1beta1.BalanceDelta.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "cosmos.tx.v1beta1.BalanceDelta.sent":
+		if len(x.Sent) == 0 {
+			return protoreflect.ValueOfList(&_BalanceDelta_2_list{})
 		}
-		listValue := &_GetBlockWithTxsResponse_1_list{list: &x.Txs}
+		listValue := &_BalanceDelta_2_list{list: &x.Sent}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.tx.v1beta1.BalanceDelta.received":
+		if len(x.Received) == 0 {
+			return protoreflect.ValueOfList(&_BalanceDelta_3_list{})
+		}
+		listValue := &_BalanceDelta_3_list{list: &x.Received}
 		return protoreflect.ValueOfList(listValue)
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
-		value := x.BlockId
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
-		value := x.Block
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BalanceDelta"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.BalanceDelta does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5133,23 +5750,23 @@ func (x *fastReflection_GetBlockWithTxsResponse) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetBlockWithTxsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_BalanceDelta) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
+	case "cosmos.tx.v1beta1.BalanceDelta.address":
+		x.Address = value.Interface().(string)
+	case "cosmos.tx.v1beta1.BalanceDelta.sent":
 		lv := value.List()
-		clv := lv.(*_GetBlockWithTxsResponse_1_list)
-		x.Txs = *clv.list
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
-		x.BlockId = value.Message().Interface().(*v2.BlockID)
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
-		x.Block = value.Message().Interface().(*v2.Block)
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+		clv := lv.(*_BalanceDelta_2_list)
+		x.Sent = *clv.list
+	case "cosmos.tx.v1beta1.BalanceDelta.received":
+		lv := value.List()
+		clv := lv.(*_BalanceDelta_3_list)
+		x.Received = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BalanceDelta"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.BalanceDelta does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5163,69 +5780,58 @@ func (x *fastReflection_GetBlockWithTxsResponse) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetBlockWithTxsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BalanceDelta) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
-		if x.Txs == nil {
-			x.Txs = []*Tx{}
+	case "cosmos.tx.v1beta1.BalanceDelta.sent":
+		if x.Sent == nil {
+			x.Sent = []*v1beta12.Coin{}
 		}
-		value := &_GetBlockWithTxsResponse_1_list{list: &x.Txs}
+		value := &_BalanceDelta_2_list{list: &x.Sent}
 		return protoreflect.ValueOfList(value)
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
-		if x.BlockId == nil {
-			x.BlockId = new(v2.BlockID)
-		}
-		return protoreflect.ValueOfMessage(x.BlockId.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
-		if x.Block == nil {
-			x.Block = new(v2.Block)
-		}
-		return protoreflect.ValueOfMessage(x.Block.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+	case "cosmos.tx.v1beta1.BalanceDelta.received":
+		if x.Received == nil {
+			x.Received = []*v1beta12.Coin{}
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		value := &_BalanceDelta_3_list{list: &x.Received}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.tx.v1beta1.BalanceDelta.address":
+		panic(fmt.Errorf("field address of message cosmos.tx.v1beta1.BalanceDelta is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BalanceDelta"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.BalanceDelta does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GetBlockWithTxsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BalanceDelta) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
-		list := []*Tx{}
-		return protoreflect.ValueOfList(&_GetBlockWithTxsResponse_1_list{list: &list})
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
-		m := new(v2.BlockID)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
-		m := new(v2.Block)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
-		m := new(v1beta1.PageResponse)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.tx.v1beta1.BalanceDelta.address":
+		return protoreflect.ValueOfString("")
+	case "cosmos.tx.v1beta1.BalanceDelta.sent":
+		list := []*v1beta12.Coin{}
+		return protoreflect.ValueOfList(&_BalanceDelta_2_list{list: &list})
+	case "cosmos.tx.v1beta1.BalanceDelta.received":
+		list := []*v1beta12.Coin{}
+		return protoreflect.ValueOfList(&_BalanceDelta_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.BalanceDelta"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.BalanceDelta does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GetBlockWithTxsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_BalanceDelta) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.GetBlockWithTxsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.BalanceDelta", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5233,7 +5839,7 @@ func (x *fastReflection_GetBlockWithTxsResponse) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GetBlockWithTxsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_BalanceDelta) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5244,7 +5850,7 @@ func (x *fastReflection_GetBlockWithTxsResponse) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GetBlockWithTxsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_BalanceDelta) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5256,7 +5862,7 @@ func (x *fastReflection_GetBlockWithTxsResponse) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_GetBlockWithTxsResponse) IsValid() bool {
+func (x *fastReflection_BalanceDelta) IsValid() bool {
 	return x != nil
 }
 
@@ -5266,9 +5872,9 @@ func (x *fastReflection_GetBlockWithTxsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_BalanceDelta) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GetBlockWithTxsResponse)
+		x := input.Message.Interface().(*BalanceDelta)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5280,23 +5886,21 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if len(x.Txs) > 0 {
-			for _, e := range x.Txs {
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Sent) > 0 {
+			for _, e := range x.Sent {
 				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.BlockId != nil {
-			l = options.Size(x.BlockId)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Block != nil {
-			l = options.Size(x.Block)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Received) > 0 {
+			for _, e := range x.Received {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -5308,7 +5912,7 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GetBlockWithTxsResponse)
+		x := input.Message.Interface().(*BalanceDelta)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5327,51 +5931,25 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.Block != nil {
-			encoded, err := options.Marshal(x.Block)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.BlockId != nil {
-			encoded, err := options.Marshal(x.BlockId)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Received) > 0 {
+			for iNdEx := len(x.Received) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Received[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
 		}
-		if len(x.Txs) > 0 {
-			for iNdEx := len(x.Txs) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Txs[iNdEx])
+		if len(x.Sent) > 0 {
+			for iNdEx := len(x.Sent) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Sent[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5382,9 +5960,16 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
-				dAtA[i] = 0xa
+				dAtA[i] = 0x12
 			}
 		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -5396,7 +5981,7 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GetBlockWithTxsResponse)
+		x := input.Message.Interface().(*BalanceDelta)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5428,17 +6013,17 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetBlockWithTxsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BalanceDelta: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetBlockWithTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BalanceDelta: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -5448,29 +6033,27 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Txs = append(x.Txs, &Tx{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Txs[len(x.Txs)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sent", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5497,16 +6080,14 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.BlockId == nil {
-					x.BlockId = &v2.BlockID{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockId); err != nil {
+				x.Sent = append(x.Sent, &v1beta12.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Sent[len(x.Sent)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5530,19 +6111,3421 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Received = append(x.Received, &v1beta12.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Received[len(x.Received)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GetTxRequest      protoreflect.MessageDescriptor
+	fd_GetTxRequest_hash protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_tx_v1beta1_service_proto_init()
+	md_GetTxRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("GetTxRequest")
+	fd_GetTxRequest_hash = md_GetTxRequest.Fields().ByName("hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_GetTxRequest)(nil)
+
+type fastReflection_GetTxRequest GetTxRequest
+
+func (x *GetTxRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetTxRequest)(x)
+}
+
+func (x *GetTxRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GetTxRequest_messageType fastReflection_GetTxRequest_messageType
+var _ protoreflect.MessageType = fastReflection_GetTxRequest_messageType{}
+
+type fastReflection_GetTxRequest_messageType struct{}
+
+func (x fastReflection_GetTxRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetTxRequest)(nil)
+}
+func (x fastReflection_GetTxRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetTxRequest)
+}
+func (x fastReflection_GetTxRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetTxRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GetTxRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetTxRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GetTxRequest) Type() protoreflect.MessageType {
+	return _fastReflection_GetTxRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GetTxRequest) New() protoreflect.Message {
+	return new(fastReflection_GetTxRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GetTxRequest) Interface() protoreflect.ProtoMessage {
+	return (*GetTxRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GetTxRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Hash != "" {
+		value := protoreflect.ValueOfString(x.Hash)
+		if !f(fd_GetTxRequest_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GetTxRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetTxRequest.hash":
+		return x.Hash != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetTxRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetTxRequest.hash":
+		x.Hash = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GetTxRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.tx.v1beta1.GetTxRequest.hash":
+		value := x.Hash
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetTxRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetTxRequest.hash":
+		x.Hash = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetTxRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetTxRequest.hash":
+		panic(fmt.Errorf("field hash of message cosmos.tx.v1beta1.GetTxRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GetTxRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetTxRequest.hash":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GetTxRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.GetTxRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GetTxRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetTxRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GetTxRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GetTxRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GetTxRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GetTxRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GetTxRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GetTxResponse             protoreflect.MessageDescriptor
+	fd_GetTxResponse_tx          protoreflect.FieldDescriptor
+	fd_GetTxResponse_tx_response protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_tx_v1beta1_service_proto_init()
+	md_GetTxResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("GetTxResponse")
+	fd_GetTxResponse_tx = md_GetTxResponse.Fields().ByName("tx")
+	fd_GetTxResponse_tx_response = md_GetTxResponse.Fields().ByName("tx_response")
+}
+
+var _ protoreflect.Message = (*fastReflection_GetTxResponse)(nil)
+
+type fastReflection_GetTxResponse GetTxResponse
+
+func (x *GetTxResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetTxResponse)(x)
+}
+
+func (x *GetTxResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GetTxResponse_messageType fastReflection_GetTxResponse_messageType
+var _ protoreflect.MessageType = fastReflection_GetTxResponse_messageType{}
+
+type fastReflection_GetTxResponse_messageType struct{}
+
+func (x fastReflection_GetTxResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetTxResponse)(nil)
+}
+func (x fastReflection_GetTxResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetTxResponse)
+}
+func (x fastReflection_GetTxResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetTxResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GetTxResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetTxResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GetTxResponse) Type() protoreflect.MessageType {
+	return _fastReflection_GetTxResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GetTxResponse) New() protoreflect.Message {
+	return new(fastReflection_GetTxResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GetTxResponse) Interface() protoreflect.ProtoMessage {
+	return (*GetTxResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GetTxResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Tx != nil {
+		value := protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
+		if !f(fd_GetTxResponse_tx, value) {
+			return
+		}
+	}
+	if x.TxResponse != nil {
+		value := protoreflect.ValueOfMessage(x.TxResponse.ProtoReflect())
+		if !f(fd_GetTxResponse_tx_response, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GetTxResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetTxResponse.tx":
+		return x.Tx != nil
+	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
+		return x.TxResponse != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetTxResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetTxResponse.tx":
+		x.Tx = nil
+	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
+		x.TxResponse = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GetTxResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.tx.v1beta1.GetTxResponse.tx":
+		value := x.Tx
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
+		value := x.TxResponse
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetTxResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetTxResponse.tx":
+		x.Tx = value.Message().Interface().(*Tx)
+	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
+		x.TxResponse = value.Message().Interface().(*v1beta11.TxResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetTxResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetTxResponse.tx":
+		if x.Tx == nil {
+			x.Tx = new(Tx)
+		}
+		return protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
+		if x.TxResponse == nil {
+			x.TxResponse = new(v1beta11.TxResponse)
+		}
+		return protoreflect.ValueOfMessage(x.TxResponse.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GetTxResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetTxResponse.tx":
+		m := new(Tx)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetTxResponse.tx_response":
+		m := new(v1beta11.TxResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetTxResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetTxResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GetTxResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.GetTxResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GetTxResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetTxResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GetTxResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GetTxResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GetTxResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Tx != nil {
+			l = options.Size(x.Tx)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TxResponse != nil {
+			l = options.Size(x.TxResponse)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GetTxResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.TxResponse != nil {
+			encoded, err := options.Marshal(x.TxResponse)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Tx != nil {
+			encoded, err := options.Marshal(x.Tx)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GetTxResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Tx == nil {
+					x.Tx = &Tx{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tx); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxResponse", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TxResponse == nil {
+					x.TxResponse = &v1beta11.TxResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TxResponse); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_GetBlockWithTxsRequest            protoreflect.MessageDescriptor
+	fd_GetBlockWithTxsRequest_height     protoreflect.FieldDescriptor
+	fd_GetBlockWithTxsRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_tx_v1beta1_service_proto_init()
+	md_GetBlockWithTxsRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("GetBlockWithTxsRequest")
+	fd_GetBlockWithTxsRequest_height = md_GetBlockWithTxsRequest.Fields().ByName("height")
+	fd_GetBlockWithTxsRequest_pagination = md_GetBlockWithTxsRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_GetBlockWithTxsRequest)(nil)
+
+type fastReflection_GetBlockWithTxsRequest GetBlockWithTxsRequest
+
+func (x *GetBlockWithTxsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetBlockWithTxsRequest)(x)
+}
+
+func (x *GetBlockWithTxsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GetBlockWithTxsRequest_messageType fastReflection_GetBlockWithTxsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_GetBlockWithTxsRequest_messageType{}
+
+type fastReflection_GetBlockWithTxsRequest_messageType struct{}
+
+func (x fastReflection_GetBlockWithTxsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetBlockWithTxsRequest)(nil)
+}
+func (x fastReflection_GetBlockWithTxsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetBlockWithTxsRequest)
+}
+func (x fastReflection_GetBlockWithTxsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetBlockWithTxsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GetBlockWithTxsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetBlockWithTxsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GetBlockWithTxsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_GetBlockWithTxsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GetBlockWithTxsRequest) New() protoreflect.Message {
+	return new(fastReflection_GetBlockWithTxsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GetBlockWithTxsRequest) Interface() protoreflect.ProtoMessage {
+	return (*GetBlockWithTxsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GetBlockWithTxsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_GetBlockWithTxsRequest_height, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_GetBlockWithTxsRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GetBlockWithTxsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
+		return x.Height != int64(0)
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetBlockWithTxsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
+		x.Height = int64(0)
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GetBlockWithTxsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetBlockWithTxsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
+		x.Height = value.Int()
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetBlockWithTxsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
+		panic(fmt.Errorf("field height of message cosmos.tx.v1beta1.GetBlockWithTxsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GetBlockWithTxsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GetBlockWithTxsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.GetBlockWithTxsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GetBlockWithTxsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetBlockWithTxsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GetBlockWithTxsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GetBlockWithTxsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GetBlockWithTxsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GetBlockWithTxsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GetBlockWithTxsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetBlockWithTxsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetBlockWithTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_GetBlockWithTxsResponse_1_list)(nil)
+
+type _GetBlockWithTxsResponse_1_list struct {
+	list *[]*Tx
+}
+
+func (x *_GetBlockWithTxsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GetBlockWithTxsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GetBlockWithTxsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Tx)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GetBlockWithTxsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Tx)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GetBlockWithTxsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Tx)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GetBlockWithTxsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GetBlockWithTxsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Tx)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GetBlockWithTxsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GetBlockWithTxsResponse            protoreflect.MessageDescriptor
+	fd_GetBlockWithTxsResponse_txs        protoreflect.FieldDescriptor
+	fd_GetBlockWithTxsResponse_block_id   protoreflect.FieldDescriptor
+	fd_GetBlockWithTxsResponse_block      protoreflect.FieldDescriptor
+	fd_GetBlockWithTxsResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_tx_v1beta1_service_proto_init()
+	md_GetBlockWithTxsResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("GetBlockWithTxsResponse")
+	fd_GetBlockWithTxsResponse_txs = md_GetBlockWithTxsResponse.Fields().ByName("txs")
+	fd_GetBlockWithTxsResponse_block_id = md_GetBlockWithTxsResponse.Fields().ByName("block_id")
+	fd_GetBlockWithTxsResponse_block = md_GetBlockWithTxsResponse.Fields().ByName("block")
+	fd_GetBlockWithTxsResponse_pagination = md_GetBlockWithTxsResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_GetBlockWithTxsResponse)(nil)
+
+type fastReflection_GetBlockWithTxsResponse GetBlockWithTxsResponse
+
+func (x *GetBlockWithTxsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GetBlockWithTxsResponse)(x)
+}
+
+func (x *GetBlockWithTxsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GetBlockWithTxsResponse_messageType fastReflection_GetBlockWithTxsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_GetBlockWithTxsResponse_messageType{}
+
+type fastReflection_GetBlockWithTxsResponse_messageType struct{}
+
+func (x fastReflection_GetBlockWithTxsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GetBlockWithTxsResponse)(nil)
+}
+func (x fastReflection_GetBlockWithTxsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_GetBlockWithTxsResponse)
+}
+func (x fastReflection_GetBlockWithTxsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetBlockWithTxsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GetBlockWithTxsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_GetBlockWithTxsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GetBlockWithTxsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_GetBlockWithTxsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GetBlockWithTxsResponse) New() protoreflect.Message {
+	return new(fastReflection_GetBlockWithTxsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GetBlockWithTxsResponse) Interface() protoreflect.ProtoMessage {
+	return (*GetBlockWithTxsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GetBlockWithTxsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Txs) != 0 {
+		value := protoreflect.ValueOfList(&_GetBlockWithTxsResponse_1_list{list: &x.Txs})
+		if !f(fd_GetBlockWithTxsResponse_txs, value) {
+			return
+		}
+	}
+	if x.BlockId != nil {
+		value := protoreflect.ValueOfMessage(x.BlockId.ProtoReflect())
+		if !f(fd_GetBlockWithTxsResponse_block_id, value) {
+			return
+		}
+	}
+	if x.Block != nil {
+		value := protoreflect.ValueOfMessage(x.Block.ProtoReflect())
+		if !f(fd_GetBlockWithTxsResponse_block, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_GetBlockWithTxsResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GetBlockWithTxsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
+		return len(x.Txs) != 0
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
+		return x.BlockId != nil
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
+		return x.Block != nil
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetBlockWithTxsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
+		x.Txs = nil
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
+		x.BlockId = nil
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
+		x.Block = nil
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GetBlockWithTxsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
+		if len(x.Txs) == 0 {
+			return protoreflect.ValueOfList(&_GetBlockWithTxsResponse_1_list{})
+		}
+		listValue := &_GetBlockWithTxsResponse_1_list{list: &x.Txs}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
+		value := x.BlockId
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
+		value := x.Block
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetBlockWithTxsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
+		lv := value.List()
+		clv := lv.(*_GetBlockWithTxsResponse_1_list)
+		x.Txs = *clv.list
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
+		x.BlockId = value.Message().Interface().(*v2.BlockID)
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
+		x.Block = value.Message().Interface().(*v2.Block)
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetBlockWithTxsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
+		if x.Txs == nil {
+			x.Txs = []*Tx{}
+		}
+		value := &_GetBlockWithTxsResponse_1_list{list: &x.Txs}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
+		if x.BlockId == nil {
+			x.BlockId = new(v2.BlockID)
+		}
+		return protoreflect.ValueOfMessage(x.BlockId.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
+		if x.Block == nil {
+			x.Block = new(v2.Block)
+		}
+		return protoreflect.ValueOfMessage(x.Block.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GetBlockWithTxsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs":
+		list := []*Tx{}
+		return protoreflect.ValueOfList(&_GetBlockWithTxsResponse_1_list{list: &list})
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id":
+		m := new(v2.BlockID)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.block":
+		m := new(v2.Block)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GetBlockWithTxsResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GetBlockWithTxsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GetBlockWithTxsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.GetBlockWithTxsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GetBlockWithTxsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GetBlockWithTxsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GetBlockWithTxsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GetBlockWithTxsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Txs) > 0 {
+			for _, e := range x.Txs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BlockId != nil {
+			l = options.Size(x.BlockId)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Block != nil {
+			l = options.Size(x.Block)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GetBlockWithTxsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Block != nil {
+			encoded, err := options.Marshal(x.Block)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BlockId != nil {
+			encoded, err := options.Marshal(x.BlockId)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Txs) > 0 {
+			for iNdEx := len(x.Txs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Txs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GetBlockWithTxsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetBlockWithTxsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GetBlockWithTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Txs = append(x.Txs, &Tx{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Txs[len(x.Txs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BlockId == nil {
+					x.BlockId = &v2.BlockID{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BlockId); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Block == nil {
+					x.Block = &v2.Block{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Block); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TxDecodeRequest          protoreflect.MessageDescriptor
+	fd_TxDecodeRequest_tx_bytes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_tx_v1beta1_service_proto_init()
+	md_TxDecodeRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxDecodeRequest")
+	fd_TxDecodeRequest_tx_bytes = md_TxDecodeRequest.Fields().ByName("tx_bytes")
+}
+
+var _ protoreflect.Message = (*fastReflection_TxDecodeRequest)(nil)
+
+type fastReflection_TxDecodeRequest TxDecodeRequest
+
+func (x *TxDecodeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxDecodeRequest)(x)
+}
+
+func (x *TxDecodeRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TxDecodeRequest_messageType fastReflection_TxDecodeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_TxDecodeRequest_messageType{}
+
+type fastReflection_TxDecodeRequest_messageType struct{}
+
+func (x fastReflection_TxDecodeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxDecodeRequest)(nil)
+}
+func (x fastReflection_TxDecodeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxDecodeRequest)
+}
+func (x fastReflection_TxDecodeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxDecodeRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TxDecodeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxDecodeRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TxDecodeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_TxDecodeRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TxDecodeRequest) New() protoreflect.Message {
+	return new(fastReflection_TxDecodeRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TxDecodeRequest) Interface() protoreflect.ProtoMessage {
+	return (*TxDecodeRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TxDecodeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.TxBytes) != 0 {
+		value := protoreflect.ValueOfBytes(x.TxBytes)
+		if !f(fd_TxDecodeRequest_tx_bytes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TxDecodeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
+		return len(x.TxBytes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxDecodeRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
+		x.TxBytes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TxDecodeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
+		value := x.TxBytes
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxDecodeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
+		x.TxBytes = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxDecodeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
+		panic(fmt.Errorf("field tx_bytes of message cosmos.tx.v1beta1.TxDecodeRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TxDecodeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TxDecodeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxDecodeRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TxDecodeRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxDecodeRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TxDecodeRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TxDecodeRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TxDecodeRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.TxBytes)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TxDecodeRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TxBytes) > 0 {
+			i -= len(x.TxBytes)
+			copy(dAtA[i:], x.TxBytes)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxBytes)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TxDecodeRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TxBytes = append(x.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+				if x.TxBytes == nil {
+					x.TxBytes = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TxDecodeResponse    protoreflect.MessageDescriptor
+	fd_TxDecodeResponse_tx protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_tx_v1beta1_service_proto_init()
+	md_TxDecodeResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxDecodeResponse")
+	fd_TxDecodeResponse_tx = md_TxDecodeResponse.Fields().ByName("tx")
+}
+
+var _ protoreflect.Message = (*fastReflection_TxDecodeResponse)(nil)
+
+type fastReflection_TxDecodeResponse TxDecodeResponse
+
+func (x *TxDecodeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxDecodeResponse)(x)
+}
+
+func (x *TxDecodeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TxDecodeResponse_messageType fastReflection_TxDecodeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_TxDecodeResponse_messageType{}
+
+type fastReflection_TxDecodeResponse_messageType struct{}
+
+func (x fastReflection_TxDecodeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxDecodeResponse)(nil)
+}
+func (x fastReflection_TxDecodeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxDecodeResponse)
+}
+func (x fastReflection_TxDecodeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxDecodeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TxDecodeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxDecodeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TxDecodeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_TxDecodeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TxDecodeResponse) New() protoreflect.Message {
+	return new(fastReflection_TxDecodeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TxDecodeResponse) Interface() protoreflect.ProtoMessage {
+	return (*TxDecodeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TxDecodeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Tx != nil {
+		value := protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
+		if !f(fd_TxDecodeResponse_tx, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TxDecodeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
+		return x.Tx != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxDecodeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
+		x.Tx = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TxDecodeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
+		value := x.Tx
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxDecodeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
+		x.Tx = value.Message().Interface().(*Tx)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxDecodeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
+		if x.Tx == nil {
+			x.Tx = new(Tx)
+		}
+		return protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TxDecodeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
+		m := new(Tx)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TxDecodeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxDecodeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TxDecodeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxDecodeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TxDecodeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TxDecodeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TxDecodeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Tx != nil {
+			l = options.Size(x.Tx)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TxDecodeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Tx != nil {
+			encoded, err := options.Marshal(x.Tx)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TxDecodeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Tx == nil {
+					x.Tx = &Tx{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tx); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TxEncodeRequest    protoreflect.MessageDescriptor
+	fd_TxEncodeRequest_tx protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_tx_v1beta1_service_proto_init()
+	md_TxEncodeRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxEncodeRequest")
+	fd_TxEncodeRequest_tx = md_TxEncodeRequest.Fields().ByName("tx")
+}
+
+var _ protoreflect.Message = (*fastReflection_TxEncodeRequest)(nil)
+
+type fastReflection_TxEncodeRequest TxEncodeRequest
+
+func (x *TxEncodeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxEncodeRequest)(x)
+}
+
+func (x *TxEncodeRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TxEncodeRequest_messageType fastReflection_TxEncodeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_TxEncodeRequest_messageType{}
+
+type fastReflection_TxEncodeRequest_messageType struct{}
+
+func (x fastReflection_TxEncodeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxEncodeRequest)(nil)
+}
+func (x fastReflection_TxEncodeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxEncodeRequest)
+}
+func (x fastReflection_TxEncodeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxEncodeRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TxEncodeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxEncodeRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TxEncodeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_TxEncodeRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TxEncodeRequest) New() protoreflect.Message {
+	return new(fastReflection_TxEncodeRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TxEncodeRequest) Interface() protoreflect.ProtoMessage {
+	return (*TxEncodeRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TxEncodeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Tx != nil {
+		value := protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
+		if !f(fd_TxEncodeRequest_tx, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TxEncodeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
+		return x.Tx != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxEncodeRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
+		x.Tx = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TxEncodeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
+		value := x.Tx
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxEncodeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
+		x.Tx = value.Message().Interface().(*Tx)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxEncodeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
+		if x.Tx == nil {
+			x.Tx = new(Tx)
+		}
+		return protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TxEncodeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
+		m := new(Tx)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
+		}
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TxEncodeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxEncodeRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TxEncodeRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TxEncodeRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TxEncodeRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TxEncodeRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TxEncodeRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Tx != nil {
+			l = options.Size(x.Tx)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TxEncodeRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Tx != nil {
+			encoded, err := options.Marshal(x.Tx)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TxEncodeRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Block == nil {
-					x.Block = &v2.Block{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Block); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				iNdEx = postIndex
-			case 4:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5569,10 +9552,10 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
+				if x.Tx == nil {
+					x.Tx = &Tx{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tx); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -5612,26 +9595,26 @@ func (x *fastReflection_GetBlockWithTxsResponse) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_TxDecodeRequest          protoreflect.MessageDescriptor
-	fd_TxDecodeRequest_tx_bytes protoreflect.FieldDescriptor
+	md_TxEncodeResponse          protoreflect.MessageDescriptor
+	fd_TxEncodeResponse_tx_bytes protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_TxDecodeRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxDecodeRequest")
-	fd_TxDecodeRequest_tx_bytes = md_TxDecodeRequest.Fields().ByName("tx_bytes")
+	md_TxEncodeResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxEncodeResponse")
+	fd_TxEncodeResponse_tx_bytes = md_TxEncodeResponse.Fields().ByName("tx_bytes")
 }
 
-var _ protoreflect.Message = (*fastReflection_TxDecodeRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_TxEncodeResponse)(nil)
 
-type fastReflection_TxDecodeRequest TxDecodeRequest
+type fastReflection_TxEncodeResponse TxEncodeResponse
 
-func (x *TxDecodeRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TxDecodeRequest)(x)
+func (x *TxEncodeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxEncodeResponse)(x)
 }
 
-func (x *TxDecodeRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[10]
+func (x *TxEncodeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5642,43 +9625,43 @@ func (x *TxDecodeRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TxDecodeRequest_messageType fastReflection_TxDecodeRequest_messageType
-var _ protoreflect.MessageType = fastReflection_TxDecodeRequest_messageType{}
+var _fastReflection_TxEncodeResponse_messageType fastReflection_TxEncodeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_TxEncodeResponse_messageType{}
 
-type fastReflection_TxDecodeRequest_messageType struct{}
+type fastReflection_TxEncodeResponse_messageType struct{}
 
-func (x fastReflection_TxDecodeRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TxDecodeRequest)(nil)
+func (x fastReflection_TxEncodeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxEncodeResponse)(nil)
 }
-func (x fastReflection_TxDecodeRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_TxDecodeRequest)
+func (x fastReflection_TxEncodeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxEncodeResponse)
 }
-func (x fastReflection_TxDecodeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxDecodeRequest
+func (x fastReflection_TxEncodeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxEncodeResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TxDecodeRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxDecodeRequest
+func (x *fastReflection_TxEncodeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxEncodeResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TxDecodeRequest) Type() protoreflect.MessageType {
-	return _fastReflection_TxDecodeRequest_messageType
+func (x *fastReflection_TxEncodeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_TxEncodeResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TxDecodeRequest) New() protoreflect.Message {
-	return new(fastReflection_TxDecodeRequest)
+func (x *fastReflection_TxEncodeResponse) New() protoreflect.Message {
+	return new(fastReflection_TxEncodeResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TxDecodeRequest) Interface() protoreflect.ProtoMessage {
-	return (*TxDecodeRequest)(x)
+func (x *fastReflection_TxEncodeResponse) Interface() protoreflect.ProtoMessage {
+	return (*TxEncodeResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5686,10 +9669,10 @@ func (x *fastReflection_TxDecodeRequest) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TxDecodeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_TxEncodeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if len(x.TxBytes) != 0 {
 		value := protoreflect.ValueOfBytes(x.TxBytes)
-		if !f(fd_TxDecodeRequest_tx_bytes, value) {
+		if !f(fd_TxEncodeResponse_tx_bytes, value) {
 			return
 		}
 	}
@@ -5706,15 +9689,15 @@ func (x *fastReflection_TxDecodeRequest) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TxDecodeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TxEncodeResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
+	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
 		return len(x.TxBytes) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5724,15 +9707,15 @@ func (x *fastReflection_TxDecodeRequest) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TxEncodeResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
+	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
 		x.TxBytes = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5742,16 +9725,16 @@ func (x *fastReflection_TxDecodeRequest) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TxDecodeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEncodeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
+	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
 		value := x.TxBytes
 		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5765,15 +9748,15 @@ func (x *fastReflection_TxDecodeRequest) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TxEncodeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
+	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
 		x.TxBytes = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5787,40 +9770,40 @@ func (x *fastReflection_TxDecodeRequest) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEncodeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
-		panic(fmt.Errorf("field tx_bytes of message cosmos.tx.v1beta1.TxDecodeRequest is not mutable"))
+	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
+		panic(fmt.Errorf("field tx_bytes of message cosmos.tx.v1beta1.TxEncodeResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TxDecodeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEncodeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeRequest.tx_bytes":
+	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
 		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TxDecodeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TxEncodeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxDecodeRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxEncodeResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5828,7 +9811,7 @@ func (x *fastReflection_TxDecodeRequest) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TxDecodeRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TxEncodeResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5839,7 +9822,7 @@ func (x *fastReflection_TxDecodeRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TxEncodeResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5851,7 +9834,7 @@ func (x *fastReflection_TxDecodeRequest) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TxDecodeRequest) IsValid() bool {
+func (x *fastReflection_TxEncodeResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5861,9 +9844,9 @@ func (x *fastReflection_TxDecodeRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TxDecodeRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TxEncodeResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TxDecodeRequest)
+		x := input.Message.Interface().(*TxEncodeResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5889,7 +9872,7 @@ func (x *fastReflection_TxDecodeRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TxDecodeRequest)
+		x := input.Message.Interface().(*TxEncodeResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5926,7 +9909,7 @@ func (x *fastReflection_TxDecodeRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TxDecodeRequest)
+		x := input.Message.Interface().(*TxEncodeResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5958,10 +9941,10 @@ func (x *fastReflection_TxDecodeRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6034,26 +10017,26 @@ func (x *fastReflection_TxDecodeRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TxDecodeResponse    protoreflect.MessageDescriptor
-	fd_TxDecodeResponse_tx protoreflect.FieldDescriptor
+	md_TxEncodeAminoRequest            protoreflect.MessageDescriptor
+	fd_TxEncodeAminoRequest_amino_json protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_TxDecodeResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxDecodeResponse")
-	fd_TxDecodeResponse_tx = md_TxDecodeResponse.Fields().ByName("tx")
+	md_TxEncodeAminoRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxEncodeAminoRequest")
+	fd_TxEncodeAminoRequest_amino_json = md_TxEncodeAminoRequest.Fields().ByName("amino_json")
 }
 
-var _ protoreflect.Message = (*fastReflection_TxDecodeResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_TxEncodeAminoRequest)(nil)
 
-type fastReflection_TxDecodeResponse TxDecodeResponse
+type fastReflection_TxEncodeAminoRequest TxEncodeAminoRequest
 
-func (x *TxDecodeResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TxDecodeResponse)(x)
+func (x *TxEncodeAminoRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxEncodeAminoRequest)(x)
 }
 
-func (x *TxDecodeResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[11]
+func (x *TxEncodeAminoRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6064,43 +10047,43 @@ func (x *TxDecodeResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TxDecodeResponse_messageType fastReflection_TxDecodeResponse_messageType
-var _ protoreflect.MessageType = fastReflection_TxDecodeResponse_messageType{}
+var _fastReflection_TxEncodeAminoRequest_messageType fastReflection_TxEncodeAminoRequest_messageType
+var _ protoreflect.MessageType = fastReflection_TxEncodeAminoRequest_messageType{}
 
-type fastReflection_TxDecodeResponse_messageType struct{}
+type fastReflection_TxEncodeAminoRequest_messageType struct{}
 
-func (x fastReflection_TxDecodeResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TxDecodeResponse)(nil)
+func (x fastReflection_TxEncodeAminoRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxEncodeAminoRequest)(nil)
 }
-func (x fastReflection_TxDecodeResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_TxDecodeResponse)
+func (x fastReflection_TxEncodeAminoRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxEncodeAminoRequest)
 }
-func (x fastReflection_TxDecodeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxDecodeResponse
+func (x fastReflection_TxEncodeAminoRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxEncodeAminoRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TxDecodeResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxDecodeResponse
+func (x *fastReflection_TxEncodeAminoRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxEncodeAminoRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TxDecodeResponse) Type() protoreflect.MessageType {
-	return _fastReflection_TxDecodeResponse_messageType
+func (x *fastReflection_TxEncodeAminoRequest) Type() protoreflect.MessageType {
+	return _fastReflection_TxEncodeAminoRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TxDecodeResponse) New() protoreflect.Message {
-	return new(fastReflection_TxDecodeResponse)
+func (x *fastReflection_TxEncodeAminoRequest) New() protoreflect.Message {
+	return new(fastReflection_TxEncodeAminoRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TxDecodeResponse) Interface() protoreflect.ProtoMessage {
-	return (*TxDecodeResponse)(x)
+func (x *fastReflection_TxEncodeAminoRequest) Interface() protoreflect.ProtoMessage {
+	return (*TxEncodeAminoRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6108,10 +10091,10 @@ func (x *fastReflection_TxDecodeResponse) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TxDecodeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Tx != nil {
-		value := protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
-		if !f(fd_TxDecodeResponse_tx, value) {
+func (x *fastReflection_TxEncodeAminoRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.AminoJson != "" {
+		value := protoreflect.ValueOfString(x.AminoJson)
+		if !f(fd_TxEncodeAminoRequest_amino_json, value) {
 			return
 		}
 	}
@@ -6128,15 +10111,15 @@ func (x *fastReflection_TxDecodeResponse) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TxDecodeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TxEncodeAminoRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
-		return x.Tx != nil
+	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
+		return x.AminoJson != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6146,15 +10129,15 @@ func (x *fastReflection_TxDecodeResponse) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TxEncodeAminoRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
-		x.Tx = nil
+	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
+		x.AminoJson = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6164,16 +10147,16 @@ func (x *fastReflection_TxDecodeResponse) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TxDecodeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEncodeAminoRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
-		value := x.Tx
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
+		value := x.AminoJson
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6187,15 +10170,15 @@ func (x *fastReflection_TxDecodeResponse) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TxEncodeAminoRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
-		x.Tx = value.Message().Interface().(*Tx)
+	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
+		x.AminoJson = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6209,44 +10192,40 @@ func (x *fastReflection_TxDecodeResponse) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEncodeAminoRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
-		if x.Tx == nil {
-			x.Tx = new(Tx)
-		}
-		return protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
+	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
+		panic(fmt.Errorf("field amino_json of message cosmos.tx.v1beta1.TxEncodeAminoRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TxDecodeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEncodeAminoRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeResponse.tx":
-		m := new(Tx)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TxDecodeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TxEncodeAminoRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxDecodeResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxEncodeAminoRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6254,7 +10233,7 @@ func (x *fastReflection_TxDecodeResponse) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TxDecodeResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TxEncodeAminoRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6265,7 +10244,7 @@ func (x *fastReflection_TxDecodeResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TxEncodeAminoRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6277,7 +10256,7 @@ func (x *fastReflection_TxDecodeResponse) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TxDecodeResponse) IsValid() bool {
+func (x *fastReflection_TxEncodeAminoRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6287,9 +10266,9 @@ func (x *fastReflection_TxDecodeResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TxDecodeResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TxEncodeAminoRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TxDecodeResponse)
+		x := input.Message.Interface().(*TxEncodeAminoRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6301,8 +10280,8 @@ func (x *fastReflection_TxDecodeResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Tx != nil {
-			l = options.Size(x.Tx)
+		l = len(x.AminoJson)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -6315,7 +10294,7 @@ func (x *fastReflection_TxDecodeResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TxDecodeResponse)
+		x := input.Message.Interface().(*TxEncodeAminoRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6334,17 +10313,10 @@ func (x *fastReflection_TxDecodeResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Tx != nil {
-			encoded, err := options.Marshal(x.Tx)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.AminoJson) > 0 {
+			i -= len(x.AminoJson)
+			copy(dAtA[i:], x.AminoJson)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AminoJson)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6359,7 +10331,7 @@ func (x *fastReflection_TxDecodeResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TxDecodeResponse)
+		x := input.Message.Interface().(*TxEncodeAminoRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6391,17 +10363,17 @@ func (x *fastReflection_TxDecodeResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeAminoRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeAminoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AminoJson", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6411,27 +10383,23 @@ func (x *fastReflection_TxDecodeResponse) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Tx == nil {
-					x.Tx = &Tx{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tx); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.AminoJson = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6469,26 +10437,26 @@ func (x *fastReflection_TxDecodeResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TxEncodeRequest    protoreflect.MessageDescriptor
-	fd_TxEncodeRequest_tx protoreflect.FieldDescriptor
+	md_TxEncodeAminoResponse              protoreflect.MessageDescriptor
+	fd_TxEncodeAminoResponse_amino_binary protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_TxEncodeRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxEncodeRequest")
-	fd_TxEncodeRequest_tx = md_TxEncodeRequest.Fields().ByName("tx")
+	md_TxEncodeAminoResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxEncodeAminoResponse")
+	fd_TxEncodeAminoResponse_amino_binary = md_TxEncodeAminoResponse.Fields().ByName("amino_binary")
 }
 
-var _ protoreflect.Message = (*fastReflection_TxEncodeRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_TxEncodeAminoResponse)(nil)
 
-type fastReflection_TxEncodeRequest TxEncodeRequest
+type fastReflection_TxEncodeAminoResponse TxEncodeAminoResponse
 
-func (x *TxEncodeRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TxEncodeRequest)(x)
+func (x *TxEncodeAminoResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxEncodeAminoResponse)(x)
 }
 
-func (x *TxEncodeRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[12]
+func (x *TxEncodeAminoResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6499,43 +10467,43 @@ func (x *TxEncodeRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TxEncodeRequest_messageType fastReflection_TxEncodeRequest_messageType
-var _ protoreflect.MessageType = fastReflection_TxEncodeRequest_messageType{}
+var _fastReflection_TxEncodeAminoResponse_messageType fastReflection_TxEncodeAminoResponse_messageType
+var _ protoreflect.MessageType = fastReflection_TxEncodeAminoResponse_messageType{}
 
-type fastReflection_TxEncodeRequest_messageType struct{}
+type fastReflection_TxEncodeAminoResponse_messageType struct{}
 
-func (x fastReflection_TxEncodeRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TxEncodeRequest)(nil)
+func (x fastReflection_TxEncodeAminoResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxEncodeAminoResponse)(nil)
 }
-func (x fastReflection_TxEncodeRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_TxEncodeRequest)
+func (x fastReflection_TxEncodeAminoResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxEncodeAminoResponse)
 }
-func (x fastReflection_TxEncodeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxEncodeRequest
+func (x fastReflection_TxEncodeAminoResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxEncodeAminoResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TxEncodeRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxEncodeRequest
+func (x *fastReflection_TxEncodeAminoResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxEncodeAminoResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TxEncodeRequest) Type() protoreflect.MessageType {
-	return _fastReflection_TxEncodeRequest_messageType
+func (x *fastReflection_TxEncodeAminoResponse) Type() protoreflect.MessageType {
+	return _fastReflection_TxEncodeAminoResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TxEncodeRequest) New() protoreflect.Message {
-	return new(fastReflection_TxEncodeRequest)
+func (x *fastReflection_TxEncodeAminoResponse) New() protoreflect.Message {
+	return new(fastReflection_TxEncodeAminoResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TxEncodeRequest) Interface() protoreflect.ProtoMessage {
-	return (*TxEncodeRequest)(x)
+func (x *fastReflection_TxEncodeAminoResponse) Interface() protoreflect.ProtoMessage {
+	return (*TxEncodeAminoResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6543,10 +10511,10 @@ func (x *fastReflection_TxEncodeRequest) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TxEncodeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Tx != nil {
-		value := protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
-		if !f(fd_TxEncodeRequest_tx, value) {
+func (x *fastReflection_TxEncodeAminoResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.AminoBinary) != 0 {
+		value := protoreflect.ValueOfBytes(x.AminoBinary)
+		if !f(fd_TxEncodeAminoResponse_amino_binary, value) {
 			return
 		}
 	}
@@ -6563,15 +10531,15 @@ func (x *fastReflection_TxEncodeRequest) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TxEncodeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TxEncodeAminoResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
-		return x.Tx != nil
+	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
+		return len(x.AminoBinary) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6581,15 +10549,15 @@ func (x *fastReflection_TxEncodeRequest) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TxEncodeAminoResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
-		x.Tx = nil
+	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
+		x.AminoBinary = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6599,16 +10567,16 @@ func (x *fastReflection_TxEncodeRequest) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TxEncodeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEncodeAminoResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
-		value := x.Tx
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
+		value := x.AminoBinary
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6622,15 +10590,15 @@ func (x *fastReflection_TxEncodeRequest) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TxEncodeAminoResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
-		x.Tx = value.Message().Interface().(*Tx)
+	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
+		x.AminoBinary = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6644,44 +10612,40 @@ func (x *fastReflection_TxEncodeRequest) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEncodeAminoResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
-		if x.Tx == nil {
-			x.Tx = new(Tx)
-		}
-		return protoreflect.ValueOfMessage(x.Tx.ProtoReflect())
+	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
+		panic(fmt.Errorf("field amino_binary of message cosmos.tx.v1beta1.TxEncodeAminoResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TxEncodeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxEncodeAminoResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeRequest.tx":
-		m := new(Tx)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TxEncodeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TxEncodeAminoResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxEncodeRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxEncodeAminoResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6689,7 +10653,7 @@ func (x *fastReflection_TxEncodeRequest) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TxEncodeRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TxEncodeAminoResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6700,7 +10664,7 @@ func (x *fastReflection_TxEncodeRequest) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TxEncodeAminoResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6712,7 +10676,7 @@ func (x *fastReflection_TxEncodeRequest) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TxEncodeRequest) IsValid() bool {
+func (x *fastReflection_TxEncodeAminoResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6722,9 +10686,9 @@ func (x *fastReflection_TxEncodeRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TxEncodeRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TxEncodeAminoResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TxEncodeRequest)
+		x := input.Message.Interface().(*TxEncodeAminoResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6736,8 +10700,8 @@ func (x *fastReflection_TxEncodeRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Tx != nil {
-			l = options.Size(x.Tx)
+		l = len(x.AminoBinary)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -6750,7 +10714,7 @@ func (x *fastReflection_TxEncodeRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TxEncodeRequest)
+		x := input.Message.Interface().(*TxEncodeAminoResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6769,17 +10733,10 @@ func (x *fastReflection_TxEncodeRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Tx != nil {
-			encoded, err := options.Marshal(x.Tx)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.AminoBinary) > 0 {
+			i -= len(x.AminoBinary)
+			copy(dAtA[i:], x.AminoBinary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AminoBinary)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6794,7 +10751,7 @@ func (x *fastReflection_TxEncodeRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TxEncodeRequest)
+		x := input.Message.Interface().(*TxEncodeAminoResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6826,17 +10783,17 @@ func (x *fastReflection_TxEncodeRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeAminoResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeAminoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AminoBinary", wireType)
 				}
-				var msglen int
+				var byteLen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6846,26 +10803,24 @@ func (x *fastReflection_TxEncodeRequest) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + byteLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Tx == nil {
-					x.Tx = &Tx{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tx); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.AminoBinary = append(x.AminoBinary[:0], dAtA[iNdEx:postIndex]...)
+				if x.AminoBinary == nil {
+					x.AminoBinary = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -6904,26 +10859,26 @@ func (x *fastReflection_TxEncodeRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TxEncodeResponse          protoreflect.MessageDescriptor
-	fd_TxEncodeResponse_tx_bytes protoreflect.FieldDescriptor
+	md_TxDecodeAminoRequest              protoreflect.MessageDescriptor
+	fd_TxDecodeAminoRequest_amino_binary protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_TxEncodeResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxEncodeResponse")
-	fd_TxEncodeResponse_tx_bytes = md_TxEncodeResponse.Fields().ByName("tx_bytes")
+	md_TxDecodeAminoRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxDecodeAminoRequest")
+	fd_TxDecodeAminoRequest_amino_binary = md_TxDecodeAminoRequest.Fields().ByName("amino_binary")
 }
 
-var _ protoreflect.Message = (*fastReflection_TxEncodeResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_TxDecodeAminoRequest)(nil)
 
-type fastReflection_TxEncodeResponse TxEncodeResponse
+type fastReflection_TxDecodeAminoRequest TxDecodeAminoRequest
 
-func (x *TxEncodeResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TxEncodeResponse)(x)
+func (x *TxDecodeAminoRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxDecodeAminoRequest)(x)
 }
 
-func (x *TxEncodeResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[13]
+func (x *TxDecodeAminoRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6934,43 +10889,43 @@ func (x *TxEncodeResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TxEncodeResponse_messageType fastReflection_TxEncodeResponse_messageType
-var _ protoreflect.MessageType = fastReflection_TxEncodeResponse_messageType{}
+var _fastReflection_TxDecodeAminoRequest_messageType fastReflection_TxDecodeAminoRequest_messageType
+var _ protoreflect.MessageType = fastReflection_TxDecodeAminoRequest_messageType{}
 
-type fastReflection_TxEncodeResponse_messageType struct{}
+type fastReflection_TxDecodeAminoRequest_messageType struct{}
 
-func (x fastReflection_TxEncodeResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TxEncodeResponse)(nil)
+func (x fastReflection_TxDecodeAminoRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxDecodeAminoRequest)(nil)
 }
-func (x fastReflection_TxEncodeResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_TxEncodeResponse)
+func (x fastReflection_TxDecodeAminoRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxDecodeAminoRequest)
 }
-func (x fastReflection_TxEncodeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxEncodeResponse
+func (x fastReflection_TxDecodeAminoRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxDecodeAminoRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TxEncodeResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxEncodeResponse
+func (x *fastReflection_TxDecodeAminoRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxDecodeAminoRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TxEncodeResponse) Type() protoreflect.MessageType {
-	return _fastReflection_TxEncodeResponse_messageType
+func (x *fastReflection_TxDecodeAminoRequest) Type() protoreflect.MessageType {
+	return _fastReflection_TxDecodeAminoRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TxEncodeResponse) New() protoreflect.Message {
-	return new(fastReflection_TxEncodeResponse)
+func (x *fastReflection_TxDecodeAminoRequest) New() protoreflect.Message {
+	return new(fastReflection_TxDecodeAminoRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TxEncodeResponse) Interface() protoreflect.ProtoMessage {
-	return (*TxEncodeResponse)(x)
+func (x *fastReflection_TxDecodeAminoRequest) Interface() protoreflect.ProtoMessage {
+	return (*TxDecodeAminoRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6978,10 +10933,10 @@ func (x *fastReflection_TxEncodeResponse) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TxEncodeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.TxBytes) != 0 {
-		value := protoreflect.ValueOfBytes(x.TxBytes)
-		if !f(fd_TxEncodeResponse_tx_bytes, value) {
+func (x *fastReflection_TxDecodeAminoRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.AminoBinary) != 0 {
+		value := protoreflect.ValueOfBytes(x.AminoBinary)
+		if !f(fd_TxDecodeAminoRequest_amino_binary, value) {
 			return
 		}
 	}
@@ -6998,15 +10953,15 @@ func (x *fastReflection_TxEncodeResponse) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TxEncodeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TxDecodeAminoRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
-		return len(x.TxBytes) != 0
+	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
+		return len(x.AminoBinary) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7016,15 +10971,15 @@ func (x *fastReflection_TxEncodeResponse) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TxDecodeAminoRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
-		x.TxBytes = nil
+	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
+		x.AminoBinary = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7034,16 +10989,16 @@ func (x *fastReflection_TxEncodeResponse) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TxEncodeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxDecodeAminoRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
-		value := x.TxBytes
+	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
+		value := x.AminoBinary
 		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7057,15 +11012,15 @@ func (x *fastReflection_TxEncodeResponse) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TxDecodeAminoRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
-		x.TxBytes = value.Bytes()
+	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
+		x.AminoBinary = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7079,40 +11034,40 @@ func (x *fastReflection_TxEncodeResponse) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxDecodeAminoRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
-		panic(fmt.Errorf("field tx_bytes of message cosmos.tx.v1beta1.TxEncodeResponse is not mutable"))
+	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
+		panic(fmt.Errorf("field amino_binary of message cosmos.tx.v1beta1.TxDecodeAminoRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TxEncodeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxDecodeAminoRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeResponse.tx_bytes":
+	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
 		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TxEncodeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TxDecodeAminoRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxEncodeResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxDecodeAminoRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7120,7 +11075,7 @@ func (x *fastReflection_TxEncodeResponse) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TxEncodeResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TxDecodeAminoRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7131,7 +11086,7 @@ func (x *fastReflection_TxEncodeResponse) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TxDecodeAminoRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7143,7 +11098,7 @@ func (x *fastReflection_TxEncodeResponse) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TxEncodeResponse) IsValid() bool {
+func (x *fastReflection_TxDecodeAminoRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7153,9 +11108,9 @@ func (x *fastReflection_TxEncodeResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TxEncodeResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TxDecodeAminoRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TxEncodeResponse)
+		x := input.Message.Interface().(*TxDecodeAminoRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7167,7 +11122,7 @@ func (x *fastReflection_TxEncodeResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.TxBytes)
+		l = len(x.AminoBinary)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -7181,7 +11136,7 @@ func (x *fastReflection_TxEncodeResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TxEncodeResponse)
+		x := input.Message.Interface().(*TxDecodeAminoRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7200,10 +11155,10 @@ func (x *fastReflection_TxEncodeResponse) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.TxBytes) > 0 {
-			i -= len(x.TxBytes)
-			copy(dAtA[i:], x.TxBytes)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxBytes)))
+		if len(x.AminoBinary) > 0 {
+			i -= len(x.AminoBinary)
+			copy(dAtA[i:], x.AminoBinary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AminoBinary)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7218,7 +11173,7 @@ func (x *fastReflection_TxEncodeResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TxEncodeResponse)
+		x := input.Message.Interface().(*TxDecodeAminoRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7250,15 +11205,15 @@ func (x *fastReflection_TxEncodeResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeAminoRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeAminoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AminoBinary", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -7285,9 +11240,9 @@ func (x *fastReflection_TxEncodeResponse) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TxBytes = append(x.TxBytes[:0], dAtA[iNdEx:postIndex]...)
-				if x.TxBytes == nil {
-					x.TxBytes = []byte{}
+				x.AminoBinary = append(x.AminoBinary[:0], dAtA[iNdEx:postIndex]...)
+				if x.AminoBinary == nil {
+					x.AminoBinary = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -7326,26 +11281,26 @@ func (x *fastReflection_TxEncodeResponse) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TxEncodeAminoRequest            protoreflect.MessageDescriptor
-	fd_TxEncodeAminoRequest_amino_json protoreflect.FieldDescriptor
+	md_TxDecodeAminoResponse            protoreflect.MessageDescriptor
+	fd_TxDecodeAminoResponse_amino_json protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_TxEncodeAminoRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxEncodeAminoRequest")
-	fd_TxEncodeAminoRequest_amino_json = md_TxEncodeAminoRequest.Fields().ByName("amino_json")
+	md_TxDecodeAminoResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxDecodeAminoResponse")
+	fd_TxDecodeAminoResponse_amino_json = md_TxDecodeAminoResponse.Fields().ByName("amino_json")
 }
 
-var _ protoreflect.Message = (*fastReflection_TxEncodeAminoRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_TxDecodeAminoResponse)(nil)
 
-type fastReflection_TxEncodeAminoRequest TxEncodeAminoRequest
+type fastReflection_TxDecodeAminoResponse TxDecodeAminoResponse
 
-func (x *TxEncodeAminoRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TxEncodeAminoRequest)(x)
+func (x *TxDecodeAminoResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TxDecodeAminoResponse)(x)
 }
 
-func (x *TxEncodeAminoRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[14]
+func (x *TxDecodeAminoResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7356,43 +11311,43 @@ func (x *TxEncodeAminoRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TxEncodeAminoRequest_messageType fastReflection_TxEncodeAminoRequest_messageType
-var _ protoreflect.MessageType = fastReflection_TxEncodeAminoRequest_messageType{}
+var _fastReflection_TxDecodeAminoResponse_messageType fastReflection_TxDecodeAminoResponse_messageType
+var _ protoreflect.MessageType = fastReflection_TxDecodeAminoResponse_messageType{}
 
-type fastReflection_TxEncodeAminoRequest_messageType struct{}
+type fastReflection_TxDecodeAminoResponse_messageType struct{}
 
-func (x fastReflection_TxEncodeAminoRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TxEncodeAminoRequest)(nil)
+func (x fastReflection_TxDecodeAminoResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TxDecodeAminoResponse)(nil)
 }
-func (x fastReflection_TxEncodeAminoRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_TxEncodeAminoRequest)
+func (x fastReflection_TxDecodeAminoResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_TxDecodeAminoResponse)
 }
-func (x fastReflection_TxEncodeAminoRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxEncodeAminoRequest
+func (x fastReflection_TxDecodeAminoResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxDecodeAminoResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TxEncodeAminoRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxEncodeAminoRequest
+func (x *fastReflection_TxDecodeAminoResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_TxDecodeAminoResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TxEncodeAminoRequest) Type() protoreflect.MessageType {
-	return _fastReflection_TxEncodeAminoRequest_messageType
+func (x *fastReflection_TxDecodeAminoResponse) Type() protoreflect.MessageType {
+	return _fastReflection_TxDecodeAminoResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TxEncodeAminoRequest) New() protoreflect.Message {
-	return new(fastReflection_TxEncodeAminoRequest)
+func (x *fastReflection_TxDecodeAminoResponse) New() protoreflect.Message {
+	return new(fastReflection_TxDecodeAminoResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TxEncodeAminoRequest) Interface() protoreflect.ProtoMessage {
-	return (*TxEncodeAminoRequest)(x)
+func (x *fastReflection_TxDecodeAminoResponse) Interface() protoreflect.ProtoMessage {
+	return (*TxDecodeAminoResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7400,10 +11355,10 @@ func (x *fastReflection_TxEncodeAminoRequest) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TxEncodeAminoRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_TxDecodeAminoResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.AminoJson != "" {
 		value := protoreflect.ValueOfString(x.AminoJson)
-		if !f(fd_TxEncodeAminoRequest_amino_json, value) {
+		if !f(fd_TxDecodeAminoResponse_amino_json, value) {
 			return
 		}
 	}
@@ -7420,15 +11375,15 @@ func (x *fastReflection_TxEncodeAminoRequest) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TxEncodeAminoRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TxDecodeAminoResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
+	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
 		return x.AminoJson != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7438,15 +11393,15 @@ func (x *fastReflection_TxEncodeAminoRequest) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeAminoRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TxDecodeAminoResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
+	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
 		x.AminoJson = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7456,16 +11411,16 @@ func (x *fastReflection_TxEncodeAminoRequest) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TxEncodeAminoRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxDecodeAminoResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
+	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
 		value := x.AminoJson
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7479,15 +11434,15 @@ func (x *fastReflection_TxEncodeAminoRequest) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeAminoRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TxDecodeAminoResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
+	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
 		x.AminoJson = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7501,40 +11456,40 @@ func (x *fastReflection_TxEncodeAminoRequest) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeAminoRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxDecodeAminoResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
-		panic(fmt.Errorf("field amino_json of message cosmos.tx.v1beta1.TxEncodeAminoRequest is not mutable"))
+	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
+		panic(fmt.Errorf("field amino_json of message cosmos.tx.v1beta1.TxDecodeAminoResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TxEncodeAminoRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TxDecodeAminoResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoRequest.amino_json":
+	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TxEncodeAminoRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TxDecodeAminoResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxEncodeAminoRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxDecodeAminoResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7542,7 +11497,7 @@ func (x *fastReflection_TxEncodeAminoRequest) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TxEncodeAminoRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TxDecodeAminoResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7553,7 +11508,7 @@ func (x *fastReflection_TxEncodeAminoRequest) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeAminoRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TxDecodeAminoResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7565,7 +11520,7 @@ func (x *fastReflection_TxEncodeAminoRequest) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TxEncodeAminoRequest) IsValid() bool {
+func (x *fastReflection_TxDecodeAminoResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7575,9 +11530,9 @@ func (x *fastReflection_TxEncodeAminoRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TxEncodeAminoRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TxDecodeAminoResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TxEncodeAminoRequest)
+		x := input.Message.Interface().(*TxDecodeAminoResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7603,7 +11558,7 @@ func (x *fastReflection_TxEncodeAminoRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TxEncodeAminoRequest)
+		x := input.Message.Interface().(*TxDecodeAminoResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7640,7 +11595,7 @@ func (x *fastReflection_TxEncodeAminoRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TxEncodeAminoRequest)
+		x := input.Message.Interface().(*TxDecodeAminoResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7672,10 +11627,10 @@ func (x *fastReflection_TxEncodeAminoRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeAminoRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeAminoResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeAminoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeAminoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -7746,26 +11701,26 @@ func (x *fastReflection_TxEncodeAminoRequest) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_TxEncodeAminoResponse              protoreflect.MessageDescriptor
-	fd_TxEncodeAminoResponse_amino_binary protoreflect.FieldDescriptor
+	md_GasPricesRequest       protoreflect.MessageDescriptor
+	fd_GasPricesRequest_denom protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_TxEncodeAminoResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxEncodeAminoResponse")
-	fd_TxEncodeAminoResponse_amino_binary = md_TxEncodeAminoResponse.Fields().ByName("amino_binary")
+	md_GasPricesRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("GasPricesRequest")
+	fd_GasPricesRequest_denom = md_GasPricesRequest.Fields().ByName("denom")
 }
 
-var _ protoreflect.Message = (*fastReflection_TxEncodeAminoResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_GasPricesRequest)(nil)
 
-type fastReflection_TxEncodeAminoResponse TxEncodeAminoResponse
+type fastReflection_GasPricesRequest GasPricesRequest
 
-func (x *TxEncodeAminoResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TxEncodeAminoResponse)(x)
+func (x *GasPricesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GasPricesRequest)(x)
 }
 
-func (x *TxEncodeAminoResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[15]
+func (x *GasPricesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7776,43 +11731,43 @@ func (x *TxEncodeAminoResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TxEncodeAminoResponse_messageType fastReflection_TxEncodeAminoResponse_messageType
-var _ protoreflect.MessageType = fastReflection_TxEncodeAminoResponse_messageType{}
+var _fastReflection_GasPricesRequest_messageType fastReflection_GasPricesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_GasPricesRequest_messageType{}
 
-type fastReflection_TxEncodeAminoResponse_messageType struct{}
+type fastReflection_GasPricesRequest_messageType struct{}
 
-func (x fastReflection_TxEncodeAminoResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TxEncodeAminoResponse)(nil)
+func (x fastReflection_GasPricesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GasPricesRequest)(nil)
 }
-func (x fastReflection_TxEncodeAminoResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_TxEncodeAminoResponse)
+func (x fastReflection_GasPricesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_GasPricesRequest)
 }
-func (x fastReflection_TxEncodeAminoResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxEncodeAminoResponse
+func (x fastReflection_GasPricesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GasPricesRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TxEncodeAminoResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxEncodeAminoResponse
+func (x *fastReflection_GasPricesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_GasPricesRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TxEncodeAminoResponse) Type() protoreflect.MessageType {
-	return _fastReflection_TxEncodeAminoResponse_messageType
+func (x *fastReflection_GasPricesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_GasPricesRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TxEncodeAminoResponse) New() protoreflect.Message {
-	return new(fastReflection_TxEncodeAminoResponse)
+func (x *fastReflection_GasPricesRequest) New() protoreflect.Message {
+	return new(fastReflection_GasPricesRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TxEncodeAminoResponse) Interface() protoreflect.ProtoMessage {
-	return (*TxEncodeAminoResponse)(x)
+func (x *fastReflection_GasPricesRequest) Interface() protoreflect.ProtoMessage {
+	return (*GasPricesRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7820,10 +11775,10 @@ func (x *fastReflection_TxEncodeAminoResponse) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TxEncodeAminoResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.AminoBinary) != 0 {
-		value := protoreflect.ValueOfBytes(x.AminoBinary)
-		if !f(fd_TxEncodeAminoResponse_amino_binary, value) {
+func (x *fastReflection_GasPricesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_GasPricesRequest_denom, value) {
 			return
 		}
 	}
@@ -7840,15 +11795,15 @@ func (x *fastReflection_TxEncodeAminoResponse) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TxEncodeAminoResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GasPricesRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
-		return len(x.AminoBinary) != 0
+	case "cosmos.tx.v1beta1.GasPricesRequest.denom":
+		return x.Denom != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GasPricesRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GasPricesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7858,15 +11813,15 @@ func (x *fastReflection_TxEncodeAminoResponse) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeAminoResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GasPricesRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
-		x.AminoBinary = nil
+	case "cosmos.tx.v1beta1.GasPricesRequest.denom":
+		x.Denom = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GasPricesRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GasPricesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7876,16 +11831,16 @@ func (x *fastReflection_TxEncodeAminoResponse) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TxEncodeAminoResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GasPricesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
-		value := x.AminoBinary
-		return protoreflect.ValueOfBytes(value)
+	case "cosmos.tx.v1beta1.GasPricesRequest.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GasPricesRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GasPricesRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7899,15 +11854,15 @@ func (x *fastReflection_TxEncodeAminoResponse) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeAminoResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GasPricesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
-		x.AminoBinary = value.Bytes()
+	case "cosmos.tx.v1beta1.GasPricesRequest.denom":
+		x.Denom = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GasPricesRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GasPricesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7921,40 +11876,40 @@ func (x *fastReflection_TxEncodeAminoResponse) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeAminoResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GasPricesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
-		panic(fmt.Errorf("field amino_binary of message cosmos.tx.v1beta1.TxEncodeAminoResponse is not mutable"))
+	case "cosmos.tx.v1beta1.GasPricesRequest.denom":
+		panic(fmt.Errorf("field denom of message cosmos.tx.v1beta1.GasPricesRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GasPricesRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GasPricesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TxEncodeAminoResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GasPricesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxEncodeAminoResponse.amino_binary":
-		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.tx.v1beta1.GasPricesRequest.denom":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxEncodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GasPricesRequest"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxEncodeAminoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GasPricesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TxEncodeAminoResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GasPricesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxEncodeAminoResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.GasPricesRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7962,7 +11917,7 @@ func (x *fastReflection_TxEncodeAminoResponse) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TxEncodeAminoResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GasPricesRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7973,7 +11928,7 @@ func (x *fastReflection_TxEncodeAminoResponse) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxEncodeAminoResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GasPricesRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7985,7 +11940,7 @@ func (x *fastReflection_TxEncodeAminoResponse) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TxEncodeAminoResponse) IsValid() bool {
+func (x *fastReflection_GasPricesRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7995,9 +11950,9 @@ func (x *fastReflection_TxEncodeAminoResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TxEncodeAminoResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GasPricesRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TxEncodeAminoResponse)
+		x := input.Message.Interface().(*GasPricesRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8009,7 +11964,7 @@ func (x *fastReflection_TxEncodeAminoResponse) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.AminoBinary)
+		l = len(x.Denom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -8023,7 +11978,7 @@ func (x *fastReflection_TxEncodeAminoResponse) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TxEncodeAminoResponse)
+		x := input.Message.Interface().(*GasPricesRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8042,10 +11997,10 @@ func (x *fastReflection_TxEncodeAminoResponse) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AminoBinary) > 0 {
-			i -= len(x.AminoBinary)
-			copy(dAtA[i:], x.AminoBinary)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AminoBinary)))
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -8060,7 +12015,7 @@ func (x *fastReflection_TxEncodeAminoResponse) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TxEncodeAminoResponse)
+		x := input.Message.Interface().(*GasPricesRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8092,17 +12047,17 @@ func (x *fastReflection_TxEncodeAminoResponse) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeAminoResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GasPricesRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxEncodeAminoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AminoBinary", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
-				var byteLen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8112,25 +12067,23 @@ func (x *fastReflection_TxEncodeAminoResponse) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AminoBinary = append(x.AminoBinary[:0], dAtA[iNdEx:postIndex]...)
-				if x.AminoBinary == nil {
-					x.AminoBinary = []byte{}
-				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8167,27 +12120,80 @@ func (x *fastReflection_TxEncodeAminoResponse) ProtoMethods() *protoiface.Method
 	}
 }
 
+var _ protoreflect.List = (*_GasPricesResponse_1_list)(nil)
+
+type _GasPricesResponse_1_list struct {
+	list *[]*GasPriceEstimate
+}
+
+func (x *_GasPricesResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GasPricesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GasPricesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GasPriceEstimate)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GasPricesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*GasPriceEstimate)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GasPricesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(GasPriceEstimate)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GasPricesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GasPricesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(GasPriceEstimate)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GasPricesResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_TxDecodeAminoRequest              protoreflect.MessageDescriptor
-	fd_TxDecodeAminoRequest_amino_binary protoreflect.FieldDescriptor
+	md_GasPricesResponse            protoreflect.MessageDescriptor
+	fd_GasPricesResponse_gas_prices protoreflect.FieldDescriptor
+	fd_GasPricesResponse_height     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_TxDecodeAminoRequest = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxDecodeAminoRequest")
-	fd_TxDecodeAminoRequest_amino_binary = md_TxDecodeAminoRequest.Fields().ByName("amino_binary")
+	md_GasPricesResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("GasPricesResponse")
+	fd_GasPricesResponse_gas_prices = md_GasPricesResponse.Fields().ByName("gas_prices")
+	fd_GasPricesResponse_height = md_GasPricesResponse.Fields().ByName("height")
 }
 
-var _ protoreflect.Message = (*fastReflection_TxDecodeAminoRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_GasPricesResponse)(nil)
 
-type fastReflection_TxDecodeAminoRequest TxDecodeAminoRequest
+type fastReflection_GasPricesResponse GasPricesResponse
 
-func (x *TxDecodeAminoRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TxDecodeAminoRequest)(x)
+func (x *GasPricesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GasPricesResponse)(x)
 }
 
-func (x *TxDecodeAminoRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[16]
+func (x *GasPricesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8198,43 +12204,43 @@ func (x *TxDecodeAminoRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TxDecodeAminoRequest_messageType fastReflection_TxDecodeAminoRequest_messageType
-var _ protoreflect.MessageType = fastReflection_TxDecodeAminoRequest_messageType{}
+var _fastReflection_GasPricesResponse_messageType fastReflection_GasPricesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_GasPricesResponse_messageType{}
 
-type fastReflection_TxDecodeAminoRequest_messageType struct{}
+type fastReflection_GasPricesResponse_messageType struct{}
 
-func (x fastReflection_TxDecodeAminoRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TxDecodeAminoRequest)(nil)
+func (x fastReflection_GasPricesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GasPricesResponse)(nil)
 }
-func (x fastReflection_TxDecodeAminoRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_TxDecodeAminoRequest)
+func (x fastReflection_GasPricesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_GasPricesResponse)
 }
-func (x fastReflection_TxDecodeAminoRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxDecodeAminoRequest
+func (x fastReflection_GasPricesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GasPricesResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TxDecodeAminoRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxDecodeAminoRequest
+func (x *fastReflection_GasPricesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_GasPricesResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TxDecodeAminoRequest) Type() protoreflect.MessageType {
-	return _fastReflection_TxDecodeAminoRequest_messageType
+func (x *fastReflection_GasPricesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_GasPricesResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TxDecodeAminoRequest) New() protoreflect.Message {
-	return new(fastReflection_TxDecodeAminoRequest)
+func (x *fastReflection_GasPricesResponse) New() protoreflect.Message {
+	return new(fastReflection_GasPricesResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TxDecodeAminoRequest) Interface() protoreflect.ProtoMessage {
-	return (*TxDecodeAminoRequest)(x)
+func (x *fastReflection_GasPricesResponse) Interface() protoreflect.ProtoMessage {
+	return (*GasPricesResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8242,10 +12248,16 @@ func (x *fastReflection_TxDecodeAminoRequest) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TxDecodeAminoRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.AminoBinary) != 0 {
-		value := protoreflect.ValueOfBytes(x.AminoBinary)
-		if !f(fd_TxDecodeAminoRequest_amino_binary, value) {
+func (x *fastReflection_GasPricesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.GasPrices) != 0 {
+		value := protoreflect.ValueOfList(&_GasPricesResponse_1_list{list: &x.GasPrices})
+		if !f(fd_GasPricesResponse_gas_prices, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_GasPricesResponse_height, value) {
 			return
 		}
 	}
@@ -8262,15 +12274,17 @@ func (x *fastReflection_TxDecodeAminoRequest) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TxDecodeAminoRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GasPricesResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
-		return len(x.AminoBinary) != 0
+	case "cosmos.tx.v1beta1.GasPricesResponse.gas_prices":
+		return len(x.GasPrices) != 0
+	case "cosmos.tx.v1beta1.GasPricesResponse.height":
+		return x.Height != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GasPricesResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GasPricesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8280,15 +12294,17 @@ func (x *fastReflection_TxDecodeAminoRequest) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeAminoRequest) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
-		x.AminoBinary = nil
+func (x *fastReflection_GasPricesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.tx.v1beta1.GasPricesResponse.gas_prices":
+		x.GasPrices = nil
+	case "cosmos.tx.v1beta1.GasPricesResponse.height":
+		x.Height = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GasPricesResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GasPricesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8298,16 +12314,22 @@ func (x *fastReflection_TxDecodeAminoRequest) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TxDecodeAminoRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GasPricesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
-		value := x.AminoBinary
-		return protoreflect.ValueOfBytes(value)
+	case "cosmos.tx.v1beta1.GasPricesResponse.gas_prices":
+		if len(x.GasPrices) == 0 {
+			return protoreflect.ValueOfList(&_GasPricesResponse_1_list{})
+		}
+		listValue := &_GasPricesResponse_1_list{list: &x.GasPrices}
+		return protoreflect.ValueOfList(listValue)
+	case "cosmos.tx.v1beta1.GasPricesResponse.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GasPricesResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GasPricesResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8321,15 +12343,19 @@ func (x *fastReflection_TxDecodeAminoRequest) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeAminoRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GasPricesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
-		x.AminoBinary = value.Bytes()
+	case "cosmos.tx.v1beta1.GasPricesResponse.gas_prices":
+		lv := value.List()
+		clv := lv.(*_GasPricesResponse_1_list)
+		x.GasPrices = *clv.list
+	case "cosmos.tx.v1beta1.GasPricesResponse.height":
+		x.Height = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GasPricesResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GasPricesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8343,40 +12369,49 @@ func (x *fastReflection_TxDecodeAminoRequest) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeAminoRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GasPricesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
-		panic(fmt.Errorf("field amino_binary of message cosmos.tx.v1beta1.TxDecodeAminoRequest is not mutable"))
+	case "cosmos.tx.v1beta1.GasPricesResponse.gas_prices":
+		if x.GasPrices == nil {
+			x.GasPrices = []*GasPriceEstimate{}
+		}
+		value := &_GasPricesResponse_1_list{list: &x.GasPrices}
+		return protoreflect.ValueOfList(value)
+	case "cosmos.tx.v1beta1.GasPricesResponse.height":
+		panic(fmt.Errorf("field height of message cosmos.tx.v1beta1.GasPricesResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GasPricesResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GasPricesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TxDecodeAminoRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GasPricesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoRequest.amino_binary":
-		return protoreflect.ValueOfBytes(nil)
+	case "cosmos.tx.v1beta1.GasPricesResponse.gas_prices":
+		list := []*GasPriceEstimate{}
+		return protoreflect.ValueOfList(&_GasPricesResponse_1_list{list: &list})
+	case "cosmos.tx.v1beta1.GasPricesResponse.height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GasPricesResponse"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GasPricesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TxDecodeAminoRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GasPricesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxDecodeAminoRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.GasPricesResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8384,7 +12419,7 @@ func (x *fastReflection_TxDecodeAminoRequest) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TxDecodeAminoRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GasPricesResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8395,7 +12430,7 @@ func (x *fastReflection_TxDecodeAminoRequest) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeAminoRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GasPricesResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8407,7 +12442,7 @@ func (x *fastReflection_TxDecodeAminoRequest) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TxDecodeAminoRequest) IsValid() bool {
+func (x *fastReflection_GasPricesResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8417,9 +12452,9 @@ func (x *fastReflection_TxDecodeAminoRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TxDecodeAminoRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GasPricesResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TxDecodeAminoRequest)
+		x := input.Message.Interface().(*GasPricesResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8431,9 +12466,14 @@ func (x *fastReflection_TxDecodeAminoRequest) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.AminoBinary)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.GasPrices) > 0 {
+			for _, e := range x.GasPrices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -8445,7 +12485,7 @@ func (x *fastReflection_TxDecodeAminoRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TxDecodeAminoRequest)
+		x := input.Message.Interface().(*GasPricesResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8464,12 +12504,26 @@ func (x *fastReflection_TxDecodeAminoRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AminoBinary) > 0 {
-			i -= len(x.AminoBinary)
-			copy(dAtA[i:], x.AminoBinary)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AminoBinary)))
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x10
+		}
+		if len(x.GasPrices) > 0 {
+			for iNdEx := len(x.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.GasPrices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8482,7 +12536,7 @@ func (x *fastReflection_TxDecodeAminoRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TxDecodeAminoRequest)
+		x := input.Message.Interface().(*GasPricesResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8514,17 +12568,17 @@ func (x *fastReflection_TxDecodeAminoRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeAminoRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GasPricesResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeAminoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AminoBinary", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8534,26 +12588,45 @@ func (x *fastReflection_TxDecodeAminoRequest) ProtoMethods() *protoiface.Methods
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AminoBinary = append(x.AminoBinary[:0], dAtA[iNdEx:postIndex]...)
-				if x.AminoBinary == nil {
-					x.AminoBinary = []byte{}
+				x.GasPrices = append(x.GasPrices, &GasPriceEstimate{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GasPrices[len(x.GasPrices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8590,26 +12663,34 @@ func (x *fastReflection_TxDecodeAminoRequest) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_TxDecodeAminoResponse            protoreflect.MessageDescriptor
-	fd_TxDecodeAminoResponse_amino_json protoreflect.FieldDescriptor
+	md_GasPriceEstimate         protoreflect.MessageDescriptor
+	fd_GasPriceEstimate_denom   protoreflect.FieldDescriptor
+	fd_GasPriceEstimate_slow    protoreflect.FieldDescriptor
+	fd_GasPriceEstimate_normal  protoreflect.FieldDescriptor
+	fd_GasPriceEstimate_fast    protoreflect.FieldDescriptor
+	fd_GasPriceEstimate_samples protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_cosmos_tx_v1beta1_service_proto_init()
-	md_TxDecodeAminoResponse = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("TxDecodeAminoResponse")
-	fd_TxDecodeAminoResponse_amino_json = md_TxDecodeAminoResponse.Fields().ByName("amino_json")
+	md_GasPriceEstimate = File_cosmos_tx_v1beta1_service_proto.Messages().ByName("GasPriceEstimate")
+	fd_GasPriceEstimate_denom = md_GasPriceEstimate.Fields().ByName("denom")
+	fd_GasPriceEstimate_slow = md_GasPriceEstimate.Fields().ByName("slow")
+	fd_GasPriceEstimate_normal = md_GasPriceEstimate.Fields().ByName("normal")
+	fd_GasPriceEstimate_fast = md_GasPriceEstimate.Fields().ByName("fast")
+	fd_GasPriceEstimate_samples = md_GasPriceEstimate.Fields().ByName("samples")
 }
 
-var _ protoreflect.Message = (*fastReflection_TxDecodeAminoResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_GasPriceEstimate)(nil)
 
-type fastReflection_TxDecodeAminoResponse TxDecodeAminoResponse
+type fastReflection_GasPriceEstimate GasPriceEstimate
 
-func (x *TxDecodeAminoResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TxDecodeAminoResponse)(x)
+func (x *GasPriceEstimate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GasPriceEstimate)(x)
 }
 
-func (x *TxDecodeAminoResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[17]
+func (x *GasPriceEstimate) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8620,43 +12701,43 @@ func (x *TxDecodeAminoResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TxDecodeAminoResponse_messageType fastReflection_TxDecodeAminoResponse_messageType
-var _ protoreflect.MessageType = fastReflection_TxDecodeAminoResponse_messageType{}
+var _fastReflection_GasPriceEstimate_messageType fastReflection_GasPriceEstimate_messageType
+var _ protoreflect.MessageType = fastReflection_GasPriceEstimate_messageType{}
 
-type fastReflection_TxDecodeAminoResponse_messageType struct{}
+type fastReflection_GasPriceEstimate_messageType struct{}
 
-func (x fastReflection_TxDecodeAminoResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TxDecodeAminoResponse)(nil)
+func (x fastReflection_GasPriceEstimate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GasPriceEstimate)(nil)
 }
-func (x fastReflection_TxDecodeAminoResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_TxDecodeAminoResponse)
+func (x fastReflection_GasPriceEstimate_messageType) New() protoreflect.Message {
+	return new(fastReflection_GasPriceEstimate)
 }
-func (x fastReflection_TxDecodeAminoResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxDecodeAminoResponse
+func (x fastReflection_GasPriceEstimate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GasPriceEstimate
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TxDecodeAminoResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_TxDecodeAminoResponse
+func (x *fastReflection_GasPriceEstimate) Descriptor() protoreflect.MessageDescriptor {
+	return md_GasPriceEstimate
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TxDecodeAminoResponse) Type() protoreflect.MessageType {
-	return _fastReflection_TxDecodeAminoResponse_messageType
+func (x *fastReflection_GasPriceEstimate) Type() protoreflect.MessageType {
+	return _fastReflection_GasPriceEstimate_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TxDecodeAminoResponse) New() protoreflect.Message {
-	return new(fastReflection_TxDecodeAminoResponse)
+func (x *fastReflection_GasPriceEstimate) New() protoreflect.Message {
+	return new(fastReflection_GasPriceEstimate)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TxDecodeAminoResponse) Interface() protoreflect.ProtoMessage {
-	return (*TxDecodeAminoResponse)(x)
+func (x *fastReflection_GasPriceEstimate) Interface() protoreflect.ProtoMessage {
+	return (*GasPriceEstimate)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8664,10 +12745,34 @@ func (x *fastReflection_TxDecodeAminoResponse) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TxDecodeAminoResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.AminoJson != "" {
-		value := protoreflect.ValueOfString(x.AminoJson)
-		if !f(fd_TxDecodeAminoResponse_amino_json, value) {
+func (x *fastReflection_GasPriceEstimate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_GasPriceEstimate_denom, value) {
+			return
+		}
+	}
+	if x.Slow != "" {
+		value := protoreflect.ValueOfString(x.Slow)
+		if !f(fd_GasPriceEstimate_slow, value) {
+			return
+		}
+	}
+	if x.Normal != "" {
+		value := protoreflect.ValueOfString(x.Normal)
+		if !f(fd_GasPriceEstimate_normal, value) {
+			return
+		}
+	}
+	if x.Fast != "" {
+		value := protoreflect.ValueOfString(x.Fast)
+		if !f(fd_GasPriceEstimate_fast, value) {
+			return
+		}
+	}
+	if x.Samples != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Samples)
+		if !f(fd_GasPriceEstimate_samples, value) {
 			return
 		}
 	}
@@ -8684,15 +12789,23 @@ func (x *fastReflection_TxDecodeAminoResponse) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TxDecodeAminoResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_GasPriceEstimate) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
-		return x.AminoJson != ""
+	case "cosmos.tx.v1beta1.GasPriceEstimate.denom":
+		return x.Denom != ""
+	case "cosmos.tx.v1beta1.GasPriceEstimate.slow":
+		return x.Slow != ""
+	case "cosmos.tx.v1beta1.GasPriceEstimate.normal":
+		return x.Normal != ""
+	case "cosmos.tx.v1beta1.GasPriceEstimate.fast":
+		return x.Fast != ""
+	case "cosmos.tx.v1beta1.GasPriceEstimate.samples":
+		return x.Samples != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GasPriceEstimate"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GasPriceEstimate does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8702,15 +12815,23 @@ func (x *fastReflection_TxDecodeAminoResponse) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeAminoResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_GasPriceEstimate) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
-		x.AminoJson = ""
+	case "cosmos.tx.v1beta1.GasPriceEstimate.denom":
+		x.Denom = ""
+	case "cosmos.tx.v1beta1.GasPriceEstimate.slow":
+		x.Slow = ""
+	case "cosmos.tx.v1beta1.GasPriceEstimate.normal":
+		x.Normal = ""
+	case "cosmos.tx.v1beta1.GasPriceEstimate.fast":
+		x.Fast = ""
+	case "cosmos.tx.v1beta1.GasPriceEstimate.samples":
+		x.Samples = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GasPriceEstimate"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GasPriceEstimate does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8720,16 +12841,28 @@ func (x *fastReflection_TxDecodeAminoResponse) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TxDecodeAminoResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GasPriceEstimate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
-		value := x.AminoJson
+	case "cosmos.tx.v1beta1.GasPriceEstimate.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.tx.v1beta1.GasPriceEstimate.slow":
+		value := x.Slow
 		return protoreflect.ValueOfString(value)
+	case "cosmos.tx.v1beta1.GasPriceEstimate.normal":
+		value := x.Normal
+		return protoreflect.ValueOfString(value)
+	case "cosmos.tx.v1beta1.GasPriceEstimate.fast":
+		value := x.Fast
+		return protoreflect.ValueOfString(value)
+	case "cosmos.tx.v1beta1.GasPriceEstimate.samples":
+		value := x.Samples
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GasPriceEstimate"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GasPriceEstimate does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8743,15 +12876,23 @@ func (x *fastReflection_TxDecodeAminoResponse) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeAminoResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_GasPriceEstimate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
-		x.AminoJson = value.Interface().(string)
+	case "cosmos.tx.v1beta1.GasPriceEstimate.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.tx.v1beta1.GasPriceEstimate.slow":
+		x.Slow = value.Interface().(string)
+	case "cosmos.tx.v1beta1.GasPriceEstimate.normal":
+		x.Normal = value.Interface().(string)
+	case "cosmos.tx.v1beta1.GasPriceEstimate.fast":
+		x.Fast = value.Interface().(string)
+	case "cosmos.tx.v1beta1.GasPriceEstimate.samples":
+		x.Samples = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GasPriceEstimate"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GasPriceEstimate does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8765,40 +12906,56 @@ func (x *fastReflection_TxDecodeAminoResponse) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeAminoResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GasPriceEstimate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
-		panic(fmt.Errorf("field amino_json of message cosmos.tx.v1beta1.TxDecodeAminoResponse is not mutable"))
+	case "cosmos.tx.v1beta1.GasPriceEstimate.denom":
+		panic(fmt.Errorf("field denom of message cosmos.tx.v1beta1.GasPriceEstimate is not mutable"))
+	case "cosmos.tx.v1beta1.GasPriceEstimate.slow":
+		panic(fmt.Errorf("field slow of message cosmos.tx.v1beta1.GasPriceEstimate is not mutable"))
+	case "cosmos.tx.v1beta1.GasPriceEstimate.normal":
+		panic(fmt.Errorf("field normal of message cosmos.tx.v1beta1.GasPriceEstimate is not mutable"))
+	case "cosmos.tx.v1beta1.GasPriceEstimate.fast":
+		panic(fmt.Errorf("field fast of message cosmos.tx.v1beta1.GasPriceEstimate is not mutable"))
+	case "cosmos.tx.v1beta1.GasPriceEstimate.samples":
+		panic(fmt.Errorf("field samples of message cosmos.tx.v1beta1.GasPriceEstimate is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GasPriceEstimate"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GasPriceEstimate does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TxDecodeAminoResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_GasPriceEstimate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.tx.v1beta1.TxDecodeAminoResponse.amino_json":
+	case "cosmos.tx.v1beta1.GasPriceEstimate.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.tx.v1beta1.GasPriceEstimate.slow":
+		return protoreflect.ValueOfString("")
+	case "cosmos.tx.v1beta1.GasPriceEstimate.normal":
+		return protoreflect.ValueOfString("")
+	case "cosmos.tx.v1beta1.GasPriceEstimate.fast":
 		return protoreflect.ValueOfString("")
+	case "cosmos.tx.v1beta1.GasPriceEstimate.samples":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.TxDecodeAminoResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.tx.v1beta1.GasPriceEstimate"))
 		}
-		panic(fmt.Errorf("message cosmos.tx.v1beta1.TxDecodeAminoResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.tx.v1beta1.GasPriceEstimate does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TxDecodeAminoResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_GasPriceEstimate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.TxDecodeAminoResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.tx.v1beta1.GasPriceEstimate", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8806,7 +12963,7 @@ func (x *fastReflection_TxDecodeAminoResponse) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TxDecodeAminoResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_GasPriceEstimate) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8817,7 +12974,7 @@ func (x *fastReflection_TxDecodeAminoResponse) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TxDecodeAminoResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_GasPriceEstimate) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8829,7 +12986,7 @@ func (x *fastReflection_TxDecodeAminoResponse) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TxDecodeAminoResponse) IsValid() bool {
+func (x *fastReflection_GasPriceEstimate) IsValid() bool {
 	return x != nil
 }
 
@@ -8839,9 +12996,9 @@ func (x *fastReflection_TxDecodeAminoResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TxDecodeAminoResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_GasPriceEstimate) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TxDecodeAminoResponse)
+		x := input.Message.Interface().(*GasPriceEstimate)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8853,10 +13010,25 @@ func (x *fastReflection_TxDecodeAminoResponse) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.AminoJson)
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Slow)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Normal)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Fast)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.Samples != 0 {
+			n += 1 + runtime.Sov(uint64(x.Samples))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8867,7 +13039,7 @@ func (x *fastReflection_TxDecodeAminoResponse) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TxDecodeAminoResponse)
+		x := input.Message.Interface().(*GasPriceEstimate)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8886,10 +13058,36 @@ func (x *fastReflection_TxDecodeAminoResponse) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.AminoJson) > 0 {
-			i -= len(x.AminoJson)
-			copy(dAtA[i:], x.AminoJson)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AminoJson)))
+		if x.Samples != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Samples))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Fast) > 0 {
+			i -= len(x.Fast)
+			copy(dAtA[i:], x.Fast)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Fast)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Normal) > 0 {
+			i -= len(x.Normal)
+			copy(dAtA[i:], x.Normal)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Normal)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Slow) > 0 {
+			i -= len(x.Slow)
+			copy(dAtA[i:], x.Slow)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Slow)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -8904,7 +13102,7 @@ func (x *fastReflection_TxDecodeAminoResponse) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TxDecodeAminoResponse)
+		x := input.Message.Interface().(*GasPriceEstimate)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8923,28 +13121,92 @@ func (x *fastReflection_TxDecodeAminoResponse) ProtoMethods() *protoiface.Method
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GasPriceEstimate: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GasPriceEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Slow", wireType)
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeAminoResponse: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TxDecodeAminoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Slow = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AminoJson", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Normal", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8972,8 +13234,59 @@ func (x *fastReflection_TxDecodeAminoResponse) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AminoJson = string(dAtA[iNdEx:postIndex])
+				x.Normal = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fast", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fast = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+				}
+				x.Samples = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Samples |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9400,6 +13713,8 @@ type SimulateRequest struct {
 	Tx *Tx `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
 	// tx_bytes is the raw transaction.
 	TxBytes []byte `protobuf:"bytes,2,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// state_diff requests the state changes the transaction would make.
+	StateDiff bool `protobuf:"varint,3,opt,name=state_diff,json=stateDiff,proto3" json:"state_diff,omitempty"`
 }
 
 func (x *SimulateRequest) Reset() {
@@ -9437,6 +13752,13 @@ func (x *SimulateRequest) GetTxBytes() []byte {
 	return nil
 }
 
+func (x *SimulateRequest) GetStateDiff() bool {
+	if x != nil {
+		return x.StateDiff
+	}
+	return false
+}
+
 // SimulateResponse is the response type for the
 // Service.SimulateRPC method.
 type SimulateResponse struct {
@@ -9448,6 +13770,9 @@ type SimulateResponse struct {
 	GasInfo *v1beta11.GasInfo `protobuf:"bytes,1,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
 	// result is the result of the simulation.
 	Result *v1beta11.Result `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// state_diff is the state changes the transaction would make. It is only set
+	// if requested.
+	StateDiff *StateDiff `protobuf:"bytes,3,opt,name=state_diff,json=stateDiff,proto3" json:"state_diff,omitempty"`
 }
 
 func (x *SimulateResponse) Reset() {
@@ -9470,16 +13795,245 @@ func (*SimulateResponse) Descriptor() ([]byte, []int) {
 	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *SimulateResponse) GetGasInfo() *v1beta11.GasInfo {
+func (x *SimulateResponse) GetGasInfo() *v1beta11.GasInfo {
+	if x != nil {
+		return x.GasInfo
+	}
+	return nil
+}
+
+func (x *SimulateResponse) GetResult() *v1beta11.Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *SimulateResponse) GetStateDiff() *StateDiff {
+	if x != nil {
+		return x.StateDiff
+	}
+	return nil
+}
+
+// StateDiff defines the state changes a simulated transaction would make.
+type StateDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// stores are the writes to the KV stores, sorted by store name.
+	Stores []*StoreDiff `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"`
+	// balances are the coins sent and received per address, sorted by address,
+	// as reported by the coin_spent and coin_received events.
+	Balances []*BalanceDelta `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances,omitempty"`
+}
+
+func (x *StateDiff) Reset() {
+	*x = StateDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateDiff) ProtoMessage() {}
+
+// Deprecated: Use StateDiff.ProtoReflect.Descriptor instead.
+func (*StateDiff) Descriptor() ([]byte, []int) {
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StateDiff) GetStores() []*StoreDiff {
+	if x != nil {
+		return x.Stores
+	}
+	return nil
+}
+
+func (x *StateDiff) GetBalances() []*BalanceDelta {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+// StoreDiff defines the writes to a KV store.
+type StoreDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// store_name is the name of the KV store.
+	StoreName string `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	// writes are the final writes to the store, sorted by key.
+	Writes []*KVWrite `protobuf:"bytes,2,rep,name=writes,proto3" json:"writes,omitempty"`
+}
+
+func (x *StoreDiff) Reset() {
+	*x = StoreDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoreDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreDiff) ProtoMessage() {}
+
+// Deprecated: Use StoreDiff.ProtoReflect.Descriptor instead.
+func (*StoreDiff) Descriptor() ([]byte, []int) {
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StoreDiff) GetStoreName() string {
+	if x != nil {
+		return x.StoreName
+	}
+	return ""
+}
+
+func (x *StoreDiff) GetWrites() []*KVWrite {
+	if x != nil {
+		return x.Writes
+	}
+	return nil
+}
+
+// KVWrite defines a write to a KV store.
+type KVWrite struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// key is the written key.
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the written value, empty if the key is deleted.
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// delete is true if the key is deleted.
+	Delete bool `protobuf:"varint,3,opt,name=delete,proto3" json:"delete,omitempty"`
+	// collection is the name of the collection the key belongs to, if the store
+	// schema is known.
+	Collection string `protobuf:"bytes,4,opt,name=collection,proto3" json:"collection,omitempty"`
+	// decoded_value is the human-readable value, if the store schema is known.
+	DecodedValue string `protobuf:"bytes,5,opt,name=decoded_value,json=decodedValue,proto3" json:"decoded_value,omitempty"`
+}
+
+func (x *KVWrite) Reset() {
+	*x = KVWrite{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KVWrite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KVWrite) ProtoMessage() {}
+
+// Deprecated: Use KVWrite.ProtoReflect.Descriptor instead.
+func (*KVWrite) Descriptor() ([]byte, []int) {
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *KVWrite) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *KVWrite) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *KVWrite) GetDelete() bool {
+	if x != nil {
+		return x.Delete
+	}
+	return false
+}
+
+func (x *KVWrite) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *KVWrite) GetDecodedValue() string {
+	if x != nil {
+		return x.DecodedValue
+	}
+	return ""
+}
+
+// BalanceDelta defines the coins sent and received by an address.
+type BalanceDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// sent are the coins sent by the account.
+	Sent []*v1beta12.Coin `protobuf:"bytes,2,rep,name=sent,proto3" json:"sent,omitempty"`
+	// received are the coins received by the account.
+	Received []*v1beta12.Coin `protobuf:"bytes,3,rep,name=received,proto3" json:"received,omitempty"`
+}
+
+func (x *BalanceDelta) Reset() {
+	*x = BalanceDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceDelta) ProtoMessage() {}
+
+// Deprecated: Use BalanceDelta.ProtoReflect.Descriptor instead.
+func (*BalanceDelta) Descriptor() ([]byte, []int) {
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BalanceDelta) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *BalanceDelta) GetSent() []*v1beta12.Coin {
 	if x != nil {
-		return x.GasInfo
+		return x.Sent
 	}
 	return nil
 }
 
-func (x *SimulateResponse) GetResult() *v1beta11.Result {
+func (x *BalanceDelta) GetReceived() []*v1beta12.Coin {
 	if x != nil {
-		return x.Result
+		return x.Received
 	}
 	return nil
 }
@@ -9498,7 +14052,7 @@ type GetTxRequest struct {
 func (x *GetTxRequest) Reset() {
 	*x = GetTxRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[6]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9512,7 +14066,7 @@ func (*GetTxRequest) ProtoMessage() {}
 
 // Deprecated: Use GetTxRequest.ProtoReflect.Descriptor instead.
 func (*GetTxRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{6}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetTxRequest) GetHash() string {
@@ -9537,7 +14091,7 @@ type GetTxResponse struct {
 func (x *GetTxResponse) Reset() {
 	*x = GetTxResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[7]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9551,7 +14105,7 @@ func (*GetTxResponse) ProtoMessage() {}
 
 // Deprecated: Use GetTxResponse.ProtoReflect.Descriptor instead.
 func (*GetTxResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{7}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetTxResponse) GetTx() *Tx {
@@ -9584,7 +14138,7 @@ type GetBlockWithTxsRequest struct {
 func (x *GetBlockWithTxsRequest) Reset() {
 	*x = GetBlockWithTxsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[8]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9598,7 +14152,7 @@ func (*GetBlockWithTxsRequest) ProtoMessage() {}
 
 // Deprecated: Use GetBlockWithTxsRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockWithTxsRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{8}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetBlockWithTxsRequest) GetHeight() int64 {
@@ -9633,7 +14187,7 @@ type GetBlockWithTxsResponse struct {
 func (x *GetBlockWithTxsResponse) Reset() {
 	*x = GetBlockWithTxsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[9]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9647,7 +14201,7 @@ func (*GetBlockWithTxsResponse) ProtoMessage() {}
 
 // Deprecated: Use GetBlockWithTxsResponse.ProtoReflect.Descriptor instead.
 func (*GetBlockWithTxsResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{9}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetBlockWithTxsResponse) GetTxs() []*Tx {
@@ -9692,7 +14246,7 @@ type TxDecodeRequest struct {
 func (x *TxDecodeRequest) Reset() {
 	*x = TxDecodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[10]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9706,7 +14260,7 @@ func (*TxDecodeRequest) ProtoMessage() {}
 
 // Deprecated: Use TxDecodeRequest.ProtoReflect.Descriptor instead.
 func (*TxDecodeRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{10}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *TxDecodeRequest) GetTxBytes() []byte {
@@ -9730,7 +14284,7 @@ type TxDecodeResponse struct {
 func (x *TxDecodeResponse) Reset() {
 	*x = TxDecodeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[11]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9744,7 +14298,7 @@ func (*TxDecodeResponse) ProtoMessage() {}
 
 // Deprecated: Use TxDecodeResponse.ProtoReflect.Descriptor instead.
 func (*TxDecodeResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{11}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *TxDecodeResponse) GetTx() *Tx {
@@ -9768,7 +14322,7 @@ type TxEncodeRequest struct {
 func (x *TxEncodeRequest) Reset() {
 	*x = TxEncodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[12]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9782,7 +14336,7 @@ func (*TxEncodeRequest) ProtoMessage() {}
 
 // Deprecated: Use TxEncodeRequest.ProtoReflect.Descriptor instead.
 func (*TxEncodeRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{12}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *TxEncodeRequest) GetTx() *Tx {
@@ -9806,7 +14360,7 @@ type TxEncodeResponse struct {
 func (x *TxEncodeResponse) Reset() {
 	*x = TxEncodeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[13]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9820,7 +14374,7 @@ func (*TxEncodeResponse) ProtoMessage() {}
 
 // Deprecated: Use TxEncodeResponse.ProtoReflect.Descriptor instead.
 func (*TxEncodeResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{13}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *TxEncodeResponse) GetTxBytes() []byte {
@@ -9843,7 +14397,7 @@ type TxEncodeAminoRequest struct {
 func (x *TxEncodeAminoRequest) Reset() {
 	*x = TxEncodeAminoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[14]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9857,7 +14411,7 @@ func (*TxEncodeAminoRequest) ProtoMessage() {}
 
 // Deprecated: Use TxEncodeAminoRequest.ProtoReflect.Descriptor instead.
 func (*TxEncodeAminoRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{14}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *TxEncodeAminoRequest) GetAminoJson() string {
@@ -9880,7 +14434,7 @@ type TxEncodeAminoResponse struct {
 func (x *TxEncodeAminoResponse) Reset() {
 	*x = TxEncodeAminoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[15]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9894,7 +14448,7 @@ func (*TxEncodeAminoResponse) ProtoMessage() {}
 
 // Deprecated: Use TxEncodeAminoResponse.ProtoReflect.Descriptor instead.
 func (*TxEncodeAminoResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{15}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *TxEncodeAminoResponse) GetAminoBinary() []byte {
@@ -9917,7 +14471,7 @@ type TxDecodeAminoRequest struct {
 func (x *TxDecodeAminoRequest) Reset() {
 	*x = TxDecodeAminoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[16]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9931,7 +14485,7 @@ func (*TxDecodeAminoRequest) ProtoMessage() {}
 
 // Deprecated: Use TxDecodeAminoRequest.ProtoReflect.Descriptor instead.
 func (*TxDecodeAminoRequest) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{16}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *TxDecodeAminoRequest) GetAminoBinary() []byte {
@@ -9954,7 +14508,7 @@ type TxDecodeAminoResponse struct {
 func (x *TxDecodeAminoResponse) Reset() {
 	*x = TxDecodeAminoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[17]
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9968,7 +14522,7 @@ func (*TxDecodeAminoResponse) ProtoMessage() {}
 
 // Deprecated: Use TxDecodeAminoResponse.ProtoReflect.Descriptor instead.
 func (*TxDecodeAminoResponse) Descriptor() ([]byte, []int) {
-	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{17}
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *TxDecodeAminoResponse) GetAminoJson() string {
@@ -9978,267 +14532,527 @@ func (x *TxDecodeAminoResponse) GetAminoJson() string {
 	return ""
 }
 
+// GasPricesRequest is the request type for the Service.GasPrices
+// RPC method.
+type GasPricesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom restricts the recommendations to the given fee denom. All the fee
+	// denoms paid in the recent blocks are returned if empty.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (x *GasPricesRequest) Reset() {
+	*x = GasPricesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GasPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GasPricesRequest) ProtoMessage() {}
+
+// Deprecated: Use GasPricesRequest.ProtoReflect.Descriptor instead.
+func (*GasPricesRequest) Descriptor() ([]byte, []int) {
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GasPricesRequest) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+// GasPricesResponse is the response type for the Service.GasPrices
+// RPC method.
+type GasPricesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// gas_prices are the gas price recommendations, one per fee denom.
+	GasPrices []*GasPriceEstimate `protobuf:"bytes,1,rep,name=gas_prices,json=gasPrices,proto3" json:"gas_prices,omitempty"`
+	// height is the height of the latest block the recommendations are computed
+	// from.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *GasPricesResponse) Reset() {
+	*x = GasPricesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GasPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GasPricesResponse) ProtoMessage() {}
+
+// Deprecated: Use GasPricesResponse.ProtoReflect.Descriptor instead.
+func (*GasPricesResponse) Descriptor() ([]byte, []int) {
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GasPricesResponse) GetGasPrices() []*GasPriceEstimate {
+	if x != nil {
+		return x.GasPrices
+	}
+	return nil
+}
+
+func (x *GasPricesResponse) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// GasPriceEstimate defines the gas price recommendations for a fee denom. The
+// gas price of a tx is its fee divided by its gas limit.
+type GasPriceEstimate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom is the fee denom the gas prices are expressed in.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// slow is the 25th percentile of the gas prices paid in the recent blocks.
+	Slow string `protobuf:"bytes,2,opt,name=slow,proto3" json:"slow,omitempty"`
+	// normal is the median of the gas prices paid in the recent blocks.
+	Normal string `protobuf:"bytes,3,opt,name=normal,proto3" json:"normal,omitempty"`
+	// fast is the 90th percentile of the gas prices paid in the recent blocks.
+	Fast string `protobuf:"bytes,4,opt,name=fast,proto3" json:"fast,omitempty"`
+	// samples is the number of txs of the recent blocks paying fees in denom.
+	Samples uint64 `protobuf:"varint,5,opt,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *GasPriceEstimate) Reset() {
+	*x = GasPriceEstimate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_tx_v1beta1_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GasPriceEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GasPriceEstimate) ProtoMessage() {}
+
+// Deprecated: Use GasPriceEstimate.ProtoReflect.Descriptor instead.
+func (*GasPriceEstimate) Descriptor() ([]byte, []int) {
+	return file_cosmos_tx_v1beta1_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GasPriceEstimate) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *GasPriceEstimate) GetSlow() string {
+	if x != nil {
+		return x.Slow
+	}
+	return ""
+}
+
+func (x *GasPriceEstimate) GetNormal() string {
+	if x != nil {
+		return x.Normal
+	}
+	return ""
+}
+
+func (x *GasPriceEstimate) GetFast() string {
+	if x != nil {
+		return x.Fast
+	}
+	return ""
+}
+
+func (x *GasPriceEstimate) GetSamples() uint64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
 var File_cosmos_tx_v1beta1_service_proto protoreflect.FileDescriptor
 
 var file_cosmos_tx_v1beta1_service_proto_rawDesc = []byte{
 	0x0a, 0x1f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65,
 	0x74, 0x61, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x11, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f,
-	0x61, 0x62, 0x63, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x61, 0x62, 0x63,
-	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
-	0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x78, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65,
-	0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1d, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f,
-	0x76, 0x32, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d,
-	0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76,
-	0x32, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x54, 0x78, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42,
-	0x02, 0x18, 0x01, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x4a, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x42, 0x79, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xda, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x35, 0x30, 0x52, 0x05, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x22, 0xea, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x54, 0x78, 0x73, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x03, 0x74,
-	0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52,
-	0x03, 0x74, 0x78, 0x73, 0x12, 0x47, 0x0a, 0x0c, 0x74, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x0b, 0x74, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x12, 0x4b, 0x0a,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x22, 0x65, 0x0a, 0x12, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x78, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65,
-	0x73, 0x12, 0x34, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65,
-	0x74, 0x61, 0x31, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64,
-	0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x5c, 0x0a, 0x13, 0x42, 0x72, 0x6f, 0x61, 0x64,
-	0x63, 0x61, 0x73, 0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45,
-	0x0a, 0x0b, 0x74, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54,
-	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x74, 0x78, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6c, 0x0a, 0x0f, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x42, 0x02, 0x18, 0x01, 0x52,
-	0x02, 0x74, 0x78, 0x12, 0x2e, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x13, 0xda, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x33, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x10, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x67,
-	0x61, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x22, 0x22, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x22, 0x7d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x02, 0x74, 0x78, 0x12, 0x45, 0x0a, 0x0b,
-	0x74, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x74, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x57, 0x69, 0x74, 0x68, 0x54, 0x78, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x15,
-	0xd2, 0xb4, 0x2d, 0x11, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30,
-	0x2e, 0x34, 0x35, 0x2e, 0x32, 0x22, 0x89, 0x02, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x74, 0x61, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2f, 0x74, 0x78, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2f, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61,
+	0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88,
+	0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x78, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x4a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a,
+	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x42, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x29,
+	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xda,
+	0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e,
+	0x35, 0x30, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0xea, 0x01, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x54, 0x78, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x27, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63,
-	0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32,
-	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
-	0x64, 0x12, 0x2e, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2e, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x15, 0xd2, 0xb4, 0x2d, 0x11,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x35, 0x2e,
-	0x32, 0x22, 0x41, 0x0a, 0x0f, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x3a,
-	0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20,
-	0x30, 0x2e, 0x34, 0x37, 0x22, 0x4e, 0x0a, 0x10, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x02, 0x74, 0x78, 0x3a,
+	0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x47, 0x0a, 0x0c, 0x74, 0x78,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61,
+	0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0b, 0x74, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x65, 0x0a, 0x12, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74,
+	0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x5c, 0x0a,
+	0x13, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0b, 0x74, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x0a, 0x74, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x0f,
+	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x29, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x54, 0x78, 0x42, 0x02, 0x18, 0x01, 0x52, 0x02, 0x74, 0x78, 0x12, 0x2e, 0x0a, 0x08, 0x74, 0x78,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x13, 0xda, 0xb4,
+	0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34,
+	0x33, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x13,
+	0xda, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30,
+	0x2e, 0x35, 0x34, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x22, 0xdc,
+	0x01, 0x0a, 0x10, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x47, 0x61, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x67, 0x61, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x38, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x50, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x42, 0x13, 0xda,
+	0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e,
+	0x35, 0x34, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x22, 0x9f, 0x01,
+	0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x3a, 0x0a, 0x06, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x69, 0x66, 0x66, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x06, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x35, 0x34, 0x22,
+	0x79, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x4b, 0x56, 0x57, 0x72, 0x69, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x73, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x35, 0x34, 0x22, 0xa3, 0x01, 0x0a, 0x07, 0x4b,
+	0x56, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x13, 0xd2, 0xb4, 0x2d,
+	0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x35, 0x34,
+	0x22, 0xa1, 0x02, 0x0a, 0x0c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x6c, 0x74,
+	0x61, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x5f, 0x0a, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30,
+	0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73,
+	0x52, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x67, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x3a,
 	0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20,
-	0x30, 0x2e, 0x34, 0x37, 0x22, 0x4d, 0x0a, 0x0f, 0x54, 0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x02, 0x74, 0x78, 0x3a, 0x13,
-	0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30,
-	0x2e, 0x34, 0x37, 0x22, 0x42, 0x0a, 0x10, 0x54, 0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62, 0x79,
-	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
-	0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x37, 0x22, 0x4a, 0x0a, 0x14, 0x54, 0x78, 0x45, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x4a, 0x73, 0x6f, 0x6e, 0x3a, 0x13,
-	0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30,
-	0x2e, 0x34, 0x37, 0x22, 0x4f, 0x0a, 0x15, 0x54, 0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x41,
-	0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c,
-	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0b, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x3a,
+	0x30, 0x2e, 0x35, 0x34, 0x22, 0x22, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x7d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54,
+	0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x02, 0x74, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74,
+	0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x02, 0x74, 0x78,
+	0x12, 0x45, 0x0a, 0x0b, 0x74, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x61, 0x62, 0x63, 0x69, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x74, 0x78, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x54, 0x78, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x3a, 0x15, 0xd2, 0xb4, 0x2d, 0x11, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x35, 0x2e, 0x32, 0x22, 0x89, 0x02, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x35,
+	0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x07, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6f, 0x6d, 0x65, 0x74, 0x62, 0x66, 0x74, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x15,
+	0xd2, 0xb4, 0x2d, 0x11, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30,
+	0x2e, 0x34, 0x35, 0x2e, 0x32, 0x22, 0x41, 0x0a, 0x0f, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x37, 0x22, 0x4e, 0x0a, 0x10, 0x54, 0x78, 0x44, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x02,
+	0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52,
+	0x02, 0x74, 0x78, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x37, 0x22, 0x4d, 0x0a, 0x0f, 0x54, 0x78, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x02, 0x74,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x52, 0x02,
+	0x74, 0x78, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x37, 0x22, 0x42, 0x0a, 0x10, 0x54, 0x78, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74,
+	0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x37, 0x22, 0x4a, 0x0a, 0x14, 0x54,
+	0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x5f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x4a, 0x73,
+	0x6f, 0x6e, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x37, 0x22, 0x4f, 0x0a, 0x15, 0x54, 0x78, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x37, 0x22, 0x4e, 0x0a, 0x14, 0x54, 0x78, 0x44, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x37, 0x22, 0x4b, 0x0a, 0x15, 0x54, 0x78, 0x44, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x4a, 0x73, 0x6f, 0x6e,
+	0x3a, 0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x20, 0x30, 0x2e, 0x34, 0x37, 0x22, 0x3d, 0x0a, 0x10, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x3a,
 	0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20,
-	0x30, 0x2e, 0x34, 0x37, 0x22, 0x4e, 0x0a, 0x14, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
-	0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
-	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0b, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x3a,
+	0x30, 0x2e, 0x35, 0x34, 0x22, 0x8a, 0x01, 0x0a, 0x11, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x67, 0x61,
+	0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x67, 0x61, 0x73, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x13, 0xd2, 0xb4,
+	0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x35,
+	0x34, 0x22, 0xb0, 0x02, 0x0a, 0x10, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x45, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x45, 0x0a, 0x04,
+	0x73, 0x6c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2,
+	0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x04, 0x73,
+	0x6c, 0x6f, 0x77, 0x12, 0x49, 0x0a, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x12, 0x45,
+	0x0a, 0x04, 0x66, 0x61, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52,
+	0x04, 0x66, 0x61, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x3a,
 	0x13, 0xd2, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20,
-	0x30, 0x2e, 0x34, 0x37, 0x22, 0x4b, 0x0a, 0x15, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
-	0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x4a, 0x73, 0x6f, 0x6e, 0x3a, 0x13, 0xd2, 0xb4,
-	0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34,
-	0x37, 0x2a, 0x48, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x18, 0x0a, 0x14,
-	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
-	0x42, 0x59, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x52, 0x44, 0x45,
-	0x52, 0x5f, 0x42, 0x59, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x02, 0x2a, 0x80, 0x01, 0x0a, 0x0d,
-	0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a,
-	0x1a, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a,
-	0x14, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
-	0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x01, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x42,
-	0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x59,
-	0x4e, 0x43, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53,
-	0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x03, 0x32, 0x8d,
-	0x0a, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7b, 0x0a, 0x08, 0x53, 0x69,
-	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53,
-	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x73,
-	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x71, 0x0a, 0x05, 0x47, 0x65, 0x74, 0x54, 0x78,
-	0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
-	0x74, 0x78, 0x73, 0x2f, 0x7b, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x7f, 0x0a, 0x0b, 0x42, 0x72,
-	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x78, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x72,
-	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x78,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
-	0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x78, 0x73, 0x12, 0x7c, 0x0a, 0x0b, 0x47,
-	0x65, 0x74, 0x54, 0x78, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x54, 0x78, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x73, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x18, 0x12, 0x16, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x78, 0x73, 0x12, 0xac, 0x01, 0x0a, 0x0f, 0x47, 0x65,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x54, 0x78, 0x73, 0x12, 0x29, 0x2e,
+	0x30, 0x2e, 0x35, 0x34, 0x2a, 0x48, 0x0a, 0x07, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12,
+	0x18, 0x0a, 0x14, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x42, 0x59, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x42, 0x59, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x02, 0x2a, 0x80,
+	0x01, 0x0a, 0x0d, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x1e, 0x0a, 0x1a, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1c, 0x0a, 0x14, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x01, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x17,
+	0x0a, 0x13, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x42, 0x52, 0x4f, 0x41, 0x44,
+	0x43, 0x41, 0x53, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x53, 0x59, 0x4e, 0x43, 0x10,
+	0x03, 0x32, 0xa0, 0x0b, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7b, 0x0a,
+	0x08, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x53, 0x69,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x71, 0x0a, 0x05, 0x47, 0x65,
+	0x74, 0x54, 0x78, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x74, 0x78, 0x73, 0x2f, 0x7b, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x7f, 0x0a,
+	0x0b, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x78, 0x12, 0x25, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x54, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x78, 0x73, 0x12, 0x7c,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x78, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x54, 0x78,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x54, 0x78, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0xca, 0xb4, 0x2d, 0x11, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x35, 0x2e, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x27, 0x12, 0x25, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x78, 0x73, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f,
-	0x7b, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x7d, 0x12, 0x8c, 0x01, 0x0a, 0x08, 0x54, 0x78, 0x44,
-	0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74,
-	0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78,
-	0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x78, 0x73, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x78, 0x73, 0x12, 0xac, 0x01, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x54, 0x78, 0x73,
+	0x12, 0x29, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x69, 0x74,
+	0x68, 0x54, 0x78, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x57, 0x69, 0x74, 0x68, 0x54, 0x78, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0xca, 0xb4, 0x2d, 0x11, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x35, 0x2e, 0x32, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78,
+	0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x74, 0x78, 0x73, 0x2f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2f, 0x7b, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x7d, 0x12, 0x8c, 0x01, 0x0a, 0x08,
+	0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x44,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x37, 0xca, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x20, 0x30, 0x2e, 0x34, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22,
+	0x19, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x08, 0x54,
+	0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x54, 0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x37, 0xca, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b,
+	0x20, 0x30, 0x2e, 0x34, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0xa1, 0x01, 0x0a, 0x0d, 0x54, 0x78,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x12, 0x27, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x54, 0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d,
 	0xca, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30,
-	0x2e, 0x34, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x63,
+	0x2e, 0x34, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x8c, 0x01, 0x0a, 0x08, 0x54, 0x78, 0x45, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0xca,
-	0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e,
-	0x34, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
-	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0xa1, 0x01, 0x0a, 0x0d, 0x54, 0x78, 0x45, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31,
-	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d,
-	0x69, 0x6e, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0xca, 0xb4, 0x2d,
-	0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x37,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x65, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x12, 0xa1, 0x01, 0x0a, 0x0d, 0x54,
-	0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x12, 0x27, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0x2e, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74,
-	0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f,
-	0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x3d, 0xca, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20,
-	0x30, 0x2e, 0x34, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
-	0x31, 0x2f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x42, 0xb9,
-	0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x3b, 0x74, 0x78, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x54, 0x58, 0xaa, 0x02, 0x11, 0x43,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x54, 0x78, 0x2e, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
-	0xca, 0x02, 0x11, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x54, 0x78, 0x5c, 0x56, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1d, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x54, 0x78,
-	0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x54,
-	0x78, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x2f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x12, 0xa1, 0x01,
+	0x0a, 0x0d, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x12,
+	0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x54, 0x78, 0x44,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3d, 0xca, 0xb4, 0x2d, 0x0f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x20, 0x30, 0x2e, 0x34, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a,
+	0x22, 0x1f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x2f, 0x61, 0x6d, 0x69, 0x6e,
+	0x6f, 0x12, 0x90, 0x01, 0x0a, 0x09, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12,
+	0x23, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0xca, 0xb4, 0x2d, 0x0f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x20, 0x30, 0x2e, 0x35, 0x34, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74,
+	0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x42, 0xb9, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x74, 0x78, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x42, 0x0c,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2c,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x74, 0x78, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x3b, 0x74, 0x78, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xa2, 0x02, 0x03, 0x43,
+	0x54, 0x58, 0xaa, 0x02, 0x11, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x54, 0x78, 0x2e, 0x56,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xca, 0x02, 0x11, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c,
+	0x54, 0x78, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0xe2, 0x02, 0x1d, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5c, 0x54, 0x78, 0x5c, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x54, 0x78, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -10254,7 +15068,7 @@ func file_cosmos_tx_v1beta1_service_proto_rawDescGZIP() []byte {
 }
 
 var file_cosmos_tx_v1beta1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_cosmos_tx_v1beta1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_cosmos_tx_v1beta1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_cosmos_tx_v1beta1_service_proto_goTypes = []interface{}{
 	(OrderBy)(0),                    // 0: cosmos.tx.v1beta1.OrderBy
 	(BroadcastMode)(0),              // 1: cosmos.tx.v1beta1.BroadcastMode
@@ -10264,70 +15078,87 @@ var file_cosmos_tx_v1beta1_service_proto_goTypes = []interface{}{
 	(*BroadcastTxResponse)(nil),     // 5: cosmos.tx.v1beta1.BroadcastTxResponse
 	(*SimulateRequest)(nil),         // 6: cosmos.tx.v1beta1.SimulateRequest
 	(*SimulateResponse)(nil),        // 7: cosmos.tx.v1beta1.SimulateResponse
-	(*GetTxRequest)(nil),            // 8: cosmos.tx.v1beta1.GetTxRequest
-	(*GetTxResponse)(nil),           // 9: cosmos.tx.v1beta1.GetTxResponse
-	(*GetBlockWithTxsRequest)(nil),  // 10: cosmos.tx.v1beta1.GetBlockWithTxsRequest
-	(*GetBlockWithTxsResponse)(nil), // 11: cosmos.tx.v1beta1.GetBlockWithTxsResponse
-	(*TxDecodeRequest)(nil),         // 12: cosmos.tx.v1beta1.TxDecodeRequest
-	(*TxDecodeResponse)(nil),        // 13: cosmos.tx.v1beta1.TxDecodeResponse
-	(*TxEncodeRequest)(nil),         // 14: cosmos.tx.v1beta1.TxEncodeRequest
-	(*TxEncodeResponse)(nil),        // 15: cosmos.tx.v1beta1.TxEncodeResponse
-	(*TxEncodeAminoRequest)(nil),    // 16: cosmos.tx.v1beta1.TxEncodeAminoRequest
-	(*TxEncodeAminoResponse)(nil),   // 17: cosmos.tx.v1beta1.TxEncodeAminoResponse
-	(*TxDecodeAminoRequest)(nil),    // 18: cosmos.tx.v1beta1.TxDecodeAminoRequest
-	(*TxDecodeAminoResponse)(nil),   // 19: cosmos.tx.v1beta1.TxDecodeAminoResponse
-	(*v1beta1.PageRequest)(nil),     // 20: cosmos.base.query.v1beta1.PageRequest
-	(*Tx)(nil),                      // 21: cosmos.tx.v1beta1.Tx
-	(*v1beta11.TxResponse)(nil),     // 22: cosmos.base.abci.v1beta1.TxResponse
-	(*v1beta1.PageResponse)(nil),    // 23: cosmos.base.query.v1beta1.PageResponse
-	(*v1beta11.GasInfo)(nil),        // 24: cosmos.base.abci.v1beta1.GasInfo
-	(*v1beta11.Result)(nil),         // 25: cosmos.base.abci.v1beta1.Result
-	(*v2.BlockID)(nil),              // 26: cometbft.types.v2.BlockID
-	(*v2.Block)(nil),                // 27: cometbft.types.v2.Block
+	(*StateDiff)(nil),               // 8: cosmos.tx.v1beta1.StateDiff
+	(*StoreDiff)(nil),               // 9: cosmos.tx.v1beta1.StoreDiff
+	(*KVWrite)(nil),                 // 10: cosmos.tx.v1beta1.KVWrite
+	(*BalanceDelta)(nil),            // 11: cosmos.tx.v1beta1.BalanceDelta
+	(*GetTxRequest)(nil),            // 12: cosmos.tx.v1beta1.GetTxRequest
+	(*GetTxResponse)(nil),           // 13: cosmos.tx.v1beta1.GetTxResponse
+	(*GetBlockWithTxsRequest)(nil),  // 14: cosmos.tx.v1beta1.GetBlockWithTxsRequest
+	(*GetBlockWithTxsResponse)(nil), // 15: cosmos.tx.v1beta1.GetBlockWithTxsResponse
+	(*TxDecodeRequest)(nil),         // 16: cosmos.tx.v1beta1.TxDecodeRequest
+	(*TxDecodeResponse)(nil),        // 17: cosmos.tx.v1beta1.TxDecodeResponse
+	(*TxEncodeRequest)(nil),         // 18: cosmos.tx.v1beta1.TxEncodeRequest
+	(*TxEncodeResponse)(nil),        // 19: cosmos.tx.v1beta1.TxEncodeResponse
+	(*TxEncodeAminoRequest)(nil),    // 20: cosmos.tx.v1beta1.TxEncodeAminoRequest
+	(*TxEncodeAminoResponse)(nil),   // 21: cosmos.tx.v1beta1.TxEncodeAminoResponse
+	(*TxDecodeAminoRequest)(nil),    // 22: cosmos.tx.v1beta1.TxDecodeAminoRequest
+	(*TxDecodeAminoResponse)(nil),   // 23: cosmos.tx.v1beta1.TxDecodeAminoResponse
+	(*GasPricesRequest)(nil),        // 24: cosmos.tx.v1beta1.GasPricesRequest
+	(*GasPricesResponse)(nil),       // 25: cosmos.tx.v1beta1.GasPricesResponse
+	(*GasPriceEstimate)(nil),        // 26: cosmos.tx.v1beta1.GasPriceEstimate
+	(*v1beta1.PageRequest)(nil),     // 27: cosmos.base.query.v1beta1.PageRequest
+	(*Tx)(nil),                      // 28: cosmos.tx.v1beta1.Tx
+	(*v1beta11.TxResponse)(nil),     // 29: cosmos.base.abci.v1beta1.TxResponse
+	(*v1beta1.PageResponse)(nil),    // 30: cosmos.base.query.v1beta1.PageResponse
+	(*v1beta11.GasInfo)(nil),        // 31: cosmos.base.abci.v1beta1.GasInfo
+	(*v1beta11.Result)(nil),         // 32: cosmos.base.abci.v1beta1.Result
+	(*v1beta12.Coin)(nil),           // 33: cosmos.base.v1beta1.Coin
+	(*v2.BlockID)(nil),              // 34: cometbft.types.v2.BlockID
+	(*v2.Block)(nil),                // 35: cometbft.types.v2.Block
 }
 var file_cosmos_tx_v1beta1_service_proto_depIdxs = []int32{
-	20, // 0: cosmos.tx.v1beta1.GetTxsEventRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	27, // 0: cosmos.tx.v1beta1.GetTxsEventRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
 	0,  // 1: cosmos.tx.v1beta1.GetTxsEventRequest.order_by:type_name -> cosmos.tx.v1beta1.OrderBy
-	21, // 2: cosmos.tx.v1beta1.GetTxsEventResponse.txs:type_name -> cosmos.tx.v1beta1.Tx
-	22, // 3: cosmos.tx.v1beta1.GetTxsEventResponse.tx_responses:type_name -> cosmos.base.abci.v1beta1.TxResponse
-	23, // 4: cosmos.tx.v1beta1.GetTxsEventResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	28, // 2: cosmos.tx.v1beta1.GetTxsEventResponse.txs:type_name -> cosmos.tx.v1beta1.Tx
+	29, // 3: cosmos.tx.v1beta1.GetTxsEventResponse.tx_responses:type_name -> cosmos.base.abci.v1beta1.TxResponse
+	30, // 4: cosmos.tx.v1beta1.GetTxsEventResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
 	1,  // 5: cosmos.tx.v1beta1.BroadcastTxRequest.mode:type_name -> cosmos.tx.v1beta1.BroadcastMode
-	22, // 6: cosmos.tx.v1beta1.BroadcastTxResponse.tx_response:type_name -> cosmos.base.abci.v1beta1.TxResponse
-	21, // 7: cosmos.tx.v1beta1.SimulateRequest.tx:type_name -> cosmos.tx.v1beta1.Tx
-	24, // 8: cosmos.tx.v1beta1.SimulateResponse.gas_info:type_name -> cosmos.base.abci.v1beta1.GasInfo
-	25, // 9: cosmos.tx.v1beta1.SimulateResponse.result:type_name -> cosmos.base.abci.v1beta1.Result
-	21, // 10: cosmos.tx.v1beta1.GetTxResponse.tx:type_name -> cosmos.tx.v1beta1.Tx
-	22, // 11: cosmos.tx.v1beta1.GetTxResponse.tx_response:type_name -> cosmos.base.abci.v1beta1.TxResponse
-	20, // 12: cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	21, // 13: cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs:type_name -> cosmos.tx.v1beta1.Tx
-	26, // 14: cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id:type_name -> cometbft.types.v2.BlockID
-	27, // 15: cosmos.tx.v1beta1.GetBlockWithTxsResponse.block:type_name -> cometbft.types.v2.Block
-	23, // 16: cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	21, // 17: cosmos.tx.v1beta1.TxDecodeResponse.tx:type_name -> cosmos.tx.v1beta1.Tx
-	21, // 18: cosmos.tx.v1beta1.TxEncodeRequest.tx:type_name -> cosmos.tx.v1beta1.Tx
-	6,  // 19: cosmos.tx.v1beta1.Service.Simulate:input_type -> cosmos.tx.v1beta1.SimulateRequest
-	8,  // 20: cosmos.tx.v1beta1.Service.GetTx:input_type -> cosmos.tx.v1beta1.GetTxRequest
-	4,  // 21: cosmos.tx.v1beta1.Service.BroadcastTx:input_type -> cosmos.tx.v1beta1.BroadcastTxRequest
-	2,  // 22: cosmos.tx.v1beta1.Service.GetTxsEvent:input_type -> cosmos.tx.v1beta1.GetTxsEventRequest
-	10, // 23: cosmos.tx.v1beta1.Service.GetBlockWithTxs:input_type -> cosmos.tx.v1beta1.GetBlockWithTxsRequest
-	12, // 24: cosmos.tx.v1beta1.Service.TxDecode:input_type -> cosmos.tx.v1beta1.TxDecodeRequest
-	14, // 25: cosmos.tx.v1beta1.Service.TxEncode:input_type -> cosmos.tx.v1beta1.TxEncodeRequest
-	16, // 26: cosmos.tx.v1beta1.Service.TxEncodeAmino:input_type -> cosmos.tx.v1beta1.TxEncodeAminoRequest
-	18, // 27: cosmos.tx.v1beta1.Service.TxDecodeAmino:input_type -> cosmos.tx.v1beta1.TxDecodeAminoRequest
-	7,  // 28: cosmos.tx.v1beta1.Service.Simulate:output_type -> cosmos.tx.v1beta1.SimulateResponse
-	9,  // 29: cosmos.tx.v1beta1.Service.GetTx:output_type -> cosmos.tx.v1beta1.GetTxResponse
-	5,  // 30: cosmos.tx.v1beta1.Service.BroadcastTx:output_type -> cosmos.tx.v1beta1.BroadcastTxResponse
-	3,  // 31: cosmos.tx.v1beta1.Service.GetTxsEvent:output_type -> cosmos.tx.v1beta1.GetTxsEventResponse
-	11, // 32: cosmos.tx.v1beta1.Service.GetBlockWithTxs:output_type -> cosmos.tx.v1beta1.GetBlockWithTxsResponse
-	13, // 33: cosmos.tx.v1beta1.Service.TxDecode:output_type -> cosmos.tx.v1beta1.TxDecodeResponse
-	15, // 34: cosmos.tx.v1beta1.Service.TxEncode:output_type -> cosmos.tx.v1beta1.TxEncodeResponse
-	17, // 35: cosmos.tx.v1beta1.Service.TxEncodeAmino:output_type -> cosmos.tx.v1beta1.TxEncodeAminoResponse
-	19, // 36: cosmos.tx.v1beta1.Service.TxDecodeAmino:output_type -> cosmos.tx.v1beta1.TxDecodeAminoResponse
-	28, // [28:37] is the sub-list for method output_type
-	19, // [19:28] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	29, // 6: cosmos.tx.v1beta1.BroadcastTxResponse.tx_response:type_name -> cosmos.base.abci.v1beta1.TxResponse
+	28, // 7: cosmos.tx.v1beta1.SimulateRequest.tx:type_name -> cosmos.tx.v1beta1.Tx
+	31, // 8: cosmos.tx.v1beta1.SimulateResponse.gas_info:type_name -> cosmos.base.abci.v1beta1.GasInfo
+	32, // 9: cosmos.tx.v1beta1.SimulateResponse.result:type_name -> cosmos.base.abci.v1beta1.Result
+	8,  // 10: cosmos.tx.v1beta1.SimulateResponse.state_diff:type_name -> cosmos.tx.v1beta1.StateDiff
+	9,  // 11: cosmos.tx.v1beta1.StateDiff.stores:type_name -> cosmos.tx.v1beta1.StoreDiff
+	11, // 12: cosmos.tx.v1beta1.StateDiff.balances:type_name -> cosmos.tx.v1beta1.BalanceDelta
+	10, // 13: cosmos.tx.v1beta1.StoreDiff.writes:type_name -> cosmos.tx.v1beta1.KVWrite
+	33, // 14: cosmos.tx.v1beta1.BalanceDelta.sent:type_name -> cosmos.base.v1beta1.Coin
+	33, // 15: cosmos.tx.v1beta1.BalanceDelta.received:type_name -> cosmos.base.v1beta1.Coin
+	28, // 16: cosmos.tx.v1beta1.GetTxResponse.tx:type_name -> cosmos.tx.v1beta1.Tx
+	29, // 17: cosmos.tx.v1beta1.GetTxResponse.tx_response:type_name -> cosmos.base.abci.v1beta1.TxResponse
+	27, // 18: cosmos.tx.v1beta1.GetBlockWithTxsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	28, // 19: cosmos.tx.v1beta1.GetBlockWithTxsResponse.txs:type_name -> cosmos.tx.v1beta1.Tx
+	34, // 20: cosmos.tx.v1beta1.GetBlockWithTxsResponse.block_id:type_name -> cometbft.types.v2.BlockID
+	35, // 21: cosmos.tx.v1beta1.GetBlockWithTxsResponse.block:type_name -> cometbft.types.v2.Block
+	30, // 22: cosmos.tx.v1beta1.GetBlockWithTxsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	28, // 23: cosmos.tx.v1beta1.TxDecodeResponse.tx:type_name -> cosmos.tx.v1beta1.Tx
+	28, // 24: cosmos.tx.v1beta1.TxEncodeRequest.tx:type_name -> cosmos.tx.v1beta1.Tx
+	26, // 25: cosmos.tx.v1beta1.GasPricesResponse.gas_prices:type_name -> cosmos.tx.v1beta1.GasPriceEstimate
+	6,  // 26: cosmos.tx.v1beta1.Service.Simulate:input_type -> cosmos.tx.v1beta1.SimulateRequest
+	12, // 27: cosmos.tx.v1beta1.Service.GetTx:input_type -> cosmos.tx.v1beta1.GetTxRequest
+	4,  // 28: cosmos.tx.v1beta1.Service.BroadcastTx:input_type -> cosmos.tx.v1beta1.BroadcastTxRequest
+	2,  // 29: cosmos.tx.v1beta1.Service.GetTxsEvent:input_type -> cosmos.tx.v1beta1.GetTxsEventRequest
+	14, // 30: cosmos.tx.v1beta1.Service.GetBlockWithTxs:input_type -> cosmos.tx.v1beta1.GetBlockWithTxsRequest
+	16, // 31: cosmos.tx.v1beta1.Service.TxDecode:input_type -> cosmos.tx.v1beta1.TxDecodeRequest
+	18, // 32: cosmos.tx.v1beta1.Service.TxEncode:input_type -> cosmos.tx.v1beta1.TxEncodeRequest
+	20, // 33: cosmos.tx.v1beta1.Service.TxEncodeAmino:input_type -> cosmos.tx.v1beta1.TxEncodeAminoRequest
+	22, // 34: cosmos.tx.v1beta1.Service.TxDecodeAmino:input_type -> cosmos.tx.v1beta1.TxDecodeAminoRequest
+	24, // 35: cosmos.tx.v1beta1.Service.GasPrices:input_type -> cosmos.tx.v1beta1.GasPricesRequest
+	7,  // 36: cosmos.tx.v1beta1.Service.Simulate:output_type -> cosmos.tx.v1beta1.SimulateResponse
+	13, // 37: cosmos.tx.v1beta1.Service.GetTx:output_type -> cosmos.tx.v1beta1.GetTxResponse
+	5,  // 38: cosmos.tx.v1beta1.Service.BroadcastTx:output_type -> cosmos.tx.v1beta1.BroadcastTxResponse
+	3,  // 39: cosmos.tx.v1beta1.Service.GetTxsEvent:output_type -> cosmos.tx.v1beta1.GetTxsEventResponse
+	15, // 40: cosmos.tx.v1beta1.Service.GetBlockWithTxs:output_type -> cosmos.tx.v1beta1.GetBlockWithTxsResponse
+	17, // 41: cosmos.tx.v1beta1.Service.TxDecode:output_type -> cosmos.tx.v1beta1.TxDecodeResponse
+	19, // 42: cosmos.tx.v1beta1.Service.TxEncode:output_type -> cosmos.tx.v1beta1.TxEncodeResponse
+	21, // 43: cosmos.tx.v1beta1.Service.TxEncodeAmino:output_type -> cosmos.tx.v1beta1.TxEncodeAminoResponse
+	23, // 44: cosmos.tx.v1beta1.Service.TxDecodeAmino:output_type -> cosmos.tx.v1beta1.TxDecodeAminoResponse
+	25, // 45: cosmos.tx.v1beta1.Service.GasPrices:output_type -> cosmos.tx.v1beta1.GasPricesResponse
+	36, // [36:46] is the sub-list for method output_type
+	26, // [26:36] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_tx_v1beta1_service_proto_init() }
@@ -10410,7 +15241,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTxRequest); i {
+			switch v := v.(*StateDiff); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10422,7 +15253,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTxResponse); i {
+			switch v := v.(*StoreDiff); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10434,7 +15265,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockWithTxsRequest); i {
+			switch v := v.(*KVWrite); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10446,7 +15277,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockWithTxsResponse); i {
+			switch v := v.(*BalanceDelta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10458,7 +15289,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxDecodeRequest); i {
+			switch v := v.(*GetTxRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10470,7 +15301,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxDecodeResponse); i {
+			switch v := v.(*GetTxResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10482,7 +15313,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxEncodeRequest); i {
+			switch v := v.(*GetBlockWithTxsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10494,7 +15325,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxEncodeResponse); i {
+			switch v := v.(*GetBlockWithTxsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10506,7 +15337,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxEncodeAminoRequest); i {
+			switch v := v.(*TxDecodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10518,7 +15349,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxEncodeAminoResponse); i {
+			switch v := v.(*TxDecodeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10530,7 +15361,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxDecodeAminoRequest); i {
+			switch v := v.(*TxEncodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10542,6 +15373,54 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			}
 		}
 		file_cosmos_tx_v1beta1_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxEncodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_tx_v1beta1_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxEncodeAminoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_tx_v1beta1_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxEncodeAminoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_tx_v1beta1_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxDecodeAminoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_tx_v1beta1_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TxDecodeAminoResponse); i {
 			case 0:
 				return &v.state
@@ -10553,6 +15432,42 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_tx_v1beta1_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GasPricesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_tx_v1beta1_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GasPricesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cosmos_tx_v1beta1_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GasPriceEstimate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -10560,7 +15475,7 @@ func file_cosmos_tx_v1beta1_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_tx_v1beta1_service_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   18,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -28,6 +28,7 @@ const (
 	Service_TxEncode_FullMethodName        = "/cosmos.tx.v1beta1.Service/TxEncode"
 	Service_TxEncodeAmino_FullMethodName   = "/cosmos.tx.v1beta1.Service/TxEncodeAmino"
 	Service_TxDecodeAmino_FullMethodName   = "/cosmos.tx.v1beta1.Service/TxDecodeAmino"
+	Service_GasPrices_FullMethodName       = "/cosmos.tx.v1beta1.Service/GasPrices"
 )
 
 // ServiceClient is the client API for Service service.
@@ -54,6 +55,9 @@ type ServiceClient interface {
 	TxEncodeAmino(ctx context.Context, in *TxEncodeAminoRequest, opts ...grpc.CallOption) (*TxEncodeAminoResponse, error)
 	// TxDecodeAmino decodes an Amino transaction from encoded bytes to JSON.
 	TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequest, opts ...grpc.CallOption) (*TxDecodeAminoResponse, error)
+	// GasPrices returns gas price recommendations per fee denom, computed from the
+	// gas prices paid by the txs included in the recent blocks.
+	GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error)
 }
 
 type serviceClient struct {
@@ -154,6 +158,16 @@ func (c *serviceClient) TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequ
 	return out, nil
 }
 
+func (c *serviceClient) GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GasPricesResponse)
+	err := c.cc.Invoke(ctx, Service_GasPrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility.
@@ -178,6 +192,9 @@ type ServiceServer interface {
 	TxEncodeAmino(context.Context, *TxEncodeAminoRequest) (*TxEncodeAminoResponse, error)
 	// TxDecodeAmino decodes an Amino transaction from encoded bytes to JSON.
 	TxDecodeAmino(context.Context, *TxDecodeAminoRequest) (*TxDecodeAminoResponse, error)
+	// GasPrices returns gas price recommendations per fee denom, computed from the
+	// gas prices paid by the txs included in the recent blocks.
+	GasPrices(context.Context, *GasPricesRequest) (*GasPricesResponse, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -215,6 +232,9 @@ func (UnimplementedServiceServer) TxEncodeAmino(context.Context, *TxEncodeAminoR
 func (UnimplementedServiceServer) TxDecodeAmino(context.Context, *TxDecodeAminoRequest) (*TxDecodeAminoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TxDecodeAmino not implemented")
 }
+func (UnimplementedServiceServer) GasPrices(context.Context, *GasPricesRequest) (*GasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPrices not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 func (UnimplementedServiceServer) testEmbeddedByValue()                 {}
 
@@ -398,6 +418,24 @@ func _Service_TxDecodeAmino_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_GasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_GasPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GasPrices(ctx, req.(*GasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -441,6 +479,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TxDecodeAmino",
 			Handler:    _Service_TxDecodeAmino_Handler,
 		},
+		{
+			MethodName: "GasPrices",
+			Handler:    _Service_GasPrices_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/tx/v1beta1/service.proto",
@@ -813,34 +813,14 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Finaliz
 	//
 	// NOTE: Not all raw transactions may adhere to the sdk.Tx interface, e.g.
 	// vote extensions, so skip those.
-	txResults := make([]*abci.ExecTxResult, 0, len(req.Txs))
-	for _, rawTx := range req.Txs {
-		var response *abci.ExecTxResult
-
-		if _, err := app.txDecoder(rawTx); err == nil {
-			response = app.deliverTx(rawTx)
-		} else {
-			// In the case where a transaction included in a block proposal is malformed,
-			// we still want to return a default response to comet. This is because comet
-			// expects a response for each transaction included in a block proposal.
-			response = sdkerrors.ResponseExecTxResultWithEvents(
-				sdkerrors.ErrTxDecode,
-				0,
-				0,
-				nil,
-				false,
-			)
-		}
-
-		// check after every tx if we should abort
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-			// continue
-		}
-
-		txResults = append(txResults, response)
+	var txResults []*abci.ExecTxResult
+	if app.parallelTxWorkers > 1 && !finalizeState.MultiStore.TracingEnabled() {
+		txResults, err = app.executeTxsParallel(ctx, req.Txs)
+	} else {
+		txResults, err = app.executeTxs(ctx, req.Txs)
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	if finalizeState.MultiStore.TracingEnabled() {
@@ -994,6 +974,17 @@ func (app *BaseApp) Commit() (*abci.CommitResponse, error) {
 		app.abciHandlers.PrepareCheckStater(app.stateManager.GetState(execModeCheck).Context())
 	}
 
+	// Replay the txs persisted by the mempool once the first block after a
+	// restart is committed, so that they are checked against a committed
+	// header rather than the empty header set on Init. Commands which load the
+	// app without running a node, e.g. export, never replay the journal.
+	if !app.mempoolReplayed {
+		app.mempoolReplayed = true
+		if err := app.replayMempool(); err != nil {
+			app.logger.Error("failed to replay mempool", "err", err)
+		}
+	}
+
 	// The SnapshotIfApplicable method will create the snapshot by starting the goroutine
 	app.snapshotManager.SnapshotIfApplicable(header.Height)
 
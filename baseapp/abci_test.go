@@ -811,6 +811,58 @@ func TestABCI_Query_SimulateTx(t *testing.T) {
 	}
 }
 
+func TestABCI_SimulateWithStateDiff(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	tx := newTxCounter(t, suite.txConfig, 0, 0)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	gInfo, result, writes, err := suite.baseApp.SimulateWithStateDiff(txBytes)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.NotZero(t, gInfo.GasUsed)
+
+	// the writes of both the ante handler and the message are reported
+	var storeWrites []*storetypes.StoreKVPair
+	for _, write := range writes {
+		if write.StoreKey == capKey1.Name() {
+			storeWrites = append(storeWrites, write)
+		}
+	}
+	require.Len(t, storeWrites, 2)
+	require.Equal(t, anteKey, storeWrites[0].Key)
+	require.Equal(t, deliverKey, storeWrites[1].Key)
+	require.False(t, storeWrites[1].Delete)
+
+	// the state is left untouched, so the same tx can be simulated again
+	store := getCheckStateCtx(suite.baseApp).KVStore(capKey1)
+	require.Equal(t, int64(0), getIntFromStore(t, store, anteKey))
+	require.Equal(t, int64(0), getIntFromStore(t, store, deliverKey))
+
+	_, _, writes, err = suite.baseApp.SimulateWithStateDiff(txBytes)
+	require.NoError(t, err)
+	require.NotEmpty(t, writes)
+
+	// failed txs report no writes
+	tx = setFailOnAnte(t, suite.txConfig, newTxCounter(t, suite.txConfig, 0, 0), true)
+	txBytes, err = suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+	_, _, writes, err = suite.baseApp.SimulateWithStateDiff(txBytes)
+	require.Error(t, err)
+	require.Empty(t, writes)
+}
+
 func TestABCI_InvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
@@ -240,7 +240,9 @@ func (h *DefaultProposalHandler) SetSignerExtractionAdapter(signerExtAdapter mem
 
 // PrepareProposalHandler returns the default implementation for processing an
 // ABCI proposal. The application's mempool is enumerated and all valid
-// transactions are added to the proposal. Transactions are valid if they:
+// transactions are added to the proposal. When the mempool is a LaneMempool,
+// its lanes are enumerated in order, each one being bound by its share of the
+// block space. Transactions are valid if they:
 //
 // 1) Successfully encode to bytes.
 // 2) Are valid (i.e. pass runTx, AnteHandler only).
@@ -288,100 +290,158 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 			return &abci.PrepareProposalResponse{Txs: h.txSelector.SelectedTxs(ctx)}, nil
 		}
 
-		selectedTxsSignersSeqs := make(map[string]uint64)
-		var (
-			resError        error
-			selectedTxsNums int
-			invalidTxs      []sdk.Tx // invalid txs to be removed out of the loop to avoid dead lock
-		)
-		mempool.SelectBy(ctx, h.mempool, req.Txs, func(memTx sdk.Tx) bool {
-			unorderedTx, ok := memTx.(sdk.TxWithUnordered)
-			isUnordered := ok && unorderedTx.GetUnordered()
-			txSignersSeqs := make(map[string]uint64)
+		if lm, ok := h.mempool.(*LaneMempool); ok {
+			return h.prepareLaneProposal(ctx, req, lm, maxBlockGas)
+		}
 
-			// if the tx is unordered, we don't need to check the sequence, we just add it
-			if !isUnordered {
-				signerData, err := h.signerExtAdapter.GetSigners(memTx)
-				if err != nil {
-					// propagate the error to the caller
-					resError = err
-					return false
-				}
+		if err := h.selectTxs(ctx, h.mempool, req.Txs, uint64(req.MaxTxBytes), maxBlockGas, make(map[string]uint64), nil); err != nil {
+			return nil, err
+		}
 
-				// If the signers aren't in selectedTxsSignersSeqs then we haven't seen them before
-				// so we add them and continue given that we don't need to check the sequence.
-				shouldAdd := true
-				for _, signer := range signerData {
-					seq, ok := selectedTxsSignersSeqs[signer.Signer.String()]
-					if !ok {
-						txSignersSeqs[signer.Signer.String()] = signer.Sequence
-						continue
-					}
+		return &abci.PrepareProposalResponse{Txs: h.txSelector.SelectedTxs(ctx)}, nil
+	}
+}
 
-					// If we have seen this signer before in this block, we must make
-					// sure that the current sequence is seq+1; otherwise is invalid
-					// and we skip it.
-					if seq+1 != signer.Sequence {
-						shouldAdd = false
-						break
-					}
+// prepareLaneProposal fills the proposal lane by lane, in order, each lane
+// being bound by its share of the block space.
+func (h *DefaultProposalHandler) prepareLaneProposal(
+	ctx sdk.Context,
+	req *abci.PrepareProposalRequest,
+	lm *LaneMempool,
+	maxBlockGas uint64,
+) (*abci.PrepareProposalResponse, error) {
+	maxTxBytes := uint64(req.MaxTxBytes)
+	selectedTxsSignersSeqs := make(map[string]uint64)
+
+	var total laneUsage
+	for _, lane := range lm.lanes {
+		if total.txBytes >= maxTxBytes || (maxBlockGas > 0 && total.gas >= maxBlockGas) {
+			break
+		}
+
+		// the TxSelector limits are cumulative over the whole proposal
+		laneMaxTxBytes, laneMaxGas := lane.limits(maxTxBytes, maxBlockGas)
+		laneMaxTxBytes = min(maxTxBytes, total.txBytes+laneMaxTxBytes)
+		if maxBlockGas > 0 {
+			laneMaxGas = min(maxBlockGas, total.gas+laneMaxGas)
+		}
+
+		err := h.selectTxs(ctx, lane.Mempool, req.Txs, laneMaxTxBytes, laneMaxGas, selectedTxsSignersSeqs, total.add)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &abci.PrepareProposalResponse{Txs: h.txSelector.SelectedTxs(ctx)}, nil
+}
+
+// selectTxs selects valid txs from mp until the TxSelector halts for the given
+// maxTxBytes and maxBlockGas. onSelected, if set, is called with every tx added
+// to the proposal. Invalid txs are removed from mp.
+func (h *DefaultProposalHandler) selectTxs(
+	ctx sdk.Context,
+	mp mempool.Mempool,
+	reqTxs [][]byte,
+	maxTxBytes, maxBlockGas uint64,
+	selectedTxsSignersSeqs map[string]uint64,
+	onSelected func(tx sdk.Tx, txBz []byte),
+) error {
+	var (
+		resError        error
+		selectedTxsNums = len(h.txSelector.SelectedTxs(ctx))
+		invalidTxs      []sdk.Tx // invalid txs to be removed out of the loop to avoid dead lock
+	)
+	mempool.SelectBy(ctx, mp, reqTxs, func(memTx sdk.Tx) bool {
+		unorderedTx, ok := memTx.(sdk.TxWithUnordered)
+		isUnordered := ok && unorderedTx.GetUnordered()
+		txSignersSeqs := make(map[string]uint64)
+
+		// if the tx is unordered, we don't need to check the sequence, we just add it
+		if !isUnordered {
+			signerData, err := h.signerExtAdapter.GetSigners(memTx)
+			if err != nil {
+				// propagate the error to the caller
+				resError = err
+				return false
+			}
+
+			// If the signers aren't in selectedTxsSignersSeqs then we haven't seen them before
+			// so we add them and continue given that we don't need to check the sequence.
+			shouldAdd := true
+			for _, signer := range signerData {
+				seq, ok := selectedTxsSignersSeqs[signer.Signer.String()]
+				if !ok {
 					txSignersSeqs[signer.Signer.String()] = signer.Sequence
+					continue
 				}
-				if !shouldAdd {
-					return true
+
+				// If we have seen this signer before in this block, we must make
+				// sure that the current sequence is seq+1; otherwise is invalid
+				// and we skip it.
+				if seq+1 != signer.Sequence {
+					shouldAdd = false
+					break
 				}
+				txSignersSeqs[signer.Signer.String()] = signer.Sequence
 			}
+			if !shouldAdd {
+				return true
+			}
+		}
 
-			// NOTE: Since transaction verification was already executed in CheckTx,
-			// which calls mempool.Insert, in theory everything in the pool should be
-			// valid. But some mempool implementations may insert invalid txs, so we
-			// check again.
-			txBz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
-			if err != nil {
-				invalidTxs = append(invalidTxs, memTx)
-			} else {
-				stop := h.txSelector.SelectTxForProposal(ctx, uint64(req.MaxTxBytes), maxBlockGas, memTx, txBz)
-				if stop {
-					return false
-				}
+		// NOTE: Since transaction verification was already executed in CheckTx,
+		// which calls mempool.Insert, in theory everything in the pool should be
+		// valid. But some mempool implementations may insert invalid txs, so we
+		// check again.
+		txBz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
+		if err != nil {
+			invalidTxs = append(invalidTxs, memTx)
+		} else {
+			stop := h.txSelector.SelectTxForProposal(ctx, maxTxBytes, maxBlockGas, memTx, txBz)
 
-				txsLen := len(h.txSelector.SelectedTxs(ctx))
-				// If the tx is unordered, we don't need to update the sender sequence.
-				if !isUnordered {
-					for sender, seq := range txSignersSeqs {
-						// If txsLen != selectedTxsNums is true, it means that we've
-						// added a new tx to the selected txs, so we need to update
-						// the sequence of the sender.
-						if txsLen != selectedTxsNums {
-							selectedTxsSignersSeqs[sender] = seq
-						} else if _, ok := selectedTxsSignersSeqs[sender]; !ok {
-							// The transaction hasn't been added but it passed the
-							// verification, so we know that the sequence is correct.
-							// So we set this sender's sequence to seq-1, in order
-							// to avoid unnecessary calls to PrepareProposalVerifyTx.
-							selectedTxsSignersSeqs[sender] = seq - 1
-						}
+			txsLen := len(h.txSelector.SelectedTxs(ctx))
+			if onSelected != nil && txsLen != selectedTxsNums {
+				onSelected(memTx, txBz)
+			}
+			// If the tx is unordered, we don't need to update the sender sequence.
+			if !isUnordered {
+				for sender, seq := range txSignersSeqs {
+					// If txsLen != selectedTxsNums is true, it means that we've
+					// added a new tx to the selected txs, so we need to update
+					// the sequence of the sender.
+					if txsLen != selectedTxsNums {
+						selectedTxsSignersSeqs[sender] = seq
+					} else if _, ok := selectedTxsSignersSeqs[sender]; !ok {
+						// The transaction hasn't been added but it passed the
+						// verification, so we know that the sequence is correct.
+						// So we set this sender's sequence to seq-1, in order
+						// to avoid unnecessary calls to PrepareProposalVerifyTx.
+						selectedTxsSignersSeqs[sender] = seq - 1
 					}
 				}
-				selectedTxsNums = txsLen
 			}
+			selectedTxsNums = txsLen
 
-			return true
-		})
-
-		if resError != nil {
-			return nil, resError
-		}
-
-		for _, tx := range invalidTxs {
-			err := h.mempool.Remove(tx)
-			if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
-				return nil, err
+			if stop {
+				return false
 			}
 		}
 
-		return &abci.PrepareProposalResponse{Txs: h.txSelector.SelectedTxs(ctx)}, nil
+		return true
+	})
+
+	if resError != nil {
+		return resError
 	}
+
+	for _, tx := range invalidTxs {
+		err := mp.Remove(tx)
+		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // ProcessProposalHandler returns the default implementation for processing an
@@ -391,6 +451,8 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 // 2. The transaction must be valid (i.e. pass runTx, AnteHandler only)
 //
 // If any transaction fails to pass either condition, the proposal is rejected.
+// When the mempool is a LaneMempool, the proposal is also rejected if its
+// transactions are not ordered by lane or exceed the block space of a lane.
 // Note that step (2) is identical to the validation step performed in
 // DefaultPrepareProposal. It is very important that the same validation logic
 // is used in both steps, and applications must ensure that this is the case in
@@ -403,6 +465,8 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 		return NoOpProcessProposal()
 	}
 
+	lm, _ := h.mempool.(*LaneMempool)
+
 	return func(ctx sdk.Context, req *abci.ProcessProposalRequest) (*abci.ProcessProposalResponse, error) {
 		var totalTxGas uint64
 
@@ -411,12 +475,21 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 			maxBlockGas = b.MaxGas
 		}
 
+		var lanes *laneVerifier
+		if lm != nil {
+			lanes = newLaneVerifier(ctx, lm)
+		}
+
 		for _, txBytes := range req.Txs {
 			tx, err := h.txVerifier.ProcessProposalVerifyTx(txBytes)
 			if err != nil {
 				return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
 			}
 
+			if lanes != nil && !lanes.add(tx, txBytes) {
+				return &abci.ProcessProposalResponse{Status: abci.PROCESS_PROPOSAL_STATUS_REJECT}, nil
+			}
+
 			if maxBlockGas > 0 {
 				gasTx, ok := tx.(GasTx)
 				if ok {
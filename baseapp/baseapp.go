@@ -109,6 +109,10 @@ type BaseApp struct {
 	// flag for sealing options and parameters to a BaseApp
 	sealed bool
 
+	// mempoolReplayed is set once the txs persisted by a replayable mempool
+	// have been replayed, on the first Commit after the app started.
+	mempoolReplayed bool
+
 	// block height at which to halt the chain and gracefully shutdown
 	haltHeight uint64
 
@@ -163,6 +167,10 @@ type BaseApp struct {
 	// SAFETY: it's safe to do if validators validate the total gas wanted in the `ProcessProposal`, which is the case in the default handler.
 	disableBlockGasMeter bool
 
+	// parallelTxWorkers is the number of goroutines executing the txs of a block
+	// in FinalizeBlock. The txs are executed sequentially if it is lower than 2.
+	parallelTxWorkers int
+
 	// nextBlockDelay is the delay to wait until the next block after ABCI has committed.
 	// This gives the application more time to receive precommits.  This is the same as TimeoutCommit,
 	// but can new be set from the application.  This value defaults to 0, and CometBFT will use the
@@ -427,6 +435,32 @@ func (app *BaseApp) Init() error {
 	return app.cms.GetPruning().Validate()
 }
 
+// replayMempool restores the txs persisted by a replayable mempool, running
+// them through CheckTx against the latest committed check state. Txs failing
+// CheckTx are dropped.
+func (app *BaseApp) replayMempool() error {
+	mp, ok := app.mempool.(mempool.ReplayableMempool)
+	if !ok {
+		return nil
+	}
+
+	var replayed, dropped int
+	err := mp.Replay(func(txBytes []byte) error {
+		if _, _, _, err := app.runTx(execModeCheck, txBytes, nil); err != nil {
+			dropped++
+			return err
+		}
+		replayed++
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to replay mempool: %w", err)
+	}
+
+	app.logger.Info("replayed persisted mempool", "replayed", replayed, "dropped", dropped)
+	return nil
+}
+
 func (app *BaseApp) setMinGasPrices(gasPrices sdk.DecCoins) {
 	app.gasConfig.MinGasPrices = gasPrices
 }
@@ -694,40 +728,45 @@ func (app *BaseApp) beginBlock(_ *abci.FinalizeBlockRequest) (sdk.BeginBlock, er
 }
 
 func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
-	gInfo := sdk.GasInfo{}
-	resultStr := "successful"
+	resp := app.execTx(app.getContextForTx(execModeFinalize, tx), tx)
+	recordTxTelemetry(resp)
 
-	var resp *abci.ExecTxResult
-
-	defer func() {
-		telemetry.IncrCounter(1, "tx", "count")
-		telemetry.IncrCounter(1, "tx", resultStr)
-		telemetry.SetGauge(float32(gInfo.GasUsed), "tx", "gas", "used")
-		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
-	}()
+	return resp
+}
 
-	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx, nil)
+// execTx executes tx in ctx and returns its execution result.
+func (app *BaseApp) execTx(ctx sdk.Context, tx []byte) *abci.ExecTxResult {
+	gInfo, result, anteEvents, err := app.runTxWithContext(ctx, execModeFinalize, tx, nil)
 	if err != nil {
-		resultStr = "failed"
-		resp = sdkerrors.ResponseExecTxResultWithEvents(
+		return sdkerrors.ResponseExecTxResultWithEvents(
 			err,
 			gInfo.GasWanted,
 			gInfo.GasUsed,
 			sdk.MarkEventsToIndex(anteEvents, app.indexEvents),
 			app.trace,
 		)
-		return resp
 	}
 
-	resp = &abci.ExecTxResult{
+	return &abci.ExecTxResult{
 		GasWanted: int64(gInfo.GasWanted),
 		GasUsed:   int64(gInfo.GasUsed),
 		Log:       result.Log,
 		Data:      result.Data,
 		Events:    sdk.MarkEventsToIndex(result.Events, app.indexEvents),
 	}
+}
 
-	return resp
+// recordTxTelemetry records the telemetry of a tx executed in FinalizeBlock.
+func recordTxTelemetry(resp *abci.ExecTxResult) {
+	resultStr := "successful"
+	if !resp.IsOK() {
+		resultStr = "failed"
+	}
+
+	telemetry.IncrCounter(1, "tx", "count")
+	telemetry.IncrCounter(1, "tx", resultStr)
+	telemetry.SetGauge(float32(resp.GasUsed), "tx", "gas", "used")
+	telemetry.SetGauge(float32(resp.GasWanted), "tx", "gas", "wanted")
 }
 
 // endBlock is an application-defined function that is called after transactions
@@ -766,12 +805,17 @@ func (app *BaseApp) endBlock(_ context.Context) (sdk.EndBlock, error) {
 // both txbytes and the decoded tx are passed to runTx to avoid the state machine encoding the tx and decoding the transaction twice
 // passing the decoded tx to runTX is optional, it will be decoded if the tx is nil
 func (app *BaseApp) runTx(mode sdk.ExecMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes, tx)
+}
+
+// runTxWithContext runs tx as runTx does, in the given context instead of the
+// context of mode.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode sdk.ExecMode, txBytes []byte, tx sdk.Tx) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -940,6 +984,12 @@ func (app *BaseApp) runTx(mode sdk.ExecMode, txBytes []byte, tx sdk.Tx) (gInfo s
 			msCache.Write()
 		}
 
+		if mode == execModeSimulate {
+			// The simulation context is a branch which is discarded, writing the
+			// message state to it lets SimulateWithStateDiff observe it.
+			msCache.Write()
+		}
+
 		if len(anteEvents) > 0 && (mode == execModeFinalize || mode == execModeSimulate) {
 			// append the events in the order of occurrence
 			result.Events = append(anteEvents, result.Events...)
@@ -1125,6 +1175,14 @@ func (app *BaseApp) Close() error {
 		}
 	}
 
+	// Close the journal of a replayable mempool
+	if mp, ok := app.mempool.(mempool.ReplayableMempool); ok {
+		app.logger.Info("Closing mempool journal")
+		if err := mp.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	return errors.Join(errs...)
 }
 
@@ -0,0 +1,273 @@
+package baseapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cmttypes "github.com/cometbft/cometbft/v2/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// ErrNoMatchingLane is returned when inserting a tx which does not belong to
+// any lane of a LaneMempool.
+var ErrNoMatchingLane = errors.New("tx does not match any lane")
+
+// Lane defines a named partition of the block space, backed by its own
+// mempool. Lanes are filled in order by the DefaultProposalHandler, each one
+// being bound by its share of the block space.
+type Lane struct {
+	// Name identifies the lane.
+	Name string
+
+	// Mempool holds the txs of the lane.
+	Mempool mempool.Mempool
+
+	// Match returns whether tx belongs to the lane. A tx belongs to the first
+	// lane it matches. A nil Match matches all txs.
+	Match func(tx sdk.Tx) bool
+
+	// MaxTxBytesShare is the share, in (0, 1], of the block tx bytes the
+	// lane may use. A nil share lets the lane use the whole block.
+	MaxTxBytesShare math.LegacyDec
+
+	// MaxGasShare is the share, in (0, 1], of the block gas the lane may use.
+	// A nil share lets the lane use the whole block.
+	MaxGasShare math.LegacyDec
+}
+
+// matches returns whether tx belongs to the lane.
+func (l Lane) matches(tx sdk.Tx) bool {
+	return l.Match == nil || l.Match(tx)
+}
+
+// limits returns the maximum tx bytes and gas of the lane in a block of
+// maxTxBytes and maxBlockGas. A zero maxBlockGas means the gas is unbounded.
+func (l Lane) limits(maxTxBytes, maxBlockGas uint64) (uint64, uint64) {
+	return applyShare(l.MaxTxBytesShare, maxTxBytes), applyShare(l.MaxGasShare, maxBlockGas)
+}
+
+func applyShare(share math.LegacyDec, limit uint64) uint64 {
+	if share.IsNil() {
+		return limit
+	}
+
+	return share.MulInt(math.NewIntFromUint64(limit)).TruncateInt().Uint64()
+}
+
+func validateShare(share math.LegacyDec) error {
+	if share.IsNil() {
+		return nil
+	}
+	if !share.IsPositive() || share.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("share must be in (0, 1], got %s", share)
+	}
+
+	return nil
+}
+
+var _ mempool.ExtMempool = (*LaneMempool)(nil)
+
+// LaneMempool is a mempool routing txs to an ordered set of lanes. Setting it
+// as the BaseApp mempool makes the DefaultProposalHandler build and verify
+// proposals lane by lane.
+type LaneMempool struct {
+	lanes []Lane
+}
+
+// NewLaneMempool returns a LaneMempool over the given lanes, in order of
+// processing. Lane names must be unique.
+func NewLaneMempool(lanes ...Lane) (*LaneMempool, error) {
+	if len(lanes) == 0 {
+		return nil, errors.New("at least one lane is required")
+	}
+
+	names := make(map[string]struct{}, len(lanes))
+	for _, lane := range lanes {
+		if lane.Name == "" {
+			return nil, errors.New("lane name cannot be empty")
+		}
+		if _, ok := names[lane.Name]; ok {
+			return nil, fmt.Errorf("duplicate lane %s", lane.Name)
+		}
+		names[lane.Name] = struct{}{}
+
+		if lane.Mempool == nil {
+			return nil, fmt.Errorf("lane %s: mempool cannot be nil", lane.Name)
+		}
+		if err := validateShare(lane.MaxTxBytesShare); err != nil {
+			return nil, fmt.Errorf("lane %s: max tx bytes %w", lane.Name, err)
+		}
+		if err := validateShare(lane.MaxGasShare); err != nil {
+			return nil, fmt.Errorf("lane %s: max gas %w", lane.Name, err)
+		}
+	}
+
+	return &LaneMempool{lanes: lanes}, nil
+}
+
+// Lanes returns the lanes of the mempool, in order of processing.
+func (lm *LaneMempool) Lanes() []Lane {
+	return lm.lanes
+}
+
+// laneIndex returns the index of the lane tx belongs to, or -1 if it does not
+// match any lane.
+func (lm *LaneMempool) laneIndex(tx sdk.Tx) int {
+	for i, lane := range lm.lanes {
+		if lane.matches(tx) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Insert inserts tx into the first lane it matches.
+func (lm *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	i := lm.laneIndex(tx)
+	if i < 0 {
+		return ErrNoMatchingLane
+	}
+
+	return lm.lanes[i].Mempool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the txs of all lanes, in order of lanes.
+func (lm *LaneMempool) Select(ctx context.Context, txs [][]byte) mempool.Iterator {
+	return newLaneIterator(ctx, lm.lanes, 0, txs)
+}
+
+// SelectBy calls callback with the txs of all lanes, in order of lanes, until
+// it returns false.
+func (lm *LaneMempool) SelectBy(ctx context.Context, txs [][]byte, callback func(sdk.Tx) bool) {
+	proceed := true
+	for _, lane := range lm.lanes {
+		mempool.SelectBy(ctx, lane.Mempool, txs, func(tx sdk.Tx) bool {
+			proceed = callback(tx)
+			return proceed
+		})
+		if !proceed {
+			return
+		}
+	}
+}
+
+// CountTx returns the number of txs in all lanes.
+func (lm *LaneMempool) CountTx() int {
+	var n int
+	for _, lane := range lm.lanes {
+		n += lane.Mempool.CountTx()
+	}
+
+	return n
+}
+
+// Remove removes tx from the lane it belongs to.
+func (lm *LaneMempool) Remove(tx sdk.Tx) error {
+	i := lm.laneIndex(tx)
+	if i < 0 {
+		return mempool.ErrTxNotFound
+	}
+
+	return lm.lanes[i].Mempool.Remove(tx)
+}
+
+// laneIterator chains the iterators of the lanes of a LaneMempool.
+type laneIterator struct {
+	ctx   context.Context
+	lanes []Lane
+	lane  int
+	txs   [][]byte
+	iter  mempool.Iterator
+}
+
+// newLaneIterator returns an iterator starting at the first non empty lane
+// from index lane, or nil if there is none.
+func newLaneIterator(ctx context.Context, lanes []Lane, lane int, txs [][]byte) mempool.Iterator {
+	for ; lane < len(lanes); lane++ {
+		if iter := lanes[lane].Mempool.Select(ctx, txs); iter != nil {
+			return &laneIterator{ctx: ctx, lanes: lanes, lane: lane, txs: txs, iter: iter}
+		}
+	}
+
+	return nil
+}
+
+func (i *laneIterator) Next() mempool.Iterator {
+	if next := i.iter.Next(); next != nil {
+		i.iter = next
+		return i
+	}
+
+	return newLaneIterator(i.ctx, i.lanes, i.lane+1, i.txs)
+}
+
+func (i *laneIterator) Tx() sdk.Tx {
+	return i.iter.Tx()
+}
+
+// laneUsage tracks the block space used by the txs of a lane.
+type laneUsage struct {
+	txBytes uint64
+	gas     uint64
+}
+
+func (u *laneUsage) add(tx sdk.Tx, txBz []byte) {
+	u.txBytes += uint64(cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txBz}))
+	if gasTx, ok := tx.(GasTx); ok {
+		u.gas += gasTx.GetGas()
+	}
+}
+
+// laneVerifier verifies that the txs of a proposal are ordered by lane and do
+// not exceed the block space of their lane.
+//
+// As the max tx bytes of the proposal is not known when processing it, the
+// tx bytes shares apply to the max block bytes of the consensus params, which
+// bounds the max tx bytes used when preparing the proposal.
+type laneVerifier struct {
+	lm          *LaneMempool
+	maxTxBytes  uint64
+	maxBlockGas uint64
+	lane        int
+	usage       laneUsage
+}
+
+func newLaneVerifier(ctx sdk.Context, lm *LaneMempool) *laneVerifier {
+	v := &laneVerifier{lm: lm, maxTxBytes: cmttypes.MaxBlockSizeBytes}
+	if b := ctx.ConsensusParams().Block; b != nil {
+		if b.MaxBytes > 0 {
+			v.maxTxBytes = uint64(b.MaxBytes)
+		}
+		if b.MaxGas > 0 {
+			v.maxBlockGas = uint64(b.MaxGas)
+		}
+	}
+
+	return v
+}
+
+// add accounts for the next tx of the proposal, returning false if it matches
+// no lane, breaks the lane ordering or exceeds the block space of its lane.
+func (v *laneVerifier) add(tx sdk.Tx, txBz []byte) bool {
+	lane := v.lm.laneIndex(tx)
+	if lane < 0 {
+		return false
+	}
+	if lane < v.lane {
+		return false
+	}
+	if lane > v.lane {
+		v.lane, v.usage = lane, laneUsage{}
+	}
+
+	v.usage.add(tx, txBz)
+	maxTxBytes, maxGas := v.lm.lanes[lane].limits(v.maxTxBytes, v.maxBlockGas)
+
+	return v.usage.txBytes <= maxTxBytes && (v.maxBlockGas == 0 || v.usage.gas <= maxGas)
+}
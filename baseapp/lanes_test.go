@@ -0,0 +1,226 @@
+package baseapp_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+	cmttypes "github.com/cometbft/cometbft/v2/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+// isOracleTx matches the txs whose first message value starts with "o".
+func isOracleTx(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+	msg, ok := msgs[0].(*baseapptestutil.MsgKeyValue)
+	return ok && bytes.HasPrefix(msg.Value, []byte("o"))
+}
+
+func newTestLaneMempool(t *testing.T) *baseapp.LaneMempool {
+	t.Helper()
+
+	lm, err := baseapp.NewLaneMempool(
+		baseapp.Lane{
+			Name:            "oracle",
+			Mempool:         mempool.DefaultPriorityMempool(),
+			Match:           isOracleTx,
+			MaxTxBytesShare: math.LegacyNewDecWithPrec(5, 1),
+		},
+		baseapp.Lane{
+			Name:    "default",
+			Mempool: mempool.DefaultPriorityMempool(),
+		},
+	)
+	require.NoError(t, err)
+
+	return lm
+}
+
+func TestNewLaneMempool(t *testing.T) {
+	mp := mempool.DefaultPriorityMempool()
+
+	testCases := map[string]struct {
+		lanes  []baseapp.Lane
+		expErr string
+	}{
+		"valid": {
+			lanes: []baseapp.Lane{
+				{Name: "a", Mempool: mp, MaxTxBytesShare: math.LegacyOneDec(), MaxGasShare: math.LegacyNewDecWithPrec(1, 1)},
+				{Name: "b", Mempool: mp},
+			},
+		},
+		"no lanes": {
+			expErr: "at least one lane is required",
+		},
+		"empty name": {
+			lanes:  []baseapp.Lane{{Mempool: mp}},
+			expErr: "lane name cannot be empty",
+		},
+		"duplicate name": {
+			lanes:  []baseapp.Lane{{Name: "a", Mempool: mp}, {Name: "a", Mempool: mp}},
+			expErr: "duplicate lane a",
+		},
+		"nil mempool": {
+			lanes:  []baseapp.Lane{{Name: "a"}},
+			expErr: "mempool cannot be nil",
+		},
+		"zero share": {
+			lanes:  []baseapp.Lane{{Name: "a", Mempool: mp, MaxTxBytesShare: math.LegacyZeroDec()}},
+			expErr: "share must be in (0, 1]",
+		},
+		"share above one": {
+			lanes:  []baseapp.Lane{{Name: "a", Mempool: mp, MaxGasShare: math.LegacyNewDec(2)}},
+			expErr: "share must be in (0, 1]",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := baseapp.NewLaneMempool(tc.lanes...)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLaneMempool(t *testing.T) {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	normalTx := buildMsg(t, txConfig, []byte("n"), [][]byte{[]byte("secret1")}, []uint64{1})
+	oracleTx := buildMsg(t, txConfig, []byte("o"), [][]byte{[]byte("secret2")}, []uint64{1})
+
+	lm := newTestLaneMempool(t)
+	ctx := sdk.Context{}
+	require.NoError(t, lm.Insert(ctx, normalTx))
+	require.NoError(t, lm.Insert(ctx, oracleTx))
+	require.Equal(t, 2, lm.CountTx())
+	require.Equal(t, 1, lm.Lanes()[0].Mempool.CountTx())
+
+	// txs are selected in order of lanes
+	var selected []sdk.Tx
+	for it := lm.Select(context.Background(), nil); it != nil; it = it.Next() {
+		selected = append(selected, it.Tx())
+	}
+	require.Equal(t, []sdk.Tx{oracleTx, normalTx}, selected)
+
+	selected = nil
+	lm.SelectBy(context.Background(), nil, func(tx sdk.Tx) bool {
+		selected = append(selected, tx)
+		return false
+	})
+	require.Equal(t, []sdk.Tx{oracleTx}, selected)
+
+	require.NoError(t, lm.Remove(oracleTx))
+	require.Equal(t, 0, lm.Lanes()[0].Mempool.CountTx())
+
+	// txs matching no lane are rejected
+	strict, err := baseapp.NewLaneMempool(baseapp.Lane{Name: "oracle", Mempool: mempool.DefaultPriorityMempool(), Match: isOracleTx})
+	require.NoError(t, err)
+	require.ErrorIs(t, strict.Insert(ctx, normalTx), baseapp.ErrNoMatchingLane)
+}
+
+func TestDefaultProposalHandler_Lanes(t *testing.T) {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	type testTx struct {
+		tx sdk.Tx
+		bz []byte
+	}
+	var txs []testTx
+	for _, v := range []struct {
+		value  string
+		secret string
+	}{{"o1", "secret1"}, {"o2", "secret2"}, {"o3", "secret3"}, {"n1", "secret4"}, {"n2", "secret5"}} {
+		tx := buildMsg(t, txConfig, []byte(v.value), [][]byte{[]byte(v.secret)}, []uint64{1})
+		bz, err := txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		txs = append(txs, testTx{tx: tx, bz: bz})
+	}
+	txSize := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txs[0].bz})
+	for _, tx := range txs {
+		require.Equal(t, txSize, cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{tx.bz}))
+	}
+
+	ctrl := gomock.NewController(t)
+	app := mock.NewMockProposalTxVerifier(ctrl)
+	lm := newTestLaneMempool(t)
+	for _, tx := range txs {
+		app.EXPECT().PrepareProposalVerifyTx(tx.tx).Return(tx.bz, nil).AnyTimes()
+		app.EXPECT().ProcessProposalVerifyTx(tx.bz).Return(tx.tx, nil).AnyTimes()
+		require.NoError(t, lm.Insert(sdk.Context{}, tx.tx))
+	}
+	ph := baseapp.NewDefaultProposalHandler(lm, app)
+
+	// the oracle lane is filled first, up to half of the block
+	ctx := sdk.Context{}.WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxBytes: 4 * txSize},
+	})
+	resp, err := ph.PrepareProposalHandler()(ctx, &abci.PrepareProposalRequest{MaxTxBytes: 4 * txSize})
+	require.NoError(t, err)
+	require.Len(t, resp.Txs, 4)
+	require.Subset(t, [][]byte{txs[0].bz, txs[1].bz, txs[2].bz}, resp.Txs[:2])
+	require.ElementsMatch(t, [][]byte{txs[3].bz, txs[4].bz}, resp.Txs[2:])
+
+	testCases := map[string]struct {
+		txs       [][]byte
+		expStatus abci.ProcessProposalStatus
+	}{
+		"prepared proposal": {
+			txs:       resp.Txs,
+			expStatus: abci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		},
+		"default lane only": {
+			txs:       [][]byte{txs[3].bz, txs[4].bz},
+			expStatus: abci.PROCESS_PROPOSAL_STATUS_ACCEPT,
+		},
+		"lanes out of order": {
+			txs:       [][]byte{txs[3].bz, txs[0].bz},
+			expStatus: abci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+		"lane exceeding its block space": {
+			txs:       [][]byte{txs[0].bz, txs[1].bz, txs[2].bz},
+			expStatus: abci.PROCESS_PROPOSAL_STATUS_REJECT,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp, err := ph.ProcessProposalHandler()(ctx, &abci.ProcessProposalRequest{Txs: tc.txs})
+			require.NoError(t, err)
+			require.Equal(t, tc.expStatus, resp.Status)
+		})
+	}
+
+	// a proposal with a tx matching no lane is rejected
+	strict, err := baseapp.NewLaneMempool(baseapp.Lane{Name: "oracle", Mempool: mempool.DefaultPriorityMempool(), Match: isOracleTx})
+	require.NoError(t, err)
+	strictPh := baseapp.NewDefaultProposalHandler(strict, app)
+	for _, proposal := range [][][]byte{{txs[3].bz}, {txs[0].bz, txs[3].bz}} {
+		resp, err := strictPh.ProcessProposalHandler()(ctx, &abci.ProcessProposalRequest{Txs: proposal})
+		require.NoError(t, err)
+		require.Equal(t, abci.PROCESS_PROPOSAL_STATUS_REJECT, resp.Status)
+	}
+}
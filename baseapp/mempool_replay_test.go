@@ -0,0 +1,139 @@
+package baseapp_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+	cmtjson "github.com/cometbft/cometbft/v2/libs/json"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil/configurator"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestABCI_CheckTx_PersistentMempoolReplay(t *testing.T) {
+	db, journal := dbm.NewMemDB(), dbm.NewMemDB()
+
+	var (
+		bankKeeper    bankkeeper.Keeper
+		accountKeeper authkeeper.AccountKeeper
+		appBuilder    *runtime.AppBuilder
+		txConfig      client.TxConfig
+		cdc           codec.Codec
+	)
+	newApp := func() *runtime.App {
+		err := depinject.Inject(
+			depinject.Configs(
+				configurator.NewAppConfig(
+					configurator.AuthModule(),
+					configurator.TxModule(),
+					configurator.ConsensusModule(),
+					configurator.BankModule(),
+					configurator.StakingModule(),
+				),
+				depinject.Supply(log.NewNopLogger()),
+			),
+			&bankKeeper,
+			&accountKeeper,
+			&txConfig,
+			&cdc,
+			&appBuilder)
+		require.NoError(t, err)
+
+		mp := mempool.NewPersistentMempool(mempool.NewSenderNonceMempool(mempool.SenderNonceMaxTxOpt(0)), journal, nil)
+		app := appBuilder.Build(db, nil, baseapp.SetMempool(mp))
+		require.NoError(t, app.Load(true))
+		return app
+	}
+	journalLen := func() int {
+		it, err := journal.Iterator(nil, nil)
+		require.NoError(t, err)
+		defer it.Close()
+
+		n := 0
+		for ; it.Valid(); it.Next() {
+			n++
+		}
+		return n
+	}
+
+	app := newApp()
+	genState := GenesisStateWithSingleValidator(t, cdc, appBuilder)
+	stateBytes, err := cmtjson.MarshalIndent(genState, "", " ")
+	require.NoError(t, err)
+	_, err = app.InitChain(&abci.InitChainRequest{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	require.NoError(t, err)
+
+	// fund a new account, which does not get account number 0
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	feeAmount := sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(150)))
+	sendAmount := sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(100)))
+	ctx := app.NewContext(false)
+	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, feeAmount.Add(sendAmount...)))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr1, feeAmount.Add(sendAmount...)))
+	accNum := accountKeeper.GetAccount(ctx, addr1).GetAccountNumber()
+	require.NotZero(t, accNum)
+
+	_, err = app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr1, addr2, sendAmount)))
+	txBuilder.SetFeeAmount(feeAmount)
+	txBuilder.SetGasLimit(200000)
+	_, txBytes, err := createTestTx(txConfig, txBuilder, []cryptotypes.PrivKey{priv1}, []uint64{accNum}, []uint64{0}, app.ChainID())
+	require.NoError(t, err)
+
+	res, err := app.CheckTx(&abci.CheckTxRequest{Type: abci.CHECK_TX_TYPE_CHECK, Tx: txBytes})
+	require.NoError(t, err)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, 1, app.Mempool().CountTx())
+
+	// restart the app: loading it doesn't replay nor drain the journal
+	app = newApp()
+	require.Equal(t, 0, app.Mempool().CountTx())
+	require.Equal(t, 1, journalLen())
+
+	// the journal is replayed, with signatures verified against the committed
+	// state, once the first block after the restart is committed
+	_, err = app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 2})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+	require.Equal(t, 1, app.Mempool().CountTx())
+	require.Equal(t, 1, journalLen())
+
+	// including the replayed tx in a block removes it from the journal
+	finalizeRes, err := app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 3, Txs: [][]byte{txBytes}})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), finalizeRes.TxResults[0].Code, finalizeRes.TxResults[0].Log)
+	_, err = app.Commit()
+	require.NoError(t, err)
+	require.Equal(t, 0, app.Mempool().CountTx())
+	require.Equal(t, 0, journalLen())
+	require.NoError(t, app.Close())
+}
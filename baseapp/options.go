@@ -139,6 +139,15 @@ func SetOptimisticExecution(opts ...func(*oe.OptimisticExecution)) func(*BaseApp
 	}
 }
 
+// SetParallelTxExecution enables the parallel execution of the txs of a block
+// in FinalizeBlock, using the given number of workers. Txs conflicting with
+// the txs preceding them in the block are executed again, so that the results
+// are identical to a sequential execution. As txs paying fees all write the
+// fee collector balance, they always conflict with each other.
+func SetParallelTxExecution(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetParallelTxExecution(workers) }
+}
+
 // DisableBlockGasMeter disables the block gas meter.
 func DisableBlockGasMeter() func(*BaseApp) {
 	return func(app *BaseApp) { app.SetDisableBlockGasMeter(true) }
@@ -409,6 +418,17 @@ func (app *BaseApp) SetDisableBlockGasMeter(disableBlockGasMeter bool) {
 	app.disableBlockGasMeter = disableBlockGasMeter
 }
 
+// SetParallelTxExecution sets the number of workers executing the txs of a
+// block in FinalizeBlock. The txs are executed sequentially if it is lower
+// than 2.
+func (app *BaseApp) SetParallelTxExecution(workers int) {
+	if app.sealed {
+		panic("SetParallelTxExecution() on sealed BaseApp")
+	}
+
+	app.parallelTxWorkers = workers
+}
+
 // SetMsgServiceRouter sets the MsgServiceRouter of a BaseApp.
 func (app *BaseApp) SetMsgServiceRouter(msgServiceRouter *MsgServiceRouter) {
 	app.msgServiceRouter = msgServiceRouter
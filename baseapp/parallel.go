@@ -0,0 +1,187 @@
+package baseapp
+
+import (
+	"context"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// txExecution is the execution of a tx of a block.
+type txExecution struct {
+	result *abci.ExecTxResult
+	// access is the state accessed by the tx, nil if it could not be decoded.
+	access *txAccess
+	// blockGas is the block gas consumed by the tx.
+	blockGas uint64
+}
+
+// executeTxs executes the txs of a block in order on the finalize state.
+func (app *BaseApp) executeTxs(ctx context.Context, txs [][]byte) ([]*abci.ExecTxResult, error) {
+	txResults := make([]*abci.ExecTxResult, 0, len(txs))
+	for _, rawTx := range txs {
+		var response *abci.ExecTxResult
+
+		if _, err := app.txDecoder(rawTx); err == nil {
+			response = app.deliverTx(rawTx)
+		} else {
+			// In the case where a transaction included in a block proposal is malformed,
+			// we still want to return a default response to comet. This is because comet
+			// expects a response for each transaction included in a block proposal.
+			response = sdkerrors.ResponseExecTxResultWithEvents(
+				sdkerrors.ErrTxDecode,
+				0,
+				0,
+				nil,
+				false,
+			)
+		}
+
+		// check after every tx if we should abort
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+			// continue
+		}
+
+		txResults = append(txResults, response)
+	}
+
+	return txResults, nil
+}
+
+// executeTxsParallel executes the txs of a block concurrently, each one on its
+// own branch of the finalize state, and then commits their writes in order.
+//
+// The keys read by each tx are recorded. A tx having read a key written by a
+// tx preceding it in the block, or not fitting in the remaining block gas, is
+// executed again on top of the committed state, so that the results are
+// identical to the ones of a sequential execution. This assumes that the
+// execution of a tx only depends on the state of the stores, and not on the
+// block gas meter or any in memory state shared with the other txs.
+func (app *BaseApp) executeTxsParallel(ctx context.Context, txs [][]byte) ([]*abci.ExecTxResult, error) {
+	finalizeState := app.stateManager.GetState(execModeFinalize)
+	blockCtx := finalizeState.Context()
+	blockGasMeter := blockCtx.BlockGasMeter()
+	ms := finalizeState.MultiStore
+	mtx := &sync.Mutex{}
+
+	executions := make([]*txExecution, len(txs))
+	done := make([]chan struct{}, len(txs))
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	abort := make(chan struct{})
+	defer close(abort)
+
+	jobs := make(chan int)
+	go func() {
+		defer close(jobs)
+		for i := range txs {
+			select {
+			case jobs <- i:
+			case <-abort:
+				return
+			}
+		}
+	}()
+
+	for range app.parallelTxWorkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				executions[i] = app.executeTx(blockCtx, ms, mtx, storetypes.NewInfiniteGasMeter(), txs[i])
+				close(done[i])
+			}
+		}()
+	}
+
+	written := make(map[storetypes.StoreKey]map[string]struct{})
+	txResults := make([]*abci.ExecTxResult, 0, len(txs))
+	for i, rawTx := range txs {
+		select {
+		case <-done[i]:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		execution := executions[i]
+		if execution.access != nil {
+			if execution.access.conflicts(written) || !hasBlockGas(blockGasMeter, execution.blockGas) {
+				execution = app.executeTx(blockCtx, ms, mtx, blockGasMeter, rawTx)
+			} else {
+				blockGasMeter.ConsumeGas(execution.blockGas, "block gas meter")
+			}
+
+			execution.access.write(written)
+			recordTxTelemetry(execution.result)
+		}
+
+		// check after every tx if we should abort
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+			// continue
+		}
+
+		txResults = append(txResults, execution.result)
+	}
+
+	return txResults, nil
+}
+
+// executeTx executes tx on a branch of ms, consuming its block gas from
+// blockGasMeter.
+func (app *BaseApp) executeTx(
+	blockCtx sdk.Context,
+	ms storetypes.MultiStore,
+	mtx *sync.Mutex,
+	blockGasMeter storetypes.GasMeter,
+	tx []byte,
+) *txExecution {
+	if _, err := app.txDecoder(tx); err != nil {
+		return &txExecution{
+			result: sdkerrors.ResponseExecTxResultWithEvents(sdkerrors.ErrTxDecode, 0, 0, nil, false),
+		}
+	}
+
+	access := newTxAccess(ms, mtx)
+	txMS := newBranchMultiStore(access)
+	blockGasBefore := blockGasMeter.GasConsumed()
+
+	ctx := blockCtx.
+		WithMultiStore(txMS).
+		WithEventManager(sdk.NewEventManager()).
+		WithBlockGasMeter(blockGasMeter).
+		WithTxBytes(tx).
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithIsSigverifyTx(app.sigverifyTx)
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	result := app.execTx(ctx, tx)
+	txMS.Write()
+
+	return &txExecution{
+		result:   result,
+		access:   access,
+		blockGas: blockGasMeter.GasConsumed() - blockGasBefore,
+	}
+}
+
+// hasBlockGas returns whether gas can be consumed from blockGasMeter as it
+// would have been by the tx if executed on it.
+func hasBlockGas(blockGasMeter storetypes.GasMeter, gas uint64) bool {
+	return !blockGasMeter.IsOutOfGas() && gas <= blockGasMeter.GasRemaining()
+}
@@ -0,0 +1,340 @@
+package baseapp
+
+import (
+	"bytes"
+	"io"
+	"slices"
+	"sync"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// keyRange is an iterated range of keys. Nil bounds are open.
+type keyRange struct {
+	start, end []byte
+}
+
+func (r keyRange) contains(key []byte) bool {
+	return (r.start == nil || bytes.Compare(key, r.start) >= 0) &&
+		(r.end == nil || bytes.Compare(key, r.end) < 0)
+}
+
+// txAccess records the state accessed by a tx executed in parallel with the
+// other txs of a block. It lazily wraps the stores of the block in accessStores
+// which record the keys read by the tx and buffer its writes, so that they can
+// be applied to the block state once the tx is known not to conflict with the
+// txs preceding it.
+type txAccess struct {
+	parent storetypes.MultiStore
+	mtx    *sync.Mutex // guards parent, shared by the txs of the block
+	stores map[storetypes.StoreKey]*accessStore
+}
+
+func newTxAccess(parent storetypes.MultiStore, mtx *sync.Mutex) *txAccess {
+	return &txAccess{
+		parent: parent,
+		mtx:    mtx,
+		stores: make(map[storetypes.StoreKey]*accessStore),
+	}
+}
+
+// GetKVStore returns the access recording store wrapping the store of key.
+func (a *txAccess) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	if store, ok := a.stores[key]; ok {
+		return store
+	}
+
+	store := &accessStore{
+		parent: a.parent.GetKVStore(key),
+		mtx:    a.mtx,
+		reads:  make(map[string]struct{}),
+		writes: make(map[string][]byte),
+	}
+	a.stores[key] = store
+
+	return store
+}
+
+// conflicts returns whether the tx read a key of written, mapping the stores
+// to the keys written by the previously committed txs of the block.
+func (a *txAccess) conflicts(written map[storetypes.StoreKey]map[string]struct{}) bool {
+	for key, store := range a.stores {
+		keys := written[key]
+		if len(keys) == 0 {
+			continue
+		}
+
+		for k := range store.reads {
+			if _, ok := keys[k]; ok {
+				return true
+			}
+		}
+
+		for _, r := range store.ranges {
+			for k := range keys {
+				if r.contains([]byte(k)) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// write applies the writes of the tx to the block state and adds the written
+// keys to written.
+func (a *txAccess) write(written map[storetypes.StoreKey]map[string]struct{}) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	for key, store := range a.stores {
+		if len(store.writes) == 0 {
+			continue
+		}
+
+		if written[key] == nil {
+			written[key] = make(map[string]struct{})
+		}
+
+		parent := a.parent.GetKVStore(key)
+		for k, value := range store.writes {
+			if value == nil {
+				parent.Delete([]byte(k))
+			} else {
+				parent.Set([]byte(k), value)
+			}
+			written[key][k] = struct{}{}
+		}
+	}
+}
+
+// accessStore is a KVStore recording the keys read from its parent and
+// buffering the writes applied to it. It is meant to be branched, the writes
+// being only flushed to it once the tx is executed.
+type accessStore struct {
+	parent storetypes.KVStore
+	mtx    *sync.Mutex
+	reads  map[string]struct{}
+	ranges []keyRange
+	writes map[string][]byte // a nil value is a deletion
+}
+
+var _ storetypes.KVStore = (*accessStore)(nil)
+
+// GetStoreType implements Store.
+func (s *accessStore) GetStoreType() storetypes.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// CacheWrap implements CacheWrapper.
+func (s *accessStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (s *accessStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// Get implements KVStore.
+func (s *accessStore) Get(key []byte) []byte {
+	s.reads[string(key)] = struct{}{}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.parent.Get(key)
+}
+
+// Has implements KVStore.
+func (s *accessStore) Has(key []byte) bool {
+	s.reads[string(key)] = struct{}{}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.parent.Has(key)
+}
+
+// Set implements KVStore.
+func (s *accessStore) Set(key, value []byte) {
+	storetypes.AssertValidKey(key)
+	storetypes.AssertValidValue(value)
+
+	s.writes[string(key)] = value
+}
+
+// Delete implements KVStore.
+func (s *accessStore) Delete(key []byte) {
+	storetypes.AssertValidKey(key)
+
+	s.writes[string(key)] = nil
+}
+
+// Iterator implements KVStore.
+func (s *accessStore) Iterator(start, end []byte) storetypes.Iterator {
+	return s.iterator(start, end, true)
+}
+
+// ReverseIterator implements KVStore.
+func (s *accessStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return s.iterator(start, end, false)
+}
+
+// iterator records the iterated range and returns an iterator over a copy of
+// its entries, as the parent cannot be accessed outside of the lock.
+func (s *accessStore) iterator(start, end []byte, ascending bool) storetypes.Iterator {
+	s.ranges = append(s.ranges, keyRange{start: slices.Clone(start), end: slices.Clone(end)})
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	var parent storetypes.Iterator
+	if ascending {
+		parent = s.parent.Iterator(start, end)
+	} else {
+		parent = s.parent.ReverseIterator(start, end)
+	}
+	defer parent.Close()
+
+	it := &pairsIterator{start: start, end: end}
+	for ; parent.Valid(); parent.Next() {
+		it.keys = append(it.keys, slices.Clone(parent.Key()))
+		it.values = append(it.values, slices.Clone(parent.Value()))
+	}
+	it.err = parent.Error()
+
+	return it
+}
+
+// pairsIterator iterates over a list of key-value pairs.
+type pairsIterator struct {
+	start, end []byte
+	keys       [][]byte
+	values     [][]byte
+	err        error
+}
+
+var _ storetypes.Iterator = (*pairsIterator)(nil)
+
+func (it *pairsIterator) Domain() ([]byte, []byte) { return it.start, it.end }
+
+func (it *pairsIterator) Valid() bool { return len(it.keys) > 0 }
+
+func (it *pairsIterator) Next() {
+	it.assertValid()
+	it.keys, it.values = it.keys[1:], it.values[1:]
+}
+
+func (it *pairsIterator) Key() []byte {
+	it.assertValid()
+	return it.keys[0]
+}
+
+func (it *pairsIterator) Value() []byte {
+	it.assertValid()
+	return it.values[0]
+}
+
+func (it *pairsIterator) Error() error { return it.err }
+
+func (it *pairsIterator) Close() error { return nil }
+
+func (it *pairsIterator) assertValid() {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+}
+
+// kvStoreGetter returns the KVStore of a store key.
+type kvStoreGetter interface {
+	GetKVStore(key storetypes.StoreKey) storetypes.KVStore
+}
+
+// branchMultiStore is a CacheMultiStore branching the stores of its parent
+// when they are first accessed.
+type branchMultiStore struct {
+	parent kvStoreGetter
+	stores map[storetypes.StoreKey]storetypes.CacheWrap
+}
+
+var _ storetypes.CacheMultiStore = (*branchMultiStore)(nil)
+
+func newBranchMultiStore(parent kvStoreGetter) *branchMultiStore {
+	return &branchMultiStore{
+		parent: parent,
+		stores: make(map[storetypes.StoreKey]storetypes.CacheWrap),
+	}
+}
+
+// GetStoreType implements Store.
+func (ms *branchMultiStore) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeMulti
+}
+
+// CacheWrap implements CacheWrapper.
+func (ms *branchMultiStore) CacheWrap() storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (ms *branchMultiStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return ms.CacheWrap()
+}
+
+// CacheMultiStore implements MultiStore.
+func (ms *branchMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return newBranchMultiStore(ms)
+}
+
+// CacheMultiStoreWithVersion implements MultiStore. It panics as a branch
+// cannot load previous versions.
+func (ms *branchMultiStore) CacheMultiStoreWithVersion(_ int64) (storetypes.CacheMultiStore, error) {
+	panic("cannot branch cached multi-store with a version")
+}
+
+// GetStore implements MultiStore.
+func (ms *branchMultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return ms.GetKVStore(key)
+}
+
+// GetKVStore implements MultiStore.
+func (ms *branchMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	store, ok := ms.stores[key]
+	if !ok {
+		store = ms.parent.GetKVStore(key).CacheWrap()
+		ms.stores[key] = store
+	}
+
+	return store.(storetypes.KVStore)
+}
+
+// TracingEnabled implements MultiStore. Tracing is not supported.
+func (ms *branchMultiStore) TracingEnabled() bool {
+	return false
+}
+
+// SetTracer implements MultiStore. Tracing is not supported.
+func (ms *branchMultiStore) SetTracer(_ io.Writer) storetypes.MultiStore {
+	return ms
+}
+
+// SetTracingContext implements MultiStore. Tracing is not supported.
+func (ms *branchMultiStore) SetTracingContext(_ storetypes.TraceContext) storetypes.MultiStore {
+	return ms
+}
+
+// LatestVersion implements MultiStore. It panics as a branch has no version.
+func (ms *branchMultiStore) LatestVersion() int64 {
+	panic("cannot get latest version from branch cached multi-store")
+}
+
+// Write writes the branched stores to their parent.
+func (ms *branchMultiStore) Write() {
+	for _, store := range ms.stores {
+		store.Write()
+	}
+}
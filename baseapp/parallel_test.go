@@ -0,0 +1,171 @@
+package baseapp_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// appendKeyValueServer appends the value of a msg to the value stored at its
+// key, so that the state depends on the order of the txs setting a same key.
+type appendKeyValueServer struct{}
+
+func (appendKeyValueServer) Set(ctx context.Context, msg *baseapptestutil.MsgKeyValue) (*baseapptestutil.MsgCreateKeyValueResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := sdkCtx.KVStore(capKey2)
+	store.Set(msg.Key, slices.Concat(store.Get(msg.Key), msg.Value))
+	sdkCtx.GasMeter().ConsumeGas(uint64(len(msg.Value)), "test")
+
+	return &baseapptestutil.MsgCreateKeyValueResponse{}, nil
+}
+
+// countKeysServer counts the keys of capKey2, failing on odd counters.
+func countKeysServer() mockCounterServer {
+	return mockCounterServer{
+		incrementCounterFn: func(ctx context.Context, msg *baseapptestutil.MsgCounter) (*baseapptestutil.MsgCreateCounterResponse, error) {
+			if msg.Counter%2 == 1 {
+				return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "odd counter")
+			}
+
+			sdkCtx := sdk.UnwrapSDKContext(ctx)
+			it := sdkCtx.KVStore(capKey2).Iterator(nil, nil)
+			defer it.Close()
+
+			var count int
+			for ; it.Valid(); it.Next() {
+				count++
+			}
+
+			sdkCtx.KVStore(capKey1).Set(fmt.Appendf(nil, "count-%d", msg.Counter), fmt.Append(nil, count))
+			sdkCtx.EventManager().EmitEvent(sdk.NewEvent("count", sdk.NewAttribute("keys", fmt.Sprint(count))))
+
+			return &baseapptestutil.MsgCreateCounterResponse{}, nil
+		},
+	}
+}
+
+func TestABCI_FinalizeBlock_ParallelTxExecution(t *testing.T) {
+	newSuite := func(t *testing.T, opts ...func(*baseapp.BaseApp)) *BaseAppSuite {
+		t.Helper()
+
+		suite := NewBaseAppSuite(t, opts...)
+		baseapptestutil.RegisterKeyValueServer(suite.baseApp.MsgServiceRouter(), appendKeyValueServer{})
+		baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), countKeysServer())
+
+		_, err := suite.baseApp.InitChain(&abci.InitChainRequest{
+			ConsensusParams: &cmtproto.ConsensusParams{
+				Block: &cmtproto.BlockParams{MaxGas: 5_000_000},
+			},
+		})
+		require.NoError(t, err)
+
+		return suite
+	}
+
+	sequential := newSuite(t)
+	parallel := newSuite(t, baseapp.SetParallelTxExecution(4))
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	newTx := func(msg sdk.Msg) []byte {
+		builder := sequential.txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msg))
+		setTxSignature(t, builder, 0)
+
+		bz, err := sequential.txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+
+		return bz
+	}
+	setTx := func(key, value string) []byte {
+		return newTx(&baseapptestutil.MsgKeyValue{Key: []byte(key), Value: []byte(value), Signer: addr.String()})
+	}
+	countTx := func(counter int64) []byte {
+		return newTx(&baseapptestutil.MsgCounter{Counter: counter, Signer: addr.String()})
+	}
+
+	large := strings.Repeat("x", 50_000)
+	blocks := [][][]byte{
+		// disjoint keys
+		{setTx("a", "1"), setTx("b", "1"), setTx("c", "1"), setTx("d", "1")},
+		// conflicting writes, iterations and undecodable txs
+		{
+			setTx("a", "2"), setTx("a", "3"), countTx(0), setTx("e", "1"),
+			[]byte("invalid"), countTx(1), setTx("b", "2"), countTx(2), setTx("a", "4"),
+		},
+		// block gas exhaustion
+		{setTx("f", large), setTx("g", large), setTx("h", large), setTx("i", large), setTx("j", large), setTx("k", large)},
+	}
+
+	for i, txs := range blocks {
+		req := &abci.FinalizeBlockRequest{Height: int64(i) + 1, Txs: txs}
+
+		expRes, err := sequential.baseApp.FinalizeBlock(req)
+		require.NoError(t, err)
+		res, err := parallel.baseApp.FinalizeBlock(req)
+		require.NoError(t, err)
+
+		require.Equal(t, expRes.TxResults, res.TxResults)
+		require.Equal(t, expRes.AppHash, res.AppHash)
+
+		_, err = sequential.baseApp.Commit()
+		require.NoError(t, err)
+		_, err = parallel.baseApp.Commit()
+		require.NoError(t, err)
+	}
+
+	// the expected state was built, including failures
+	ctx := parallel.baseApp.NewContext(true)
+	require.Equal(t, []byte("1234"), ctx.KVStore(capKey2).Get([]byte("a")))
+	require.Equal(t, []byte("12"), ctx.KVStore(capKey2).Get([]byte("b")))
+	require.Equal(t, []byte("4"), ctx.KVStore(capKey1).Get([]byte("count-0")))
+	require.Equal(t, []byte("5"), ctx.KVStore(capKey1).Get([]byte("count-2")))
+	require.Nil(t, ctx.KVStore(capKey1).Get([]byte("count-1")))
+	require.NotNil(t, ctx.KVStore(capKey2).Get([]byte("f")))
+	require.Nil(t, ctx.KVStore(capKey2).Get([]byte("k")))
+}
+
+func TestABCI_FinalizeBlock_ParallelTxExecution_FailedMsg(t *testing.T) {
+	// the writes of a failing msg are discarded while the ones of the ante
+	// handler are kept, as with a sequential execution
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			ctx.KVStore(capKey1).Set([]byte("ante"), []byte("1"))
+			return ctx, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetParallelTxExecution(2))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), mockCounterServer{
+		incrementCounterFn: func(ctx context.Context, msg *baseapptestutil.MsgCounter) (*baseapptestutil.MsgCreateCounterResponse, error) {
+			sdk.UnwrapSDKContext(ctx).KVStore(capKey1).Set([]byte("msg"), []byte("1"))
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+		},
+	})
+
+	_, err := suite.baseApp.InitChain(&abci.InitChainRequest{ConsensusParams: &cmtproto.ConsensusParams{}})
+	require.NoError(t, err)
+
+	txBytes, err := suite.txConfig.TxEncoder()(newTxCounter(t, suite.txConfig, 0, 0))
+	require.NoError(t, err)
+
+	res, err := suite.baseApp.FinalizeBlock(&abci.FinalizeBlockRequest{Height: 1, Txs: [][]byte{txBytes}})
+	require.NoError(t, err)
+	require.False(t, res.TxResults[0].IsOK())
+
+	ctx := getFinalizeBlockStateCtx(suite.baseApp)
+	require.Equal(t, []byte("1"), ctx.KVStore(capKey1).Get([]byte("ante")))
+	require.Nil(t, ctx.KVStore(capKey1).Get([]byte("msg")))
+}
@@ -1,9 +1,18 @@
 package baseapp
 
 import (
+	"errors"
+	"sort"
+
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	dbm "github.com/cosmos/cosmos-db"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/listenkv"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -29,6 +38,42 @@ func (app *BaseApp) Simulate(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
 	return gasInfo, result, err
 }
 
+// SimulateWithStateDiff executes a tx in simulate mode as Simulate does, and
+// also returns the final writes the tx would make to the persistent KV stores,
+// sorted by store name and key.
+func (app *BaseApp) SimulateWithStateDiff(txBytes []byte) (sdk.GasInfo, *sdk.Result, []*storetypes.StoreKVPair, error) {
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		return sdk.GasInfo{}, nil, nil, errors.New("state diff simulation requires a root multi-store")
+	}
+
+	// The tx runs in a branch of the simulation context whose stores are
+	// listened to, so that writing the branch once the tx has run reports its
+	// final writes, in the order of the keys of each store.
+	ctx := app.getContextForTx(execModeSimulate, txBytes)
+	listener := storetypes.NewMemoryListener()
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper)
+	for _, key := range rms.StoreKeysByName() {
+		store := ctx.MultiStore().GetKVStore(key)
+		if _, ok := key.(*storetypes.KVStoreKey); ok {
+			store = listenkv.NewStore(store, key, listener)
+		}
+		stores[key] = store
+	}
+	msCache := cachemulti.NewFromKVStore(dbadapter.Store{DB: dbm.NewMemDB()}, stores, rms.StoreKeysByName(), nil, nil)
+
+	gasInfo, result, _, err := app.runTxWithContext(ctx.WithMultiStore(msCache), execModeSimulate, txBytes, nil)
+	if err != nil {
+		return gasInfo, result, nil, err
+	}
+
+	msCache.Write()
+	writes := listener.PopStateCache()
+	sort.SliceStable(writes, func(i, j int) bool { return writes[i].StoreKey < writes[j].StoreKey })
+
+	return gasInfo, result, writes, nil
+}
+
 func (app *BaseApp) SimDeliver(txEncoder sdk.TxEncoder, tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
 	// See comment for Check().
 	bz, err := txEncoder(tx)
@@ -0,0 +1,11 @@
+package client
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GasPriceRetriever defines the interface required by transactions to query
+// for the gas prices to use when neither fees nor gas prices are provided.
+type GasPriceRetriever interface {
+	GetGasPrices(clientCtx Context) (sdk.DecCoins, error)
+}
@@ -0,0 +1,47 @@
+package mempool
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+var ServiceAutoCLIDescriptor = &autocliv1.ServiceCommandDescriptor{
+	Service: Query_serviceDesc.ServiceName,
+	RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+		{
+			RpcMethod: "PendingTxs",
+			Use:       "pending-txs",
+			Short:     "Query the txs pending in the node mempool, optionally filtered by sender or message type URL",
+			FlagOptions: map[string]*autocliv1.FlagOptions{
+				"sender":       {Usage: "Only list the txs of the given sender"},
+				"msg_type_url": {Usage: "Only list the txs containing a message of the given type URL"},
+			},
+		},
+		{
+			RpcMethod:      "SenderTxs",
+			Use:            "sender-txs [sender]",
+			Short:          "Query the nonce ordered txs of a sender in the node mempool, along with their priority",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}},
+		},
+	},
+}
+
+// NewMempoolCommands is a fake `appmodule.Module` to be considered as a module
+// and be added in AutoCLI.
+func NewMempoolCommands() *mempoolModule {
+	return &mempoolModule{}
+}
+
+type mempoolModule struct{}
+
+func (m mempoolModule) IsOnePerModuleType() {}
+func (m mempoolModule) IsAppModule()        {}
+
+func (m mempoolModule) Name() string {
+	return "mempool"
+}
+
+func (m mempoolModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: ServiceAutoCLIDescriptor,
+	}
+}
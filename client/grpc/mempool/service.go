@@ -0,0 +1,210 @@
+package mempool
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// App defines the application whose mempool is inspected by the mempool query
+// service.
+type App interface {
+	Mempool() sdkmempool.Mempool
+	TxEncode(tx sdk.Tx) ([]byte, error)
+}
+
+// RegisterMempoolService registers the mempool query service on the provided
+// gRPC router.
+func RegisterMempoolService(server gogogrpc.Server, app App) {
+	RegisterQueryServer(server, NewQueryServer(app))
+}
+
+// RegisterGRPCGatewayRoutes mounts the mempool query service's GRPC-gateway
+// routes on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientConn))
+}
+
+var _ QueryServer = queryServer{}
+
+type queryServer struct {
+	app             App
+	signerExtractor sdkmempool.SignerExtractionAdapter
+}
+
+// NewQueryServer returns a QueryServer inspecting the mempool of app.
+func NewQueryServer(app App) QueryServer {
+	return queryServer{
+		app:             app,
+		signerExtractor: sdkmempool.NewDefaultSignerExtractionAdapter(),
+	}
+}
+
+// PendingTxs implements the Query/PendingTxs gRPC method.
+func (s queryServer) PendingTxs(_ context.Context, req *QueryPendingTxsRequest) (*QueryPendingTxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var sender sdk.AccAddress
+	if req.Sender != "" {
+		var err error
+		if sender, err = sdk.AccAddressFromBech32(req.Sender); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sender address: %s", err)
+		}
+	}
+
+	var (
+		offset     uint64
+		limit      = uint64(query.DefaultLimit)
+		countTotal bool
+	)
+	if req.Pagination != nil {
+		if len(req.Pagination.Key) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "key based pagination is not supported")
+		}
+		offset, countTotal = req.Pagination.Offset, req.Pagination.CountTotal
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+	}
+
+	mp, ok := s.app.Mempool().(sdkmempool.InspectableMempool)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "mempool %T does not keep txs per sender", s.app.Mempool())
+	}
+
+	// txs are listed by sender, then by nonce, so that pages are stable as long
+	// as the mempool is not updated in between
+	senders := mp.Senders()
+	if !sender.Empty() {
+		senders = []string{sender.String()}
+	}
+
+	var (
+		txs   = make([]*PendingTx, 0)
+		total uint64
+	)
+	for _, addr := range senders {
+		for _, senderTx := range mp.SenderTxs(addr) {
+			if !matches(senderTx.Tx, req.MsgTypeUrl) {
+				continue
+			}
+
+			total++
+			if total <= offset || uint64(len(txs)) >= limit {
+				continue
+			}
+
+			pendingTx, err := s.pendingTx(senderTx.Tx)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			pendingTx.Nonce, pendingTx.Priority = senderTx.Nonce, senderTx.Priority
+
+			txs = append(txs, pendingTx)
+		}
+
+		if !countTotal && uint64(len(txs)) >= limit {
+			break
+		}
+	}
+
+	pageRes := &query.PageResponse{}
+	if countTotal {
+		pageRes.Total = total
+	}
+
+	return &QueryPendingTxsResponse{Txs: txs, Pagination: pageRes}, nil
+}
+
+// SenderTxs implements the Query/SenderTxs gRPC method.
+func (s queryServer) SenderTxs(_ context.Context, req *QuerySenderTxsRequest) (*QuerySenderTxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sender address: %s", err)
+	}
+
+	mp, ok := s.app.Mempool().(sdkmempool.InspectableMempool)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "mempool %T does not keep txs per sender", s.app.Mempool())
+	}
+
+	senderTxs := mp.SenderTxs(sender.String())
+	txs := make([]*PendingTx, 0, len(senderTxs))
+	for _, senderTx := range senderTxs {
+		pendingTx, err := s.pendingTx(senderTx.Tx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		pendingTx.Nonce, pendingTx.Priority = senderTx.Nonce, senderTx.Priority
+
+		txs = append(txs, pendingTx)
+	}
+
+	return &QuerySenderTxsResponse{Txs: txs}, nil
+}
+
+// matches returns whether tx contains a message of type msgTypeURL. An empty
+// type URL matches any tx.
+func matches(tx sdk.Tx, msgTypeURL string) bool {
+	if msgTypeURL == "" {
+		return true
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if sdk.MsgTypeURL(msg) == msgTypeURL {
+			return true
+		}
+	}
+
+	return false
+}
+
+// pendingTx describes tx, leaving its priority empty.
+func (s queryServer) pendingTx(tx sdk.Tx) (*PendingTx, error) {
+	txBytes, err := s.app.TxEncode(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	signers, err := s.signerExtractor.GetSigners(tx)
+	if err != nil {
+		return nil, err
+	}
+	if len(signers) == 0 {
+		return nil, fmt.Errorf("tx must have at least one signer")
+	}
+
+	nonce, err := sdkmempool.ChooseNonce(signers[0].Sequence, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := tx.GetMsgs()
+	msgTypeURLs := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		msgTypeURLs = append(msgTypeURLs, sdk.MsgTypeURL(msg))
+	}
+
+	return &PendingTx{
+		Hash:        fmt.Sprintf("%X", sha256.Sum256(txBytes)),
+		TxBytes:     txBytes,
+		Sender:      signers[0].Signer.String(),
+		Nonce:       nonce,
+		MsgTypeUrls: msgTypeURLs,
+	}, nil
+}
@@ -0,0 +1,138 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protov2 "google.golang.org/protobuf/proto"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txsigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+type testPubKey struct {
+	cryptotypes.PubKey
+	address sdk.AccAddress
+}
+
+func (pk testPubKey) Address() cryptotypes.Address { return pk.address.Bytes() }
+
+type testTx struct {
+	sender sdk.AccAddress
+	nonce  uint64
+	msgs   []sdk.Msg
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func (tx testTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+func (tx testTx) GetSigners() ([][]byte, error) { return [][]byte{tx.sender}, nil }
+
+func (tx testTx) GetPubKeys() ([]cryptotypes.PubKey, error) { return nil, nil }
+
+func (tx testTx) GetSignaturesV2() ([]txsigning.SignatureV2, error) {
+	return []txsigning.SignatureV2{{PubKey: testPubKey{address: tx.sender}, Sequence: tx.nonce}}, nil
+}
+
+type testApp struct {
+	mempool sdkmempool.Mempool
+}
+
+func (app testApp) Mempool() sdkmempool.Mempool { return app.mempool }
+
+func (app testApp) TxEncode(tx sdk.Tx) ([]byte, error) {
+	t := tx.(testTx)
+	return []byte(fmt.Sprintf("%s/%d", t.sender, t.nonce)), nil
+}
+
+func TestQueryServer(t *testing.T) {
+	_, _, sa := testdata.KeyTestPubAddr()
+	_, _, sb := testdata.KeyTestPubAddr()
+	if sa.String() > sb.String() {
+		sa, sb = sb, sa
+	}
+	dogMsg, testMsg := &testdata.MsgCreateDog{}, testdata.NewTestMsg(sa)
+
+	mp := sdkmempool.NewPriorityMempool(sdkmempool.DefaultPriorityNonceMempoolConfig())
+	ctx := sdk.Context{}
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), testTx{sender: sa, nonce: 0, msgs: []sdk.Msg{testMsg}}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(20), testTx{sender: sa, nonce: 1, msgs: []sdk.Msg{dogMsg}}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(5), testTx{sender: sb, nonce: 3, msgs: []sdk.Msg{dogMsg, testMsg}}))
+
+	svr := NewQueryServer(testApp{mempool: mp})
+	goCtx := context.Background()
+
+	_, err := svr.PendingTxs(goCtx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	res, err := svr.PendingTxs(goCtx, &QueryPendingTxsRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 3)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	require.Equal(t, sa.String(), res.Txs[0].Sender)
+	require.Equal(t, "10", res.Txs[0].Priority)
+	require.Equal(t, []string{sdk.MsgTypeURL(testMsg)}, res.Txs[0].MsgTypeUrls)
+	require.Equal(t, []byte(fmt.Sprintf("%s/0", sa)), res.Txs[0].TxBytes)
+	require.Len(t, res.Txs[0].Hash, 64)
+
+	// filters
+	res, err = svr.PendingTxs(goCtx, &QueryPendingTxsRequest{Sender: sb.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 1)
+	require.Equal(t, uint64(3), res.Txs[0].Nonce)
+	require.Equal(t, "5", res.Txs[0].Priority)
+
+	res, err = svr.PendingTxs(goCtx, &QueryPendingTxsRequest{MsgTypeUrl: sdk.MsgTypeURL(dogMsg)})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 2)
+
+	_, err = svr.PendingTxs(goCtx, &QueryPendingTxsRequest{Sender: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// pagination, txs being listed by sender then by nonce
+	for i, exp := range []struct {
+		sender sdk.AccAddress
+		nonce  uint64
+	}{{sa, 0}, {sa, 1}, {sb, 3}} {
+		res, err = svr.PendingTxs(goCtx, &QueryPendingTxsRequest{Pagination: &query.PageRequest{Offset: uint64(i), Limit: 1, CountTotal: true}})
+		require.NoError(t, err)
+		require.Len(t, res.Txs, 1)
+		require.Equal(t, exp.sender.String(), res.Txs[0].Sender)
+		require.Equal(t, exp.nonce, res.Txs[0].Nonce)
+		require.Equal(t, uint64(3), res.Pagination.Total)
+	}
+
+	res, err = svr.PendingTxs(goCtx, &QueryPendingTxsRequest{Pagination: &query.PageRequest{Offset: 3}})
+	require.NoError(t, err)
+	require.Empty(t, res.Txs)
+
+	_, err = svr.PendingTxs(goCtx, &QueryPendingTxsRequest{Pagination: &query.PageRequest{Key: []byte("key")}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// sender queues
+	senderRes, err := svr.SenderTxs(goCtx, &QuerySenderTxsRequest{Sender: sa.String()})
+	require.NoError(t, err)
+	require.Len(t, senderRes.Txs, 2)
+	require.Equal(t, uint64(0), senderRes.Txs[0].Nonce)
+	require.Equal(t, "10", senderRes.Txs[0].Priority)
+	require.Equal(t, uint64(1), senderRes.Txs[1].Nonce)
+	require.Equal(t, "20", senderRes.Txs[1].Priority)
+
+	// querying doesn't modify the mempool
+	require.Equal(t, 3, mp.CountTx())
+
+	noOpSvr := NewQueryServer(testApp{mempool: sdkmempool.NoOpMempool{}})
+	_, err = noOpSvr.PendingTxs(goCtx, &QueryPendingTxsRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+	_, err = noOpSvr.SenderTxs(goCtx, &QuerySenderTxsRequest{Sender: sa.String()})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -30,6 +30,7 @@ type Factory struct {
 	keybase            keyring.Keyring
 	txConfig           client.TxConfig
 	accountRetriever   client.AccountRetriever
+	gasPriceRetriever  client.GasPriceRetriever
 	accountNumber      uint64
 	sequence           uint64
 	gas                uint64
@@ -74,6 +75,8 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 		signMode = signing.SignMode_SIGN_MODE_TEXTUAL
 	case flags.SignModeEIP191:
 		signMode = signing.SignMode_SIGN_MODE_EIP_191
+	case flags.SignModeEIP712:
+		signMode = signing.SignMode_SIGN_MODE_EIP_712
 	}
 
 	var accNum, accSeq uint64
@@ -125,27 +128,32 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 	f = f.WithFees(feesStr)
 
 	gasPricesStr := clientCtx.Viper.GetString(flags.FlagGasPrices)
-	f = f.WithGasPrices(gasPricesStr)
+	if gasPricesStr == flags.GasPricesFlagAuto {
+		f = f.WithGasPriceRetriever(RecentGasPriceRetriever{})
+	} else {
+		f = f.WithGasPrices(gasPricesStr)
+	}
 
 	f = f.WithPreprocessTxHook(clientCtx.PreprocessTxHook)
 
 	return f, nil
 }
 
-func (f Factory) AccountNumber() uint64                     { return f.accountNumber }
-func (f Factory) Sequence() uint64                          { return f.sequence }
-func (f Factory) Gas() uint64                               { return f.gas }
-func (f Factory) GasAdjustment() float64                    { return f.gasAdjustment }
-func (f Factory) Keybase() keyring.Keyring                  { return f.keybase }
-func (f Factory) ChainID() string                           { return f.chainID }
-func (f Factory) Memo() string                              { return f.memo }
-func (f Factory) Fees() sdk.Coins                           { return f.fees }
-func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
-func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
-func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
-func (f Factory) TimeoutTimestamp() time.Time               { return f.timeoutTimestamp }
-func (f Factory) Unordered() bool                           { return f.unordered }
-func (f Factory) FromName() string                          { return f.fromName }
+func (f Factory) AccountNumber() uint64                       { return f.accountNumber }
+func (f Factory) Sequence() uint64                            { return f.sequence }
+func (f Factory) Gas() uint64                                 { return f.gas }
+func (f Factory) GasAdjustment() float64                      { return f.gasAdjustment }
+func (f Factory) Keybase() keyring.Keyring                    { return f.keybase }
+func (f Factory) ChainID() string                             { return f.chainID }
+func (f Factory) Memo() string                                { return f.memo }
+func (f Factory) Fees() sdk.Coins                             { return f.fees }
+func (f Factory) GasPrices() sdk.DecCoins                     { return f.gasPrices }
+func (f Factory) AccountRetriever() client.AccountRetriever   { return f.accountRetriever }
+func (f Factory) GasPriceRetriever() client.GasPriceRetriever { return f.gasPriceRetriever }
+func (f Factory) TimeoutHeight() uint64                       { return f.timeoutHeight }
+func (f Factory) TimeoutTimestamp() time.Time                 { return f.timeoutTimestamp }
+func (f Factory) Unordered() bool                             { return f.unordered }
+func (f Factory) FromName() string                            { return f.fromName }
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
 // using the gas from the simulation results
@@ -163,6 +171,15 @@ func (f Factory) WithAccountRetriever(ar client.AccountRetriever) Factory {
 	return f
 }
 
+// WithGasPriceRetriever returns a copy of the Factory with an updated
+// GasPriceRetriever. When set, Prepare fills in the gas prices returned by the
+// retriever if neither fees nor gas prices were provided, e.g. to pay the x/auth
+// fee market base gas price.
+func (f Factory) WithGasPriceRetriever(gpr client.GasPriceRetriever) Factory {
+	f.gasPriceRetriever = gpr
+	return f
+}
+
 // WithChainID returns a copy of the Factory with an updated chainID.
 func (f Factory) WithChainID(chainID string) Factory {
 	f.chainID = chainID
@@ -545,5 +562,14 @@ func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 		}
 	}
 
+	if fc.gasPriceRetriever != nil && fc.fees.IsZero() && fc.gasPrices.IsZero() {
+		gasPrices, err := fc.gasPriceRetriever.GetGasPrices(clientCtx)
+		if err != nil {
+			return fc, err
+		}
+
+		fc.gasPrices = gasPrices
+	}
+
 	return fc, nil
 }
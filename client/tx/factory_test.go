@@ -3,9 +3,11 @@ package tx
 import (
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -128,3 +130,14 @@ func TestFactory_getSimSignatureData(t *testing.T) {
 		})
 	}
 }
+
+func TestNewFactoryCLIGasPricesAuto(t *testing.T) {
+	cmd := &cobra.Command{}
+	flags.AddTxFlagsToCmd(cmd)
+	require.NoError(t, cmd.Flags().Set(flags.FlagGasPrices, flags.GasPricesFlagAuto))
+
+	factory, err := NewFactoryCLI(client.Context{}, cmd.Flags())
+	require.NoError(t, err)
+	require.True(t, factory.GasPrices().IsZero())
+	require.Equal(t, RecentGasPriceRetriever{}, factory.GasPriceRetriever())
+}
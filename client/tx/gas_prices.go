@@ -0,0 +1,40 @@
+package tx
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ client.GasPriceRetriever = RecentGasPriceRetriever{}
+
+// RecentGasPriceRetriever retrieves the normal gas price recommended by the
+// node from the gas prices paid in the recent blocks, in the fee denom most of
+// their txs paid fees in. It backs the --gas-prices=auto flag.
+type RecentGasPriceRetriever struct{}
+
+// GetGasPrices queries for the gas prices recommended by the node. An error is
+// returned when no tx paid fees in the recent blocks.
+func (RecentGasPriceRetriever) GetGasPrices(clientCtx client.Context) (sdk.DecCoins, error) {
+	serviceClient := txtypes.NewServiceClient(clientCtx)
+	res, err := serviceClient.GasPrices(context.Background(), &txtypes.GasPricesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.GasPrices) == 0 {
+		return nil, errors.New("no gas prices were paid in the recent blocks to recommend gas prices from; please set the gas prices explicitly")
+	}
+
+	estimate := res.GasPrices[0]
+	for _, gasPrice := range res.GasPrices[1:] {
+		if gasPrice.Samples > estimate.Samples {
+			estimate = gasPrice
+		}
+	}
+
+	return sdk.NewDecCoins(sdk.NewDecCoinFromDec(estimate.Denom, estimate.Normal)), nil
+}
@@ -0,0 +1,367 @@
+package tx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// MultisignSession is an offline signing session of a tx by the members of a
+// multisig account. The members sign the tx in turn, each appending their
+// signature to the session, which is finalized into the signed tx once the
+// threshold of the multisig is met.
+type MultisignSession struct {
+	ChainID       string
+	AccountNumber uint64
+	Sequence      uint64
+	SignMode      signing.SignMode
+	PubKey        multisig.PubKey
+	Tx            authsigning.Tx
+	// Signatures are the signatures of the members of the multisig.
+	Signatures []signing.SignatureV2
+}
+
+// MultisignSessionStatus is the signature progress of a MultisignSession.
+type MultisignSessionStatus struct {
+	Threshold uint32   `json:"threshold" yaml:"threshold"`
+	Signed    []string `json:"signed" yaml:"signed"`
+	Pending   []string `json:"pending" yaml:"pending"`
+	Complete  bool     `json:"complete" yaml:"complete"`
+}
+
+// multisignSessionJSON is the JSON encoding of a MultisignSession.
+type multisignSessionJSON struct {
+	ChainID       string          `json:"chain_id"`
+	AccountNumber uint64          `json:"account_number,string"`
+	Sequence      uint64          `json:"sequence,string"`
+	SignMode      string          `json:"sign_mode"`
+	PubKey        json.RawMessage `json:"pub_key"`
+	Tx            json.RawMessage `json:"tx"`
+	Signatures    json.RawMessage `json:"signatures"`
+}
+
+// NewMultisignSession creates a signing session of tx by the members of the
+// multisig pubKey, which must be a signer of tx. The sign mode defaults to
+// SIGN_MODE_LEGACY_AMINO_JSON, and SIGN_MODE_DIRECT is not supported.
+func NewMultisignSession(
+	tx authsigning.Tx, pubKey multisig.PubKey, chainID string, accNum, seq uint64, signMode signing.SignMode,
+) (*MultisignSession, error) {
+	if chainID == "" {
+		return nil, errors.New("chain id is required")
+	}
+
+	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		signMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	}
+	if signMode == signing.SignMode_SIGN_MODE_DIRECT {
+		return nil, errors.New("multisig signing sessions do not support SIGN_MODE_DIRECT")
+	}
+
+	signers, err := tx.GetSigners()
+	if err != nil {
+		return nil, err
+	}
+	isSigner := false
+	for _, signer := range signers {
+		if bytes.Equal(signer, pubKey.Address()) {
+			isSigner = true
+			break
+		}
+	}
+	if !isSigner {
+		return nil, fmt.Errorf("multisig %s is not a signer of the tx", sdk.AccAddress(pubKey.Address()))
+	}
+
+	return &MultisignSession{
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      seq,
+		SignMode:      signMode,
+		PubKey:        pubKey,
+		Tx:            tx,
+	}, nil
+}
+
+// Sign signs the tx of the session with the named key of the keyring of txf,
+// which must be a member of the multisig, replacing its previous signature.
+func (s *MultisignSession) Sign(ctx context.Context, txf Factory, name string) error {
+	if txf.keybase == nil {
+		return errors.New("keybase must be set prior to signing a transaction")
+	}
+
+	k, err := txf.keybase.Key(name)
+	if err != nil {
+		return err
+	}
+	pubKey, err := k.GetPubKey()
+	if err != nil {
+		return err
+	}
+	if s.memberIndex(pubKey) < 0 {
+		return fmt.Errorf("key %s is not a member of multisig %s", name, sdk.AccAddress(s.PubKey.Address()))
+	}
+
+	signerData := authsigning.SignerData{
+		ChainID:       s.ChainID,
+		AccountNumber: s.AccountNumber,
+		Sequence:      s.Sequence,
+		PubKey:        pubKey,
+		Address:       sdk.AccAddress(pubKey.Address()).String(),
+	}
+	signBytes, err := authsigning.GetSignBytesAdapter(ctx, txf.txConfig.SignModeHandler(), s.SignMode, signerData, s.Tx)
+	if err != nil {
+		return err
+	}
+	sigBytes, _, err := txf.keybase.Sign(name, signBytes, s.SignMode)
+	if err != nil {
+		return err
+	}
+
+	sig := signing.SignatureV2{
+		PubKey: pubKey,
+		Data: &signing.SingleSignatureData{
+			SignMode:  s.SignMode,
+			Signature: sigBytes,
+		},
+		Sequence: s.Sequence,
+	}
+
+	for i, prev := range s.Signatures {
+		if prev.PubKey.Equals(pubKey) {
+			s.Signatures[i] = sig
+			return nil
+		}
+	}
+	s.Signatures = append(s.Signatures, sig)
+
+	return nil
+}
+
+// Status returns the signature progress of the session.
+func (s *MultisignSession) Status() MultisignSessionStatus {
+	status := MultisignSessionStatus{
+		Threshold: uint32(s.PubKey.GetThreshold()),
+		Signed:    []string{},
+		Pending:   []string{},
+	}
+
+	for _, member := range s.PubKey.GetPubKeys() {
+		addr := sdk.AccAddress(member.Address()).String()
+		if s.hasSigned(member) {
+			status.Signed = append(status.Signed, addr)
+		} else {
+			status.Pending = append(status.Pending, addr)
+		}
+	}
+	status.Complete = len(status.Signed) >= int(status.Threshold)
+
+	return status
+}
+
+// Verify verifies the signatures of the members of the multisig with the sign
+// mode handlers of txConfig.
+func (s *MultisignSession) Verify(ctx context.Context, txConfig client.TxConfig) error {
+	adaptableTx, ok := s.Tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", s.Tx)
+	}
+	txData := adaptableTx.GetSigningTxData()
+
+	for _, sig := range s.Signatures {
+		signerData, err := s.signerData(sig.PubKey)
+		if err != nil {
+			return err
+		}
+
+		err = authsigning.VerifySignature(ctx, sig.PubKey, signerData, sig.Data, txConfig.SignModeHandler(), txData)
+		if err != nil {
+			return fmt.Errorf("couldn't verify signature for address %s: %w", sdk.AccAddress(sig.PubKey.Address()), err)
+		}
+	}
+
+	return nil
+}
+
+// Finalize verifies the signatures of the session, and returns its tx signed
+// by the multisig once its threshold is met.
+func (s *MultisignSession) Finalize(ctx context.Context, txConfig client.TxConfig) (authsigning.Tx, error) {
+	if status := s.Status(); !status.Complete {
+		return nil, fmt.Errorf("multisig threshold not met: %d of %d signatures", len(status.Signed), status.Threshold)
+	}
+
+	if err := s.Verify(ctx, txConfig); err != nil {
+		return nil, err
+	}
+
+	multisigSig := multisig.NewMultisig(len(s.PubKey.GetPubKeys()))
+	for _, sig := range s.Signatures {
+		if err := multisig.AddSignatureV2(multisigSig, sig, s.PubKey.GetPubKeys()); err != nil {
+			return nil, err
+		}
+	}
+
+	txBuilder, err := txConfig.WrapTxBuilder(s.Tx)
+	if err != nil {
+		return nil, err
+	}
+	err = txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   s.PubKey,
+		Data:     multisigSig,
+		Sequence: s.Sequence,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the multisig signature is verified as a whole before the tx is broadcast
+	signerData, err := s.signerData(s.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	signedTx := txBuilder.GetTx()
+	adaptableTx, ok := signedTx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return nil, fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", signedTx)
+	}
+	err = authsigning.VerifySignature(ctx, s.PubKey, signerData, multisigSig, txConfig.SignModeHandler(), adaptableTx.GetSigningTxData())
+	if err != nil {
+		return nil, fmt.Errorf("couldn't verify multisig signature: %w", err)
+	}
+
+	return signedTx, nil
+}
+
+// memberIndex returns the index of pubKey in the members of the multisig, or
+// -1 if it is not a member.
+func (s *MultisignSession) memberIndex(pubKey cryptotypes.PubKey) int {
+	for i, member := range s.PubKey.GetPubKeys() {
+		if member.Equals(pubKey) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *MultisignSession) hasSigned(pubKey cryptotypes.PubKey) bool {
+	for _, sig := range s.Signatures {
+		if sig.PubKey.Equals(pubKey) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *MultisignSession) signerData(pubKey cryptotypes.PubKey) (txsigning.SignerData, error) {
+	anyPk, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return txsigning.SignerData{}, err
+	}
+
+	return txsigning.SignerData{
+		ChainID:       s.ChainID,
+		AccountNumber: s.AccountNumber,
+		Sequence:      s.Sequence,
+		Address:       sdk.AccAddress(pubKey.Address()).String(),
+		PubKey: &anypb.Any{
+			TypeUrl: anyPk.TypeUrl,
+			Value:   anyPk.Value,
+		},
+	}, nil
+}
+
+// WriteMultisignSession writes the JSON encoding of the session to the file.
+func WriteMultisignSession(clientCtx client.Context, filename string, s *MultisignSession) error {
+	pubKey, err := clientCtx.Codec.MarshalInterfaceJSON(s.PubKey)
+	if err != nil {
+		return err
+	}
+	tx, err := clientCtx.TxConfig.TxJSONEncoder()(s.Tx)
+	if err != nil {
+		return err
+	}
+	sigs, err := clientCtx.TxConfig.MarshalSignatureJSON(s.Signatures)
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.MarshalIndent(multisignSessionJSON{
+		ChainID:       s.ChainID,
+		AccountNumber: s.AccountNumber,
+		Sequence:      s.Sequence,
+		SignMode:      s.SignMode.String(),
+		PubKey:        pubKey,
+		Tx:            tx,
+		Signatures:    sigs,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, bz, 0o600)
+}
+
+// ReadMultisignSession reads a session from its JSON encoding in the file.
+func ReadMultisignSession(clientCtx client.Context, filename string) (*MultisignSession, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var sessionJSON multisignSessionJSON
+	if err := json.Unmarshal(bz, &sessionJSON); err != nil {
+		return nil, err
+	}
+
+	signMode, ok := signing.SignMode_value[sessionJSON.SignMode]
+	if !ok {
+		return nil, fmt.Errorf("invalid sign mode %s", sessionJSON.SignMode)
+	}
+
+	var pubKey cryptotypes.PubKey
+	if err := clientCtx.Codec.UnmarshalInterfaceJSON(sessionJSON.PubKey, &pubKey); err != nil {
+		return nil, err
+	}
+	multisigPubKey, ok := pubKey.(multisig.PubKey)
+	if !ok {
+		return nil, fmt.Errorf("expected a multisig pubkey, got %T", pubKey)
+	}
+
+	tx, err := clientCtx.TxConfig.TxJSONDecoder()(sessionJSON.Tx)
+	if err != nil {
+		return nil, err
+	}
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return nil, fmt.Errorf("expected tx to implement authsigning.Tx, got %T", tx)
+	}
+
+	sigs, err := clientCtx.TxConfig.UnmarshalSignatureJSON(sessionJSON.Signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MultisignSession{
+		ChainID:       sessionJSON.ChainID,
+		AccountNumber: sessionJSON.AccountNumber,
+		Sequence:      sessionJSON.Sequence,
+		SignMode:      signing.SignMode(signMode),
+		PubKey:        multisigPubKey,
+		Tx:            sigTx,
+		Signatures:    sigs,
+	}, nil
+}
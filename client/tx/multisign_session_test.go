@@ -0,0 +1,87 @@
+package tx
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMultisignSession(t *testing.T) {
+	txConfig, cdc := newTestTxConfig()
+	banktypes.RegisterInterfaces(cdc.InterfaceRegistry())
+	ctx := context.Background()
+	kb, err := keyring.New(t.Name(), "test", t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+	clientCtx := client.Context{}.WithCodec(cdc).WithTxConfig(txConfig)
+
+	names := []string{"k1", "k2", "k3", "other"}
+	pubKeys := make([]cryptotypes.PubKey, len(names))
+	for i, name := range names {
+		k, _, err := kb.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		pubKeys[i], err = k.GetPubKey()
+		require.NoError(t, err)
+	}
+	multisigPub := kmultisig.NewLegacyAminoPubKey(2, pubKeys[:3])
+
+	txf := mockTxFactory(txConfig).WithKeybase(kb)
+	txb, err := txf.BuildUnsignedTx(banktypes.NewMsgSend(sdk.AccAddress(multisigPub.Address()), sdk.AccAddress("to"), nil))
+	require.NoError(t, err)
+
+	_, err = NewMultisignSession(txb.GetTx(), multisigPub, txf.ChainID(), 50, 23, signingtypes.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorContains(t, err, "SIGN_MODE_DIRECT")
+	_, err = NewMultisignSession(txb.GetTx(), kmultisig.NewLegacyAminoPubKey(2, pubKeys[1:]), txf.ChainID(), 50, 23, signingtypes.SignMode_SIGN_MODE_UNSPECIFIED)
+	require.ErrorContains(t, err, "not a signer")
+
+	session, err := NewMultisignSession(txb.GetTx(), multisigPub, txf.ChainID(), 50, 23, signingtypes.SignMode_SIGN_MODE_UNSPECIFIED)
+	require.NoError(t, err)
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, session.SignMode)
+
+	// the session is passed from one member to the next through its file
+	sessionFile := filepath.Join(t.TempDir(), "session.json")
+	require.NoError(t, WriteMultisignSession(clientCtx, sessionFile, session))
+
+	session, err = ReadMultisignSession(clientCtx, sessionFile)
+	require.NoError(t, err)
+	require.ErrorContains(t, session.Sign(ctx, txf, "other"), "not a member")
+	require.NoError(t, session.Sign(ctx, txf, "k1"))
+	require.NoError(t, session.Verify(ctx, txConfig))
+	_, err = session.Finalize(ctx, txConfig)
+	require.ErrorContains(t, err, "threshold not met")
+	require.NoError(t, WriteMultisignSession(clientCtx, sessionFile, session))
+
+	session, err = ReadMultisignSession(clientCtx, sessionFile)
+	require.NoError(t, err)
+	require.NoError(t, session.Sign(ctx, txf, "k3"))
+
+	status := session.Status()
+	require.Equal(t, uint32(2), status.Threshold)
+	require.Equal(t, []string{sdk.AccAddress(pubKeys[0].Address()).String(), sdk.AccAddress(pubKeys[2].Address()).String()}, status.Signed)
+	require.Equal(t, []string{sdk.AccAddress(pubKeys[1].Address()).String()}, status.Pending)
+	require.True(t, status.Complete)
+
+	signedTx, err := session.Finalize(ctx, txConfig)
+	require.NoError(t, err)
+	sigs, err := signedTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+	require.True(t, multisigPub.Equals(sigs[0].PubKey))
+	require.Len(t, sigs[0].Data.(*signingtypes.MultiSignatureData).Signatures, 2)
+
+	// a tampered signature is rejected before the tx is broadcast
+	session.Signatures[0].Data.(*signingtypes.SingleSignatureData).Signature[7] ^= byte(0x01)
+	require.Error(t, session.Verify(ctx, txConfig))
+	_, err = session.Finalize(ctx, txConfig)
+	require.ErrorContains(t, err, "couldn't verify signature")
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -219,6 +220,12 @@ func countDirectSigners(data signing.SignatureData) int {
 		}
 
 		return directSigners
+	case *signing.AggregateSignatureData:
+		if data.SignMode == signing.SignMode_SIGN_MODE_DIRECT {
+			return 1
+		}
+
+		return 0
 	default:
 		panic("unreachable case")
 	}
@@ -360,6 +367,74 @@ func Sign(ctx context.Context, txf Factory, name string, txBuilder client.TxBuil
 	return txf.PreprocessTx(name, txBuilder)
 }
 
+// AggregateSignatures aggregates the signatures of the BLS12-381 signers of the
+// tx into a single signature, carried by the first of them. The signatures
+// already aggregated are aggregated again with the new ones.
+//
+// Only SIGN_MODE_LEGACY_AMINO_JSON signatures can be aggregated, as the sign
+// bytes of the other sign modes cover the mode infos of the signers, which are
+// changed by the aggregation.
+func AggregateSignatures(txBuilder client.TxBuilder) error {
+	sigs, err := txBuilder.GetTx().GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+
+	var (
+		aggregated []int
+		partials   [][]byte
+	)
+	for i, sig := range sigs {
+		if _, ok := sig.PubKey.(*bls12_381.PubKey); !ok {
+			continue
+		}
+
+		var (
+			signMode  signing.SignMode
+			signature []byte
+		)
+		switch data := sig.Data.(type) {
+		case *signing.SingleSignatureData:
+			if len(data.Signature) == 0 {
+				return fmt.Errorf("signature %d is empty", i)
+			}
+			signMode, signature = data.SignMode, data.Signature
+		case *signing.AggregateSignatureData:
+			signMode, signature = data.SignMode, data.Signature
+		default:
+			continue
+		}
+
+		if signMode != signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+			return sdkerrors.ErrNotSupported.Wrapf("cannot aggregate signature %d made in %s", i, signMode)
+		}
+
+		aggregated = append(aggregated, i)
+		if len(signature) > 0 {
+			partials = append(partials, signature)
+		}
+	}
+
+	if len(aggregated) < 2 {
+		return errors.New("at least two BLS12-381 signatures are required to aggregate")
+	}
+
+	aggregate, err := bls12_381.AggregateSignatures(partials)
+	if err != nil {
+		return err
+	}
+
+	for j, i := range aggregated {
+		data := &signing.AggregateSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON}
+		if j == 0 {
+			data.Signature = aggregate
+		}
+		sigs[i].Data = data
+	}
+
+	return txBuilder.SetSignatures(sigs...)
+}
+
 // GasEstimateResponse defines a response definition for tx gas estimation.
 type GasEstimateResponse struct {
 	GasEstimate uint64 `json:"gas_estimate" yaml:"gas_estimate"`
@@ -132,3 +132,18 @@ func (pubKey PubKey) Equals(other cryptotypes.PubKey) bool {
 func (pubKey PubKey) String() string {
 	return fmt.Sprintf("PubKeyBLS12_381{%X}", pubKey.Key)
 }
+
+// ===============================================================================================
+// Aggregate Signature
+// ===============================================================================================
+
+// AggregateSignatures aggregates the given signatures into a single signature.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
+
+// VerifyAggregateSignature verifies that sig is the aggregate of the signatures
+// of msgs[i] by pubKeys[i].
+func VerifyAggregateSignature(pubKeys []*PubKey, msgs [][]byte, sig []byte) bool {
+	panic("not implemented, build flags are required to use bls12_381 keys")
+}
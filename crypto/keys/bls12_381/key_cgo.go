@@ -4,12 +4,14 @@ package bls12_381
 
 import (
 	"bytes"
+	"crypto/rand"
 	"errors"
 	"fmt"
 
 	"github.com/cometbft/cometbft/v2/crypto"
 	"github.com/cometbft/cometbft/v2/crypto/bls12381"
 	"github.com/cometbft/cometbft/v2/crypto/tmhash"
+	blst "github.com/supranational/blst/bindings/go"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -121,7 +123,7 @@ func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
 // wrapper conforms to crypto.Pubkey to allow for the use of the Ethereum
 // BLS12-381 public key type.
 
-var _ cryptotypes.PubKey = &PubKey{}
+var _ cryptotypes.BatchVerifiablePubKey = &PubKey{}
 
 // Address returns the address of the key.
 //
@@ -167,3 +169,118 @@ func (pubKey PubKey) Equals(other cryptotypes.PubKey) bool {
 func (pubKey PubKey) String() string {
 	return fmt.Sprintf("PubKeyBLS12_381{%X}", pubKey.Key)
 }
+
+// NewBatchVerifier returns an empty batch of BLS12-381 signatures. The batch
+// is verified as a single aggregate signature, each signature being weighted
+// by a random scalar so that invalid signatures cannot cancel each other out.
+func (PubKey) NewBatchVerifier() cryptotypes.BatchVerifier {
+	return &batchVerifier{}
+}
+
+// batchVerifier verifies BLS12-381 signatures in batch.
+type batchVerifier struct {
+	pubKeys []*blst.P1Affine
+	msgs    []blst.Message
+	sigs    []*blst.P2Affine
+}
+
+func (v *batchVerifier) Add(pubKey cryptotypes.PubKey, msg, sig []byte) error {
+	pk, ok := pubKey.(*PubKey)
+	if !ok {
+		return fmt.Errorf("expected %T, got %T", pk, pubKey)
+	}
+	p := new(blst.P1Affine).Deserialize(pk.Key)
+	if p == nil || !p.KeyValidate() {
+		return errors.New("invalid BLS12-381 pubkey")
+	}
+	if len(sig) != bls12381.SignatureLength {
+		return fmt.Errorf("invalid BLS12-381 signature size %d", len(sig))
+	}
+	signature := new(blst.P2Affine).Uncompress(sig)
+	if signature == nil {
+		return errors.New("invalid BLS12-381 signature")
+	}
+
+	v.pubKeys = append(v.pubKeys, p)
+	v.msgs = append(v.msgs, msg)
+	v.sigs = append(v.sigs, signature)
+	return nil
+}
+
+func (v *batchVerifier) Verify() bool {
+	if len(v.sigs) == 0 {
+		return true
+	}
+
+	return new(blst.P2Affine).MultipleAggregateVerify(v.sigs, true, v.pubKeys, false, v.msgs, dstMinPk, randomScalar, 64)
+}
+
+// randomScalar sets s to a random scalar weighting a signature of a batch.
+func randomScalar(s *blst.Scalar) {
+	var b [32]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	s.FromBEndian(b[:])
+}
+
+// ===============================================================================================
+// Aggregate Signature
+// ===============================================================================================
+
+// dstMinPk is the domain separation tag of the signatures, as used by CometBFT.
+var dstMinPk = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_")
+
+// AggregateSignatures aggregates the given signatures into a single signature.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+
+	var agg blst.P2Aggregate
+	if !agg.AggregateCompressed(sigs, true) {
+		return nil, errors.New("invalid signature")
+	}
+
+	return agg.ToAffine().Compress(), nil
+}
+
+// VerifyAggregateSignature verifies that sig is the aggregate of the signatures
+// of msgs[i] by pubKeys[i].
+//
+// The messages must be distinct, as the aggregate signatures of a same message
+// are vulnerable to rogue key attacks without a proof of possession of the keys.
+func VerifyAggregateSignature(pubKeys []*PubKey, msgs [][]byte, sig []byte) bool {
+	if len(pubKeys) == 0 || len(pubKeys) != len(msgs) || len(sig) != bls12381.SignatureLength {
+		return false
+	}
+
+	seen := make(map[string]struct{}, len(msgs))
+	for _, msg := range msgs {
+		if _, ok := seen[string(msg)]; ok {
+			return false
+		}
+		seen[string(msg)] = struct{}{}
+	}
+
+	pks := make([]*blst.P1Affine, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		pk := new(blst.P1Affine).Deserialize(pubKey.Key)
+		if pk == nil || !pk.KeyValidate() {
+			return false
+		}
+		pks[i] = pk
+	}
+
+	signature := new(blst.P2Affine).Uncompress(sig)
+	if signature == nil {
+		return false
+	}
+
+	blstMsgs := make([]blst.Message, len(msgs))
+	for i, msg := range msgs {
+		blstMsgs[i] = msg
+	}
+
+	return signature.AggregateVerify(true, pks, false, blstMsgs, dstMinPk)
+}
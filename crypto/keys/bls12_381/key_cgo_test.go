@@ -0,0 +1,121 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package bls12_381_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func TestAggregateSignatures(t *testing.T) {
+	var (
+		pubKeys []*bls12_381.PubKey
+		msgs    [][]byte
+		sigs    [][]byte
+	)
+	for _, msg := range []string{"msg1", "msg2", "msg3"} {
+		privKey, err := bls12_381.GenPrivKey()
+		require.NoError(t, err)
+		sig, err := privKey.Sign([]byte(msg))
+		require.NoError(t, err)
+
+		pubKeys = append(pubKeys, privKey.PubKey().(*bls12_381.PubKey))
+		msgs = append(msgs, []byte(msg))
+		sigs = append(sigs, sig)
+	}
+
+	aggregate, err := bls12_381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.True(t, bls12_381.VerifyAggregateSignature(pubKeys, msgs, aggregate))
+
+	// the aggregate signature covers all the messages and signers
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys[:2], msgs[:2], aggregate))
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys, [][]byte{msgs[0], msgs[1], []byte("other")}, aggregate))
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys, msgs, sigs[0]))
+
+	// an aggregate signature can be aggregated again with other signatures
+	partial, err := bls12_381.AggregateSignatures(sigs[:2])
+	require.NoError(t, err)
+	aggregate, err = bls12_381.AggregateSignatures([][]byte{partial, sigs[2]})
+	require.NoError(t, err)
+	require.True(t, bls12_381.VerifyAggregateSignature(pubKeys, msgs, aggregate))
+
+	_, err = bls12_381.AggregateSignatures(nil)
+	require.Error(t, err)
+	_, err = bls12_381.AggregateSignatures([][]byte{[]byte("invalid")})
+	require.Error(t, err)
+}
+
+func TestVerifyAggregateSignatureDistinctMessages(t *testing.T) {
+	msg := []byte("msg")
+
+	var (
+		pubKeys []*bls12_381.PubKey
+		sigs    [][]byte
+	)
+	for i := 0; i < 2; i++ {
+		privKey, err := bls12_381.GenPrivKey()
+		require.NoError(t, err)
+		sig, err := privKey.Sign(msg)
+		require.NoError(t, err)
+
+		pubKeys = append(pubKeys, privKey.PubKey().(*bls12_381.PubKey))
+		sigs = append(sigs, sig)
+	}
+
+	aggregate, err := bls12_381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys, [][]byte{msg, msg}, aggregate))
+}
+
+func TestBatchVerify(t *testing.T) {
+	// the invalid signatures are either replaced or swapped with one another,
+	// as swapped signatures cancel each other out in a plain aggregate
+	batch := func(invalid ...int) cryptotypes.BatchVerifier {
+		var (
+			pubKeys []cryptotypes.PubKey
+			msgs    [][]byte
+			sigs    [][]byte
+		)
+		for _, msg := range []string{"msg1", "msg2", "msg3", "msg4"} {
+			privKey, err := bls12_381.GenPrivKey()
+			require.NoError(t, err)
+			sig, err := privKey.Sign([]byte(msg))
+			require.NoError(t, err)
+
+			pubKeys = append(pubKeys, privKey.PubKey())
+			msgs = append(msgs, []byte(msg))
+			sigs = append(sigs, sig)
+		}
+		switch len(invalid) {
+		case 1:
+			sigs[invalid[0]] = sigs[(invalid[0]+1)%len(sigs)]
+		case 2:
+			sigs[invalid[0]], sigs[invalid[1]] = sigs[invalid[1]], sigs[invalid[0]]
+		}
+
+		verifier := (&bls12_381.PubKey{}).NewBatchVerifier()
+		for i := range sigs {
+			require.NoError(t, verifier.Add(pubKeys[i], msgs[i], sigs[i]))
+		}
+		return verifier
+	}
+
+	require.True(t, batch().Verify())
+	require.False(t, batch(2).Verify())
+	require.False(t, batch(1, 3).Verify())
+
+	// only BLS12-381 keys and signatures can be added
+	privKey, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+	sig, err := privKey.Sign([]byte("msg"))
+	require.NoError(t, err)
+	verifier := (&bls12_381.PubKey{}).NewBatchVerifier()
+	require.Error(t, verifier.Add(secp256k1.GenPrivKey().PubKey(), []byte("msg"), sig))
+	require.Error(t, verifier.Add(privKey.PubKey(), []byte("msg"), []byte("sig")))
+}
@@ -155,8 +155,9 @@ func GenPrivKeyFromSecret(secret []byte) *PrivKey {
 //-------------------------------------
 
 var (
-	_ cryptotypes.PubKey   = &PubKey{}
-	_ codec.AminoMarshaler = &PubKey{}
+	_ cryptotypes.PubKey                = &PubKey{}
+	_ cryptotypes.BatchVerifiablePubKey = &PubKey{}
+	_ codec.AminoMarshaler              = &PubKey{}
 )
 
 // Address is the SHA256-20 of the raw pubkey bytes.
@@ -186,6 +187,33 @@ func (pubKey *PubKey) VerifySignature(msg, sig []byte) bool {
 	return ed25519consensus.Verify(pubKey.Key, msg, sig)
 }
 
+// NewBatchVerifier returns an empty batch of ed25519 signatures, verified with
+// the same zip215 verification rules as VerifySignature.
+func (pubKey *PubKey) NewBatchVerifier() cryptotypes.BatchVerifier {
+	return &batchVerifier{ed25519consensus.NewBatchVerifier()}
+}
+
+// batchVerifier verifies ed25519 signatures in batch.
+type batchVerifier struct {
+	ed25519consensus.BatchVerifier
+}
+
+func (v *batchVerifier) Add(pubKey cryptotypes.PubKey, msg, sig []byte) error {
+	pk, ok := pubKey.(*PubKey)
+	if !ok {
+		return errorsmod.Wrapf(errors.ErrInvalidPubKey, "expected %T, got %T", pk, pubKey)
+	}
+	if len(pk.Key) != PubKeySize {
+		return errorsmod.Wrapf(errors.ErrInvalidPubKey, "invalid ed25519 pubkey size %d", len(pk.Key))
+	}
+	if len(sig) != SignatureSize {
+		return fmt.Errorf("invalid ed25519 signature size %d", len(sig))
+	}
+
+	v.BatchVerifier.Add(pk.Key, msg, sig)
+	return nil
+}
+
 // String returns Hex representation of a pubkey with it's type
 func (pubKey *PubKey) String() string {
 	return fmt.Sprintf("PubKeyEd25519{%X}", pubKey.Key)
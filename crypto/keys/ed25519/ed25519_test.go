@@ -47,6 +47,31 @@ func TestSignAndValidateEd25519(t *testing.T) {
 	assert.False(t, pubKey.VerifySignature(msg, sig))
 }
 
+func TestBatchVerifyEd25519(t *testing.T) {
+	batch := func(invalid int) cryptotypes.BatchVerifier {
+		verifier := (&ed25519.PubKey{}).NewBatchVerifier()
+		for i := 0; i < 4; i++ {
+			privKey := ed25519.GenPrivKey()
+			msg := crypto.CRandBytes(100)
+			sig, err := privKey.Sign(msg)
+			require.NoError(t, err)
+			if i == invalid {
+				sig[7] ^= byte(0x01)
+			}
+			require.NoError(t, verifier.Add(privKey.PubKey(), msg, sig))
+		}
+		return verifier
+	}
+
+	require.True(t, batch(-1).Verify())
+	require.False(t, batch(2).Verify())
+
+	// only ed25519 keys and signatures can be added
+	verifier := (&ed25519.PubKey{}).NewBatchVerifier()
+	require.Error(t, verifier.Add(secp256k1.GenPrivKey().PubKey(), []byte("msg"), make([]byte, ed25519.SignatureSize)))
+	require.Error(t, verifier.Add(ed25519.GenPrivKey().PubKey(), []byte("msg"), []byte("sig")))
+}
+
 func TestPubKeyEquals(t *testing.T) {
 	ed25519PubKey := ed25519.GenPrivKey().PubKey().(*ed25519.PubKey)
 
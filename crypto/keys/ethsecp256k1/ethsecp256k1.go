@@ -0,0 +1,226 @@
+package ethsecp256k1
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/v2/crypto"
+	secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"golang.org/x/crypto/sha3"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ cryptotypes.PrivKey  = &PrivKey{}
+	_ codec.AminoMarshaler = &PrivKey{}
+)
+
+const (
+	PrivKeySize = 32
+	// SignatureSize is the size of an Ethereum-style signature R || S || V.
+	SignatureSize = 65
+	keyType       = "eth_secp256k1"
+	PrivKeyName   = "cosmos/PrivKeyEthSecp256k1"
+	PubKeyName    = "cosmos/PubKeyEthSecp256k1"
+)
+
+// Keccak256 returns the Keccak-256 hash of the data, as used by Ethereum.
+func Keccak256(data ...[]byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	for _, bz := range data {
+		hasher.Write(bz)
+	}
+	return hasher.Sum(nil)
+}
+
+// GenPrivKey generates a new Ethereum-style secp256k1 private key.
+// It uses OS randomness to generate the private key.
+func GenPrivKey() *PrivKey {
+	priv, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		panic(err)
+	}
+
+	return &PrivKey{Key: priv.Serialize()}
+}
+
+// Bytes returns the byte representation of the Private Key.
+func (privKey *PrivKey) Bytes() []byte {
+	return privKey.Key
+}
+
+// PubKey performs the point-scalar multiplication from the privKey on the
+// generator point to get the pubkey.
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	pubkeyObject := secp256k1.PrivKeyFromBytes(privKey.Key).PubKey()
+	return &PubKey{Key: pubkeyObject.SerializeCompressed()}
+}
+
+// Equals - you probably don't need to use this.
+// Runs in constant time based on length of the keys.
+func (privKey *PrivKey) Equals(other cryptotypes.LedgerPrivKey) bool {
+	return privKey.Type() == other.Type() && subtle.ConstantTimeCompare(privKey.Bytes(), other.Bytes()) == 1
+}
+
+func (privKey *PrivKey) Type() string {
+	return keyType
+}
+
+// Sign creates an ECDSA signature on curve Secp256k1, using Keccak-256 on the
+// msg. The returned signature will be of the Ethereum form R || S || V (in
+// lower-S form), where V is the recovery id 0 or 1.
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	priv := secp256k1.PrivKeyFromBytes(privKey.Key)
+	sig := ecdsa.SignCompact(priv, Keccak256(msg), false)
+
+	// move the recovery code from the first byte to the last, as the recovery id
+	return append(sig[1:], sig[0]-27), nil
+}
+
+// MarshalAmino overrides Amino binary marshaling.
+func (privKey PrivKey) MarshalAmino() ([]byte, error) {
+	return privKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (privKey *PrivKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PrivKeySize {
+		return fmt.Errorf("invalid privkey size")
+	}
+	privKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (privKey PrivKey) MarshalAminoJSON() ([]byte, error) {
+	return privKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (privKey *PrivKey) UnmarshalAminoJSON(bz []byte) error {
+	return privKey.UnmarshalAmino(bz)
+}
+
+//-------------------------------------
+
+var (
+	_ cryptotypes.PubKey   = &PubKey{}
+	_ codec.AminoMarshaler = &PubKey{}
+)
+
+// PubKeySize is comprised of 32 bytes for one field element
+// (the x-coordinate), plus one byte for the parity of the y-coordinate.
+const PubKeySize = 33
+
+// Address returns an Ethereum style address: the last 20 bytes of the
+// Keccak-256 hash of the uncompressed pubkey, without its 0x04 prefix.
+func (pubKey *PubKey) Address() crypto.Address {
+	pub, err := secp256k1.ParsePubKey(pubKey.Key)
+	if err != nil {
+		panic(err)
+	}
+
+	return Keccak256(pub.SerializeUncompressed()[1:])[12:]
+}
+
+// Bytes returns the pubkey byte format.
+func (pubKey *PubKey) Bytes() []byte {
+	return pubKey.Key
+}
+
+func (pubKey *PubKey) String() string {
+	return fmt.Sprintf("PubKeyEthSecp256k1{%X}", pubKey.Key)
+}
+
+func (pubKey *PubKey) Type() string {
+	return keyType
+}
+
+func (pubKey *PubKey) Equals(other cryptotypes.PubKey) bool {
+	return pubKey.Type() == other.Type() && bytes.Equal(pubKey.Bytes(), other.Bytes())
+}
+
+// VerifySignature verifies a signature of the form R || S or R || S || V of
+// the Keccak-256 hash of msg, where V is the recovery id 0 or 1. It rejects
+// signatures which are not in lower-S form, or whose recovery id does not
+// recover the pubkey.
+func (pubKey *PubKey) VerifySignature(msg, sigStr []byte) bool {
+	if len(sigStr) != SignatureSize && len(sigStr) != SignatureSize-1 {
+		return false
+	}
+	pub, err := secp256k1.ParsePubKey(pubKey.Key)
+	if err != nil {
+		return false
+	}
+	// parse the signature, will return error if it is not in lower-S form
+	signature, err := signatureFromBytes(sigStr[:64])
+	if err != nil {
+		return false
+	}
+	hash := Keccak256(msg)
+	if !signature.Verify(hash, pub) {
+		return false
+	}
+
+	if len(sigStr) == SignatureSize {
+		if sigStr[64] > 1 {
+			return false
+		}
+		compactSig := append([]byte{sigStr[64] + 27}, sigStr[:64]...)
+		recovered, _, err := ecdsa.RecoverCompact(compactSig, hash)
+		if err != nil || !recovered.IsEqual(pub) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MarshalAmino overrides Amino binary marshaling.
+func (pubKey PubKey) MarshalAmino() ([]byte, error) {
+	return pubKey.Key, nil
+}
+
+// UnmarshalAmino overrides Amino binary marshaling.
+func (pubKey *PubKey) UnmarshalAmino(bz []byte) error {
+	if len(bz) != PubKeySize {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "invalid pubkey size")
+	}
+	pubKey.Key = bz
+
+	return nil
+}
+
+// MarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey PubKey) MarshalAminoJSON() ([]byte, error) {
+	return pubKey.MarshalAmino()
+}
+
+// UnmarshalAminoJSON overrides Amino JSON marshaling.
+func (pubKey *PubKey) UnmarshalAminoJSON(bz []byte) error {
+	return pubKey.UnmarshalAmino(bz)
+}
+
+// signatureFromBytes reads a Signature struct from R || S. Caller needs to
+// ensure that len(sigStr) == 64.
+// Rejects malleable signatures (if S value if it is over half order).
+func signatureFromBytes(sigStr []byte) (*ecdsa.Signature, error) {
+	var r secp256k1.ModNScalar
+	r.SetByteSlice(sigStr[:32])
+	var s secp256k1.ModNScalar
+	s.SetByteSlice(sigStr[32:64])
+	if s.IsOverHalfOrder() {
+		return nil, errors.New("signature is not in lower-S form")
+	}
+
+	return ecdsa.NewSignature(&r, &s), nil
+}
@@ -0,0 +1,59 @@
+package ethsecp256k1_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+)
+
+func TestPubKeyEthSecp256k1Address(t *testing.T) {
+	// test vector from the web3.js documentation
+	privKeyBz, err := hex.DecodeString("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	require.NoError(t, err)
+
+	privKey := &ethsecp256k1.PrivKey{Key: privKeyBz}
+	require.Equal(t, "2c7536e3605d9c16a7a3d7b1898e529396a65c23", hex.EncodeToString(privKey.PubKey().Address()))
+}
+
+func TestSignAndValidateEthSecp256k1(t *testing.T) {
+	privKey := ethsecp256k1.GenPrivKey()
+	pubKey := privKey.PubKey()
+	msg := []byte("hello world")
+
+	sig, err := privKey.Sign(msg)
+	require.NoError(t, err)
+	require.Len(t, sig, ethsecp256k1.SignatureSize)
+	require.True(t, pubKey.VerifySignature(msg, sig))
+	require.True(t, pubKey.VerifySignature(msg, sig[:64]))
+	require.False(t, pubKey.VerifySignature([]byte("other"), sig))
+
+	// the recovery id must recover the pubkey
+	invalidSig := append([]byte(nil), sig...)
+	invalidSig[64] ^= 1
+	require.False(t, pubKey.VerifySignature(msg, invalidSig))
+	invalidSig[64] = sig[64] + 27
+	require.False(t, pubKey.VerifySignature(msg, invalidSig))
+
+	invalidSig = append([]byte(nil), sig...)
+	invalidSig[3] ^= byte(0x01)
+	require.False(t, pubKey.VerifySignature(msg, invalidSig))
+
+	// the signatures are over the Keccak-256 hash of the msg, unlike the
+	// signatures of secp256k1 keys
+	secpPubKey := &secp256k1.PubKey{Key: pubKey.Bytes()}
+	require.False(t, secpPubKey.VerifySignature(msg, sig[:64]))
+	require.NotEqual(t, secpPubKey.Address(), pubKey.Address())
+}
+
+func TestPubKeyEthSecp256k1Equals(t *testing.T) {
+	privKey := ethsecp256k1.GenPrivKey()
+	pubKey := privKey.PubKey()
+
+	require.True(t, pubKey.Equals(&ethsecp256k1.PubKey{Key: pubKey.Bytes()}))
+	require.False(t, pubKey.Equals(&secp256k1.PubKey{Key: pubKey.Bytes()}))
+	require.False(t, pubKey.Equals(ethsecp256k1.GenPrivKey().PubKey()))
+}
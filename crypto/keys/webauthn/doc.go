@@ -0,0 +1,29 @@
+// Package webauthn implements a Cosmos-SDK compatible public key verifying WebAuthn
+// assertions, allowing accounts to be controlled by passkeys. The credential key is
+// a secp256r1 public key and the challenge of an assertion is the SHA-256 hash of
+// the sign bytes of the transaction. The keys can be protobuf serialized and packed
+// in Any.
+package webauthn
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+const (
+	name = "webauthn"
+
+	// authenticatorDataMinSize is the size of the RP ID hash, flags and signature
+	// counter of the authenticator data.
+	authenticatorDataMinSize = 37
+	// flagUserPresent is the user present flag of the authenticator data.
+	flagUserPresent = 0x01
+
+	// clientDataTypeGet is the type of the client data of an assertion.
+	clientDataTypeGet = "webauthn.get"
+)
+
+// RegisterInterfaces adds webauthn PubKey to pubkey registry
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{})
+}
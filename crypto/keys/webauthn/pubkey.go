@@ -0,0 +1,92 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	cmtcrypto "github.com/cometbft/cometbft/v2/crypto"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+var _ cryptotypes.PubKey = (*PubKey)(nil)
+
+// NewPubKey creates a WebAuthn PubKey from the secp256r1 public key of a credential.
+func NewPubKey(key *secp256r1.PubKey) *PubKey {
+	return &PubKey{Key: key}
+}
+
+// String implements proto.Message interface.
+func (m *PubKey) String() string {
+	return fmt.Sprintf("%s{%X}", name, m.Bytes())
+}
+
+// Bytes implements SDK PubKey interface.
+func (m *PubKey) Bytes() []byte {
+	if m == nil {
+		return nil
+	}
+	return m.Key.Bytes()
+}
+
+// Equals implements SDK PubKey interface.
+func (m *PubKey) Equals(other cryptotypes.PubKey) bool {
+	pk2, ok := other.(*PubKey)
+	if !ok || m.Key == nil || pk2.Key == nil {
+		return false
+	}
+	return m.Key.Equals(pk2.Key)
+}
+
+// Address implements SDK PubKey interface. The address is distinct from the
+// address of the secp256r1 public key of the credential.
+func (m *PubKey) Address() cmtcrypto.Address {
+	return address.Hash(proto.MessageName(m), m.Bytes())
+}
+
+// Type returns key type name. Implements SDK PubKey interface.
+func (m *PubKey) Type() string {
+	return name
+}
+
+// VerifySignature implements SDK PubKey interface. sig is a protobuf encoded
+// Signature holding a WebAuthn assertion whose challenge is the SHA-256 hash
+// of msg.
+func (m *PubKey) VerifySignature(msg, sig []byte) bool {
+	if m.Key == nil {
+		return false
+	}
+
+	var assertion Signature
+	if err := assertion.Unmarshal(sig); err != nil {
+		return false
+	}
+
+	authData := assertion.AuthenticatorData
+	if len(authData) < authenticatorDataMinSize || authData[32]&flagUserPresent == 0 {
+		return false
+	}
+
+	var clientData struct {
+		Type      string `json:"type"`
+		Challenge string `json:"challenge"`
+	}
+	if err := json.Unmarshal(assertion.ClientDataJSON, &clientData); err != nil {
+		return false
+	}
+	if clientData.Type != clientDataTypeGet || clientData.Challenge != Challenge(msg) {
+		return false
+	}
+
+	// the authenticator signs the authenticator data followed by the hash of
+	// the client data JSON
+	clientDataHash := sha256.Sum256(assertion.ClientDataJSON)
+	signedData := bytes.Join([][]byte{authData, clientDataHash[:]}, nil)
+
+	return m.Key.VerifySignature(signedData, assertion.Signature)
+}
@@ -0,0 +1,153 @@
+package webauthn_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// credential is a passkey signing WebAuthn assertions.
+type credential struct {
+	key    *ecdsa.PrivateKey
+	pubKey *webauthn.PubKey
+}
+
+func newCredential(t *testing.T) credential {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pk, err := secp256r1.NewPubKeyFromBytes(elliptic.MarshalCompressed(elliptic.P256(), key.X, key.Y))
+	require.NoError(t, err)
+
+	return credential{key: key, pubKey: webauthn.NewPubKey(pk)}
+}
+
+// sign returns the assertion of the credential with the given authenticator
+// data and client data JSON, as returned by an authenticator.
+func (c credential) sign(t *testing.T, authData, clientDataJSON []byte) []byte {
+	t.Helper()
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	hash := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	derSig, err := ecdsa.SignASN1(rand.Reader, c.key, hash[:])
+	require.NoError(t, err)
+
+	sig, err := webauthn.NewSignature(authData, clientDataJSON, derSig)
+	require.NoError(t, err)
+	return sig
+}
+
+func newAuthenticatorData(flags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte("wallet.example.com"))
+	return append(rpIDHash[:], flags, 0, 0, 0, 1)
+}
+
+func newClientDataJSON(t *testing.T, typ, challenge string) []byte {
+	t.Helper()
+
+	bz, err := json.Marshal(map[string]any{
+		"type":        typ,
+		"challenge":   challenge,
+		"origin":      "https://wallet.example.com",
+		"crossOrigin": false,
+	})
+	require.NoError(t, err)
+	return bz
+}
+
+func TestVerifySignature(t *testing.T) {
+	cred := newCredential(t)
+	msg := []byte("sign bytes")
+	authData := newAuthenticatorData(0x05)
+	clientDataJSON := newClientDataJSON(t, "webauthn.get", webauthn.Challenge(msg))
+
+	validSig := cred.sign(t, authData, clientDataJSON)
+	tamperedSig := append([]byte{}, validSig...)
+	tamperedSig[len(tamperedSig)-1] ^= 0x01
+
+	testCases := []struct {
+		name  string
+		msg   []byte
+		sig   []byte
+		valid bool
+	}{
+		{"valid assertion", msg, validSig, true},
+		{"other sign bytes", []byte("other sign bytes"), validSig, false},
+		{"tampered signature", msg, tamperedSig, false},
+		{"invalid encoding", msg, []byte("not an assertion"), false},
+		{"user not present", msg, cred.sign(t, newAuthenticatorData(0x04), clientDataJSON), false},
+		{"short authenticator data", msg, cred.sign(t, authData[:36], clientDataJSON), false},
+		{"registration client data", msg, cred.sign(t, authData, newClientDataJSON(t, "webauthn.create", webauthn.Challenge(msg))), false},
+		{"raw sign bytes challenge", msg, cred.sign(t, authData, newClientDataJSON(t, "webauthn.get", string(msg))), false},
+		{"invalid client data", msg, cred.sign(t, authData, []byte("{")), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.valid, cred.pubKey.VerifySignature(tc.msg, tc.sig))
+		})
+	}
+
+	// the assertion must be signed by the credential
+	require.False(t, newCredential(t).pubKey.VerifySignature(msg, validSig))
+}
+
+func TestNewSignature(t *testing.T) {
+	_, err := webauthn.NewSignature(nil, nil, []byte("not DER"))
+	require.Error(t, err)
+
+	// the signatures of an authenticator are normalized to low-S values
+	cred := newCredential(t)
+	msg := []byte("sign bytes")
+	authData := newAuthenticatorData(0x01)
+	clientDataJSON := newClientDataJSON(t, "webauthn.get", webauthn.Challenge(msg))
+	for range 10 {
+		require.True(t, cred.pubKey.VerifySignature(msg, cred.sign(t, authData, clientDataJSON)))
+	}
+}
+
+func TestPubKey(t *testing.T) {
+	cred := newCredential(t)
+	pk := cred.pubKey
+
+	require.Equal(t, "webauthn", pk.Type())
+	require.Equal(t, pk.Key.Bytes(), pk.Bytes())
+	require.True(t, pk.Equals(pk))
+	require.False(t, pk.Equals(newCredential(t).pubKey))
+	require.False(t, pk.Equals(pk.Key))
+
+	// the address of the credential differs from the address of its secp256r1 key
+	require.Len(t, pk.Address(), 32)
+	require.NotEqual(t, pk.Key.Address(), pk.Address())
+}
+
+func TestPubKeyCodec(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	var pk cryptotypes.PubKey = newCredential(t).pubKey
+	bz, err := cdc.MarshalInterface(pk)
+	require.NoError(t, err)
+
+	var decoded cryptotypes.PubKey
+	require.NoError(t, cdc.UnmarshalInterface(bz, &decoded))
+	require.True(t, pk.Equals(decoded))
+
+	bz, err = cdc.MarshalInterfaceJSON(pk)
+	require.NoError(t, err)
+	require.NoError(t, cdc.UnmarshalInterfaceJSON(bz, &decoded))
+	require.True(t, pk.Equals(decoded))
+}
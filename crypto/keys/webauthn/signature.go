@@ -0,0 +1,56 @@
+package webauthn
+
+import (
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/internal/ecdsa"
+)
+
+// Challenge returns the challenge of the WebAuthn assertion signing signBytes,
+// encoded as in the client data JSON: the base64url encoding of the SHA-256 hash
+// of signBytes, without padding.
+func Challenge(signBytes []byte) string {
+	hash := sha256.Sum256(signBytes)
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// NewSignature returns the protobuf encoded Signature of a WebAuthn assertion
+// from the authenticator data, client data JSON and ASN.1 DER encoded signature
+// returned by the authenticator. The signature is converted to the fixed-width
+// R || S encoding with a low-S value expected by PubKey.VerifySignature.
+func NewSignature(authenticatorData, clientDataJSON, derSignature []byte) ([]byte, error) {
+	var sig struct {
+		R, S *big.Int
+	}
+	rest, err := asn1.Unmarshal(derSignature, &sig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DER signature: %w", err)
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("invalid DER signature: trailing data")
+	}
+	if sig.R.Sign() <= 0 || sig.S.Sign() <= 0 || sig.R.BitLen() > 256 || sig.S.BitLen() > 256 {
+		return nil, errors.New("invalid DER signature: values out of range")
+	}
+
+	rawSig := make([]byte, 64)
+	sig.R.FillBytes(rawSig[:32])
+	ecdsa.NormalizeS(sig.S).FillBytes(rawSig[32:])
+
+	assertion := &Signature{
+		AuthenticatorData: authenticatorData,
+		ClientDataJSON:    clientDataJSON,
+		Signature:         rawSig,
+	}
+	return assertion.Marshal()
+}
+
+// String implements proto.Message interface.
+func (m *Signature) String() string {
+	return fmt.Sprintf("%s{%X, %s, %X}", name, m.AuthenticatorData, m.ClientDataJSON, m.Signature)
+}
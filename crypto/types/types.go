@@ -16,6 +16,26 @@ type PubKey interface {
 	Type() string
 }
 
+// BatchVerifier verifies the signatures of several messages at once, which is
+// faster than verifying them one by one.
+type BatchVerifier interface {
+	// Add adds the signature of msg made by pubKey to the batch. An error is
+	// returned if pubKey or sig cannot be verified by the batch.
+	Add(pubKey PubKey, msg, sig []byte) error
+	// Verify reports whether all the signatures of the batch are valid. If
+	// not, the signatures must be verified one by one to find the invalid ones.
+	Verify() bool
+}
+
+// BatchVerifiablePubKey defines a public key whose signatures can be verified
+// in batch with the signatures of the other keys of its type.
+type BatchVerifiablePubKey interface {
+	PubKey
+
+	// NewBatchVerifier returns an empty batch of signatures of the key type.
+	NewBatchVerifier() BatchVerifier
+}
+
 // LedgerPrivKey defines a private key that is not a proto message. For now,
 // LedgerSecp256k1 keys are not converted to proto.Message yet, this is why
 // they use LedgerPrivKey instead of PrivKey. All other keys must use PrivKey
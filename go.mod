@@ -55,6 +55,7 @@ require (
 	github.com/spf13/pflag v1.0.7
 	github.com/spf13/viper v1.20.1
 	github.com/stretchr/testify v1.10.0
+	github.com/supranational/blst v0.3.14
 	github.com/tendermint/go-amino v0.16.0
 	go.uber.org/mock v0.5.2
 	golang.org/x/crypto v0.40.0
@@ -179,7 +180,6 @@ require (
 	github.com/spf13/afero v1.12.0 // indirect
 	github.com/spiffe/go-spiffe/v2 v2.5.0 // indirect
 	github.com/subosito/gotenv v1.6.0 // indirect
-	github.com/supranational/blst v0.3.14 // indirect
 	github.com/syndtr/goleveldb v1.0.1-0.20220721030215-126854af5e6d // indirect
 	github.com/tidwall/btree v1.7.0 // indirect
 	github.com/twitchyliquid64/golang-asm v0.15.1 // indirect
@@ -218,6 +218,12 @@ require (
 
 // Here are the short-lived replace from the Cosmos SDK
 // Replace here are pending PRs, or version to be tagged
+replace (
+	// TODO remove once the api module is tagged with the mempool and aggregate signature types
+	cosmossdk.io/api => ./api
+	// TODO remove once the x/tx module is tagged with the SIGN_MODE_EIP_712 handler
+	cosmossdk.io/x/tx => ./x/tx
+)
 
 // Below are the long-lived replace of the Cosmos SDK
 replace (
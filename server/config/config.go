@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/spf13/viper"
 
@@ -169,6 +170,27 @@ type MempoolConfig struct {
 	// unbounded in how many txs it may contain, and a positive value indicates
 	// the maximum amount of txs it may contain.
 	MaxTxs int `mapstructure:"max-txs"`
+
+	// MaxTxsPerSender defines the maximum amount of txs a single sender may have
+	// in the mempool. Zero indicates no limit.
+	MaxTxsPerSender int `mapstructure:"max-txs-per-sender"`
+
+	// MaxBytes defines the maximum total size in bytes of the txs in the mempool.
+	// Zero indicates no limit.
+	MaxBytes int64 `mapstructure:"max-bytes"`
+
+	// TxTTL defines for how long, measured in block time, a tx may stay in the
+	// mempool before being evicted. Zero indicates txs never expire.
+	TxTTL time.Duration `mapstructure:"tx-ttl"`
+
+	// EvictLowestPriority defines if a full mempool evicts its lowest priority
+	// txs to make room for a higher priority tx. It makes the node use a
+	// priority nonce mempool instead of the default sender nonce mempool.
+	EvictLowestPriority bool `mapstructure:"evict-lowest-priority"`
+
+	// Persistent defines if the txs of the mempool are journaled to disk, under
+	// the node home, and restored on restart.
+	Persistent bool `mapstructure:"persistent"`
 }
 
 // State Streaming configuration
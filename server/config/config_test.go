@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
@@ -146,6 +147,27 @@ func TestIndexEventsWriteRead(t *testing.T) {
 	require.Equal(t, expected, actual, "config value")
 }
 
+func TestMempoolConfigWriteRead(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.Mempool.MaxTxs = 5000
+	conf.Mempool.MaxTxsPerSender = 16
+	conf.Mempool.MaxBytes = 1 << 20
+	conf.Mempool.TxTTL = 10 * time.Minute
+	conf.Mempool.EvictLowestPriority = true
+	conf.Mempool.Persistent = true
+
+	WriteConfigFile(confFile, conf)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig(), "reading config file into viper")
+
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err, "parsing config")
+	require.Equal(t, conf.Mempool, cfg.Mempool)
+}
+
 func TestGlobalLabelsEventsMarshalling(t *testing.T) {
 	expectedIn := `global-labels = [
   ["labelname1", "labelvalue1"],
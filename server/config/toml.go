@@ -246,6 +246,27 @@ stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
 max-txs = {{ .Mempool.MaxTxs }}
+
+# Setting max-txs-per-sender to a positive number (> 0) will limit the number of transactions a single sender
+# may have in the mempool. Setting it to 0 disables the limit.
+max-txs-per-sender = {{ .Mempool.MaxTxsPerSender }}
+
+# Setting max-bytes to a positive number (> 0) will limit the total size, in bytes, of the transactions in
+# the mempool. Setting it to 0 disables the limit.
+max-bytes = {{ .Mempool.MaxBytes }}
+
+# tx-ttl defines for how long, measured in block time, a transaction may stay in the mempool before being
+# evicted, e.g. "10m". Setting it to "0s" disables the expiry of transactions.
+tx-ttl = "{{ .Mempool.TxTTL }}"
+
+# evict-lowest-priority defines if a full mempool (by max-txs or max-bytes) evicts its lowest priority transactions
+# to make room for a higher priority transaction instead of rejecting it. Enabling it makes the node use a priority
+# nonce mempool, ordering transactions by priority, instead of the default sender nonce mempool.
+evict-lowest-priority = {{ .Mempool.EvictLowestPriority }}
+
+# persistent defines if the transactions of the mempool are journaled to disk, under the node home, so that
+# they are restored, after being re-validated, when the node restarts.
+persistent = {{ .Mempool.Persistent }}
 `
 
 var configTemplate *template.Template
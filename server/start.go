@@ -106,7 +106,12 @@ const (
 
 	// mempool flags
 
-	FlagMempoolMaxTxs = "mempool.max-txs"
+	FlagMempoolMaxTxs              = "mempool.max-txs"
+	FlagMempoolMaxTxsPerSender     = "mempool.max-txs-per-sender"
+	FlagMempoolMaxBytes            = "mempool.max-bytes"
+	FlagMempoolTxTTL               = "mempool.tx-ttl"
+	FlagMempoolEvictLowestPriority = "mempool.evict-lowest-priority"
+	FlagMempoolPersistent          = "mempool.persistent"
 
 	// testnet keys
 
@@ -1034,6 +1039,11 @@ func addStartNodeFlags(cmd *cobra.Command, opts StartCmdOptions) {
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
+	cmd.Flags().Int(FlagMempoolMaxTxsPerSender, 0, "Sets the maximum number of txs per sender in the app-side mempool (0 means no limit)")
+	cmd.Flags().Int64(FlagMempoolMaxBytes, 0, "Sets the maximum total size in bytes of the app-side mempool (0 means no limit)")
+	cmd.Flags().Duration(FlagMempoolTxTTL, 0, "Sets for how long, in block time, a tx may stay in the app-side mempool (0 means no expiry)")
+	cmd.Flags().Bool(FlagMempoolEvictLowestPriority, false, "Use a priority app-side mempool evicting its lowest priority txs when full")
+	cmd.Flags().Bool(FlagMempoolPersistent, false, "Journal the app-side mempool txs to disk and restore them on restart")
 	cmd.Flags().Duration(FlagShutdownGrace, 0*time.Second, "On Shutdown, duration to wait for resource clean up")
 
 	// support old flags name for backwards compatibility
@@ -559,11 +559,33 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 
 	defaultMempool := baseapp.SetMempool(mempool.NoOpMempool{})
 	if maxTxs := cast.ToInt(appOpts.Get(FlagMempoolMaxTxs)); maxTxs >= 0 {
-		defaultMempool = baseapp.SetMempool(
-			mempool.NewSenderNonceMempool(
+		var mp mempool.ExtMempool
+		if cast.ToBool(appOpts.Get(FlagMempoolEvictLowestPriority)) {
+			cfg := mempool.DefaultPriorityNonceMempoolConfig()
+			cfg.MaxTx = maxTxs
+			cfg.MaxTxsPerSender = cast.ToInt(appOpts.Get(FlagMempoolMaxTxsPerSender))
+			cfg.MaxBytes = cast.ToInt64(appOpts.Get(FlagMempoolMaxBytes))
+			cfg.TxTTL = cast.ToDuration(appOpts.Get(FlagMempoolTxTTL))
+			cfg.EvictLowestPriority = true
+			mp = mempool.NewPriorityMempool(cfg)
+		} else {
+			mp = mempool.NewSenderNonceMempool(
 				mempool.SenderNonceMaxTxOpt(maxTxs),
-			),
-		)
+				mempool.SenderNonceMaxTxsPerSenderOpt(cast.ToInt(appOpts.Get(FlagMempoolMaxTxsPerSender))),
+				mempool.SenderNonceMaxBytesOpt(cast.ToInt64(appOpts.Get(FlagMempoolMaxBytes))),
+				mempool.SenderNonceTxTTLOpt(cast.ToDuration(appOpts.Get(FlagMempoolTxTTL))),
+			)
+		}
+
+		if cast.ToBool(appOpts.Get(FlagMempoolPersistent)) {
+			journalDB, err := dbm.NewDB("mempool", GetAppDBBackend(appOpts), filepath.Join(homeDir, "data"))
+			if err != nil {
+				panic(fmt.Errorf("failed to open mempool journal: %w", err))
+			}
+			mp = mempool.NewPersistentMempool(mp, journalDB, nil)
+		}
+
+		defaultMempool = baseapp.SetMempool(mp)
 	}
 
 	return []func(*baseapp.BaseApp){
@@ -17,6 +17,7 @@ import (
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
 	"cosmossdk.io/client/v2/autocli"
 	clienthelpers "cosmossdk.io/client/v2/helpers"
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
@@ -26,6 +27,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	mempoolservice "github.com/cosmos/cosmos-sdk/client/grpc/mempool"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/address"
@@ -121,6 +123,7 @@ var (
 		minttypes.ModuleName:                        {authtypes.Minter},
 		stakingtypes.BondedPoolName:                 {authtypes.Burner, authtypes.Staking},
 		stakingtypes.NotBondedPoolName:              {authtypes.Burner, authtypes.Staking},
+		stakingtypes.ModuleName:                     {authtypes.Minter, authtypes.Burner},
 		govtypes.ModuleName:                         {authtypes.Burner},
 		nft.ModuleName:                              nil,
 		protocolpooltypes.ModuleName:                nil,
@@ -175,6 +178,9 @@ type SimApp struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// sigBatchVerifier verifies in batch the signatures of the txs of each block
+	sigBatchVerifier *ante.SigBatchVerifier
 }
 
 func init() {
@@ -319,8 +325,8 @@ func NewSimApp(
 		logger,
 	)
 
-	// optional: enable sign mode textual by overwriting the default tx config (after setting the bank keeper)
-	enabledSignModes := append(tx.DefaultSignModes, sigtypes.SignMode_SIGN_MODE_TEXTUAL)
+	// optional: enable sign modes textual and EIP-712 by overwriting the default tx config (after setting the bank keeper)
+	enabledSignModes := append(tx.DefaultSignModes, sigtypes.SignMode_SIGN_MODE_TEXTUAL, sigtypes.SignMode_SIGN_MODE_EIP_712)
 	txConfigOpts := tx.ConfigOptions{
 		EnabledSignModes:           enabledSignModes,
 		TextualCoinMetadataQueryFn: txmodule.NewBankKeeperCoinMetadataQueryFn(app.BankKeeper),
@@ -570,6 +576,7 @@ func NewSimApp(
 	app.ModuleManager.SetOrderEndBlockers(
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
+		authtypes.ModuleName,
 		genutiltypes.ModuleName,
 		feegrant.ModuleName,
 		group.ModuleName,
@@ -696,6 +703,8 @@ func NewSimApp(
 }
 
 func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
+	app.sigBatchVerifier = ante.NewSigBatchVerifier(app.AccountKeeper, txConfig.SignModeHandler(), txConfig.TxDecoder())
+
 	anteHandler, err := NewAnteHandler(
 		HandlerOptions{
 			ante.HandlerOptions{
@@ -703,11 +712,13 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 				BankKeeper:      app.BankKeeper,
 				SignModeHandler: txConfig.SignModeHandler(),
 				FeegrantKeeper:  app.FeeGrantKeeper,
+				FeeMarketKeeper: app.AccountKeeper,
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 				SigVerifyOptions: []ante.SigVerificationDecoratorOption{
 					// change below as needed.
 					ante.WithUnorderedTxGasCost(ante.DefaultUnorderedTxGasCost),
 					ante.WithMaxUnorderedTxTimeoutDuration(ante.DefaultMaxTimeoutDuration),
+					ante.WithSigBatchVerifier(app.sigBatchVerifier),
 				},
 			},
 			&app.CircuitKeeper,
@@ -736,7 +747,8 @@ func (app *SimApp) setPostHandler() {
 func (app *SimApp) Name() string { return app.BaseApp.Name() }
 
 // PreBlocker application updates every pre block
-func (app *SimApp) PreBlocker(ctx sdk.Context, _ *abci.FinalizeBlockRequest) (*sdk.ResponsePreBlock, error) {
+func (app *SimApp) PreBlocker(ctx sdk.Context, req *abci.FinalizeBlockRequest) (*sdk.ResponsePreBlock, error) {
+	app.sigBatchVerifier.VerifyBlock(ctx, req.Txs)
 	return app.ModuleManager.PreBlock(ctx)
 }
 
@@ -856,6 +868,9 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 	// Register node gRPC service for grpc-gateway.
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register mempool gRPC service for grpc-gateway.
+	mempoolservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
 	// Register grpc-gateway routes for all modules.
 	app.BasicModuleManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
@@ -867,7 +882,19 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *SimApp) RegisterTxService(clientCtx client.Context) {
-	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
+	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry,
+		authtx.WithStateDiffSimulation(app.BaseApp.SimulateWithStateDiff, map[string]collections.Schema{
+			authtypes.StoreKey:         app.AccountKeeper.Schema,
+			banktypes.StoreKey:         app.BankKeeper.Schema,
+			distrtypes.StoreKey:        app.DistrKeeper.Schema,
+			govtypes.StoreKey:          app.GovKeeper.Schema,
+			minttypes.StoreKey:         app.MintKeeper.Schema,
+			feegrant.StoreKey:          app.FeeGrantKeeper.Schema,
+			evidencetypes.StoreKey:     app.EvidenceKeeper.Schema,
+			epochstypes.StoreKey:       app.EpochsKeeper.Schema,
+			protocolpooltypes.StoreKey: app.ProtocolPoolKeeper.Schema,
+		}),
+	)
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -883,6 +910,7 @@ func (app *SimApp) RegisterTendermintService(clientCtx client.Context) {
 
 func (app *SimApp) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg)
+	mempoolservice.RegisterMempoolService(app.GRPCQueryRouter(), app)
 }
 
 // GetMaccPerms returns a copy of the module account permissions
@@ -81,6 +81,7 @@ var (
 		{Account: minttypes.ModuleName, Permissions: []string{authtypes.Minter}},
 		{Account: stakingtypes.BondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
 		{Account: stakingtypes.NotBondedPoolName, Permissions: []string{authtypes.Burner, stakingtypes.ModuleName}},
+		{Account: stakingtypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		{Account: govtypes.ModuleName, Permissions: []string{authtypes.Burner}},
 		{Account: nft.ModuleName},
 		{Account: protocolpooltypes.ModuleName},
@@ -94,6 +95,7 @@ var (
 		minttypes.ModuleName,
 		stakingtypes.BondedPoolName,
 		stakingtypes.NotBondedPoolName,
+		stakingtypes.ModuleName,
 		nft.ModuleName,
 		// We allow the following module accounts to receive funds:
 		// govtypes.ModuleName
@@ -126,6 +128,7 @@ var (
 				EndBlockers: []string{
 					govtypes.ModuleName,
 					stakingtypes.ModuleName,
+					authtypes.ModuleName,
 					feegrant.ModuleName,
 					group.ModuleName,
 					protocolpooltypes.ModuleName,
@@ -278,6 +278,7 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 				BankKeeper:      app.BankKeeper,
 				SignModeHandler: txConfig.SignModeHandler(),
 				FeegrantKeeper:  app.FeeGrantKeeper,
+				FeeMarketKeeper: app.AccountKeeper,
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
 			&app.CircuitKeeper,
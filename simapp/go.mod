@@ -5,6 +5,7 @@ go 1.23.5
 require (
 	cosmossdk.io/api v1.0.0-rc.1
 	cosmossdk.io/client/v2 v2.10.0-beta.4
+	cosmossdk.io/collections v1.3.1
 	cosmossdk.io/core v1.1.0-rc.1
 	cosmossdk.io/depinject v1.2.1
 	cosmossdk.io/log v1.6.0
@@ -36,7 +37,6 @@ require (
 	cloud.google.com/go/iam v1.5.2 // indirect
 	cloud.google.com/go/monitoring v1.24.0 // indirect
 	cloud.google.com/go/storage v1.53.0 // indirect
-	cosmossdk.io/collections v1.3.1 // indirect
 	cosmossdk.io/errors v1.0.2 // indirect
 	cosmossdk.io/schema v1.1.0 // indirect
 	filippo.io/edwards25519 v1.1.0 // indirect
@@ -217,9 +217,12 @@ require (
 
 // Here are the short-lived replace from the SimApp
 // Replace here are pending PRs, or version to be tagged
-// replace (
-// 	<temporary replace>
-// )
+replace (
+	// TODO remove once the api module is tagged with the mempool and aggregate signature types
+	cosmossdk.io/api => ../api
+	// TODO remove once the x/tx module is tagged with the SIGN_MODE_EIP_712 handler
+	cosmossdk.io/x/tx => ../x/tx
+)
 
 // Below are the long-lived replace of the SimApp
 replace (
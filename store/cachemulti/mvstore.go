@@ -0,0 +1,556 @@
+package cachemulti
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/tidwall/btree"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/tracekv"
+	"cosmossdk.io/store/types"
+)
+
+// Version identifies an execution of a tx of a block: the index of the tx in
+// the block and its incarnation, incremented each time the tx is executed
+// again.
+type Version struct {
+	TxIndex     int
+	Incarnation int
+}
+
+// StorageVersion is the version of the values read from the parent of a
+// MultiVersionStore, which are not written by any tx of the block.
+var StorageVersion = Version{TxIndex: -1}
+
+// ReadSet maps the keys read by a tx from a store to the version read.
+type ReadSet map[string]Version
+
+// WriteSet maps the keys written by a tx to a store to their value, a nil
+// value being a deletion.
+type WriteSet map[string][]byte
+
+// MultiVersionStore holds the writes of the txs of a block executed
+// concurrently, in the manner of Block-STM. Each key maps the indexes of the
+// txs having written it to the value written by their last incarnation, so
+// that a tx reads the value written by the closest tx preceding it in the
+// block, or the one of the parent store if there is none.
+//
+// Each incarnation of a tx is executed on a VersionedStore recording the
+// versions of the keys it reads. Once the txs preceding a tx are executed,
+// ValidateReadSet tells whether the tx read the values it would have read if
+// executed sequentially, the tx having to be executed again otherwise.
+type MultiVersionStore struct {
+	parent types.MultiStore
+	keys   map[string]types.StoreKey
+	stores map[types.StoreKey]*mvKVStore
+
+	mtx sync.Mutex // guards txs
+	txs map[int]*txRecord
+}
+
+// txRecord is the last recorded execution of a tx.
+type txRecord struct {
+	version Version
+	stores  map[types.StoreKey]*versionedKVStore
+}
+
+// NewMultiVersionStore creates a MultiVersionStore on top of the stores of
+// parent registered in keys. The parent must not be written until the
+// MultiVersionStore is written to it.
+func NewMultiVersionStore(parent types.MultiStore, keys map[string]types.StoreKey) *MultiVersionStore {
+	s := &MultiVersionStore{
+		parent: parent,
+		keys:   keys,
+		stores: make(map[types.StoreKey]*mvKVStore, len(keys)),
+		txs:    make(map[int]*txRecord),
+	}
+
+	// the parent stores are read by the txs concurrently
+	parentMtx := &sync.Mutex{}
+	for _, key := range keys {
+		s.stores[key] = &mvKVStore{parent: parent.GetKVStore(key), parentMtx: parentMtx}
+	}
+
+	return s
+}
+
+// VersionedStore returns the store on which to execute the given version of
+// a tx. Writing it records the read set and the write set of the tx.
+func (s *MultiVersionStore) VersionedStore(version Version) *VersionedStore {
+	if version.TxIndex < 0 || version.Incarnation < 0 {
+		panic(fmt.Sprintf("invalid version %+v", version))
+	}
+
+	stores := make(map[types.StoreKey]*versionedKVStore, len(s.stores))
+	wrappers := make(map[types.StoreKey]types.CacheWrapper, len(s.stores))
+	for key, store := range s.stores {
+		stores[key] = &versionedKVStore{
+			store:   store,
+			version: version,
+			reads:   make(ReadSet),
+			writes:  make(WriteSet),
+		}
+		wrappers[key] = stores[key]
+	}
+
+	return &VersionedStore{
+		Store:   NewFromKVStore(dbadapter.Store{DB: dbm.NewMemDB()}, wrappers, s.keys, nil, nil),
+		mvs:     s,
+		version: version,
+		stores:  stores,
+	}
+}
+
+// record records the read set and the write set of an execution of a tx,
+// replacing the ones of its previous incarnations.
+func (s *MultiVersionStore) record(version Version, stores map[types.StoreKey]*versionedKVStore) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	prev := s.txs[version.TxIndex]
+	if prev != nil && prev.version.Incarnation > version.Incarnation {
+		panic(fmt.Sprintf("cannot record incarnation %d of tx %d after incarnation %d",
+			version.Incarnation, version.TxIndex, prev.version.Incarnation))
+	}
+
+	for key, store := range stores {
+		var prevWrites WriteSet
+		if prev != nil {
+			prevWrites = prev.stores[key].writes
+		}
+		s.stores[key].write(version, store.writes, prevWrites)
+	}
+
+	s.txs[version.TxIndex] = &txRecord{version: version, stores: stores}
+}
+
+// ReadSet returns the keys of the store of key read by the last recorded
+// execution of the tx at txIndex, with their version.
+func (s *MultiVersionStore) ReadSet(txIndex int, key types.StoreKey) ReadSet {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if rec, ok := s.txs[txIndex]; ok {
+		return rec.stores[key].reads
+	}
+
+	return nil
+}
+
+// WriteSet returns the values written to the store of key by the last
+// recorded execution of the tx at txIndex.
+func (s *MultiVersionStore) WriteSet(txIndex int, key types.StoreKey) WriteSet {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if rec, ok := s.txs[txIndex]; ok {
+		return rec.stores[key].writes
+	}
+
+	return nil
+}
+
+// ValidateReadSet returns whether the last recorded execution of the tx at
+// txIndex read the values currently written by the txs preceding it, that is
+// whether each key it read, and each key of the ranges it iterated, still has
+// the version it read. It returns false if no execution of the tx is recorded.
+func (s *MultiVersionStore) ValidateReadSet(txIndex int) bool {
+	s.mtx.Lock()
+	rec, ok := s.txs[txIndex]
+	s.mtx.Unlock()
+	if !ok {
+		return false
+	}
+
+	for _, store := range rec.stores {
+		for key, version := range store.reads {
+			if store.store.version(key, txIndex) != version {
+				return false
+			}
+		}
+
+		for _, it := range store.iterations {
+			entries := store.store.iterate(it.start, it.end, txIndex)
+			if !slices.EqualFunc(entries, it.entries, func(a, b mvEntry) bool {
+				return bytes.Equal(a.key, b.key) && a.version == b.version
+			}) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Write writes to the parent stores the values written by the last tx having
+// written each key.
+func (s *MultiVersionStore) Write() {
+	for _, store := range s.stores {
+		store.writeToParent()
+	}
+}
+
+// versionedValue is a value written by an incarnation of a tx, a nil value
+// being a deletion.
+type versionedValue struct {
+	incarnation int
+	value       []byte
+}
+
+// mvEntry is an entry of a store as seen by a tx.
+type mvEntry struct {
+	key, value []byte
+	version    Version
+}
+
+// mvKVStore holds the values written to a store by the txs of a block.
+type mvKVStore struct {
+	parent    types.KVStore
+	parentMtx *sync.Mutex
+
+	mtx sync.RWMutex
+	// keys maps the written keys to the index of the txs having written them
+	// to their value.
+	keys btree.Map[string, *btree.Map[int, versionedValue]]
+}
+
+// latest returns the value of key written by the closest tx preceding the tx
+// at txIndex, if any. s.mtx must be held.
+func (s *mvKVStore) latest(key string, txIndex int) (value []byte, version Version, found bool) {
+	versions, ok := s.keys.Get(key)
+	if !ok {
+		return nil, StorageVersion, false
+	}
+
+	// the values are shared by the txs, they are clipped so that appending to
+	// them does not modify the values seen by the other txs
+	version = StorageVersion
+	versions.Descend(txIndex-1, func(tx int, v versionedValue) bool {
+		value, version, found = slices.Clip(v.value), Version{TxIndex: tx, Incarnation: v.incarnation}, true
+		return false
+	})
+
+	return value, version, found
+}
+
+// version returns the version of key visible to the tx at txIndex.
+func (s *mvKVStore) version(key string, txIndex int) Version {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	_, version, _ := s.latest(key, txIndex)
+	return version
+}
+
+// get returns the value of key visible to the tx at txIndex and its version.
+func (s *mvKVStore) get(key []byte, txIndex int) ([]byte, Version) {
+	s.mtx.RLock()
+	value, version, found := s.latest(string(key), txIndex)
+	s.mtx.RUnlock()
+	if found {
+		return value, version
+	}
+
+	s.parentMtx.Lock()
+	defer s.parentMtx.Unlock()
+
+	return slices.Clip(s.parent.Get(key)), StorageVersion
+}
+
+// iterate returns the entries of the domain [start, end) visible to the tx at
+// txIndex, in ascending order.
+func (s *mvKVStore) iterate(start, end []byte, txIndex int) []mvEntry {
+	visible := make(map[string]mvEntry)
+
+	s.parentMtx.Lock()
+	it := s.parent.Iterator(start, end)
+	for ; it.Valid(); it.Next() {
+		visible[string(it.Key())] = mvEntry{
+			key:     slices.Clone(it.Key()),
+			value:   slices.Clone(it.Value()),
+			version: StorageVersion,
+		}
+	}
+	it.Close()
+	s.parentMtx.Unlock()
+
+	s.mtx.RLock()
+	s.keys.Ascend(string(start), func(key string, _ *btree.Map[int, versionedValue]) bool {
+		if end != nil && key >= string(end) {
+			return false
+		}
+
+		value, version, found := s.latest(key, txIndex)
+		switch {
+		case !found:
+		case value == nil:
+			delete(visible, key)
+		default:
+			visible[key] = mvEntry{key: []byte(key), value: value, version: version}
+		}
+
+		return true
+	})
+	s.mtx.RUnlock()
+
+	entries := make([]mvEntry, 0, len(visible))
+	for _, entry := range visible {
+		entries = append(entries, entry)
+	}
+	slices.SortFunc(entries, func(a, b mvEntry) int {
+		return bytes.Compare(a.key, b.key)
+	})
+
+	return entries
+}
+
+// write sets the values written by version, removing the keys written by a
+// previous incarnation of the tx and not written again.
+func (s *mvKVStore) write(version Version, writes, prevWrites WriteSet) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for key := range prevWrites {
+		if _, ok := writes[key]; ok {
+			continue
+		}
+
+		if versions, ok := s.keys.Get(key); ok {
+			versions.Delete(version.TxIndex)
+			if versions.Len() == 0 {
+				s.keys.Delete(key)
+			}
+		}
+	}
+
+	for key, value := range writes {
+		versions, ok := s.keys.Get(key)
+		if !ok {
+			versions = &btree.Map[int, versionedValue]{}
+			s.keys.Set(key, versions)
+		}
+		versions.Set(version.TxIndex, versionedValue{incarnation: version.Incarnation, value: value})
+	}
+}
+
+// writeToParent writes to the parent store the value written by the last tx
+// having written each key.
+func (s *mvKVStore) writeToParent() {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	s.parentMtx.Lock()
+	defer s.parentMtx.Unlock()
+
+	s.keys.Scan(func(key string, versions *btree.Map[int, versionedValue]) bool {
+		_, v, _ := versions.Max()
+		if v.value == nil {
+			s.parent.Delete([]byte(key))
+		} else {
+			s.parent.Set([]byte(key), v.value)
+		}
+
+		return true
+	})
+}
+
+// VersionedStore is the CacheMultiStore on which a version of a tx is
+// executed. It branches stores reading the values visible to the tx from a
+// MultiVersionStore, and writing it records the read set and the write set of
+// the tx in the MultiVersionStore.
+type VersionedStore struct {
+	Store
+
+	mvs     *MultiVersionStore
+	version Version
+	stores  map[types.StoreKey]*versionedKVStore
+}
+
+var _ types.CacheMultiStore = (*VersionedStore)(nil)
+
+// Version returns the version of the tx executed on the store.
+func (vs *VersionedStore) Version() Version {
+	return vs.version
+}
+
+// Write writes the branched stores and records the read set and the write set
+// of the tx.
+func (vs *VersionedStore) Write() {
+	vs.Store.Write()
+	vs.mvs.record(vs.version, vs.stores)
+}
+
+// iteration is a range iterated by a tx, with the entries it saw.
+type iteration struct {
+	start, end []byte
+	entries    []mvEntry
+}
+
+// versionedKVStore is the KVStore of a tx reading the values visible to its
+// version from a mvKVStore, recording their version, and buffering its writes.
+// It is meant to be branched, the writes of the tx being only flushed to it
+// once the tx is executed.
+type versionedKVStore struct {
+	store   *mvKVStore
+	version Version
+
+	reads      ReadSet
+	iterations []iteration
+	writes     WriteSet
+}
+
+var _ types.KVStore = (*versionedKVStore)(nil)
+
+// GetStoreType implements Store.
+func (s *versionedKVStore) GetStoreType() types.StoreType {
+	return s.store.parent.GetStoreType()
+}
+
+// CacheWrap implements CacheWrapper.
+func (s *versionedKVStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (s *versionedKVStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// Get implements KVStore.
+func (s *versionedKVStore) Get(key []byte) []byte {
+	types.AssertValidKey(key)
+
+	if value, ok := s.writes[string(key)]; ok {
+		return value
+	}
+
+	value, version := s.store.get(key, s.version.TxIndex)
+	s.reads[string(key)] = version
+
+	return value
+}
+
+// Has implements KVStore.
+func (s *versionedKVStore) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+// Set implements KVStore.
+func (s *versionedKVStore) Set(key, value []byte) {
+	types.AssertValidKey(key)
+	types.AssertValidValue(value)
+
+	s.writes[string(key)] = value
+}
+
+// Delete implements KVStore.
+func (s *versionedKVStore) Delete(key []byte) {
+	types.AssertValidKey(key)
+
+	s.writes[string(key)] = nil
+}
+
+// Iterator implements KVStore.
+func (s *versionedKVStore) Iterator(start, end []byte) types.Iterator {
+	return s.iterator(start, end, true)
+}
+
+// ReverseIterator implements KVStore.
+func (s *versionedKVStore) ReverseIterator(start, end []byte) types.Iterator {
+	return s.iterator(start, end, false)
+}
+
+// iterator records the iterated range and returns an iterator over the
+// entries visible to the tx, including its own writes.
+func (s *versionedKVStore) iterator(start, end []byte, ascending bool) types.Iterator {
+	entries := s.store.iterate(start, end, s.version.TxIndex)
+	s.iterations = append(s.iterations, iteration{
+		start:   slices.Clone(start),
+		end:     slices.Clone(end),
+		entries: entries,
+	})
+
+	it := &entriesIterator{start: start, end: end}
+	if len(s.writes) == 0 {
+		it.entries = entries
+	} else {
+		it.entries = s.overlayWrites(entries, start, end)
+	}
+	if !ascending {
+		it.entries = slices.Clone(it.entries)
+		slices.Reverse(it.entries)
+	}
+
+	return it
+}
+
+// overlayWrites returns entries updated with the writes of the tx to the
+// domain [start, end).
+func (s *versionedKVStore) overlayWrites(entries []mvEntry, start, end []byte) []mvEntry {
+	visible := make(map[string][]byte, len(entries))
+	for _, entry := range entries {
+		visible[string(entry.key)] = entry.value
+	}
+	for key, value := range s.writes {
+		if (start != nil && key < string(start)) || (end != nil && key >= string(end)) {
+			continue
+		}
+		if value == nil {
+			delete(visible, key)
+		} else {
+			visible[key] = value
+		}
+	}
+
+	overlaid := make([]mvEntry, 0, len(visible))
+	for key, value := range visible {
+		overlaid = append(overlaid, mvEntry{key: []byte(key), value: value})
+	}
+	slices.SortFunc(overlaid, func(a, b mvEntry) int {
+		return bytes.Compare(a.key, b.key)
+	})
+
+	return overlaid
+}
+
+// entriesIterator iterates over a list of entries.
+type entriesIterator struct {
+	start, end []byte
+	entries    []mvEntry
+}
+
+var _ types.Iterator = (*entriesIterator)(nil)
+
+func (it *entriesIterator) Domain() ([]byte, []byte) { return it.start, it.end }
+
+func (it *entriesIterator) Valid() bool { return len(it.entries) > 0 }
+
+func (it *entriesIterator) Next() {
+	it.assertValid()
+	it.entries = it.entries[1:]
+}
+
+func (it *entriesIterator) Key() []byte {
+	it.assertValid()
+	return it.entries[0].key
+}
+
+func (it *entriesIterator) Value() []byte {
+	it.assertValid()
+	return it.entries[0].value
+}
+
+func (it *entriesIterator) Error() error { return nil }
+
+func (it *entriesIterator) Close() error { return nil }
+
+func (it *entriesIterator) assertValid() {
+	if !it.Valid() {
+		panic("iterator is invalid")
+	}
+}
@@ -0,0 +1,320 @@
+package cachemulti
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
+
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/types"
+)
+
+var (
+	mvKey1 = types.NewKVStoreKey("store1")
+	mvKey2 = types.NewKVStoreKey("store2")
+	mvKeys = map[string]types.StoreKey{mvKey1.Name(): mvKey1, mvKey2.Name(): mvKey2}
+)
+
+// newMVTestParent returns a multi-store holding initial, backed by the
+// returned dbs.
+func newMVTestParent(initial map[types.StoreKey]map[string]string) (Store, map[types.StoreKey]dbm.DB) {
+	dbs := make(map[types.StoreKey]dbm.DB)
+	stores := make(map[types.StoreKey]types.CacheWrapper)
+	for _, key := range mvKeys {
+		db := dbm.NewMemDB()
+		for k, v := range initial[key] {
+			if err := db.Set([]byte(k), []byte(v)); err != nil {
+				panic(err)
+			}
+		}
+		dbs[key] = db
+		stores[key] = dbadapter.Store{DB: db}
+	}
+
+	return NewStore(dbm.NewMemDB(), stores, mvKeys, nil, nil), dbs
+}
+
+// dumpDBs returns the entries of dbs.
+func dumpDBs(t require.TestingT, dbs map[types.StoreKey]dbm.DB) map[string]map[string]string {
+	dump := make(map[string]map[string]string)
+	for key, db := range dbs {
+		it, err := db.Iterator(nil, nil)
+		require.NoError(t, err)
+
+		dump[key.Name()] = make(map[string]string)
+		for ; it.Valid(); it.Next() {
+			dump[key.Name()][string(it.Key())] = string(it.Value())
+		}
+		require.NoError(t, it.Close())
+	}
+
+	return dump
+}
+
+// mvOp is an operation of a tx on a store, returning what it observed.
+type mvOp struct {
+	kind       string
+	store      types.StoreKey
+	key, value []byte
+	start, end []byte
+}
+
+func (op mvOp) apply(ms types.MultiStore) string {
+	store := ms.GetKVStore(op.store)
+	switch op.kind {
+	case "get":
+		return fmt.Sprintf("%s %t", store.Get(op.key), store.Has(op.key))
+	case "set":
+		store.Set(op.key, op.value)
+	case "delete":
+		store.Delete(op.key)
+	case "append":
+		store.Set(op.key, append(store.Get(op.key), op.value...))
+	case "iterate", "reverse":
+		var it types.Iterator
+		if op.kind == "iterate" {
+			it = store.Iterator(op.start, op.end)
+		} else {
+			it = store.ReverseIterator(op.start, op.end)
+		}
+
+		var entries []string
+		for ; it.Valid(); it.Next() {
+			entries = append(entries, fmt.Sprintf("%s=%s", it.Key(), it.Value()))
+		}
+		it.Close()
+
+		// the count of the iterated entries is written, so that the writes of
+		// the tx depend on the iterated range
+		store.Set(op.key, fmt.Append(nil, len(entries)))
+		return strings.Join(entries, ",")
+	}
+
+	return ""
+}
+
+func applyMVOps(ms types.MultiStore, ops []mvOp) []string {
+	outputs := make([]string, len(ops))
+	for i, op := range ops {
+		outputs[i] = op.apply(ms)
+	}
+
+	return outputs
+}
+
+var (
+	mvKeyGen   = rapid.SampledFrom([]string{"a", "b", "c", "d", "e"})
+	mvValueGen = rapid.StringMatching("[0-9]")
+	mvOpGen    = rapid.Custom(func(t *rapid.T) mvOp {
+		op := mvOp{
+			kind:  rapid.SampledFrom([]string{"get", "set", "delete", "append", "iterate", "reverse"}).Draw(t, "kind"),
+			store: rapid.SampledFrom([]types.StoreKey{mvKey1, mvKey2}).Draw(t, "store"),
+			key:   []byte(mvKeyGen.Draw(t, "key")),
+			value: []byte(mvValueGen.Draw(t, "value")),
+		}
+		if rapid.Bool().Draw(t, "bounded start") {
+			op.start = []byte(mvKeyGen.Draw(t, "start"))
+		}
+		if rapid.Bool().Draw(t, "bounded end") {
+			op.end = []byte(mvKeyGen.Draw(t, "end"))
+		}
+
+		return op
+	})
+	mvTxsGen = rapid.SliceOfN(rapid.SliceOfN(mvOpGen, 0, 8), 1, 8)
+)
+
+func drawMVInitial(t *rapid.T) map[types.StoreKey]map[string]string {
+	return map[types.StoreKey]map[string]string{
+		mvKey1: rapid.MapOf(mvKeyGen, mvValueGen).Draw(t, "initial1"),
+		mvKey2: rapid.MapOf(mvKeyGen, mvValueGen).Draw(t, "initial2"),
+	}
+}
+
+// executeSequentially executes txs in order on branches of a cachekv backed
+// multi-store, returning the outputs of their operations and the final state.
+func executeSequentially(t require.TestingT, initial map[types.StoreKey]map[string]string, txs [][]mvOp) ([][]string, map[string]map[string]string) {
+	parent, dbs := newMVTestParent(initial)
+
+	outputs := make([][]string, len(txs))
+	for i, ops := range txs {
+		ms := parent.CacheMultiStore()
+		outputs[i] = applyMVOps(ms, ops)
+		ms.Write()
+	}
+	parent.Write()
+
+	return outputs, dumpDBs(t, dbs)
+}
+
+func TestMultiVersionStoreCacheKVEquivalence(t *testing.T) {
+	// executing the txs in order on a MultiVersionStore is equivalent to
+	// executing them on cachekv stores
+	rapid.Check(t, func(t *rapid.T) {
+		initial := drawMVInitial(t)
+		txs := mvTxsGen.Draw(t, "txs")
+		expOutputs, expState := executeSequentially(t, initial, txs)
+
+		parent, dbs := newMVTestParent(initial)
+		mvs := NewMultiVersionStore(parent, mvKeys)
+		for i, ops := range txs {
+			vs := mvs.VersionedStore(Version{TxIndex: i})
+
+			// the txs are executed either on the versioned store or on a
+			// branch of it
+			if rapid.Bool().Draw(t, "branch") {
+				ms := vs.CacheMultiStore()
+				require.Equal(t, expOutputs[i], applyMVOps(ms, ops))
+				ms.Write()
+			} else {
+				require.Equal(t, expOutputs[i], applyMVOps(vs, ops))
+			}
+			vs.Write()
+
+			require.True(t, mvs.ValidateReadSet(i))
+		}
+
+		mvs.Write()
+		parent.Write()
+		require.Equal(t, expState, dumpDBs(t, dbs))
+	})
+}
+
+func TestMultiVersionStoreSpeculativeExecution(t *testing.T) {
+	// executing the txs in any order, and then executing again the ones whose
+	// read set is invalid, is equivalent to executing them sequentially
+	rapid.Check(t, func(t *rapid.T) {
+		initial := drawMVInitial(t)
+		txs := mvTxsGen.Draw(t, "txs")
+		expOutputs, expState := executeSequentially(t, initial, txs)
+
+		parent, dbs := newMVTestParent(initial)
+		mvs := NewMultiVersionStore(parent, mvKeys)
+		outputs := make([][]string, len(txs))
+		execute := func(version Version) {
+			vs := mvs.VersionedStore(version)
+			outputs[version.TxIndex] = applyMVOps(vs, txs[version.TxIndex])
+			vs.Write()
+		}
+
+		indexes := make([]int, len(txs))
+		for i := range indexes {
+			indexes[i] = i
+		}
+		for _, i := range rapid.Permutation(indexes).Draw(t, "order") {
+			execute(Version{TxIndex: i})
+		}
+
+		for i := range txs {
+			if !mvs.ValidateReadSet(i) {
+				execute(Version{TxIndex: i, Incarnation: 1})
+				require.True(t, mvs.ValidateReadSet(i))
+			}
+		}
+		require.Equal(t, expOutputs, outputs)
+
+		mvs.Write()
+		parent.Write()
+		require.Equal(t, expState, dumpDBs(t, dbs))
+	})
+}
+
+func TestMultiVersionStoreConcurrentExecution(t *testing.T) {
+	var txs [][]mvOp
+	for i := range 50 {
+		key := []byte{'a' + byte(i%4)}
+		txs = append(txs, []mvOp{
+			{kind: "append", store: mvKey1, key: key, value: fmt.Append(nil, i%10)},
+			{kind: "iterate", store: mvKey1, key: []byte("e"), end: []byte("c")},
+			{kind: "get", store: mvKey2, key: []byte("e")},
+		})
+	}
+	initial := map[types.StoreKey]map[string]string{mvKey1: {"a": "x"}, mvKey2: {"e": "y"}}
+	expOutputs, expState := executeSequentially(t, initial, txs)
+
+	parent, dbs := newMVTestParent(initial)
+	mvs := NewMultiVersionStore(parent, mvKeys)
+	outputs := make([][]string, len(txs))
+	execute := func(version Version) {
+		vs := mvs.VersionedStore(version)
+		outputs[version.TxIndex] = applyMVOps(vs, txs[version.TxIndex])
+		vs.Write()
+	}
+
+	var wg sync.WaitGroup
+	for i := range txs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			execute(Version{TxIndex: i})
+		}()
+	}
+	wg.Wait()
+
+	for i := range txs {
+		if !mvs.ValidateReadSet(i) {
+			execute(Version{TxIndex: i, Incarnation: 1})
+		}
+	}
+	require.Equal(t, expOutputs, outputs)
+
+	mvs.Write()
+	parent.Write()
+	require.Equal(t, expState, dumpDBs(t, dbs))
+}
+
+func TestMultiVersionStoreReadWriteSets(t *testing.T) {
+	parent, _ := newMVTestParent(map[types.StoreKey]map[string]string{mvKey1: {"a": "0", "c": "0"}})
+	mvs := NewMultiVersionStore(parent, mvKeys)
+	require.False(t, mvs.ValidateReadSet(0))
+
+	tx0 := mvs.VersionedStore(Version{TxIndex: 0})
+	tx0.GetKVStore(mvKey1).Set([]byte("a"), []byte("1"))
+	tx0.GetKVStore(mvKey1).Set([]byte("b"), []byte("1"))
+	tx0.Write()
+	require.Equal(t, WriteSet{"a": []byte("1"), "b": []byte("1")}, mvs.WriteSet(0, mvKey1))
+
+	// tx 2 reads the writes of tx 0, and the parent store for the other keys
+	tx2 := mvs.VersionedStore(Version{TxIndex: 2})
+	store := tx2.GetKVStore(mvKey1)
+	require.Equal(t, []byte("1"), store.Get([]byte("a")))
+	require.Equal(t, []byte("0"), store.Get([]byte("c")))
+	store.Delete([]byte("c"))
+	tx2.Write()
+	require.Equal(t, ReadSet{"a": {TxIndex: 0}, "c": StorageVersion}, mvs.ReadSet(2, mvKey1))
+	require.True(t, mvs.ValidateReadSet(2))
+
+	// tx 3 iterates over the keys deleted by tx 2
+	tx3 := mvs.VersionedStore(Version{TxIndex: 3})
+	it := tx3.GetKVStore(mvKey1).Iterator(nil, nil)
+	var keys []string
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+	require.NoError(t, it.Close())
+	require.Equal(t, []string{"a", "b"}, keys)
+	tx3.Write()
+	require.True(t, mvs.ValidateReadSet(3))
+
+	// tx 1 writing a key read by tx 2 invalidates it, and tx 3 iterating over it
+	tx1 := mvs.VersionedStore(Version{TxIndex: 1})
+	tx1.GetKVStore(mvKey1).Set([]byte("c"), []byte("1"))
+	tx1.Write()
+	require.False(t, mvs.ValidateReadSet(2))
+	require.True(t, mvs.ValidateReadSet(3))
+
+	// a new incarnation of tx 0 not writing b anymore invalidates tx 3
+	tx0 = mvs.VersionedStore(Version{TxIndex: 0, Incarnation: 1})
+	tx0.GetKVStore(mvKey1).Set([]byte("a"), []byte("1"))
+	tx0.Write()
+	require.Equal(t, WriteSet{"a": []byte("1")}, mvs.WriteSet(0, mvKey1))
+	require.False(t, mvs.ValidateReadSet(2))
+	require.False(t, mvs.ValidateReadSet(3))
+
+	require.Panics(t, func() { mvs.VersionedStore(Version{TxIndex: 0}).Write() })
+}
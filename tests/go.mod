@@ -213,9 +213,12 @@ require (
 
 // Here are the short-lived replace from the SimApp
 // Replace here are pending PRs, or version to be tagged
-// replace (
-// 	<temporary replace>
-// )
+replace (
+	// TODO remove once the api module is tagged with the mempool and aggregate signature types
+	cosmossdk.io/api => ../api
+	// TODO remove once the x/tx module is tagged with the SIGN_MODE_EIP_712 handler
+	cosmossdk.io/x/tx => ../x/tx
+)
 
 // Below are the long-lived replace for tests.
 replace (
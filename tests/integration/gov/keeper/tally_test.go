@@ -9,6 +9,7 @@ import (
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -149,6 +150,171 @@ func TestTallyOnlyValidators51Yes(t *testing.T) {
 	assert.Assert(t, tallyResults.Equals(v1.EmptyTallyResult()) == false)
 }
 
+func TestTallyMessageBasedParams(t *testing.T) {
+	t.Parallel()
+
+	f := initFixture(t)
+
+	ctx := f.ctx
+
+	valAccAddrs, _ := createValidators(t, f, []int64{5, 6, 0})
+
+	// the proposal messages require a threshold of 60%
+	msgParams := v1.NewMessageBasedParams("0.334", "0.6", "0.334", nil)
+	assert.NilError(t, f.govKeeper.MessageBasedParams.Set(ctx, sdk.MsgTypeURL(&v1.MsgExecLegacyContent{}), msgParams))
+
+	tp := TestProposal
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", valAccAddrs[0], false)
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	assert.NilError(t, f.govKeeper.SetProposal(ctx, proposal))
+
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, valAccAddrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, valAccAddrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	proposal, ok := f.govKeeper.Proposals.Get(ctx, proposalID)
+	assert.Assert(t, ok)
+	passes, burnDeposits, tallyResults, _ := f.govKeeper.Tally(ctx, proposal)
+
+	assert.Assert(t, passes == false)
+	assert.Assert(t, burnDeposits == false)
+	assert.Assert(t, tallyResults.Equals(v1.EmptyTallyResult()) == false)
+}
+
+func TestTallyOptimisticPasses(t *testing.T) {
+	t.Parallel()
+
+	f := initFixture(t)
+
+	ctx := f.ctx
+
+	valAccAddrs, _ := createValidators(t, f, []int64{1, 10, 10})
+
+	params, err := f.govKeeper.Params.Get(ctx)
+	assert.NilError(t, err)
+	params.OptimisticAuthorizedAddresses = []string{valAccAddrs[0].String()}
+	params.OptimisticAllowedMsgUrls = []string{sdk.MsgTypeURL(&v1.MsgExecLegacyContent{})}
+	assert.NilError(t, f.govKeeper.Params.Set(ctx, params))
+
+	tp := TestProposal
+	proposal, err := f.govKeeper.SubmitOptimisticProposal(ctx, tp, "", "test", "description", valAccAddrs[0])
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	assert.NilError(t, f.govKeeper.SetProposal(ctx, proposal))
+
+	// less than 10% of the bonded stake votes against the proposal
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, valAccAddrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+	proposal, ok := f.govKeeper.Proposals.Get(ctx, proposalID)
+	assert.Assert(t, ok)
+	passes, burnDeposits, tallyResults, _ := f.govKeeper.Tally(ctx, proposal)
+
+	assert.Assert(t, passes)
+	assert.Assert(t, burnDeposits == false)
+	assert.Assert(t, tallyResults.Equals(v1.EmptyTallyResult()) == false)
+}
+
+func TestTallyOptimisticRejected(t *testing.T) {
+	t.Parallel()
+
+	f := initFixture(t)
+
+	ctx := f.ctx
+
+	valAccAddrs, _ := createValidators(t, f, []int64{5, 5, 5})
+
+	params, err := f.govKeeper.Params.Get(ctx)
+	assert.NilError(t, err)
+	params.OptimisticAuthorizedAddresses = []string{valAccAddrs[0].String()}
+	params.OptimisticAllowedMsgUrls = []string{sdk.MsgTypeURL(&v1.MsgExecLegacyContent{})}
+	assert.NilError(t, f.govKeeper.Params.Set(ctx, params))
+
+	tp := TestProposal
+	proposal, err := f.govKeeper.SubmitOptimisticProposal(ctx, tp, "", "test", "description", valAccAddrs[0])
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	assert.NilError(t, f.govKeeper.SetProposal(ctx, proposal))
+
+	// a third of the bonded stake votes against the proposal
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, valAccAddrs[1], v1.NewNonSplitVoteOption(v1.OptionNoWithVeto), ""))
+
+	proposal, ok := f.govKeeper.Proposals.Get(ctx, proposalID)
+	assert.Assert(t, ok)
+	passes, burnDeposits, tallyResults, _ := f.govKeeper.Tally(ctx, proposal)
+
+	assert.Assert(t, passes == false)
+	assert.Assert(t, burnDeposits == false)
+	assert.Assert(t, tallyResults.Equals(v1.EmptyTallyResult()) == false)
+}
+
+func TestTallyMultipleChoicePasses(t *testing.T) {
+	t.Parallel()
+
+	f := initFixture(t)
+
+	ctx := f.ctx
+
+	valAccAddrs, _ := createValidators(t, f, []int64{5, 5, 5})
+
+	optionOne, err := v1.NewProposalOption("one", TestProposal[:1])
+	assert.NilError(t, err)
+	optionTwo, err := v1.NewProposalOption("two", TestProposal[1:])
+	assert.NilError(t, err)
+	proposal, err := f.govKeeper.SubmitMultipleChoiceProposal(ctx, []*v1.ProposalOption{optionOne, optionTwo}, "", "test", "description", valAccAddrs[0])
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	assert.NilError(t, f.govKeeper.SetProposal(ctx, proposal))
+
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, valAccAddrs[0], v1.WeightedVoteOptions{v1.NewWeightedChoiceVoteOption(2, math.LegacyOneDec())}, ""))
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, valAccAddrs[1], v1.WeightedVoteOptions{v1.NewWeightedChoiceVoteOption(1, math.LegacyNewDecWithPrec(4, 1)), v1.NewWeightedChoiceVoteOption(2, math.LegacyNewDecWithPrec(6, 1))}, ""))
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, valAccAddrs[2], v1.NewNonSplitVoteOption(v1.OptionAbstain), ""))
+
+	proposal, ok := f.govKeeper.Proposals.Get(ctx, proposalID)
+	assert.Assert(t, ok)
+	passes, burnDeposits, tallyResults, _ := f.govKeeper.Tally(ctx, proposal)
+
+	assert.Assert(t, passes)
+	assert.Assert(t, burnDeposits == false)
+	assert.Equal(t, uint32(2), tallyResults.WinningOption())
+	assert.Equal(t, 2, len(tallyResults.OptionCounts))
+	assert.Assert(t, tallyResults.AbstainCount != "0")
+}
+
+func TestTallyMultipleChoiceTie(t *testing.T) {
+	t.Parallel()
+
+	f := initFixture(t)
+
+	ctx := f.ctx
+
+	valAccAddrs, _ := createValidators(t, f, []int64{5, 5, 5})
+
+	optionOne, err := v1.NewProposalOption("one", TestProposal[:1])
+	assert.NilError(t, err)
+	optionTwo, err := v1.NewProposalOption("two", nil)
+	assert.NilError(t, err)
+	proposal, err := f.govKeeper.SubmitMultipleChoiceProposal(ctx, []*v1.ProposalOption{optionOne, optionTwo}, "", "test", "description", valAccAddrs[0])
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	assert.NilError(t, f.govKeeper.SetProposal(ctx, proposal))
+
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, valAccAddrs[0], v1.WeightedVoteOptions{v1.NewWeightedChoiceVoteOption(1, math.LegacyOneDec())}, ""))
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, valAccAddrs[1], v1.WeightedVoteOptions{v1.NewWeightedChoiceVoteOption(2, math.LegacyOneDec())}, ""))
+
+	proposal, ok := f.govKeeper.Proposals.Get(ctx, proposalID)
+	assert.Assert(t, ok)
+	passes, burnDeposits, tallyResults, _ := f.govKeeper.Tally(ctx, proposal)
+
+	assert.Assert(t, passes == false)
+	assert.Assert(t, burnDeposits == false)
+	assert.Equal(t, uint32(0), tallyResults.WinningOption())
+}
+
 func TestTallyOnlyValidatorsVetoed(t *testing.T) {
 	t.Parallel()
 
@@ -344,6 +510,152 @@ func TestTallyDelegatorInherit(t *testing.T) {
 	assert.Assert(t, tallyResults.Equals(v1.EmptyTallyResult()) == false)
 }
 
+func TestTallyGovernorInherit(t *testing.T) {
+	t.Parallel()
+
+	f := initFixture(t)
+
+	ctx := f.ctx
+
+	addrs, vals := createValidators(t, f, []int64{5, 6, 7})
+
+	delTokens := f.stakingKeeper.TokensFromConsensusPower(ctx, 30)
+	val1, found := f.stakingKeeper.GetValidator(ctx, vals[0])
+	assert.Assert(t, found)
+
+	_, err := f.stakingKeeper.Delegate(ctx, addrs[4], delTokens, stakingtypes.Unbonded, val1, true)
+	assert.NilError(t, err)
+
+	_, err = f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+
+	// the delegator of validator 1 delegates its voting power to a governor voting no
+	assert.NilError(t, f.govKeeper.CreateGovernor(ctx, addrs[3], ""))
+	assert.NilError(t, f.govKeeper.DelegateGovernor(ctx, addrs[4], addrs[3]))
+
+	tp := TestProposal
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	assert.NilError(t, f.govKeeper.SetProposal(ctx, proposal))
+
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[3], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+	proposal, err = f.govKeeper.Proposals.Get(ctx, proposalID)
+	assert.NilError(t, err)
+
+	// the governor votes with the voting power of its delegator
+	emitCtx := ctx.WithEventManager(sdk.NewEventManager())
+	assert.NilError(t, f.govKeeper.EmitGovernorVotes(emitCtx, proposal))
+	events := emitCtx.EventManager().Events()
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, types.EventTypeGovernorVote, events[0].Type)
+	votingPower, ok := events[0].GetAttribute(types.AttributeKeyVotingPower)
+	assert.Assert(t, ok)
+	assert.Equal(t, math.LegacyNewDecFromInt(delTokens).String(), votingPower.Value)
+
+	passes, burnDeposits, tallyResults, _ := f.govKeeper.Tally(ctx, proposal)
+
+	assert.Assert(t, passes == false)
+	assert.Assert(t, burnDeposits == false)
+	assert.Equal(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 18).String(), tallyResults.YesCount)
+	assert.Equal(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 30).String(), tallyResults.NoCount)
+}
+
+func TestTallyGovernorDelegatorOverride(t *testing.T) {
+	t.Parallel()
+
+	f := initFixture(t)
+
+	ctx := f.ctx
+
+	addrs, vals := createValidators(t, f, []int64{5, 6, 7})
+
+	delTokens := f.stakingKeeper.TokensFromConsensusPower(ctx, 30)
+	val1, found := f.stakingKeeper.GetValidator(ctx, vals[0])
+	assert.Assert(t, found)
+
+	_, err := f.stakingKeeper.Delegate(ctx, addrs[4], delTokens, stakingtypes.Unbonded, val1, true)
+	assert.NilError(t, err)
+
+	_, err = f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+
+	// the delegator of validator 1 delegates its voting power to a governor voting no, but votes yes itself
+	assert.NilError(t, f.govKeeper.CreateGovernor(ctx, addrs[3], ""))
+	assert.NilError(t, f.govKeeper.DelegateGovernor(ctx, addrs[4], addrs[3]))
+
+	tp := TestProposal
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	assert.NilError(t, f.govKeeper.SetProposal(ctx, proposal))
+
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[3], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[4], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	proposal, err = f.govKeeper.Proposals.Get(ctx, proposalID)
+	assert.NilError(t, err)
+	passes, burnDeposits, tallyResults, _ := f.govKeeper.Tally(ctx, proposal)
+
+	assert.Assert(t, passes)
+	assert.Assert(t, burnDeposits == false)
+	assert.Equal(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 48).String(), tallyResults.YesCount)
+	assert.Equal(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 0).String(), tallyResults.NoCount)
+}
+
+func TestTallyGovernorNonVoter(t *testing.T) {
+	t.Parallel()
+
+	f := initFixture(t)
+
+	ctx := f.ctx
+
+	addrs, vals := createValidators(t, f, []int64{5, 6, 7})
+
+	delTokens := f.stakingKeeper.TokensFromConsensusPower(ctx, 30)
+	val1, found := f.stakingKeeper.GetValidator(ctx, vals[0])
+	assert.Assert(t, found)
+
+	_, err := f.stakingKeeper.Delegate(ctx, addrs[4], delTokens, stakingtypes.Unbonded, val1, true)
+	assert.NilError(t, err)
+
+	_, err = f.stakingKeeper.EndBlocker(ctx)
+	assert.NilError(t, err)
+
+	// the delegator of validator 1 delegates its voting power to a governor not voting, inheriting the vote of validator 1
+	assert.NilError(t, f.govKeeper.CreateGovernor(ctx, addrs[3], ""))
+	assert.NilError(t, f.govKeeper.DelegateGovernor(ctx, addrs[4], addrs[3]))
+
+	tp := TestProposal
+	proposal, err := f.govKeeper.SubmitProposal(ctx, tp, "", "test", "description", addrs[0], false)
+	assert.NilError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	assert.NilError(t, f.govKeeper.SetProposal(ctx, proposal))
+
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	assert.NilError(t, f.govKeeper.AddVote(ctx, proposalID, addrs[2], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	proposal, err = f.govKeeper.Proposals.Get(ctx, proposalID)
+	assert.NilError(t, err)
+	passes, burnDeposits, tallyResults, _ := f.govKeeper.Tally(ctx, proposal)
+
+	assert.Assert(t, passes)
+	assert.Assert(t, burnDeposits == false)
+	assert.Equal(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 48).String(), tallyResults.YesCount)
+	assert.Equal(t, f.stakingKeeper.TokensFromConsensusPower(ctx, 0).String(), tallyResults.NoCount)
+}
+
 func TestTallyDelegatorMultipleOverride(t *testing.T) {
 	t.Parallel()
 
@@ -229,7 +229,7 @@ var (
 		GenType(&vestingtypes.MsgCreatePeriodicVestingAccount{}, &vestingapi.MsgCreatePeriodicVestingAccount{}, GenOpts),
 	}
 	NonsignableTypes = []GeneratedType{
-		GenType(&authtypes.Params{}, &authapi.Params{}, GenOpts),
+		GenType(&authtypes.Params{}, &authapi.Params{}, GenOpts.WithDisallowNil()),
 		GenType(&authtypes.BaseAccount{}, &authapi.BaseAccount{}, GenOpts.WithAnyTypes(&ed25519.PubKey{})),
 		GenType(&authtypes.ModuleCredential{}, &authapi.ModuleCredential{}, GenOpts),
 
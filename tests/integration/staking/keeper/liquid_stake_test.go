@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	addrs, valAddrs, _ := createValidators(t, f, []int64{10, 20, 30})
+	bondDenom, err := f.stakingKeeper.BondDenom(ctx)
+	assert.NilError(t, err)
+
+	// addrs[0] tokenizes a third of its delegation to the second validator
+	tokens := f.stakingKeeper.TokensFromConsensusPower(ctx, 10)
+	res, err := msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(addrs[0].String(), valAddrs[1].String(), sdk.NewCoin(bondDenom, tokens), addrs[0].String()))
+	assert.NilError(t, err)
+
+	record, err := f.stakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, types.NewTokenizeShareRecord(1, addrs[0].String(), valAddrs[1].String()), record)
+	assert.DeepEqual(t, sdk.NewCoin(record.GetShareTokenDenom(), tokens), res.Amount)
+	assert.DeepEqual(t, res.Amount, f.bankKeeper.GetBalance(ctx, addrs[0], record.GetShareTokenDenom()))
+
+	delegation, err := f.stakingKeeper.GetDelegation(ctx, addrs[0], valAddrs[1])
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(f.stakingKeeper.TokensFromConsensusPower(ctx, 20)), delegation.Shares)
+	recordDelegation, err := f.stakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddrs[1])
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(tokens), recordDelegation.Shares)
+
+	liquidValidator, err := f.stakingKeeper.GetLiquidValidator(ctx, valAddrs[1])
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(tokens), liquidValidator.LiquidShares)
+	totalLiquidStakedTokens, err := f.stakingKeeper.GetTotalLiquidStakedTokens(ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, tokens, totalLiquidStakedTokens)
+
+	// half of the share tokens are redeemed by another account
+	halfShareTokens := sdk.NewCoin(record.GetShareTokenDenom(), tokens.QuoRaw(2))
+	assert.NilError(t, f.bankKeeper.SendCoins(ctx, addrs[0], addrs[2], sdk.NewCoins(halfShareTokens)))
+	redeemRes, err := msgServer.RedeemTokensForShares(ctx, types.NewMsgRedeemTokensForShares(addrs[2].String(), halfShareTokens))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, sdk.NewCoin(bondDenom, tokens.QuoRaw(2)), redeemRes.Amount)
+
+	delegation, err = f.stakingKeeper.GetDelegation(ctx, addrs[2], valAddrs[1])
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(tokens.QuoRaw(2)), delegation.Shares)
+
+	_, err = f.stakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	assert.NilError(t, err)
+
+	// the remaining share tokens are redeemed by the delegator
+	_, err = msgServer.RedeemTokensForShares(ctx, types.NewMsgRedeemTokensForShares(addrs[0].String(), halfShareTokens))
+	assert.NilError(t, err)
+
+	delegation, err = f.stakingKeeper.GetDelegation(ctx, addrs[0], valAddrs[1])
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(f.stakingKeeper.TokensFromConsensusPower(ctx, 25)), delegation.Shares)
+	_, err = f.stakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddrs[1])
+	assert.ErrorIs(t, err, types.ErrNoDelegation)
+	_, err = f.stakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	assert.ErrorIs(t, err, types.ErrNoTokenizeShareRecord)
+	assert.Assert(t, f.bankKeeper.GetSupply(ctx, record.GetShareTokenDenom()).IsZero())
+
+	liquidValidator, err = f.stakingKeeper.GetLiquidValidator(ctx, valAddrs[1])
+	assert.NilError(t, err)
+	assert.Assert(t, liquidValidator.LiquidShares.IsZero())
+	totalLiquidStakedTokens, err = f.stakingKeeper.GetTotalLiquidStakedTokens(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, totalLiquidStakedTokens.IsZero())
+
+	// unknown share tokens cannot be redeemed
+	_, err = msgServer.RedeemTokensForShares(ctx, types.NewMsgRedeemTokensForShares(addrs[0].String(), sdk.NewCoin(bondDenom, tokens)))
+	assert.ErrorIs(t, err, types.ErrNotTokenizeShareDenom)
+}
+
+func TestTokenizeSharesLiquidStakingCaps(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	addrs, valAddrs, _ := createValidators(t, f, []int64{10, 20, 30})
+	bondDenom, err := f.stakingKeeper.BondDenom(ctx)
+	assert.NilError(t, err)
+
+	tokens := f.stakingKeeper.TokensFromConsensusPower(ctx, 10)
+	msg := types.NewMsgTokenizeShares(addrs[0].String(), valAddrs[1].String(), sdk.NewCoin(bondDenom, tokens), addrs[0].String())
+	defaultParams, err := f.stakingKeeper.GetParams(ctx)
+	assert.NilError(t, err)
+
+	// the validator has no validator bond
+	params := defaultParams
+	params.ValidatorBondFactor = math.LegacyOneDec()
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+	_, err = msgServer.TokenizeShares(ctx, msg)
+	assert.ErrorIs(t, err, types.ErrInsufficientValidatorBondShares)
+
+	// the validator caps the liquid shares to 10% of its shares
+	params = defaultParams
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(1, 1)
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+	_, err = msgServer.TokenizeShares(ctx, msg)
+	assert.ErrorIs(t, err, types.ErrValidatorLiquidStakingCapExceeded)
+
+	// the chain caps the liquid staked tokens to 10% of the bonded tokens
+	params = defaultParams
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(1, 1)
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+	_, err = msgServer.TokenizeShares(ctx, msg)
+	assert.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+
+	// the self-delegation of the operator is designated as validator bond
+	params = defaultParams
+	params.ValidatorBondFactor = math.LegacyOneDec()
+	assert.NilError(t, f.stakingKeeper.SetParams(ctx, params))
+	_, err = msgServer.ValidatorBond(ctx, types.NewMsgValidatorBond(addrs[1].String(), valAddrs[1].String()))
+	assert.NilError(t, err)
+	_, err = msgServer.TokenizeShares(ctx, msg)
+	assert.NilError(t, err)
+
+	// the validator bond cannot be undelegated below the liquid shares
+	undelegateAmount := sdk.NewCoin(bondDenom, f.stakingKeeper.TokensFromConsensusPower(ctx, 11))
+	_, err = msgServer.Undelegate(ctx, types.NewMsgUndelegate(addrs[1].String(), valAddrs[1].String(), undelegateAmount))
+	assert.ErrorIs(t, err, types.ErrInsufficientValidatorBondShares)
+	undelegateAmount = sdk.NewCoin(bondDenom, f.stakingKeeper.TokensFromConsensusPower(ctx, 10))
+	_, err = msgServer.Undelegate(ctx, types.NewMsgUndelegate(addrs[1].String(), valAddrs[1].String(), undelegateAmount))
+	assert.NilError(t, err)
+
+	liquidValidator, err := f.stakingKeeper.GetLiquidValidator(ctx, valAddrs[1])
+	assert.NilError(t, err)
+	assert.DeepEqual(t, math.LegacyNewDecFromInt(tokens), liquidValidator.ValidatorBondShares)
+}
+
+func TestTokenizeSharesSlashed(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	ctx := f.sdkCtx
+	msgServer := keeper.NewMsgServerImpl(f.stakingKeeper)
+	addrs, valAddrs, vals := createValidators(t, f, []int64{10, 20, 30})
+	bondDenom, err := f.stakingKeeper.BondDenom(ctx)
+	assert.NilError(t, err)
+
+	tokens := f.stakingKeeper.TokensFromConsensusPower(ctx, 10)
+	res, err := msgServer.TokenizeShares(ctx, types.NewMsgTokenizeShares(addrs[0].String(), valAddrs[1].String(), sdk.NewCoin(bondDenom, tokens), addrs[0].String()))
+	assert.NilError(t, err)
+
+	validator, err := f.stakingKeeper.GetValidator(ctx, valAddrs[1])
+	assert.NilError(t, err)
+	consAddr, err := vals[1].GetConsAddr()
+	assert.NilError(t, err)
+	power := validator.GetConsensusPower(f.stakingKeeper.PowerReduction(ctx))
+	_, err = f.stakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), power, math.LegacyNewDecWithPrec(1, 1))
+	assert.NilError(t, err)
+
+	// the liquid staked tokens are slashed with the validator
+	slashedTokens := f.stakingKeeper.TokensFromConsensusPower(ctx, 9)
+	totalLiquidStakedTokens, err := f.stakingKeeper.GetTotalLiquidStakedTokens(ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, slashedTokens, totalLiquidStakedTokens)
+
+	redeemRes, err := msgServer.RedeemTokensForShares(ctx, types.NewMsgRedeemTokensForShares(addrs[0].String(), res.Amount))
+	assert.NilError(t, err)
+	assert.DeepEqual(t, sdk.NewCoin(bondDenom, slashedTokens), redeemRes.Amount)
+
+	totalLiquidStakedTokens, err = f.stakingKeeper.GetTotalLiquidStakedTokens(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, totalLiquidStakedTokens.IsZero())
+}
@@ -157,6 +157,7 @@ func AuthModule() ModuleOption {
 					{Account: "mint", Permissions: []string{"minter"}},
 					{Account: "bonded_tokens_pool", Permissions: []string{"burner", "staking"}},
 					{Account: "not_bonded_tokens_pool", Permissions: []string{"burner", "staking"}},
+					{Account: "staking", Permissions: []string{"minter", "burner"}},
 					{Account: "gov", Permissions: []string{"burner"}},
 					{Account: "nft"},
 					{Account: protocolpooltypes.ModuleName},
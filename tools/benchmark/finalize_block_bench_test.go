@@ -0,0 +1,149 @@
+package benchmark_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil/configurator"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/x/auth"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	_ "github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	_ "github.com/cosmos/cosmos-sdk/x/consensus"
+	_ "github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+const blockTxs = 500
+
+// BenchmarkFinalizeBlockBankSend measures the execution of blocks of bank send
+// txs, sequentially and with parallel tx execution. Each tx is signed by a
+// distinct sender. Senders either pay distinct recipients, in which case the
+// txs do not conflict, or all pay a same recipient, in which case every tx
+// conflicts with the previous ones and is executed again.
+//
+// Txs paying fees all credit the fee collector account, hence conflict with
+// each other like txs paying a same recipient do: parallel execution only
+// speeds up blocks of zero fee txs.
+func BenchmarkFinalizeBlockBankSend(b *testing.B) {
+	for _, fees := range []bool{false, true} {
+		for _, hotRecipient := range []bool{false, true} {
+			for _, workers := range []int{1, 2, 4, 8} {
+				b.Run(fmt.Sprintf("fees=%t/hot_recipient=%t/workers=%d", fees, hotRecipient, workers), func(b *testing.B) {
+					benchmarkFinalizeBlockBankSend(b, workers, fees, hotRecipient)
+				})
+			}
+		}
+	}
+}
+
+type bankSendAccount struct {
+	priv      cryptotypes.PrivKey
+	addr      sdk.AccAddress
+	accNum    uint64
+	recipient sdk.AccAddress
+}
+
+func benchmarkFinalizeBlockBankSend(b *testing.B, workers int, fees, hotRecipient bool) {
+	b.Helper()
+
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1_000_000_000)))
+	accounts := make([]*bankSendAccount, blockTxs)
+	genAccounts := make([]simtestutil.GenesisAccount, 0, 2*blockTxs)
+	for i := range accounts {
+		priv := secp256k1.GenPrivKey()
+		recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		accounts[i] = &bankSendAccount{priv: priv, addr: sdk.AccAddress(priv.PubKey().Address()), recipient: recipient}
+
+		// recipients are funded so that sends do not create accounts, which
+		// would make every tx conflict on the account number sequence
+		genAccounts = append(genAccounts,
+			simtestutil.GenesisAccount{GenesisAccount: authtypes.NewBaseAccount(accounts[i].addr, priv.PubKey(), 0, 0), Coins: coins},
+			simtestutil.GenesisAccount{GenesisAccount: authtypes.NewBaseAccount(recipient, nil, 0, 0), Coins: coins},
+		)
+	}
+	if hotRecipient {
+		for _, acc := range accounts {
+			acc.recipient = accounts[0].recipient
+		}
+	}
+
+	startupCfg := simtestutil.DefaultStartUpConfig()
+	startupCfg.GenesisAccounts = genAccounts
+	startupCfg.BaseAppOption = runtime.BaseAppOption(baseapp.SetParallelTxExecution(workers))
+
+	var (
+		accountKeeper authkeeper.AccountKeeper
+		txConfig      client.TxConfig
+	)
+	app, err := simtestutil.SetupWithConfiguration(
+		depinject.Configs(
+			configurator.NewAppConfig(
+				configurator.AuthModule(),
+				configurator.BankModule(),
+				configurator.StakingModule(),
+				configurator.TxModule(),
+				configurator.ConsensusModule(),
+			),
+			depinject.Supply(log.NewNopLogger()),
+		),
+		startupCfg, &accountKeeper, &txConfig)
+	require.NoError(b, err)
+	_, err = app.Commit()
+	require.NoError(b, err)
+
+	ctx := app.NewContext(true)
+	for _, acc := range accounts {
+		acc.accNum = accountKeeper.GetAccount(ctx, acc.addr).GetAccountNumber()
+	}
+
+	r := rand.New(rand.NewSource(0))
+	send := banktypes.NewMsgSend(nil, nil, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	feeAmt := sdk.Coins{}
+	if fees {
+		feeAmt = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+		txs := make([][]byte, len(accounts))
+		for i, acc := range accounts {
+			msg := *send
+			msg.FromAddress, msg.ToAddress = acc.addr.String(), acc.recipient.String()
+
+			tx, err := simtestutil.GenSignedMockTx(r, txConfig, []sdk.Msg{&msg}, feeAmt, simtestutil.DefaultGenTxGas,
+				"", []uint64{acc.accNum}, []uint64{uint64(n)}, acc.priv)
+			require.NoError(b, err)
+
+			txs[i], err = txConfig.TxEncoder()(tx)
+			require.NoError(b, err)
+		}
+		b.StartTimer()
+
+		res, err := app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: app.LastBlockHeight() + 1, Txs: txs})
+		require.NoError(b, err)
+		for _, txResult := range res.TxResults {
+			require.True(b, txResult.IsOK(), txResult.Log)
+		}
+
+		_, err = app.Commit()
+		require.NoError(b, err)
+	}
+}
@@ -0,0 +1,121 @@
+package mempool
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+	"github.com/huandu/skiplist"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Reasons for which a tx is evicted from, or rejected by, a mempool. They are
+// reported as the reason label of the mempool telemetry counters.
+const (
+	// EvictionReasonExpired is used when a tx stayed in the mempool for longer
+	// than the configured TTL.
+	EvictionReasonExpired = "expired"
+	// EvictionReasonLowPriority is used when a tx is evicted to make room for a
+	// higher priority tx in a full mempool.
+	EvictionReasonLowPriority = "low_priority"
+	// EvictionReasonReplaced is used when a tx is replaced by another tx with
+	// the same sender and nonce.
+	EvictionReasonReplaced = "replaced"
+
+	// RejectionReasonMaxTx is used when a tx is rejected because the mempool
+	// reached its maximum number of txs.
+	RejectionReasonMaxTx = "max_tx"
+	// RejectionReasonMaxBytes is used when a tx is rejected because the mempool
+	// reached its maximum size in bytes.
+	RejectionReasonMaxBytes = "max_bytes"
+	// RejectionReasonMaxTxsPerSender is used when a tx is rejected because its
+	// sender reached the maximum number of txs per sender.
+	RejectionReasonMaxTxsPerSender = "max_txs_per_sender"
+)
+
+// Telemetry keys of the mempool eviction and rejection counters.
+var (
+	MetricKeyEvictedTxs  = []string{"mempool", "evicted_txs"}
+	MetricKeyRejectedTxs = []string{"mempool", "rejected_txs"}
+)
+
+func emitEvictedTx(reason string) {
+	telemetry.IncrCounterWithLabels(MetricKeyEvictedTxs, 1, []metrics.Label{telemetry.NewLabel("reason", reason)})
+}
+
+func emitRejectedTx(reason string) {
+	telemetry.IncrCounterWithLabels(MetricKeyRejectedTxs, 1, []metrics.Label{telemetry.NewLabel("reason", reason)})
+}
+
+// txSizeAndBlockTime returns the size of the tx bytes and the block time set on
+// the given context. Zero values are returned when ctx does not carry an
+// sdk.Context, in which case byte caps and TTLs are not applied to the tx.
+func txSizeAndBlockTime(ctx context.Context) (int64, time.Time) {
+	sdkCtx, ok := unwrapSDKContext(ctx)
+	if !ok {
+		return 0, time.Time{}
+	}
+
+	return int64(len(sdkCtx.TxBytes())), sdkCtx.BlockTime()
+}
+
+// unwrapSDKContext returns the sdk.Context carried by ctx, if any.
+func unwrapSDKContext(ctx context.Context) (sdk.Context, bool) {
+	if sdkCtx, ok := ctx.(sdk.Context); ok {
+		return sdkCtx, true
+	}
+
+	sdkCtx, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context)
+	return sdkCtx, ok
+}
+
+// isExpired returns whether a tx inserted at insertedAt has outlived ttl at
+// time now.
+func isExpired(insertedAt, now time.Time, ttl time.Duration) bool {
+	if ttl <= 0 || insertedAt.IsZero() || now.IsZero() {
+		return false
+	}
+
+	return now.Sub(insertedAt) > ttl
+}
+
+// expiryKey indexes a tx in an expiry index by the block time at which it was
+// inserted, then by sender and nonce.
+type expiryKey struct {
+	insertedAt time.Time
+	sender     string
+	nonce      uint64
+}
+
+// newExpiryIndex returns a skip list of expiryKeys ordered by insertion time,
+// oldest first, which lets a mempool evict its expired txs without scanning all its txs.
+func newExpiryIndex() *skiplist.SkipList {
+	return skiplist.New(skiplist.GreaterThanFunc(func(a, b any) int {
+		keyA := a.(expiryKey)
+		keyB := b.(expiryKey)
+
+		if res := keyA.insertedAt.Compare(keyB.insertedAt); res != 0 {
+			return res
+		}
+
+		if res := skiplist.String.Compare(keyA.sender, keyB.sender); res != 0 {
+			return res
+		}
+
+		return skiplist.Uint64.Compare(keyA.nonce, keyB.nonce)
+	}))
+}
+
+// nextExpired returns the oldest entry of the expiry index if it outlived ttl at
+// block time now.
+func nextExpired(index *skiplist.SkipList, now time.Time, ttl time.Duration) (expiryKey, bool) {
+	front := index.Front()
+	if front == nil {
+		return expiryKey{}, false
+	}
+
+	key := front.Key().(expiryKey)
+	return key, isExpired(key.insertedAt, now, ttl)
+}
@@ -34,6 +34,34 @@ type ExtMempool interface {
 	SelectBy(context.Context, [][]byte, func(sdk.Tx) bool)
 }
 
+// InspectableMempool is implemented by mempools keeping a queue of txs per
+// sender, exposing these queues for inspection.
+type InspectableMempool interface {
+	// Senders returns the senders having txs in the mempool, sorted.
+	Senders() []string
+
+	// SenderTxs returns the txs of the given sender, ordered by nonce.
+	SenderTxs(sender string) []SenderTx
+}
+
+// EvictingMempool is implemented by mempools which drop txs on their own, i.e.
+// not through Remove, by evicting expired or lower priority txs or replacing
+// txs with a duplicated sender nonce.
+type EvictingMempool interface {
+	// SetOnTxEvicted sets the callback called with every tx the mempool drops
+	// on its own, replacing any previously set callback.
+	SetOnTxEvicted(onTxEvicted func(tx sdk.Tx))
+}
+
+// SenderTx describes a tx queued for a sender in an InspectableMempool.
+type SenderTx struct {
+	Tx    sdk.Tx
+	Nonce uint64
+	// Priority is the formatted priority of the tx, empty if the mempool does
+	// not prioritize txs.
+	Priority string
+}
+
 // Iterator defines an app-side mempool iterator interface that is as minimal as
 // possible. The order of iteration is determined by the app-side mempool
 // implementation.
@@ -47,8 +75,11 @@ type Iterator interface {
 }
 
 var (
-	ErrTxNotFound           = errors.New("tx not found in mempool")
-	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
+	ErrTxNotFound                = errors.New("tx not found in mempool")
+	ErrMempoolTxMaxCapacity      = errors.New("pool reached max tx capacity")
+	ErrMempoolTxMaxBytesCapacity = errors.New("pool reached max bytes capacity")
+	ErrMempoolSenderMaxCapacity  = errors.New("sender reached max tx capacity")
+	ErrTxReplacementRejected     = errors.New("tx doesn't fit the replacement rule")
 )
 
 // SelectBy is compatible with old interface to avoid breaking api.
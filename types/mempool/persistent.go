@@ -0,0 +1,259 @@
+package mempool
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+var (
+	_ ReplayableMempool  = (*PersistentMempool)(nil)
+	_ InspectableMempool = (*PersistentMempool)(nil)
+)
+
+// ReplayableMempool defines a mempool which persists its txs and is able to
+// restore them after a node restart.
+type ReplayableMempool interface {
+	ExtMempool
+
+	// Replay calls checkTx with the raw bytes of every persisted tx. checkTx is
+	// expected to validate the tx and insert it back into the mempool, txs for
+	// which it returns an error are dropped.
+	Replay(checkTx func(txBytes []byte) error) error
+
+	// Close releases the resources held by the mempool.
+	Close() error
+}
+
+// PersistentMempool wraps an ExtMempool and journals every inserted and
+// removed tx into a database, so that pending txs survive node restarts.
+//
+// Txs are journaled by their first signer and nonce, meaning that a tx
+// replacing another one overwrites its journal entry. Only txs inserted with
+// a context carrying the tx bytes, as done by BaseApp during CheckTx, are
+// journaled. When the wrapped mempool is an EvictingMempool, the entries of
+// the txs it evicts are deleted as they are evicted, otherwise they are pruned
+// from the journal when it is replayed.
+type PersistentMempool struct {
+	ExtMempool
+
+	mtx             sync.Mutex
+	db              dbm.DB
+	signerExtractor SignerExtractionAdapter
+}
+
+// NewPersistentMempool returns a PersistentMempool wrapping mp and journaling
+// its txs into db. If mp is an EvictingMempool, its OnTxEvicted callback is
+// set to delete the journal entries of the evicted txs.
+func NewPersistentMempool(mp ExtMempool, db dbm.DB, signerExtractor SignerExtractionAdapter) *PersistentMempool {
+	if signerExtractor == nil {
+		signerExtractor = NewDefaultSignerExtractionAdapter()
+	}
+
+	pmp := &PersistentMempool{
+		ExtMempool:      mp,
+		db:              db,
+		signerExtractor: signerExtractor,
+	}
+	if evicting, ok := mp.(EvictingMempool); ok {
+		evicting.SetOnTxEvicted(pmp.onTxEvicted)
+	}
+
+	return pmp
+}
+
+// Insert inserts tx into the wrapped mempool and, on success, journals the tx
+// bytes carried by ctx.
+func (mp *PersistentMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	if err := mp.ExtMempool.Insert(ctx, tx); err != nil {
+		return err
+	}
+
+	sdkCtx, ok := unwrapSDKContext(ctx)
+	if !ok || len(sdkCtx.TxBytes()) == 0 {
+		return nil
+	}
+
+	key, err := mp.journalKey(tx)
+	if err != nil {
+		return err
+	}
+
+	return mp.db.Set(key, sdkCtx.TxBytes())
+}
+
+// Remove removes tx from the wrapped mempool and from the journal.
+func (mp *PersistentMempool) Remove(tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	err := mp.ExtMempool.Remove(tx)
+	if err != nil && !errors.Is(err, ErrTxNotFound) {
+		return err
+	}
+
+	key, keyErr := mp.journalKey(tx)
+	if keyErr != nil {
+		return errors.Join(err, keyErr)
+	}
+
+	if delErr := mp.db.Delete(key); delErr != nil {
+		return errors.Join(err, delErr)
+	}
+
+	return err
+}
+
+// Replay implements ReplayableMempool. The journal is left untouched while the
+// txs are handed to checkTx, txs re-admitted through Insert overwriting their
+// own entry. Only once every tx has been replayed are the entries of txs which
+// are not part of the wrapped mempool, as checkTx rejected them or they were
+// evicted, pruned.
+func (mp *PersistentMempool) Replay(checkTx func(txBytes []byte) error) error {
+	txs, err := mp.readJournal()
+	if err != nil {
+		return err
+	}
+
+	for _, bz := range txs {
+		// invalid txs are dropped, like they would be by a regular CheckTx
+		_ = checkTx(bz)
+	}
+
+	return mp.prune()
+}
+
+// Senders implements InspectableMempool, returning nil when the wrapped
+// mempool is not inspectable.
+func (mp *PersistentMempool) Senders() []string {
+	if inspectable, ok := mp.ExtMempool.(InspectableMempool); ok {
+		return inspectable.Senders()
+	}
+
+	return nil
+}
+
+// SenderTxs implements InspectableMempool, returning nil when the wrapped
+// mempool is not inspectable.
+func (mp *PersistentMempool) SenderTxs(sender string) []SenderTx {
+	if inspectable, ok := mp.ExtMempool.(InspectableMempool); ok {
+		return inspectable.SenderTxs(sender)
+	}
+
+	return nil
+}
+
+// Close closes the journal database.
+func (mp *PersistentMempool) Close() error {
+	return mp.db.Close()
+}
+
+// readJournal returns all the journaled tx bytes.
+func (mp *PersistentMempool) readJournal() ([][]byte, error) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	it, err := mp.db.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var txs [][]byte
+	for ; it.Valid(); it.Next() {
+		txs = append(txs, it.Value())
+	}
+
+	return txs, it.Error()
+}
+
+// prune deletes the journal entries of txs which are not part of the wrapped
+// mempool.
+func (mp *PersistentMempool) prune() error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	pooled := make(map[string]struct{}, mp.ExtMempool.CountTx())
+	var err error
+	mp.ExtMempool.SelectBy(context.Background(), nil, func(tx sdk.Tx) bool {
+		var key []byte
+		if key, err = mp.journalKey(tx); err != nil {
+			return false
+		}
+		pooled[string(key)] = struct{}{}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	it, err := mp.db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	var stale [][]byte
+	for ; it.Valid(); it.Next() {
+		if _, ok := pooled[string(it.Key())]; !ok {
+			stale = append(stale, it.Key())
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+
+	for _, key := range stale {
+		if err := mp.db.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// onTxEvicted deletes the journal entry of a tx evicted by the wrapped mempool.
+// It is called from within the wrapped mempool's Insert, i.e. with mp.mtx held.
+// An entry which fails to be deleted is pruned on the next replay.
+func (mp *PersistentMempool) onTxEvicted(tx sdk.Tx) {
+	key, err := mp.journalKey(tx)
+	if err != nil {
+		return
+	}
+
+	_ = mp.db.Delete(key)
+}
+
+// journalKey returns the journal key of tx: the length prefixed address of
+// its first signer followed by its big endian nonce.
+func (mp *PersistentMempool) journalKey(tx sdk.Tx) ([]byte, error) {
+	signers, err := mp.signerExtractor.GetSigners(tx)
+	if err != nil {
+		return nil, err
+	}
+	if len(signers) == 0 {
+		return nil, fmt.Errorf("tx must have at least one signer")
+	}
+
+	nonce, err := ChooseNonce(signers[0].Sequence, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := address.LengthPrefix(signers[0].Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	return binary.BigEndian.AppendUint64(sender, nonce), nil
+}
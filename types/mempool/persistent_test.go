@@ -0,0 +1,138 @@
+package mempool_test
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestPersistentMempool(t *testing.T) {
+	now := time.Now()
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger()).WithBlockTime(now)
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	sa, sb, sc := accounts[0].Address, accounts[1].Address, accounts[2].Address
+
+	// txs are identified by their bytes when replayed
+	txs := map[string]testTx{
+		"a0":  {nonce: 0, address: sa},
+		"a1":  {nonce: 1, address: sa},
+		"a1'": {nonce: 1, address: sa},
+		"b0":  {nonce: 0, address: sb},
+		"c0":  {nonce: 0, address: sc},
+	}
+	insert := func(mp mempool.Mempool, ctx sdk.Context, bz string) error {
+		return mp.Insert(ctx.WithTxBytes([]byte(bz)), txs[bz])
+	}
+
+	db := dbm.NewMemDB()
+	mp := mempool.NewPersistentMempool(mempool.NewSenderNonceMempool(
+		mempool.SenderNonceMaxTxOpt(0),
+		mempool.SenderNonceTxTTLOpt(time.Minute),
+	), db, nil)
+
+	require.NoError(t, insert(mp, ctx, "a0"))
+	require.NoError(t, insert(mp, ctx, "a1"))
+	require.NoError(t, insert(mp, ctx, "b0"))
+	require.NoError(t, insert(mp, ctx, "c0"))
+	// a replacement overwrites the journaled tx
+	require.NoError(t, insert(mp, ctx, "a1'"))
+	// txs inserted without their bytes are not journaled
+	require.NoError(t, mp.Insert(context.Background(), testTx{nonce: 2, address: sa}))
+	require.NoError(t, mp.Remove(txs["b0"]))
+	// removing a tx which is not in the mempool anymore reports it as not found
+	require.ErrorIs(t, mp.Remove(txs["b0"]), mempool.ErrTxNotFound)
+	require.Equal(t, 4, mp.CountTx())
+
+	// restart the mempool, rejecting a0 on replay
+	mp = mempool.NewPersistentMempool(mempool.NewSenderNonceMempool(
+		mempool.SenderNonceMaxTxOpt(0),
+		mempool.SenderNonceTxTTLOpt(time.Minute),
+	), db, nil)
+
+	var replayed []string
+	require.NoError(t, mp.Replay(func(txBytes []byte) error {
+		replayed = append(replayed, string(txBytes))
+		if string(txBytes) == "a0" {
+			return errors.New("invalid tx")
+		}
+		return insert(mp, ctx, string(txBytes))
+	}))
+	require.ElementsMatch(t, []string{"a0", "a1'", "c0"}, replayed)
+	require.Equal(t, 2, mp.CountTx())
+
+	// expired txs are deleted from the journal as they are evicted
+	require.NoError(t, insert(mp, ctx.WithBlockTime(now.Add(2*time.Minute)), "b0"))
+	require.Equal(t, 1, mp.CountTx())
+	replayed = nil
+	mp = mempool.NewPersistentMempool(mempool.NewSenderNonceMempool(), db, nil)
+	require.NoError(t, mp.Replay(func(txBytes []byte) error {
+		replayed = append(replayed, string(txBytes))
+		return nil
+	}))
+	require.ElementsMatch(t, []string{"b0"}, replayed)
+
+	replayed = nil
+	require.NoError(t, mp.Replay(func(txBytes []byte) error {
+		replayed = append(replayed, string(txBytes))
+		return nil
+	}))
+	require.Empty(t, replayed)
+	require.NoError(t, mp.Close())
+}
+
+func TestPersistentMempool_Evicted(t *testing.T) {
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	txs := map[string]testTx{
+		"a0":  {priority: 1, nonce: 0, address: sa},
+		"b0":  {priority: 10, nonce: 0, address: sb},
+		"b0'": {priority: 20, nonce: 0, address: sb},
+	}
+	insert := func(mp mempool.Mempool, bz string) error {
+		return mp.Insert(ctx.WithPriority(txs[bz].priority).WithTxBytes([]byte(bz)), txs[bz])
+	}
+	journaled := func(db dbm.DB) []string {
+		it, err := db.Iterator(nil, nil)
+		require.NoError(t, err)
+		defer it.Close()
+
+		var entries []string
+		for ; it.Valid(); it.Next() {
+			entries = append(entries, string(it.Value()))
+		}
+		return entries
+	}
+
+	db := dbm.NewMemDB()
+	mp := mempool.NewPersistentMempool(mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[int64]{
+		TxPriority:          mempool.NewDefaultTxPriority(),
+		MaxTx:               1,
+		EvictLowestPriority: true,
+	}), db, nil)
+
+	require.NoError(t, insert(mp, "a0"))
+	require.Equal(t, []string{"a0"}, journaled(db))
+
+	// a0 is evicted to make room for the higher priority b0
+	require.NoError(t, insert(mp, "b0"))
+	require.Equal(t, []string{"b0"}, journaled(db))
+
+	// a replacement inserted without its bytes deletes the replaced tx entry
+	require.NoError(t, mp.Insert(ctx.WithPriority(txs["b0'"].priority), txs["b0'"]))
+	require.Equal(t, 1, mp.CountTx())
+	require.Empty(t, journaled(db))
+}
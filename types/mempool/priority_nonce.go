@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"math/big"
+	"slices"
 	"sync"
+	"time"
 
 	"github.com/huandu/skiplist"
 
@@ -12,8 +15,10 @@ import (
 )
 
 var (
-	_ ExtMempool = (*PriorityNonceMempool[int64])(nil)
-	_ Iterator   = (*PriorityNonceIterator[int64])(nil)
+	_ ExtMempool         = (*PriorityNonceMempool[int64])(nil)
+	_ InspectableMempool = (*PriorityNonceMempool[int64])(nil)
+	_ EvictingMempool    = (*PriorityNonceMempool[int64])(nil)
+	_ Iterator           = (*PriorityNonceIterator[int64])(nil)
 )
 
 type (
@@ -31,6 +36,17 @@ type (
 		// replacement rule based on tx priority or certain transaction fields.
 		TxReplacement func(op, np C, oTx, nTx sdk.Tx) bool
 
+		// OnTxReplaced is a callback to be called when a tx with a duplicated sender
+		// nonce replaces an existing tx during mempool insert. oTx is the evicted
+		// tx and nTx the tx replacing it, which lets an application keep its own
+		// bookkeeping (e.g. CheckTx/recheck state) consistent with the mempool.
+		OnTxReplaced func(oTx, nTx sdk.Tx)
+
+		// OnTxEvicted is a callback to be called with every tx the mempool drops on
+		// its own, i.e. expired txs, txs evicted to make room for a higher priority
+		// tx and replaced txs.
+		OnTxEvicted func(tx sdk.Tx)
+
 		// MaxTx sets the maximum number of transactions allowed in the mempool with
 		// the semantics:
 		// - if MaxTx == 0, there is no cap on the number of transactions in the mempool
@@ -40,6 +56,26 @@ type (
 		// - if MaxTx < 0, `Insert` is a no-op.
 		MaxTx int
 
+		// MaxTxsPerSender caps the number of txs a single sender, i.e. the first
+		// signer of a tx, may have in the mempool. Zero means no cap.
+		MaxTxsPerSender int
+
+		// MaxBytes caps the total size in bytes of the txs in the mempool. The size
+		// of a tx is the length of the tx bytes set on the insert context. Zero
+		// means no cap.
+		MaxBytes int64
+
+		// TxTTL sets for how long, measured in block time, a tx may stay in the
+		// mempool. Expired txs are evicted on Insert, before the tx is inserted.
+		// Zero means txs never expire.
+		TxTTL time.Duration
+
+		// EvictLowestPriority, when set, makes a full mempool (by MaxTx or MaxBytes)
+		// evict its lowest priority txs to make room for a tx with a higher
+		// priority instead of rejecting it. Only the tx with the highest nonce of
+		// a sender is evicted so that no nonce gap is created.
+		EvictLowestPriority bool
+
 		// SignerExtractor is an implementation which retrieves signer data from a sdk.Tx
 		SignerExtractor SignerExtractionAdapter
 	}
@@ -57,6 +93,8 @@ type (
 		priorityCounts map[C]int
 		senderIndices  map[string]*skiplist.SkipList
 		scores         map[txMeta[C]]txMeta[C]
+		expiryIndex    *skiplist.SkipList
+		totalBytes     int64
 		cfg            PriorityNonceMempoolConfig[C]
 	}
 
@@ -99,6 +137,10 @@ type (
 		weight C
 		// senderElement is a pointer to the transaction's element in the sender index
 		senderElement *skiplist.Element
+		// size is the transaction's size in bytes
+		size int64
+		// insertedAt is the block time at which the transaction was inserted
+		insertedAt time.Time
 	}
 )
 
@@ -116,6 +158,28 @@ func NewDefaultTxPriority() TxPriority[int64] {
 	}
 }
 
+// NewReplaceByFeeRule returns a TxReplacement rule implementing a replace-by-fee
+// policy for int64 priorities: a tx with a duplicated sender nonce only replaces
+// the existing tx if its priority is higher and exceeds the priority of the
+// existing tx by at least bumpPercent percent.
+func NewReplaceByFeeRule(bumpPercent uint64) func(op, np int64, oTx, nTx sdk.Tx) bool {
+	return func(op, np int64, _, _ sdk.Tx) bool {
+		if np <= op {
+			return false
+		}
+
+		// a percentage bump is meaningless for non-positive priorities.
+		if op <= 0 {
+			return true
+		}
+
+		// np * 100 >= op * (100 + bumpPercent), computed without overflow.
+		lhs := new(big.Int).Mul(big.NewInt(np), big.NewInt(100))
+		rhs := new(big.Int).Mul(big.NewInt(op), new(big.Int).Add(big.NewInt(100), new(big.Int).SetUint64(bumpPercent)))
+		return lhs.Cmp(rhs) >= 0
+	}
+}
+
 func DefaultPriorityNonceMempoolConfig() PriorityNonceMempoolConfig[int64] {
 	return PriorityNonceMempoolConfig[int64]{
 		TxPriority:      NewDefaultTxPriority(),
@@ -168,6 +232,7 @@ func NewPriorityMempool[C comparable](cfg PriorityNonceMempoolConfig[C]) *Priori
 		priorityCounts: make(map[C]int),
 		senderIndices:  make(map[string]*skiplist.SkipList),
 		scores:         make(map[txMeta[C]]txMeta[C]),
+		expiryIndex:    newExpiryIndex(),
 		cfg:            cfg,
 	}
 
@@ -192,6 +257,53 @@ func (mp *PriorityNonceMempool[C]) NextSenderTx(sender string) sdk.Tx {
 	return cursor.Value.(sdk.Tx)
 }
 
+// SetOnTxEvicted implements EvictingMempool, setting the OnTxEvicted callback
+// of the mempool config.
+func (mp *PriorityNonceMempool[C]) SetOnTxEvicted(onTxEvicted func(tx sdk.Tx)) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	mp.cfg.OnTxEvicted = onTxEvicted
+}
+
+// Senders returns the senders having transactions in the mempool, sorted.
+func (mp *PriorityNonceMempool[C]) Senders() []string {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	senders := make([]string, 0, len(mp.senderIndices))
+	for sender := range mp.senderIndices {
+		senders = append(senders, sender)
+	}
+	slices.Sort(senders)
+
+	return senders
+}
+
+// SenderTxs returns the transactions of the given sender ordered by nonce,
+// along with their priority.
+func (mp *PriorityNonceMempool[C]) SenderTxs(sender string) []SenderTx {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	senderIndex, ok := mp.senderIndices[sender]
+	if !ok {
+		return nil
+	}
+
+	txs := make([]SenderTx, 0, senderIndex.Len())
+	for e := senderIndex.Front(); e != nil; e = e.Next() {
+		key := e.Key().(txMeta[C])
+		txs = append(txs, SenderTx{
+			Tx:       e.Value.(sdk.Tx),
+			Nonce:    key.nonce,
+			Priority: fmt.Sprint(key.priority),
+		})
+	}
+
+	return txs
+}
+
 // Insert attempts to insert a Tx into the app-side mempool in O(log n) time,
 // returning an error if unsuccessful. Sender and nonce are derived from the
 // transaction's first signature.
@@ -200,13 +312,19 @@ func (mp *PriorityNonceMempool[C]) NextSenderTx(sender string) sdk.Tx {
 // O(log n) no-op.
 //
 // Inserting a duplicate tx with a different priority overwrites the existing tx,
-// changing the total order of the mempool.
+// changing the total order of the mempool, unless it is rejected by the
+// TxReplacement rule. Since a replacement does not grow the mempool, it is
+// accepted even when the mempool is at capacity. The evicted tx is reported
+// through OnTxReplaced.
+//
+// Txs which outlived TxTTL are evicted before the tx is inserted. The insert
+// then fails if the sender of the tx reached MaxTxsPerSender, or if the mempool
+// reached MaxTx or MaxBytes and no room could be made by evicting, if enabled,
+// lower priority txs.
 func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
-	if mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx {
-		return ErrMempoolTxMaxCapacity
-	} else if mp.cfg.MaxTx < 0 {
+	if mp.cfg.MaxTx < 0 {
 		return nil
 	}
 
@@ -226,9 +344,46 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 		return err
 	}
 
-	key := txMeta[C]{nonce: nonce, priority: priority, sender: sender}
+	size, now := txSizeAndBlockTime(ctx)
+
+	// Expired txs are evicted first so that the tx replaced by this insert, if
+	// any, is resolved against the remaining txs.
+	mp.evictExpired(now)
+
+	key := txMeta[C]{nonce: nonce, priority: priority, sender: sender, size: size, insertedAt: now}
+	sk := txMeta[C]{nonce: nonce, sender: sender}
+	oldScore, txExists := mp.scores[sk]
 
 	senderIndex, ok := mp.senderIndices[sender]
+	if !txExists && ok && mp.cfg.MaxTxsPerSender > 0 && senderIndex.Len() >= mp.cfg.MaxTxsPerSender {
+		emitRejectedTx(RejectionReasonMaxTxsPerSender)
+		return ErrMempoolSenderMaxCapacity
+	}
+
+	var (
+		oldTx        sdk.Tx
+		replacedSize int64
+	)
+	if txExists {
+		oldTx = senderIndex.Get(key).Value.(sdk.Tx)
+		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, oldTx, tx) {
+			return fmt.Errorf(
+				"%w, oldPriority: %v, newPriority: %v, oldTx: %v, newTx: %v",
+				ErrTxReplacementRejected,
+				oldScore.priority,
+				priority,
+				oldTx,
+				tx,
+			)
+		}
+
+		replacedSize = oldScore.size
+	}
+
+	if err := mp.makeRoom(key, !txExists, replacedSize); err != nil {
+		return err
+	}
+
 	if !ok {
 		senderIndex = skiplist.New(skiplist.LessThanFunc(func(a, b any) int {
 			return skiplist.Uint64.Compare(b.(txMeta[C]).nonce, a.(txMeta[C]).nonce)
@@ -245,39 +400,167 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	//
 	// This O(log n) remove operation is rare and only happens when a tx's priority
 	// changes.
-	sk := txMeta[C]{nonce: nonce, sender: sender}
-	if oldScore, txExists := mp.scores[sk]; txExists {
-		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, senderIndex.Get(key).Value.(sdk.Tx), tx) {
-			return fmt.Errorf(
-				"tx doesn't fit the replacement rule, oldPriority: %v, newPriority: %v, oldTx: %v, newTx: %v",
-				oldScore.priority,
-				priority,
-				senderIndex.Get(key).Value.(sdk.Tx),
-				tx,
-			)
-		}
-
+	if txExists {
 		mp.priorityIndex.Remove(txMeta[C]{
 			nonce:    nonce,
 			sender:   sender,
 			priority: oldScore.priority,
 			weight:   oldScore.weight,
 		})
+		mp.expiryIndex.Remove(expiryKey{insertedAt: oldScore.insertedAt, sender: sender, nonce: nonce})
 		mp.priorityCounts[oldScore.priority]--
+		mp.totalBytes -= oldScore.size
 	}
 
 	mp.priorityCounts[priority]++
+	mp.totalBytes += size
+	if mp.cfg.TxTTL > 0 {
+		mp.expiryIndex.Set(expiryKey{insertedAt: now, sender: sender, nonce: nonce}, nil)
+	}
 
 	// Since senderIndex is scored by nonce, a changed priority will overwrite the
 	// existing key.
 	key.senderElement = senderIndex.Set(key, tx)
 
-	mp.scores[sk] = txMeta[C]{priority: priority}
+	mp.scores[sk] = txMeta[C]{priority: priority, size: size, insertedAt: now}
 	mp.priorityIndex.Set(key, tx)
 
+	if txExists {
+		emitEvictedTx(EvictionReasonReplaced)
+		if mp.cfg.OnTxReplaced != nil {
+			mp.cfg.OnTxReplaced(oldTx, tx)
+		}
+		if mp.cfg.OnTxEvicted != nil {
+			mp.cfg.OnTxEvicted(oldTx)
+		}
+	}
+
+	return nil
+}
+
+// makeRoom ensures the mempool can hold the tx described by key. newTx is false
+// when the tx replaces an existing one of size replacedSize. If the mempool is
+// full and EvictLowestPriority is enabled, lower priority txs are evicted.
+func (mp *PriorityNonceMempool[C]) makeRoom(key txMeta[C], newTx bool, replacedSize int64) error {
+	txsFull := func() bool {
+		return newTx && mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx
+	}
+	excessBytes := func() int64 {
+		if mp.cfg.MaxBytes <= 0 {
+			return 0
+		}
+		return mp.totalBytes - replacedSize + key.size - mp.cfg.MaxBytes
+	}
+
+	txsNeeded := txsFull()
+	bytesNeeded := excessBytes()
+	if !txsNeeded && bytesNeeded <= 0 {
+		return nil
+	}
+
+	err, reason := ErrMempoolTxMaxCapacity, RejectionReasonMaxTx
+	if !txsNeeded {
+		err, reason = ErrMempoolTxMaxBytesCapacity, RejectionReasonMaxBytes
+	}
+
+	if !mp.cfg.EvictLowestPriority || (mp.cfg.MaxBytes > 0 && key.size > mp.cfg.MaxBytes) {
+		emitRejectedTx(reason)
+		return err
+	}
+
+	// Walk the priority index from the lowest priority up and collect the txs to
+	// evict. Only txs with a lower priority than the inserted tx and which are
+	// the last tx of their sender, by nonce, are candidates.
+	var (
+		victims []txMeta[C]
+		freed   int64
+	)
+	for e := mp.priorityIndex.Back(); e != nil && ((txsNeeded && len(victims) == 0) || freed < bytesNeeded); e = e.Prev() {
+		k := e.Key().(txMeta[C])
+		if mp.cfg.TxPriority.Compare(k.priority, key.priority) >= 0 {
+			break
+		}
+
+		if k.sender == key.sender {
+			continue
+		}
+
+		if last := mp.senderIndices[k.sender].Back(); last == nil || last.Key().(txMeta[C]).nonce != k.nonce {
+			continue
+		}
+
+		victims = append(victims, k)
+		freed += k.size
+	}
+
+	if (txsNeeded && len(victims) == 0) || freed < bytesNeeded {
+		emitRejectedTx(reason)
+		return err
+	}
+
+	for _, k := range victims {
+		mp.evictTx(k, EvictionReasonLowPriority)
+	}
+
 	return nil
 }
 
+// evictExpired removes all txs which outlived the configured TxTTL at block time
+// now, oldest first.
+func (mp *PriorityNonceMempool[C]) evictExpired(now time.Time) {
+	if mp.cfg.TxTTL <= 0 || now.IsZero() {
+		return
+	}
+
+	for {
+		ek, expired := nextExpired(mp.expiryIndex, now, mp.cfg.TxTTL)
+		if !expired {
+			return
+		}
+
+		score := mp.scores[txMeta[C]{nonce: ek.nonce, sender: ek.sender}]
+		mp.evictTx(txMeta[C]{
+			nonce:      ek.nonce,
+			priority:   score.priority,
+			sender:     ek.sender,
+			weight:     score.weight,
+			size:       score.size,
+			insertedAt: ek.insertedAt,
+		}, EvictionReasonExpired)
+	}
+}
+
+// evictTx removes the tx indexed by the given priority index key from the
+// mempool, reporting it as evicted for the given reason.
+func (mp *PriorityNonceMempool[C]) evictTx(tk txMeta[C], reason string) {
+	e := mp.priorityIndex.Get(tk)
+	mp.removeTx(tk)
+	emitEvictedTx(reason)
+	if e != nil && mp.cfg.OnTxEvicted != nil {
+		mp.cfg.OnTxEvicted(e.Value.(sdk.Tx))
+	}
+}
+
+// removeTx removes the tx indexed by the given priority index key from all the
+// mempool indices.
+func (mp *PriorityNonceMempool[C]) removeTx(tk txMeta[C]) {
+	mp.priorityIndex.Remove(tk)
+	mp.removeFromSenderIndex(mp.senderIndices[tk.sender], tk)
+	mp.expiryIndex.Remove(expiryKey{insertedAt: tk.insertedAt, sender: tk.sender, nonce: tk.nonce})
+	delete(mp.scores, txMeta[C]{nonce: tk.nonce, sender: tk.sender})
+	mp.priorityCounts[tk.priority]--
+	mp.totalBytes -= tk.size
+}
+
+// removeFromSenderIndex removes the tx indexed by the given key from the index
+// of its sender, and deletes the index once the sender has no tx left.
+func (mp *PriorityNonceMempool[C]) removeFromSenderIndex(senderIndex *skiplist.SkipList, tk txMeta[C]) {
+	senderIndex.Remove(tk)
+	if senderIndex.Len() == 0 {
+		delete(mp.senderIndices, tk.sender)
+	}
+}
+
 func (i *PriorityNonceIterator[C]) iteratePriority() Iterator {
 	// beginning of priority iteration
 	if i.priorityNode == nil {
@@ -310,8 +593,11 @@ func (i *PriorityNonceIterator[C]) Next() Iterator {
 
 	cursor, ok := i.senderCursors[i.sender]
 	if !ok {
-		// beginning of sender iteration
-		cursor = i.mempool.senderIndices[i.sender].Front()
+		// beginning of sender iteration, unless all the txs of the sender were
+		// removed since the iterator was created
+		if senderIndex, ok := i.mempool.senderIndices[i.sender]; ok {
+			cursor = senderIndex.Front()
+		}
 	} else {
 		// middle of sender iteration
 		cursor = cursor.Next()
@@ -478,9 +764,11 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	}
 
 	mp.priorityIndex.Remove(tk)
-	senderTxs.Remove(tk)
+	mp.removeFromSenderIndex(senderTxs, tk)
+	mp.expiryIndex.Remove(expiryKey{insertedAt: score.insertedAt, sender: sender, nonce: nonce})
 	delete(mp.scores, scoreKey)
 	mp.priorityCounts[score.priority]--
+	mp.totalBytes -= score.size
 
 	return nil
 }
@@ -1017,3 +1017,246 @@ func TestPriorityNonceMempool_UnorderedTx(t *testing.T) {
 		require.Equal(t, txs[i].id, tx.(testTx).id)
 	}
 }
+
+func TestPriorityNonceMempool_ReplaceByFee(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	var evicted []sdk.Tx
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:    mempool.NewDefaultTxPriority(),
+			TxReplacement: mempool.NewReplaceByFeeRule(10),
+			OnTxReplaced: func(oTx, _ sdk.Tx) {
+				evicted = append(evicted, oTx)
+			},
+			MaxTx:           2,
+			SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+		},
+	)
+
+	txs := []testTx{
+		{id: 0, priority: 100, nonce: 1, address: sa},
+		{id: 1, priority: 50, nonce: 1, address: sb},
+		{id: 2, priority: 109, nonce: 1, address: sa}, // bump below 10%
+		{id: 3, priority: 110, nonce: 1, address: sa}, // bump of 10%
+		{id: 4, priority: 200, nonce: 2, address: sb}, // new nonce, mempool is full
+	}
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(txs[0].priority), txs[0]))
+	require.NoError(t, mp.Insert(ctx.WithPriority(txs[1].priority), txs[1]))
+
+	err := mp.Insert(ctx.WithPriority(txs[2].priority), txs[2])
+	require.ErrorIs(t, err, mempool.ErrTxReplacementRejected)
+	require.Empty(t, evicted)
+
+	// a replacement is accepted even though the mempool is at capacity
+	require.NoError(t, mp.Insert(ctx.WithPriority(txs[3].priority), txs[3]))
+	require.Equal(t, []sdk.Tx{txs[0]}, evicted)
+	require.Equal(t, 2, mp.CountTx())
+
+	err = mp.Insert(ctx.WithPriority(txs[4].priority), txs[4])
+	require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+
+	orderedTxs := fetchTxs(mp.Select(ctx, nil), 1000)
+	require.Equal(t, []sdk.Tx{txs[3], txs[1]}, orderedTxs)
+}
+
+func TestNewReplaceByFeeRule(t *testing.T) {
+	rule := mempool.NewReplaceByFeeRule(10)
+
+	tests := []struct {
+		name     string
+		op, np   int64
+		expected bool
+	}{
+		{"lower priority", 100, 90, false},
+		{"same priority", 100, 100, false},
+		{"bump below threshold", 100, 109, false},
+		{"bump at threshold", 100, 110, true},
+		{"bump above threshold", 100, 200, true},
+		{"zero old priority", 0, 1, true},
+		{"negative old priority", -10, -5, true},
+		{"no overflow", math.MaxInt64 - 1, math.MaxInt64, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, rule(tt.op, tt.np, nil, nil))
+		})
+	}
+
+	require.True(t, mempool.NewReplaceByFeeRule(0)(100, 101, nil, nil))
+}
+
+func TestPriorityNonceMempool_Limits(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	now := time.Now()
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger()).WithBlockTime(now)
+	sa, sb, sc := accounts[0].Address, accounts[1].Address, accounts[2].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      mempool.NewDefaultTxPriority(),
+			MaxTxsPerSender: 2,
+			MaxBytes:        30,
+			TxTTL:           time.Minute,
+			SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+		},
+	)
+
+	insert := func(c sdk.Context, tx testTx, size int) error {
+		return mp.Insert(c.WithPriority(tx.priority).WithTxBytes(make([]byte, size)), tx)
+	}
+
+	// per sender cap
+	require.NoError(t, insert(ctx, testTx{priority: 10, nonce: 0, address: sa}, 10))
+	require.NoError(t, insert(ctx, testTx{priority: 10, nonce: 1, address: sa}, 10))
+	require.ErrorIs(t, insert(ctx, testTx{priority: 10, nonce: 2, address: sa}, 1), mempool.ErrMempoolSenderMaxCapacity)
+
+	// a replacement does not count against the per sender cap
+	require.NoError(t, insert(ctx, testTx{priority: 10, nonce: 1, address: sa}, 5))
+
+	// byte cap
+	require.ErrorIs(t, insert(ctx, testTx{priority: 10, nonce: 0, address: sb}, 20), mempool.ErrMempoolTxMaxBytesCapacity)
+	require.NoError(t, insert(ctx, testTx{priority: 10, nonce: 0, address: sb}, 15))
+	require.Equal(t, 3, mp.CountTx())
+
+	// a full mempool evicts expired txs to make room for a new tx
+	later := ctx.WithBlockTime(now.Add(2 * time.Minute))
+	require.NoError(t, insert(later, testTx{priority: 10, nonce: 0, address: sc}, 20))
+	require.Equal(t, 1, mp.CountTx())
+
+	// select is readonly and does not evict expired txs
+	require.Len(t, fetchTxs(mp.Select(ctx.WithBlockTime(now.Add(4*time.Minute)), nil), 1000), 1)
+	require.Equal(t, 1, mp.CountTx())
+
+	// expired txs are evicted on insert, even when the mempool is not full
+	require.NoError(t, insert(ctx.WithBlockTime(now.Add(4*time.Minute)), testTx{priority: 10, nonce: 0, address: sa}, 1))
+	require.Equal(t, 1, mp.CountTx())
+	require.NoError(t, mp.Remove(testTx{nonce: 0, address: sa}))
+	require.NoError(t, mempool.IsEmpty[int64](mp))
+}
+
+func TestPriorityNonceMempool_EvictExpiredStaggered(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	now := time.Now()
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger()).WithBlockTime(now)
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      mempool.NewDefaultTxPriority(),
+			TxTTL:           time.Minute,
+			SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+		},
+	)
+
+	// only the oldest tx expired by the last insert, while the newer ones are
+	// still pooled
+	for i, offset := range []time.Duration{0, 50 * time.Second, 90 * time.Second} {
+		tx := testTx{id: i, priority: 10, nonce: 0, address: accounts[i].Address}
+		require.NoError(t, mp.Insert(ctx.WithBlockTime(now.Add(offset)).WithPriority(tx.priority), tx))
+	}
+	require.Equal(t, 2, mp.CountTx())
+
+	// the second tx expires next
+	tx := testTx{id: 3, priority: 10, nonce: 1, address: accounts[2].Address}
+	require.NoError(t, mp.Insert(ctx.WithBlockTime(now.Add(111*time.Second)).WithPriority(tx.priority), tx))
+	require.Equal(t, 2, mp.CountTx())
+}
+
+func TestPriorityNonceMempool_Senders(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	mp := mempool.DefaultPriorityMempool()
+	txA := testTx{id: 0, priority: 10, nonce: 0, address: sa}
+	txB := testTx{id: 1, priority: 10, nonce: 0, address: sb}
+	require.NoError(t, mp.Insert(ctx.WithPriority(txA.priority), txA))
+	require.NoError(t, mp.Insert(ctx.WithPriority(txB.priority), txB))
+	require.ElementsMatch(t, []string{sa.String(), sb.String()}, mp.Senders())
+
+	// a sender whose only tx is removed is no longer listed
+	require.NoError(t, mp.Remove(txA))
+	require.Equal(t, []string{sb.String()}, mp.Senders())
+	require.Empty(t, mp.SenderTxs(sa.String()))
+
+	// a removed sender can insert again
+	require.NoError(t, mp.Insert(ctx.WithPriority(txA.priority), txA))
+	require.ElementsMatch(t, []string{sa.String(), sb.String()}, mp.Senders())
+}
+
+func TestPriorityNonceMempool_ReplaceExpiredTx(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	now := time.Now()
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger()).WithBlockTime(now)
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	var replaced int
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      mempool.NewDefaultTxPriority(),
+			MaxBytes:        10,
+			TxTTL:           time.Minute,
+			OnTxReplaced:    func(_, _ sdk.Tx) { replaced++ },
+			SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+		},
+	)
+
+	insert := func(c sdk.Context, tx testTx, size int) error {
+		return mp.Insert(c.WithPriority(tx.priority).WithTxBytes(make([]byte, size)), tx)
+	}
+
+	require.NoError(t, insert(ctx, testTx{id: 0, priority: 10, nonce: 0, address: sa}, 5))
+	require.NoError(t, insert(ctx, testTx{id: 1, priority: 10, nonce: 0, address: sb}, 5))
+
+	// the tx being replaced expired, the insert is handled as a new tx
+	later := ctx.WithBlockTime(now.Add(2 * time.Minute))
+	tx := testTx{id: 2, priority: 20, nonce: 0, address: sa}
+	require.NoError(t, insert(later, tx, 6))
+	require.Equal(t, 0, replaced)
+	require.Equal(t, 1, mp.CountTx())
+	require.Equal(t, []sdk.Tx{tx}, fetchTxs(mp.Select(later, nil), 1000))
+
+	require.NoError(t, mp.Remove(tx))
+	require.NoError(t, mempool.IsEmpty[int64](mp))
+}
+
+func TestPriorityNonceMempool_EvictLowestPriority(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
+	sa, sb, sc := accounts[0].Address, accounts[1].Address, accounts[2].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:          mempool.NewDefaultTxPriority(),
+			MaxTx:               3,
+			EvictLowestPriority: true,
+			SignerExtractor:     mempool.NewDefaultSignerExtractionAdapter(),
+		},
+	)
+
+	txs := []testTx{
+		{id: 0, priority: 5, nonce: 0, address: sa},
+		{id: 1, priority: 10, nonce: 1, address: sa},
+		{id: 2, priority: 20, nonce: 0, address: sb},
+		{id: 3, priority: 1, nonce: 0, address: sc},  // lower than every tx in the pool
+		{id: 4, priority: 15, nonce: 0, address: sc}, // evicts tx 1, as tx 0 is not the last tx of sa
+		{id: 5, priority: 6, nonce: 1, address: sc},  // evicts tx 0, now the last tx of sa
+	}
+	for _, tx := range txs[:3] {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(txs[3].priority), txs[3]), mempool.ErrMempoolTxMaxCapacity)
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(txs[4].priority), txs[4]))
+	require.Equal(t, 3, mp.CountTx())
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(txs[5].priority), txs[5]))
+	require.Equal(t, 3, mp.CountTx())
+
+	orderedTxs := fetchTxs(mp.Select(ctx, nil), 1000)
+	require.Equal(t, []sdk.Tx{txs[2], txs[4], txs[5]}, orderedTxs)
+}
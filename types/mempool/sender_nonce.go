@@ -8,6 +8,7 @@ import (
 	"math/rand" // #nosec // math/rand is used for random selection and seeded from crypto/rand
 	"slices"
 	"sync"
+	"time"
 
 	"github.com/huandu/skiplist"
 
@@ -16,8 +17,10 @@ import (
 )
 
 var (
-	_ ExtMempool = (*SenderNonceMempool)(nil)
-	_ Iterator   = (*senderNonceMempoolIterator)(nil)
+	_ ExtMempool         = (*SenderNonceMempool)(nil)
+	_ InspectableMempool = (*SenderNonceMempool)(nil)
+	_ EvictingMempool    = (*SenderNonceMempool)(nil)
+	_ Iterator           = (*senderNonceMempoolIterator)(nil)
 )
 
 var DefaultMaxTx = -1
@@ -33,11 +36,17 @@ var DefaultMaxTx = -1
 // Note that PrepareProposal could choose to stop iteration before reaching the
 // end if maxBytes is reached.
 type SenderNonceMempool struct {
-	mtx        sync.Mutex
-	senders    map[string]*skiplist.SkipList
-	rnd        *rand.Rand
-	maxTx      int
-	existingTx map[txKey]bool
+	mtx             sync.Mutex
+	senders         map[string]*skiplist.SkipList
+	rnd             *rand.Rand
+	maxTx           int
+	maxTxsPerSender int
+	maxBytes        int64
+	txTTL           time.Duration
+	totalBytes      int64
+	existingTx      map[txKey]txInfo
+	expiryIndex     *skiplist.SkipList
+	onTxEvicted     func(tx sdk.Tx)
 }
 
 type SenderNonceOptions func(*SenderNonceMempool)
@@ -47,15 +56,21 @@ type txKey struct {
 	nonce   uint64
 }
 
+type txInfo struct {
+	size       int64
+	insertedAt time.Time
+}
+
 // NewSenderNonceMempool creates a new mempool that prioritizes transactions by
 // nonce, the lowest first, picking a random sender on each iteration.
 func NewSenderNonceMempool(opts ...SenderNonceOptions) *SenderNonceMempool {
 	senderMap := make(map[string]*skiplist.SkipList)
-	existingTx := make(map[txKey]bool)
+	existingTx := make(map[txKey]txInfo)
 	snp := &SenderNonceMempool{
-		senders:    senderMap,
-		maxTx:      DefaultMaxTx,
-		existingTx: existingTx,
+		senders:     senderMap,
+		maxTx:       DefaultMaxTx,
+		existingTx:  existingTx,
+		expiryIndex: newExpiryIndex(),
 	}
 
 	var seed int64
@@ -98,6 +113,60 @@ func SenderNonceMaxTxOpt(maxTx int) SenderNonceOptions {
 	}
 }
 
+// SenderNonceMaxTxsPerSenderOpt Option To set the maximum number of txs a
+// single sender may have in the mempool when calling the constructor
+// NewSenderNonceMempool. Zero means no cap.
+//
+// Example:
+//
+//	NewSenderNonceMempool(SenderNonceMaxTxsPerSenderOpt(16))
+func SenderNonceMaxTxsPerSenderOpt(maxTxsPerSender int) SenderNonceOptions {
+	return func(snp *SenderNonceMempool) {
+		snp.maxTxsPerSender = maxTxsPerSender
+	}
+}
+
+// SenderNonceMaxBytesOpt Option To set the maximum total size in bytes of the
+// txs in the mempool when calling the constructor NewSenderNonceMempool. The
+// size of a tx is the length of the tx bytes set on the insert context. Zero
+// means no cap.
+//
+// Example:
+//
+//	NewSenderNonceMempool(SenderNonceMaxBytesOpt(64 << 20))
+func SenderNonceMaxBytesOpt(maxBytes int64) SenderNonceOptions {
+	return func(snp *SenderNonceMempool) {
+		snp.maxBytes = maxBytes
+	}
+}
+
+// SenderNonceTxTTLOpt Option To set for how long, measured in block time, a tx
+// may stay in the mempool when calling the constructor NewSenderNonceMempool.
+// Expired txs are evicted on Insert, before the tx is inserted. Zero means txs
+// never expire.
+//
+// Example:
+//
+//	NewSenderNonceMempool(SenderNonceTxTTLOpt(10 * time.Minute))
+func SenderNonceTxTTLOpt(ttl time.Duration) SenderNonceOptions {
+	return func(snp *SenderNonceMempool) {
+		snp.txTTL = ttl
+	}
+}
+
+// SenderNonceOnTxEvictedOpt Option To set a callback called with every tx the
+// mempool drops on its own, i.e. expired and replaced txs, when calling the
+// constructor NewSenderNonceMempool.
+//
+// Example:
+//
+//	NewSenderNonceMempool(SenderNonceOnTxEvictedOpt(func(tx sdk.Tx) { ... }))
+func SenderNonceOnTxEvictedOpt(onTxEvicted func(tx sdk.Tx)) SenderNonceOptions {
+	return func(snp *SenderNonceMempool) {
+		snp.onTxEvicted = onTxEvicted
+	}
+}
+
 func (snm *SenderNonceMempool) setSeed(seed int64) {
 	s1 := rand.NewSource(seed)
 	snm.rnd = rand.New(s1) //#nosec // math/rand is seeded from crypto/rand by default
@@ -116,14 +185,58 @@ func (snm *SenderNonceMempool) NextSenderTx(sender string) sdk.Tx {
 	return cursor.Value.(sdk.Tx)
 }
 
-// Insert adds a tx to the mempool. It returns an error if the tx does not have
-// at least one signer. Note, priority is ignored.
-func (snm *SenderNonceMempool) Insert(_ context.Context, tx sdk.Tx) error {
+// SetOnTxEvicted implements EvictingMempool.
+func (snm *SenderNonceMempool) SetOnTxEvicted(onTxEvicted func(tx sdk.Tx)) {
+	snm.mtx.Lock()
+	defer snm.mtx.Unlock()
+
+	snm.onTxEvicted = onTxEvicted
+}
+
+// Senders returns the senders having transactions in the mempool, sorted.
+func (snm *SenderNonceMempool) Senders() []string {
 	snm.mtx.Lock()
 	defer snm.mtx.Unlock()
-	if snm.maxTx > 0 && len(snm.existingTx) >= snm.maxTx {
-		return ErrMempoolTxMaxCapacity
+
+	senders := make([]string, 0, len(snm.senders))
+	for sender := range snm.senders {
+		senders = append(senders, sender)
 	}
+	slices.Sort(senders)
+
+	return senders
+}
+
+// SenderTxs returns the transactions of the given sender ordered by nonce. As
+// the mempool does not prioritize transactions, their priority is left empty.
+func (snm *SenderNonceMempool) SenderTxs(sender string) []SenderTx {
+	snm.mtx.Lock()
+	defer snm.mtx.Unlock()
+
+	senderIndex, ok := snm.senders[sender]
+	if !ok {
+		return nil
+	}
+
+	txs := make([]SenderTx, 0, senderIndex.Len())
+	for e := senderIndex.Front(); e != nil; e = e.Next() {
+		txs = append(txs, SenderTx{
+			Tx:    e.Value.(sdk.Tx),
+			Nonce: e.Key().(uint64),
+		})
+	}
+
+	return txs
+}
+
+// Insert adds a tx to the mempool, evicting the txs which outlived the
+// configured TTL beforehand. It returns an error if the tx does not have at
+// least one signer, if its sender reached the maximum number of txs per sender
+// or if the mempool is full. Note, priority is ignored, hence a full mempool
+// never evicts txs in favor of a new one.
+func (snm *SenderNonceMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	snm.mtx.Lock()
+	defer snm.mtx.Unlock()
 	if snm.maxTx < 0 {
 		return nil
 	}
@@ -143,20 +256,116 @@ func (snm *SenderNonceMempool) Insert(_ context.Context, tx sdk.Tx) error {
 		return err
 	}
 
+	size, now := txSizeAndBlockTime(ctx)
+
+	// Expired txs are evicted first so that the tx replaced by this insert, if
+	// any, is resolved against the remaining txs.
+	snm.evictExpired(now)
+
+	key := txKey{nonce: nonce, address: sender}
+	oldInfo, exists := snm.existingTx[key]
+
 	senderTxs, found := snm.senders[sender]
+	if !exists && found && snm.maxTxsPerSender > 0 && senderTxs.Len() >= snm.maxTxsPerSender {
+		emitRejectedTx(RejectionReasonMaxTxsPerSender)
+		return ErrMempoolSenderMaxCapacity
+	}
+
+	if reason, err := snm.checkCapacity(!exists, size-oldInfo.size); err != nil {
+		emitRejectedTx(reason)
+		return err
+	}
+
 	if !found {
 		senderTxs = skiplist.New(skiplist.Uint64)
 		snm.senders[sender] = senderTxs
 	}
 
+	var oldTx sdk.Tx
+	if exists {
+		oldTx = senderTxs.Get(nonce).Value.(sdk.Tx)
+	}
 	senderTxs.Set(nonce, tx)
 
-	key := txKey{nonce: nonce, address: sender}
-	snm.existingTx[key] = true
+	if exists {
+		snm.expiryIndex.Remove(expiryKey{insertedAt: oldInfo.insertedAt, sender: sender, nonce: nonce})
+		snm.totalBytes -= oldInfo.size
+		emitEvictedTx(EvictionReasonReplaced)
+		if snm.onTxEvicted != nil {
+			snm.onTxEvicted(oldTx)
+		}
+	}
+	snm.existingTx[key] = txInfo{size: size, insertedAt: now}
+	snm.totalBytes += size
+	if snm.txTTL > 0 {
+		snm.expiryIndex.Set(expiryKey{insertedAt: now, sender: sender, nonce: nonce}, nil)
+	}
 
 	return nil
 }
 
+// checkCapacity returns an error, along with the rejection reason, if the
+// mempool cannot hold a tx growing its size by sizeDelta bytes and, if newTx is
+// set, its count by one.
+func (snm *SenderNonceMempool) checkCapacity(newTx bool, sizeDelta int64) (string, error) {
+	if newTx && snm.maxTx > 0 && len(snm.existingTx) >= snm.maxTx {
+		return RejectionReasonMaxTx, ErrMempoolTxMaxCapacity
+	}
+	if snm.maxBytes > 0 && snm.totalBytes+sizeDelta > snm.maxBytes {
+		return RejectionReasonMaxBytes, ErrMempoolTxMaxBytesCapacity
+	}
+
+	return "", nil
+}
+
+// evictExpired removes all txs which outlived the configured TTL at block time
+// now, oldest first.
+func (snm *SenderNonceMempool) evictExpired(now time.Time) {
+	if snm.txTTL <= 0 || now.IsZero() {
+		return
+	}
+
+	for {
+		ek, expired := nextExpired(snm.expiryIndex, now, snm.txTTL)
+		if !expired {
+			return
+		}
+
+		key := txKey{nonce: ek.nonce, address: ek.sender}
+		e := snm.senders[key.address].Get(key.nonce)
+		snm.removeTx(key)
+		emitEvictedTx(EvictionReasonExpired)
+		if e != nil && snm.onTxEvicted != nil {
+			snm.onTxEvicted(e.Value.(sdk.Tx))
+		}
+	}
+}
+
+// removeTx removes the tx identified by key from the mempool, returning false
+// if it was not found.
+func (snm *SenderNonceMempool) removeTx(key txKey) bool {
+	senderTxs, found := snm.senders[key.address]
+	if !found {
+		return false
+	}
+
+	res := senderTxs.Remove(key.nonce)
+	if res == nil {
+		return false
+	}
+
+	if senderTxs.Len() == 0 {
+		delete(snm.senders, key.address)
+	}
+
+	info := snm.existingTx[key]
+	snm.expiryIndex.Remove(expiryKey{insertedAt: info.insertedAt, sender: key.address, nonce: key.nonce})
+	snm.totalBytes -= info.size
+	delete(snm.existingTx, key)
+
+	return true
+}
+
 // Select returns an iterator ordering transactions the mempool with the lowest
 // nonce of a random selected sender first.
 //
@@ -234,23 +443,10 @@ func (snm *SenderNonceMempool) Remove(tx sdk.Tx) error {
 		return err
 	}
 
-	senderTxs, found := snm.senders[sender]
-	if !found {
-		return ErrTxNotFound
-	}
-
-	res := senderTxs.Remove(nonce)
-	if res == nil {
+	if !snm.removeTx(txKey{nonce: nonce, address: sender}) {
 		return ErrTxNotFound
 	}
 
-	if senderTxs.Len() == 0 {
-		delete(snm.senders, sender)
-	}
-
-	key := txKey{nonce: nonce, address: sender}
-	delete(snm.existingTx, key)
-
 	return nil
 }
 
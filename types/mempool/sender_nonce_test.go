@@ -170,6 +170,78 @@ func (s *MempoolTestSuite) TestMaxTx() {
 	require.Equal(t, mempool.ErrMempoolTxMaxCapacity, err)
 }
 
+func (s *MempoolTestSuite) TestSenderNonceLimits() {
+	t := s.T()
+	now := time.Now()
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger()).WithBlockTime(now)
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	sa, sb, sc := accounts[0].Address, accounts[1].Address, accounts[2].Address
+
+	mp := mempool.NewSenderNonceMempool(
+		mempool.SenderNonceMaxTxOpt(0),
+		mempool.SenderNonceMaxTxsPerSenderOpt(2),
+		mempool.SenderNonceMaxBytesOpt(30),
+		mempool.SenderNonceTxTTLOpt(time.Minute),
+	)
+
+	// per sender cap
+	require.NoError(t, mp.Insert(ctx.WithTxBytes(make([]byte, 10)), testTx{nonce: 0, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithTxBytes(make([]byte, 10)), testTx{nonce: 1, address: sa}))
+	err := mp.Insert(ctx.WithTxBytes(make([]byte, 1)), testTx{nonce: 2, address: sa})
+	require.ErrorIs(t, err, mempool.ErrMempoolSenderMaxCapacity)
+
+	// a replacement does not count against the per sender cap
+	require.NoError(t, mp.Insert(ctx.WithTxBytes(make([]byte, 5)), testTx{nonce: 1, address: sa}))
+
+	// byte cap
+	err = mp.Insert(ctx.WithTxBytes(make([]byte, 20)), testTx{nonce: 0, address: sb})
+	require.ErrorIs(t, err, mempool.ErrMempoolTxMaxBytesCapacity)
+	require.NoError(t, mp.Insert(ctx.WithTxBytes(make([]byte, 15)), testTx{nonce: 0, address: sb}))
+	require.Equal(t, 3, mp.CountTx())
+
+	// a full mempool evicts expired txs to make room for a new tx
+	later := ctx.WithBlockTime(now.Add(2 * time.Minute))
+	require.NoError(t, mp.Insert(later.WithTxBytes(make([]byte, 20)), testTx{nonce: 0, address: sc}))
+	require.Equal(t, 1, mp.CountTx())
+
+	// select is readonly and does not evict expired txs
+	require.NotNil(t, mp.Select(ctx.WithBlockTime(now.Add(4*time.Minute)), nil))
+	require.Equal(t, 1, mp.CountTx())
+
+	// expired txs are evicted on insert, even when the mempool is not full
+	require.NoError(t, mp.Insert(ctx.WithBlockTime(now.Add(4*time.Minute)).WithTxBytes(make([]byte, 1)), testTx{nonce: 0, address: sa}))
+	require.Equal(t, 1, mp.CountTx())
+
+	// a replaced tx which expired is evicted before the insert, which is then
+	// handled as a new tx
+	require.NoError(t, mp.Insert(ctx.WithBlockTime(now.Add(4*time.Minute)).WithTxBytes(make([]byte, 29)), testTx{nonce: 0, address: sb}))
+	require.NoError(t, mp.Insert(ctx.WithBlockTime(now.Add(6*time.Minute)).WithTxBytes(make([]byte, 30)), testTx{nonce: 0, address: sb}))
+	require.Equal(t, 1, mp.CountTx())
+}
+
+func (s *MempoolTestSuite) TestSenderNonceEvictExpiredStaggered() {
+	t := s.T()
+	now := time.Now()
+	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger()).WithBlockTime(now)
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+
+	mp := mempool.NewSenderNonceMempool(
+		mempool.SenderNonceMaxTxOpt(0),
+		mempool.SenderNonceTxTTLOpt(time.Minute),
+	)
+
+	// only the oldest tx expired by the last insert, while the newer ones are
+	// still pooled
+	for i, offset := range []time.Duration{0, 50 * time.Second, 90 * time.Second} {
+		require.NoError(t, mp.Insert(ctx.WithBlockTime(now.Add(offset)), testTx{nonce: 0, address: accounts[i].Address}))
+	}
+	require.Equal(t, 2, mp.CountTx())
+
+	// the second tx expires next
+	require.NoError(t, mp.Insert(ctx.WithBlockTime(now.Add(111*time.Second)), testTx{nonce: 1, address: accounts[2].Address}))
+	require.Equal(t, 2, mp.CountTx())
+}
+
 func (s *MempoolTestSuite) TestTxRejectedWithUnorderedAndSequence() {
 	t := s.T()
 	ctx := sdk.NewContext(nil, cmtproto.Header{}, false, log.NewNopLogger())
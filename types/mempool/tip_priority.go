@@ -0,0 +1,55 @@
+package mempool
+
+import (
+	"context"
+	"math"
+
+	"github.com/huandu/skiplist"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BaseGasPriceFn returns the base gas price a transaction must pay, e.g. the
+// x/auth fee market base gas price.
+type BaseGasPriceFn func(ctx context.Context) (sdk.DecCoin, error)
+
+// NewEffectiveTipTxPriority returns a TxPriority comparator using the effective
+// tip of a transaction as the defining transaction priority. The effective tip
+// is the gas price paid in the base gas price denom above the base gas price,
+// truncated to an integer. Transactions which do not pay more than the base gas
+// price, or whose base gas price cannot be retrieved, have a zero priority.
+func NewEffectiveTipTxPriority(baseGasPrice BaseGasPriceFn) TxPriority[int64] {
+	return TxPriority[int64]{
+		GetTxPriority: func(ctx context.Context, tx sdk.Tx) int64 {
+			feeTx, ok := tx.(sdk.FeeTx)
+			if !ok || feeTx.GetGas() == 0 {
+				return 0
+			}
+
+			base, err := baseGasPrice(ctx)
+			if err != nil || base.Amount.IsNil() {
+				return 0
+			}
+
+			paid := feeTx.GetFee().AmountOf(base.Denom)
+			gasPrice := sdkmath.LegacyNewDecFromInt(paid).QuoInt(sdkmath.NewIntFromUint64(feeTx.GetGas()))
+
+			tip := gasPrice.Sub(base.Amount).TruncateInt()
+			if !tip.IsPositive() {
+				return 0
+			}
+
+			if !tip.IsInt64() {
+				return math.MaxInt64
+			}
+
+			return tip.Int64()
+		},
+		Compare: func(a, b int64) int {
+			return skiplist.Int64.Compare(a, b)
+		},
+		MinValue: math.MinInt64,
+	}
+}
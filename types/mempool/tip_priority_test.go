@@ -0,0 +1,65 @@
+package mempool_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+type feeTx struct {
+	gas uint64
+	fee sdk.Coins
+}
+
+func (tx feeTx) GetMsgs() []sdk.Msg { return nil }
+
+func (tx feeTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+func (tx feeTx) GetGas() uint64 { return tx.gas }
+
+func (tx feeTx) GetFee() sdk.Coins { return tx.fee }
+
+func (tx feeTx) FeePayer() []byte { return nil }
+
+func (tx feeTx) FeeGranter() []byte { return nil }
+
+func TestEffectiveTipTxPriority(t *testing.T) {
+	base := sdk.NewDecCoinFromDec("stake", sdkmath.LegacyNewDec(2))
+	txPriority := mempool.NewEffectiveTipTxPriority(func(context.Context) (sdk.DecCoin, error) {
+		return base, nil
+	})
+
+	tests := []struct {
+		name     string
+		tx       sdk.Tx
+		expected int64
+	}{
+		{"not a fee tx", testTx{}, 0},
+		{"zero gas", feeTx{gas: 0, fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))}, 0},
+		{"below base gas price", feeTx{gas: 100, fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))}, 0},
+		{"at base gas price", feeTx{gas: 100, fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 200))}, 0},
+		{"other denom", feeTx{gas: 100, fee: sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))}, 0},
+		{"tip", feeTx{gas: 100, fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 750))}, 5},
+		{"overflow", feeTx{gas: 1, fee: sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewIntFromUint64(math.MaxUint64)))}, math.MaxInt64},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, txPriority.GetTxPriority(context.Background(), tt.tx))
+		})
+	}
+
+	failing := mempool.NewEffectiveTipTxPriority(func(context.Context) (sdk.DecCoin, error) {
+		return sdk.DecCoin{}, errors.New("unavailable")
+	})
+	tx := feeTx{gas: 100, fee: sdk.NewCoins(sdk.NewInt64Coin("stake", 750))}
+	require.Equal(t, int64(0), failing.GetTxPriority(context.Background(), tx))
+}
@@ -5,11 +5,14 @@ package tx
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	v2 "github.com/cometbft/cometbft/api/cometbft/types/v2"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -390,6 +393,8 @@ type SimulateRequest struct {
 	Tx *Tx `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"` // Deprecated: Do not use.
 	// tx_bytes is the raw transaction.
 	TxBytes []byte `protobuf:"bytes,2,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// state_diff requests the state changes the transaction would make.
+	StateDiff bool `protobuf:"varint,3,opt,name=state_diff,json=stateDiff,proto3" json:"state_diff,omitempty"`
 }
 
 func (m *SimulateRequest) Reset()         { *m = SimulateRequest{} }
@@ -440,6 +445,13 @@ func (m *SimulateRequest) GetTxBytes() []byte {
 	return nil
 }
 
+func (m *SimulateRequest) GetStateDiff() bool {
+	if m != nil {
+		return m.StateDiff
+	}
+	return false
+}
+
 // SimulateResponse is the response type for the
 // Service.SimulateRPC method.
 type SimulateResponse struct {
@@ -447,6 +459,9 @@ type SimulateResponse struct {
 	GasInfo *types.GasInfo `protobuf:"bytes,1,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
 	// result is the result of the simulation.
 	Result *types.Result `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// state_diff is the state changes the transaction would make. It is only set
+	// if requested.
+	StateDiff *StateDiff `protobuf:"bytes,3,opt,name=state_diff,json=stateDiff,proto3" json:"state_diff,omitempty"`
 }
 
 func (m *SimulateResponse) Reset()         { *m = SimulateResponse{} }
@@ -496,6 +511,271 @@ func (m *SimulateResponse) GetResult() *types.Result {
 	return nil
 }
 
+func (m *SimulateResponse) GetStateDiff() *StateDiff {
+	if m != nil {
+		return m.StateDiff
+	}
+	return nil
+}
+
+// StateDiff defines the state changes a simulated transaction would make.
+type StateDiff struct {
+	// stores are the writes to the KV stores, sorted by store name.
+	Stores []StoreDiff `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores"`
+	// balances are the coins sent and received per address, sorted by address,
+	// as reported by the coin_spent and coin_received events.
+	Balances []BalanceDelta `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances"`
+}
+
+func (m *StateDiff) Reset()         { *m = StateDiff{} }
+func (m *StateDiff) String() string { return proto.CompactTextString(m) }
+func (*StateDiff) ProtoMessage()    {}
+func (*StateDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{6}
+}
+func (m *StateDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateDiff.Merge(m, src)
+}
+func (m *StateDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateDiff proto.InternalMessageInfo
+
+func (m *StateDiff) GetStores() []StoreDiff {
+	if m != nil {
+		return m.Stores
+	}
+	return nil
+}
+
+func (m *StateDiff) GetBalances() []BalanceDelta {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+// StoreDiff defines the writes to a KV store.
+type StoreDiff struct {
+	// store_name is the name of the KV store.
+	StoreName string `protobuf:"bytes,1,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	// writes are the final writes to the store, sorted by key.
+	Writes []KVWrite `protobuf:"bytes,2,rep,name=writes,proto3" json:"writes"`
+}
+
+func (m *StoreDiff) Reset()         { *m = StoreDiff{} }
+func (m *StoreDiff) String() string { return proto.CompactTextString(m) }
+func (*StoreDiff) ProtoMessage()    {}
+func (*StoreDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{7}
+}
+func (m *StoreDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoreDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoreDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoreDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoreDiff.Merge(m, src)
+}
+func (m *StoreDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoreDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoreDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoreDiff proto.InternalMessageInfo
+
+func (m *StoreDiff) GetStoreName() string {
+	if m != nil {
+		return m.StoreName
+	}
+	return ""
+}
+
+func (m *StoreDiff) GetWrites() []KVWrite {
+	if m != nil {
+		return m.Writes
+	}
+	return nil
+}
+
+// KVWrite defines a write to a KV store.
+type KVWrite struct {
+	// key is the written key.
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the written value, empty if the key is deleted.
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// delete is true if the key is deleted.
+	Delete bool `protobuf:"varint,3,opt,name=delete,proto3" json:"delete,omitempty"`
+	// collection is the name of the collection the key belongs to, if the store
+	// schema is known.
+	Collection string `protobuf:"bytes,4,opt,name=collection,proto3" json:"collection,omitempty"`
+	// decoded_value is the human-readable value, if the store schema is known.
+	DecodedValue string `protobuf:"bytes,5,opt,name=decoded_value,json=decodedValue,proto3" json:"decoded_value,omitempty"`
+}
+
+func (m *KVWrite) Reset()         { *m = KVWrite{} }
+func (m *KVWrite) String() string { return proto.CompactTextString(m) }
+func (*KVWrite) ProtoMessage()    {}
+func (*KVWrite) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{8}
+}
+func (m *KVWrite) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KVWrite) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KVWrite.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KVWrite) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KVWrite.Merge(m, src)
+}
+func (m *KVWrite) XXX_Size() int {
+	return m.Size()
+}
+func (m *KVWrite) XXX_DiscardUnknown() {
+	xxx_messageInfo_KVWrite.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KVWrite proto.InternalMessageInfo
+
+func (m *KVWrite) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *KVWrite) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *KVWrite) GetDelete() bool {
+	if m != nil {
+		return m.Delete
+	}
+	return false
+}
+
+func (m *KVWrite) GetCollection() string {
+	if m != nil {
+		return m.Collection
+	}
+	return ""
+}
+
+func (m *KVWrite) GetDecodedValue() string {
+	if m != nil {
+		return m.DecodedValue
+	}
+	return ""
+}
+
+// BalanceDelta defines the coins sent and received by an address.
+type BalanceDelta struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// sent are the coins sent by the account.
+	Sent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=sent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"sent"`
+	// received are the coins received by the account.
+	Received github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=received,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"received"`
+}
+
+func (m *BalanceDelta) Reset()         { *m = BalanceDelta{} }
+func (m *BalanceDelta) String() string { return proto.CompactTextString(m) }
+func (*BalanceDelta) ProtoMessage()    {}
+func (*BalanceDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{9}
+}
+func (m *BalanceDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BalanceDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceDelta.Merge(m, src)
+}
+func (m *BalanceDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceDelta proto.InternalMessageInfo
+
+func (m *BalanceDelta) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BalanceDelta) GetSent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Sent
+	}
+	return nil
+}
+
+func (m *BalanceDelta) GetReceived() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Received
+	}
+	return nil
+}
+
 // GetTxRequest is the request type for the Service.GetTx
 // RPC method.
 type GetTxRequest struct {
@@ -507,7 +787,7 @@ func (m *GetTxRequest) Reset()         { *m = GetTxRequest{} }
 func (m *GetTxRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTxRequest) ProtoMessage()    {}
 func (*GetTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{6}
+	return fileDescriptor_e0b00a618705eca7, []int{10}
 }
 func (m *GetTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,7 +835,7 @@ func (m *GetTxResponse) Reset()         { *m = GetTxResponse{} }
 func (m *GetTxResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTxResponse) ProtoMessage()    {}
 func (*GetTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{7}
+	return fileDescriptor_e0b00a618705eca7, []int{11}
 }
 func (m *GetTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,7 +891,7 @@ func (m *GetBlockWithTxsRequest) Reset()         { *m = GetBlockWithTxsRequest{}
 func (m *GetBlockWithTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsRequest) ProtoMessage()    {}
 func (*GetBlockWithTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{8}
+	return fileDescriptor_e0b00a618705eca7, []int{12}
 }
 func (m *GetBlockWithTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -669,7 +949,7 @@ func (m *GetBlockWithTxsResponse) Reset()         { *m = GetBlockWithTxsResponse
 func (m *GetBlockWithTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsResponse) ProtoMessage()    {}
 func (*GetBlockWithTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{9}
+	return fileDescriptor_e0b00a618705eca7, []int{13}
 }
 func (m *GetBlockWithTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -737,7 +1017,7 @@ func (m *TxDecodeRequest) Reset()         { *m = TxDecodeRequest{} }
 func (m *TxDecodeRequest) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeRequest) ProtoMessage()    {}
 func (*TxDecodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{10}
+	return fileDescriptor_e0b00a618705eca7, []int{14}
 }
 func (m *TxDecodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -784,7 +1064,7 @@ func (m *TxDecodeResponse) Reset()         { *m = TxDecodeResponse{} }
 func (m *TxDecodeResponse) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeResponse) ProtoMessage()    {}
 func (*TxDecodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{11}
+	return fileDescriptor_e0b00a618705eca7, []int{15}
 }
 func (m *TxDecodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -831,7 +1111,7 @@ func (m *TxEncodeRequest) Reset()         { *m = TxEncodeRequest{} }
 func (m *TxEncodeRequest) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeRequest) ProtoMessage()    {}
 func (*TxEncodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{12}
+	return fileDescriptor_e0b00a618705eca7, []int{16}
 }
 func (m *TxEncodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -878,7 +1158,7 @@ func (m *TxEncodeResponse) Reset()         { *m = TxEncodeResponse{} }
 func (m *TxEncodeResponse) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeResponse) ProtoMessage()    {}
 func (*TxEncodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{13}
+	return fileDescriptor_e0b00a618705eca7, []int{17}
 }
 func (m *TxEncodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +1204,7 @@ func (m *TxEncodeAminoRequest) Reset()         { *m = TxEncodeAminoRequest{} }
 func (m *TxEncodeAminoRequest) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeAminoRequest) ProtoMessage()    {}
 func (*TxEncodeAminoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{14}
+	return fileDescriptor_e0b00a618705eca7, []int{18}
 }
 func (m *TxEncodeAminoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -970,7 +1250,7 @@ func (m *TxEncodeAminoResponse) Reset()         { *m = TxEncodeAminoResponse{} }
 func (m *TxEncodeAminoResponse) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeAminoResponse) ProtoMessage()    {}
 func (*TxEncodeAminoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{15}
+	return fileDescriptor_e0b00a618705eca7, []int{19}
 }
 func (m *TxEncodeAminoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1016,7 +1296,7 @@ func (m *TxDecodeAminoRequest) Reset()         { *m = TxDecodeAminoRequest{} }
 func (m *TxDecodeAminoRequest) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeAminoRequest) ProtoMessage()    {}
 func (*TxDecodeAminoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{16}
+	return fileDescriptor_e0b00a618705eca7, []int{20}
 }
 func (m *TxDecodeAminoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1062,7 +1342,7 @@ func (m *TxDecodeAminoResponse) Reset()         { *m = TxDecodeAminoResponse{} }
 func (m *TxDecodeAminoResponse) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeAminoResponse) ProtoMessage()    {}
 func (*TxDecodeAminoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{17}
+	return fileDescriptor_e0b00a618705eca7, []int{21}
 }
 func (m *TxDecodeAminoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1098,161 +1378,369 @@ func (m *TxDecodeAminoResponse) GetAminoJson() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("cosmos.tx.v1beta1.OrderBy", OrderBy_name, OrderBy_value)
-	proto.RegisterEnum("cosmos.tx.v1beta1.BroadcastMode", BroadcastMode_name, BroadcastMode_value)
-	proto.RegisterType((*GetTxsEventRequest)(nil), "cosmos.tx.v1beta1.GetTxsEventRequest")
-	proto.RegisterType((*GetTxsEventResponse)(nil), "cosmos.tx.v1beta1.GetTxsEventResponse")
-	proto.RegisterType((*BroadcastTxRequest)(nil), "cosmos.tx.v1beta1.BroadcastTxRequest")
-	proto.RegisterType((*BroadcastTxResponse)(nil), "cosmos.tx.v1beta1.BroadcastTxResponse")
-	proto.RegisterType((*SimulateRequest)(nil), "cosmos.tx.v1beta1.SimulateRequest")
-	proto.RegisterType((*SimulateResponse)(nil), "cosmos.tx.v1beta1.SimulateResponse")
-	proto.RegisterType((*GetTxRequest)(nil), "cosmos.tx.v1beta1.GetTxRequest")
-	proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
-	proto.RegisterType((*GetBlockWithTxsRequest)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsRequest")
-	proto.RegisterType((*GetBlockWithTxsResponse)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsResponse")
-	proto.RegisterType((*TxDecodeRequest)(nil), "cosmos.tx.v1beta1.TxDecodeRequest")
-	proto.RegisterType((*TxDecodeResponse)(nil), "cosmos.tx.v1beta1.TxDecodeResponse")
-	proto.RegisterType((*TxEncodeRequest)(nil), "cosmos.tx.v1beta1.TxEncodeRequest")
-	proto.RegisterType((*TxEncodeResponse)(nil), "cosmos.tx.v1beta1.TxEncodeResponse")
-	proto.RegisterType((*TxEncodeAminoRequest)(nil), "cosmos.tx.v1beta1.TxEncodeAminoRequest")
-	proto.RegisterType((*TxEncodeAminoResponse)(nil), "cosmos.tx.v1beta1.TxEncodeAminoResponse")
-	proto.RegisterType((*TxDecodeAminoRequest)(nil), "cosmos.tx.v1beta1.TxDecodeAminoRequest")
-	proto.RegisterType((*TxDecodeAminoResponse)(nil), "cosmos.tx.v1beta1.TxDecodeAminoResponse")
+// GasPricesRequest is the request type for the Service.GasPrices
+// RPC method.
+type GasPricesRequest struct {
+	// denom restricts the recommendations to the given fee denom. All the fee
+	// denoms paid in the recent blocks are returned if empty.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
-
-var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 1313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0xcf, 0x8f, 0xd3, 0x46,
-	0x14, 0xc7, 0xd7, 0xde, 0x5f, 0xd9, 0x97, 0x5d, 0x36, 0xcc, 0x06, 0x08, 0x01, 0xb2, 0xc1, 0xb0,
-	0x6c, 0x58, 0x09, 0x1b, 0x02, 0x2b, 0x2a, 0xd4, 0xaa, 0x8a, 0x93, 0xb0, 0x5d, 0x28, 0x04, 0x39,
-	0x5b, 0x55, 0x54, 0x95, 0x22, 0x27, 0x19, 0x12, 0x97, 0xc4, 0x0e, 0xf1, 0xec, 0xca, 0x11, 0x45,
-	0xad, 0x7a, 0x6a, 0xab, 0x56, 0xad, 0xd4, 0xbf, 0xa0, 0xf7, 0x1e, 0xf9, 0x23, 0x2a, 0x4e, 0x88,
-	0x5e, 0xaa, 0x9e, 0x2a, 0xe8, 0xa9, 0x7f, 0x45, 0xe5, 0x99, 0x71, 0x62, 0x27, 0x36, 0xcb, 0xa6,
-	0xb7, 0x19, 0xcf, 0x9b, 0xf7, 0x3e, 0xf3, 0x9d, 0x99, 0xf7, 0xc6, 0xb0, 0xde, 0xb0, 0xec, 0xae,
-	0x65, 0x2b, 0xc4, 0x51, 0x0e, 0xae, 0xd5, 0x31, 0xd1, 0xaf, 0x29, 0x36, 0xee, 0x1f, 0x18, 0x0d,
-	0x2c, 0xf7, 0xfa, 0x16, 0xb1, 0xd0, 0x71, 0x66, 0x20, 0x13, 0x47, 0xe6, 0x06, 0xe9, 0xb3, 0x2d,
-	0xcb, 0x6a, 0x75, 0xb0, 0xa2, 0xf7, 0x0c, 0x45, 0x37, 0x4d, 0x8b, 0xe8, 0xc4, 0xb0, 0x4c, 0x9b,
-	0x4d, 0x48, 0x5f, 0xe0, 0x1e, 0xeb, 0xba, 0x8d, 0x15, 0xbd, 0xde, 0x30, 0x86, 0x8e, 0xdd, 0x0e,
-	0x37, 0x4a, 0x4f, 0x86, 0x25, 0x0e, 0x1f, 0xdb, 0xf2, 0x3b, 0x78, 0xb2, 0x8f, 0xfb, 0x83, 0xa1,
-	0x4d, 0x4f, 0x6f, 0x19, 0x26, 0x8d, 0xc6, 0x6d, 0xcf, 0x35, 0xac, 0x2e, 0x26, 0xf5, 0x47, 0x44,
-	0x21, 0x83, 0x1e, 0xb6, 0x95, 0x83, 0xbc, 0x52, 0xef, 0x58, 0x8d, 0xc7, 0xd1, 0xc3, 0xb4, 0xc1,
-	0x87, 0x4f, 0xb3, 0x48, 0x35, 0xda, 0x53, 0xf8, 0x42, 0x69, 0x47, 0xfa, 0x56, 0x04, 0xb4, 0x83,
-	0xc9, 0x9e, 0x63, 0x97, 0x0f, 0xb0, 0x49, 0x34, 0xfc, 0x64, 0x1f, 0xdb, 0x04, 0xa5, 0x61, 0x01,
-	0xbb, 0x7d, 0x3b, 0x25, 0x64, 0x67, 0x73, 0x4b, 0xaa, 0x98, 0x12, 0x34, 0xfe, 0x05, 0xdd, 0x01,
-	0x18, 0xf1, 0xa5, 0xc4, 0xac, 0x90, 0x8b, 0xe7, 0x2f, 0xc9, 0xdc, 0xab, 0xbb, 0x18, 0x99, 0x2e,
-	0xc6, 0x93, 0x51, 0x7e, 0xa0, 0xb7, 0x30, 0xf7, 0x4b, 0xfd, 0xf8, 0x66, 0xa3, 0x6d, 0x88, 0x59,
-	0xfd, 0x26, 0xee, 0xd7, 0xea, 0x83, 0xd4, 0x6c, 0x56, 0xc8, 0x1d, 0xcb, 0xa7, 0xe5, 0x89, 0x8d,
-	0x90, 0x2b, 0xae, 0x89, 0x3a, 0xd0, 0x16, 0x2d, 0xd6, 0x40, 0x08, 0xe6, 0x7a, 0x7a, 0x0b, 0xa7,
-	0xe6, 0xb2, 0x42, 0x6e, 0x4e, 0xa3, 0x6d, 0x94, 0x84, 0xf9, 0x8e, 0xd1, 0x35, 0x48, 0x6a, 0x9e,
-	0x7e, 0x64, 0x1d, 0x74, 0x19, 0xe6, 0x29, 0x4d, 0x6a, 0x21, 0x2b, 0xe4, 0x96, 0xd4, 0xb5, 0xbf,
-	0x9e, 0x5f, 0x59, 0x65, 0x01, 0xae, 0xd8, 0xcd, 0xc7, 0xd9, 0xab, 0xf2, 0xf6, 0x55, 0x8d, 0x59,
-	0x48, 0xff, 0x0a, 0xb0, 0x16, 0x90, 0xc2, 0xee, 0x59, 0xa6, 0x8d, 0xd1, 0x26, 0xcc, 0x12, 0x87,
-	0x09, 0x11, 0xcf, 0x9f, 0x08, 0xc1, 0xdb, 0x73, 0x34, 0xd7, 0x02, 0xed, 0xc0, 0x32, 0x71, 0x6a,
-	0x7d, 0x3e, 0xcf, 0x4e, 0x89, 0x74, 0xc6, 0xc5, 0x80, 0x34, 0xf4, 0x6c, 0xf8, 0x26, 0x72, 0x63,
-	0x2d, 0x4e, 0x86, 0x6d, 0x1b, 0xdd, 0x0d, 0x28, 0x3c, 0x4b, 0x15, 0xde, 0x3c, 0x54, 0x61, 0x36,
-	0x7b, 0x42, 0xe2, 0x24, 0xcc, 0x13, 0x8b, 0xe8, 0x1d, 0x2e, 0x16, 0xeb, 0x48, 0x18, 0x90, 0xda,
-	0xb7, 0xf4, 0x66, 0x43, 0xb7, 0x89, 0x8b, 0xc1, 0xb6, 0xfd, 0x34, 0xc4, 0x88, 0x53, 0xab, 0x0f,
-	0x08, 0x76, 0xd7, 0x2b, 0xe4, 0x96, 0xb5, 0x45, 0xe2, 0xa8, 0x6e, 0x17, 0xdd, 0x80, 0xb9, 0xae,
-	0xd5, 0xc4, 0x74, 0xbf, 0x8f, 0xe5, 0xb3, 0x21, 0x32, 0x0c, 0xfd, 0xdd, 0xb3, 0x9a, 0x58, 0xa3,
-	0xd6, 0xd2, 0xe7, 0xb0, 0x16, 0x08, 0xc3, 0x25, 0x2d, 0x43, 0xdc, 0xa7, 0x14, 0x0d, 0xf5, 0xae,
-	0x42, 0xc1, 0x48, 0x28, 0xa9, 0x03, 0xab, 0x55, 0xa3, 0xbb, 0xdf, 0xd1, 0x89, 0x77, 0xc0, 0xd0,
-	0x65, 0x10, 0x89, 0xc3, 0x1d, 0x86, 0xef, 0x15, 0x15, 0x48, 0x24, 0x0e, 0x92, 0x7d, 0x8b, 0x75,
-	0x57, 0xb5, 0x1c, 0x72, 0x3a, 0x6e, 0x5c, 0x1f, 0x2a, 0x20, 0x7d, 0x2f, 0x40, 0x62, 0x14, 0x8e,
-	0xaf, 0xe4, 0x7d, 0x88, 0xb5, 0x74, 0xbb, 0x66, 0x98, 0x8f, 0x2c, 0x1e, 0xf5, 0x7c, 0xf4, 0x32,
-	0x76, 0x74, 0x7b, 0xd7, 0x7c, 0x64, 0x69, 0x8b, 0x2d, 0xd6, 0x40, 0xef, 0xc1, 0x42, 0x1f, 0xdb,
-	0xfb, 0x1d, 0xc2, 0xaf, 0x51, 0x36, 0x7a, 0xae, 0x46, 0xed, 0x34, 0x6e, 0x2f, 0x49, 0xb0, 0x4c,
-	0xcf, 0xaa, 0xb7, 0x6e, 0x04, 0x73, 0x6d, 0xdd, 0x6e, 0x53, 0x86, 0x25, 0x8d, 0xb6, 0xa5, 0x67,
-	0xb0, 0xc2, 0x6d, 0x38, 0xec, 0xc6, 0xa1, 0xe2, 0x50, 0x61, 0xc6, 0x76, 0x47, 0x9c, 0x72, 0x77,
-	0x7e, 0x12, 0xe0, 0xe4, 0x0e, 0x26, 0xaa, 0x9b, 0xa7, 0x3e, 0x35, 0x48, 0x7b, 0xcf, 0xb1, 0x3d,
-	0xda, 0x93, 0xb0, 0xd0, 0xc6, 0x46, 0xab, 0x4d, 0x28, 0xcc, 0xac, 0xc6, 0x7b, 0xe8, 0xf6, 0xf4,
-	0xa9, 0xc5, 0x7f, 0xe6, 0x6f, 0x9d, 0x78, 0xf5, 0xfc, 0xca, 0xf1, 0xe0, 0x46, 0x6e, 0xcb, 0x79,
-	0xe9, 0x3b, 0x11, 0x4e, 0x4d, 0x10, 0x1d, 0xf5, 0x96, 0x6f, 0x43, 0x8c, 0xa6, 0xde, 0x9a, 0xd1,
-	0xe4, 0x84, 0x6e, 0xca, 0x62, 0xe9, 0x57, 0x66, 0x59, 0xf7, 0x20, 0x2f, 0xd3, 0x18, 0xbb, 0x25,
-	0x6d, 0x91, 0xda, 0xee, 0x36, 0x91, 0x0c, 0xf3, 0xb4, 0xc9, 0xaf, 0x73, 0x2a, 0x6a, 0x8e, 0xc6,
-	0xcc, 0xd0, 0x4e, 0x40, 0x8a, 0xb9, 0x23, 0xe5, 0x80, 0x77, 0xd1, 0xa2, 0x00, 0xab, 0x7b, 0x4e,
-	0x09, 0x37, 0xdc, 0xbb, 0x7a, 0xe8, 0xed, 0xbf, 0xb5, 0xf6, 0x6a, 0xe2, 0x66, 0xdc, 0x94, 0xee,
-	0x43, 0x62, 0xe4, 0xe2, 0x48, 0x47, 0x2c, 0xdc, 0xdf, 0x3d, 0x17, 0xa9, 0x6c, 0xfa, 0x91, 0xfe,
-	0x8f, 0x3b, 0xd5, 0xc5, 0xf3, 0xdc, 0x71, 0xbc, 0xa3, 0x2e, 0xf1, 0x0e, 0x24, 0x3d, 0x1f, 0x85,
-	0xae, 0x61, 0x5a, 0x1e, 0xd7, 0x39, 0x00, 0xdd, 0xed, 0xd7, 0xbe, 0xb0, 0x2d, 0x93, 0x5f, 0xba,
-	0x25, 0xfa, 0xe5, 0x8e, 0x6d, 0x99, 0xe1, 0xbe, 0x2a, 0x70, 0x62, 0xcc, 0x17, 0x87, 0x3a, 0x0f,
-	0xcb, 0xcc, 0x59, 0xdd, 0x30, 0xf5, 0xfe, 0x80, 0x83, 0xc5, 0xe9, 0x37, 0x95, 0x7e, 0x8a, 0xd2,
-	0x3f, 0xe9, 0xe9, 0x1f, 0x80, 0x9b, 0xd6, 0xdf, 0x5d, 0x17, 0x30, 0xe0, 0x8f, 0x03, 0x4e, 0xb1,
-	0xda, 0xad, 0x8f, 0x60, 0x91, 0x97, 0x6d, 0x94, 0x82, 0x64, 0x45, 0x2b, 0x95, 0xb5, 0x9a, 0xfa,
-	0xb0, 0xf6, 0xc9, 0xfd, 0xea, 0x83, 0x72, 0x71, 0xf7, 0xf6, 0x6e, 0xb9, 0x94, 0x98, 0x41, 0x09,
-	0x58, 0x1e, 0x8e, 0x14, 0xaa, 0xc5, 0x84, 0x80, 0x8e, 0xc3, 0xca, 0xf0, 0x4b, 0xa9, 0x5c, 0x2d,
-	0x26, 0xc4, 0xad, 0xaf, 0x05, 0x58, 0x09, 0xd4, 0x16, 0x94, 0x81, 0xb4, 0xaa, 0x55, 0x0a, 0xa5,
-	0x62, 0xa1, 0xba, 0x57, 0xbb, 0x57, 0x29, 0x95, 0xc7, 0xdc, 0x9e, 0x85, 0xe4, 0xd8, 0xb8, 0xfa,
-	0x71, 0xa5, 0x78, 0x37, 0x21, 0xa4, 0xc5, 0x98, 0x80, 0x4e, 0xc1, 0xda, 0xd8, 0x68, 0xf5, 0xe1,
-	0xfd, 0x62, 0x42, 0x74, 0x39, 0xc7, 0x06, 0x0a, 0x74, 0x64, 0x36, 0xff, 0x23, 0xc0, 0x62, 0x95,
-	0x3d, 0x17, 0xd1, 0x53, 0x88, 0x79, 0x55, 0x00, 0x49, 0x21, 0x47, 0x72, 0xac, 0x22, 0xa5, 0x2f,
-	0xbc, 0xd5, 0x86, 0xe7, 0xca, 0x4b, 0xdf, 0xfc, 0xf1, 0xcf, 0x2f, 0x62, 0xf6, 0x96, 0xb0, 0x25,
-	0x9d, 0x51, 0x42, 0x9e, 0xaa, 0x5e, 0xc0, 0x27, 0x30, 0x4f, 0x53, 0x3a, 0x5a, 0x0f, 0xf1, 0xea,
-	0x2f, 0x08, 0xe9, 0x6c, 0xb4, 0x01, 0x8f, 0xb9, 0x41, 0x63, 0xae, 0xa3, 0x73, 0x4a, 0xd8, 0x23,
-	0xd5, 0x56, 0x9e, 0xba, 0x45, 0xe4, 0x19, 0xfa, 0x0a, 0xe2, 0xbe, 0x12, 0x8e, 0x36, 0xde, 0x56,
-	0xf9, 0x47, 0xe1, 0x2f, 0x1d, 0x66, 0xc6, 0x21, 0xce, 0x53, 0x88, 0x33, 0xee, 0xc2, 0x4f, 0x86,
-	0x73, 0xa0, 0x2f, 0x21, 0xee, 0x7b, 0x96, 0x85, 0x02, 0x4c, 0xbe, 0x60, 0x43, 0x01, 0x42, 0x5e,
-	0x77, 0x52, 0x86, 0x02, 0xa4, 0x50, 0x54, 0xf4, 0xdf, 0x04, 0x58, 0x1d, 0xab, 0x19, 0xe8, 0x72,
-	0xb8, 0xef, 0x90, 0x4a, 0x97, 0xde, 0x7a, 0x17, 0x53, 0x8e, 0xa2, 0xbe, 0x08, 0xcb, 0xd4, 0x94,
-	0x6f, 0x13, 0x6d, 0x44, 0xec, 0x12, 0xad, 0x16, 0xca, 0x53, 0x56, 0x40, 0x9f, 0xa1, 0x1f, 0x04,
-	0x88, 0x79, 0x97, 0x38, 0xf4, 0x78, 0x8e, 0x25, 0xfd, 0xd0, 0xe3, 0x39, 0x9e, 0xd5, 0xa5, 0x9b,
-	0x2f, 0x26, 0x6f, 0x38, 0xe5, 0xca, 0xb8, 0x1b, 0x77, 0x3a, 0x04, 0xad, 0xc9, 0x08, 0x18, 0x0e,
-	0x4b, 0x7a, 0x11, 0x38, 0x81, 0x84, 0x1f, 0x81, 0x13, 0xcc, 0xe2, 0x53, 0xe0, 0x60, 0x46, 0xf0,
-	0xab, 0x00, 0x2b, 0x81, 0x1c, 0x8c, 0x36, 0xdf, 0x12, 0xcf, 0x9f, 0x54, 0xd3, 0xb9, 0xc3, 0x0d,
-	0x39, 0xdd, 0x07, 0x51, 0x74, 0x17, 0x5d, 0xba, 0xf5, 0x48, 0x3a, 0x85, 0x66, 0x54, 0xce, 0xe8,
-	0x4b, 0xc3, 0x11, 0x8c, 0x93, 0x89, 0x3f, 0x82, 0x31, 0x24, 0xa3, 0x4f, 0xc1, 0xc8, 0x36, 0x94,
-	0x31, 0xaa, 0x1f, 0xfe, 0xfe, 0x3a, 0x23, 0xbc, 0x7c, 0x9d, 0x11, 0xfe, 0x7e, 0x9d, 0x11, 0x7e,
-	0x7e, 0x93, 0x99, 0x79, 0xf9, 0x26, 0x33, 0xf3, 0xe7, 0x9b, 0xcc, 0xcc, 0x67, 0x1b, 0x2d, 0x83,
-	0xb4, 0xf7, 0xeb, 0xee, 0xeb, 0xc6, 0x73, 0x32, 0x0a, 0xc3, 0xff, 0x4e, 0x89, 0x53, 0x5f, 0xa0,
-	0x7f, 0x9f, 0xd7, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xa1, 0xa0, 0x7a, 0xec, 0x97, 0x0f, 0x00,
-	0x00,
+func (m *GasPricesRequest) Reset()         { *m = GasPricesRequest{} }
+func (m *GasPricesRequest) String() string { return proto.CompactTextString(m) }
+func (*GasPricesRequest) ProtoMessage()    {}
+func (*GasPricesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{22}
+}
+func (m *GasPricesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPricesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPricesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPricesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPricesRequest.Merge(m, src)
+}
+func (m *GasPricesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPricesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPricesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_GasPricesRequest proto.InternalMessageInfo
 
-// ServiceClient is the client API for Service service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ServiceClient interface {
-	// Simulate simulates executing a transaction for estimating gas usage.
-	Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
-	// GetTx fetches a tx by hash.
-	GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error)
-	// BroadcastTx broadcast transaction.
-	BroadcastTx(ctx context.Context, in *BroadcastTxRequest, opts ...grpc.CallOption) (*BroadcastTxResponse, error)
-	// GetTxsEvent fetches txs by event.
-	GetTxsEvent(ctx context.Context, in *GetTxsEventRequest, opts ...grpc.CallOption) (*GetTxsEventResponse, error)
-	// GetBlockWithTxs fetches a block with decoded txs.
-	GetBlockWithTxs(ctx context.Context, in *GetBlockWithTxsRequest, opts ...grpc.CallOption) (*GetBlockWithTxsResponse, error)
-	// TxDecode decodes the transaction.
-	TxDecode(ctx context.Context, in *TxDecodeRequest, opts ...grpc.CallOption) (*TxDecodeResponse, error)
-	// TxEncode encodes the transaction.
-	TxEncode(ctx context.Context, in *TxEncodeRequest, opts ...grpc.CallOption) (*TxEncodeResponse, error)
-	// TxEncodeAmino encodes an Amino transaction from JSON to encoded bytes.
-	TxEncodeAmino(ctx context.Context, in *TxEncodeAminoRequest, opts ...grpc.CallOption) (*TxEncodeAminoResponse, error)
-	// TxDecodeAmino decodes an Amino transaction from encoded bytes to JSON.
-	TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequest, opts ...grpc.CallOption) (*TxDecodeAminoResponse, error)
+func (m *GasPricesRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-type serviceClient struct {
-	cc grpc1.ClientConn
+// GasPricesResponse is the response type for the Service.GasPrices
+// RPC method.
+type GasPricesResponse struct {
+	// gas_prices are the gas price recommendations, one per fee denom.
+	GasPrices []GasPriceEstimate `protobuf:"bytes,1,rep,name=gas_prices,json=gasPrices,proto3" json:"gas_prices"`
+	// height is the height of the latest block the recommendations are computed
+	// from.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
-	return &serviceClient{cc}
+func (m *GasPricesResponse) Reset()         { *m = GasPricesResponse{} }
+func (m *GasPricesResponse) String() string { return proto.CompactTextString(m) }
+func (*GasPricesResponse) ProtoMessage()    {}
+func (*GasPricesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{23}
 }
-
-func (c *serviceClient) Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error) {
-	out := new(SimulateResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/Simulate", in, out, opts...)
+func (m *GasPricesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPricesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPricesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPricesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPricesResponse.Merge(m, src)
+}
+func (m *GasPricesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPricesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPricesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPricesResponse proto.InternalMessageInfo
+
+func (m *GasPricesResponse) GetGasPrices() []GasPriceEstimate {
+	if m != nil {
+		return m.GasPrices
+	}
+	return nil
+}
+
+func (m *GasPricesResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// GasPriceEstimate defines the gas price recommendations for a fee denom. The
+// gas price of a tx is its fee divided by its gas limit.
+type GasPriceEstimate struct {
+	// denom is the fee denom the gas prices are expressed in.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// slow is the 25th percentile of the gas prices paid in the recent blocks.
+	Slow cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=slow,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slow"`
+	// normal is the median of the gas prices paid in the recent blocks.
+	Normal cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=normal,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"normal"`
+	// fast is the 90th percentile of the gas prices paid in the recent blocks.
+	Fast cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=fast,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fast"`
+	// samples is the number of txs of the recent blocks paying fees in denom.
+	Samples uint64 `protobuf:"varint,5,opt,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (m *GasPriceEstimate) Reset()         { *m = GasPriceEstimate{} }
+func (m *GasPriceEstimate) String() string { return proto.CompactTextString(m) }
+func (*GasPriceEstimate) ProtoMessage()    {}
+func (*GasPriceEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{24}
+}
+func (m *GasPriceEstimate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceEstimate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceEstimate.Merge(m, src)
+}
+func (m *GasPriceEstimate) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceEstimate proto.InternalMessageInfo
+
+func (m *GasPriceEstimate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *GasPriceEstimate) GetSamples() uint64 {
+	if m != nil {
+		return m.Samples
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.tx.v1beta1.OrderBy", OrderBy_name, OrderBy_value)
+	proto.RegisterEnum("cosmos.tx.v1beta1.BroadcastMode", BroadcastMode_name, BroadcastMode_value)
+	proto.RegisterType((*GetTxsEventRequest)(nil), "cosmos.tx.v1beta1.GetTxsEventRequest")
+	proto.RegisterType((*GetTxsEventResponse)(nil), "cosmos.tx.v1beta1.GetTxsEventResponse")
+	proto.RegisterType((*BroadcastTxRequest)(nil), "cosmos.tx.v1beta1.BroadcastTxRequest")
+	proto.RegisterType((*BroadcastTxResponse)(nil), "cosmos.tx.v1beta1.BroadcastTxResponse")
+	proto.RegisterType((*SimulateRequest)(nil), "cosmos.tx.v1beta1.SimulateRequest")
+	proto.RegisterType((*SimulateResponse)(nil), "cosmos.tx.v1beta1.SimulateResponse")
+	proto.RegisterType((*StateDiff)(nil), "cosmos.tx.v1beta1.StateDiff")
+	proto.RegisterType((*StoreDiff)(nil), "cosmos.tx.v1beta1.StoreDiff")
+	proto.RegisterType((*KVWrite)(nil), "cosmos.tx.v1beta1.KVWrite")
+	proto.RegisterType((*BalanceDelta)(nil), "cosmos.tx.v1beta1.BalanceDelta")
+	proto.RegisterType((*GetTxRequest)(nil), "cosmos.tx.v1beta1.GetTxRequest")
+	proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
+	proto.RegisterType((*GetBlockWithTxsRequest)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsRequest")
+	proto.RegisterType((*GetBlockWithTxsResponse)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsResponse")
+	proto.RegisterType((*TxDecodeRequest)(nil), "cosmos.tx.v1beta1.TxDecodeRequest")
+	proto.RegisterType((*TxDecodeResponse)(nil), "cosmos.tx.v1beta1.TxDecodeResponse")
+	proto.RegisterType((*TxEncodeRequest)(nil), "cosmos.tx.v1beta1.TxEncodeRequest")
+	proto.RegisterType((*TxEncodeResponse)(nil), "cosmos.tx.v1beta1.TxEncodeResponse")
+	proto.RegisterType((*TxEncodeAminoRequest)(nil), "cosmos.tx.v1beta1.TxEncodeAminoRequest")
+	proto.RegisterType((*TxEncodeAminoResponse)(nil), "cosmos.tx.v1beta1.TxEncodeAminoResponse")
+	proto.RegisterType((*TxDecodeAminoRequest)(nil), "cosmos.tx.v1beta1.TxDecodeAminoRequest")
+	proto.RegisterType((*TxDecodeAminoResponse)(nil), "cosmos.tx.v1beta1.TxDecodeAminoResponse")
+	proto.RegisterType((*GasPricesRequest)(nil), "cosmos.tx.v1beta1.GasPricesRequest")
+	proto.RegisterType((*GasPricesResponse)(nil), "cosmos.tx.v1beta1.GasPricesResponse")
+	proto.RegisterType((*GasPriceEstimate)(nil), "cosmos.tx.v1beta1.GasPriceEstimate")
+}
+
+func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
+
+var fileDescriptor_e0b00a618705eca7 = []byte{
+	// 1822 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x1d, 0xd5, 0x92, 0x94, 0x48, 0xfe, 0x48, 0xc5, 0xf4, 0x88, 0x76, 0x68, 0xda, 0xa6, 0x68, 0xca,
+	0xb2, 0x65, 0x01, 0xda, 0xb5, 0x19, 0x1b, 0x09, 0x8c, 0x06, 0x85, 0x28, 0x32, 0x8a, 0xec, 0xd8,
+	0x32, 0x96, 0x6a, 0x82, 0x14, 0x05, 0x88, 0x21, 0x77, 0x44, 0x6d, 0x4d, 0xee, 0xc8, 0x3b, 0x23,
+	0x85, 0x82, 0x6b, 0xb4, 0xe8, 0xa9, 0x2d, 0x0a, 0x34, 0x40, 0x3f, 0x40, 0x13, 0xf4, 0x56, 0xf4,
+	0xd0, 0x83, 0x3f, 0x44, 0xe0, 0x53, 0xe0, 0x5e, 0x8a, 0xa0, 0x70, 0x0b, 0xbb, 0xa7, 0x7e, 0x8a,
+	0x62, 0x67, 0x66, 0xc9, 0x25, 0xb9, 0x14, 0x2d, 0xb9, 0xb7, 0xf9, 0xf3, 0xe6, 0xcd, 0x9b, 0x37,
+	0x33, 0xbf, 0xdf, 0xec, 0xc2, 0x62, 0x8b, 0xb2, 0x2e, 0x65, 0x06, 0xef, 0x19, 0x87, 0xb7, 0x9a,
+	0x84, 0xe3, 0x5b, 0x06, 0x23, 0xee, 0xa1, 0xdd, 0x22, 0xfa, 0xbe, 0x4b, 0x39, 0x45, 0x67, 0x25,
+	0x40, 0xe7, 0x3d, 0x5d, 0x01, 0xf2, 0xd9, 0x36, 0x6d, 0x53, 0xd1, 0x6b, 0x78, 0x25, 0x09, 0xcc,
+	0x5f, 0x6a, 0x53, 0xda, 0xee, 0x10, 0x03, 0xef, 0xdb, 0x06, 0x76, 0x1c, 0xca, 0x31, 0xb7, 0xa9,
+	0xc3, 0x54, 0xef, 0x92, 0x9a, 0xa7, 0x89, 0x19, 0x31, 0x70, 0xb3, 0x65, 0xf7, 0xa7, 0xf3, 0x2a,
+	0x0a, 0x94, 0x1f, 0x17, 0xc3, 0x7b, 0xaa, 0x6f, 0x35, 0x48, 0xf0, 0xe4, 0x80, 0xb8, 0x47, 0x7d,
+	0xcc, 0x3e, 0x6e, 0xdb, 0x8e, 0x98, 0x4d, 0x61, 0x0b, 0x41, 0xac, 0x8f, 0x6a, 0x51, 0xdb, 0xef,
+	0xbf, 0xdc, 0xa2, 0x5d, 0xc2, 0x9b, 0xbb, 0xdc, 0xe0, 0x47, 0xfb, 0x84, 0x19, 0x87, 0x65, 0xa3,
+	0xd9, 0xa1, 0xad, 0xc7, 0x93, 0xbb, 0x45, 0x41, 0x75, 0x5f, 0x90, 0xec, 0x0d, 0xe9, 0x80, 0xac,
+	0xc8, 0xae, 0xd2, 0x6f, 0x22, 0x80, 0x36, 0x09, 0xdf, 0xe9, 0xb1, 0xda, 0x21, 0x71, 0xb8, 0x49,
+	0x9e, 0x1c, 0x10, 0xc6, 0x51, 0x1e, 0xe6, 0x88, 0x57, 0x67, 0x39, 0xad, 0x18, 0x5d, 0x49, 0x56,
+	0x22, 0x39, 0xcd, 0x54, 0x2d, 0xe8, 0x1e, 0xc0, 0x40, 0x7f, 0x2e, 0x52, 0xd4, 0x56, 0x52, 0xe5,
+	0x6b, 0xba, 0x62, 0xf5, 0x16, 0xa0, 0x8b, 0xc5, 0xfa, 0xe6, 0xeb, 0x8f, 0x70, 0x9b, 0x28, 0x5e,
+	0xc1, 0x13, 0x18, 0x8d, 0xee, 0x40, 0x82, 0xba, 0x16, 0x71, 0x1b, 0xcd, 0xa3, 0x5c, 0xb4, 0xa8,
+	0xad, 0xbc, 0x57, 0xce, 0xeb, 0x63, 0xdb, 0xa7, 0x6f, 0x7b, 0x90, 0xca, 0x91, 0x19, 0xa7, 0xb2,
+	0x80, 0x10, 0xc4, 0xf6, 0x71, 0x9b, 0xe4, 0x62, 0x45, 0x6d, 0x25, 0x66, 0x8a, 0x32, 0xca, 0xc2,
+	0x6c, 0xc7, 0xee, 0xda, 0x3c, 0x37, 0x2b, 0x1a, 0x65, 0x05, 0xdd, 0x80, 0x59, 0xa1, 0x26, 0x37,
+	0x57, 0xd4, 0x56, 0x92, 0x95, 0x85, 0x1f, 0x9e, 0xaf, 0x9d, 0x91, 0x13, 0xac, 0x31, 0xeb, 0x71,
+	0xf1, 0xa6, 0x7e, 0xe7, 0xa6, 0x29, 0x11, 0xa5, 0xff, 0x6a, 0xb0, 0x30, 0x64, 0x05, 0xdb, 0xa7,
+	0x0e, 0x23, 0xe8, 0x3a, 0x44, 0x79, 0x4f, 0x1a, 0x91, 0x2a, 0x9f, 0x0b, 0x91, 0xb7, 0xd3, 0x33,
+	0x3d, 0x04, 0xda, 0x84, 0x34, 0xef, 0x35, 0x5c, 0x35, 0x8e, 0xe5, 0x22, 0x62, 0xc4, 0xd5, 0x21,
+	0x6b, 0xc4, 0xd9, 0x09, 0x0c, 0x54, 0x60, 0x33, 0xc5, 0xfb, 0x65, 0x86, 0xee, 0x0f, 0x39, 0x1c,
+	0x15, 0x0e, 0x5f, 0x9f, 0xea, 0xb0, 0x1c, 0x3d, 0x66, 0x71, 0x16, 0x66, 0x39, 0xe5, 0xb8, 0xa3,
+	0xcc, 0x92, 0x95, 0x12, 0x01, 0x54, 0x71, 0x29, 0xb6, 0x5a, 0x98, 0xf1, 0x9d, 0x9e, 0xda, 0x1e,
+	0x74, 0x01, 0x12, 0xbc, 0xd7, 0x68, 0x1e, 0x71, 0xe2, 0xad, 0x57, 0x5b, 0x49, 0x9b, 0x71, 0xde,
+	0xab, 0x78, 0x55, 0x74, 0x1b, 0x62, 0x5d, 0x6a, 0x11, 0xb1, 0xdf, 0xef, 0x95, 0x8b, 0x21, 0x36,
+	0xf4, 0xf9, 0x1e, 0x50, 0x8b, 0x98, 0x02, 0x5d, 0xfa, 0x19, 0x2c, 0x0c, 0x4d, 0xa3, 0x2c, 0xad,
+	0x41, 0x2a, 0xe0, 0x94, 0x98, 0xea, 0x6d, 0x8d, 0x82, 0x81, 0x51, 0xa5, 0x6f, 0x34, 0x38, 0x53,
+	0xb7, 0xbb, 0x07, 0x1d, 0xcc, 0xfd, 0x13, 0x86, 0x6e, 0x40, 0x84, 0xf7, 0x14, 0x63, 0xf8, 0x66,
+	0x09, 0x87, 0x22, 0xbc, 0x87, 0xf4, 0xc0, 0x6a, 0xbd, 0x65, 0xa5, 0x43, 0x8e, 0xc7, 0xed, 0x0f,
+	0x06, 0x16, 0x94, 0x01, 0x18, 0xc7, 0x9c, 0x34, 0x2c, 0x7b, 0x77, 0x57, 0x6c, 0x4b, 0x22, 0xec,
+	0x40, 0xdd, 0x36, 0x93, 0x02, 0x56, 0xb5, 0x77, 0x77, 0x4b, 0xff, 0xd4, 0x20, 0x33, 0x90, 0xa8,
+	0x96, 0xff, 0x23, 0x48, 0xb4, 0x31, 0x6b, 0xd8, 0xce, 0x2e, 0x55, 0x4a, 0xaf, 0x4c, 0x5e, 0xfb,
+	0x26, 0x66, 0x5b, 0xce, 0x2e, 0x35, 0xe3, 0x6d, 0x59, 0x40, 0x1f, 0xc1, 0x9c, 0x4b, 0xd8, 0x41,
+	0x87, 0xab, 0xbb, 0x57, 0x9c, 0x3c, 0xd6, 0x14, 0x38, 0x53, 0xe1, 0xd1, 0xa3, 0xb1, 0x05, 0xa4,
+	0xca, 0x97, 0x42, 0x3c, 0xaa, 0xfb, 0xf2, 0xa7, 0x2e, 0xef, 0x4f, 0x1a, 0x24, 0xfb, 0x68, 0x74,
+	0x17, 0xe6, 0x18, 0xa7, 0x2e, 0xf1, 0x2f, 0x4b, 0x38, 0x37, 0x75, 0x25, 0x77, 0xec, 0xbb, 0x57,
+	0x8b, 0x33, 0xa6, 0x1a, 0x81, 0xd6, 0x21, 0xd1, 0xc4, 0x1d, 0xec, 0xb4, 0xfa, 0x17, 0x67, 0x31,
+	0xec, 0x8c, 0x49, 0x48, 0x95, 0x74, 0x38, 0x56, 0x04, 0xfd, 0x61, 0x77, 0x17, 0x5e, 0x8e, 0x8b,
+	0x2d, 0x1d, 0x41, 0xb2, 0x3f, 0x25, 0xba, 0xec, 0x19, 0x40, 0x5d, 0xd2, 0x70, 0x70, 0x57, 0x1e,
+	0xbb, 0xa4, 0xb7, 0x1a, 0xea, 0x92, 0x87, 0xb8, 0x4b, 0x3c, 0x67, 0xbf, 0x72, 0x6d, 0xde, 0x57,
+	0x10, 0x16, 0x8b, 0xee, 0x7f, 0xfe, 0x85, 0x07, 0xf1, 0xd5, 0x4b, 0x7c, 0xf8, 0xd4, 0x7f, 0xd6,
+	0x20, 0xae, 0xe0, 0x28, 0x03, 0xd1, 0xc7, 0xe4, 0x48, 0x5d, 0x2a, 0xaf, 0xe8, 0xdd, 0xcb, 0x43,
+	0xdc, 0x39, 0x90, 0x37, 0x2a, 0x6d, 0xca, 0x0a, 0x3a, 0x0f, 0x73, 0x16, 0xe9, 0x10, 0x4e, 0xe4,
+	0xf9, 0x32, 0x55, 0x0d, 0x15, 0x00, 0x5a, 0xb4, 0xd3, 0x21, 0x2d, 0x11, 0x12, 0x62, 0x42, 0x79,
+	0xa0, 0x05, 0x2d, 0xc1, 0xbc, 0x45, 0x5a, 0xd4, 0x22, 0x56, 0x43, 0xb2, 0xce, 0x0a, 0x48, 0x5a,
+	0x35, 0x7e, 0xee, 0xb5, 0x85, 0xab, 0xfc, 0x36, 0x02, 0xe9, 0xa0, 0xad, 0xa8, 0x0c, 0x71, 0x6c,
+	0x59, 0x2e, 0x61, 0x32, 0x06, 0x24, 0x2b, 0xb9, 0x97, 0xcf, 0xd7, 0xb2, 0xca, 0x89, 0x75, 0xd9,
+	0x53, 0xe7, 0xae, 0xed, 0xb4, 0x4d, 0x1f, 0x88, 0x1a, 0x10, 0x63, 0xc4, 0xe1, 0xca, 0xb7, 0x0b,
+	0x43, 0x27, 0xd2, 0x77, 0x6e, 0x83, 0xda, 0x4e, 0xe5, 0xa6, 0x67, 0xdb, 0x5f, 0xfe, 0xb5, 0xb8,
+	0xd2, 0xb6, 0xf9, 0xde, 0x41, 0x53, 0x6f, 0xd1, 0xae, 0x4a, 0x48, 0xc6, 0x40, 0x98, 0x4a, 0x5e,
+	0xde, 0x00, 0x66, 0x0a, 0x62, 0xd4, 0x86, 0x84, 0x4b, 0x5a, 0xc4, 0x3e, 0x24, 0x56, 0x2e, 0xfa,
+	0xff, 0x9f, 0xa4, 0x4f, 0x1e, 0xee, 0x51, 0x09, 0xd2, 0x22, 0x33, 0xf8, 0x41, 0x06, 0x41, 0x6c,
+	0x0f, 0xb3, 0x3d, 0x75, 0x82, 0x44, 0xb9, 0xf4, 0x0c, 0xe6, 0x15, 0x46, 0xdd, 0xf2, 0xe5, 0xa9,
+	0x91, 0x48, 0x44, 0xa1, 0x91, 0x58, 0x18, 0x39, 0x65, 0x2c, 0xfc, 0x83, 0x06, 0xe7, 0x37, 0x09,
+	0xaf, 0x78, 0xaf, 0x82, 0x2f, 0x6c, 0xbe, 0xb7, 0xd3, 0x63, 0xbe, 0xda, 0xf3, 0x30, 0xb7, 0x47,
+	0xec, 0xf6, 0x1e, 0x17, 0x62, 0xa2, 0xa6, 0xaa, 0xa1, 0x4f, 0x4e, 0x9f, 0xc8, 0x83, 0x19, 0xe6,
+	0xee, 0xb9, 0x97, 0xcf, 0xd7, 0xce, 0x0e, 0x47, 0xcd, 0x3b, 0x7a, 0xb9, 0xf4, 0xdb, 0x08, 0xbc,
+	0x3f, 0xa6, 0xe8, 0xa4, 0x39, 0xf5, 0x0e, 0x24, 0xc4, 0x43, 0xa7, 0x61, 0x5b, 0x4a, 0x61, 0x5e,
+	0xf7, 0x1f, 0x3b, 0xba, 0xdc, 0xc1, 0xc3, 0xb2, 0x2e, 0xe6, 0xd8, 0xaa, 0x9a, 0x71, 0x81, 0xdd,
+	0xb2, 0x90, 0x0e, 0xb3, 0xa2, 0xa8, 0x82, 0x5c, 0x6e, 0xd2, 0x18, 0x53, 0xc2, 0xd0, 0xe6, 0x90,
+	0x15, 0xb1, 0x13, 0x65, 0xdc, 0xb7, 0xf1, 0x62, 0x1d, 0xce, 0xec, 0xf4, 0xaa, 0xe2, 0x2e, 0x4e,
+	0xcf, 0xb5, 0x21, 0x67, 0xf0, 0xf6, 0x87, 0xa5, 0x87, 0x90, 0x19, 0x50, 0x9c, 0xe8, 0x88, 0x85,
+	0xf3, 0x3d, 0xf0, 0x24, 0xd5, 0x9c, 0xa0, 0xa4, 0x77, 0xa1, 0xab, 0x40, 0x66, 0x40, 0xa7, 0xe4,
+	0x9d, 0x74, 0x89, 0xf7, 0x20, 0xeb, 0x73, 0xac, 0x77, 0x6d, 0x87, 0xfa, 0xba, 0x2e, 0x03, 0x60,
+	0xaf, 0xde, 0xf8, 0x39, 0xa3, 0x8e, 0x1f, 0xb6, 0x45, 0xcb, 0x3d, 0x46, 0x9d, 0x70, 0xae, 0x6d,
+	0x38, 0x37, 0xc2, 0xa5, 0x44, 0x5d, 0x81, 0xb4, 0x24, 0x6b, 0xda, 0x0e, 0x76, 0xfd, 0x90, 0x9c,
+	0x12, 0x6d, 0x15, 0xd1, 0x34, 0xc9, 0xff, 0xac, 0xef, 0xff, 0x90, 0xb8, 0xd3, 0xf2, 0xdd, 0x87,
+	0x73, 0x23, 0x7c, 0x4a, 0xe0, 0x69, 0x56, 0xfb, 0x31, 0x64, 0x36, 0x31, 0x7b, 0xe4, 0xda, 0x2d,
+	0xd2, 0xbf, 0xf6, 0x59, 0x98, 0xb5, 0x88, 0x43, 0xbb, 0x8a, 0x42, 0x56, 0xc2, 0xe3, 0xdb, 0xef,
+	0x34, 0x38, 0x1b, 0x18, 0xaf, 0x84, 0x7c, 0x0a, 0xe0, 0x3d, 0x53, 0xf6, 0x45, 0xab, 0xba, 0xab,
+	0x4b, 0x21, 0xc7, 0xc2, 0x1f, 0x59, 0x63, 0xdc, 0xee, 0xe2, 0x7e, 0x6e, 0x4c, 0xb6, 0x7d, 0xc6,
+	0x40, 0x04, 0x8a, 0x04, 0x23, 0x50, 0xb8, 0x98, 0xbf, 0x45, 0x20, 0x33, 0x4a, 0x19, 0xbe, 0x18,
+	0x54, 0x83, 0x18, 0xeb, 0xd0, 0xaf, 0x04, 0x6b, 0xb2, 0x72, 0xcb, 0x9b, 0xf6, 0x87, 0x57, 0x8b,
+	0x17, 0x25, 0x23, 0xb3, 0x1e, 0xeb, 0x36, 0x35, 0xba, 0x98, 0xef, 0xe9, 0x9f, 0x91, 0x36, 0x6e,
+	0x1d, 0x55, 0x49, 0xeb, 0xe5, 0xf3, 0x35, 0x50, 0x2b, 0xa8, 0x92, 0x96, 0x29, 0x86, 0xa3, 0x2d,
+	0x98, 0x73, 0xa8, 0xdb, 0xc5, 0x9d, 0x5c, 0xf4, 0xb4, 0x44, 0x8a, 0xc0, 0x53, 0xb4, 0x8b, 0x19,
+	0xcf, 0xc5, 0x4e, 0x4b, 0x24, 0x86, 0xa3, 0x1c, 0xc4, 0x19, 0xee, 0xee, 0x77, 0x08, 0x53, 0x9f,
+	0x33, 0x7e, 0x35, 0xd4, 0xb2, 0xd5, 0x4f, 0x21, 0xae, 0xbe, 0x91, 0x50, 0x0e, 0xb2, 0xdb, 0x66,
+	0xb5, 0x66, 0x36, 0x2a, 0x5f, 0x36, 0x7e, 0xf2, 0xb0, 0xfe, 0xa8, 0xb6, 0xb1, 0xf5, 0xc9, 0x56,
+	0xad, 0x9a, 0x99, 0x41, 0x19, 0x48, 0xf7, 0x7b, 0xd6, 0xeb, 0x1b, 0x19, 0x0d, 0x9d, 0x85, 0xf9,
+	0x7e, 0x4b, 0xb5, 0x56, 0xdf, 0xc8, 0x44, 0x56, 0x7f, 0xa5, 0xc1, 0xfc, 0xd0, 0x43, 0x1e, 0x15,
+	0x20, 0x5f, 0x31, 0xb7, 0xd7, 0xab, 0x1b, 0xeb, 0xf5, 0x9d, 0xc6, 0x83, 0xed, 0x6a, 0x6d, 0x84,
+	0xf6, 0x12, 0x64, 0x47, 0xfa, 0x2b, 0x9f, 0x6d, 0x6f, 0xdc, 0xcf, 0x68, 0xf9, 0x48, 0x42, 0x43,
+	0xef, 0xc3, 0xc2, 0x48, 0x6f, 0xfd, 0xcb, 0x87, 0x1b, 0x99, 0x88, 0xa7, 0x73, 0xa4, 0x63, 0x5d,
+	0xf4, 0x44, 0xcb, 0xdf, 0xa4, 0x20, 0x5e, 0x97, 0x5f, 0xf4, 0xe8, 0x29, 0x24, 0xfc, 0xd7, 0x33,
+	0x2a, 0x85, 0xbd, 0x26, 0x87, 0x5f, 0xff, 0xf9, 0xa5, 0x63, 0x31, 0x2a, 0x55, 0x5e, 0xfb, 0xf5,
+	0xdf, 0xff, 0xf3, 0xc7, 0x48, 0xb1, 0x74, 0xd1, 0x08, 0xf9, 0x95, 0xa0, 0xc0, 0x77, 0xb5, 0x55,
+	0xf4, 0x04, 0x66, 0x45, 0x46, 0x47, 0x61, 0x2f, 0xd1, 0xe0, 0x7b, 0x20, 0x5f, 0x9c, 0x0c, 0x50,
+	0x73, 0x2e, 0x8b, 0x39, 0x17, 0xd1, 0x65, 0x23, 0xec, 0x8f, 0x01, 0x33, 0x9e, 0x7a, 0x6f, 0x88,
+	0x67, 0xe8, 0x97, 0x90, 0x0a, 0x7c, 0x2f, 0xa1, 0xe5, 0xe3, 0x3e, 0xb3, 0x06, 0xd3, 0x5f, 0x9b,
+	0x06, 0x53, 0x22, 0xae, 0x08, 0x11, 0x17, 0x4b, 0xe7, 0xc3, 0x45, 0x78, 0x6b, 0xfe, 0x05, 0xa4,
+	0x02, 0xdf, 0xc0, 0xa1, 0x02, 0xc6, 0x7f, 0x17, 0xe4, 0xaf, 0x4d, 0x83, 0x29, 0x01, 0x05, 0x21,
+	0x20, 0x87, 0x26, 0x08, 0x40, 0x7f, 0xd5, 0xe0, 0xcc, 0xc8, 0x93, 0x01, 0xdd, 0x08, 0xe7, 0x0e,
+	0x79, 0xe8, 0xe4, 0x57, 0xdf, 0x06, 0xaa, 0xa4, 0x54, 0x5e, 0x84, 0x25, 0x6a, 0xa1, 0xef, 0x3a,
+	0x5a, 0x9e, 0xb0, 0x4b, 0xe2, 0xb1, 0x60, 0x3c, 0x95, 0xd1, 0xeb, 0x19, 0xfa, 0xbd, 0x06, 0x09,
+	0x3f, 0x86, 0x87, 0x1e, 0xcf, 0x91, 0x9c, 0x9f, 0x5f, 0x3a, 0x16, 0xa3, 0x94, 0x7d, 0xf8, 0x62,
+	0x3c, 0xc0, 0x0b, 0x5d, 0x85, 0xd2, 0x85, 0x10, 0x5d, 0xf2, 0x85, 0xef, 0xed, 0x9d, 0x94, 0x53,
+	0x73, 0x8e, 0x91, 0x53, 0x73, 0xa6, 0xcb, 0xa9, 0x39, 0xef, 0x22, 0x87, 0x38, 0xbe, 0x9c, 0x6f,
+	0x35, 0x98, 0x1f, 0x4a, 0xc1, 0xe8, 0xfa, 0x31, 0xf3, 0x05, 0x73, 0x6a, 0x7e, 0x65, 0x3a, 0x50,
+	0xa9, 0xfb, 0x78, 0x92, 0xba, 0xab, 0xa5, 0xc5, 0x89, 0xea, 0x0c, 0x91, 0x4d, 0x07, 0x1a, 0xab,
+	0x64, 0x9a, 0xc6, 0x2a, 0x79, 0x4b, 0x8d, 0x55, 0xf2, 0xce, 0x1a, 0x2d, 0x32, 0xac, 0xf1, 0x6b,
+	0x0d, 0x92, 0xfd, 0xe4, 0x8c, 0x8e, 0x4b, 0xc0, 0xfd, 0x8b, 0x70, 0xf5, 0x78, 0x90, 0xd2, 0xf5,
+	0xd1, 0x8b, 0xf1, 0x54, 0x72, 0x4c, 0x98, 0x1a, 0xbc, 0x05, 0x2a, 0x3f, 0xfe, 0xee, 0x75, 0x41,
+	0xfb, 0xfe, 0x75, 0x41, 0xfb, 0xf7, 0xeb, 0x82, 0xf6, 0xf5, 0x9b, 0xc2, 0xcc, 0xf7, 0x6f, 0x0a,
+	0x33, 0xff, 0x78, 0x53, 0x98, 0xf9, 0xe9, 0xf2, 0xf4, 0x4f, 0x2e, 0x83, 0xf7, 0x9a, 0x73, 0xe2,
+	0xef, 0xe3, 0x07, 0xff, 0x1b, 0x00, 0xf3, 0x99, 0xfd, 0x0b, 0xcd, 0x15, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ServiceClient is the client API for Service service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ServiceClient interface {
+	// Simulate simulates executing a transaction for estimating gas usage.
+	Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
+	// GetTx fetches a tx by hash.
+	GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error)
+	// BroadcastTx broadcast transaction.
+	BroadcastTx(ctx context.Context, in *BroadcastTxRequest, opts ...grpc.CallOption) (*BroadcastTxResponse, error)
+	// GetTxsEvent fetches txs by event.
+	GetTxsEvent(ctx context.Context, in *GetTxsEventRequest, opts ...grpc.CallOption) (*GetTxsEventResponse, error)
+	// GetBlockWithTxs fetches a block with decoded txs.
+	GetBlockWithTxs(ctx context.Context, in *GetBlockWithTxsRequest, opts ...grpc.CallOption) (*GetBlockWithTxsResponse, error)
+	// TxDecode decodes the transaction.
+	TxDecode(ctx context.Context, in *TxDecodeRequest, opts ...grpc.CallOption) (*TxDecodeResponse, error)
+	// TxEncode encodes the transaction.
+	TxEncode(ctx context.Context, in *TxEncodeRequest, opts ...grpc.CallOption) (*TxEncodeResponse, error)
+	// TxEncodeAmino encodes an Amino transaction from JSON to encoded bytes.
+	TxEncodeAmino(ctx context.Context, in *TxEncodeAminoRequest, opts ...grpc.CallOption) (*TxEncodeAminoResponse, error)
+	// TxDecodeAmino decodes an Amino transaction from encoded bytes to JSON.
+	TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequest, opts ...grpc.CallOption) (*TxDecodeAminoResponse, error)
+	// GasPrices returns gas price recommendations per fee denom, computed from the
+	// gas prices paid by the txs included in the recent blocks.
+	GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error)
+}
+
+type serviceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewServiceClient(cc grpc1.ClientConn) ServiceClient {
+	return &serviceClient{cc}
+}
+
+func (c *serviceClient) Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error) {
+	out := new(SimulateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/Simulate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1331,6 +1819,15 @@ func (c *serviceClient) TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequ
 	return out, nil
 }
 
+func (c *serviceClient) GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error) {
+	out := new(GasPricesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/GasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 type ServiceServer interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
@@ -1351,6 +1848,9 @@ type ServiceServer interface {
 	TxEncodeAmino(context.Context, *TxEncodeAminoRequest) (*TxEncodeAminoResponse, error)
 	// TxDecodeAmino decodes an Amino transaction from encoded bytes to JSON.
 	TxDecodeAmino(context.Context, *TxDecodeAminoRequest) (*TxDecodeAminoResponse, error)
+	// GasPrices returns gas price recommendations per fee denom, computed from the
+	// gas prices paid by the txs included in the recent blocks.
+	GasPrices(context.Context, *GasPricesRequest) (*GasPricesResponse, error)
 }
 
 // UnimplementedServiceServer can be embedded to have forward compatible implementations.
@@ -1384,6 +1884,9 @@ func (*UnimplementedServiceServer) TxEncodeAmino(ctx context.Context, req *TxEnc
 func (*UnimplementedServiceServer) TxDecodeAmino(ctx context.Context, req *TxDecodeAminoRequest) (*TxDecodeAminoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TxDecodeAmino not implemented")
 }
+func (*UnimplementedServiceServer) GasPrices(ctx context.Context, req *GasPricesRequest) (*GasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPrices not implemented")
+}
 
 func RegisterServiceServer(s grpc1.Server, srv ServiceServer) {
 	s.RegisterService(&_Service_serviceDesc, srv)
@@ -1551,6 +2054,24 @@ func _Service_TxDecodeAmino_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_GasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).GasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/GasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).GasPrices(ctx, req.(*GasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Service_serviceDesc = _Service_serviceDesc
 var _Service_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.tx.v1beta1.Service",
@@ -1592,6 +2113,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TxDecodeAmino",
 			Handler:    _Service_TxDecodeAmino_Handler,
 		},
+		{
+			MethodName: "GasPrices",
+			Handler:    _Service_GasPrices_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/tx/v1beta1/service.proto",
@@ -1821,6 +2346,16 @@ func (m *SimulateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StateDiff {
+		i--
+		if m.StateDiff {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.TxBytes) > 0 {
 		i -= len(m.TxBytes)
 		copy(dAtA[i:], m.TxBytes)
@@ -1863,6 +2398,18 @@ func (m *SimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StateDiff != nil {
+		{
+			size, err := m.StateDiff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Result != nil {
 		{
 			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
@@ -1890,7 +2437,7 @@ func (m *SimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
+func (m *StateDiff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1900,27 +2447,48 @@ func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *StateDiff) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StateDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Stores) > 0 {
+		for iNdEx := len(m.Stores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
+func (m *StoreDiff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1930,44 +2498,41 @@ func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *StoreDiff) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StoreDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TxResponse != nil {
-		{
-			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Writes) > 0 {
+		for iNdEx := len(m.Writes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Writes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.Tx != nil {
-		{
-			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if len(m.StoreName) > 0 {
+		i -= len(m.StoreName)
+		copy(dAtA[i:], m.StoreName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.StoreName)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
+func (m *KVWrite) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1977,37 +2542,58 @@ func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetBlockWithTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *KVWrite) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetBlockWithTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *KVWrite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+	if len(m.DecodedValue) > 0 {
+		i -= len(m.DecodedValue)
+		copy(dAtA[i:], m.DecodedValue)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DecodedValue)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Collection) > 0 {
+		i -= len(m.Collection)
+		copy(dAtA[i:], m.Collection)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Collection)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Delete {
+		i--
+		if m.Delete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Value)))
+		i--
 		dAtA[i] = 0x12
 	}
-	if m.Height != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Height))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Key)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetBlockWithTxsResponse) Marshal() (dAtA []byte, err error) {
+func (m *BalanceDelta) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2017,21 +2603,196 @@ func (m *GetBlockWithTxsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetBlockWithTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *BalanceDelta) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetBlockWithTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BalanceDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Received) > 0 {
+		for iNdEx := len(m.Received) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Received[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sent) > 0 {
+		for iNdEx := len(m.Sent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxResponse != nil {
+		{
+			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockWithTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockWithTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockWithTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockWithTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockWithTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
 			i -= size
 			i = encodeVarintService(dAtA, i, uint64(size))
@@ -2330,59 +3091,196 @@ func (m *TxDecodeAminoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintService(dAtA []byte, offset int, v uint64) int {
-	offset -= sovService(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GetTxsEventRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Events) > 0 {
-		for _, s := range m.Events {
-			l = len(s)
-			n += 1 + l + sovService(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.OrderBy != 0 {
-		n += 1 + sovService(uint64(m.OrderBy))
-	}
-	if m.Page != 0 {
-		n += 1 + sovService(uint64(m.Page))
-	}
-	if m.Limit != 0 {
-		n += 1 + sovService(uint64(m.Limit))
-	}
-	l = len(m.Query)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetTxsEventResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Txs) > 0 {
-		for _, e := range m.Txs {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.GasPrices) > 0 {
+		for iNdEx := len(m.GasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPriceEstimate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceEstimate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Samples != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Samples))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Fast.Size()
+		i -= size
+		if _, err := m.Fast.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintService(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Normal.Size()
+		i -= size
+		if _, err := m.Normal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintService(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Slow.Size()
+		i -= size
+		if _, err := m.Slow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintService(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetTxsEventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, s := range m.Events {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.OrderBy != 0 {
+		n += 1 + sovService(uint64(m.OrderBy))
+	}
+	if m.Page != 0 {
+		n += 1 + sovService(uint64(m.Page))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovService(uint64(m.Limit))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTxsEventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
 		}
 	}
 	if len(m.TxResponses) > 0 {
@@ -2444,6 +3342,9 @@ func (m *SimulateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.StateDiff {
+		n += 2
+	}
 	return n
 }
 
@@ -2461,6 +3362,103 @@ func (m *SimulateResponse) Size() (n int) {
 		l = m.Result.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.StateDiff != nil {
+		l = m.StateDiff.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *StateDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stores) > 0 {
+		for _, e := range m.Stores {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoreDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StoreName)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Writes) > 0 {
+		for _, e := range m.Writes {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KVWrite) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Delete {
+		n += 2
+	}
+	l = len(m.Collection)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DecodedValue)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *BalanceDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Sent) > 0 {
+		for _, e := range m.Sent {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.Received) > 0 {
+		for _, e := range m.Received {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2641,6 +3639,59 @@ func (m *TxDecodeAminoResponse) Size() (n int) {
 	return n
 }
 
+func (m *GasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *GasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovService(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GasPriceEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = m.Slow.Size()
+	n += 1 + l + sovService(uint64(l))
+	l = m.Normal.Size()
+	n += 1 + l + sovService(uint64(l))
+	l = m.Fast.Size()
+	n += 1 + l + sovService(uint64(l))
+	if m.Samples != 0 {
+		n += 1 + sovService(uint64(m.Samples))
+	}
+	return n
+}
+
 func sovService(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2854,7 +3905,509 @@ func (m *GetTxsEventRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
+func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTxsEventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTxsEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &Tx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxResponses = append(m.TxResponses, &types.TxResponse{})
+			if err := m.TxResponses[len(m.TxResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BroadcastTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BroadcastTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= BroadcastMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BroadcastTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BroadcastTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BroadcastTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxResponse == nil {
+				m.TxResponse = &types.TxResponse{}
+			}
+			if err := m.TxResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &Tx{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateDiff", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StateDiff = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2877,15 +4430,15 @@ func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTxsEventResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTxsEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2912,14 +4465,16 @@ func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Txs = append(m.Txs, &Tx{})
-			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.GasInfo == nil {
+				m.GasInfo = &types.GasInfo{}
+			}
+			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxResponses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2946,14 +4501,16 @@ func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxResponses = append(m.TxResponses, &types.TxResponse{})
-			if err := m.TxResponses[len(m.TxResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Result == nil {
+				m.Result = &types.Result{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StateDiff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2980,32 +4537,13 @@ func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.StateDiff == nil {
+				m.StateDiff = &StateDiff{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StateDiff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
-			}
-			m.Total = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Total |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -3027,7 +4565,7 @@ func (m *GetTxsEventResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
+func (m *StateDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3050,17 +4588,17 @@ func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BroadcastTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StateDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BroadcastTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StateDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -3070,31 +4608,31 @@ func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.TxBytes == nil {
-				m.TxBytes = []byte{}
+			m.Stores = append(m.Stores, StoreDiff{})
+			if err := m.Stores[len(m.Stores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
-			m.Mode = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -3104,11 +4642,26 @@ func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Mode |= BroadcastMode(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, BalanceDelta{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -3130,7 +4683,7 @@ func (m *BroadcastTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BroadcastTxResponse) Unmarshal(dAtA []byte) error {
+func (m *StoreDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3153,15 +4706,47 @@ func (m *BroadcastTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BroadcastTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoreDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BroadcastTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoreDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxResponse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Writes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3188,10 +4773,8 @@ func (m *BroadcastTxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TxResponse == nil {
-				m.TxResponse = &types.TxResponse{}
-			}
-			if err := m.TxResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Writes = append(m.Writes, KVWrite{})
+			if err := m.Writes[len(m.Writes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3216,7 +4799,7 @@ func (m *BroadcastTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
+func (m *KVWrite) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3239,17 +4822,17 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SimulateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: KVWrite: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SimulateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KVWrite: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -3259,31 +4842,29 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tx == nil {
-				m.Tx = &Tx{}
-			}
-			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3310,10 +4891,94 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.TxBytes == nil {
-				m.TxBytes = []byte{}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Delete = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.DecodedValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3336,7 +5001,7 @@ func (m *SimulateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
+func (m *BalanceDelta) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3359,15 +5024,47 @@ func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SimulateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BalanceDelta: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SimulateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BalanceDelta: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3394,16 +5091,14 @@ func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GasInfo == nil {
-				m.GasInfo = &types.GasInfo{}
-			}
-			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sent = append(m.Sent, types.Coin{})
+			if err := m.Sent[len(m.Sent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3430,10 +5125,8 @@ func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &types.Result{}
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Received = append(m.Received, types.Coin{})
+			if err := m.Received[len(m.Received)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3904,7 +5597,263 @@ func (m *GetBlockWithTxsResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxDecodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxDecodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxDecodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxDecodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxDecodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxDecodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &Tx{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxEncodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxEncodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxEncodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3931,10 +5880,10 @@ func (m *GetBlockWithTxsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Tx == nil {
+				m.Tx = &Tx{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3959,7 +5908,7 @@ func (m *GetBlockWithTxsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxDecodeRequest) Unmarshal(dAtA []byte) error {
+func (m *TxEncodeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3982,10 +5931,10 @@ func (m *TxDecodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxDecodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxEncodeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxDecodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxEncodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4043,7 +5992,7 @@ func (m *TxDecodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxDecodeResponse) Unmarshal(dAtA []byte) error {
+func (m *TxEncodeAminoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4066,17 +6015,17 @@ func (m *TxDecodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxDecodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxEncodeAminoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxDecodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxEncodeAminoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AminoJson", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -4086,27 +6035,23 @@ func (m *TxDecodeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tx == nil {
-				m.Tx = &Tx{}
-			}
-			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AminoJson = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4129,7 +6074,7 @@ func (m *TxDecodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxEncodeRequest) Unmarshal(dAtA []byte) error {
+func (m *TxEncodeAminoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4152,17 +6097,17 @@ func (m *TxEncodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxEncodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxEncodeAminoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxEncodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxEncodeAminoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AminoBinary", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -4172,26 +6117,24 @@ func (m *TxEncodeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tx == nil {
-				m.Tx = &Tx{}
-			}
-			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AminoBinary = append(m.AminoBinary[:0], dAtA[iNdEx:postIndex]...)
+			if m.AminoBinary == nil {
+				m.AminoBinary = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4215,7 +6158,7 @@ func (m *TxEncodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxEncodeResponse) Unmarshal(dAtA []byte) error {
+func (m *TxDecodeAminoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4238,15 +6181,15 @@ func (m *TxEncodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxEncodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxDecodeAminoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxEncodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxDecodeAminoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AminoBinary", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4273,9 +6216,9 @@ func (m *TxEncodeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.TxBytes == nil {
-				m.TxBytes = []byte{}
+			m.AminoBinary = append(m.AminoBinary[:0], dAtA[iNdEx:postIndex]...)
+			if m.AminoBinary == nil {
+				m.AminoBinary = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4299,7 +6242,7 @@ func (m *TxEncodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxEncodeAminoRequest) Unmarshal(dAtA []byte) error {
+func (m *TxDecodeAminoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4322,10 +6265,10 @@ func (m *TxEncodeAminoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxEncodeAminoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxDecodeAminoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxEncodeAminoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxDecodeAminoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4381,7 +6324,7 @@ func (m *TxEncodeAminoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxEncodeAminoResponse) Unmarshal(dAtA []byte) error {
+func (m *GasPricesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4404,17 +6347,17 @@ func (m *TxEncodeAminoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxEncodeAminoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasPricesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxEncodeAminoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AminoBinary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -4424,25 +6367,23 @@ func (m *TxEncodeAminoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AminoBinary = append(m.AminoBinary[:0], dAtA[iNdEx:postIndex]...)
-			if m.AminoBinary == nil {
-				m.AminoBinary = []byte{}
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4465,7 +6406,7 @@ func (m *TxEncodeAminoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxDecodeAminoRequest) Unmarshal(dAtA []byte) error {
+func (m *GasPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4488,17 +6429,17 @@ func (m *TxDecodeAminoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxDecodeAminoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxDecodeAminoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AminoBinary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -4508,26 +6449,45 @@ func (m *TxDecodeAminoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AminoBinary = append(m.AminoBinary[:0], dAtA[iNdEx:postIndex]...)
-			if m.AminoBinary == nil {
-				m.AminoBinary = []byte{}
+			m.GasPrices = append(m.GasPrices, GasPriceEstimate{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -4549,7 +6509,7 @@ func (m *TxDecodeAminoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxDecodeAminoResponse) Unmarshal(dAtA []byte) error {
+func (m *GasPriceEstimate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4572,15 +6532,15 @@ func (m *TxDecodeAminoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxDecodeAminoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasPriceEstimate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxDecodeAminoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasPriceEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AminoJson", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4608,8 +6568,129 @@ func (m *TxDecodeAminoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AminoJson = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Normal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Normal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fast", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fast.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			m.Samples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Samples |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -54,6 +54,15 @@ func SignatureDataToProto(data SignatureData) *SignatureDescriptor_Data {
 				},
 			},
 		}
+	case *AggregateSignatureData:
+		return &SignatureDescriptor_Data{
+			Sum: &SignatureDescriptor_Data_Aggregate_{
+				Aggregate: &SignatureDescriptor_Data_Aggregate{
+					Mode:      data.SignMode,
+					Signature: data.Signature,
+				},
+			},
+		}
 	default:
 		panic(fmt.Errorf("unexpected case %+v", data))
 	}
@@ -81,6 +90,11 @@ func SignatureDataFromProto(descData *SignatureDescriptor_Data) SignatureData {
 			BitArray:   multi.Bitarray,
 			Signatures: datas,
 		}
+	case *SignatureDescriptor_Data_Aggregate_:
+		return &AggregateSignatureData{
+			SignMode:  descData.Aggregate.Mode,
+			Signature: descData.Aggregate.Signature,
+		}
 	default:
 		panic(fmt.Errorf("unexpected case %+v", descData))
 	}
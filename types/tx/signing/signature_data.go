@@ -4,7 +4,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 )
 
-// SignatureData represents either a *SingleSignatureData or *MultiSignatureData.
+// SignatureData represents either a *SingleSignatureData, *MultiSignatureData
+// or *AggregateSignatureData.
 // It is a convenience type that is easier to use in business logic than the encoded
 // protobuf ModeInfo's and raw signatures.
 type SignatureData interface {
@@ -30,7 +31,20 @@ type MultiSignatureData struct {
 	Signatures []SignatureData
 }
 
-var _, _ SignatureData = &SingleSignatureData{}, &MultiSignatureData{}
+// AggregateSignatureData represents the signature of a BLS12-381 signer which
+// is aggregated in a single signature for all the aggregate signers of a tx.
+// The aggregate signature is carried by the first aggregate signer of the tx.
+type AggregateSignatureData struct {
+	// SignMode represents the SignMode of the signature
+	SignMode SignMode
+
+	// Signature is the aggregate signature of the tx if this is its first
+	// aggregate signer, and is empty otherwise.
+	Signature []byte
+}
+
+var _, _, _ SignatureData = &SingleSignatureData{}, &MultiSignatureData{}, &AggregateSignatureData{}
 
-func (m *SingleSignatureData) isSignatureData() {}
-func (m *MultiSignatureData) isSignatureData()  {}
+func (m *SingleSignatureData) isSignatureData()    {}
+func (m *MultiSignatureData) isSignatureData()     {}
+func (m *AggregateSignatureData) isSignatureData() {}
@@ -5,6 +5,7 @@ package signing
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/crypto/types"
 	proto "github.com/cosmos/gogoproto/proto"
 	any "github.com/cosmos/gogoproto/types/any"
@@ -67,6 +68,13 @@ const (
 	//
 	// Since: cosmos-sdk 0.45.2
 	SignMode_SIGN_MODE_EIP_191 SignMode = 191
+	// SIGN_MODE_EIP_712 specifies the sign mode for EIP-712 signing on the
+	// Cosmos SDK, which maps the tx body and auth info into EIP-712 typed data
+	// that Ethereum wallets can sign.
+	// Ref: https://eips.ethereum.org/EIPS/eip-712
+	//
+	// Since: cosmos-sdk 0.54
+	SignMode_SIGN_MODE_EIP_712 SignMode = 712
 )
 
 var SignMode_name = map[int32]string{
@@ -76,6 +84,7 @@ var SignMode_name = map[int32]string{
 	3:   "SIGN_MODE_DIRECT_AUX",
 	127: "SIGN_MODE_LEGACY_AMINO_JSON",
 	191: "SIGN_MODE_EIP_191",
+	712: "SIGN_MODE_EIP_712",
 }
 
 var SignMode_value = map[string]int32{
@@ -85,6 +94,7 @@ var SignMode_value = map[string]int32{
 	"SIGN_MODE_DIRECT_AUX":        3,
 	"SIGN_MODE_LEGACY_AMINO_JSON": 127,
 	"SIGN_MODE_EIP_191":           191,
+	"SIGN_MODE_EIP_712":           712,
 }
 
 func (x SignMode) String() string {
@@ -217,6 +227,7 @@ type SignatureDescriptor_Data struct {
 	//
 	//	*SignatureDescriptor_Data_Single_
 	//	*SignatureDescriptor_Data_Multi_
+	//	*SignatureDescriptor_Data_Aggregate_
 	Sum isSignatureDescriptor_Data_Sum `protobuf_oneof:"sum"`
 }
 
@@ -265,9 +276,13 @@ type SignatureDescriptor_Data_Single_ struct {
 type SignatureDescriptor_Data_Multi_ struct {
 	Multi *SignatureDescriptor_Data_Multi `protobuf:"bytes,2,opt,name=multi,proto3,oneof" json:"multi,omitempty"`
 }
+type SignatureDescriptor_Data_Aggregate_ struct {
+	Aggregate *SignatureDescriptor_Data_Aggregate `protobuf:"bytes,3,opt,name=aggregate,proto3,oneof" json:"aggregate,omitempty"`
+}
 
-func (*SignatureDescriptor_Data_Single_) isSignatureDescriptor_Data_Sum() {}
-func (*SignatureDescriptor_Data_Multi_) isSignatureDescriptor_Data_Sum()  {}
+func (*SignatureDescriptor_Data_Single_) isSignatureDescriptor_Data_Sum()    {}
+func (*SignatureDescriptor_Data_Multi_) isSignatureDescriptor_Data_Sum()     {}
+func (*SignatureDescriptor_Data_Aggregate_) isSignatureDescriptor_Data_Sum() {}
 
 func (m *SignatureDescriptor_Data) GetSum() isSignatureDescriptor_Data_Sum {
 	if m != nil {
@@ -290,11 +305,19 @@ func (m *SignatureDescriptor_Data) GetMulti() *SignatureDescriptor_Data_Multi {
 	return nil
 }
 
+func (m *SignatureDescriptor_Data) GetAggregate() *SignatureDescriptor_Data_Aggregate {
+	if x, ok := m.GetSum().(*SignatureDescriptor_Data_Aggregate_); ok {
+		return x.Aggregate
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*SignatureDescriptor_Data) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*SignatureDescriptor_Data_Single_)(nil),
 		(*SignatureDescriptor_Data_Multi_)(nil),
+		(*SignatureDescriptor_Data_Aggregate_)(nil),
 	}
 }
 
@@ -408,6 +431,63 @@ func (m *SignatureDescriptor_Data_Multi) GetSignatures() []*SignatureDescriptor_
 	return nil
 }
 
+// Aggregate is the signature data for a BLS12-381 signer whose signature
+// is aggregated with the ones of the other aggregate signers of the tx
+type SignatureDescriptor_Data_Aggregate struct {
+	// mode is the signing mode of the signer
+	Mode SignMode `protobuf:"varint,1,opt,name=mode,proto3,enum=cosmos.tx.signing.v1beta1.SignMode" json:"mode,omitempty"`
+	// signature is the aggregate signature of the tx when carried by this
+	// signer, and is empty otherwise
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *SignatureDescriptor_Data_Aggregate) Reset()         { *m = SignatureDescriptor_Data_Aggregate{} }
+func (m *SignatureDescriptor_Data_Aggregate) String() string { return proto.CompactTextString(m) }
+func (*SignatureDescriptor_Data_Aggregate) ProtoMessage()    {}
+func (*SignatureDescriptor_Data_Aggregate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a54958ff3d0b1b9, []int{1, 0, 2}
+}
+func (m *SignatureDescriptor_Data_Aggregate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignatureDescriptor_Data_Aggregate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignatureDescriptor_Data_Aggregate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignatureDescriptor_Data_Aggregate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignatureDescriptor_Data_Aggregate.Merge(m, src)
+}
+func (m *SignatureDescriptor_Data_Aggregate) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignatureDescriptor_Data_Aggregate) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignatureDescriptor_Data_Aggregate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignatureDescriptor_Data_Aggregate proto.InternalMessageInfo
+
+func (m *SignatureDescriptor_Data_Aggregate) GetMode() SignMode {
+	if m != nil {
+		return m.Mode
+	}
+	return SignMode_SIGN_MODE_UNSPECIFIED
+}
+
+func (m *SignatureDescriptor_Data_Aggregate) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.tx.signing.v1beta1.SignMode", SignMode_name, SignMode_value)
 	proto.RegisterType((*SignatureDescriptors)(nil), "cosmos.tx.signing.v1beta1.SignatureDescriptors")
@@ -415,6 +495,7 @@ func init() {
 	proto.RegisterType((*SignatureDescriptor_Data)(nil), "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data")
 	proto.RegisterType((*SignatureDescriptor_Data_Single)(nil), "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Single")
 	proto.RegisterType((*SignatureDescriptor_Data_Multi)(nil), "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Multi")
+	proto.RegisterType((*SignatureDescriptor_Data_Aggregate)(nil), "cosmos.tx.signing.v1beta1.SignatureDescriptor.Data.Aggregate")
 }
 
 func init() {
@@ -422,43 +503,47 @@ func init() {
 }
 
 var fileDescriptor_9a54958ff3d0b1b9 = []byte{
-	// 573 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0xc7, 0xed, 0x26, 0xad, 0xda, 0xe9, 0xa7, 0x4f, 0x66, 0x49, 0x51, 0x6a, 0x90, 0xa9, 0xca,
-	0x81, 0x0a, 0xa9, 0x6b, 0xa5, 0x3d, 0xa0, 0x72, 0x73, 0x13, 0x93, 0x9a, 0x36, 0x69, 0xb1, 0x53,
-	0xa9, 0x70, 0xb1, 0x6c, 0x67, 0x6b, 0xac, 0xc6, 0x5e, 0xe3, 0x5d, 0xa3, 0xfa, 0xc4, 0x2b, 0xf0,
-	0x12, 0x1c, 0x78, 0x0a, 0x0e, 0x5c, 0x38, 0xf6, 0xc8, 0x11, 0x25, 0xcf, 0xc0, 0x1d, 0xc5, 0x8e,
-	0x93, 0x80, 0x8a, 0x10, 0x39, 0x59, 0x33, 0xf3, 0xdf, 0xdf, 0xfc, 0x57, 0x33, 0x6b, 0x78, 0xec,
-	0x51, 0x16, 0x52, 0xa6, 0xf2, 0x6b, 0x95, 0x05, 0x7e, 0x14, 0x44, 0xbe, 0xfa, 0xae, 0xe1, 0x12,
-	0xee, 0x34, 0xca, 0x18, 0xc7, 0x09, 0xe5, 0x14, 0x6d, 0x16, 0x42, 0xcc, 0xaf, 0x71, 0x59, 0x98,
-	0x08, 0xe5, 0xdd, 0x09, 0xc3, 0x4b, 0xb2, 0x98, 0x53, 0x35, 0x4c, 0x07, 0x3c, 0x60, 0xc1, 0x0c,
-	0x54, 0x26, 0x0a, 0x92, 0xbc, 0xe9, 0x53, 0xea, 0x0f, 0x88, 0x9a, 0x47, 0x6e, 0x7a, 0xa9, 0x3a,
-	0x51, 0x56, 0x94, 0xb6, 0x2f, 0xa1, 0x66, 0x05, 0x7e, 0xe4, 0xf0, 0x34, 0x21, 0x2d, 0xc2, 0xbc,
-	0x24, 0x88, 0x39, 0x4d, 0x18, 0xea, 0x02, 0xb0, 0x32, 0xcf, 0xea, 0xe2, 0x56, 0x65, 0x67, 0x7d,
-	0x0f, 0xe3, 0x3f, 0x3a, 0xc2, 0xb7, 0x40, 0xcc, 0x39, 0xc2, 0xf6, 0x8f, 0x2a, 0xdc, 0xbd, 0x45,
-	0x83, 0xf6, 0x01, 0xe2, 0xd4, 0x1d, 0x04, 0x9e, 0x7d, 0x45, 0xb2, 0xba, 0xb8, 0x25, 0xee, 0xac,
-	0xef, 0xd5, 0x70, 0xe1, 0x17, 0x97, 0x7e, 0xb1, 0x16, 0x65, 0xe6, 0x5a, 0xa1, 0x3b, 0x26, 0x19,
-	0x6a, 0x43, 0xb5, 0xef, 0x70, 0xa7, 0xbe, 0x94, 0xcb, 0xf7, 0xff, 0xcd, 0x16, 0x6e, 0x39, 0xdc,
-	0x31, 0x73, 0x00, 0x92, 0x61, 0x95, 0x91, 0xb7, 0x29, 0x89, 0x3c, 0x52, 0xaf, 0x6c, 0x89, 0x3b,
-	0x55, 0x73, 0x1a, 0xcb, 0x5f, 0x2a, 0x50, 0x1d, 0x4b, 0x51, 0x0f, 0x56, 0x58, 0x10, 0xf9, 0x03,
-	0x32, 0xb1, 0xf7, 0x6c, 0x81, 0x7e, 0xd8, 0xca, 0x09, 0x47, 0x82, 0x39, 0x61, 0xa1, 0x97, 0xb0,
-	0x9c, 0x4f, 0x69, 0x72, 0x89, 0x83, 0x45, 0xa0, 0x9d, 0x31, 0xe0, 0x48, 0x30, 0x0b, 0x92, 0x6c,
-	0xc3, 0x4a, 0xd1, 0x06, 0x3d, 0x85, 0x6a, 0x48, 0xfb, 0x85, 0xe1, 0xff, 0xf7, 0x1e, 0xfd, 0x85,
-	0xdd, 0xa1, 0x7d, 0x62, 0xe6, 0x07, 0xd0, 0x03, 0x58, 0x9b, 0x0e, 0x2d, 0x77, 0xf6, 0x9f, 0x39,
-	0x4b, 0xc8, 0x9f, 0x44, 0x58, 0xce, 0x7b, 0xa2, 0x63, 0x58, 0x75, 0x03, 0xee, 0x24, 0x89, 0x53,
-	0x0e, 0x4d, 0x2d, 0x9b, 0x14, 0x3b, 0x89, 0xa7, 0x2b, 0x58, 0x76, 0x6a, 0xd2, 0x30, 0x76, 0x3c,
-	0x7e, 0x18, 0x70, 0x6d, 0x7c, 0xcc, 0x9c, 0x02, 0x90, 0xf5, 0xcb, 0xae, 0x2d, 0xe5, 0xbb, 0xb6,
-	0xd0, 0x50, 0xe7, 0x30, 0x87, 0xcb, 0x50, 0x61, 0x69, 0xf8, 0xe4, 0xa3, 0x08, 0xab, 0xe5, 0x1d,
-	0xd1, 0x26, 0x6c, 0x58, 0x46, 0xbb, 0x6b, 0x77, 0x4e, 0x5b, 0xba, 0x7d, 0xde, 0xb5, 0xce, 0xf4,
-	0xa6, 0xf1, 0xdc, 0xd0, 0x5b, 0x92, 0x80, 0x6a, 0x20, 0xcd, 0x4a, 0x2d, 0xc3, 0xd4, 0x9b, 0x3d,
-	0x49, 0x44, 0x1b, 0x70, 0x67, 0x96, 0xed, 0xe9, 0x17, 0xbd, 0x73, 0xed, 0x44, 0x5a, 0x42, 0x75,
-	0xa8, 0xfd, 0x2e, 0xb6, 0xb5, 0xf3, 0x0b, 0xa9, 0x82, 0x1e, 0xc2, 0xfd, 0x59, 0xe5, 0x44, 0x6f,
-	0x6b, 0xcd, 0x57, 0xb6, 0xd6, 0x31, 0xba, 0xa7, 0xf6, 0x0b, 0xeb, 0xb4, 0x2b, 0xbd, 0x47, 0xf7,
-	0xe6, 0x89, 0xba, 0x71, 0x66, 0x37, 0x0e, 0x1a, 0xd2, 0x67, 0xf1, 0xb0, 0xfd, 0x75, 0xa8, 0x88,
-	0x37, 0x43, 0x45, 0xfc, 0x3e, 0x54, 0xc4, 0x0f, 0x23, 0x45, 0xb8, 0x19, 0x29, 0xc2, 0xb7, 0x91,
-	0x22, 0xbc, 0xde, 0xf5, 0x03, 0xfe, 0x26, 0x75, 0xb1, 0x47, 0x43, 0xb5, 0x7c, 0xf6, 0xf9, 0x67,
-	0x97, 0xf5, 0xaf, 0x54, 0x9e, 0xc5, 0x64, 0xfe, 0x5f, 0xe2, 0xae, 0xe4, 0x8f, 0x66, 0xff, 0x67,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x02, 0x3d, 0xad, 0x03, 0x67, 0x04, 0x00, 0x00,
+	// 639 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xed, 0xe6, 0x43, 0xcd, 0x14, 0x81, 0xd9, 0xa6, 0x28, 0x35, 0x28, 0x44, 0xe5, 0x40,
+	0x85, 0x94, 0x35, 0x49, 0x41, 0x55, 0x2b, 0x71, 0x70, 0x12, 0x93, 0x86, 0x36, 0x69, 0x71, 0x52,
+	0xa9, 0x70, 0xb1, 0x1c, 0x67, 0x6b, 0xac, 0x26, 0x76, 0xf0, 0xae, 0xa1, 0x39, 0xf1, 0x0a, 0xbc,
+	0x06, 0x37, 0x0e, 0x5c, 0x11, 0xd7, 0x1e, 0x2b, 0x4e, 0x88, 0x13, 0x4a, 0x5f, 0x04, 0xc5, 0xce,
+	0x26, 0x81, 0x16, 0x21, 0x22, 0x71, 0x9c, 0x99, 0xff, 0xfe, 0x66, 0x67, 0x76, 0x76, 0xe0, 0xbe,
+	0xe5, 0xd1, 0x9e, 0x47, 0x15, 0x76, 0xaa, 0x50, 0xc7, 0x76, 0x1d, 0xd7, 0x56, 0xde, 0x14, 0xda,
+	0x84, 0x99, 0x05, 0x6e, 0xe3, 0xbe, 0xef, 0x31, 0x0f, 0xad, 0x46, 0x42, 0xcc, 0x4e, 0x31, 0x0f,
+	0x8c, 0x85, 0x72, 0x7e, 0xcc, 0xb0, 0xfc, 0x41, 0x9f, 0x79, 0x4a, 0x2f, 0xe8, 0x32, 0x87, 0x3a,
+	0x53, 0x10, 0x77, 0x44, 0x24, 0x79, 0xd5, 0xf6, 0x3c, 0xbb, 0x4b, 0x94, 0xd0, 0x6a, 0x07, 0xc7,
+	0x8a, 0xe9, 0x0e, 0x78, 0x28, 0x22, 0x19, 0xa1, 0xa5, 0x8c, 0x33, 0x86, 0xc6, 0xda, 0x31, 0xa4,
+	0x9b, 0x8e, 0xed, 0x9a, 0x2c, 0xf0, 0x49, 0x85, 0x50, 0xcb, 0x77, 0xfa, 0xcc, 0xf3, 0x29, 0x6a,
+	0x00, 0x50, 0xee, 0xa7, 0x19, 0x31, 0x17, 0x5b, 0x5f, 0x2a, 0x62, 0xfc, 0xc7, 0xcb, 0xe2, 0x2b,
+	0x20, 0xfa, 0x0c, 0x61, 0x6d, 0x98, 0x84, 0xe5, 0x2b, 0x34, 0x68, 0x03, 0xa0, 0x1f, 0xb4, 0xbb,
+	0x8e, 0x65, 0x9c, 0x90, 0x41, 0x46, 0xcc, 0x89, 0xeb, 0x4b, 0xc5, 0x34, 0x8e, 0x4a, 0xc1, 0xbc,
+	0x14, 0xac, 0xba, 0x03, 0x3d, 0x15, 0xe9, 0x76, 0xc9, 0x00, 0x55, 0x21, 0xde, 0x31, 0x99, 0x99,
+	0x59, 0x08, 0xe5, 0x1b, 0xff, 0x76, 0x2d, 0x5c, 0x31, 0x99, 0xa9, 0x87, 0x00, 0x24, 0xc3, 0x22,
+	0x25, 0xaf, 0x03, 0xe2, 0x5a, 0x24, 0x13, 0xcb, 0x89, 0xeb, 0x71, 0x7d, 0x62, 0xcb, 0x1f, 0x13,
+	0x10, 0x1f, 0x49, 0x51, 0x0b, 0x92, 0xd4, 0x71, 0xed, 0x2e, 0x19, 0x5f, 0x6f, 0x7b, 0x8e, 0x7c,
+	0xb8, 0x19, 0x12, 0x76, 0x04, 0x7d, 0xcc, 0x42, 0xcf, 0x21, 0x11, 0x3e, 0xe0, 0xb8, 0x88, 0xad,
+	0x79, 0xa0, 0xf5, 0x11, 0x60, 0x47, 0xd0, 0x23, 0x12, 0xf2, 0x21, 0x65, 0xda, 0xb6, 0x4f, 0x6c,
+	0x93, 0x45, 0xe5, 0x2c, 0x15, 0x9f, 0xcc, 0x83, 0x55, 0x39, 0xa4, 0xb4, 0xfc, 0xfd, 0x53, 0xfe,
+	0x46, 0x44, 0xc8, 0xd3, 0xce, 0x49, 0xee, 0x21, 0x7e, 0xfc, 0x68, 0x47, 0xd0, 0xa7, 0x69, 0x64,
+	0x03, 0x92, 0x51, 0x69, 0x68, 0x13, 0xe2, 0x3d, 0xaf, 0x13, 0x35, 0xe9, 0x7a, 0xf1, 0xde, 0x5f,
+	0x12, 0xd7, 0xbd, 0x0e, 0xd1, 0xc3, 0x03, 0xe8, 0x0e, 0xa4, 0x26, 0x83, 0x12, 0x76, 0xe3, 0x9a,
+	0x3e, 0x75, 0xc8, 0x1f, 0x44, 0x48, 0x84, 0x75, 0xa2, 0x5d, 0x58, 0x6c, 0x3b, 0xcc, 0xf4, 0x7d,
+	0x93, 0x0f, 0x8a, 0xc2, 0x93, 0x44, 0x5f, 0x04, 0x4f, 0x7e, 0x04, 0xcf, 0x54, 0xf6, 0x7a, 0x7d,
+	0xd3, 0x62, 0x25, 0x87, 0xa9, 0xa3, 0x63, 0xfa, 0x04, 0x80, 0x9a, 0xbf, 0xcc, 0xf7, 0x42, 0x2e,
+	0x36, 0xef, 0x20, 0xcd, 0x60, 0xe4, 0xb7, 0x90, 0x9a, 0xf4, 0xee, 0x3f, 0xf5, 0x63, 0x7b, 0xf9,
+	0xeb, 0xe5, 0x27, 0x29, 0x25, 0x20, 0x46, 0x83, 0xde, 0x83, 0xcf, 0x22, 0x2c, 0x72, 0x18, 0x5a,
+	0x85, 0x95, 0x66, 0xad, 0xda, 0x30, 0xea, 0xfb, 0x15, 0xcd, 0x38, 0x6c, 0x34, 0x0f, 0xb4, 0x72,
+	0xed, 0x69, 0x4d, 0xab, 0x48, 0x02, 0x4a, 0x83, 0x34, 0x0d, 0x55, 0x6a, 0xba, 0x56, 0x6e, 0x49,
+	0x22, 0x5a, 0x81, 0x9b, 0x53, 0x6f, 0x4b, 0x3b, 0x6a, 0x1d, 0xaa, 0x7b, 0xd2, 0x02, 0xca, 0x40,
+	0xfa, 0x77, 0xb1, 0xa1, 0x1e, 0x1e, 0x49, 0x31, 0x74, 0x17, 0x6e, 0x4f, 0x23, 0x7b, 0x5a, 0x55,
+	0x2d, 0xbf, 0x30, 0xd4, 0x7a, 0xad, 0xb1, 0x6f, 0x3c, 0x6b, 0xee, 0x37, 0xa4, 0x77, 0xe8, 0xd6,
+	0x2c, 0x51, 0xab, 0x1d, 0x18, 0x85, 0xad, 0x82, 0xf4, 0x45, 0xbc, 0xec, 0xdf, 0x2c, 0x14, 0xa5,
+	0xb3, 0x44, 0xa9, 0x7a, 0x36, 0xcc, 0x8a, 0xe7, 0xc3, 0xac, 0xf8, 0x63, 0x98, 0x15, 0xdf, 0x5f,
+	0x64, 0x85, 0xf3, 0x8b, 0xac, 0xf0, 0xed, 0x22, 0x2b, 0xbc, 0xcc, 0xdb, 0x0e, 0x7b, 0x15, 0xb4,
+	0xb1, 0xe5, 0xf5, 0x14, 0xbe, 0x16, 0x27, 0x3d, 0x50, 0xd8, 0xa0, 0x4f, 0x66, 0x77, 0x6d, 0x3b,
+	0x19, 0x6e, 0x8e, 0x8d, 0x9f, 0x03, 0x00, 0xd3, 0x09, 0x6b, 0x10, 0x87, 0x05, 0x00, 0x00,
 }
 
 func (m *SignatureDescriptors) Marshal() (dAtA []byte, err error) {
@@ -624,6 +709,27 @@ func (m *SignatureDescriptor_Data_Multi_) MarshalToSizedBuffer(dAtA []byte) (int
 	}
 	return len(dAtA) - i, nil
 }
+func (m *SignatureDescriptor_Data_Aggregate_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignatureDescriptor_Data_Aggregate_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Aggregate != nil {
+		{
+			size, err := m.Aggregate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSigning(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *SignatureDescriptor_Data_Single) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -708,6 +814,41 @@ func (m *SignatureDescriptor_Data_Multi) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *SignatureDescriptor_Data_Aggregate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignatureDescriptor_Data_Aggregate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignatureDescriptor_Data_Aggregate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintSigning(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Mode != 0 {
+		i = encodeVarintSigning(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSigning(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSigning(v)
 	base := offset
@@ -790,6 +931,18 @@ func (m *SignatureDescriptor_Data_Multi_) Size() (n int) {
 	}
 	return n
 }
+func (m *SignatureDescriptor_Data_Aggregate_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Aggregate != nil {
+		l = m.Aggregate.Size()
+		n += 1 + l + sovSigning(uint64(l))
+	}
+	return n
+}
 func (m *SignatureDescriptor_Data_Single) Size() (n int) {
 	if m == nil {
 		return 0
@@ -825,6 +978,22 @@ func (m *SignatureDescriptor_Data_Multi) Size() (n int) {
 	return n
 }
 
+func (m *SignatureDescriptor_Data_Aggregate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovSigning(uint64(m.Mode))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovSigning(uint64(l))
+	}
+	return n
+}
+
 func sovSigning(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1155,6 +1324,41 @@ func (m *SignatureDescriptor_Data) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &SignatureDescriptor_Data_Multi_{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SignatureDescriptor_Data_Aggregate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &SignatureDescriptor_Data_Aggregate_{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSigning(dAtA[iNdEx:])
@@ -1399,6 +1603,109 @@ func (m *SignatureDescriptor_Data_Multi) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SignatureDescriptor_Data_Aggregate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSigning
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= SignMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSigning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSigning
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSigning(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSigning
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSigning(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -631,6 +631,7 @@ type ModeInfo struct {
 	//
 	//	*ModeInfo_Single_
 	//	*ModeInfo_Multi_
+	//	*ModeInfo_Aggregate_
 	Sum isModeInfo_Sum `protobuf_oneof:"sum"`
 }
 
@@ -679,9 +680,13 @@ type ModeInfo_Single_ struct {
 type ModeInfo_Multi_ struct {
 	Multi *ModeInfo_Multi `protobuf:"bytes,2,opt,name=multi,proto3,oneof" json:"multi,omitempty"`
 }
+type ModeInfo_Aggregate_ struct {
+	Aggregate *ModeInfo_Aggregate `protobuf:"bytes,3,opt,name=aggregate,proto3,oneof" json:"aggregate,omitempty"`
+}
 
-func (*ModeInfo_Single_) isModeInfo_Sum() {}
-func (*ModeInfo_Multi_) isModeInfo_Sum()  {}
+func (*ModeInfo_Single_) isModeInfo_Sum()    {}
+func (*ModeInfo_Multi_) isModeInfo_Sum()     {}
+func (*ModeInfo_Aggregate_) isModeInfo_Sum() {}
 
 func (m *ModeInfo) GetSum() isModeInfo_Sum {
 	if m != nil {
@@ -704,11 +709,19 @@ func (m *ModeInfo) GetMulti() *ModeInfo_Multi {
 	return nil
 }
 
+func (m *ModeInfo) GetAggregate() *ModeInfo_Aggregate {
+	if x, ok := m.GetSum().(*ModeInfo_Aggregate_); ok {
+		return x.Aggregate
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ModeInfo) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ModeInfo_Single_)(nil),
 		(*ModeInfo_Multi_)(nil),
+		(*ModeInfo_Aggregate_)(nil),
 	}
 }
 
@@ -816,6 +829,55 @@ func (m *ModeInfo_Multi) GetModeInfos() []*ModeInfo {
 	return nil
 }
 
+// Aggregate is the mode info for a BLS12-381 signer whose signature is
+// aggregated in a single signature for all the aggregate signers of the tx.
+// The aggregate signature is carried by the first aggregate signer of the
+// tx, the signatures of the other aggregate signers being empty.
+type ModeInfo_Aggregate struct {
+	// mode is the signing mode of the signer
+	Mode signing.SignMode `protobuf:"varint,1,opt,name=mode,proto3,enum=cosmos.tx.signing.v1beta1.SignMode" json:"mode,omitempty"`
+}
+
+func (m *ModeInfo_Aggregate) Reset()         { *m = ModeInfo_Aggregate{} }
+func (m *ModeInfo_Aggregate) String() string { return proto.CompactTextString(m) }
+func (*ModeInfo_Aggregate) ProtoMessage()    {}
+func (*ModeInfo_Aggregate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96d1575ffde80842, []int{7, 2}
+}
+func (m *ModeInfo_Aggregate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModeInfo_Aggregate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModeInfo_Aggregate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModeInfo_Aggregate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModeInfo_Aggregate.Merge(m, src)
+}
+func (m *ModeInfo_Aggregate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModeInfo_Aggregate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModeInfo_Aggregate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModeInfo_Aggregate proto.InternalMessageInfo
+
+func (m *ModeInfo_Aggregate) GetMode() signing.SignMode {
+	if m != nil {
+		return m.Mode
+	}
+	return signing.SignMode_SIGN_MODE_UNSPECIFIED
+}
+
 // Fee includes the amount of coins paid in fees and the maximum
 // gas to be used by the transaction. The ratio yields an effective "gasprice",
 // which must be above some minimum to be accepted into the mempool.
@@ -1046,6 +1108,7 @@ func init() {
 	proto.RegisterType((*ModeInfo)(nil), "cosmos.tx.v1beta1.ModeInfo")
 	proto.RegisterType((*ModeInfo_Single)(nil), "cosmos.tx.v1beta1.ModeInfo.Single")
 	proto.RegisterType((*ModeInfo_Multi)(nil), "cosmos.tx.v1beta1.ModeInfo.Multi")
+	proto.RegisterType((*ModeInfo_Aggregate)(nil), "cosmos.tx.v1beta1.ModeInfo.Aggregate")
 	proto.RegisterType((*Fee)(nil), "cosmos.tx.v1beta1.Fee")
 	proto.RegisterType((*Tip)(nil), "cosmos.tx.v1beta1.Tip")
 	proto.RegisterType((*AuxSignerData)(nil), "cosmos.tx.v1beta1.AuxSignerData")
@@ -1054,80 +1117,82 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/tx.proto", fileDescriptor_96d1575ffde80842) }
 
 var fileDescriptor_96d1575ffde80842 = []byte{
-	// 1161 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x8f, 0xd3, 0xc6,
-	0x17, 0x8f, 0xe3, 0x24, 0x9b, 0x3c, 0x76, 0x61, 0x77, 0x80, 0xaf, 0x42, 0x56, 0x64, 0xf7, 0x1b,
-	0x44, 0x1b, 0xa1, 0xae, 0x0d, 0x4b, 0x7f, 0x50, 0x54, 0x95, 0x26, 0x50, 0x04, 0xa2, 0xb4, 0x92,
-	0x77, 0x4f, 0x5c, 0xac, 0x89, 0x3d, 0xeb, 0x8c, 0x88, 0x67, 0x5c, 0xcf, 0xb8, 0x8d, 0x8f, 0x3d,
-	0xf5, 0x54, 0x09, 0xf5, 0x52, 0xa9, 0x7f, 0x41, 0xd5, 0x43, 0xc5, 0x01, 0xa9, 0xff, 0x02, 0xbd,
-	0x21, 0x4e, 0x15, 0x07, 0x40, 0xec, 0x81, 0x3f, 0xa3, 0x95, 0xc7, 0x63, 0xef, 0xb2, 0x84, 0x6c,
-	0xab, 0x56, 0xea, 0xc5, 0x9a, 0x79, 0xf3, 0x79, 0x9f, 0xf9, 0xcc, 0x9b, 0xf7, 0xde, 0x18, 0x3a,
-	0x1e, 0x17, 0x21, 0x17, 0xb6, 0x9c, 0xda, 0x5f, 0x5d, 0x18, 0x11, 0x89, 0x2f, 0xd8, 0x72, 0x6a,
-	0x45, 0x31, 0x97, 0x1c, 0xad, 0xe4, 0x6b, 0x96, 0x9c, 0x5a, 0x7a, 0xad, 0xb3, 0x82, 0x43, 0xca,
-	0xb8, 0xad, 0xbe, 0x39, 0xaa, 0x73, 0x22, 0xe0, 0x01, 0x57, 0x43, 0x3b, 0x1b, 0x69, 0xeb, 0x86,
-	0xe6, 0xf5, 0xe2, 0x34, 0x92, 0xdc, 0x0e, 0x93, 0x89, 0xa4, 0x82, 0x06, 0xe5, 0x26, 0x85, 0x41,
-	0xc3, 0xbb, 0x1a, 0x3e, 0xc2, 0x82, 0x94, 0x18, 0x8f, 0x53, 0xa6, 0xd7, 0xdf, 0xde, 0x93, 0x29,
-	0x68, 0xc0, 0x28, 0xdb, 0x63, 0xd2, 0x73, 0x0d, 0x3c, 0x15, 0x70, 0x1e, 0x4c, 0x88, 0xad, 0x66,
-	0xa3, 0x64, 0xc7, 0xc6, 0x2c, 0x2d, 0x96, 0x72, 0x0e, 0x37, 0xd7, 0xaa, 0xcf, 0x96, 0x2f, 0xad,
-	0x1d, 0xf4, 0x92, 0x34, 0x24, 0x42, 0xe2, 0x30, 0xca, 0x01, 0xbd, 0xef, 0x0c, 0xa8, 0x6e, 0x4f,
-	0xd1, 0x06, 0xd4, 0x46, 0xdc, 0x4f, 0xdb, 0xc6, 0xba, 0xd1, 0x3f, 0xb2, 0x79, 0xca, 0x7a, 0x2d,
-	0x40, 0xd6, 0xf6, 0x74, 0xc8, 0xfd, 0xd4, 0x51, 0x30, 0x74, 0x09, 0x5a, 0x38, 0x91, 0x63, 0x97,
-	0xb2, 0x1d, 0xde, 0xae, 0x2a, 0x9f, 0xd5, 0x19, 0x3e, 0x83, 0x44, 0x8e, 0x6f, 0xb2, 0x1d, 0xee,
-	0x34, 0xb1, 0x1e, 0xa1, 0x2e, 0x40, 0x76, 0x2e, 0x2c, 0x93, 0x98, 0x88, 0xb6, 0xb9, 0x6e, 0xf6,
-	0x17, 0x9d, 0x7d, 0x96, 0x1e, 0x83, 0xfa, 0xf6, 0xd4, 0xc1, 0x5f, 0xa3, 0xd3, 0x00, 0xd9, 0x56,
-	0xee, 0x28, 0x95, 0x44, 0x28, 0x5d, 0x8b, 0x4e, 0x2b, 0xb3, 0x0c, 0x33, 0x03, 0x7a, 0x0b, 0x8e,
-	0x95, 0x0a, 0x34, 0xa6, 0xaa, 0x30, 0x4b, 0xc5, 0x56, 0x39, 0xee, 0xb0, 0xfd, 0xbe, 0x37, 0x60,
-	0x61, 0x8b, 0x06, 0xec, 0x1a, 0xf7, 0xfe, 0xad, 0x2d, 0x4f, 0x41, 0xd3, 0x1b, 0x63, 0xca, 0x5c,
-	0xea, 0xb7, 0xcd, 0x75, 0xa3, 0xdf, 0x72, 0x16, 0xd4, 0xfc, 0xa6, 0x8f, 0xce, 0xc2, 0x51, 0xec,
-	0x79, 0x3c, 0x61, 0xd2, 0x65, 0x49, 0x38, 0x22, 0x71, 0xbb, 0xb6, 0x6e, 0xf4, 0x6b, 0xce, 0x92,
-	0xb6, 0x7e, 0xae, 0x8c, 0xbd, 0x6f, 0xab, 0xb0, 0xac, 0x45, 0x5d, 0xa3, 0x31, 0xf1, 0xe4, 0x20,
-	0x99, 0x1e, 0xa6, 0xee, 0x22, 0x40, 0x94, 0x8c, 0x26, 0xd4, 0x73, 0xef, 0x92, 0x54, 0xdf, 0xc9,
-	0x09, 0x2b, 0xbf, 0x7e, 0xab, 0xb8, 0x7e, 0x6b, 0xc0, 0x52, 0xa7, 0x95, 0xe3, 0x6e, 0x91, 0xf4,
-	0x9f, 0x4b, 0x45, 0x1d, 0x68, 0x0a, 0xf2, 0x65, 0x42, 0x98, 0x47, 0xda, 0x75, 0x05, 0x28, 0xe7,
-	0xe8, 0x1d, 0x30, 0x25, 0x8d, 0xda, 0x0d, 0xa5, 0xe5, 0x7f, 0xb3, 0x72, 0x8a, 0x46, 0xc3, 0x6a,
-	0xdb, 0x70, 0x32, 0xd8, 0xe5, 0xe3, 0x8f, 0x1f, 0x6c, 0x1c, 0xcb, 0x31, 0x1b, 0xc2, 0xbf, 0xbb,
-	0x7e, 0xde, 0x7a, 0xf7, 0xfd, 0xde, 0x2f, 0x26, 0x34, 0xf2, 0xcc, 0x43, 0xe7, 0xa1, 0x19, 0x12,
-	0x21, 0x70, 0xa0, 0x4e, 0x6f, 0xbe, 0xf1, 0x78, 0x25, 0x0a, 0x21, 0xa8, 0x85, 0x24, 0xcc, 0x13,
-	0xb4, 0xe5, 0xa8, 0x71, 0x76, 0xac, 0xac, 0x04, 0x78, 0x22, 0xdd, 0x31, 0xa1, 0xc1, 0x58, 0xaa,
-	0x73, 0xd7, 0x9c, 0x25, 0x6d, 0xbd, 0xa1, 0x8c, 0xe8, 0x02, 0xb4, 0x12, 0xc6, 0x63, 0x9f, 0xc4,
-	0xc4, 0x57, 0x07, 0x6f, 0x0e, 0x8f, 0x3f, 0x39, 0xa8, 0xef, 0xbd, 0x8b, 0xce, 0x1e, 0x0a, 0xf9,
-	0xb0, 0x52, 0x30, 0x97, 0x45, 0xa6, 0x42, 0x72, 0x64, 0xb3, 0xf3, 0x9a, 0xd0, 0xed, 0x02, 0x31,
-	0x5c, 0x7d, 0xf8, 0x74, 0xcd, 0xb8, 0xf7, 0x6c, 0xcd, 0x98, 0x45, 0xbf, 0xac, 0x19, 0x4b, 0x38,
-	0x1a, 0xc2, 0x0a, 0x99, 0x4a, 0xc2, 0x04, 0xe5, 0xcc, 0xe5, 0x91, 0xa4, 0x9c, 0x89, 0xf6, 0x1f,
-	0x0b, 0x73, 0xe2, 0xb1, 0x5c, 0xe2, 0xbf, 0xc8, 0xe1, 0xe8, 0x0e, 0x74, 0x19, 0x67, 0xae, 0x17,
-	0x53, 0x49, 0x3d, 0x3c, 0x71, 0x67, 0x10, 0x1e, 0x9b, 0x43, 0xb8, 0xca, 0x38, 0xbb, 0xaa, 0x7d,
-	0x3f, 0x3d, 0xc0, 0xdd, 0xfb, 0xd5, 0x80, 0x66, 0x51, 0xf6, 0xe8, 0x13, 0x58, 0xcc, 0x4a, 0x8d,
-	0xc4, 0xaa, 0x66, 0x8a, 0x6b, 0x3b, 0x3d, 0x23, 0x13, 0xb6, 0x14, 0x4c, 0xf5, 0x8a, 0x23, 0xa2,
-	0x1c, 0x0b, 0xd4, 0x07, 0x73, 0x87, 0x10, 0x9d, 0xce, 0xb3, 0x52, 0xe8, 0x3a, 0x21, 0x4e, 0x06,
-	0x41, 0x57, 0xf2, 0x64, 0x33, 0xe7, 0x26, 0xdb, 0xc9, 0x27, 0xaf, 0xe7, 0x98, 0xce, 0xbf, 0xde,
-	0x0f, 0x06, 0xc0, 0x9e, 0x8c, 0x03, 0xf5, 0x64, 0xfc, 0xb5, 0x7a, 0xba, 0x04, 0xad, 0x90, 0xfb,
-	0xe4, 0xb0, 0xbe, 0x78, 0x9b, 0xfb, 0x24, 0xef, 0x8b, 0xa1, 0x1e, 0xbd, 0x52, 0x47, 0xe6, 0xab,
-	0x75, 0xd4, 0x7b, 0x5e, 0x85, 0x66, 0xe1, 0x82, 0x3e, 0x82, 0x86, 0xa0, 0x2c, 0x98, 0x10, 0xad,
-	0xa9, 0x37, 0x87, 0xdf, 0xda, 0x52, 0xc8, 0x1b, 0x15, 0x47, 0xfb, 0xa0, 0x0f, 0xa1, 0xae, 0x1e,
-	0x28, 0x2d, 0xee, 0xff, 0xf3, 0x9c, 0x6f, 0x67, 0xc0, 0x1b, 0x15, 0x27, 0xf7, 0xe8, 0x0c, 0xa0,
-	0x91, 0xd3, 0xa1, 0x0f, 0xa0, 0x96, 0xe9, 0x56, 0x02, 0x8e, 0x6e, 0x9e, 0xd9, 0xc7, 0x51, 0x3c,
-	0x59, 0xfb, 0xaf, 0x35, 0xe3, 0x73, 0x94, 0x43, 0xe7, 0x9e, 0x01, 0x75, 0xc5, 0x8a, 0x6e, 0x41,
-	0x73, 0x44, 0x25, 0x8e, 0x63, 0x5c, 0xc4, 0xd6, 0x2e, 0x68, 0xf2, 0x87, 0xd5, 0x2a, 0xdf, 0xd1,
-	0x82, 0xeb, 0x2a, 0x0f, 0x23, 0xec, 0xc9, 0x21, 0x95, 0x83, 0xcc, 0xcd, 0x29, 0x09, 0xd0, 0x65,
-	0x80, 0x32, 0xea, 0x59, 0x4f, 0x36, 0x0f, 0x0b, 0x7b, 0xab, 0x08, 0xbb, 0x18, 0xd6, 0xc1, 0x14,
-	0x49, 0xd8, 0xfb, 0xa6, 0x0a, 0xe6, 0x75, 0x42, 0x50, 0x0a, 0x0d, 0x1c, 0x66, 0xed, 0x4d, 0xe7,
-	0x6a, 0xf9, 0x12, 0x66, 0xef, 0xf7, 0x3e, 0x29, 0x94, 0x0d, 0xaf, 0x3f, 0x7c, 0xba, 0x56, 0xf9,
-	0xf9, 0xd9, 0x5a, 0x3f, 0xa0, 0x72, 0x9c, 0x8c, 0x2c, 0x8f, 0x87, 0x76, 0xf1, 0x6f, 0x50, 0x66,
-	0x98, 0x2d, 0xd3, 0x88, 0x08, 0xe5, 0x20, 0x7e, 0x7c, 0x79, 0xff, 0xdc, 0xe2, 0x84, 0x04, 0xd8,
-	0x4b, 0xdd, 0xec, 0x0f, 0x40, 0xfc, 0xf4, 0xf2, 0xfe, 0x39, 0xc3, 0xd1, 0x1b, 0xa2, 0x55, 0x68,
-	0x05, 0x58, 0xb8, 0x13, 0x1a, 0x52, 0xa9, 0xae, 0xa7, 0xe6, 0x34, 0x03, 0x2c, 0x3e, 0xcb, 0xe6,
-	0xc8, 0x82, 0x7a, 0x84, 0x53, 0x12, 0xe7, 0x5d, 0x7a, 0xd8, 0x7e, 0xfc, 0x60, 0xe3, 0x84, 0x56,
-	0x36, 0xf0, 0xfd, 0x98, 0x08, 0xb1, 0x25, 0x63, 0xca, 0x02, 0x27, 0x87, 0xa1, 0x4d, 0x58, 0x08,
-	0x62, 0xcc, 0xa4, 0x6e, 0xdb, 0xf3, 0x3c, 0x0a, 0x60, 0xef, 0x37, 0x03, 0xcc, 0x6d, 0x1a, 0xfd,
-	0x97, 0x31, 0x38, 0x0f, 0x0d, 0x49, 0xa3, 0x88, 0xc4, 0x79, 0xcf, 0x9e, 0xa3, 0x5a, 0xe3, 0x2e,
-	0x9f, 0x7c, 0x3c, 0xab, 0xa2, 0x7b, 0xbb, 0x06, 0x2c, 0x0d, 0x92, 0x69, 0x5e, 0xcf, 0xd7, 0xb0,
-	0xc4, 0x59, 0x44, 0x70, 0xce, 0xa0, 0x12, 0x6e, 0x6e, 0x44, 0x34, 0x10, 0x7d, 0x0c, 0xcd, 0x2c,
-	0xa3, 0x5d, 0x9f, 0x7b, 0xba, 0x60, 0xce, 0xbc, 0xa1, 0x77, 0xed, 0x7f, 0xa9, 0x9d, 0x05, 0xa1,
-	0x7f, 0x28, 0x8a, 0x42, 0x31, 0xff, 0x66, 0xa1, 0xa0, 0x65, 0x30, 0x05, 0x0d, 0xd4, 0xd5, 0x2d,
-	0x3a, 0xd9, 0x70, 0xe6, 0xeb, 0x38, 0xbc, 0xf2, 0xf0, 0x45, 0xd7, 0x78, 0xf4, 0xa2, 0x6b, 0x3c,
-	0x7f, 0xd1, 0x35, 0xee, 0xed, 0x76, 0x2b, 0x8f, 0x76, 0xbb, 0x95, 0xdf, 0x77, 0xbb, 0x95, 0x3b,
-	0x67, 0x0f, 0xbf, 0x10, 0x5b, 0x4e, 0x47, 0x0d, 0xd5, 0xc8, 0x2e, 0xfe, 0x19, 0x00, 0x00, 0xff,
-	0xff, 0x84, 0x6f, 0x9f, 0x30, 0x2d, 0x0b, 0x00, 0x00,
+	// 1197 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0xd4, 0xc6,
+	0x17, 0x5f, 0xaf, 0x37, 0x9b, 0xdd, 0x47, 0x02, 0xc9, 0x00, 0x5f, 0x99, 0x8d, 0xd8, 0xe4, 0xbb,
+	0x88, 0x36, 0x42, 0x8d, 0x17, 0x02, 0xb4, 0x14, 0x55, 0xa5, 0xbb, 0x50, 0x14, 0x44, 0x69, 0x25,
+	0x27, 0x97, 0x72, 0xb1, 0x66, 0xed, 0x89, 0x33, 0x62, 0x3d, 0xe3, 0x7a, 0xc6, 0xed, 0xfa, 0xd8,
+	0x53, 0x4f, 0x95, 0x50, 0x2f, 0x48, 0xfd, 0x0b, 0xaa, 0x1e, 0x2a, 0x0e, 0x48, 0xfd, 0x17, 0xe8,
+	0x0d, 0x71, 0xaa, 0x38, 0x40, 0x45, 0x0e, 0xfc, 0x19, 0xad, 0x3c, 0x1e, 0x3b, 0x21, 0x6c, 0x76,
+	0xfb, 0x4b, 0xea, 0xc5, 0x9a, 0x79, 0xf3, 0x79, 0xef, 0x7d, 0xde, 0xbc, 0x1f, 0x63, 0x68, 0x79,
+	0x5c, 0x84, 0x5c, 0x74, 0xe5, 0xa8, 0xfb, 0xe5, 0x85, 0x01, 0x91, 0xf8, 0x42, 0x57, 0x8e, 0xec,
+	0x28, 0xe6, 0x92, 0xa3, 0xc5, 0xfc, 0xcc, 0x96, 0x23, 0x5b, 0x9f, 0xb5, 0x16, 0x71, 0x48, 0x19,
+	0xef, 0xaa, 0x6f, 0x8e, 0x6a, 0x9d, 0x08, 0x78, 0xc0, 0xd5, 0xb2, 0x9b, 0xad, 0xb4, 0x74, 0x4d,
+	0xdb, 0xf5, 0xe2, 0x34, 0x92, 0xbc, 0x1b, 0x26, 0x43, 0x49, 0x05, 0x0d, 0x4a, 0x27, 0x85, 0x40,
+	0xc3, 0xdb, 0x1a, 0x3e, 0xc0, 0x82, 0x94, 0x18, 0x8f, 0x53, 0xa6, 0xcf, 0xdf, 0xde, 0xa3, 0x29,
+	0x68, 0xc0, 0x28, 0xdb, 0xb3, 0xa4, 0xf7, 0x1a, 0x78, 0x2a, 0xe0, 0x3c, 0x18, 0x92, 0xae, 0xda,
+	0x0d, 0x92, 0xed, 0x2e, 0x66, 0x69, 0x71, 0x94, 0xdb, 0x70, 0x73, 0xae, 0x3a, 0xb6, 0xfc, 0x68,
+	0xf9, 0xa0, 0x96, 0xa4, 0x21, 0x11, 0x12, 0x87, 0x51, 0x0e, 0xe8, 0x7c, 0x6b, 0x40, 0x75, 0x6b,
+	0x84, 0xd6, 0xa0, 0x36, 0xe0, 0x7e, 0x6a, 0x19, 0x2b, 0xc6, 0xea, 0x91, 0xf5, 0x53, 0xf6, 0x1b,
+	0x17, 0x64, 0x6f, 0x8d, 0xfa, 0xdc, 0x4f, 0x1d, 0x05, 0x43, 0x57, 0xa0, 0x89, 0x13, 0xb9, 0xe3,
+	0x52, 0xb6, 0xcd, 0xad, 0xaa, 0xd2, 0x59, 0x1a, 0xa3, 0xd3, 0x4b, 0xe4, 0xce, 0x2d, 0xb6, 0xcd,
+	0x9d, 0x06, 0xd6, 0x2b, 0xd4, 0x06, 0xc8, 0xe2, 0xc2, 0x32, 0x89, 0x89, 0xb0, 0xcc, 0x15, 0x73,
+	0x75, 0xce, 0xd9, 0x27, 0xe9, 0x30, 0x98, 0xd9, 0x1a, 0x39, 0xf8, 0x2b, 0x74, 0x1a, 0x20, 0x73,
+	0xe5, 0x0e, 0x52, 0x49, 0x84, 0xe2, 0x35, 0xe7, 0x34, 0x33, 0x49, 0x3f, 0x13, 0xa0, 0xb7, 0xe0,
+	0x58, 0xc9, 0x40, 0x63, 0xaa, 0x0a, 0x33, 0x5f, 0xb8, 0xca, 0x71, 0xd3, 0xfc, 0x7d, 0x67, 0xc0,
+	0xec, 0x26, 0x0d, 0xd8, 0x0d, 0xee, 0xfd, 0x5b, 0x2e, 0x4f, 0x41, 0xc3, 0xdb, 0xc1, 0x94, 0xb9,
+	0xd4, 0xb7, 0xcc, 0x15, 0x63, 0xb5, 0xe9, 0xcc, 0xaa, 0xfd, 0x2d, 0x1f, 0x9d, 0x85, 0xa3, 0xd8,
+	0xf3, 0x78, 0xc2, 0xa4, 0xcb, 0x92, 0x70, 0x40, 0x62, 0xab, 0xb6, 0x62, 0xac, 0xd6, 0x9c, 0x79,
+	0x2d, 0xfd, 0x54, 0x09, 0x3b, 0xdf, 0x54, 0x61, 0x41, 0x93, 0xba, 0x41, 0x63, 0xe2, 0xc9, 0x5e,
+	0x32, 0x9a, 0xc6, 0xee, 0x22, 0x40, 0x94, 0x0c, 0x86, 0xd4, 0x73, 0xef, 0x91, 0x54, 0xe7, 0xe4,
+	0x84, 0x9d, 0xa7, 0xdf, 0x2e, 0xd2, 0x6f, 0xf7, 0x58, 0xea, 0x34, 0x73, 0xdc, 0x6d, 0x92, 0xfe,
+	0x73, 0xaa, 0xa8, 0x05, 0x0d, 0x41, 0xbe, 0x48, 0x08, 0xf3, 0x88, 0x35, 0xa3, 0x00, 0xe5, 0x1e,
+	0xbd, 0x03, 0xa6, 0xa4, 0x91, 0x55, 0x57, 0x5c, 0xfe, 0x37, 0xae, 0xa6, 0x68, 0xd4, 0xaf, 0x5a,
+	0x86, 0x93, 0xc1, 0xae, 0x1e, 0x7f, 0xfa, 0x68, 0xed, 0x58, 0x8e, 0x59, 0x13, 0xfe, 0xbd, 0x95,
+	0xf3, 0xf6, 0xa5, 0x77, 0x3b, 0x3f, 0x99, 0x50, 0xcf, 0x2b, 0x0f, 0x9d, 0x87, 0x46, 0x48, 0x84,
+	0xc0, 0x81, 0x8a, 0xde, 0x3c, 0x34, 0xbc, 0x12, 0x85, 0x10, 0xd4, 0x42, 0x12, 0xe6, 0x05, 0xda,
+	0x74, 0xd4, 0x3a, 0x0b, 0x2b, 0x6b, 0x01, 0x9e, 0x48, 0x77, 0x87, 0xd0, 0x60, 0x47, 0xaa, 0xb8,
+	0x6b, 0xce, 0xbc, 0x96, 0x6e, 0x28, 0x21, 0xba, 0x00, 0xcd, 0x84, 0xf1, 0xd8, 0x27, 0x31, 0xf1,
+	0x55, 0xe0, 0x8d, 0xfe, 0xf1, 0x67, 0x07, 0xf9, 0x5d, 0xbe, 0xe8, 0xec, 0xa1, 0x90, 0x0f, 0x8b,
+	0x85, 0xe5, 0xb2, 0xc9, 0xd4, 0x95, 0x1c, 0x59, 0x6f, 0xbd, 0x41, 0x74, 0xab, 0x40, 0xf4, 0x97,
+	0x1e, 0x3f, 0x5f, 0x36, 0xee, 0xbf, 0x58, 0x36, 0xc6, 0x99, 0x5f, 0xd0, 0x16, 0x4b, 0x38, 0xea,
+	0xc3, 0x22, 0x19, 0x49, 0xc2, 0x04, 0xe5, 0xcc, 0xe5, 0x91, 0xa4, 0x9c, 0x09, 0xeb, 0xf7, 0xd9,
+	0x09, 0xf7, 0xb1, 0x50, 0xe2, 0x3f, 0xcb, 0xe1, 0xe8, 0x2e, 0xb4, 0x19, 0x67, 0xae, 0x17, 0x53,
+	0x49, 0x3d, 0x3c, 0x74, 0xc7, 0x18, 0x3c, 0x36, 0xc1, 0xe0, 0x12, 0xe3, 0xec, 0xba, 0xd6, 0xfd,
+	0xf8, 0x80, 0xed, 0xce, 0xcf, 0x06, 0x34, 0x8a, 0xb6, 0x47, 0x1f, 0xc1, 0x5c, 0xd6, 0x6a, 0x24,
+	0x56, 0x3d, 0x53, 0xa4, 0xed, 0xf4, 0x98, 0x4a, 0xd8, 0x54, 0x30, 0x35, 0x2b, 0x8e, 0x88, 0x72,
+	0x2d, 0xd0, 0x2a, 0x98, 0xdb, 0x84, 0x58, 0xd5, 0x43, 0x4b, 0xe8, 0x26, 0x21, 0x4e, 0x06, 0x41,
+	0xd7, 0xf2, 0x62, 0x33, 0x27, 0x16, 0xdb, 0xc9, 0x67, 0x6f, 0xd6, 0x98, 0xae, 0xbf, 0xce, 0x03,
+	0x03, 0x60, 0x8f, 0xc6, 0x81, 0x7e, 0x32, 0xfe, 0x5c, 0x3f, 0x5d, 0x81, 0x66, 0xc8, 0x7d, 0x32,
+	0x6d, 0x2e, 0xde, 0xe1, 0x3e, 0xc9, 0xe7, 0x62, 0xa8, 0x57, 0xaf, 0xf5, 0x91, 0xf9, 0x7a, 0x1f,
+	0x75, 0x1e, 0xd4, 0xa0, 0x51, 0xa8, 0xa0, 0x0f, 0xa0, 0x2e, 0x28, 0x0b, 0x86, 0x44, 0x73, 0xea,
+	0x4c, 0xb0, 0x6f, 0x6f, 0x2a, 0xe4, 0x46, 0xc5, 0xd1, 0x3a, 0xe8, 0x7d, 0x98, 0x51, 0x0f, 0x94,
+	0x26, 0xf7, 0xff, 0x49, 0xca, 0x77, 0x32, 0xe0, 0x46, 0xc5, 0xc9, 0x35, 0xd0, 0x5d, 0x68, 0xe2,
+	0x20, 0x88, 0x49, 0x80, 0x25, 0xd1, 0xd7, 0x7c, 0x76, 0x92, 0x7a, 0xaf, 0x00, 0x8f, 0xeb, 0x9c,
+	0x4b, 0x1b, 0x15, 0x67, 0xcf, 0x5c, 0xab, 0x07, 0xf5, 0x9c, 0x2a, 0x7a, 0x0f, 0x6a, 0xd9, 0x9d,
+	0xa8, 0xe0, 0x8e, 0xae, 0x9f, 0xd9, 0xe7, 0xa0, 0x78, 0x0e, 0xf7, 0x97, 0x4c, 0xe6, 0xcc, 0x51,
+	0x0a, 0xad, 0xfb, 0x06, 0xcc, 0x28, 0xc6, 0xe8, 0x36, 0x34, 0x06, 0x54, 0xe2, 0x38, 0xc6, 0x45,
+	0xde, 0xba, 0x85, 0x99, 0xfc, 0xd1, 0xb6, 0xcb, 0x37, 0xba, 0xb0, 0x75, 0x9d, 0x87, 0x11, 0xf6,
+	0x64, 0x9f, 0xca, 0x5e, 0xa6, 0xe6, 0x94, 0x06, 0xd0, 0x55, 0x80, 0x32, 0xa3, 0xd9, 0xbc, 0x37,
+	0xa7, 0xa5, 0xb4, 0x59, 0xa4, 0x54, 0xb4, 0x3e, 0x87, 0x66, 0x79, 0x09, 0x7f, 0x3b, 0xb0, 0x31,
+	0x73, 0xf1, 0xf2, 0xa5, 0xfe, 0x0c, 0x98, 0x22, 0x09, 0x3b, 0x5f, 0x57, 0xc1, 0xbc, 0x49, 0x08,
+	0x4a, 0xa1, 0x8e, 0xc3, 0x6c, 0x2a, 0xeb, 0x16, 0x2b, 0x1f, 0xf0, 0xec, 0xb7, 0x63, 0x5f, 0x94,
+	0x94, 0xf5, 0x6f, 0x3e, 0x7e, 0xbe, 0x5c, 0xf9, 0xf1, 0xc5, 0xf2, 0x6a, 0x40, 0xe5, 0x4e, 0x32,
+	0xb0, 0x3d, 0x1e, 0x76, 0x8b, 0x5f, 0x9a, 0xd2, 0x49, 0x57, 0xa6, 0x11, 0x11, 0x4a, 0x41, 0x7c,
+	0xff, 0xea, 0xe1, 0xb9, 0xb9, 0x21, 0x09, 0xb0, 0x97, 0xba, 0xd9, 0x8f, 0x8b, 0xf8, 0xe1, 0xd5,
+	0xc3, 0x73, 0x86, 0xa3, 0x1d, 0xa2, 0x25, 0x68, 0x06, 0x58, 0xb8, 0x43, 0x1a, 0x52, 0xa9, 0xaa,
+	0xaa, 0xe6, 0x34, 0x02, 0x2c, 0x3e, 0xc9, 0xf6, 0xc8, 0x86, 0x99, 0x08, 0xa7, 0x24, 0xce, 0x1f,
+	0x97, 0xbe, 0xf5, 0xf4, 0xd1, 0xda, 0x09, 0xcd, 0xac, 0xe7, 0xfb, 0x31, 0x11, 0x62, 0x53, 0xc6,
+	0x94, 0x05, 0x4e, 0x0e, 0x43, 0xeb, 0x30, 0x1b, 0xc4, 0x98, 0x49, 0xfd, 0xda, 0x4c, 0xd2, 0x28,
+	0x80, 0x9d, 0x5f, 0x0c, 0x30, 0xb7, 0x68, 0xf4, 0x5f, 0xde, 0xc1, 0x79, 0xa8, 0x4b, 0x1a, 0x45,
+	0x24, 0xb6, 0xaa, 0x53, 0x58, 0x6b, 0xdc, 0xd5, 0x93, 0x4f, 0xc7, 0x0d, 0xa2, 0xce, 0xae, 0x01,
+	0xf3, 0xbd, 0x64, 0x94, 0x8f, 0xa1, 0x1b, 0x58, 0xe2, 0xec, 0x46, 0x70, 0x6e, 0xc1, 0x32, 0xa6,
+	0xd8, 0x2e, 0x80, 0xe8, 0x43, 0x68, 0x64, 0x35, 0xe5, 0xfa, 0xdc, 0xd3, 0x7d, 0x7e, 0xe6, 0x90,
+	0x91, 0xbb, 0xff, 0x07, 0xc3, 0x99, 0x15, 0xb9, 0xa4, 0x2c, 0x55, 0xf3, 0x2f, 0x96, 0x2a, 0x5a,
+	0x00, 0x53, 0xd0, 0x40, 0xa5, 0x6e, 0xce, 0xc9, 0x96, 0x63, 0x1f, 0xf5, 0xfe, 0xb5, 0xc7, 0x2f,
+	0xdb, 0xc6, 0x93, 0x97, 0x6d, 0xe3, 0xb7, 0x97, 0x6d, 0xe3, 0xfe, 0x6e, 0xbb, 0xf2, 0x64, 0xb7,
+	0x5d, 0xf9, 0x75, 0xb7, 0x5d, 0xb9, 0x7b, 0x76, 0x7a, 0x42, 0xba, 0x72, 0x34, 0xa8, 0xab, 0xf9,
+	0x7b, 0xf1, 0x8f, 0x01, 0x00, 0x0d, 0x89, 0x3f, 0x87, 0xe4, 0x0b, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1640,6 +1705,27 @@ func (m *ModeInfo_Multi_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ModeInfo_Aggregate_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModeInfo_Aggregate_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Aggregate != nil {
+		{
+			size, err := m.Aggregate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ModeInfo_Single) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1717,6 +1803,34 @@ func (m *ModeInfo_Multi) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ModeInfo_Aggregate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModeInfo_Aggregate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModeInfo_Aggregate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Fee) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2103,6 +2217,18 @@ func (m *ModeInfo_Multi_) Size() (n int) {
 	}
 	return n
 }
+func (m *ModeInfo_Aggregate_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Aggregate != nil {
+		l = m.Aggregate.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
 func (m *ModeInfo_Single) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2134,6 +2260,18 @@ func (m *ModeInfo_Multi) Size() (n int) {
 	return n
 }
 
+func (m *ModeInfo_Aggregate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		n += 1 + sovTx(uint64(m.Mode))
+	}
+	return n
+}
+
 func (m *Fee) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3563,6 +3701,41 @@ func (m *ModeInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &ModeInfo_Multi_{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ModeInfo_Aggregate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ModeInfo_Aggregate_{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3773,6 +3946,75 @@ func (m *ModeInfo_Multi) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ModeInfo_Aggregate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= signing.SignMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Fee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -17,9 +17,12 @@ type HandlerOptions struct {
 	BankKeeper             types.BankKeeper
 	ExtensionOptionChecker ExtensionOptionChecker
 	FeegrantKeeper         FeegrantKeeper
-	SignModeHandler        *txsigning.HandlerMap
-	SigGasConsumer         func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
-	TxFeeChecker           TxFeeChecker
+	// FeeMarketKeeper enables enforcement of the x/auth fee market base gas price
+	// when set. It is typically the x/auth AccountKeeper.
+	FeeMarketKeeper FeeMarketKeeper
+	SignModeHandler *txsigning.HandlerMap
+	SigGasConsumer  func(meter storetypes.GasMeter, sig signing.SignatureV2, params types.Params) error
+	TxFeeChecker    TxFeeChecker
 	// SigVerifyOptions are the options for the signature verification decorator.
 	// This allows for modification of signature verification behavior, such as how long an unordered transaction can
 	// be valid, or how much gas to charge for unordered transactions.
@@ -49,6 +52,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		NewTxTimeoutHeightDecorator(),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
+		NewFeeMarketDecorator(options.FeeMarketKeeper),
 		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
 		NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(options.AccountKeeper),
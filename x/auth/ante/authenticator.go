@@ -0,0 +1,97 @@
+package ante
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// signerAuthenticatorsKey is the context key of the signerAuthenticatorsCache
+// of the tx being processed.
+type signerAuthenticatorsKey struct{}
+
+// signerAuthenticatorsCache holds the authenticators of the signers of a tx.
+type signerAuthenticatorsCache struct {
+	txBytes        []byte
+	signers        [][]byte
+	authenticators [][]types.AccountAuthenticator
+}
+
+// signerAuthenticators returns the authenticators of the signers of a tx, by
+// signer index. They are read from the store by the first decorator needing
+// them, and carried by the returned context to the following decorators so
+// that they do not read them again.
+func signerAuthenticators(ctx sdk.Context, ak AccountKeeper, signers [][]byte) (sdk.Context, [][]types.AccountAuthenticator, error) {
+	if cache, ok := ctx.Value(signerAuthenticatorsKey{}).(signerAuthenticatorsCache); ok &&
+		bytes.Equal(cache.txBytes, ctx.TxBytes()) && slices.EqualFunc(cache.signers, signers, bytes.Equal) {
+		return ctx, cache.authenticators, nil
+	}
+
+	authenticators := make([][]types.AccountAuthenticator, len(signers))
+	for i, signer := range signers {
+		var err error
+		if authenticators[i], err = ak.GetAccountAuthenticators(ctx, signer); err != nil {
+			return ctx, nil, err
+		}
+	}
+
+	ctx = ctx.WithValue(signerAuthenticatorsKey{}, signerAuthenticatorsCache{
+		txBytes:        ctx.TxBytes(),
+		signers:        signers,
+		authenticators: authenticators,
+	})
+
+	return ctx, authenticators, nil
+}
+
+// authenticate authenticates the signature of acc with the authenticators the
+// account opted into. The signature is authenticated if any of them accepts it,
+// the state writes of the ones rejecting it being discarded.
+func (svd SigVerificationDecorator) authenticate(
+	ctx sdk.Context, tx sdk.Tx, acc sdk.AccountI, authenticators []types.AccountAuthenticator,
+	sig signing.SignatureV2, accNum uint64, simulate bool,
+) error {
+	verifySignature := func(pubKey cryptotypes.PubKey) error {
+		// no need to verify signatures on recheck tx
+		if simulate || ctx.IsReCheckTx() || !ctx.IsSigverifyTx() {
+			return nil
+		}
+		return svd.verifySignature(ctx, tx, acc, pubKey, sig, accNum, nil)
+	}
+
+	var errs []error
+	for _, accAuthenticator := range authenticators {
+		authenticator, ok := svd.ak.GetAuthenticator(accAuthenticator.AuthenticatorType)
+		if !ok {
+			errs = append(errs, fmt.Errorf("authenticator %d: unknown authenticator type %s", accAuthenticator.Id, accAuthenticator.AuthenticatorType))
+			continue
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		err := authenticator.Authenticate(cacheCtx, types.AuthenticationRequest{
+			Account:         acc,
+			Config:          accAuthenticator.Config,
+			Tx:              tx,
+			Signature:       sig,
+			Simulate:        simulate,
+			VerifySignature: verifySignature,
+		})
+		if err == nil {
+			write()
+			return nil
+		}
+		errs = append(errs, fmt.Errorf("authenticator %d: %w", accAuthenticator.Id, err))
+	}
+
+	return errorsmod.Wrapf(sdkerrors.ErrUnauthorized,
+		"no authenticator of account %s authenticated the signature: %s", acc.GetAddress(), errors.Join(errs...))
+}
@@ -0,0 +1,110 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestSigVerificationAuthenticators(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	now := time.Unix(1000, 0)
+	suite.ctx = suite.ctx.WithBlockHeight(1).WithBlockTime(now)
+
+	ownerPriv, _, addr := testdata.KeyTestPubAddr()
+	sessionPriv, sessionPub, _ := testdata.KeyTestPubAddr()
+	otherPriv, _, _ := testdata.KeyTestPubAddr()
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	require.NoError(t, acc.SetAccountNumber(1000))
+	require.NoError(t, acc.SetPubKey(ownerPriv.PubKey()))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+
+	addSessionKey := func(allowedMsgTypeURLs []string) {
+		sessionKey, err := types.NewSessionKeyConfig(sessionPub, allowedMsgTypeURLs, now.Add(time.Hour))
+		require.NoError(t, err)
+		config, err := suite.encCfg.Codec.Marshal(sessionKey)
+		require.NoError(t, err)
+		_, err = suite.accountKeeper.AddAuthenticator(suite.ctx, addr, types.SessionKeyAuthenticatorType, config)
+		require.NoError(t, err)
+	}
+
+	spkd := ante.NewSetPubKeyDecorator(suite.accountKeeper)
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	testCases := []struct {
+		name      string
+		setup     func()
+		priv      cryptotypes.PrivKey
+		blockTime time.Time
+		expErrMsg string
+	}{
+		{
+			name: "account key with signature authenticator",
+			setup: func() {
+				_, err := suite.accountKeeper.AddAuthenticator(suite.ctx, addr, types.SignatureAuthenticatorType, nil)
+				require.NoError(t, err)
+			},
+			priv:      ownerPriv,
+			blockTime: now,
+		},
+		{
+			name:      "session key signing a message it does not allow",
+			setup:     func() { addSessionKey([]string{"/cosmos.bank.v1beta1.MsgSend"}) },
+			priv:      sessionPriv,
+			blockTime: now,
+			expErrMsg: "is not allowed by the session key",
+		},
+		{
+			name:      "session key signing an allowed message",
+			setup:     func() { addSessionKey([]string{sdk.MsgTypeURL(testdata.NewTestMsg(addr))}) },
+			priv:      sessionPriv,
+			blockTime: now,
+		},
+		{
+			name:      "expired session key",
+			priv:      sessionPriv,
+			blockTime: now.Add(time.Hour),
+			expErrMsg: "session key expired",
+		},
+		{
+			name:      "key unknown to the account",
+			priv:      otherPriv,
+			blockTime: now,
+			expErrMsg: "no authenticator of account",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{tc.priv}, []uint64{1000}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+			require.NoError(t, err)
+
+			_, err = antehandler(suite.ctx.WithBlockTime(tc.blockTime), tx, false)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// the account pubkey is left untouched by the txs signed by the session key
+	require.True(t, ownerPriv.PubKey().Equals(suite.accountKeeper.GetAccount(suite.ctx, addr).GetPubKey()))
+}
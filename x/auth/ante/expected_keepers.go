@@ -21,9 +21,16 @@ type AccountKeeper interface {
 	UnorderedTransactionsEnabled() bool
 	RemoveExpiredUnorderedNonces(ctx sdk.Context) error
 	TryAddUnorderedNonce(ctx sdk.Context, sender []byte, timestamp time.Time) error
+	GetAccountAuthenticators(ctx context.Context, addr sdk.AccAddress) ([]types.AccountAuthenticator, error)
+	GetAuthenticator(authenticatorType string) (types.Authenticator, bool)
 }
 
 // FeegrantKeeper defines the expected feegrant keeper.
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// FeeMarketKeeper defines the expected fee market keeper.
+type FeeMarketKeeper interface {
+	GetBaseGasPrice(ctx context.Context) (sdk.DecCoin, error)
+}
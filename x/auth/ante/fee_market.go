@@ -0,0 +1,60 @@
+package ante
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeeMarketDecorator enforces the x/auth fee market base gas price. A tx must
+// pay at least base gas price * gas limit in the fee market denom, otherwise it
+// is rejected. The decorator is a no-op when no FeeMarketKeeper is set or when
+// the fee market is disabled.
+// CONTRACT: Tx must implement FeeTx interface to use FeeMarketDecorator
+type FeeMarketDecorator struct {
+	feeMarketKeeper FeeMarketKeeper
+}
+
+func NewFeeMarketDecorator(fmk FeeMarketKeeper) FeeMarketDecorator {
+	return FeeMarketDecorator{
+		feeMarketKeeper: fmk,
+	}
+}
+
+func (fmd FeeMarketDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// the fee is unknown at simulation time and genesis txs are not charged.
+	if fmd.feeMarketKeeper == nil || simulate || ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	baseGasPrice, err := fmd.feeMarketKeeper.GetBaseGasPrice(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	if baseGasPrice.Amount.IsNil() || baseGasPrice.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	// required fee = ceil(baseGasPrice * gasLimit).
+	gasLimitDec := sdkmath.LegacyNewDecFromBigInt(new(big.Int).SetUint64(feeTx.GetGas()))
+	required := sdk.NewCoin(baseGasPrice.Denom, baseGasPrice.Amount.Mul(gasLimitDec).Ceil().RoundInt())
+
+	if paid := feeTx.GetFee().AmountOf(required.Denom); paid.LT(required.Amount) {
+		return ctx, errorsmod.Wrapf(
+			sdkerrors.ErrInsufficientFee,
+			"insufficient fees for base gas price %s; got: %s required: %s", baseGasPrice, feeTx.GetFee(), required,
+		)
+	}
+
+	return next(ctx, tx, simulate)
+}
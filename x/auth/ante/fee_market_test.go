@@ -0,0 +1,57 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestFeeMarketDecorator(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	fmd := ante.NewFeeMarketDecorator(s.accountKeeper)
+	antehandler := sdk.ChainAnteDecorators(fmd)
+
+	accs := s.CreateTestAccounts(1)
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	// 150atom for 200000 gas, i.e. a gas price of 0.00075atom
+	s.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	// fee market disabled
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+
+	params := authtypes.NewFeeMarketParams(true, "atom", math.LegacyNewDecWithPrec(1, 4), math.LegacyZeroDec(), 1000, 8)
+	require.NoError(t, s.accountKeeper.FeeMarketParams.Set(s.ctx, params))
+	require.NoError(t, s.accountKeeper.BaseGasPrice.Set(s.ctx, math.LegacyNewDecWithPrec(1, 3)))
+
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// the base gas price is not enforced in simulation mode nor at genesis
+	_, err = antehandler(s.ctx, tx, true)
+	require.NoError(t, err)
+	_, err = antehandler(s.ctx.WithBlockHeight(0), tx, false)
+	require.NoError(t, err)
+
+	require.NoError(t, s.accountKeeper.BaseGasPrice.Set(s.ctx, math.LegacyNewDecWithPrec(75, 5)))
+	_, err = antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+}
@@ -2,6 +2,7 @@ package ante
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
@@ -14,10 +15,13 @@ import (
 	txsigning "cosmossdk.io/x/tx/signing"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -75,6 +79,11 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 		return sdk.Context{}, err
 	}
 
+	ctx, authenticators, err := signerAuthenticators(ctx, spkd.ak, signers)
+	if err != nil {
+		return ctx, err
+	}
+
 	signerStrs := make([]string, len(signers))
 	for i, pk := range pubkeys {
 		var err error
@@ -90,20 +99,34 @@ func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 			}
 			pk = simSecp256k1Pubkey
 		}
-		// Only make check if simulate=false
-		if !simulate && !bytes.Equal(pk.Address(), signers[i]) && ctx.IsSigverifyTx() {
-			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
-				"pubKey does not match signer address %s with signer index: %d", signerStrs[i], i)
-		}
 
 		acc, err := GetSignerAcc(ctx, spkd.ak, signers[i])
 		if err != nil {
 			return ctx, err
 		}
-		// account already has pubkey set,no need to reset
-		if acc.GetPubKey() != nil {
+
+		// the signatures of accounts having authenticators are not made with
+		// their pubkey, which is left untouched
+		if len(authenticators[i]) > 0 {
+			continue
+		}
+
+		// account already has pubkey set, no need to reset. Its pubkey may have
+		// been rotated and not match its address anymore, so the tx must carry
+		// the pubkey of the account.
+		if accPk := acc.GetPubKey(); accPk != nil {
+			if !simulate && !accPk.Equals(pk) && ctx.IsSigverifyTx() {
+				return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
+					"pubKey does not match the pubKey of signer %s with signer index: %d", signerStrs[i], i)
+			}
 			continue
 		}
+
+		// Only make check if simulate=false
+		if !simulate && !bytes.Equal(pk.Address(), signers[i]) && ctx.IsSigverifyTx() {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
+				"pubKey does not match signer address %s with signer index: %d", signerStrs[i], i)
+		}
 		err = acc.SetPubKey(pk)
 		if err != nil {
 			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
@@ -191,6 +214,11 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		return ctx, err
 	}
 
+	ctx, authenticators, err := signerAuthenticators(ctx, sgcd.ak, signers)
+	if err != nil {
+		return ctx, err
+	}
+
 	for i, sig := range sigs {
 		signerAcc, err := GetSignerAcc(ctx, sgcd.ak, signers[i])
 		if err != nil {
@@ -199,11 +227,18 @@ func (sgcd SigGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 
 		pubKey := signerAcc.GetPubKey()
 
+		// The signatures of accounts having authenticators are not made with
+		// their pubkey, so the pubkey of the signature is charged, defaulting to
+		// the most expensive one.
+		if len(authenticators[i]) > 0 {
+			pubKey = sig.PubKey
+		}
+
 		// In simulate mode the transaction comes with no signatures, thus if the
 		// account's pubkey is nil, both signature verification and gasKVStore.Set()
 		// shall consume the largest amount, i.e. it takes more gas to verify
 		// secp256k1 keys than ed25519 ones.
-		if simulate && pubKey == nil {
+		if (simulate || len(authenticators[i]) > 0) && pubKey == nil {
 			pubKey = simSecp256k1Pubkey
 		}
 
@@ -236,6 +271,8 @@ type SigVerificationDecorator struct {
 	signModeHandler      *txsigning.HandlerMap
 	maxTxTimeoutDuration time.Duration
 	unorderedTxGasCost   uint64
+	batchVerifier        *SigBatchVerifier
+	batchVerifyCheckTx   bool
 }
 
 type SigVerificationDecoratorOption func(svd *SigVerificationDecorator)
@@ -257,6 +294,23 @@ func WithUnorderedTxGasCost(gasCost uint64) SigVerificationDecoratorOption {
 	}
 }
 
+// WithSigBatchVerifier sets the verifier of the signatures of the txs of a block
+// in batch. The signatures it verified in batch are not verified again when the
+// txs are executed in FinalizeBlock.
+func WithSigBatchVerifier(verifier *SigBatchVerifier) SigVerificationDecoratorOption {
+	return func(svd *SigVerificationDecorator) {
+		svd.batchVerifier = verifier
+	}
+}
+
+// WithCheckTxSigBatchVerification enables the verification in batch of the
+// signatures of each tx in CheckTx, with the verifier set by WithSigBatchVerifier.
+func WithCheckTxSigBatchVerification() SigVerificationDecoratorOption {
+	return func(svd *SigVerificationDecorator) {
+		svd.batchVerifyCheckTx = true
+	}
+}
+
 const (
 	// DefaultMaxTimeoutDuration defines a default maximum TTL a transaction can define.
 	DefaultMaxTimeoutDuration = 10 * time.Minute
@@ -291,6 +345,8 @@ func OnlyLegacyAminoSigners(sigData signing.SignatureData) bool {
 	switch v := sigData.(type) {
 	case *signing.SingleSignatureData:
 		return v.SignMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	case *signing.AggregateSignatureData:
+		return v.SignMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
 	case *signing.MultiSignatureData:
 		for _, s := range v.Signatures {
 			if !OnlyLegacyAminoSigners(s) {
@@ -329,6 +385,11 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, err
 	}
 
+	pubKeys, err := sigTx.GetPubKeys()
+	if err != nil {
+		return ctx, err
+	}
+
 	// check that signer length and signature length are the same
 	if len(sigs) != len(signers) {
 		return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signers), len(sigs))
@@ -343,6 +404,14 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 		}
 	}
 
+	ctx, authenticators, err := signerAuthenticators(ctx, svd.ak, signers)
+	if err != nil {
+		return ctx, err
+	}
+
+	batchVerified := svd.batchVerifiedSigs(ctx, tx, simulate)
+
+	var aggregateSigners []authsigning.AggregateSigner
 	for i, sig := range sigs {
 		if sig.Sequence > 0 && isUnordered {
 			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "sequence is not allowed for unordered transactions")
@@ -354,10 +423,17 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 
 		// retrieve pubkey
 		pubKey := acc.GetPubKey()
-		if !simulate && pubKey == nil {
+		if !simulate && pubKey == nil && len(authenticators[i]) == 0 {
 			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
 
+		// the pubkey of the account may have been rotated, in which case the
+		// signature must be made with the new one
+		if !simulate && len(authenticators[i]) == 0 && i < len(pubKeys) && pubKeys[i] != nil && !pubKey.Equals(pubKeys[i]) && ctx.IsSigverifyTx() {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
+				"pubkey does not match the pubkey on account %s, which may have been rotated", acc.GetAddress())
+		}
+
 		// Check account sequence number.
 		if !isUnordered {
 			if sig.Sequence != acc.GetSequence() {
@@ -370,50 +446,140 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 
 		// retrieve signer data
 		genesis := ctx.BlockHeight() == 0
-		chainID := ctx.ChainID()
 		var accNum uint64
 		if !genesis {
 			accNum = acc.GetAccountNumber()
 		}
 
+		// the signatures of accounts having authenticators are authenticated
+		// by them instead of being verified against the account pubkey
+		if len(authenticators[i]) > 0 {
+			if err := svd.authenticate(ctx, tx, acc, authenticators[i], sig, accNum, simulate); err != nil {
+				return ctx, err
+			}
+			continue
+		}
+
 		// no need to verify signatures on recheck tx
 		if !simulate && !ctx.IsReCheckTx() && ctx.IsSigverifyTx() {
-			anyPk, _ := codectypes.NewAnyWithValue(pubKey)
-
-			signerData := txsigning.SignerData{
-				Address:       acc.GetAddress().String(),
-				ChainID:       chainID,
-				AccountNumber: accNum,
-				Sequence:      sig.Sequence,
-				PubKey: &anypb.Any{
-					TypeUrl: anyPk.TypeUrl,
-					Value:   anyPk.Value,
-				},
-			}
-			adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
-			if !ok {
-				return ctx, fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
+			// the aggregate signatures are verified together once all the
+			// signers are checked
+			if data, ok := sig.Data.(*signing.AggregateSignatureData); ok {
+				aggregateSigners = append(aggregateSigners, authsigning.AggregateSigner{
+					PubKey:     pubKey,
+					SignerData: newSignerData(ctx, acc, pubKey, sig.Sequence, accNum),
+					Data:       data,
+				})
+				continue
 			}
-			txData := adaptableTx.GetSigningTxData()
-			err = authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, txData)
-			if err != nil {
-				var errMsg string
-				if OnlyLegacyAminoSigners(sig.Data) {
-					// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
-					// and therefore communicate sequence number as a potential cause of error.
-					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, acc.GetSequence(), chainID)
-				} else {
-					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s): (%s)", accNum, chainID, err.Error())
-				}
-				return ctx, errorsmod.Wrap(sdkerrors.ErrUnauthorized, errMsg)
 
+			if err := svd.verifySignature(ctx, tx, acc, pubKey, sig, accNum, batchVerified); err != nil {
+				return ctx, err
 			}
 		}
 	}
 
+	if err := svd.verifyAggregateSignature(ctx, tx, aggregateSigners); err != nil {
+		return ctx, err
+	}
+
 	return next(ctx, tx, simulate)
 }
 
+// batchVerifiedSigs returns the signatures of tx verified in batch, which are
+// the ones verified for the block in FinalizeBlock and, if enabled, the ones of
+// tx verified in batch in CheckTx.
+func (svd SigVerificationDecorator) batchVerifiedSigs(ctx sdk.Context, tx sdk.Tx, simulate bool) verifiedSigs {
+	if svd.batchVerifier == nil || simulate || !ctx.IsSigverifyTx() {
+		return nil
+	}
+
+	switch ctx.ExecMode() {
+	case sdk.ExecModeFinalize:
+		return svd.batchVerifier.blockVerified()
+	case sdk.ExecModeCheck:
+		if svd.batchVerifyCheckTx {
+			return svd.batchVerifier.verifyTx(ctx, tx)
+		}
+	}
+
+	return nil
+}
+
+// verifySignature verifies the signature of acc was made by pubKey over the
+// sign bytes of tx. The single signatures found in batchVerified are not
+// verified again.
+func (svd SigVerificationDecorator) verifySignature(
+	ctx sdk.Context, tx sdk.Tx, acc sdk.AccountI, pubKey cryptotypes.PubKey, sig signing.SignatureV2, accNum uint64, batchVerified verifiedSigs,
+) error {
+	chainID := ctx.ChainID()
+	signerData := newSignerData(ctx, acc, pubKey, sig.Sequence, accNum)
+	adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
+	}
+	txData := adaptableTx.GetSigningTxData()
+
+	// the signatures verified in batch are neither verified again nor are
+	// their sign bytes computed again
+	if data, ok := sig.Data.(*signing.SingleSignatureData); ok && len(batchVerified) > 0 &&
+		batchVerified.has(sha256.Sum256(ctx.TxBytes()), signerData, data, pubKey) {
+		return nil
+	}
+
+	err := authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, txData)
+	if err != nil {
+		var errMsg string
+		if OnlyLegacyAminoSigners(sig.Data) {
+			// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
+			// and therefore communicate sequence number as a potential cause of error.
+			errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, acc.GetSequence(), chainID)
+		} else {
+			errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s): (%s)", accNum, chainID, err.Error())
+		}
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, errMsg)
+	}
+
+	return nil
+}
+
+// verifyAggregateSignature verifies the aggregate signature of the aggregate
+// signers of tx.
+func (svd SigVerificationDecorator) verifyAggregateSignature(ctx sdk.Context, tx sdk.Tx, signers []authsigning.AggregateSigner) error {
+	if len(signers) == 0 {
+		return nil
+	}
+
+	adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
+	}
+
+	err := authsigning.VerifyAggregateSignature(ctx, signers, svd.signModeHandler, adaptableTx.GetSigningTxData())
+	if err != nil {
+		errMsg := fmt.Sprintf("aggregate signature verification failed; please verify account numbers, sequences and chain-id (%s): (%s)", ctx.ChainID(), err.Error())
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, errMsg)
+	}
+
+	return nil
+}
+
+// newSignerData returns the data of acc signing a tx with pubKey.
+func newSignerData(ctx sdk.Context, acc sdk.AccountI, pubKey cryptotypes.PubKey, sequence, accNum uint64) txsigning.SignerData {
+	anyPk, _ := codectypes.NewAnyWithValue(pubKey)
+
+	return txsigning.SignerData{
+		Address:       acc.GetAddress().String(),
+		ChainID:       ctx.ChainID(),
+		AccountNumber: accNum,
+		Sequence:      sequence,
+		PubKey: &anypb.Any{
+			TypeUrl: anyPk.TypeUrl,
+			Value:   anyPk.Value,
+		},
+	}
+}
+
 // verifyUnorderedNonce verifies the unordered nonce of an unordered transaction.
 // This checks that:
 // 1. The unordered transaction's timeout timestamp is set.
@@ -580,10 +746,22 @@ func DefaultSigVerificationGasConsumer(
 		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
 		return nil
 
+	case *ethsecp256k1.PubKey:
+		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: eth_secp256k1")
+		return nil
+
 	case *secp256r1.PubKey:
 		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
 		return nil
 
+	case *webauthn.PubKey:
+		meter.ConsumeGas(params.SigVerifyCostWebAuthn(), "ante verify: webauthn")
+		return nil
+
+	case *bls12_381.PubKey:
+		meter.ConsumeGas(params.SigVerifyCostBLS12381(), "ante verify: bls12_381")
+		return nil
+
 	case multisig.PubKey:
 		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
 		if !ok {
@@ -669,6 +847,12 @@ func signatureDataToBz(data signing.SignatureData) ([][]byte, error) {
 	switch data := data.(type) {
 	case *signing.SingleSignatureData:
 		return [][]byte{data.Signature}, nil
+	case *signing.AggregateSignatureData:
+		// the aggregate signature is only carried by the first aggregate signer
+		if len(data.Signature) == 0 {
+			return nil, nil
+		}
+		return [][]byte{data.Signature}, nil
 	case *signing.MultiSignatureData:
 		sigs := [][]byte{}
 		var err error
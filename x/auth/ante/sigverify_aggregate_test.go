@@ -0,0 +1,94 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+func TestAggregateSigVerification(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	txConfig := suite.clientCtx.TxConfig
+
+	privs := []cryptotypes.PrivKey{secp256k1.GenPrivKey()}
+	for i := 0; i < 2; i++ {
+		priv, err := bls12_381.GenPrivKey()
+		require.NoError(t, err)
+		privs = append(privs, &priv)
+	}
+
+	msgs := make([]sdk.Msg, len(privs))
+	accNums := make([]uint64, len(privs))
+	accSeqs := make([]uint64, len(privs))
+	for i, priv := range privs {
+		addr := sdk.AccAddress(priv.PubKey().Address())
+		acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+		suite.accountKeeper.SetAccount(suite.ctx, acc)
+		msgs[i] = testdata.NewTestMsg(addr)
+		accNums[i] = acc.GetAccountNumber()
+	}
+
+	suite.txBuilder = txConfig.NewTxBuilder()
+	require.NoError(t, suite.txBuilder.SetMsgs(msgs...))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	_, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	require.NoError(t, err)
+
+	sigs, err := suite.txBuilder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	partialSig := sigs[1].Data.(*signing.SingleSignatureData).Signature
+
+	// the signatures of the BLS12-381 signers are aggregated in the first one
+	require.NoError(t, clienttx.AggregateSignatures(suite.txBuilder))
+	txBytes, err := txConfig.TxEncoder()(suite.txBuilder.GetTx())
+	require.NoError(t, err)
+	tx, err := txConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+
+	txBuilder, err := txConfig.WrapTxBuilder(tx)
+	require.NoError(t, err)
+	sigs, err = txBuilder.GetTx().GetSignaturesV2()
+	require.NoError(t, err)
+	require.IsType(t, &signing.SingleSignatureData{}, sigs[0].Data)
+	require.NotEmpty(t, sigs[1].Data.(*signing.AggregateSignatureData).Signature)
+	require.Empty(t, sigs[2].Data.(*signing.AggregateSignatureData).Signature)
+
+	antehandler := sdk.ChainAnteDecorators(
+		ante.NewSetPubKeyDecorator(suite.accountKeeper),
+		ante.NewSigGasConsumeDecorator(suite.accountKeeper, ante.DefaultSigVerificationGasConsumer),
+		ante.NewSigVerificationDecorator(suite.accountKeeper, txConfig.SignModeHandler()),
+	)
+	run := func(sigs []signing.SignatureV2) error {
+		require.NoError(t, txBuilder.SetSignatures(sigs...))
+		ctx, _ := suite.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).CacheContext()
+		_, err := antehandler(ctx, txBuilder.GetTx(), false)
+		return err
+	}
+
+	require.NoError(t, run(sigs))
+
+	// the aggregate signature must cover all the aggregate signers
+	invalidSigs := append([]signing.SignatureV2(nil), sigs...)
+	invalidSigs[1].Data = &signing.AggregateSignatureData{SignMode: signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, Signature: partialSig}
+	require.ErrorIs(t, run(invalidSigs), sdkerrors.ErrUnauthorized)
+
+	// the aggregate signature must be carried by the first aggregate signer
+	invalidSigs = append([]signing.SignatureV2(nil), sigs...)
+	invalidSigs[1].Data, invalidSigs[2].Data = sigs[2].Data, sigs[1].Data
+	require.ErrorIs(t, run(invalidSigs), sdkerrors.ErrUnauthorized)
+}
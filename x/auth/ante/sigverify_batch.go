@@ -0,0 +1,235 @@
+package ante
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// SigBatchVerifier verifies in batch the signatures of the txs of a block
+// before they are executed, for the key types supporting it (see
+// cryptotypes.BatchVerifiablePubKey). The SigVerificationDecorator then does
+// not verify again the signatures verified in batch, nor compute again their
+// sign bytes, while the other ones, including the invalid ones, are verified
+// individually as usual. The results of the txs, and the gas they consume, are
+// therefore unchanged.
+//
+// The signer data of the signatures are read from the state at the beginning
+// of the block. The signatures whose signer data are changed by the previous
+// txs of the block, e.g. because their signer account is created by one of
+// them, are simply verified individually. So are the SIGN_MODE_TEXTUAL
+// signatures, whose sign bytes also depend on the state.
+type SigBatchVerifier struct {
+	ak              AccountKeeper
+	signModeHandler *txsigning.HandlerMap
+	txDecoder       sdk.TxDecoder
+
+	mu sync.RWMutex
+	// verified are the signatures of the current block verified in batch.
+	verified verifiedSigs
+}
+
+func NewSigBatchVerifier(ak AccountKeeper, signModeHandler *txsigning.HandlerMap, txDecoder sdk.TxDecoder) *SigBatchVerifier {
+	return &SigBatchVerifier{
+		ak:              ak,
+		signModeHandler: signModeHandler,
+		txDecoder:       txDecoder,
+	}
+}
+
+// VerifyBlock verifies in batch the signatures of the txs of the block about
+// to be executed, replacing the signatures verified for the previous block. It
+// must be called by the PreBlocker of the app with the txs of the
+// FinalizeBlock request.
+func (v *SigBatchVerifier) VerifyBlock(ctx sdk.Context, txs [][]byte) {
+	var sigs []batchSig
+	for _, txBytes := range txs {
+		tx, err := v.txDecoder(txBytes)
+		if err != nil {
+			continue
+		}
+		sigs = append(sigs, v.collectSigs(ctx, tx, txBytes)...)
+	}
+
+	verified := verifyBatch(sigs)
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.verified = verified
+}
+
+// blockVerified returns the signatures of the current block verified in batch.
+func (v *SigBatchVerifier) blockVerified() verifiedSigs {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.verified
+}
+
+// verifyTx verifies in batch the signatures of tx, and returns the valid ones.
+func (v *SigBatchVerifier) verifyTx(ctx sdk.Context, tx sdk.Tx) verifiedSigs {
+	return verifyBatch(v.collectSigs(ctx, tx, ctx.TxBytes()))
+}
+
+// collectSigs returns the single signatures of tx which can be verified in
+// batch, along with their sign bytes. Their signer data are built as in the
+// SigVerificationDecorator, so that it finds them in the verified signatures.
+func (v *SigBatchVerifier) collectSigs(ctx sdk.Context, tx sdk.Tx, txBytes []byte) []batchSig {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return nil
+	}
+	adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return nil
+	}
+	txData := adaptableTx.GetSigningTxData()
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil || len(sigs) != len(signers) {
+		return nil
+	}
+
+	pubKeys, err := sigTx.GetPubKeys()
+	if err != nil {
+		return nil
+	}
+
+	txHash := sha256.Sum256(txBytes)
+	var batch []batchSig
+	for i, sig := range sigs {
+		data, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok || data.SignMode == signing.SignMode_SIGN_MODE_TEXTUAL {
+			continue
+		}
+
+		// the signatures of the accounts having authenticators are not
+		// verified against their pubkey
+		authenticators, err := v.ak.GetAccountAuthenticators(ctx, signers[i])
+		if err != nil || len(authenticators) > 0 {
+			continue
+		}
+
+		acc := v.ak.GetAccount(ctx, signers[i])
+		if acc == nil {
+			continue
+		}
+
+		// the pubkey of a new account is set from the tx by the
+		// SetPubKeyDecorator
+		pubKey := acc.GetPubKey()
+		if pubKey == nil && i < len(pubKeys) {
+			pubKey = pubKeys[i]
+		}
+
+		batchPubKey, ok := pubKey.(cryptotypes.BatchVerifiablePubKey)
+		if !ok {
+			continue
+		}
+
+		var accNum uint64
+		if ctx.BlockHeight() != 0 {
+			accNum = acc.GetAccountNumber()
+		}
+
+		signerData := newSignerData(ctx, acc, pubKey, sig.Sequence, accNum)
+		signBytes, err := authsigning.GetSignBytes(ctx, v.signModeHandler, data.SignMode, signerData, txData)
+		if err != nil {
+			continue
+		}
+
+		batch = append(batch, batchSig{
+			key:    verifiedSigKey(txHash, signerData, data, pubKey),
+			pubKey: batchPubKey,
+			msg:    signBytes,
+			sig:    data.Signature,
+		})
+	}
+
+	return batch
+}
+
+// batchSig is a signature to verify in batch.
+type batchSig struct {
+	key    [sha256.Size]byte
+	pubKey cryptotypes.BatchVerifiablePubKey
+	msg    []byte
+	sig    []byte
+}
+
+// verifyBatch verifies sigs in batch per key type, and returns the valid ones.
+// When the batch of a key type is invalid, its signatures are verified one by
+// one to find the invalid ones.
+func verifyBatch(sigs []batchSig) verifiedSigs {
+	verified := make(verifiedSigs)
+
+	verifiers := make(map[string]cryptotypes.BatchVerifier)
+	batches := make(map[string][]batchSig)
+	for _, s := range sigs {
+		keyType := s.pubKey.Type()
+		verifier, ok := verifiers[keyType]
+		if !ok {
+			verifier = s.pubKey.NewBatchVerifier()
+			verifiers[keyType] = verifier
+		}
+
+		// the signatures which cannot be added are verified individually by
+		// the SigVerificationDecorator
+		if err := verifier.Add(s.pubKey, s.msg, s.sig); err != nil {
+			continue
+		}
+		batches[keyType] = append(batches[keyType], s)
+	}
+
+	for keyType, batch := range batches {
+		allValid := verifiers[keyType].Verify()
+		for _, s := range batch {
+			if allValid || s.pubKey.VerifySignature(s.msg, s.sig) {
+				verified[s.key] = struct{}{}
+			}
+		}
+	}
+
+	return verified
+}
+
+// verifiedSigs is a set of valid signatures, identified by verifiedSigKey.
+type verifiedSigs map[[sha256.Size]byte]struct{}
+
+// has reports whether the signature of the tx of the given hash, made with
+// pubKey by the signer of signerData, is in the set.
+func (s verifiedSigs) has(txHash [sha256.Size]byte, signerData txsigning.SignerData, data *signing.SingleSignatureData, pubKey cryptotypes.PubKey) bool {
+	_, ok := s[verifiedSigKey(txHash, signerData, data, pubKey)]
+	return ok
+}
+
+// verifiedSigKey returns the key of a signature of the tx of the given hash in
+// a set of valid signatures. The key covers everything the sign bytes of the
+// signature are computed from, so that the signature can be looked up without
+// computing them again.
+func verifiedSigKey(txHash [sha256.Size]byte, signerData txsigning.SignerData, data *signing.SingleSignatureData, pubKey cryptotypes.PubKey) [sha256.Size]byte {
+	h := sha256.New()
+	h.Write(txHash[:])
+	h.Write(binary.BigEndian.AppendUint64(nil, signerData.AccountNumber))
+	h.Write(binary.BigEndian.AppendUint64(nil, signerData.Sequence))
+	h.Write(binary.BigEndian.AppendUint32(nil, uint32(data.SignMode)))
+	for _, bz := range [][]byte{[]byte(signerData.ChainID), []byte(signerData.Address), []byte(pubKey.Type()), pubKey.Bytes(), data.Signature} {
+		h.Write(binary.BigEndian.AppendUint64(nil, uint64(len(bz))))
+		h.Write(bz)
+	}
+
+	var key [sha256.Size]byte
+	h.Sum(key[:0])
+	return key
+}
@@ -0,0 +1,130 @@
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	txsigning "cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/direct"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsign "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func TestSigBatchVerification(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	suite.ctx = suite.ctx.WithExecMode(sdk.ExecModeFinalize)
+	txConfig := suite.clientCtx.TxConfig
+
+	privs := []cryptotypes.PrivKey{ed25519.GenPrivKey(), ed25519.GenPrivKey(), secp256k1.GenPrivKey(), ed25519.GenPrivKey()}
+	msgs := make([]sdk.Msg, len(privs))
+	accNums := make([]uint64, len(privs))
+	accSeqs := make([]uint64, len(privs))
+	for i, priv := range privs {
+		addr := sdk.AccAddress(priv.PubKey().Address())
+		acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+		suite.accountKeeper.SetAccount(suite.ctx, acc)
+		msgs[i] = testdata.NewTestMsg(addr)
+		accNums[i] = acc.GetAccountNumber()
+	}
+
+	createTx := func(invalidSig int) (authsign.Tx, []byte) {
+		suite.txBuilder = txConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(msgs...))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		tx, err := suite.CreateTestTx(suite.ctx, privs, accNums, accSeqs, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+
+		if invalidSig >= 0 {
+			sigs, err := tx.GetSignaturesV2()
+			require.NoError(t, err)
+			sigs[invalidSig].Data.(*signing.SingleSignatureData).Signature[7] ^= byte(0x01)
+			require.NoError(t, suite.txBuilder.SetSignatures(sigs...))
+			tx = suite.txBuilder.GetTx()
+		}
+
+		txBytes, err := txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return tx, txBytes
+	}
+
+	validTx, validTxBytes := createTx(-1)
+	invalidTx, invalidTxBytes := createTx(1)
+
+	batchVerifier := ante.NewSigBatchVerifier(suite.accountKeeper, txConfig.SignModeHandler(), txConfig.TxDecoder())
+	batchVerifier.VerifyBlock(suite.ctx, [][]byte{validTxBytes, []byte("invalid tx"), invalidTxBytes})
+
+	newAnteHandler := func(opts ...ante.SigVerificationDecoratorOption) sdk.AnteHandler {
+		return sdk.ChainAnteDecorators(
+			ante.NewSetPubKeyDecorator(suite.accountKeeper),
+			ante.NewSigGasConsumeDecorator(suite.accountKeeper, ante.DefaultSigVerificationGasConsumer),
+			ante.NewSigVerificationDecorator(suite.accountKeeper, txConfig.SignModeHandler(), opts...),
+		)
+	}
+
+	// the sign bytes of the signatures verified in batch are not computed
+	// again, only the ones of the secp256k1 signature are
+	signModeHandler := &countingSignModeHandler{SignModeHandler: direct.SignModeHandler{}}
+	handlerMap := txsigning.NewHandlerMap(signModeHandler)
+	ctx, _ := suite.ctx.WithTxBytes(validTxBytes).CacheContext()
+	_, err := sdk.ChainAnteDecorators(
+		ante.NewSetPubKeyDecorator(suite.accountKeeper),
+		ante.NewSigVerificationDecorator(suite.accountKeeper, handlerMap, ante.WithSigBatchVerifier(batchVerifier)),
+	)(ctx, validTx, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, signModeHandler.calls)
+
+	// the txs have the same result and consume the same gas with and without
+	// the verification in batch
+	for _, tc := range []struct {
+		name      string
+		tx        authsign.Tx
+		txBytes   []byte
+		shouldErr bool
+	}{
+		{"valid tx", validTx, validTxBytes, false},
+		{"invalid signature", invalidTx, invalidTxBytes, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			run := func(anteHandler sdk.AnteHandler) (storetypes.Gas, error) {
+				ctx, _ := suite.ctx.WithTxBytes(tc.txBytes).WithGasMeter(storetypes.NewInfiniteGasMeter()).CacheContext()
+				ctx, err := anteHandler(ctx, tc.tx, false)
+				return ctx.GasMeter().GasConsumed(), err
+			}
+
+			gas, err := run(newAnteHandler())
+			batchGas, batchErr := run(newAnteHandler(ante.WithSigBatchVerifier(batchVerifier)))
+			if tc.shouldErr {
+				require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+				require.Equal(t, err.Error(), batchErr.Error())
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, batchErr)
+				require.Equal(t, gas, batchGas)
+			}
+		})
+	}
+}
+
+// countingSignModeHandler counts the sign bytes it computes.
+type countingSignModeHandler struct {
+	txsigning.SignModeHandler
+	calls int
+}
+
+func (h *countingSignModeHandler) GetSignBytes(ctx context.Context, signerData txsigning.SignerData, txData txsigning.TxData) ([]byte, error) {
+	h.calls++
+	return h.SignModeHandler.GetSignBytes(ctx, signerData, txData)
+}
@@ -11,10 +11,13 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/webauthn"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -134,7 +137,10 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 	}{
 		{"PubKeyEd25519", args{storetypes.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, p.SigVerifyCostED25519, false},
 		{"PubKeySecp256k1", args{storetypes.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
+		{"PubKeyEthSecp256k1", args{storetypes.NewInfiniteGasMeter(), nil, ethsecp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
 		{"PubKeySecp256r1", args{storetypes.NewInfiniteGasMeter(), nil, skR1.PubKey(), params}, p.SigVerifyCostSecp256r1(), false},
+		{"PubKeyWebAuthn", args{storetypes.NewInfiniteGasMeter(), nil, webauthn.NewPubKey(skR1.PubKey().(*secp256r1.PubKey)), params}, p.SigVerifyCostWebAuthn(), false},
+		{"PubKeyBLS12381", args{storetypes.NewInfiniteGasMeter(), nil, &bls12_381.PubKey{}, params}, p.SigVerifyCostBLS12381(), false},
 		{"Multisig", args{storetypes.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
 		{"unknown key", args{storetypes.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
@@ -277,6 +283,50 @@ func TestSigVerification(t *testing.T) {
 	}
 }
 
+func TestSigVerificationRotatedPubKey(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	oldPriv, _, addr := testdata.KeyTestPubAddr()
+	newPriv, newPub, _ := testdata.KeyTestPubAddr()
+	acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	require.NoError(t, acc.SetAccountNumber(1000))
+	require.NoError(t, acc.SetPubKey(oldPriv.PubKey()))
+	suite.accountKeeper.SetAccount(suite.ctx, acc)
+	require.NoError(t, suite.accountKeeper.RotatePubKey(suite.ctx, addr, newPub))
+
+	spkd := ante.NewSetPubKeyDecorator(suite.accountKeeper)
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+	antehandler := sdk.ChainAnteDecorators(spkd, svd)
+
+	testCases := []struct {
+		name      string
+		priv      cryptotypes.PrivKey
+		expErrMsg string
+	}{
+		{"signed by the old key", oldPriv, "pubKey does not match the pubKey of signer"},
+		{"signed by the new key", newPriv, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+			tx, err := suite.CreateTestTx(suite.ctx, []cryptotypes.PrivKey{tc.priv}, []uint64{1000}, []uint64{0}, suite.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+			require.NoError(t, err)
+
+			_, err = antehandler(suite.ctx, tx, false)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestSigIntegration(t *testing.T) {
 	// generate private keys
 	privs := []cryptotypes.PrivKey{
@@ -72,6 +72,36 @@ func (mr *MockAccountKeeperMockRecorder) GetAccount(ctx, addr any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockAccountKeeper)(nil).GetAccount), ctx, addr)
 }
 
+// GetAccountAuthenticators mocks base method.
+func (m *MockAccountKeeper) GetAccountAuthenticators(ctx context.Context, addr types.AccAddress) ([]types0.AccountAuthenticator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountAuthenticators", ctx, addr)
+	ret0, _ := ret[0].([]types0.AccountAuthenticator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountAuthenticators indicates an expected call of GetAccountAuthenticators.
+func (mr *MockAccountKeeperMockRecorder) GetAccountAuthenticators(ctx, addr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountAuthenticators", reflect.TypeOf((*MockAccountKeeper)(nil).GetAccountAuthenticators), ctx, addr)
+}
+
+// GetAuthenticator mocks base method.
+func (m *MockAccountKeeper) GetAuthenticator(authenticatorType string) (types0.Authenticator, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthenticator", authenticatorType)
+	ret0, _ := ret[0].(types0.Authenticator)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetAuthenticator indicates an expected call of GetAuthenticator.
+func (mr *MockAccountKeeperMockRecorder) GetAuthenticator(authenticatorType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthenticator", reflect.TypeOf((*MockAccountKeeper)(nil).GetAuthenticator), authenticatorType)
+}
+
 // GetModuleAddress mocks base method.
 func (m *MockAccountKeeper) GetModuleAddress(moduleName string) types.AccAddress {
 	m.ctrl.T.Helper()
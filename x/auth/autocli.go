@@ -74,6 +74,40 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Query the current auth parameters",
 				},
+				{
+					RpcMethod: "FeeMarketParams",
+					Use:       "fee-market-params",
+					Short:     "Query the current fee market parameters",
+				},
+				{
+					RpcMethod:      "AccountAuthenticators",
+					Use:            "authenticators [address]",
+					Short:          "Query the authenticators an account opted into",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "BaseGasPrice",
+					Use:       "base-gas-price",
+					Short:     "Query the current base gas price of the fee market",
+				},
+				{
+					RpcMethod: "UnorderedNonces",
+					Use:       "unordered-nonces",
+					Short:     "Query the nonces of the unordered transactions which did not expire yet",
+					Example:   fmt.Sprintf("%s q auth unordered-nonces --sender cosmos1... --timeout-start 2025-01-01T00:00:00Z", version.AppName),
+				},
+				{
+					RpcMethod:      "UnorderedNonce",
+					Use:            "unordered-nonce [sender] [timeout]",
+					Short:          "Query whether a sender already used a timeout for an unordered transaction",
+					Example:        fmt.Sprintf("%s q auth unordered-nonce cosmos1... 2025-01-01T00:00:00Z", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender"}, {ProtoField: "timeout"}},
+				},
+				{
+					RpcMethod: "UnorderedNoncesStats",
+					Use:       "unordered-nonces-stats",
+					Short:     "Query aggregate statistics of the nonces of the unordered transactions",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -83,10 +117,43 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
 					Short:          "Submit a proposal to update auth module params. Note: the entire params must be provided.",
-					Example:        fmt.Sprintf(`%s tx auth update-params-proposal '{ "max_memo_characters": 0, "tx_sig_limit": 0, "tx_size_cost_per_byte": 0, "sig_verify_cost_ed25519": 0, "sig_verify_cost_secp256k1": 0 }'`, version.AppName),
+					Example:        fmt.Sprintf(`%s tx auth update-params-proposal '{ "max_memo_characters": 0, "tx_sig_limit": 0, "tx_size_cost_per_byte": 0, "sig_verify_cost_ed25519": 0, "sig_verify_cost_secp256k1": 0, "pub_key_rotation_cooldown": "86400s" }'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "UpdateFeeMarketParams",
+					Use:            "update-fee-market-params-proposal [params]",
+					Short:          "Submit a proposal to update the auth fee market params. Note: the entire params must be provided.",
+					Example:        fmt.Sprintf(`%s tx auth update-fee-market-params-proposal '{ "enabled": true, "fee_denom": "stake", "min_base_gas_price": "0.001", "max_base_gas_price": "10", "target_block_gas": 50000000, "base_gas_price_change_denominator": 8 }'`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "RotatePubKey",
+					Use:            "rotate-pub-key [new-pub-key]",
+					Short:          "Replace the public key of the signer account. The transaction must be signed by the current key.",
+					Example:        fmt.Sprintf(`%s tx auth rotate-pub-key '{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"A0Q1yTJKaCa1GnfGoNr9Kf/sZNNgSEqbUuPKDwGWRm1c"}' --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "new_pub_key"}},
+				},
+				{
+					RpcMethod: "AddAuthenticator",
+					Use:       "add-authenticator [authenticator-type] [config]",
+					Short:     "Opt the signer account into an authenticator. Once an account has authenticators, its transactions are authenticated by them instead of its public key.",
+					Long: `Opt the signer account into an authenticator. Once an account has authenticators, its transactions are authenticated by them instead of its public key.
+The config is the configuration of the authenticator, given as a file path, or a hex or base64 string. The "signature" authenticator takes no config,
+the "session_key" authenticator takes a protobuf encoded cosmos.auth.v1beta1.SessionKeyConfig.`,
+					Example: fmt.Sprintf(`%s tx auth add-authenticator signature --from mykey
+%s tx auth add-authenticator session_key session_key_config.bin --from mykey`, version.AppName, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "authenticator_type"}, {ProtoField: "config", Optional: true}},
+				},
+				{
+					RpcMethod:      "RemoveAuthenticator",
+					Use:            "remove-authenticator [id]",
+					Short:          "Remove an authenticator of the signer account",
+					Example:        fmt.Sprintf(`%s tx auth remove-authenticator 1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
 			},
 		},
 	}
@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// GetAggregateSignaturesCommand returns the aggregate-signatures command.
+func GetAggregateSignaturesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-signatures [file] [[signature-file]...]",
+		Short: "Aggregate the BLS12-381 signatures of a transaction generated offline",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Aggregate the signatures of the BLS12-381 signers of a transaction into a single
+signature, reducing the size of the transaction.
+
+Read the transaction from [file], along with the signatures of its signers from the
+optional [signature-file]s generated by the sign command with the --signature-only flag,
+and print the transaction with the aggregate signature.
+
+Example:
+$ %s tx aggregate-signatures transaction.json k1sig.json k2sig.json k3sig.json
+
+Only signatures made in the amino-json sign mode can be aggregated.
+`,
+				version.AppName,
+			),
+		),
+		RunE: makeAggregateSignaturesCmd(),
+		Args: cobra.MinimumNArgs(1),
+	}
+
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.Flags().MarkHidden(flags.FlagOutput)
+
+	return cmd
+}
+
+func makeAggregateSignaturesCmd() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+		parsedTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+		if err != nil {
+			return err
+		}
+
+		txCfg := clientCtx.TxConfig
+		txBuilder, err := txCfg.WrapTxBuilder(parsedTx)
+		if err != nil {
+			return err
+		}
+
+		if len(args) > 1 {
+			sigs, err := txBuilder.GetTx().GetSignaturesV2()
+			if err != nil {
+				return err
+			}
+			for _, filename := range args[1:] {
+				fileSigs, err := unmarshalSignatureJSON(clientCtx, filename)
+				if err != nil {
+					return err
+				}
+				sigs = append(sigs, fileSigs...)
+			}
+
+			sigs, err = sortSignaturesBySigners(txBuilder.GetTx(), sigs)
+			if err != nil {
+				return err
+			}
+			if err := txBuilder.SetSignatures(sigs...); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.AggregateSignatures(txBuilder); err != nil {
+			return err
+		}
+
+		json, err := marshalSignatureJSON(txCfg, txBuilder, false)
+		if err != nil {
+			return err
+		}
+
+		closeFunc, err := setOutputFile(cmd)
+		if err != nil {
+			return err
+		}
+
+		defer closeFunc()
+
+		cmd.Printf("%s\n", json)
+		return nil
+	}
+}
+
+// sortSignaturesBySigners returns the signatures of the signers of tx, in the
+// order of the signers.
+func sortSignaturesBySigners(tx signing.Tx, sigs []signingtypes.SignatureV2) ([]signingtypes.SignatureV2, error) {
+	signers, err := tx.GetSigners()
+	if err != nil {
+		return nil, err
+	}
+
+	sigsBySigner := make(map[string]signingtypes.SignatureV2, len(sigs))
+	for _, sig := range sigs {
+		if sig.PubKey == nil {
+			continue
+		}
+		sigsBySigner[string(sig.PubKey.Address())] = sig
+	}
+
+	sorted := make([]signingtypes.SignatureV2, len(signers))
+	for i, signer := range signers {
+		sig, ok := sigsBySigner[string(signer)]
+		if !ok {
+			return nil, fmt.Errorf("missing signature of signer %s", sdk.AccAddress(signer))
+		}
+		sorted[i] = sig
+	}
+
+	return sorted, nil
+}
@@ -0,0 +1,286 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// GetMultisignSessionCommand returns the multisign-session command and its
+// subcommands.
+func GetMultisignSessionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisign-session",
+		Short: "Sign transactions generated offline by the members of a multisig in turn",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sign a transaction created with the --generate-only flag by the members of a
+multisig account in turn, through a session file passed from one member to the next.
+
+Example:
+$ %[1]s tx multisign-session create transaction.json k1k2k3 session.json --chain-id=<chain-id>
+$ %[1]s tx multisign-session sign session.json --from k1
+$ %[1]s tx multisign-session sign session.json --from k2
+$ %[1]s tx multisign-session status session.json
+$ %[1]s tx multisign-session finalize session.json --output-document=signed.json
+
+The signatures are verified locally as they are added to the session, and again
+when the session is finalized.
+
+The sessions default to amino-json sign mode.
+The SIGN_MODE_DIRECT sign mode is not supported.
+`,
+				version.AppName,
+			),
+		),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		getMultisignSessionCreateCmd(),
+		getMultisignSessionSignCmd(),
+		getMultisignSessionStatusCmd(),
+		getMultisignSessionFinalizeCmd(),
+	)
+
+	return cmd
+}
+
+func getMultisignSessionCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [file] [name] [session-file]",
+		Short: "Create a signing session of a transaction by the multisig key [name]",
+		Long: `Create a signing session of the transaction read from [file] by the members of the
+multisig key [name], and write it to [session-file].
+
+If the --offline flag is on, the client will not reach out to an external node.
+Account number or sequence number lookups are not performed so you must
+set these parameters manually.
+`,
+		RunE: makeMultisignSessionCreateCmd(),
+		Args: cobra.ExactArgs(3),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.Flags().MarkHidden(flags.FlagOutput)
+
+	return cmd
+}
+
+func makeMultisignSessionCreateCmd() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		_ = cmd.Flags().Set(flags.FlagFrom, args[1])
+
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+		parsedTx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+		if err != nil {
+			return err
+		}
+		sigTx, ok := parsedTx.(signing.Tx)
+		if !ok {
+			return fmt.Errorf("expected tx to implement signing.Tx, got %T", parsedTx)
+		}
+
+		txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		k, err := getMultisigRecord(clientCtx, args[1])
+		if err != nil {
+			return err
+		}
+		pubKey, err := k.GetPubKey()
+		if err != nil {
+			return err
+		}
+		multisigPub, ok := pubKey.(multisig.PubKey)
+		if !ok {
+			return fmt.Errorf("%s is not a multisig key", args[1])
+		}
+
+		if !clientCtx.Offline {
+			addr, err := k.GetAddress()
+			if err != nil {
+				return err
+			}
+			accnum, seq, err := clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, addr)
+			if err != nil {
+				return err
+			}
+
+			txFactory = txFactory.WithAccountNumber(accnum).WithSequence(seq)
+		}
+
+		if txFactory.ChainID() == "" {
+			return fmt.Errorf("set the chain id with either the --chain-id flag or config file")
+		}
+
+		session, err := tx.NewMultisignSession(
+			sigTx, multisigPub, txFactory.ChainID(), txFactory.AccountNumber(), txFactory.Sequence(), txFactory.SignMode(),
+		)
+		if err != nil {
+			return err
+		}
+
+		return tx.WriteMultisignSession(clientCtx, args[2], session)
+	}
+}
+
+func getMultisignSessionSignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [session-file]",
+		Short: "Add the signature of a member of the multisig to a signing session",
+		Long: `Sign the transaction of the signing session read from [session-file] with the key
+set by the --from flag, which must be a member of the multisig, and write the session
+with its signature back to [session-file].
+
+The signature replaces any previous signature of the same member.
+`,
+		RunE: makeMultisignSessionSignCmd(),
+		Args: cobra.ExactArgs(1),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.Flags().MarkHidden(flags.FlagOutput)
+
+	return cmd
+}
+
+func makeMultisignSessionSignCmd() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+		if clientCtx.FromName == "" {
+			return fmt.Errorf("set the signing key with the --from flag")
+		}
+
+		session, err := tx.ReadMultisignSession(clientCtx, args[0])
+		if err != nil {
+			return err
+		}
+
+		txFactory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		if err := session.Sign(cmd.Context(), txFactory, clientCtx.FromName); err != nil {
+			return err
+		}
+		if err := session.Verify(cmd.Context(), clientCtx.TxConfig); err != nil {
+			return err
+		}
+
+		return tx.WriteMultisignSession(clientCtx, args[0], session)
+	}
+}
+
+func getMultisignSessionStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status [session-file]",
+		Short: "Show the signature progress of a signing session",
+		Long: `Show the threshold of the multisig of the signing session read from [session-file],
+along with the members which have signed and which have yet to sign.
+`,
+		RunE: makeMultisignSessionStatusCmd(),
+		Args: cobra.ExactArgs(1),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func makeMultisignSessionStatusCmd() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientQueryContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		session, err := tx.ReadMultisignSession(clientCtx, args[0])
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintObjectLegacy(session.Status())
+	}
+}
+
+func getMultisignSessionFinalizeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize [session-file]",
+		Short: "Generate the multisig signature of a signing session",
+		Long: `Verify the signatures of the signing session read from [session-file], and once
+the threshold of the multisig is met, print the transaction with the multisig signature.
+
+If --signature-only flag is on, output a JSON representation
+of only the generated signature.
+`,
+		RunE: makeMultisignSessionFinalizeCmd(),
+		Args: cobra.ExactArgs(1),
+	}
+
+	cmd.Flags().Bool(flagSigOnly, false, "Print only the generated signature, then exit")
+	cmd.Flags().String(flags.FlagOutputDocument, "", "The document is written to the given file instead of STDOUT")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.Flags().MarkHidden(flags.FlagOutput)
+
+	return cmd
+}
+
+func makeMultisignSessionFinalizeCmd() func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		session, err := tx.ReadMultisignSession(clientCtx, args[0])
+		if err != nil {
+			return err
+		}
+
+		signedTx, err := session.Finalize(cmd.Context(), clientCtx.TxConfig)
+		if err != nil {
+			return err
+		}
+
+		txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(signedTx)
+		if err != nil {
+			return err
+		}
+
+		sigOnly, _ := cmd.Flags().GetBool(flagSigOnly)
+		json, err := marshalSignatureJSON(clientCtx.TxConfig, txBuilder, sigOnly)
+		if err != nil {
+			return err
+		}
+
+		closeFunc, err := setOutputFile(cmd)
+		if err != nil {
+			return err
+		}
+
+		defer closeFunc()
+
+		cmd.Printf("%s\n", json)
+		return nil
+	}
+}
@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RegisterAuthenticator registers an authenticator accounts can opt into. It
+// panics if an authenticator of the same type is already registered.
+func (ak AccountKeeper) RegisterAuthenticator(authenticator types.Authenticator) {
+	authenticatorType := authenticator.Type()
+	if _, ok := ak.authenticators[authenticatorType]; ok {
+		panic(fmt.Sprintf("authenticator %s is already registered", authenticatorType))
+	}
+
+	ak.authenticators[authenticatorType] = authenticator
+}
+
+// GetAuthenticator returns the registered authenticator of the given type.
+func (ak AccountKeeper) GetAuthenticator(authenticatorType string) (types.Authenticator, bool) {
+	authenticator, ok := ak.authenticators[authenticatorType]
+	return authenticator, ok
+}
+
+// GetAccountAuthenticators returns the authenticators the account at addr opted
+// into, ordered by identifier.
+func (ak AccountKeeper) GetAccountAuthenticators(ctx context.Context, addr sdk.AccAddress) ([]types.AccountAuthenticator, error) {
+	iter, err := ak.AccountAuthenticators.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr))
+	if err != nil {
+		return nil, err
+	}
+
+	return iter.Values()
+}
+
+// AddAuthenticator opts the account at addr into the registered authenticator
+// of the given type, returning the identifier of the account authenticator.
+func (ak AccountKeeper) AddAuthenticator(ctx context.Context, addr sdk.AccAddress, authenticatorType string, config []byte) (uint64, error) {
+	if !ak.HasAccount(ctx, addr) {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+
+	authenticator, ok := ak.GetAuthenticator(authenticatorType)
+	if !ok {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown authenticator type %s", authenticatorType)
+	}
+	if err := authenticator.ValidateConfig(config); err != nil {
+		return 0, err
+	}
+
+	authenticators, err := ak.GetAccountAuthenticators(ctx, addr)
+	if err != nil {
+		return 0, err
+	}
+	if len(authenticators) >= types.MaxAccountAuthenticators {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"account %s cannot have more than %d authenticators", addr, types.MaxAccountAuthenticators)
+	}
+
+	addrStr, err := ak.addressCodec.BytesToString(addr)
+	if err != nil {
+		return 0, err
+	}
+
+	accountAuthenticator := types.AccountAuthenticator{
+		Address:           addrStr,
+		AuthenticatorType: authenticatorType,
+		Config:            config,
+	}
+	if err := ak.assertNotLockedOut(addr, append(authenticators, accountAuthenticator)); err != nil {
+		return 0, err
+	}
+
+	id, err := ak.NextAuthenticatorID.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	accountAuthenticator.Id = id
+	if err := ak.AccountAuthenticators.Set(ctx, collections.Join(addr, id), accountAuthenticator); err != nil {
+		return 0, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddAuthenticator,
+			sdk.NewAttribute(types.AttributeKeyAddress, addrStr),
+			sdk.NewAttribute(types.AttributeKeyAuthenticatorID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAuthenticatorType, authenticatorType),
+		),
+	)
+
+	return id, nil
+}
+
+// RemoveAuthenticator removes the authenticator with the given identifier from
+// the authenticators of the account at addr. Removing the last authenticator
+// of the account authenticates it with its public key again.
+func (ak AccountKeeper) RemoveAuthenticator(ctx context.Context, addr sdk.AccAddress, id uint64) error {
+	key := collections.Join(addr, id)
+	accountAuthenticator, err := ak.AccountAuthenticators.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrapf(sdkerrors.ErrNotFound, "authenticator %d of account %s not found", id, addr)
+		}
+		return err
+	}
+
+	authenticators, err := ak.GetAccountAuthenticators(ctx, addr)
+	if err != nil {
+		return err
+	}
+	authenticators = slices.DeleteFunc(authenticators, func(a types.AccountAuthenticator) bool { return a.Id == id })
+	if err := ak.assertNotLockedOut(addr, authenticators); err != nil {
+		return err
+	}
+
+	if err := ak.AccountAuthenticators.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveAuthenticator,
+			sdk.NewAttribute(types.AttributeKeyAddress, accountAuthenticator.Address),
+			sdk.NewAttribute(types.AttributeKeyAuthenticatorID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAuthenticatorType, accountAuthenticator.AuthenticatorType),
+		),
+	)
+
+	return nil
+}
+
+// assertNotLockedOut returns an error if the account at addr would be locked out
+// with the given authenticators, i.e. if it has authenticators but none of them
+// is registered and does not expire. An account without authenticators is
+// authenticated with its public key.
+func (ak AccountKeeper) assertNotLockedOut(addr sdk.AccAddress, authenticators []types.AccountAuthenticator) error {
+	if len(authenticators) == 0 {
+		return nil
+	}
+
+	for _, accountAuthenticator := range authenticators {
+		authenticator, ok := ak.GetAuthenticator(accountAuthenticator.AuthenticatorType)
+		if !ok {
+			continue
+		}
+		if expiring, ok := authenticator.(types.ExpiringAuthenticator); !ok || !expiring.Expires(accountAuthenticator.Config) {
+			return nil
+		}
+	}
+
+	return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+		"account %s must keep an authenticator which does not expire, such as a %s authenticator", addr, types.SignatureAuthenticatorType)
+}
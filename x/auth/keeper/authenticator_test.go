@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestAddAuthenticator() {
+	ctx := suite.ctx
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, addr))
+
+	sessionKey, err := types.NewSessionKeyConfig(secp256k1.GenPrivKey().PubKey(), []string{sdk.MsgTypeURL(&types.MsgUpdateParams{})}, time.Unix(2000, 0))
+	suite.Require().NoError(err)
+	sessionKeyConfig, err := suite.encCfg.Codec.Marshal(sessionKey)
+	suite.Require().NoError(err)
+	noExpiry := *sessionKey
+	noExpiry.Expiry = time.Time{}
+	noExpiryConfig, err := suite.encCfg.Codec.Marshal(&noExpiry)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgAddAuthenticator
+		expErrMsg string
+		expID     uint64
+	}{
+		{
+			name:      "invalid address",
+			req:       &types.MsgAddAuthenticator{Sender: "foo", AuthenticatorType: types.SignatureAuthenticatorType},
+			expErrMsg: "invalid sender address",
+		},
+		{
+			name:      "unknown account",
+			req:       &types.MsgAddAuthenticator{Sender: sdk.AccAddress("unknown").String(), AuthenticatorType: types.SignatureAuthenticatorType},
+			expErrMsg: "does not exist",
+		},
+		{
+			name:      "unknown authenticator type",
+			req:       &types.MsgAddAuthenticator{Sender: addr.String(), AuthenticatorType: "unknown"},
+			expErrMsg: "unknown authenticator type unknown",
+		},
+		{
+			name:      "signature authenticator with config",
+			req:       &types.MsgAddAuthenticator{Sender: addr.String(), AuthenticatorType: types.SignatureAuthenticatorType, Config: []byte{1}},
+			expErrMsg: "signature authenticator takes no config",
+		},
+		{
+			name:      "invalid session key config",
+			req:       &types.MsgAddAuthenticator{Sender: addr.String(), AuthenticatorType: types.SessionKeyAuthenticatorType, Config: []byte("foo")},
+			expErrMsg: "invalid session key config",
+		},
+		{
+			name:      "session key without expiry",
+			req:       &types.MsgAddAuthenticator{Sender: addr.String(), AuthenticatorType: types.SessionKeyAuthenticatorType, Config: noExpiryConfig},
+			expErrMsg: "session key expiry must be set",
+		},
+		{
+			name:  "signature authenticator",
+			req:   &types.MsgAddAuthenticator{Sender: addr.String(), AuthenticatorType: types.SignatureAuthenticatorType},
+			expID: 0,
+		},
+		{
+			name:  "session key authenticator",
+			req:   &types.MsgAddAuthenticator{Sender: addr.String(), AuthenticatorType: types.SessionKeyAuthenticatorType, Config: sessionKeyConfig},
+			expID: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.msgServer.AddAuthenticator(ctx, tc.req)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expID, res.Id)
+			}
+		})
+	}
+
+	queryRes, err := suite.queryClient.AccountAuthenticators(ctx, &types.QueryAccountAuthenticatorsRequest{Address: addr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.AccountAuthenticator{
+		{Address: addr.String(), Id: 0, AuthenticatorType: types.SignatureAuthenticatorType},
+		{Address: addr.String(), Id: 1, AuthenticatorType: types.SessionKeyAuthenticatorType, Config: sessionKeyConfig},
+	}, queryRes.Authenticators)
+
+	events := ctx.EventManager().Events()
+	suite.Require().Equal(types.EventTypeAddAuthenticator, events[len(events)-1].Type)
+
+	// an account cannot opt into more than MaxAccountAuthenticators authenticators
+	for range types.MaxAccountAuthenticators - 2 {
+		_, err := suite.accountKeeper.AddAuthenticator(ctx, addr, types.SignatureAuthenticatorType, nil)
+		suite.Require().NoError(err)
+	}
+	_, err = suite.accountKeeper.AddAuthenticator(ctx, addr, types.SignatureAuthenticatorType, nil)
+	suite.Require().ErrorContains(err, "cannot have more than")
+}
+
+func (suite *KeeperTestSuite) TestRemoveAuthenticator() {
+	ctx := suite.ctx
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	otherAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, addr))
+	suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, otherAddr))
+
+	id, err := suite.accountKeeper.AddAuthenticator(ctx, addr, types.SignatureAuthenticatorType, nil)
+	suite.Require().NoError(err)
+
+	// an account cannot remove the authenticators of another account
+	_, err = suite.msgServer.RemoveAuthenticator(ctx, &types.MsgRemoveAuthenticator{Sender: otherAddr.String(), Id: id})
+	suite.Require().ErrorContains(err, "not found")
+
+	_, err = suite.msgServer.RemoveAuthenticator(ctx, &types.MsgRemoveAuthenticator{Sender: addr.String(), Id: id})
+	suite.Require().NoError(err)
+
+	authenticators, err := suite.accountKeeper.GetAccountAuthenticators(ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Empty(authenticators)
+
+	events := ctx.EventManager().Events()
+	suite.Require().Equal(types.EventTypeRemoveAuthenticator, events[len(events)-1].Type)
+
+	_, err = suite.msgServer.RemoveAuthenticator(ctx, &types.MsgRemoveAuthenticator{Sender: addr.String(), Id: id})
+	suite.Require().ErrorContains(err, "not found")
+}
+
+func (suite *KeeperTestSuite) TestAuthenticatorsLockout() {
+	ctx := suite.ctx
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, addr))
+
+	sessionKey, err := types.NewSessionKeyConfig(secp256k1.GenPrivKey().PubKey(), []string{sdk.MsgTypeURL(&types.MsgUpdateParams{})}, time.Unix(2000, 0))
+	suite.Require().NoError(err)
+	sessionKeyConfig, err := suite.encCfg.Codec.Marshal(sessionKey)
+	suite.Require().NoError(err)
+
+	// an account cannot be authenticated by session keys only, as it would be
+	// locked out once they expire
+	_, err = suite.accountKeeper.AddAuthenticator(ctx, addr, types.SessionKeyAuthenticatorType, sessionKeyConfig)
+	suite.Require().ErrorContains(err, "must keep an authenticator which does not expire")
+
+	signatureID, err := suite.accountKeeper.AddAuthenticator(ctx, addr, types.SignatureAuthenticatorType, nil)
+	suite.Require().NoError(err)
+	sessionKeyID, err := suite.accountKeeper.AddAuthenticator(ctx, addr, types.SessionKeyAuthenticatorType, sessionKeyConfig)
+	suite.Require().NoError(err)
+
+	// nor can it remove its last authenticator which does not expire
+	_, err = suite.msgServer.RemoveAuthenticator(ctx, &types.MsgRemoveAuthenticator{Sender: addr.String(), Id: signatureID})
+	suite.Require().ErrorContains(err, "must keep an authenticator which does not expire")
+
+	// removing all its authenticators authenticates the account with its
+	// public key again
+	suite.Require().NoError(suite.accountKeeper.RemoveAuthenticator(ctx, addr, sessionKeyID))
+	suite.Require().NoError(suite.accountKeeper.RemoveAuthenticator(ctx, addr, signatureID))
+
+	authenticators, err := suite.accountKeeper.GetAccountAuthenticators(ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Empty(authenticators)
+}
+
+func (suite *KeeperTestSuite) TestAuthenticatorsGenesis() {
+	ctx := suite.ctx
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	genState := types.DefaultGenesisState()
+	genState.Authenticators = []types.AccountAuthenticator{
+		{Address: addr.String(), Id: 4, AuthenticatorType: types.SignatureAuthenticatorType},
+	}
+	suite.accountKeeper.InitGenesis(ctx, *genState)
+	suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, addr))
+
+	exported := suite.accountKeeper.ExportGenesis(ctx)
+	suite.Require().Equal(genState.Authenticators, exported.Authenticators)
+
+	// the identifiers of the new authenticators follow the imported ones
+	id, err := suite.accountKeeper.AddAuthenticator(ctx, addr, types.SignatureAuthenticatorType, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(5), id)
+}
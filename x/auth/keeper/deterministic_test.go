@@ -5,6 +5,7 @@ import (
 	"sort"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"pgregory.net/rapid"
@@ -207,6 +208,7 @@ func (suite *DeterministicTestSuite) TestGRPCQueryParameters() {
 			rapid.Uint64Min(1).Draw(t, "sig-verify-cost-ed25519"),
 			rapid.Uint64Min(1).Draw(t, "sig-verify-cost-Secp256k1"),
 		)
+		params.PubKeyRotationCooldown = time.Duration(rapid.Int64Min(0).Draw(t, "pub-key-rotation-cooldown"))
 		err := suite.accountKeeper.Params.Set(suite.ctx, params)
 		suite.Require().NoError(err)
 
@@ -221,7 +223,7 @@ func (suite *DeterministicTestSuite) TestGRPCQueryParameters() {
 	suite.Require().NoError(err)
 
 	req := &types.QueryParamsRequest{}
-	testdata.DeterministicIterations(suite.ctx, suite.T(), req, suite.queryClient.Params, 1042, false)
+	testdata.DeterministicIterations(suite.ctx, suite.T(), req, suite.queryClient.Params, 1048, false)
 }
 
 func (suite *DeterministicTestSuite) TestGRPCQueryAccountInfo() {
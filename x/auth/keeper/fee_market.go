@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GetFeeMarketParams gets the fee market parameters.
+func (ak AccountKeeper) GetFeeMarketParams(ctx context.Context) (params types.FeeMarketParams) {
+	params, err := ak.FeeMarketParams.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		panic(err)
+	}
+	return params
+}
+
+// GetBaseGasPrice returns the base gas price of the current block. When the
+// fee market is disabled, a zero gas price is returned.
+func (ak AccountKeeper) GetBaseGasPrice(ctx context.Context) (sdk.DecCoin, error) {
+	params := ak.GetFeeMarketParams(ctx)
+	if !params.Enabled {
+		// the params may not have been set yet (e.g. before an upgrade), hence
+		// the denom is not validated.
+		return sdk.DecCoin{Denom: params.FeeDenom, Amount: math.LegacyZeroDec()}, nil
+	}
+
+	price, err := ak.BaseGasPrice.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return sdk.DecCoin{}, err
+	}
+
+	return sdk.NewDecCoinFromDec(params.FeeDenom, params.ClampBaseGasPrice(price)), nil
+}
+
+// UpdateBaseGasPrice adjusts the base gas price according to the gas consumed
+// by the current block. It is a no-op when the fee market is disabled.
+func (ak AccountKeeper) UpdateBaseGasPrice(ctx context.Context, blockGasUsed uint64) error {
+	params := ak.GetFeeMarketParams(ctx)
+	if !params.Enabled {
+		return nil
+	}
+
+	price, err := ak.BaseGasPrice.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	next := params.NextBaseGasPrice(price, blockGasUsed)
+	if err := ak.BaseGasPrice.Set(ctx, next); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeMarket,
+			sdk.NewAttribute(types.AttributeKeyBaseGasPrice, sdk.NewDecCoinFromDec(params.FeeDenom, next).String()),
+			sdk.NewAttribute(types.AttributeKeyBlockGasUsed, math.NewIntFromUint64(blockGasUsed).String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestBaseGasPrice() {
+	ctx := suite.ctx
+
+	// the fee market is disabled by default
+	price, err := suite.accountKeeper.GetBaseGasPrice(ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(price.IsZero())
+	suite.Require().NoError(suite.accountKeeper.UpdateBaseGasPrice(ctx, 1_000_000))
+	has, err := suite.accountKeeper.BaseGasPrice.Has(ctx)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	params := types.NewFeeMarketParams(true, "stake", math.LegacyNewDecWithPrec(1, 2), math.LegacyNewDec(2), 1000, 8)
+	suite.Require().NoError(suite.accountKeeper.FeeMarketParams.Set(ctx, params))
+
+	// an unset base gas price defaults to the minimum
+	price, err = suite.accountKeeper.GetBaseGasPrice(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal("stake", price.Denom)
+	suite.Require().True(price.Amount.Equal(params.MinBaseGasPrice))
+
+	suite.Require().NoError(suite.accountKeeper.BaseGasPrice.Set(ctx, math.LegacyNewDec(1)))
+	suite.Require().NoError(suite.accountKeeper.UpdateBaseGasPrice(ctx, 2000))
+
+	price, err = suite.accountKeeper.GetBaseGasPrice(ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(price.Amount.Equal(math.LegacyNewDecWithPrec(1125, 3)))
+
+	events := ctx.EventManager().Events()
+	suite.Require().NotEmpty(events)
+	suite.Require().Equal(types.EventTypeFeeMarket, events[len(events)-1].Type)
+
+	res, err := suite.queryClient.BaseGasPrice(ctx, &types.QueryBaseGasPriceRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(price, res.BaseGasPrice)
+
+	paramsRes, err := suite.queryClient.FeeMarketParams(ctx, &types.QueryFeeMarketParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, paramsRes.Params)
+}
+
+func (suite *KeeperTestSuite) TestUpdateFeeMarketParams() {
+	params := types.NewFeeMarketParams(true, "stake", math.LegacyNewDecWithPrec(1, 2), math.LegacyNewDec(2), 1000, 8)
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgUpdateFeeMarketParams
+		expectErr bool
+		expErrMsg string
+	}{
+		{
+			name: "set invalid authority",
+			req: &types.MsgUpdateFeeMarketParams{
+				Authority: "foo",
+				Params:    params,
+			},
+			expectErr: true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "set invalid params",
+			req: &types.MsgUpdateFeeMarketParams{
+				Authority: suite.accountKeeper.GetAuthority(),
+				Params:    types.NewFeeMarketParams(true, "stake", math.LegacyZeroDec(), math.LegacyNewDec(2), 1000, 8),
+			},
+			expectErr: true,
+			expErrMsg: "min base gas price must be positive",
+		},
+		{
+			name: "set full valid params",
+			req: &types.MsgUpdateFeeMarketParams{
+				Authority: suite.accountKeeper.GetAuthority(),
+				Params:    params,
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.UpdateFeeMarketParams(suite.ctx, tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.req.Params, suite.accountKeeper.GetFeeMarketParams(suite.ctx))
+			}
+		})
+	}
+}
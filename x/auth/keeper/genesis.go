@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"time"
+
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
@@ -14,6 +18,16 @@ func (ak AccountKeeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		panic(err)
 	}
 
+	if err := ak.FeeMarketParams.Set(ctx, data.FeeMarketParams); err != nil {
+		panic(err)
+	}
+
+	if data.FeeMarketParams.Enabled {
+		if err := ak.BaseGasPrice.Set(ctx, data.FeeMarketParams.ClampBaseGasPrice(data.BaseGasPrice)); err != nil {
+			panic(err)
+		}
+	}
+
 	accounts, err := types.UnpackAccounts(data.Accounts)
 	if err != nil {
 		panic(err)
@@ -31,6 +45,43 @@ func (ak AccountKeeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		ak.SetAccount(ctx, acc)
 	}
 
+	for _, rotation := range data.PubKeyRotations {
+		addr, err := ak.addressCodec.StringToBytes(rotation.Address)
+		if err != nil {
+			panic(err)
+		}
+		if err := ak.PubKeyRotations.Set(ctx, addr, rotation.Time); err != nil {
+			panic(err)
+		}
+	}
+
+	// make sure the next authenticator id is greater than the ids of the
+	// genesis authenticators
+	var nextAuthenticatorID uint64
+	for _, authenticator := range data.Authenticators {
+		addr, err := ak.addressCodec.StringToBytes(authenticator.Address)
+		if err != nil {
+			panic(err)
+		}
+		if err := ak.AccountAuthenticators.Set(ctx, collections.Join(sdk.AccAddress(addr), authenticator.Id), authenticator); err != nil {
+			panic(err)
+		}
+		nextAuthenticatorID = max(nextAuthenticatorID, authenticator.Id+1)
+	}
+	if err := ak.NextAuthenticatorID.Set(ctx, nextAuthenticatorID); err != nil {
+		panic(err)
+	}
+
+	for _, nonce := range data.UnorderedNonces {
+		sender, err := ak.addressCodec.StringToBytes(nonce.Sender)
+		if err != nil {
+			panic(err)
+		}
+		if err := ak.addUnorderedNonce(ctx, collections.Join(nonce.Timeout.UnixNano(), sender)); err != nil {
+			panic(err)
+		}
+	}
+
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
 }
 
@@ -45,5 +96,46 @@ func (ak AccountKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	genState := types.NewGenesisState(params, genAccounts)
+	genState.FeeMarketParams = ak.GetFeeMarketParams(ctx)
+
+	baseGasPrice, err := ak.GetBaseGasPrice(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genState.BaseGasPrice = baseGasPrice.Amount
+
+	err = ak.PubKeyRotations.Walk(ctx, nil, func(addr sdk.AccAddress, rotationTime time.Time) (bool, error) {
+		addrStr, err := ak.addressCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		genState.PubKeyRotations = append(genState.PubKeyRotations, types.PubKeyRotation{Address: addrStr, Time: rotationTime})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = ak.AccountAuthenticators.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, uint64], authenticator types.AccountAuthenticator) (bool, error) {
+		genState.Authenticators = append(genState.Authenticators, authenticator)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = ak.UnorderedNonces.Walk(ctx, nil, func(key collections.Pair[int64, []byte]) (bool, error) {
+		nonce, err := ak.unorderedNonce(key)
+		if err != nil {
+			return true, err
+		}
+		genState.UnorderedNonces = append(genState.UnorderedNonces, nonce)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return genState
 }
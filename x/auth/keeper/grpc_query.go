@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"sort"
@@ -9,6 +10,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -245,3 +249,161 @@ func (s queryServer) AccountInfo(ctx context.Context, req *types.QueryAccountInf
 		},
 	}, nil
 }
+
+// FeeMarketParams returns the parameters of the fee market
+func (s queryServer) FeeMarketParams(ctx context.Context, req *types.QueryFeeMarketParamsRequest) (*types.QueryFeeMarketParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryFeeMarketParamsResponse{Params: s.k.GetFeeMarketParams(ctx)}, nil
+}
+
+// BaseGasPrice returns the current base gas price of the fee market
+func (s queryServer) BaseGasPrice(ctx context.Context, req *types.QueryBaseGasPriceRequest) (*types.QueryBaseGasPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	price, err := s.k.GetBaseGasPrice(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBaseGasPriceResponse{BaseGasPrice: price}, nil
+}
+
+// AccountAuthenticators returns the authenticators an account opted into
+func (s queryServer) AccountAuthenticators(ctx context.Context, req *types.QueryAccountAuthenticatorsRequest) (*types.QueryAccountAuthenticatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := s.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	authenticators, err := s.k.GetAccountAuthenticators(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAccountAuthenticatorsResponse{Authenticators: authenticators}, nil
+}
+
+func (s queryServer) UnorderedNonces(ctx context.Context, req *types.QueryUnorderedNoncesRequest) (*types.QueryUnorderedNoncesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var sender []byte
+	if req.Sender != "" {
+		var err error
+		sender, err = s.k.addressCodec.StringToBytes(req.Sender)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	// only the nonces within the timeout bounds are iterated over
+	nonces := unorderedNoncesInWindow{KeySet: s.k.UnorderedNonces}
+	if req.TimeoutStart != nil {
+		nonces.start = encodeUnorderedNonceTimeout(s.k.UnorderedNonces, req.TimeoutStart.UnixNano())
+	}
+	if req.TimeoutEnd != nil {
+		nonces.end = storetypes.PrefixEndBytes(encodeUnorderedNonceTimeout(s.k.UnorderedNonces, req.TimeoutEnd.UnixNano()))
+	}
+
+	res, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		nonces,
+		req.Pagination,
+		func(key collections.Pair[int64, []byte], _ collections.NoValue) (bool, error) {
+			return sender == nil || bytes.Equal(key.K2(), sender), nil
+		},
+		func(key collections.Pair[int64, []byte], _ collections.NoValue) (types.UnorderedNonce, error) {
+			return s.k.unorderedNonce(key)
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnorderedNoncesResponse{Nonces: res, Pagination: pageRes}, nil
+}
+
+// unorderedNoncesInWindow restricts the iteration over the unordered nonces to
+// the raw keys within [start, end), i.e. to the nonces whose timeout is within
+// the bounds these keys encode. A nil bound leaves the window open on its side.
+type unorderedNoncesInWindow struct {
+	collections.KeySet[collections.Pair[int64, []byte]]
+	start, end []byte
+}
+
+func (n unorderedNoncesInWindow) IterateRaw(ctx context.Context, start, end []byte, order collections.Order) (collections.Iterator[collections.Pair[int64, []byte], collections.NoValue], error) {
+	if n.start != nil && bytes.Compare(start, n.start) < 0 {
+		start = n.start
+	}
+	if n.end != nil && (end == nil || bytes.Compare(end, n.end) > 0) {
+		end = n.end
+	}
+
+	return n.KeySet.IterateRaw(ctx, start, end, order)
+}
+
+// encodeUnorderedNonceTimeout returns the raw key prefix of the unordered
+// nonces with the given timeout.
+func encodeUnorderedNonceTimeout(nonces collections.KeySet[collections.Pair[int64, []byte]], timeout int64) []byte {
+	prefix := collections.PairPrefix[int64, []byte](timeout)
+	buffer := make([]byte, nonces.KeyCodec().Size(prefix))
+	// encoding an int64 cannot fail
+	_, _ = nonces.KeyCodec().Encode(buffer, prefix)
+	return buffer
+}
+
+func (s queryServer) UnorderedNonce(ctx context.Context, req *types.QueryUnorderedNonceRequest) (*types.QueryUnorderedNonceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sender, err := s.k.addressCodec.StringToBytes(req.Sender)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	used, err := s.k.UnorderedNonces.Has(ctx, collections.Join(req.Timeout.UnixNano(), sender))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnorderedNonceResponse{Used: used}, nil
+}
+
+func (s queryServer) UnorderedNoncesStats(ctx context.Context, req *types.QueryUnorderedNoncesStatsRequest) (*types.QueryUnorderedNoncesStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	count, err := s.k.UnorderedNoncesCount.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	senders, err := s.k.UnorderedNonceSendersCount.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := &types.QueryUnorderedNoncesStatsResponse{Count: count, Senders: senders}
+
+	// the nonces are ordered by timeout
+	res.EarliestTimeout, err = s.k.firstUnorderedNonceTimeout(ctx, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res.LatestTimeout, err = s.k.firstUnorderedNonceTimeout(ctx, new(collections.Range[collections.Pair[int64, []byte]]).Descending())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return res, nil
+}
@@ -9,14 +9,17 @@ import (
 	gogotypes "github.com/cosmos/gogoproto/types"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -100,6 +103,9 @@ type AccountKeeper struct {
 	// The prototypical AccountI constructor.
 	proto func() sdk.AccountI
 
+	// authenticators are the authenticators accounts can opt into, by type.
+	authenticators map[string]types.Authenticator
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -110,6 +116,19 @@ type AccountKeeper struct {
 	AccountNumber   collections.Sequence
 	Accounts        *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
 	UnorderedNonces collections.KeySet[collections.Pair[int64, []byte]]
+	FeeMarketParams collections.Item[types.FeeMarketParams]
+	BaseGasPrice    collections.Item[math.LegacyDec]
+	PubKeyRotations collections.Map[sdk.AccAddress, time.Time]
+
+	AccountAuthenticators collections.Map[collections.Pair[sdk.AccAddress, uint64], types.AccountAuthenticator]
+	NextAuthenticatorID   collections.Sequence
+
+	// UnorderedNoncesCount, UnorderedNonceSenders and UnorderedNonceSendersCount
+	// keep track of the number of unordered nonces, in total and by sender, so
+	// that they are not counted by walking the whole store.
+	UnorderedNoncesCount       collections.Item[uint64]
+	UnorderedNonceSenders      collections.Map[[]byte, uint64]
+	UnorderedNonceSendersCount collections.Item[uint64]
 }
 
 type InitOption func(*AccountKeeper)
@@ -123,6 +142,16 @@ func WithUnorderedTransactions(enable bool) InitOption {
 	}
 }
 
+// WithAuthenticators registers authenticators accounts can opt into, in
+// addition to the signature and session key authenticators.
+func WithAuthenticators(authenticators ...types.Authenticator) InitOption {
+	return func(ak *AccountKeeper) {
+		for _, authenticator := range authenticators {
+			ak.RegisterAuthenticator(authenticator)
+		}
+	}
+}
+
 var _ AccountKeeperI = &AccountKeeper{}
 
 // NewAccountKeeper returns a new AccountKeeperI that uses go-amino to
@@ -154,6 +183,17 @@ func NewAccountKeeper(
 		AccountNumber:   collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
 		Accounts:        collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
 		UnorderedNonces: collections.NewKeySet(sb, types.UnorderedNoncesKey, "unordered_nonces", collections.PairKeyCodec(collections.Int64Key, collections.BytesKey)),
+		FeeMarketParams: collections.NewItem(sb, types.FeeMarketParamsKey, "fee_market_params", codec.CollValue[types.FeeMarketParams](cdc)),
+		BaseGasPrice:    collections.NewItem(sb, types.BaseGasPriceKey, "base_gas_price", sdk.LegacyDecValue),
+		PubKeyRotations: collections.NewMap(sb, types.PubKeyRotationsKey, "pub_key_rotations", sdk.AccAddressKey, collcodec.KeyToValueCodec(sdk.TimeKey)),
+		AccountAuthenticators: collections.NewMap(sb, types.AccountAuthenticatorsKey, "account_authenticators",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), codec.CollValue[types.AccountAuthenticator](cdc)),
+		NextAuthenticatorID:  collections.NewSequence(sb, types.NextAuthenticatorIDKey, "next_authenticator_id"),
+		authenticators:       make(map[string]types.Authenticator),
+		UnorderedNoncesCount: collections.NewItem(sb, types.UnorderedNoncesCountKey, "unordered_nonces_count", collections.Uint64Value),
+		UnorderedNonceSenders: collections.NewMap(sb, types.UnorderedNonceSendersKey, "unordered_nonce_senders",
+			collections.BytesKey, collections.Uint64Value),
+		UnorderedNonceSendersCount: collections.NewItem(sb, types.UnorderedNonceSendersCountKey, "unordered_nonce_senders_count", collections.Uint64Value),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -161,6 +201,9 @@ func NewAccountKeeper(
 	}
 	ak.Schema = schema
 
+	ak.RegisterAuthenticator(types.SignatureAuthenticator{})
+	ak.RegisterAuthenticator(types.NewSessionKeyAuthenticator(cdc))
+
 	for _, opt := range opts {
 		opt(&ak)
 	}
@@ -351,12 +394,13 @@ func (ak AccountKeeper) TryAddUnorderedNonce(ctx sdk.Context, sender []byte, tim
 		return fmt.Errorf("sender %s has already used timeout %d", sdk.AccAddress(sender).String(), timeout.UnixNano())
 	}
 
-	return ak.UnorderedNonces.Set(ctx, collections.Join(timeout.UnixNano(), sender))
+	return ak.addUnorderedNonce(ctx, collections.Join(timeout.UnixNano(), sender))
 }
 
 // RemoveExpiredUnorderedNonces removes all unordered nonces that have a timeout value before
 // the current block time.
 func (ak AccountKeeper) RemoveExpiredUnorderedNonces(ctx sdk.Context) error {
+	start := telemetry.Now()
 	blkTime := ctx.BlockTime().UnixNano()
 	it, err := ak.UnorderedNonces.Iterate(ctx, collections.NewPrefixUntilPairRange[int64, []byte](blkTime))
 	if err != nil {
@@ -369,11 +413,129 @@ func (ak AccountKeeper) RemoveExpiredUnorderedNonces(ctx sdk.Context) error {
 		return err
 	}
 
+	if err := ak.removeUnorderedNonces(ctx, keys); err != nil {
+		return err
+	}
+
+	telemetry.ModuleMeasureSince(types.ModuleName, start, "unordered_nonces", "prune")
+	telemetry.ModuleSetGauge(types.ModuleName, float32(len(keys)), "unordered_nonces", "pruned")
+
+	if telemetry.IsTelemetryEnabled() {
+		count, err := ak.UnorderedNoncesCount.Get(ctx)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		telemetry.ModuleSetGauge(types.ModuleName, float32(count), "unordered_nonces", "size")
+	}
+
+	return nil
+}
+
+// addUnorderedNonce stores the unordered nonce under the given key, counting
+// it along with its sender.
+func (ak AccountKeeper) addUnorderedNonce(ctx context.Context, key collections.Pair[int64, []byte]) error {
+	if err := ak.UnorderedNonces.Set(ctx, key); err != nil {
+		return err
+	}
+
+	senderCount, err := ak.UnorderedNonceSenders.Get(ctx, key.K2())
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if senderCount == 0 {
+		if err := addToCount(ctx, ak.UnorderedNonceSendersCount, 1); err != nil {
+			return err
+		}
+	}
+	if err := ak.UnorderedNonceSenders.Set(ctx, key.K2(), senderCount+1); err != nil {
+		return err
+	}
+
+	return addToCount(ctx, ak.UnorderedNoncesCount, 1)
+}
+
+// removeUnorderedNonces removes the unordered nonces stored under the given
+// keys, uncounting them along with their senders.
+func (ak AccountKeeper) removeUnorderedNonces(ctx context.Context, keys []collections.Pair[int64, []byte]) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	var removedSenders int64
 	for _, key := range keys {
 		if err := ak.UnorderedNonces.Remove(ctx, key); err != nil {
 			return err
 		}
+
+		senderCount, err := ak.UnorderedNonceSenders.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		if senderCount > 1 {
+			err = ak.UnorderedNonceSenders.Set(ctx, key.K2(), senderCount-1)
+		} else {
+			err = ak.UnorderedNonceSenders.Remove(ctx, key.K2())
+			removedSenders++
+		}
+		if err != nil {
+			return err
+		}
 	}
 
-	return nil
+	if err := addToCount(ctx, ak.UnorderedNonceSendersCount, -removedSenders); err != nil {
+		return err
+	}
+
+	return addToCount(ctx, ak.UnorderedNoncesCount, -int64(len(keys)))
+}
+
+// addToCount adds delta to the count stored in the given item.
+func addToCount(ctx context.Context, count collections.Item[uint64], delta int64) error {
+	if delta == 0 {
+		return nil
+	}
+
+	value, err := count.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if delta < 0 {
+		if uint64(-delta) > value {
+			return fmt.Errorf("cannot remove %d from count %d", -delta, value)
+		}
+		return count.Set(ctx, value-uint64(-delta))
+	}
+
+	return count.Set(ctx, value+uint64(delta))
+}
+
+// firstUnorderedNonceTimeout returns the timeout of the first unordered nonce
+// in the given range, or nil if there is none.
+func (ak AccountKeeper) firstUnorderedNonceTimeout(ctx context.Context, ranger collections.Ranger[collections.Pair[int64, []byte]]) (*time.Time, error) {
+	it, err := ak.UnorderedNonces.Iterate(ctx, ranger)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	if !it.Valid() {
+		return nil, nil
+	}
+	key, err := it.Key()
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := time.Unix(0, key.K1()).UTC()
+	return &timeout, nil
+}
+
+// unorderedNonce returns the unordered nonce stored under the given key.
+func (ak AccountKeeper) unorderedNonce(key collections.Pair[int64, []byte]) (types.UnorderedNonce, error) {
+	sender, err := ak.addressCodec.BytesToString(key.K2())
+	if err != nil {
+		return types.UnorderedNonce{}, err
+	}
+
+	return types.UnorderedNonce{Sender: sender, Timeout: time.Unix(0, key.K1()).UTC()}, nil
 }
@@ -2,9 +2,15 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -39,3 +45,84 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (ms msgServer) UpdateFeeMarketParams(goCtx context.Context, msg *types.MsgUpdateFeeMarketParams) (*types.MsgUpdateFeeMarketParamsResponse, error) {
+	if ms.ak.authority != msg.Authority {
+		return nil, fmt.Errorf(
+			"expected gov account as only signer for proposal message; invalid authority; expected %s, got %s",
+			ms.ak.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := ms.ak.FeeMarketParams.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	if msg.Params.Enabled {
+		// re-apply the bounds so that the base gas price immediately reflects
+		// updated min and max values.
+		price, err := ms.ak.BaseGasPrice.Get(ctx)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+
+		if err := ms.ak.BaseGasPrice.Set(ctx, msg.Params.ClampBaseGasPrice(price)); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgUpdateFeeMarketParamsResponse{}, nil
+}
+
+func (ms msgServer) RotatePubKey(goCtx context.Context, msg *types.MsgRotatePubKey) (*types.MsgRotatePubKeyResponse, error) {
+	addr, err := ms.ak.addressCodec.StringToBytes(msg.Address)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", err)
+	}
+
+	if msg.NewPubKey == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "new public key cannot be empty")
+	}
+
+	pubKey, ok := msg.NewPubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "expecting cryptotypes.PubKey, got %T", msg.NewPubKey.GetCachedValue())
+	}
+
+	if err := ms.ak.RotatePubKey(goCtx, addr, pubKey); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotatePubKeyResponse{}, nil
+}
+
+func (ms msgServer) AddAuthenticator(goCtx context.Context, msg *types.MsgAddAuthenticator) (*types.MsgAddAuthenticatorResponse, error) {
+	addr, err := ms.ak.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	id, err := ms.ak.AddAuthenticator(goCtx, addr, msg.AuthenticatorType, msg.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddAuthenticatorResponse{Id: id}, nil
+}
+
+func (ms msgServer) RemoveAuthenticator(goCtx context.Context, msg *types.MsgRemoveAuthenticator) (*types.MsgRemoveAuthenticatorResponse, error) {
+	addr, err := ms.ak.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+
+	if err := ms.ak.RemoveAuthenticator(goCtx, addr, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveAuthenticatorResponse{}, nil
+}
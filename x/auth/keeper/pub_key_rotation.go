@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// RotatePubKey replaces the public key of the account at addr with pubKey,
+// keeping its address, account number and sequence. The public key of an
+// account can be rotated at most once per rotation cooldown.
+func (ak AccountKeeper) RotatePubKey(ctx context.Context, addr sdk.AccAddress, pubKey cryptotypes.PubKey) error {
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+	if _, ok := acc.(sdk.ModuleAccountI); ok {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cannot rotate the public key of module account %s", addr)
+	}
+
+	oldPubKey := acc.GetPubKey()
+	if oldPubKey == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "account %s has no public key", addr)
+	}
+	if oldPubKey.Equals(pubKey) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "new public key is the current public key of the account")
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	lastRotation, err := ak.PubKeyRotations.Get(ctx, addr)
+	switch {
+	case err == nil:
+		if next := lastRotation.Add(ak.GetParams(ctx).PubKeyRotationCooldown); blockTime.Before(next) {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+				"public key of account %s was rotated at %s, it cannot be rotated before %s", addr, lastRotation, next)
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	if err := acc.SetPubKey(pubKey); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	ak.SetAccount(ctx, acc)
+
+	if err := ak.PubKeyRotations.Set(ctx, addr, blockTime); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRotatePubKey,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyOldPubKey, oldPubKey.String()),
+			sdk.NewAttribute(types.AttributeKeyNewPubKey, pubKey.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/core/header"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestRotatePubKey() {
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: time.Unix(1000, 0)}).WithBlockTime(time.Unix(1000, 0))
+	suite.Require().NoError(suite.accountKeeper.Params.Set(ctx, types.DefaultParams()))
+
+	oldPubKey := secp256k1.GenPrivKey().PubKey()
+	newPubKey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(oldPubKey.Address())
+	noPubKeyAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	acc := suite.accountKeeper.NewAccountWithAddress(ctx, addr)
+	suite.Require().NoError(acc.SetPubKey(oldPubKey))
+	suite.accountKeeper.SetAccount(ctx, acc)
+	suite.accountKeeper.SetAccount(ctx, suite.accountKeeper.NewAccountWithAddress(ctx, noPubKeyAddr))
+	suite.accountKeeper.GetModuleAccount(ctx, "mint")
+
+	newMsg := func(addr string) *types.MsgRotatePubKey {
+		msg, err := types.NewMsgRotatePubKey(addr, newPubKey)
+		suite.Require().NoError(err)
+		return msg
+	}
+
+	testCases := []struct {
+		name      string
+		req       *types.MsgRotatePubKey
+		expErrMsg string
+	}{
+		{
+			name:      "invalid address",
+			req:       newMsg("foo"),
+			expErrMsg: "invalid address",
+		},
+		{
+			name:      "unknown account",
+			req:       newMsg(sdk.AccAddress("unknown").String()),
+			expErrMsg: "does not exist",
+		},
+		{
+			name:      "module account",
+			req:       newMsg(types.NewModuleAddress("mint").String()),
+			expErrMsg: "cannot rotate the public key of module account",
+		},
+		{
+			name:      "account without public key",
+			req:       newMsg(noPubKeyAddr.String()),
+			expErrMsg: "has no public key",
+		},
+		{
+			name: "missing public key",
+			req: &types.MsgRotatePubKey{
+				Address: addr.String(),
+			},
+			expErrMsg: "new public key cannot be empty",
+		},
+		{
+			name: "same public key",
+			req: func() *types.MsgRotatePubKey {
+				msg, err := types.NewMsgRotatePubKey(addr.String(), oldPubKey)
+				suite.Require().NoError(err)
+				return msg
+			}(),
+			expErrMsg: "new public key is the current public key",
+		},
+		{
+			name: "valid rotation",
+			req:  newMsg(addr.String()),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.RotatePubKey(ctx, tc.req)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	// the address, account number and sequence of the account are kept
+	rotated := suite.accountKeeper.GetAccount(ctx, addr)
+	suite.Require().True(newPubKey.Equals(rotated.GetPubKey()))
+	suite.Require().Equal(acc.GetAccountNumber(), rotated.GetAccountNumber())
+	suite.Require().Equal(acc.GetSequence(), rotated.GetSequence())
+
+	rotationTime, err := suite.accountKeeper.PubKeyRotations.Get(ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(time.Unix(1000, 0).UTC(), rotationTime.UTC())
+
+	events := ctx.EventManager().Events()
+	suite.Require().Equal(types.EventTypeRotatePubKey, events[len(events)-1].Type)
+
+	// the public key cannot be rotated again before the end of the cooldown
+	nextPubKey := secp256k1.GenPrivKey().PubKey()
+	msg, err := types.NewMsgRotatePubKey(addr.String(), nextPubKey)
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.RotatePubKey(ctx.WithBlockTime(time.Unix(1000, 0).Add(time.Hour)), msg)
+	suite.Require().ErrorContains(err, "it cannot be rotated before")
+
+	_, err = suite.msgServer.RotatePubKey(ctx.WithBlockTime(time.Unix(1000, 0).Add(types.DefaultPubKeyRotationCooldown)), msg)
+	suite.Require().NoError(err)
+	suite.Require().True(nextPubKey.Equals(suite.accountKeeper.GetAccount(ctx, addr).GetPubKey()))
+}
@@ -10,8 +10,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -324,3 +326,100 @@ func TestCannotAddDuplicate(t *testing.T) {
 	err = mgr.TryAddUnorderedNonce(ctx, addUser, timeout)
 	require.ErrorContains(t, err, "already used timeout")
 }
+
+func (suite *KeeperTestSuite) TestUnorderedNoncesQueries() {
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	t10, t20, t30 := time.Unix(10, 0).UTC(), time.Unix(20, 0).UTC(), time.Unix(30, 0).UTC()
+
+	res, err := suite.queryClient.UnorderedNoncesStats(suite.ctx, &types.QueryUnorderedNoncesStatsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryUnorderedNoncesStatsResponse{}, res)
+
+	for _, nonce := range []struct {
+		sender  sdk.AccAddress
+		timeout time.Time
+	}{{addr1, t10}, {addr2, t20}, {addr1, t30}} {
+		suite.Require().NoError(suite.accountKeeper.TryAddUnorderedNonce(suite.ctx, nonce.sender, nonce.timeout))
+	}
+
+	nonces, err := suite.queryClient.UnorderedNonces(suite.ctx, &types.QueryUnorderedNoncesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.UnorderedNonce{
+		{Sender: addr1.String(), Timeout: t10},
+		{Sender: addr2.String(), Timeout: t20},
+		{Sender: addr1.String(), Timeout: t30},
+	}, nonces.Nonces)
+
+	// filtered by sender
+	nonces, err = suite.queryClient.UnorderedNonces(suite.ctx, &types.QueryUnorderedNoncesRequest{Sender: addr1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.UnorderedNonce{{Sender: addr1.String(), Timeout: t10}, {Sender: addr1.String(), Timeout: t30}}, nonces.Nonces)
+
+	// filtered by timeout window, paginated
+	nonces, err = suite.queryClient.UnorderedNonces(suite.ctx, &types.QueryUnorderedNoncesRequest{
+		TimeoutStart: &t20,
+		TimeoutEnd:   &t30,
+		Pagination:   &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.UnorderedNonce{{Sender: addr2.String(), Timeout: t20}}, nonces.Nonces)
+	suite.Require().Equal(uint64(2), nonces.Pagination.Total)
+	nonces, err = suite.queryClient.UnorderedNonces(suite.ctx, &types.QueryUnorderedNoncesRequest{
+		TimeoutStart: &t20,
+		TimeoutEnd:   &t30,
+		Pagination:   &query.PageRequest{Key: nonces.Pagination.NextKey, Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.UnorderedNonce{{Sender: addr1.String(), Timeout: t30}}, nonces.Nonces)
+	suite.Require().Nil(nonces.Pagination.NextKey)
+
+	// the timeout window bounds the iteration in reverse order too
+	nonces, err = suite.queryClient.UnorderedNonces(suite.ctx, &types.QueryUnorderedNoncesRequest{
+		TimeoutEnd: &t20,
+		Pagination: &query.PageRequest{Reverse: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.UnorderedNonce{{Sender: addr2.String(), Timeout: t20}, {Sender: addr1.String(), Timeout: t10}}, nonces.Nonces)
+
+	_, err = suite.queryClient.UnorderedNonces(suite.ctx, &types.QueryUnorderedNoncesRequest{Sender: "invalid"})
+	suite.Require().Error(err)
+
+	used, err := suite.queryClient.UnorderedNonce(suite.ctx, &types.QueryUnorderedNonceRequest{Sender: addr2.String(), Timeout: t20})
+	suite.Require().NoError(err)
+	suite.Require().True(used.Used)
+	used, err = suite.queryClient.UnorderedNonce(suite.ctx, &types.QueryUnorderedNonceRequest{Sender: addr2.String(), Timeout: t10})
+	suite.Require().NoError(err)
+	suite.Require().False(used.Used)
+
+	res, err = suite.queryClient.UnorderedNoncesStats(suite.ctx, &types.QueryUnorderedNoncesStatsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryUnorderedNoncesStatsResponse{Count: 3, Senders: 2, EarliestTimeout: &t10, LatestTimeout: &t30}, res)
+
+	// the counts follow the pruning of the nonces
+	suite.Require().NoError(suite.accountKeeper.RemoveExpiredUnorderedNonces(suite.ctx.WithBlockTime(t20.Add(time.Second))))
+	res, err = suite.queryClient.UnorderedNoncesStats(suite.ctx, &types.QueryUnorderedNoncesStatsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryUnorderedNoncesStatsResponse{Count: 1, Senders: 1, EarliestTimeout: &t30, LatestTimeout: &t30}, res)
+}
+
+func (suite *KeeperTestSuite) TestUnorderedNoncesGenesis() {
+	ctx := suite.ctx
+	_, _, addr := testdata.KeyTestPubAddr()
+
+	genState := types.DefaultGenesisState()
+	genState.UnorderedNonces = []types.UnorderedNonce{{Sender: addr.String(), Timeout: time.Unix(10, 5).UTC()}}
+	suite.Require().NoError(types.ValidateGenesis(*genState))
+	suite.accountKeeper.InitGenesis(ctx, *genState)
+
+	has, err := suite.accountKeeper.ContainsUnorderedNonce(ctx, addr, time.Unix(10, 5))
+	suite.Require().NoError(err)
+	suite.Require().True(has)
+
+	exported := suite.accountKeeper.ExportGenesis(ctx)
+	suite.Require().Equal(genState.UnorderedNonces, exported.UnorderedNonces)
+
+	// duplicate nonces are rejected
+	genState.UnorderedNonces = append(genState.UnorderedNonces, genState.UnorderedNonces[0])
+	suite.Require().ErrorContains(types.ValidateGenesis(*genState), "duplicate unordered nonce")
+}
@@ -0,0 +1,41 @@
+package v6
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+)
+
+// Migrate counts the stored unordered nonces, in total and by sender, as the
+// x/auth module keeps track of these numbers from consensus version 6 onwards.
+func Migrate(
+	ctx context.Context,
+	nonces collections.KeySet[collections.Pair[int64, []byte]],
+	noncesCount collections.Item[uint64],
+	senders collections.Map[[]byte, uint64],
+	sendersCount collections.Item[uint64],
+) error {
+	var count, senderCount uint64
+	err := nonces.Walk(ctx, nil, func(key collections.Pair[int64, []byte]) (bool, error) {
+		n, err := senders.Get(ctx, key.K2())
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return true, err
+		}
+		if n == 0 {
+			senderCount++
+		}
+
+		count++
+		return false, senders.Set(ctx, key.K2(), n+1)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := noncesCount.Set(ctx, count); err != nil {
+		return err
+	}
+
+	return sendersCount.Set(ctx, senderCount)
+}
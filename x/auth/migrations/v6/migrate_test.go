@@ -0,0 +1,50 @@
+package v6
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+)
+
+func TestMigrate(t *testing.T) {
+	kv, ctx := colltest.MockStore()
+	sb := collections.NewSchemaBuilder(kv)
+	nonces := collections.NewKeySet(sb, collections.NewPrefix(0), "nonces", collections.PairKeyCodec(collections.Int64Key, collections.BytesKey))
+	noncesCount := collections.NewItem(sb, collections.NewPrefix(1), "nonces_count", collections.Uint64Value)
+	senders := collections.NewMap(sb, collections.NewPrefix(2), "senders", collections.BytesKey, collections.Uint64Value)
+	sendersCount := collections.NewItem(sb, collections.NewPrefix(3), "senders_count", collections.Uint64Value)
+
+	sender1, sender2 := []byte("sender1"), []byte("sender2")
+	for _, key := range []collections.Pair[int64, []byte]{
+		collections.Join(int64(10), sender1),
+		collections.Join(int64(20), sender1),
+		collections.Join(int64(20), sender2),
+	} {
+		require.NoError(t, nonces.Set(ctx, key))
+	}
+
+	require.NoError(t, Migrate(ctx, nonces, noncesCount, senders, sendersCount))
+
+	count, err := noncesCount.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), count)
+	count, err = sendersCount.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+	count, err = senders.Get(ctx, sender1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+	count, err = senders.Get(ctx, sender2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+
+	// without nonces, the counts are zero
+	ctx = kv.NewStoreContext()
+	require.NoError(t, Migrate(ctx, nonces, noncesCount, senders, sendersCount))
+	count, err = noncesCount.Get(ctx)
+	require.NoError(t, err)
+	require.Zero(t, count)
+}
@@ -29,7 +29,7 @@ import (
 
 // ConsensusVersion defines the current x/auth module consensus version.
 const (
-	ConsensusVersion = 5
+	ConsensusVersion = 6
 	GovModuleName    = "gov"
 )
 
@@ -39,6 +39,7 @@ var (
 	_ module.HasGenesis          = AppModule{}
 	_ module.HasServices         = AppModule{}
 	_ appmodule.HasPreBlocker    = AppModule{}
+	_ appmodule.HasEndBlocker    = AppModule{}
 
 	_ appmodule.AppModule = AppModule{}
 )
@@ -111,6 +112,21 @@ func (am AppModule) PreBlock(ctx context.Context) (appmodule.ResponsePreBlock, e
 	return &sdk.ResponsePreBlock{ConsensusParamsChanged: false}, nil
 }
 
+// EndBlock adjusts the fee market base gas price according to the gas consumed
+// by the block.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	start := telemetry.Now()
+	defer telemetry.ModuleMeasureSince(types.ModuleName, start, telemetry.MetricKeyEndBlocker)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	var blockGasUsed uint64
+	if gasMeter := sdkCtx.BlockGasMeter(); gasMeter != nil {
+		blockGasUsed = gasMeter.GasConsumed()
+	}
+
+	return am.accountKeeper.UpdateBaseGasPrice(ctx, blockGasUsed)
+}
+
 // IsOnePerModuleType implements the depinject.OnePerModuleType interface.
 func (am AppModule) IsOnePerModuleType() {}
 
@@ -149,6 +165,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4To5); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5", types.ModuleName))
 	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5To6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 5 to 6: %v", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the auth module. It returns
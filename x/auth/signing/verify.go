@@ -7,6 +7,7 @@ import (
 	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
 	txsigning "cosmossdk.io/x/tx/signing"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -36,6 +37,8 @@ func APISignModeToInternal(mode signingv1beta1.SignMode) (signing.SignMode, erro
 		return signing.SignMode_SIGN_MODE_TEXTUAL, nil
 	case signingv1beta1.SignMode_SIGN_MODE_DIRECT_AUX:
 		return signing.SignMode_SIGN_MODE_DIRECT_AUX, nil
+	case signingv1beta1.SignMode_SIGN_MODE_EIP_712:
+		return signing.SignMode_SIGN_MODE_EIP_712, nil
 	default:
 		return signing.SignMode_SIGN_MODE_UNSPECIFIED, fmt.Errorf("unsupported sign mode %s", mode)
 	}
@@ -52,6 +55,8 @@ func internalSignModeToAPI(mode signing.SignMode) (signingv1beta1.SignMode, erro
 		return signingv1beta1.SignMode_SIGN_MODE_TEXTUAL, nil
 	case signing.SignMode_SIGN_MODE_DIRECT_AUX:
 		return signingv1beta1.SignMode_SIGN_MODE_DIRECT_AUX, nil
+	case signing.SignMode_SIGN_MODE_EIP_712:
+		return signingv1beta1.SignMode_SIGN_MODE_EIP_712, nil
 	default:
 		return signingv1beta1.SignMode_SIGN_MODE_UNSPECIFIED, fmt.Errorf("unsupported sign mode %s", mode)
 	}
@@ -69,11 +74,7 @@ func VerifySignature(
 ) error {
 	switch data := signatureData.(type) {
 	case *signing.SingleSignatureData:
-		signMode, err := internalSignModeToAPI(data.SignMode)
-		if err != nil {
-			return err
-		}
-		signBytes, err := handler.GetSignBytes(ctx, signMode, signerData, txData)
+		signBytes, err := GetSignBytes(ctx, handler, data.SignMode, signerData, txData)
 		if err != nil {
 			return err
 		}
@@ -98,7 +99,75 @@ func VerifySignature(
 			return err
 		}
 		return nil
+	case *signing.AggregateSignatureData:
+		return fmt.Errorf("aggregate signatures must be verified with VerifyAggregateSignature")
 	default:
 		return fmt.Errorf("unexpected SignatureData %T", signatureData)
 	}
 }
+
+// GetSignBytes returns the bytes of txData signed in the given sign mode by the
+// signer, as verified by VerifySignature.
+func GetSignBytes(
+	ctx context.Context,
+	handler *txsigning.HandlerMap,
+	mode signing.SignMode,
+	signerData txsigning.SignerData,
+	txData txsigning.TxData,
+) ([]byte, error) {
+	signMode, err := internalSignModeToAPI(mode)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.GetSignBytes(ctx, signMode, signerData, txData)
+}
+
+// AggregateSigner is a signer of a tx whose signature is aggregated with the
+// ones of the other aggregate signers of the tx.
+type AggregateSigner struct {
+	PubKey     cryptotypes.PubKey
+	SignerData txsigning.SignerData
+	Data       *signing.AggregateSignatureData
+}
+
+// VerifyAggregateSignature verifies the BLS12-381 aggregate signature of the
+// aggregate signers of a tx, which is carried by the first of them.
+func VerifyAggregateSignature(
+	ctx context.Context,
+	signers []AggregateSigner,
+	handler *txsigning.HandlerMap,
+	txData txsigning.TxData,
+) error {
+	if len(signers) == 0 {
+		return nil
+	}
+
+	pubKeys := make([]*bls12_381.PubKey, len(signers))
+	msgs := make([][]byte, len(signers))
+	for i, signer := range signers {
+		if i > 0 && len(signer.Data.Signature) > 0 {
+			return fmt.Errorf("aggregate signature must be carried by the first aggregate signer only")
+		}
+
+		pubKey, ok := signer.PubKey.(*bls12_381.PubKey)
+		if !ok {
+			return fmt.Errorf("expected %T, got %T", (*bls12_381.PubKey)(nil), signer.PubKey)
+		}
+		pubKeys[i] = pubKey
+
+		signMode, err := internalSignModeToAPI(signer.Data.SignMode)
+		if err != nil {
+			return err
+		}
+		msgs[i], err = handler.GetSignBytes(ctx, signMode, signer.SignerData, txData)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !bls12_381.VerifyAggregateSignature(pubKeys, msgs, signers[0].Data.Signature) {
+		return fmt.Errorf("unable to verify aggregate signature")
+	}
+	return nil
+}
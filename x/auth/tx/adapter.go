@@ -143,5 +143,11 @@ func adaptModeInfo(legacy *tx.ModeInfo, res *txv1beta1.ModeInfo) {
 				ModeInfos: modeInfos,
 			},
 		}
+	case *tx.ModeInfo_Aggregate_:
+		res.Sum = &txv1beta1.ModeInfo_Aggregate_{
+			Aggregate: &txv1beta1.ModeInfo_Aggregate{
+				Mode: signingv1beta1.SignMode(mi.Aggregate.Mode),
+			},
+		}
 	}
 }
@@ -3,10 +3,13 @@ package tx
 import (
 	"fmt"
 
+	"google.golang.org/protobuf/reflect/protoreflect"
+
 	txsigning "cosmossdk.io/x/tx/signing"
 	"cosmossdk.io/x/tx/signing/aminojson"
 	"cosmossdk.io/x/tx/signing/direct"
 	"cosmossdk.io/x/tx/signing/directaux"
+	"cosmossdk.io/x/tx/signing/eip712"
 	"cosmossdk.io/x/tx/signing/textual"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -44,6 +47,12 @@ type ConfigOptions struct {
 	// TextualCoinMetadataQueryFn is the function that will be used to query coin metadata when constructing
 	// textual sign mode handler. This is required if SIGN_MODE_TEXTUAL is enabled.
 	TextualCoinMetadataQueryFn textual.CoinMetadataQueryFn
+	// TextualMessageRenderers are the custom value renderers of messages that will be defined in the textual sign
+	// mode handler, replacing the default rendering of these messages.
+	TextualMessageRenderers map[protoreflect.FullName]TextualMessageRendererCreator
+	// EIP712Options are the options of the EIP-712 domain used when constructing the SIGN_MODE_EIP_712 sign mode
+	// handler. Its FileResolver and TypeResolver default to the ones of SigningOptions.
+	EIP712Options eip712.SignModeHandlerOptions
 	// CustomSignModes are the custom sign modes that will be added to the txsigning.HandlerMap.
 	CustomSignModes []txsigning.SignModeHandler
 	// ProtoDecoder is the decoder that will be used to decode protobuf transactions.
@@ -56,12 +65,26 @@ type ConfigOptions struct {
 	JSONEncoder sdk.TxEncoder
 }
 
+// TextualMessageRendererCreator returns the SIGN_MODE_TEXTUAL value renderer of a message. It is given the textual
+// sign mode handler, so that the renderer can render the fields of the message with the handler's value renderers.
+type TextualMessageRendererCreator func(*textual.SignModeHandler) textual.ValueRenderer
+
+// TextualMessageRenderer is a custom TextualMessageRendererCreator that is defined for a specific message type.
+// Modules can provide it through depinject to render their messages in SIGN_MODE_TEXTUAL.
+type TextualMessageRenderer struct {
+	MsgType     protoreflect.FullName
+	NewRenderer TextualMessageRendererCreator
+}
+
+func (r TextualMessageRenderer) IsManyPerContainerType() {}
+
 // DefaultSignModes are the default sign modes enabled for protobuf transactions.
 var DefaultSignModes = []signingtypes.SignMode{
 	signingtypes.SignMode_SIGN_MODE_DIRECT,
 	signingtypes.SignMode_SIGN_MODE_DIRECT_AUX,
 	signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
 	// signingtypes.SignMode_SIGN_MODE_TEXTUAL is not enabled by default, as it requires a x/bank keeper or gRPC connection.
+	// signingtypes.SignMode_SIGN_MODE_EIP_712 is not enabled by default, as its domain is specific to each chain.
 }
 
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and sign modes. The
@@ -141,7 +164,7 @@ func NewSigningHandlerMap(configOpts ConfigOptions) (*txsigning.HandlerMap, erro
 				TypeResolver: signingOpts.TypeResolver,
 			})
 		case signingtypes.SignMode_SIGN_MODE_TEXTUAL:
-			handlers[i], err = textual.NewSignModeHandler(textual.SignModeOptions{
+			txt, err := textual.NewSignModeHandler(textual.SignModeOptions{
 				CoinMetadataQuerier: configOpts.TextualCoinMetadataQueryFn,
 				FileResolver:        signingOpts.FileResolver,
 				TypeResolver:        signingOpts.TypeResolver,
@@ -152,6 +175,19 @@ func NewSigningHandlerMap(configOpts ConfigOptions) (*txsigning.HandlerMap, erro
 			if err != nil {
 				return nil, err
 			}
+			for name, newRenderer := range configOpts.TextualMessageRenderers {
+				txt.DefineMessageRenderer(name, newRenderer(txt))
+			}
+			handlers[i] = txt
+		case signingtypes.SignMode_SIGN_MODE_EIP_712:
+			eip712Opts := configOpts.EIP712Options
+			if eip712Opts.FileResolver == nil {
+				eip712Opts.FileResolver = signingOpts.FileResolver
+			}
+			if eip712Opts.TypeResolver == nil {
+				eip712Opts.TypeResolver = signingOpts.TypeResolver
+			}
+			handlers[i] = eip712.NewSignModeHandler(eip712Opts)
 		}
 	}
 	for i, m := range configOpts.CustomSignModes {
@@ -53,6 +53,7 @@ type ModuleInputs struct {
 	FeeGrantKeeper         ante.FeegrantKeeper                `optional:"true"`
 	CustomSignModeHandlers func() []txsigning.SignModeHandler `optional:"true"`
 	CustomGetSigners       []txsigning.CustomGetSigner        `optional:"true"`
+	TextualRenderers       []tx.TextualMessageRenderer        `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -92,6 +93,10 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	if in.MetadataBankKeeper != nil {
 		txConfigOptions.EnabledSignModes = append(txConfigOptions.EnabledSignModes, signingtypes.SignMode_SIGN_MODE_TEXTUAL)
 		txConfigOptions.TextualCoinMetadataQueryFn = NewBankKeeperCoinMetadataQueryFn(in.MetadataBankKeeper)
+		txConfigOptions.TextualMessageRenderers = make(map[protoreflect.FullName]tx.TextualMessageRendererCreator)
+		for _, renderer := range in.TextualRenderers {
+			txConfigOptions.TextualMessageRenderers[renderer.MsgType] = renderer.NewRenderer
+		}
 	}
 
 	txConfig, err := tx.NewTxConfigWithOptions(in.Codec, txConfigOptions)
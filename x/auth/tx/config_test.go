@@ -1,17 +1,33 @@
 package tx_test
 
 import (
+	"bytes"
+	"context"
 	"testing"
 
+	gogoproto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
 
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txsigning "cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/eip712"
+	"cosmossdk.io/x/tx/signing/textual"
+
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/testutil"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ethsecp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/std"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 	txtestutil "github.com/cosmos/cosmos-sdk/x/auth/tx/testutil"
 )
@@ -34,3 +50,126 @@ func TestConfigOptions(t *testing.T) {
 	handler := txConfig.SignModeHandler()
 	require.NotNil(t, handler)
 }
+
+// constRenderer renders any message as a single screen.
+type constRenderer struct{}
+
+func (constRenderer) Format(context.Context, protoreflect.Value) ([]textual.Screen, error) {
+	return []textual.Screen{{Title: "Custom", Content: "custom message screen"}}, nil
+}
+
+func (constRenderer) Parse(context.Context, []textual.Screen) (protoreflect.Value, error) {
+	return protoreflect.Value{}, nil
+}
+
+func TestConfigOptionsTextualMessageRenderers(t *testing.T) {
+	interfaceRegistry := testutil.CodecOptions{}.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	protoCodec := codec.NewProtoCodec(interfaceRegistry)
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	signerData := authsigning.SignerData{ChainID: "test-chain", Address: addr.String()}
+
+	getSignBytes := func(renderers map[protoreflect.FullName]tx.TextualMessageRendererCreator) []byte {
+		txConfig, err := tx.NewTxConfigWithOptions(protoCodec, tx.ConfigOptions{
+			EnabledSignModes: []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL},
+			TextualCoinMetadataQueryFn: func(context.Context, string) (*bankv1beta1.Metadata, error) {
+				return nil, nil
+			},
+			TextualMessageRenderers: renderers,
+		})
+		require.NoError(t, err)
+
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(&testdata.TestMsg{Signers: []string{addr.String()}}))
+		txBuilder.SetGasLimit(200000)
+
+		signBytes, err := authsigning.GetSignBytesAdapter(context.Background(), txConfig.SignModeHandler(),
+			signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder.GetTx())
+		require.NoError(t, err)
+		return signBytes
+	}
+
+	require.False(t, bytes.Contains(getSignBytes(nil), []byte("custom message screen")))
+
+	signBytes := getSignBytes(map[protoreflect.FullName]tx.TextualMessageRendererCreator{
+		protoreflect.FullName(gogoproto.MessageName(&testdata.TestMsg{})): func(*textual.SignModeHandler) textual.ValueRenderer {
+			return constRenderer{}
+		},
+	})
+	require.True(t, bytes.Contains(signBytes, []byte("custom message screen")))
+}
+
+func TestConfigOptionsEIP712(t *testing.T) {
+	interfaceRegistry := testutil.CodecOptions{}.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	protoCodec := codec.NewProtoCodec(interfaceRegistry)
+
+	newTxConfig := func(eip155ChainID uint64) client.TxConfig {
+		txConfig, err := tx.NewTxConfigWithOptions(protoCodec, tx.ConfigOptions{
+			EnabledSignModes: []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_EIP_712},
+			EIP712Options:    eip712.SignModeHandlerOptions{EIP155ChainID: eip155ChainID},
+		})
+		require.NoError(t, err)
+		return txConfig
+	}
+	txConfig := newTxConfig(1)
+	require.Contains(t, txConfig.SignModeHandler().SupportedModes(), signingv1beta1.SignMode_SIGN_MODE_EIP_712)
+
+	priv := ethsecp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	signerData := authsigning.SignerData{
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      2,
+		Address:       addr.String(),
+		PubKey:        priv.PubKey(),
+	}
+
+	// sign the tx in SIGN_MODE_EIP_712 with an Ethereum-style key
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(&testdata.TestMsg{Signers: []string{addr.String()}}))
+	txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	sigData := &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_EIP_712}
+	sig := signingtypes.SignatureV2{PubKey: priv.PubKey(), Data: sigData, Sequence: signerData.Sequence}
+	require.NoError(t, txBuilder.SetSignatures(sig))
+
+	signBytes, err := authsigning.GetSignBytesAdapter(context.Background(), txConfig.SignModeHandler(),
+		signingtypes.SignMode_SIGN_MODE_EIP_712, signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+	sigData.Signature, err = priv.Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, txBuilder.SetSignatures(sig))
+
+	txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	// verify the signature of the decoded tx
+	verify := func(txConfig client.TxConfig) error {
+		decoded, err := txConfig.TxDecoder()(txBytes)
+		require.NoError(t, err)
+		sigTx := decoded.(authsigning.Tx)
+		sigs, err := sigTx.GetSignaturesV2()
+		require.NoError(t, err)
+		require.Len(t, sigs, 1)
+
+		anyPk, err := types.NewAnyWithValue(sigs[0].PubKey)
+		require.NoError(t, err)
+		txSignerData := txsigning.SignerData{
+			ChainID:       signerData.ChainID,
+			AccountNumber: signerData.AccountNumber,
+			Sequence:      signerData.Sequence,
+			Address:       signerData.Address,
+			PubKey:        &anypb.Any{TypeUrl: anyPk.TypeUrl, Value: anyPk.Value},
+		}
+		txData := decoded.(authsigning.V2AdaptableTx).GetSigningTxData()
+		return authsigning.VerifySignature(context.Background(), sigs[0].PubKey, txSignerData, sigs[0].Data, txConfig.SignModeHandler(), txData)
+	}
+	require.NoError(t, verify(txConfig))
+
+	// the signature is bound to the EIP-712 domain
+	require.Error(t, verify(newTxConfig(2)))
+}
@@ -0,0 +1,163 @@
+package tx
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// DefaultGasPriceWindow is the number of recent blocks the gas price
+// recommendations of the tx service are computed from.
+const DefaultGasPriceWindow = 20
+
+// DefaultGasPriceBlocksPerQuery is the maximum number of blocks fetched from
+// the node by a single gas price query, bounding its cost when the window is
+// far behind. The blocks left out are fetched by the next queries.
+const DefaultGasPriceBlocksPerQuery = 5
+
+// Percentiles of the gas prices paid in the recent blocks recommended as the
+// slow, normal and fast gas prices.
+const (
+	slowGasPricePercentile   = 25
+	normalGasPricePercentile = 50
+	fastGasPricePercentile   = 90
+)
+
+// blockTxsFn returns the raw txs included in the block at the given height.
+type blockTxsFn func(ctx context.Context, height int64) ([][]byte, error)
+
+// gasPriceOracle keeps the gas prices paid by the txs included in a rolling
+// window of recent blocks, per fee denom. It is updated lazily, fetching up to
+// maxFetch of the blocks missing from the window when recommendations are
+// requested.
+type gasPriceOracle struct {
+	mu sync.Mutex
+
+	window    int64
+	maxFetch  int
+	blockTxs  blockTxsFn
+	txDecoder sdk.TxDecoder
+
+	// height is the height of the latest block of the window.
+	height int64
+	// blocks are the gas prices paid in each block of the window, per fee denom.
+	blocks map[int64]map[string][]math.LegacyDec
+}
+
+func newGasPriceOracle(window int64, maxFetch int, blockTxs blockTxsFn, txDecoder sdk.TxDecoder) *gasPriceOracle {
+	return &gasPriceOracle{
+		window:    window,
+		maxFetch:  maxFetch,
+		blockTxs:  blockTxs,
+		txDecoder: txDecoder,
+		blocks:    make(map[int64]map[string][]math.LegacyDec),
+	}
+}
+
+// GasPrices returns the gas price recommendations of denom, or of all the fee
+// denoms paid in the window if denom is empty, once the window is moved to end
+// at latestHeight, along with the height the window ends at. The window never
+// moves back, so that height is later than latestHeight if the window already
+// ended at a later height.
+func (o *gasPriceOracle) GasPrices(ctx context.Context, latestHeight int64, denom string) ([]txtypes.GasPriceEstimate, int64, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if err := o.update(ctx, latestHeight); err != nil {
+		return nil, 0, err
+	}
+
+	prices := make(map[string][]math.LegacyDec)
+	for _, blockPrices := range o.blocks {
+		for d, p := range blockPrices {
+			if denom == "" || d == denom {
+				prices[d] = append(prices[d], p...)
+			}
+		}
+	}
+
+	denoms := make([]string, 0, len(prices))
+	for d := range prices {
+		denoms = append(denoms, d)
+	}
+	sort.Strings(denoms)
+
+	estimates := make([]txtypes.GasPriceEstimate, 0, len(denoms))
+	for _, d := range denoms {
+		p := prices[d]
+		sort.Slice(p, func(i, j int) bool { return p[i].LT(p[j]) })
+		estimates = append(estimates, txtypes.GasPriceEstimate{
+			Denom:   d,
+			Slow:    percentile(p, slowGasPricePercentile),
+			Normal:  percentile(p, normalGasPricePercentile),
+			Fast:    percentile(p, fastGasPricePercentile),
+			Samples: uint64(len(p)),
+		})
+	}
+
+	return estimates, o.height, nil
+}
+
+// update moves the window to end at latestHeight, if later than its current
+// end, drops the blocks which fell out of it and fetches up to maxFetch of the
+// blocks missing from it, the most recent first.
+func (o *gasPriceOracle) update(ctx context.Context, latestHeight int64) error {
+	o.height = max(o.height, latestHeight)
+	for height := range o.blocks {
+		if height <= o.height-o.window {
+			delete(o.blocks, height)
+		}
+	}
+
+	fetched := 0
+	for height := o.height; height > max(o.height-o.window, 0) && fetched < o.maxFetch; height-- {
+		if _, ok := o.blocks[height]; ok {
+			continue
+		}
+
+		txs, err := o.blockTxs(ctx, height)
+		if err != nil {
+			return err
+		}
+
+		o.blocks[height] = o.blockGasPrices(txs)
+		fetched++
+	}
+
+	return nil
+}
+
+// blockGasPrices returns the gas prices paid by the txs of a block, per fee
+// denom. The txs which cannot be decoded or have no gas limit are skipped.
+func (o *gasPriceOracle) blockGasPrices(txs [][]byte) map[string][]math.LegacyDec {
+	prices := make(map[string][]math.LegacyDec)
+	for _, txBytes := range txs {
+		tx, err := o.txDecoder(txBytes)
+		if err != nil {
+			continue
+		}
+
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok || feeTx.GetGas() == 0 {
+			continue
+		}
+
+		gas := math.LegacyNewDecFromInt(math.NewIntFromUint64(feeTx.GetGas()))
+		for _, fee := range feeTx.GetFee() {
+			prices[fee.Denom] = append(prices[fee.Denom], math.LegacyNewDecFromInt(fee.Amount).Quo(gas))
+		}
+	}
+
+	return prices
+}
+
+// percentile returns the nearest-rank percentile of sorted prices.
+func percentile(prices []math.LegacyDec, p int) math.LegacyDec {
+	rank := (p*len(prices) + 99) / 100
+	return prices[max(rank-1, 0)]
+}
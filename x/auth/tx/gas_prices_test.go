@@ -0,0 +1,126 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+func TestGasPriceOracle(t *testing.T) {
+	_, _, addr := testdata.KeyTestPubAddr()
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+
+	// txs are identified by their raw bytes in the blocks
+	txs := make(map[string]sdk.Tx)
+	newTx := func(fees sdk.Coins, gas uint64) []byte {
+		txBuilder := newBuilder(cdc)
+		require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		txBuilder.SetFeeAmount(fees)
+		txBuilder.SetGasLimit(gas)
+
+		txBytes := fmt.Appendf(nil, "tx-%d", len(txs))
+		txs[string(txBytes)] = txBuilder.GetTx()
+		return txBytes
+	}
+	txDecoder := func(txBytes []byte) (sdk.Tx, error) {
+		tx, ok := txs[string(txBytes)]
+		if !ok {
+			return nil, errors.New("invalid tx")
+		}
+		return tx, nil
+	}
+
+	// block h includes a tx paying a gas price of h stake, and from block 3 on
+	// a tx paying a gas price of 2h atom
+	blocks := make(map[int64][][]byte)
+	var fetched []int64
+	blockTxs := func(_ context.Context, height int64) ([][]byte, error) {
+		fetched = append(fetched, height)
+		if _, ok := blocks[height]; !ok {
+			blocks[height] = [][]byte{newTx(sdk.NewCoins(sdk.NewInt64Coin("stake", height*100)), 100), []byte("undecodable")}
+			if height >= 3 {
+				blocks[height] = append(blocks[height], newTx(sdk.NewCoins(sdk.NewInt64Coin("atom", height*200)), 100))
+			}
+			blocks[height] = append(blocks[height], newTx(sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), 0))
+		}
+		return blocks[height], nil
+	}
+
+	oracle := newGasPriceOracle(4, 3, blockTxs, txDecoder)
+
+	estimates, height, err := oracle.GasPrices(context.Background(), 0, "")
+	require.NoError(t, err)
+	require.Empty(t, estimates)
+	require.Zero(t, height)
+
+	// the window spans blocks 1 to 4, of which only the 3 most recent are fetched
+	estimates, height, err = oracle.GasPrices(context.Background(), 4, "")
+	require.NoError(t, err)
+	require.Equal(t, int64(4), height)
+	require.Equal(t, []int64{4, 3, 2}, fetched)
+	require.Equal(t, []txtypes.GasPriceEstimate{
+		{Denom: "atom", Slow: sdkmath.LegacyNewDec(6), Normal: sdkmath.LegacyNewDec(6), Fast: sdkmath.LegacyNewDec(8), Samples: 2},
+		{Denom: "stake", Slow: sdkmath.LegacyNewDec(2), Normal: sdkmath.LegacyNewDec(3), Fast: sdkmath.LegacyNewDec(4), Samples: 3},
+	}, estimates)
+
+	// the next query fetches the missing block
+	estimates, _, err = oracle.GasPrices(context.Background(), 4, "")
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 3, 2, 1}, fetched)
+	require.Equal(t, []txtypes.GasPriceEstimate{
+		{Denom: "atom", Slow: sdkmath.LegacyNewDec(6), Normal: sdkmath.LegacyNewDec(6), Fast: sdkmath.LegacyNewDec(8), Samples: 2},
+		{Denom: "stake", Slow: sdkmath.LegacyNewDec(1), Normal: sdkmath.LegacyNewDec(2), Fast: sdkmath.LegacyNewDec(4), Samples: 4},
+	}, estimates)
+
+	// only the new blocks are fetched, and the window moves to blocks 3 to 6
+	estimates, height, err = oracle.GasPrices(context.Background(), 6, "stake")
+	require.NoError(t, err)
+	require.Equal(t, int64(6), height)
+	require.Equal(t, []int64{4, 3, 2, 1, 6, 5}, fetched)
+	require.Equal(t, []txtypes.GasPriceEstimate{
+		{Denom: "stake", Slow: sdkmath.LegacyNewDec(3), Normal: sdkmath.LegacyNewDec(4), Fast: sdkmath.LegacyNewDec(6), Samples: 4},
+	}, estimates)
+
+	// the window does not move back for a query at a past height, whose
+	// recommendations are reported at the height the window ends at
+	estimates, height, err = oracle.GasPrices(context.Background(), 2, "stake")
+	require.NoError(t, err)
+	require.Equal(t, int64(6), height)
+	require.Equal(t, []int64{4, 3, 2, 1, 6, 5}, fetched)
+	require.Equal(t, sdkmath.LegacyNewDec(4), estimates[0].Normal)
+
+	// blocks older than the window are not fetched
+	_, height, err = oracle.GasPrices(context.Background(), 20, "")
+	require.NoError(t, err)
+	require.Equal(t, int64(20), height)
+	require.Equal(t, []int64{4, 3, 2, 1, 6, 5, 20, 19, 18}, fetched)
+	_, _, err = oracle.GasPrices(context.Background(), 20, "")
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 3, 2, 1, 6, 5, 20, 19, 18, 17}, fetched)
+
+	// errors fetching the blocks are returned
+	failing := newGasPriceOracle(4, 3, func(context.Context, int64) ([][]byte, error) {
+		return nil, errors.New("node unavailable")
+	}, txDecoder)
+	_, _, err = failing.GasPrices(context.Background(), 1, "")
+	require.ErrorContains(t, err, "node unavailable")
+}
+
+func TestPercentile(t *testing.T) {
+	prices := []sdkmath.LegacyDec{sdkmath.LegacyNewDec(1), sdkmath.LegacyNewDec(2), sdkmath.LegacyNewDec(3)}
+	require.Equal(t, sdkmath.LegacyNewDec(1), percentile(prices, 0))
+	require.Equal(t, sdkmath.LegacyNewDec(1), percentile(prices, 25))
+	require.Equal(t, sdkmath.LegacyNewDec(2), percentile(prices, 50))
+	require.Equal(t, sdkmath.LegacyNewDec(3), percentile(prices, 90))
+	require.Equal(t, sdkmath.LegacyNewDec(3), percentile(prices, 100))
+}
@@ -11,6 +11,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -26,18 +28,38 @@ type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
 
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
-	clientCtx         client.Context
-	simulate          baseAppSimulateFn
-	interfaceRegistry codectypes.InterfaceRegistry
+	clientCtx             client.Context
+	simulate              baseAppSimulateFn
+	simulateWithStateDiff baseAppSimulateWithStateDiffFn
+	storeCollections      map[string][]collections.Collection
+	interfaceRegistry     codectypes.InterfaceRegistry
+	gasPrices             *gasPriceOracle
 }
 
 // NewTxServer creates a new Tx service server.
-func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
-	return txServer{
+func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry, opts ...TxServerOption) txtypes.ServiceServer {
+	blockTxs := func(ctx context.Context, height int64) ([][]byte, error) {
+		_, block, err := cmtservice.GetProtoBlock(ctx, clientCtx, &height)
+		if err != nil {
+			return nil, err
+		}
+		return block.Data.Txs, nil
+	}
+	txDecoder := func(txBytes []byte) (sdk.Tx, error) {
+		return clientCtx.TxConfig.TxDecoder()(txBytes)
+	}
+
+	s := txServer{
 		clientCtx:         clientCtx,
 		simulate:          simulate,
 		interfaceRegistry: interfaceRegistry,
+		gasPrices:         newGasPriceOracle(DefaultGasPriceWindow, DefaultGasPriceBlocksPerQuery, blockTxs, txDecoder),
+	}
+	for _, opt := range opts {
+		opt(&s)
 	}
+
+	return s
 }
 
 var _ txtypes.ServiceServer = txServer{}
@@ -95,6 +117,23 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 		return nil, status.Errorf(codes.InvalidArgument, "empty txBytes is not allowed")
 	}
 
+	if req.StateDiff {
+		if s.simulateWithStateDiff == nil {
+			return nil, status.Error(codes.Unimplemented, "state diff simulation is not enabled")
+		}
+
+		gasInfo, result, writes, err := s.simulateWithStateDiff(txBytes)
+		if err != nil {
+			return nil, status.Errorf(codes.Unknown, "%v with gas used: '%d'", err, gasInfo.GasUsed)
+		}
+
+		return &txtypes.SimulateResponse{
+			GasInfo:   &gasInfo,
+			Result:    result,
+			StateDiff: newStateDiff(writes, result.Events, s.storeCollections),
+		}, nil
+	}
+
 	gasInfo, result, err := s.simulate(txBytes)
 	if err != nil {
 		return nil, status.Errorf(codes.Unknown, "%v with gas used: '%d'", err, gasInfo.GasUsed)
@@ -304,16 +343,36 @@ func (s txServer) TxDecodeAmino(_ context.Context, req *txtypes.TxDecodeAminoReq
 	}, nil
 }
 
+// GasPrices implements the ServiceServer.GasPrices RPC method.
+func (s txServer) GasPrices(ctx context.Context, req *txtypes.GasPricesRequest) (*txtypes.GasPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	// The window never moves back, so a query at a past height reports the
+	// height the window actually ends at.
+	gasPrices, height, err := s.gasPrices.GasPrices(ctx, sdk.UnwrapSDKContext(ctx).BlockHeight(), req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &txtypes.GasPricesResponse{
+		GasPrices: gasPrices,
+		Height:    height,
+	}, nil
+}
+
 // RegisterTxService registers the tx service on the gRPC router.
 func RegisterTxService(
 	qrt gogogrpc.Server,
 	clientCtx client.Context,
 	simulateFn baseAppSimulateFn,
 	interfaceRegistry codectypes.InterfaceRegistry,
+	opts ...TxServerOption,
 ) {
 	txtypes.RegisterServiceServer(
 		qrt,
-		NewTxServer(clientCtx, simulateFn, interfaceRegistry),
+		NewTxServer(clientCtx, simulateFn, interfaceRegistry, opts...),
 	)
 }
 
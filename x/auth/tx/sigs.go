@@ -48,6 +48,12 @@ func SignatureDataToModeInfoAndSig(data signing.SignatureData) (*tx.ModeInfo, []
 				},
 			},
 		}, sig
+	case *signing.AggregateSignatureData:
+		return &tx.ModeInfo{
+			Sum: &tx.ModeInfo_Aggregate_{
+				Aggregate: &tx.ModeInfo_Aggregate{Mode: data.SignMode},
+			},
+		}, data.Signature
 	default:
 		panic(fmt.Sprintf("unexpected signature data type %T", data))
 	}
@@ -84,6 +90,12 @@ func ModeInfoAndSigToSignatureData(modeInfo *tx.ModeInfo, sig []byte) (signing.S
 			Signatures: sigv2s,
 		}, nil
 
+	case *tx.ModeInfo_Aggregate_:
+		return &signing.AggregateSignatureData{
+			SignMode:  modeInfo.Aggregate.Mode,
+			Signature: sig,
+		}, nil
+
 	default:
 		panic(fmt.Errorf("unexpected ModeInfo data type %T", modeInfo))
 	}
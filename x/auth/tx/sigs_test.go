@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 )
 
 func TestDecodeMultisignatures(t *testing.T) {
@@ -37,3 +38,20 @@ func TestDecodeMultisignatures(t *testing.T) {
 
 	require.Equal(t, testSigs, decodedSigs)
 }
+
+func TestAggregateSignatureData(t *testing.T) {
+	data := &signing.AggregateSignatureData{
+		SignMode:  signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		Signature: []byte("aggregate"),
+	}
+
+	modeInfo, sig := SignatureDataToModeInfoAndSig(data)
+	require.Equal(t, data.SignMode, modeInfo.GetAggregate().Mode)
+	require.Equal(t, data.Signature, sig)
+
+	decoded, err := ModeInfoAndSigToSignatureData(modeInfo, sig)
+	require.NoError(t, err)
+	require.Equal(t, data, decoded)
+
+	require.Equal(t, data, signing.SignatureDataFromProto(signing.SignatureDataToProto(data)))
+}
@@ -0,0 +1,131 @@
+package tx
+
+import (
+	"bytes"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/v2/abci/types"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// baseAppSimulateWithStateDiffFn is the signature of the Baseapp#SimulateWithStateDiff function.
+type baseAppSimulateWithStateDiffFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, []*storetypes.StoreKVPair, error)
+
+// TxServerOption is an option of the tx service server.
+type TxServerOption func(*txServer)
+
+// WithStateDiffSimulation enables the state diff of the Simulate RPC method,
+// computed with the given BaseApp#SimulateWithStateDiff function. The writes
+// to the stores whose collections schema is given, by store name, are decoded
+// with the value codecs of their collections.
+func WithStateDiffSimulation(simulate baseAppSimulateWithStateDiffFn, schemas map[string]collections.Schema) TxServerOption {
+	return func(s *txServer) {
+		s.simulateWithStateDiff = simulate
+		s.storeCollections = make(map[string][]collections.Collection, len(schemas))
+		for storeName, schema := range schemas {
+			s.storeCollections[storeName] = schema.ListCollections()
+		}
+	}
+}
+
+// newStateDiff returns the state diff of the writes and events of a simulated tx.
+func newStateDiff(writes []*storetypes.StoreKVPair, events []abci.Event, storeCollections map[string][]collections.Collection) *txtypes.StateDiff {
+	stateDiff := &txtypes.StateDiff{}
+
+	for _, write := range writes {
+		if len(stateDiff.Stores) == 0 || stateDiff.Stores[len(stateDiff.Stores)-1].StoreName != write.StoreKey {
+			stateDiff.Stores = append(stateDiff.Stores, txtypes.StoreDiff{StoreName: write.StoreKey})
+		}
+		storeDiff := &stateDiff.Stores[len(stateDiff.Stores)-1]
+
+		kvWrite := txtypes.KVWrite{Key: write.Key, Value: write.Value, Delete: write.Delete}
+		for _, coll := range storeCollections[write.StoreKey] {
+			if bytes.HasPrefix(write.Key, coll.GetPrefix()) {
+				kvWrite.Collection = coll.GetName()
+				if !write.Delete {
+					kvWrite.DecodedValue = decodeValue(coll.ValueCodec(), write.Value)
+				}
+				break
+			}
+		}
+		storeDiff.Writes = append(storeDiff.Writes, kvWrite)
+	}
+
+	stateDiff.Balances = balanceDeltas(events)
+
+	return stateDiff
+}
+
+// decodeValue returns the human-readable value of a collection, or an empty
+// string if it cannot be decoded.
+func decodeValue(valueCodec collcodec.UntypedValueCodec, value []byte) string {
+	decoded, err := valueCodec.Decode(value)
+	if err != nil {
+		return ""
+	}
+	s, err := valueCodec.Stringify(decoded)
+	if err != nil {
+		return ""
+	}
+	return s
+}
+
+// balanceDeltas returns the coins sent and received per address, sorted by
+// address, from the coin_spent and coin_received events of the bank module.
+func balanceDeltas(events []abci.Event) []txtypes.BalanceDelta {
+	deltas := map[string]*txtypes.BalanceDelta{}
+	getDelta := func(address string) *txtypes.BalanceDelta {
+		if _, ok := deltas[address]; !ok {
+			deltas[address] = &txtypes.BalanceDelta{Address: address}
+		}
+		return deltas[address]
+	}
+
+	for _, event := range events {
+		var addressKey string
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			addressKey = banktypes.AttributeKeySpender
+		case banktypes.EventTypeCoinReceived:
+			addressKey = banktypes.AttributeKeyReceiver
+		default:
+			continue
+		}
+
+		var address, amount string
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case addressKey:
+				address = attr.Value
+			case sdk.AttributeKeyAmount:
+				amount = attr.Value
+			}
+		}
+		coins, err := sdk.ParseCoinsNormalized(amount)
+		if address == "" || err != nil {
+			continue
+		}
+
+		delta := getDelta(address)
+		if event.Type == banktypes.EventTypeCoinSpent {
+			delta.Sent = delta.Sent.Add(coins...)
+		} else {
+			delta.Received = delta.Received.Add(coins...)
+		}
+	}
+
+	balances := make([]txtypes.BalanceDelta, 0, len(deltas))
+	for _, delta := range deltas {
+		balances = append(balances, *delta)
+	}
+	sort.Slice(balances, func(i, j int) bool { return balances[i].Address < balances[j].Address })
+
+	return balances
+}
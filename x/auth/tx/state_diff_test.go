@@ -0,0 +1,73 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/colltest"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestNewStateDiff(t *testing.T) {
+	storeService, _ := colltest.MockStore()
+	sb := collections.NewSchemaBuilder(storeService)
+	counters := collections.NewMap(sb, collections.NewPrefix(1), "counters", collections.StringKey, collections.Uint64Value)
+	schema, err := sb.Build()
+	require.NoError(t, err)
+
+	key, err := collections.EncodeKeyWithPrefix(counters.GetPrefix(), collections.StringKey, "foo")
+	require.NoError(t, err)
+	value, err := collections.Uint64Value.Encode(42)
+	require.NoError(t, err)
+
+	writes := []*storetypes.StoreKVPair{
+		{StoreKey: "bank", Key: []byte{0x01}, Value: []byte{0x02}},
+		{StoreKey: "counter", Key: key, Value: value},
+		{StoreKey: "counter", Key: []byte{0x02}, Delete: true},
+	}
+	events := sdk.Events{
+		sdk.NewEvent(banktypes.EventTypeCoinSpent,
+			sdk.NewAttribute(banktypes.AttributeKeySpender, "alice"),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, "10stake,5atom"),
+		),
+		sdk.NewEvent(banktypes.EventTypeCoinReceived,
+			sdk.NewAttribute(banktypes.AttributeKeyReceiver, "bob"),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, "10stake"),
+		),
+		sdk.NewEvent(banktypes.EventTypeCoinReceived,
+			sdk.NewAttribute(banktypes.AttributeKeyReceiver, "alice"),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, "1stake"),
+		),
+		sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAmount, "1stake")),
+	}.ToABCIEvents()
+
+	var server txServer
+	WithStateDiffSimulation(nil, map[string]collections.Schema{"counter": schema})(&server)
+	stateDiff := newStateDiff(writes, events, server.storeCollections)
+
+	require.Equal(t, []txtypes.StoreDiff{
+		{StoreName: "bank", Writes: []txtypes.KVWrite{{Key: []byte{0x01}, Value: []byte{0x02}}}},
+		{StoreName: "counter", Writes: []txtypes.KVWrite{
+			{Key: key, Value: value, Collection: "counters", DecodedValue: "42"},
+			{Key: []byte{0x02}, Delete: true},
+		}},
+	}, stateDiff.Stores)
+
+	require.Equal(t, []txtypes.BalanceDelta{
+		{
+			Address:  "alice",
+			Sent:     sdk.NewCoins(sdk.NewInt64Coin("stake", 10), sdk.NewInt64Coin("atom", 5)),
+			Received: sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+		},
+		{
+			Address:  "bob",
+			Received: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		},
+	}, stateDiff.Balances)
+}
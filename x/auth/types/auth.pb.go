@@ -4,21 +4,27 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	any "github.com/cosmos/gogoproto/types/any"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -172,6 +178,9 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// pub_key_rotation_cooldown is the minimum duration between two rotations of
+	// the public key of an account. A zero value disables the cooldown.
+	PubKeyRotationCooldown time.Duration `protobuf:"bytes,6,opt,name=pub_key_rotation_cooldown,json=pubKeyRotationCooldown,proto3,stdduration" json:"pub_key_rotation_cooldown"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -242,64 +251,387 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetPubKeyRotationCooldown() time.Duration {
+	if m != nil {
+		return m.PubKeyRotationCooldown
+	}
+	return 0
+}
+
+// FeeMarketParams defines the parameters of the x/auth fee market, which
+// maintains an EIP-1559 style base gas price that is adjusted every block
+// based on the block gas utilization.
+type FeeMarketParams struct {
+	// enabled defines whether the base gas price is enforced and adjusted.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// fee_denom is the denomination the base gas price is expressed in.
+	FeeDenom string `protobuf:"bytes,2,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty"`
+	// min_base_gas_price is the lower bound of the base gas price.
+	MinBaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=min_base_gas_price,json=minBaseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_gas_price"`
+	// max_base_gas_price is the upper bound of the base gas price. A zero value
+	// means the base gas price is unbounded.
+	MaxBaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_base_gas_price,json=maxBaseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_base_gas_price"`
+	// target_block_gas is the amount of gas a block should consume for the base
+	// gas price to remain unchanged.
+	TargetBlockGas uint64 `protobuf:"varint,5,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
+	// base_gas_price_change_denominator bounds the amount the base gas price can
+	// change between blocks, e.g. a value of 8 allows a change of at most 12.5%.
+	BaseGasPriceChangeDenominator uint64 `protobuf:"varint,6,opt,name=base_gas_price_change_denominator,json=baseGasPriceChangeDenominator,proto3" json:"base_gas_price_change_denominator,omitempty"`
+}
+
+func (m *FeeMarketParams) Reset()         { *m = FeeMarketParams{} }
+func (m *FeeMarketParams) String() string { return proto.CompactTextString(m) }
+func (*FeeMarketParams) ProtoMessage()    {}
+func (*FeeMarketParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{4}
+}
+func (m *FeeMarketParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeMarketParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeMarketParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeMarketParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeMarketParams.Merge(m, src)
+}
+func (m *FeeMarketParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeMarketParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeMarketParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeMarketParams proto.InternalMessageInfo
+
+func (m *FeeMarketParams) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *FeeMarketParams) GetFeeDenom() string {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return ""
+}
+
+func (m *FeeMarketParams) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *FeeMarketParams) GetBaseGasPriceChangeDenominator() uint64 {
+	if m != nil {
+		return m.BaseGasPriceChangeDenominator
+	}
+	return 0
+}
+
+// AccountAuthenticator is an authenticator an account opted into. The signatures
+// of an account having authenticators are authenticated by them, instead of
+// being verified against the public key of the account.
+type AccountAuthenticator struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// id is the identifier of the authenticator.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// authenticator_type is the type of the authenticator, under which it is
+	// registered in the account keeper.
+	AuthenticatorType string `protobuf:"bytes,3,opt,name=authenticator_type,json=authenticatorType,proto3" json:"authenticator_type,omitempty"`
+	// config is the configuration of the authenticator for the account, whose
+	// encoding is defined by the authenticator.
+	Config []byte `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (m *AccountAuthenticator) Reset()         { *m = AccountAuthenticator{} }
+func (m *AccountAuthenticator) String() string { return proto.CompactTextString(m) }
+func (*AccountAuthenticator) ProtoMessage()    {}
+func (*AccountAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{5}
+}
+func (m *AccountAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountAuthenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountAuthenticator.Merge(m, src)
+}
+func (m *AccountAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountAuthenticator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountAuthenticator proto.InternalMessageInfo
+
+func (m *AccountAuthenticator) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountAuthenticator) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AccountAuthenticator) GetAuthenticatorType() string {
+	if m != nil {
+		return m.AuthenticatorType
+	}
+	return ""
+}
+
+func (m *AccountAuthenticator) GetConfig() []byte {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+// SessionKeyConfig is the configuration of the session key authenticator. It
+// authenticates the txs signed by a session key until its expiry, provided all
+// their messages are allowed.
+type SessionKeyConfig struct {
+	// pub_key is the public key of the session key.
+	PubKey *any.Any `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// allowed_msg_type_urls are the type URLs of the messages the session key can
+	// sign.
+	AllowedMsgTypeUrls []string `protobuf:"bytes,2,rep,name=allowed_msg_type_urls,json=allowedMsgTypeUrls,proto3" json:"allowed_msg_type_urls,omitempty"`
+	// expiry is the time after which the session key is no longer valid.
+	Expiry time.Time `protobuf:"bytes,3,opt,name=expiry,proto3,stdtime" json:"expiry"`
+}
+
+func (m *SessionKeyConfig) Reset()         { *m = SessionKeyConfig{} }
+func (m *SessionKeyConfig) String() string { return proto.CompactTextString(m) }
+func (*SessionKeyConfig) ProtoMessage()    {}
+func (*SessionKeyConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{6}
+}
+func (m *SessionKeyConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionKeyConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionKeyConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionKeyConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionKeyConfig.Merge(m, src)
+}
+func (m *SessionKeyConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionKeyConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionKeyConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionKeyConfig proto.InternalMessageInfo
+
+func (m *SessionKeyConfig) GetPubKey() *any.Any {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *SessionKeyConfig) GetAllowedMsgTypeUrls() []string {
+	if m != nil {
+		return m.AllowedMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *SessionKeyConfig) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
+// UnorderedNonce is the nonce of an unordered transaction: the timeout of the
+// transaction, which its sender cannot use again until it expires.
+type UnorderedNonce struct {
+	// sender is the address of the signer of the transaction.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// timeout is the timeout timestamp of the transaction.
+	Timeout time.Time `protobuf:"bytes,2,opt,name=timeout,proto3,stdtime" json:"timeout"`
+}
+
+func (m *UnorderedNonce) Reset()         { *m = UnorderedNonce{} }
+func (m *UnorderedNonce) String() string { return proto.CompactTextString(m) }
+func (*UnorderedNonce) ProtoMessage()    {}
+func (*UnorderedNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{7}
+}
+func (m *UnorderedNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnorderedNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnorderedNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnorderedNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnorderedNonce.Merge(m, src)
+}
+func (m *UnorderedNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnorderedNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnorderedNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnorderedNonce proto.InternalMessageInfo
+
+func (m *UnorderedNonce) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *UnorderedNonce) GetTimeout() time.Time {
+	if m != nil {
+		return m.Timeout
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*ModuleCredential)(nil), "cosmos.auth.v1beta1.ModuleCredential")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*FeeMarketParams)(nil), "cosmos.auth.v1beta1.FeeMarketParams")
+	proto.RegisterType((*AccountAuthenticator)(nil), "cosmos.auth.v1beta1.AccountAuthenticator")
+	proto.RegisterType((*SessionKeyConfig)(nil), "cosmos.auth.v1beta1.SessionKeyConfig")
+	proto.RegisterType((*UnorderedNonce)(nil), "cosmos.auth.v1beta1.UnorderedNonce")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 745 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x41, 0x6f, 0xe3, 0x44,
-	0x14, 0x8e, 0x93, 0xd0, 0xa5, 0x93, 0x6e, 0x97, 0x7a, 0x43, 0xf1, 0x46, 0x28, 0xf6, 0x46, 0x42,
-	0x1b, 0x2a, 0x62, 0x6f, 0x02, 0x05, 0x35, 0xb7, 0x26, 0x20, 0x54, 0x95, 0x96, 0xca, 0x11, 0x3d,
-	0xf4, 0x62, 0x8d, 0x9d, 0xa9, 0x3b, 0x6a, 0xc6, 0x63, 0x3c, 0xe3, 0x2a, 0xee, 0x2f, 0xa8, 0x38,
-	0x21, 0x2e, 0x5c, 0x0b, 0xbf, 0xa0, 0x87, 0xfe, 0x08, 0xc4, 0xa9, 0xea, 0x05, 0x4e, 0x11, 0x4a,
-	0x0f, 0xad, 0x10, 0x3f, 0x02, 0x79, 0xc6, 0x69, 0x92, 0x6e, 0x2e, 0x91, 0xdf, 0xf7, 0x7d, 0xef,
-	0xbd, 0xef, 0xbd, 0x3c, 0x1b, 0x54, 0x3d, 0xca, 0x08, 0x65, 0x16, 0x8c, 0xf9, 0x89, 0x75, 0xd6,
-	0x74, 0x11, 0x87, 0x4d, 0x11, 0x98, 0x61, 0x44, 0x39, 0x55, 0x5f, 0x4a, 0xde, 0x14, 0x50, 0xc6,
-	0x57, 0xd6, 0x20, 0xc1, 0x01, 0xb5, 0xc4, 0xaf, 0xd4, 0x55, 0x5e, 0x49, 0x9d, 0x23, 0x22, 0x2b,
-	0x4b, 0x92, 0x54, 0xd9, 0xa7, 0x3e, 0x95, 0x78, 0xfa, 0x34, 0x49, 0xf0, 0x29, 0xf5, 0x07, 0xc8,
-	0x12, 0x91, 0x1b, 0x1f, 0x5b, 0x30, 0x48, 0x24, 0x55, 0xfb, 0x2d, 0x0f, 0x4a, 0x1d, 0xc8, 0xd0,
-	0xb6, 0xe7, 0xd1, 0x38, 0xe0, 0x6a, 0x0b, 0x3c, 0x83, 0xfd, 0x7e, 0x84, 0x18, 0xd3, 0x14, 0x43,
-	0xa9, 0x2f, 0x77, 0xb4, 0xdb, 0xeb, 0x46, 0x39, 0xeb, 0xb1, 0x2d, 0x99, 0x1e, 0x8f, 0x70, 0xe0,
-	0xdb, 0x13, 0xa1, 0x7a, 0x08, 0x9e, 0x85, 0xb1, 0xeb, 0x9c, 0xa2, 0x44, 0xcb, 0x1b, 0x4a, 0xbd,
-	0xd4, 0x2a, 0x9b, 0xb2, 0xa1, 0x39, 0x69, 0x68, 0x6e, 0x07, 0x49, 0xe7, 0xcd, 0xbf, 0x23, 0xbd,
-	0x1c, 0xc6, 0xee, 0x00, 0x7b, 0xa9, 0xf6, 0x33, 0x4a, 0x30, 0x47, 0x24, 0xe4, 0xc9, 0xef, 0xf7,
-	0x57, 0x1b, 0x60, 0x4a, 0xd8, 0x4b, 0x61, 0xec, 0xee, 0xa2, 0x44, 0xfd, 0x04, 0xac, 0x42, 0x69,
-	0xcb, 0x09, 0x62, 0xe2, 0xa2, 0x48, 0x2b, 0x18, 0x4a, 0xbd, 0x68, 0x3f, 0xcf, 0xd0, 0x7d, 0x01,
-	0xaa, 0x15, 0xf0, 0x3e, 0x43, 0x3f, 0xc6, 0x28, 0xf0, 0x90, 0x56, 0x14, 0x82, 0xc7, 0xb8, 0xdd,
-	0xbd, 0xb8, 0xd4, 0x73, 0x0f, 0x97, 0x7a, 0xee, 0xcf, 0xeb, 0xc6, 0xc7, 0x0b, 0xd6, 0x6b, 0x66,
-	0x73, 0xef, 0xfc, 0x74, 0x7f, 0xb5, 0xb1, 0x2e, 0x05, 0x0d, 0xd6, 0x3f, 0xb5, 0x66, 0x76, 0x52,
-	0xfb, 0x4f, 0x01, 0xcf, 0xf7, 0x68, 0x3f, 0x1e, 0x3c, 0x6e, 0x69, 0x07, 0xac, 0xb8, 0x90, 0x21,
-	0x27, 0x33, 0x22, 0x56, 0x55, 0x6a, 0x19, 0xe6, 0xa2, 0x0e, 0x33, 0x95, 0x3a, 0xc5, 0x9b, 0x91,
-	0xae, 0xd8, 0x25, 0x77, 0x66, 0xe1, 0x2a, 0x28, 0x06, 0x90, 0x20, 0xb1, 0xb9, 0x65, 0x5b, 0x3c,
-	0xab, 0x06, 0x28, 0x85, 0x28, 0x22, 0x98, 0x31, 0x4c, 0x03, 0xa6, 0x15, 0x8c, 0x42, 0x7d, 0xd9,
-	0x9e, 0x85, 0xda, 0x47, 0x17, 0x72, 0xa6, 0xda, 0xa2, 0x8e, 0x73, 0x5e, 0xc5, 0x64, 0xda, 0xcc,
-	0x64, 0x73, 0xec, 0x2f, 0xf7, 0x57, 0x1b, 0xab, 0x44, 0x20, 0x93, 0x61, 0x6a, 0xbf, 0x2a, 0xe0,
-	0x03, 0x29, 0xea, 0x46, 0xa8, 0x8f, 0x02, 0x8e, 0xe1, 0x40, 0xd5, 0x41, 0x29, 0x93, 0x09, 0xb7,
-	0xe2, 0x36, 0x6c, 0x20, 0xa1, 0xfd, 0xd4, 0xf3, 0x1b, 0xf0, 0xa2, 0x8f, 0x22, 0x7c, 0x06, 0x39,
-	0xa6, 0x41, 0xfa, 0x37, 0x32, 0x2d, 0x6f, 0x14, 0xea, 0x2b, 0xf6, 0xea, 0x14, 0xde, 0x45, 0x09,
-	0x6b, 0x6f, 0xdd, 0x5e, 0x37, 0x5e, 0x4c, 0xfd, 0x18, 0x6f, 0xcd, 0x2f, 0xbe, 0x4a, 0x3d, 0xbe,
-	0x9e, 0xf1, 0xf8, 0x6d, 0x44, 0xe3, 0x30, 0xb3, 0x38, 0x35, 0x51, 0xfb, 0x2b, 0x0f, 0x96, 0x0e,
-	0x60, 0x04, 0x09, 0x53, 0x4d, 0xf0, 0x92, 0xc0, 0xa1, 0x43, 0x10, 0xa1, 0x8e, 0x77, 0x02, 0x23,
-	0xe8, 0x71, 0x14, 0xc9, 0x9b, 0x2d, 0xda, 0x6b, 0x04, 0x0e, 0xf7, 0x10, 0xa1, 0xdd, 0x47, 0x42,
-	0x35, 0xc0, 0x0a, 0x1f, 0x3a, 0x0c, 0xfb, 0xce, 0x00, 0x13, 0xcc, 0xc5, 0xba, 0x8b, 0x36, 0xe0,
-	0xc3, 0x1e, 0xf6, 0xbf, 0x4b, 0x11, 0xf5, 0x2d, 0xf8, 0x50, 0x28, 0xce, 0x91, 0xe3, 0x51, 0xc6,
-	0x9d, 0x10, 0x45, 0x8e, 0x9b, 0x70, 0x94, 0x1d, 0xdd, 0x5a, 0x2a, 0x3d, 0x47, 0x5d, 0xca, 0xf8,
-	0x01, 0x8a, 0x3a, 0x09, 0x47, 0xea, 0xf7, 0xe0, 0xa3, 0xb4, 0xe0, 0x19, 0x8a, 0xf0, 0x71, 0x22,
-	0x93, 0x50, 0xbf, 0xb5, 0xb9, 0xd9, 0xdc, 0x92, 0x77, 0xd8, 0xd1, 0xc6, 0x23, 0xbd, 0xdc, 0xc3,
-	0xfe, 0xa1, 0x50, 0xa4, 0xa9, 0xdf, 0x7c, 0x2d, 0x78, 0xbb, 0xcc, 0xe6, 0x50, 0x99, 0xa5, 0xfe,
-	0x00, 0x5e, 0x3d, 0x2d, 0xc8, 0x90, 0x17, 0xb6, 0x36, 0xbf, 0x3c, 0x6d, 0x6a, 0xef, 0x89, 0x92,
-	0x95, 0xf1, 0x48, 0x5f, 0x9f, 0x2b, 0xd9, 0x9b, 0x28, 0xec, 0x75, 0xb6, 0x10, 0x6f, 0xbf, 0x7e,
-	0xb8, 0xd4, 0x95, 0xa7, 0x67, 0x30, 0x94, 0x9f, 0x21, 0xb9, 0xce, 0x4e, 0xf7, 0x8f, 0x71, 0x55,
-	0xb9, 0x19, 0x57, 0x95, 0x7f, 0xc6, 0x55, 0xe5, 0xe7, 0xbb, 0x6a, 0xee, 0xe6, 0xae, 0x9a, 0xfb,
-	0xfb, 0xae, 0x9a, 0x3b, 0xfa, 0xd4, 0xc7, 0xfc, 0x24, 0x76, 0x4d, 0x8f, 0x92, 0xec, 0x53, 0x63,
-	0xbd, 0x5b, 0x85, 0x27, 0x21, 0x62, 0xee, 0x92, 0x78, 0xdd, 0x3f, 0xff, 0x3f, 0x00, 0x00, 0xff,
-	0xff, 0x8c, 0x05, 0x77, 0x9c, 0xe8, 0x04, 0x00, 0x00,
+	// 1225 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x4f, 0x1b, 0xc7,
+	0x17, 0x67, 0xc1, 0x5f, 0x13, 0xc6, 0x04, 0xc2, 0xc6, 0xe1, 0xbb, 0x90, 0xd6, 0x76, 0x2c, 0x55,
+	0xa1, 0xa8, 0xac, 0x03, 0x0d, 0xa9, 0x82, 0xd4, 0x03, 0x36, 0x2d, 0x8d, 0x12, 0x52, 0xb4, 0x24,
+	0x39, 0xe4, 0xb2, 0x9a, 0xdd, 0x7d, 0x2c, 0x2b, 0xbc, 0x3b, 0xdb, 0x99, 0x59, 0xe2, 0xcd, 0x5f,
+	0x10, 0xf5, 0x14, 0xf5, 0xd2, 0x1e, 0x7a, 0x48, 0x7b, 0xea, 0x91, 0x03, 0xea, 0x7f, 0x50, 0x29,
+	0xca, 0xa5, 0x11, 0xa7, 0x2a, 0x07, 0x52, 0x11, 0xa9, 0x44, 0x55, 0xff, 0x88, 0x6a, 0x67, 0xc6,
+	0xf8, 0x07, 0x56, 0xab, 0xb6, 0x17, 0xcb, 0xf3, 0xde, 0x67, 0xde, 0xfb, 0xbc, 0xf9, 0xbc, 0x37,
+	0xb3, 0xa8, 0xe4, 0x12, 0x16, 0x12, 0x56, 0xc3, 0x09, 0xdf, 0xa9, 0xed, 0x2d, 0x3a, 0xc0, 0xf1,
+	0xa2, 0x58, 0x98, 0x31, 0x25, 0x9c, 0xe8, 0x17, 0xa5, 0xdf, 0x14, 0x26, 0xe5, 0x9f, 0x9d, 0xc2,
+	0x61, 0x10, 0x91, 0x9a, 0xf8, 0x95, 0xb8, 0xd9, 0x19, 0x89, 0xb3, 0xc5, 0xaa, 0xa6, 0x36, 0x49,
+	0x57, 0xd1, 0x27, 0x3e, 0x91, 0xf6, 0xec, 0x5f, 0x7b, 0x83, 0x4f, 0x88, 0xdf, 0x84, 0x9a, 0x58,
+	0x39, 0xc9, 0x76, 0x0d, 0x47, 0xa9, 0x72, 0x95, 0xfa, 0x5d, 0x5e, 0x42, 0x31, 0x0f, 0x48, 0xa4,
+	0xfc, 0xe5, 0x7e, 0x3f, 0x0f, 0x42, 0x60, 0x1c, 0x87, 0xb1, 0x04, 0x54, 0xbf, 0x1b, 0x46, 0x85,
+	0x3a, 0x66, 0xb0, 0xea, 0xba, 0x24, 0x89, 0xb8, 0xbe, 0x84, 0x46, 0xb1, 0xe7, 0x51, 0x60, 0xcc,
+	0xd0, 0x2a, 0xda, 0xdc, 0x58, 0xdd, 0x38, 0x3c, 0x58, 0x28, 0x2a, 0x92, 0xab, 0xd2, 0xb3, 0xc5,
+	0x69, 0x10, 0xf9, 0x56, 0x1b, 0xa8, 0x3f, 0x40, 0xa3, 0x71, 0xe2, 0xd8, 0xbb, 0x90, 0x1a, 0xc3,
+	0x15, 0x6d, 0xae, 0xb0, 0x54, 0x34, 0x65, 0x5a, 0xb3, 0x9d, 0xd6, 0x5c, 0x8d, 0xd2, 0xfa, 0xd5,
+	0xdf, 0x8f, 0xca, 0xc5, 0x38, 0x71, 0x9a, 0x81, 0x9b, 0x61, 0x3f, 0x20, 0x61, 0xc0, 0x21, 0x8c,
+	0x79, 0xfa, 0xfd, 0xc9, 0xfe, 0x3c, 0xea, 0x38, 0xac, 0x7c, 0x9c, 0x38, 0xb7, 0x21, 0xd5, 0xdf,
+	0x43, 0x13, 0x58, 0xd2, 0xb2, 0xa3, 0x24, 0x74, 0x80, 0x1a, 0x23, 0x15, 0x6d, 0x2e, 0x67, 0x9d,
+	0x57, 0xd6, 0xbb, 0xc2, 0xa8, 0xcf, 0xa2, 0x73, 0x0c, 0xbe, 0x48, 0x20, 0x72, 0xc1, 0xc8, 0x09,
+	0xc0, 0xe9, 0x7a, 0xa5, 0xf1, 0xe4, 0x59, 0x79, 0xe8, 0xed, 0xb3, 0xf2, 0xd0, 0x8b, 0x83, 0x85,
+	0x77, 0x06, 0xe8, 0x63, 0xaa, 0xba, 0x6f, 0x7d, 0x79, 0xb2, 0x3f, 0x3f, 0x2d, 0x01, 0x0b, 0xcc,
+	0xdb, 0xad, 0x75, 0x9d, 0x49, 0xf5, 0x0f, 0x0d, 0x9d, 0xdf, 0x20, 0x5e, 0xd2, 0x3c, 0x3d, 0xa5,
+	0x5b, 0x68, 0xdc, 0xc1, 0x0c, 0x6c, 0x45, 0x44, 0x1c, 0x55, 0x61, 0xa9, 0x62, 0x0e, 0xca, 0xd0,
+	0x15, 0xa9, 0x9e, 0x7b, 0x79, 0x54, 0xd6, 0xac, 0x82, 0xd3, 0x75, 0xe0, 0x3a, 0xca, 0x45, 0x38,
+	0x04, 0x71, 0x72, 0x63, 0x96, 0xf8, 0xaf, 0x57, 0x50, 0x21, 0x06, 0x1a, 0x06, 0x8c, 0x05, 0x24,
+	0x62, 0xc6, 0x48, 0x65, 0x64, 0x6e, 0xcc, 0xea, 0x36, 0xad, 0x3c, 0x7c, 0x22, 0x6b, 0xaa, 0x0e,
+	0xca, 0xd8, 0xc3, 0x55, 0x54, 0x66, 0x74, 0x55, 0xd6, 0xe3, 0xfd, 0xea, 0x64, 0x7f, 0x7e, 0x22,
+	0x14, 0x96, 0x76, 0x31, 0xd5, 0xaf, 0x35, 0x74, 0x41, 0x82, 0x1a, 0x14, 0x3c, 0x88, 0x78, 0x80,
+	0x9b, 0x7a, 0x19, 0x15, 0x14, 0x4c, 0xb0, 0x15, 0xbd, 0x61, 0x21, 0x69, 0xba, 0x9b, 0x71, 0xbe,
+	0x8a, 0x26, 0x3d, 0xa0, 0xc1, 0x9e, 0xe8, 0xbe, 0x4c, 0x46, 0x66, 0x0c, 0x57, 0x46, 0xe6, 0xc6,
+	0xad, 0x89, 0x8e, 0xf9, 0x36, 0xa4, 0x6c, 0xe5, 0xe6, 0xe1, 0xc1, 0xc2, 0x64, 0x87, 0x4f, 0xe5,
+	0x9a, 0x79, 0xfd, 0xa3, 0x8c, 0xe3, 0x95, 0x2e, 0x8e, 0xeb, 0x94, 0x24, 0xb1, 0xa2, 0xd8, 0x21,
+	0x51, 0xfd, 0x79, 0x04, 0xe5, 0x37, 0x31, 0xc5, 0x21, 0xd3, 0x4d, 0x74, 0x31, 0xc4, 0x2d, 0x3b,
+	0x84, 0x90, 0xd8, 0xee, 0x0e, 0xa6, 0xd8, 0xe5, 0x40, 0x65, 0xcf, 0xe6, 0xac, 0xa9, 0x10, 0xb7,
+	0x36, 0x20, 0x24, 0x8d, 0x53, 0x87, 0x5e, 0x41, 0xe3, 0xbc, 0x65, 0xb3, 0xc0, 0xb7, 0x9b, 0x41,
+	0x18, 0x70, 0x71, 0xdc, 0x39, 0x0b, 0xf1, 0xd6, 0x56, 0xe0, 0xdf, 0xc9, 0x2c, 0xfa, 0x35, 0x74,
+	0x49, 0x20, 0x1e, 0x83, 0xed, 0x12, 0xc6, 0xed, 0x18, 0xa8, 0xed, 0xa4, 0x1c, 0x54, 0xd3, 0x4d,
+	0x65, 0xd0, 0xc7, 0xd0, 0x20, 0x8c, 0x6f, 0x02, 0xad, 0xa7, 0x1c, 0xf4, 0xcf, 0xd1, 0xff, 0xb3,
+	0x80, 0x7b, 0x40, 0x83, 0xed, 0x54, 0x6e, 0x02, 0x6f, 0x69, 0x79, 0x79, 0xf1, 0xa6, 0xec, 0xc3,
+	0xba, 0x71, 0x7c, 0x54, 0x2e, 0x6e, 0x05, 0xfe, 0x03, 0x81, 0xc8, 0xb6, 0x7e, 0xb2, 0x26, 0xfc,
+	0x56, 0x91, 0xf5, 0x58, 0xe5, 0x2e, 0xfd, 0x3e, 0x9a, 0xe9, 0x0f, 0xc8, 0xc0, 0x8d, 0x97, 0x96,
+	0x6f, 0xec, 0x2e, 0x1a, 0xff, 0x13, 0x21, 0x67, 0x8f, 0x8f, 0xca, 0xd3, 0x3d, 0x21, 0xb7, 0xda,
+	0x08, 0x6b, 0x9a, 0x0d, 0xb4, 0xeb, 0x7b, 0x68, 0x46, 0xcd, 0xa7, 0x4d, 0x09, 0x97, 0x02, 0xb9,
+	0x84, 0x34, 0x3d, 0xf2, 0x28, 0x32, 0xf2, 0xa2, 0x75, 0x67, 0xce, 0x4c, 0xec, 0x9a, 0xba, 0x48,
+	0xea, 0x95, 0xe7, 0x47, 0xe5, 0xa1, 0x6f, 0x5e, 0x97, 0xb5, 0x57, 0xfd, 0xba, 0x2d, 0x5f, 0xff,
+	0xe1, 0x64, 0x7f, 0x5e, 0xb3, 0xa6, 0xe5, 0xbc, 0x5a, 0x2a, 0x76, 0x43, 0x85, 0x5e, 0xb9, 0xf2,
+	0xf6, 0x59, 0x59, 0xeb, 0x6f, 0xbf, 0x96, 0xbc, 0x3f, 0xa5, 0x8c, 0xd5, 0x9f, 0x46, 0xd0, 0xe4,
+	0xa7, 0x00, 0x1b, 0x98, 0xee, 0x02, 0x57, 0xd2, 0x1a, 0x68, 0x14, 0x22, 0xec, 0x34, 0xc1, 0x13,
+	0x72, 0x9e, 0xb3, 0xda, 0x4b, 0xfd, 0x32, 0x1a, 0xdb, 0x06, 0xb0, 0x3d, 0x88, 0x48, 0xa8, 0x06,
+	0xe6, 0xdc, 0x36, 0xc0, 0x5a, 0xb6, 0xd6, 0x5d, 0xa4, 0x87, 0x41, 0x64, 0x8b, 0xb9, 0xf4, 0x71,
+	0x76, 0xbd, 0x06, 0xae, 0x14, 0x6f, 0xac, 0x7e, 0x23, 0xab, 0xe1, 0xd5, 0x51, 0xf9, 0xb2, 0x24,
+	0xc2, 0xbc, 0x5d, 0x33, 0x20, 0xb5, 0x10, 0xf3, 0x1d, 0xf3, 0x0e, 0xf8, 0xd8, 0x4d, 0xd7, 0xc0,
+	0x3d, 0x3c, 0x58, 0x40, 0x6a, 0x9a, 0xd6, 0xc0, 0x95, 0x95, 0x4d, 0x86, 0x41, 0x94, 0xcd, 0xef,
+	0x3a, 0x66, 0x9b, 0x59, 0x38, 0x91, 0x04, 0xb7, 0xfa, 0x93, 0xe4, 0xfe, 0x63, 0x12, 0xdc, 0xea,
+	0x49, 0x32, 0x87, 0x2e, 0x70, 0x4c, 0x7d, 0xe0, 0xb6, 0xd3, 0x24, 0xee, 0x6e, 0x96, 0x48, 0xaa,
+	0x6f, 0x4d, 0x48, 0x7b, 0x3d, 0x33, 0xaf, 0x63, 0xa6, 0x7f, 0x86, 0xae, 0xf4, 0x52, 0xc9, 0x66,
+	0x21, 0xf2, 0xd5, 0x11, 0x05, 0x11, 0xe6, 0x84, 0x0a, 0x85, 0x73, 0xd6, 0xbb, 0x4e, 0x57, 0x8a,
+	0x86, 0x40, 0xad, 0x75, 0x40, 0x2b, 0x1f, 0x67, 0x5a, 0x1d, 0x9e, 0x55, 0xb8, 0x7f, 0x32, 0x95,
+	0x7c, 0x7d, 0x9a, 0x55, 0x7f, 0xd4, 0x50, 0x51, 0xcd, 0xeb, 0x6a, 0xc2, 0x77, 0xb2, 0x79, 0x75,
+	0xb3, 0xb8, 0xff, 0xea, 0x3d, 0x99, 0x40, 0xc3, 0x81, 0xa7, 0x26, 0x74, 0x38, 0xf0, 0xf4, 0x05,
+	0xa4, 0xe3, 0xee, 0xa0, 0x36, 0x4f, 0x63, 0xa5, 0xac, 0x35, 0xd5, 0xe3, 0xb9, 0x97, 0xc6, 0xa0,
+	0x4f, 0xa3, 0xbc, 0x4b, 0xa2, 0xed, 0xc0, 0x17, 0xba, 0x8c, 0x5b, 0x6a, 0xb5, 0x72, 0x71, 0x40,
+	0x79, 0xd5, 0xdf, 0x34, 0x74, 0x61, 0x0b, 0xc4, 0xad, 0x7a, 0x1b, 0xd2, 0x86, 0x40, 0xea, 0xeb,
+	0x9d, 0x07, 0x4d, 0xfb, 0x8b, 0x07, 0xcd, 0x78, 0xd1, 0x29, 0xc5, 0xa5, 0x69, 0xcc, 0x89, 0xb9,
+	0x29, 0x27, 0xa1, 0xfd, 0x82, 0x2d, 0xa2, 0x4b, 0xb8, 0xd9, 0x24, 0x8f, 0xc0, 0xb3, 0x43, 0xe6,
+	0x0b, 0xde, 0x76, 0x42, 0x9b, 0xf2, 0x6a, 0x1c, 0xb3, 0x74, 0xe5, 0xdc, 0x60, 0x7e, 0xc6, 0xfc,
+	0x3e, 0x6d, 0x32, 0x7d, 0x15, 0xe5, 0xa1, 0x15, 0x07, 0x34, 0x15, 0x05, 0x16, 0x96, 0x66, 0xcf,
+	0xa4, 0xbe, 0xd7, 0x7e, 0xc2, 0xeb, 0xe7, 0xb3, 0x8e, 0x7b, 0xfa, 0xba, 0xac, 0xc9, 0x46, 0x52,
+	0x1b, 0x07, 0x17, 0xfa, 0xad, 0x86, 0x26, 0xee, 0x47, 0x84, 0x7a, 0x40, 0xc1, 0xbb, 0x4b, 0x22,
+	0x17, 0xf4, 0x6b, 0x28, 0xcf, 0x20, 0xf2, 0x80, 0xfe, 0xad, 0x34, 0x0a, 0xa7, 0x37, 0xd0, 0x68,
+	0xf6, 0x01, 0x41, 0x12, 0x6e, 0x0c, 0xff, 0x53, 0x76, 0xed, 0x9d, 0x03, 0xe9, 0xd5, 0x1b, 0xcf,
+	0x8f, 0x4b, 0xda, 0xcb, 0xe3, 0x92, 0xf6, 0xeb, 0x71, 0x49, 0x7b, 0xfa, 0xa6, 0x34, 0xf4, 0xf2,
+	0x4d, 0x69, 0xe8, 0x97, 0x37, 0xa5, 0xa1, 0x87, 0xef, 0xfb, 0x01, 0xdf, 0x49, 0x1c, 0xd3, 0x25,
+	0xa1, 0xfa, 0x58, 0xaa, 0x9d, 0xed, 0xc7, 0xec, 0x64, 0x99, 0x93, 0x17, 0x2c, 0x3e, 0xfc, 0x73,
+	0x00, 0x5d, 0x04, 0xa8, 0x2a, 0xaa, 0x09, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -336,6 +668,48 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if this.PubKeyRotationCooldown != that1.PubKeyRotationCooldown {
+		return false
+	}
+	return true
+}
+func (this *FeeMarketParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeMarketParams)
+	if !ok {
+		that2, ok := that.(FeeMarketParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.FeeDenom != that1.FeeDenom {
+		return false
+	}
+	if !this.MinBaseGasPrice.Equal(that1.MinBaseGasPrice) {
+		return false
+	}
+	if !this.MaxBaseGasPrice.Equal(that1.MaxBaseGasPrice) {
+		return false
+	}
+	if this.TargetBlockGas != that1.TargetBlockGas {
+		return false
+	}
+	if this.BaseGasPriceChangeDenominator != that1.BaseGasPriceChangeDenominator {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -500,6 +874,14 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.PubKeyRotationCooldown, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PubKeyRotationCooldown):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAuth(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -528,52 +910,261 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuth(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FeeMarketParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *BaseAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *FeeMarketParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeMarketParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
+	if m.BaseGasPriceChangeDenominator != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.BaseGasPriceChangeDenominator))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.PubKey != nil {
-		l = m.PubKey.Size()
-		n += 1 + l + sovAuth(uint64(l))
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.AccountNumber != 0 {
-		n += 1 + sovAuth(uint64(m.AccountNumber))
+	{
+		size := m.MaxBaseGasPrice.Size()
+		i -= size
+		if _, err := m.MaxBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinBaseGasPrice.Size()
+		i -= size
+		if _, err := m.MinBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovAuth(uint64(m.Sequence))
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ModuleAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AccountAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BaseAccount != nil {
-		l = m.BaseAccount.Size()
-		n += 1 + l + sovAuth(uint64(l))
+	if len(m.Config) > 0 {
+		i -= len(m.Config)
+		copy(dAtA[i:], m.Config)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Config)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Name)
-	if l > 0 {
+	if len(m.AuthenticatorType) > 0 {
+		i -= len(m.AuthenticatorType)
+		copy(dAtA[i:], m.AuthenticatorType)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.AuthenticatorType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SessionKeyConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SessionKeyConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SessionKeyConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAuth(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AllowedMsgTypeUrls) > 0 {
+		for iNdEx := len(m.AllowedMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.AllowedMsgTypeUrls[iNdEx])
+			i = encodeVarintAuth(dAtA, i, uint64(len(m.AllowedMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PubKey != nil {
+		{
+			size, err := m.PubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnorderedNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnorderedNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnorderedNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timeout):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintAuth(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuth(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BaseAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovAuth(uint64(m.AccountNumber))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovAuth(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *ModuleAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseAccount != nil {
+		l = m.BaseAccount.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
 		n += 1 + l + sovAuth(uint64(l))
 	}
 	if len(m.Permissions) > 0 {
@@ -625,6 +1216,94 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.PubKeyRotationCooldown)
+	n += 1 + l + sovAuth(uint64(l))
+	return n
+}
+
+func (m *FeeMarketParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = m.MinBaseGasPrice.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	l = m.MaxBaseGasPrice.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovAuth(uint64(m.TargetBlockGas))
+	}
+	if m.BaseGasPriceChangeDenominator != 0 {
+		n += 1 + sovAuth(uint64(m.BaseGasPriceChangeDenominator))
+	}
+	return n
+}
+
+func (m *AccountAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovAuth(uint64(m.Id))
+	}
+	l = len(m.AuthenticatorType)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = len(m.Config)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	return n
+}
+
+func (m *SessionKeyConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PubKey != nil {
+		l = m.PubKey.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if len(m.AllowedMsgTypeUrls) > 0 {
+		for _, s := range m.AllowedMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovAuth(uint64(l))
+	return n
+}
+
+func (m *UnorderedNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timeout)
+	n += 1 + l + sovAuth(uint64(l))
 	return n
 }
 
@@ -644,28 +1323,678 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowAuth
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &any.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+			}
+			m.AccountNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAccount == nil {
+				m.BaseAccount = &BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleCredential: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivationKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivationKeys = append(m.DerivationKeys, make([]byte, postIndex-iNdEx))
+			copy(m.DerivationKeys[len(m.DerivationKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoCharacters", wireType)
+			}
+			m.MaxMemoCharacters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoCharacters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSigLimit", wireType)
+			}
+			m.TxSigLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSigLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeCostPerByte", wireType)
+			}
+			m.TxSizeCostPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSizeCostPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostED25519", wireType)
+			}
+			m.SigVerifyCostED25519 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostED25519 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256k1", wireType)
+			}
+			m.SigVerifyCostSecp256k1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostSecp256k1 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotationCooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.PubKeyRotationCooldown, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeMarketParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeMarketParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeMarketParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BaseAccount: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BaseAccount: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseGasPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -693,13 +2022,15 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.MinBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseGasPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -709,33 +2040,31 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PubKey == nil {
-				m.PubKey = &any.Any{}
-			}
-			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
 			}
-			m.AccountNumber = 0
+			m.TargetBlockGas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -745,16 +2074,16 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccountNumber |= uint64(b&0x7F) << shift
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPriceChangeDenominator", wireType)
 			}
-			m.Sequence = 0
+			m.BaseGasPriceChangeDenominator = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -764,7 +2093,7 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.BaseGasPriceChangeDenominator |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -790,7 +2119,7 @@ func (m *BaseAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
+func (m *AccountAuthenticator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -813,17 +2142,17 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModuleAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: AccountAuthenticator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModuleAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AccountAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -833,31 +2162,46 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseAccount == nil {
-				m.BaseAccount = &BaseAccount{}
-			}
-			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -885,13 +2229,13 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.AuthenticatorType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -901,23 +2245,25 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
+			if m.Config == nil {
+				m.Config = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -940,7 +2286,7 @@ func (m *ModuleAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
+func (m *SessionKeyConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -963,15 +2309,51 @@ func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModuleCredential: wiretype end group for non-group")
+			return fmt.Errorf("proto: SessionKeyConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModuleCredential: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SessionKeyConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubKey == nil {
+				m.PubKey = &any.Any{}
+			}
+			if err := m.PubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgTypeUrls", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -999,13 +2381,13 @@ func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			m.AllowedMsgTypeUrls = append(m.AllowedMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DerivationKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1015,23 +2397,24 @@ func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DerivationKeys = append(m.DerivationKeys, make([]byte, postIndex-iNdEx))
-			copy(m.DerivationKeys[len(m.DerivationKeys)-1], dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1054,7 +2437,7 @@ func (m *ModuleCredential) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *UnorderedNonce) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1077,17 +2460,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnorderedNonce: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnorderedNonce: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoCharacters", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			m.MaxMemoCharacters = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1097,35 +2480,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxMemoCharacters |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxSigLimit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
 			}
-			m.TxSigLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxSigLimit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxSizeCostPerByte", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TxSizeCostPerByte = 0
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1135,49 +2512,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxSizeCostPerByte |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostED25519", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
 			}
-			m.SigVerifyCostED25519 = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SigVerifyCostED25519 |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256k1", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SigVerifyCostSecp256k1 = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SigVerifyCostSecp256k1 |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
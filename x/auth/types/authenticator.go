@@ -0,0 +1,234 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+const (
+	// MaxAccountAuthenticators is the maximum number of authenticators an
+	// account can opt into.
+	MaxAccountAuthenticators = 8
+
+	// SignatureAuthenticatorType is the type of the SignatureAuthenticator.
+	SignatureAuthenticatorType = "signature"
+	// SessionKeyAuthenticatorType is the type of the SessionKeyAuthenticator.
+	SessionKeyAuthenticatorType = "session_key"
+)
+
+// Authenticator authenticates the signatures of the accounts which opted into
+// it, in place of the verification of their signatures against the public key
+// of the account. Authenticators are registered in the AccountKeeper under
+// their type, and accounts opt into them with MsgAddAuthenticator.
+type Authenticator interface {
+	// Type returns the type of the authenticator.
+	Type() string
+
+	// ValidateConfig validates the configuration of the authenticator for an
+	// account. It is called when an account opts into the authenticator.
+	ValidateConfig(config []byte) error
+
+	// Authenticate returns an error if the signature of the request does not
+	// authenticate its signer. It can consume gas and write state, the writes
+	// being discarded when it returns an error.
+	Authenticate(ctx context.Context, req AuthenticationRequest) error
+}
+
+// ExpiringAuthenticator is implemented by the authenticators which stop
+// authenticating an account after some time, such as session keys. An account
+// having authenticators must keep at least one which does not expire, so that
+// it cannot be locked out.
+type ExpiringAuthenticator interface {
+	Authenticator
+
+	// Expires returns whether the authenticator configured with config stops
+	// authenticating the account at some point.
+	Expires(config []byte) bool
+}
+
+// AuthenticationRequest is a request to authenticate the signature of a signer
+// of a tx.
+type AuthenticationRequest struct {
+	// Account is the account of the signer.
+	Account sdk.AccountI
+	// Config is the configuration of the authenticator for the account.
+	Config []byte
+	// Tx is the tx being authenticated.
+	Tx sdk.Tx
+	// Signature is the signature of the signer.
+	Signature signing.SignatureV2
+	// Simulate is true when the tx is simulated, in which case it carries no
+	// valid signatures.
+	Simulate bool
+	// VerifySignature verifies that the signature was made by pubKey over the
+	// sign bytes of the tx. It does not verify anything when signatures are not
+	// verified, e.g. on ReCheckTx or when simulating.
+	VerifySignature func(pubKey cryptotypes.PubKey) error
+}
+
+// SignatureAuthenticator authenticates the signatures made with the public key
+// of the account, as for the accounts without authenticators. It lets an
+// account keep using its key alongside its other authenticators.
+type SignatureAuthenticator struct{}
+
+var _ Authenticator = SignatureAuthenticator{}
+
+// Type implements Authenticator.
+func (SignatureAuthenticator) Type() string {
+	return SignatureAuthenticatorType
+}
+
+// ValidateConfig implements Authenticator. The authenticator takes no
+// configuration.
+func (SignatureAuthenticator) ValidateConfig(config []byte) error {
+	if len(config) != 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "signature authenticator takes no config")
+	}
+
+	return nil
+}
+
+// Authenticate implements Authenticator.
+func (SignatureAuthenticator) Authenticate(_ context.Context, req AuthenticationRequest) error {
+	pubKey := req.Account.GetPubKey()
+	if pubKey == nil {
+		if req.Simulate {
+			return nil
+		}
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
+	}
+
+	return req.VerifySignature(pubKey)
+}
+
+// SessionKeyAuthenticator authenticates the txs signed by a session key, until
+// the expiry of the key and provided all their messages are allowed by it. Its
+// configuration is a protobuf encoded SessionKeyConfig.
+type SessionKeyAuthenticator struct {
+	cdc codec.BinaryCodec
+}
+
+var _ ExpiringAuthenticator = SessionKeyAuthenticator{}
+
+// NewSessionKeyAuthenticator returns a SessionKeyAuthenticator decoding its
+// configurations with cdc.
+func NewSessionKeyAuthenticator(cdc codec.BinaryCodec) SessionKeyAuthenticator {
+	return SessionKeyAuthenticator{cdc: cdc}
+}
+
+// Type implements Authenticator.
+func (SessionKeyAuthenticator) Type() string {
+	return SessionKeyAuthenticatorType
+}
+
+// ValidateConfig implements Authenticator.
+func (a SessionKeyAuthenticator) ValidateConfig(config []byte) error {
+	sessionKey, _, err := a.decodeConfig(config)
+	if err != nil {
+		return err
+	}
+
+	if len(sessionKey.AllowedMsgTypeUrls) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "session key must allow at least one message type")
+	}
+	for _, typeURL := range sessionKey.AllowedMsgTypeUrls {
+		if typeURL == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "session key allowed message type URL cannot be empty")
+		}
+	}
+	if sessionKey.Expiry.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "session key expiry must be set")
+	}
+
+	return nil
+}
+
+// Expires implements ExpiringAuthenticator. Session keys always expire.
+func (SessionKeyAuthenticator) Expires([]byte) bool {
+	return true
+}
+
+// Authenticate implements Authenticator.
+func (a SessionKeyAuthenticator) Authenticate(ctx context.Context, req AuthenticationRequest) error {
+	sessionKey, pubKey, err := a.decodeConfig(req.Config)
+	if err != nil {
+		return err
+	}
+
+	if !sdk.UnwrapSDKContext(ctx).BlockTime().Before(sessionKey.Expiry) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "session key expired at %s", sessionKey.Expiry)
+	}
+
+	for _, msg := range req.Tx.GetMsgs() {
+		if typeURL := sdk.MsgTypeURL(msg); !slices.Contains(sessionKey.AllowedMsgTypeUrls, typeURL) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "message %s is not allowed by the session key", typeURL)
+		}
+	}
+
+	return req.VerifySignature(pubKey)
+}
+
+func (a SessionKeyAuthenticator) decodeConfig(config []byte) (SessionKeyConfig, cryptotypes.PubKey, error) {
+	var sessionKey SessionKeyConfig
+	if err := a.cdc.Unmarshal(config, &sessionKey); err != nil {
+		return SessionKeyConfig{}, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid session key config: %s", err)
+	}
+
+	if sessionKey.PubKey == nil {
+		return SessionKeyConfig{}, nil, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "session key public key cannot be empty")
+	}
+	pubKey, ok := sessionKey.PubKey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return SessionKeyConfig{}, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "expecting cryptotypes.PubKey, got %T", sessionKey.PubKey.GetCachedValue())
+	}
+
+	return sessionKey, pubKey, nil
+}
+
+// NewSessionKeyConfig returns the configuration of a session key authenticator
+// allowing pubKey to sign the messages of allowedMsgTypeURLs until expiry.
+func NewSessionKeyConfig(pubKey cryptotypes.PubKey, allowedMsgTypeURLs []string, expiry time.Time) (*SessionKeyConfig, error) {
+	anyPubKey, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SessionKeyConfig{
+		PubKey:             anyPubKey,
+		AllowedMsgTypeUrls: allowedMsgTypeURLs,
+		Expiry:             expiry,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (c *SessionKeyConfig) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if c.PubKey == nil {
+		return nil
+	}
+
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(c.PubKey, &pubKey)
+}
+
+// Validate performs a basic validation of the account authenticator.
+func (a AccountAuthenticator) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+		return fmt.Errorf("invalid authenticator address %s: %w", a.Address, err)
+	}
+	if a.AuthenticatorType == "" {
+		return fmt.Errorf("authenticator %d of %s has no type", a.Id, a.Address)
+	}
+
+	return nil
+}
@@ -18,9 +18,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&BaseAccount{}, "cosmos-sdk/BaseAccount", nil)
 	cdc.RegisterConcrete(&ModuleAccount{}, "cosmos-sdk/ModuleAccount", nil)
 	cdc.RegisterConcrete(Params{}, "cosmos-sdk/x/auth/Params", nil)
+	cdc.RegisterConcrete(FeeMarketParams{}, "cosmos-sdk/x/auth/FeeMarketParams", nil)
 	cdc.RegisterConcrete(&ModuleCredential{}, "cosmos-sdk/GroupAccountCredential", nil)
 
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/auth/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateFeeMarketParams{}, "cosmos-sdk/x/auth/MsgUpdateFeeMarket")
+	legacy.RegisterAminoMsg(cdc, &MsgRotatePubKey{}, "cosmos-sdk/x/auth/MsgRotatePubKey")
+	legacy.RegisterAminoMsg(cdc, &MsgAddAuthenticator{}, "cosmos-sdk/MsgAddAuthenticator")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveAuthenticator{}, "cosmos-sdk/MsgRemoveAuthenticator")
 
 	legacytx.RegisterLegacyAminoCodec(cdc)
 }
@@ -57,5 +62,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgUpdateFeeMarketParams{},
+		&MsgRotatePubKey{},
+		&MsgAddAuthenticator{},
+		&MsgRemoveAuthenticator{},
 	)
 }
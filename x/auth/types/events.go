@@ -0,0 +1,19 @@
+package types
+
+// auth module events
+const (
+	EventTypeFeeMarket    = "fee_market"
+	EventTypeRotatePubKey = "rotate_pub_key"
+
+	EventTypeAddAuthenticator    = "add_authenticator"
+	EventTypeRemoveAuthenticator = "remove_authenticator"
+
+	AttributeKeyBaseGasPrice = "base_gas_price"
+	AttributeKeyBlockGasUsed = "block_gas_used"
+	AttributeKeyAddress      = "address"
+	AttributeKeyOldPubKey    = "old_pub_key"
+	AttributeKeyNewPubKey    = "new_pub_key"
+
+	AttributeKeyAuthenticatorID   = "authenticator_id"
+	AttributeKeyAuthenticatorType = "authenticator_type"
+)
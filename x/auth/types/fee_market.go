@@ -0,0 +1,113 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Default fee market parameter values
+var (
+	DefaultMinBaseGasPrice                = math.LegacyZeroDec()
+	DefaultMaxBaseGasPrice                = math.LegacyZeroDec()
+	DefaultTargetBlockGas          uint64 = 50_000_000
+	DefaultBaseGasPriceChangeDenom uint64 = 8
+)
+
+// NewFeeMarketParams creates a new FeeMarketParams object
+func NewFeeMarketParams(
+	enabled bool, feeDenom string, minBaseGasPrice, maxBaseGasPrice math.LegacyDec, targetBlockGas, changeDenominator uint64,
+) FeeMarketParams {
+	return FeeMarketParams{
+		Enabled:                       enabled,
+		FeeDenom:                      feeDenom,
+		MinBaseGasPrice:               minBaseGasPrice,
+		MaxBaseGasPrice:               maxBaseGasPrice,
+		TargetBlockGas:                targetBlockGas,
+		BaseGasPriceChangeDenominator: changeDenominator,
+	}
+}
+
+// DefaultFeeMarketParams returns a default set of fee market parameters. The
+// fee market is disabled by default.
+func DefaultFeeMarketParams() FeeMarketParams {
+	return FeeMarketParams{
+		Enabled:                       false,
+		FeeDenom:                      sdk.DefaultBondDenom,
+		MinBaseGasPrice:               DefaultMinBaseGasPrice,
+		MaxBaseGasPrice:               DefaultMaxBaseGasPrice,
+		TargetBlockGas:                DefaultTargetBlockGas,
+		BaseGasPriceChangeDenominator: DefaultBaseGasPriceChangeDenom,
+	}
+}
+
+// Validate checks that the fee market parameters have valid values.
+func (p FeeMarketParams) Validate() error {
+	if !p.Enabled {
+		return nil
+	}
+
+	if err := sdk.ValidateDenom(p.FeeDenom); err != nil {
+		return fmt.Errorf("invalid fee market denom: %w", err)
+	}
+
+	// a zero base gas price can never be increased by the multiplicative
+	// update rule, hence the lower bound must be positive.
+	if p.MinBaseGasPrice.IsNil() || !p.MinBaseGasPrice.IsPositive() {
+		return fmt.Errorf("min base gas price must be positive: %s", p.MinBaseGasPrice)
+	}
+
+	if p.MaxBaseGasPrice.IsNil() || p.MaxBaseGasPrice.IsNegative() {
+		return fmt.Errorf("max base gas price must be non-negative: %s", p.MaxBaseGasPrice)
+	}
+
+	if p.MaxBaseGasPrice.IsPositive() && p.MaxBaseGasPrice.LT(p.MinBaseGasPrice) {
+		return fmt.Errorf("max base gas price %s must not be lower than min base gas price %s", p.MaxBaseGasPrice, p.MinBaseGasPrice)
+	}
+
+	if p.TargetBlockGas == 0 {
+		return errors.New("target block gas must be positive")
+	}
+
+	if p.BaseGasPriceChangeDenominator == 0 {
+		return errors.New("base gas price change denominator must be positive")
+	}
+
+	return nil
+}
+
+// ClampBaseGasPrice bounds the provided base gas price by the minimum and, if
+// set, the maximum base gas price.
+func (p FeeMarketParams) ClampBaseGasPrice(price math.LegacyDec) math.LegacyDec {
+	if price.IsNil() || price.LT(p.MinBaseGasPrice) {
+		return p.MinBaseGasPrice
+	}
+
+	if p.MaxBaseGasPrice.IsPositive() && price.GT(p.MaxBaseGasPrice) {
+		return p.MaxBaseGasPrice
+	}
+
+	return price
+}
+
+// NextBaseGasPrice returns the base gas price of the next block given the
+// current base gas price and the amount of gas consumed by the current block,
+// following the EIP-1559 update rule:
+//
+//	next = current * (1 + (gasUsed - target) / target / changeDenominator)
+func (p FeeMarketParams) NextBaseGasPrice(current math.LegacyDec, blockGasUsed uint64) math.LegacyDec {
+	current = p.ClampBaseGasPrice(current)
+	if blockGasUsed == p.TargetBlockGas {
+		return current
+	}
+
+	target := math.LegacyNewDecFromInt(math.NewIntFromUint64(p.TargetBlockGas))
+	gasUsed := math.LegacyNewDecFromInt(math.NewIntFromUint64(blockGasUsed))
+	denominator := math.LegacyNewDecFromInt(math.NewIntFromUint64(p.BaseGasPriceChangeDenominator))
+
+	delta := current.Mul(gasUsed.Sub(target)).Quo(target).Quo(denominator)
+	return p.ClampBaseGasPrice(current.Add(delta))
+}
@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestFeeMarketParams_Validate(t *testing.T) {
+	minPrice, maxPrice := math.LegacyNewDecWithPrec(1, 3), math.LegacyNewDec(10)
+
+	tests := []struct {
+		name      string
+		params    types.FeeMarketParams
+		expErrMsg string
+	}{
+		{"default params", types.DefaultFeeMarketParams(), ""},
+		{"enabled params", types.NewFeeMarketParams(true, "stake", minPrice, maxPrice, 1000, 8), ""},
+		{"enabled params without max", types.NewFeeMarketParams(true, "stake", minPrice, math.LegacyZeroDec(), 1000, 8), ""},
+		{"invalid denom", types.NewFeeMarketParams(true, "1", minPrice, maxPrice, 1000, 8), "invalid fee market denom"},
+		{"zero min base gas price", types.NewFeeMarketParams(true, "stake", math.LegacyZeroDec(), maxPrice, 1000, 8), "min base gas price must be positive"},
+		{"negative max base gas price", types.NewFeeMarketParams(true, "stake", minPrice, math.LegacyNewDec(-1), 1000, 8), "max base gas price must be non-negative"},
+		{"max lower than min", types.NewFeeMarketParams(true, "stake", maxPrice, minPrice, 1000, 8), "must not be lower than min base gas price"},
+		{"zero target block gas", types.NewFeeMarketParams(true, "stake", minPrice, maxPrice, 0, 8), "target block gas must be positive"},
+		{"zero change denominator", types.NewFeeMarketParams(true, "stake", minPrice, maxPrice, 1000, 0), "base gas price change denominator must be positive"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.expErrMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, tt.expErrMsg)
+		})
+	}
+}
+
+func TestFeeMarketParams_NextBaseGasPrice(t *testing.T) {
+	params := types.NewFeeMarketParams(true, "stake", math.LegacyNewDecWithPrec(1, 2), math.LegacyNewDec(2), 1000, 8)
+	current := math.LegacyNewDec(1)
+
+	tests := []struct {
+		name     string
+		current  math.LegacyDec
+		gasUsed  uint64
+		expected math.LegacyDec
+	}{
+		{"at target", current, 1000, current},
+		{"full block", current, 2000, math.LegacyNewDecWithPrec(1125, 3)},
+		{"empty block", current, 0, math.LegacyNewDecWithPrec(875, 3)},
+		{"half full block", current, 500, math.LegacyNewDecWithPrec(9375, 4)},
+		{"clamped to max", math.LegacyNewDec(2), 10_000, math.LegacyNewDec(2)},
+		{"clamped to min", math.LegacyNewDecWithPrec(1, 2), 0, math.LegacyNewDecWithPrec(1, 2)},
+		{"unset current price", math.LegacyDec{}, 1000, math.LegacyNewDecWithPrec(1, 2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.True(t, tt.expected.Equal(params.NextBaseGasPrice(tt.current, tt.gasUsed)))
+		})
+	}
+}
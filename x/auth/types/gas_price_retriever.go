@@ -0,0 +1,30 @@
+package types
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ client.GasPriceRetriever = BaseGasPriceRetriever{}
+
+// BaseGasPriceRetriever retrieves the current fee market base gas price so
+// that transactions can be built paying at least the base gas price.
+type BaseGasPriceRetriever struct{}
+
+// GetGasPrices queries for the current base gas price. An empty set of gas
+// prices is returned when the fee market is disabled.
+func (BaseGasPriceRetriever) GetGasPrices(clientCtx client.Context) (sdk.DecCoins, error) {
+	queryClient := NewQueryClient(clientCtx)
+	res, err := queryClient.BaseGasPrice(context.Background(), &QueryBaseGasPriceRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.BaseGasPrice.Amount.IsNil() || !res.BaseGasPrice.IsPositive() {
+		return sdk.DecCoins{}, nil
+	}
+
+	return sdk.NewDecCoins(res.BaseGasPrice), nil
+}
@@ -45,7 +45,10 @@ func (g GenesisState) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), GenesisAccounts{})
+	genState := NewGenesisState(DefaultParams(), GenesisAccounts{})
+	genState.FeeMarketParams = DefaultFeeMarketParams()
+	genState.BaseGasPrice = genState.FeeMarketParams.MinBaseGasPrice
+	return genState
 }
 
 // GetGenesisStateFromAppState returns x/auth GenesisState given raw application
@@ -67,12 +70,54 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if err := data.FeeMarketParams.Validate(); err != nil {
+		return err
+	}
+
+	if !data.BaseGasPrice.IsNil() && data.BaseGasPrice.IsNegative() {
+		return fmt.Errorf("base gas price must be non-negative: %s", data.BaseGasPrice)
+	}
+
+	rotated := make(map[string]bool, len(data.PubKeyRotations))
+	for _, rotation := range data.PubKeyRotations {
+		if _, err := sdk.AccAddressFromBech32(rotation.Address); err != nil {
+			return fmt.Errorf("invalid pub key rotation address %s: %w", rotation.Address, err)
+		}
+		if rotated[rotation.Address] {
+			return fmt.Errorf("duplicate pub key rotation found in genesis state; address: %s", rotation.Address)
+		}
+		rotated[rotation.Address] = true
+	}
+
+	authenticatorIDs := make(map[uint64]bool, len(data.Authenticators))
+	for _, authenticator := range data.Authenticators {
+		if err := authenticator.Validate(); err != nil {
+			return err
+		}
+		if authenticatorIDs[authenticator.Id] {
+			return fmt.Errorf("duplicate authenticator id found in genesis state; id: %d", authenticator.Id)
+		}
+		authenticatorIDs[authenticator.Id] = true
+	}
+
+	nonces := make(map[string]bool, len(data.UnorderedNonces))
+	for _, nonce := range data.UnorderedNonces {
+		if _, err := sdk.AccAddressFromBech32(nonce.Sender); err != nil {
+			return fmt.Errorf("invalid unordered nonce sender %s: %w", nonce.Sender, err)
+		}
+		key := fmt.Sprintf("%s/%d", nonce.Sender, nonce.Timeout.UnixNano())
+		if nonces[key] {
+			return fmt.Errorf("duplicate unordered nonce found in genesis state; sender: %s, timeout: %s", nonce.Sender, nonce.Timeout)
+		}
+		nonces[key] = true
+	}
+
 	genAccs, err := UnpackAccounts(data.Accounts)
 	if err != nil {
 		return err
 	}
 
-	return ValidateGenAccounts(genAccs)
+	return validateGenAccounts(genAccs, rotated)
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
@@ -122,6 +167,13 @@ func SanitizeGenesisAccounts(genAccs GenesisAccounts) GenesisAccounts {
 
 // ValidateGenAccounts validates an array of GenesisAccounts and checks for duplicates
 func ValidateGenAccounts(accounts GenesisAccounts) error {
+	return validateGenAccounts(accounts, nil)
+}
+
+// validateGenAccounts validates an array of GenesisAccounts and checks for
+// duplicates, the public key of the rotated accounts not having to match their
+// address.
+func validateGenAccounts(accounts GenesisAccounts, rotated map[string]bool) error {
 	addrMap := make(map[string]bool, len(accounts))
 
 	for _, acc := range accounts {
@@ -133,6 +185,14 @@ func ValidateGenAccounts(accounts GenesisAccounts) error {
 
 		addrMap[addrStr] = true
 
+		// the public key of a rotated account does not match its address
+		if rotated[addrStr] {
+			acc = proto.Clone(acc).(GenesisAccount)
+			if err := acc.SetPubKey(nil); err != nil {
+				return err
+			}
+		}
+
 		// check account specific validation
 		if err := acc.Validate(); err != nil {
 			return fmt.Errorf("invalid account found in genesis state; address: %s, error: %s", addrStr, err.Error())
@@ -4,20 +4,26 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	any "github.com/cosmos/gogoproto/types/any"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,6 +37,17 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*any.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// fee_market_params defines the parameters of the fee market.
+	FeeMarketParams FeeMarketParams `protobuf:"bytes,3,opt,name=fee_market_params,json=feeMarketParams,proto3" json:"fee_market_params"`
+	// base_gas_price is the current base gas price of the fee market.
+	BaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=base_gas_price,json=baseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_gas_price"`
+	// pub_key_rotations are the last rotations of the public key of accounts.
+	PubKeyRotations []PubKeyRotation `protobuf:"bytes,5,rep,name=pub_key_rotations,json=pubKeyRotations,proto3" json:"pub_key_rotations"`
+	// authenticators are the authenticators accounts opted into.
+	Authenticators []AccountAuthenticator `protobuf:"bytes,6,rep,name=authenticators,proto3" json:"authenticators"`
+	// unordered_nonces are the nonces of the unordered transactions which did
+	// not expire yet.
+	UnorderedNonces []UnorderedNonce `protobuf:"bytes,7,rep,name=unordered_nonces,json=unorderedNonces,proto3" json:"unordered_nonces"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -80,31 +97,136 @@ func (m *GenesisState) GetAccounts() []*any.Any {
 	return nil
 }
 
+func (m *GenesisState) GetFeeMarketParams() FeeMarketParams {
+	if m != nil {
+		return m.FeeMarketParams
+	}
+	return FeeMarketParams{}
+}
+
+func (m *GenesisState) GetPubKeyRotations() []PubKeyRotation {
+	if m != nil {
+		return m.PubKeyRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuthenticators() []AccountAuthenticator {
+	if m != nil {
+		return m.Authenticators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnorderedNonces() []UnorderedNonce {
+	if m != nil {
+		return m.UnorderedNonces
+	}
+	return nil
+}
+
+// PubKeyRotation is the last rotation of the public key of an account, whose
+// public key does not match its address anymore.
+type PubKeyRotation struct {
+	// address is the address of the account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// time is the block time of the rotation.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *PubKeyRotation) Reset()         { *m = PubKeyRotation{} }
+func (m *PubKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*PubKeyRotation) ProtoMessage()    {}
+func (*PubKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d897ccbce9822332, []int{1}
+}
+func (m *PubKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PubKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PubKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PubKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubKeyRotation.Merge(m, src)
+}
+func (m *PubKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PubKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubKeyRotation proto.InternalMessageInfo
+
+func (m *PubKeyRotation) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PubKeyRotation) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
+	proto.RegisterType((*PubKeyRotation)(nil), "cosmos.auth.v1beta1.PubKeyRotation")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x42, 0xe4, 0x05,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x48, 0xa9, 0x81, 0x91, 0x8b, 0xc7, 0x1d,
-	0xe2, 0x94, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x3b, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc,
-	0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0x4e, 0xd3, 0x0b, 0x00, 0x2b,
-	0x71, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x42,
-	0x06, 0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a,
-	0xdc, 0x46, 0x22, 0x7a, 0x10, 0x7f, 0xe8, 0xc1, 0xfc, 0xa1, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57,
-	0xe5, 0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99,
-	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0xaf, 0x41, 0x28, 0xdd, 0xe2, 0x94,
-	0x6c, 0xfd, 0x0a, 0x88, 0x3f, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x86, 0x1b, 0x03,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x70, 0x8d, 0xea, 0x6c, 0x01, 0x00, 0x00,
+	// 579 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x14, 0x85, 0xe3, 0xfe, 0xd2, 0x69, 0x69, 0xa9, 0xdb, 0x85, 0xdb, 0x22, 0x27, 0x14, 0x16, 0x2d,
+	0x52, 0xc6, 0x6d, 0x81, 0x0d, 0x12, 0x48, 0x09, 0x15, 0x5d, 0xf0, 0xa3, 0x2a, 0x85, 0x0d, 0x1b,
+	0x6b, 0x6c, 0xdf, 0x38, 0x26, 0xf5, 0x8c, 0xe5, 0x19, 0x23, 0xfc, 0x16, 0x5d, 0xf2, 0x08, 0x88,
+	0x15, 0x8b, 0x3c, 0x44, 0x97, 0x55, 0x56, 0x88, 0x45, 0x41, 0xc9, 0x82, 0xd7, 0x40, 0x9e, 0x99,
+	0x00, 0x69, 0x2d, 0xb2, 0x89, 0x1c, 0xcf, 0x77, 0xef, 0x39, 0xe7, 0x7a, 0x2e, 0xba, 0xe3, 0x33,
+	0x1e, 0x33, 0xee, 0x90, 0x4c, 0x74, 0x9c, 0x0f, 0xfb, 0x1e, 0x08, 0xb2, 0xef, 0x84, 0x40, 0x81,
+	0x47, 0x1c, 0x27, 0x29, 0x13, 0xcc, 0x5c, 0x53, 0x08, 0x2e, 0x10, 0xac, 0x91, 0xcd, 0x8d, 0x90,
+	0xb1, 0xf0, 0x14, 0x1c, 0x89, 0x78, 0x59, 0xdb, 0x21, 0x34, 0x57, 0xfc, 0x66, 0xf5, 0xea, 0x91,
+	0x88, 0x62, 0xe0, 0x82, 0xc4, 0x89, 0x06, 0xd6, 0x43, 0x16, 0x32, 0xf9, 0xe8, 0x14, 0x4f, 0xfa,
+	0xad, 0x5d, 0xe6, 0x44, 0x6a, 0xaa, 0xf3, 0x55, 0x12, 0x47, 0x94, 0x39, 0xf2, 0x57, 0xbf, 0xda,
+	0x50, 0x25, 0xae, 0xea, 0xa5, 0x6d, 0xca, 0x3f, 0xdb, 0x5f, 0x66, 0xd1, 0xd2, 0x91, 0x8a, 0x71,
+	0x22, 0x88, 0x00, 0xf3, 0x29, 0x9a, 0x4b, 0x48, 0x4a, 0x62, 0x6e, 0x19, 0x35, 0x63, 0x67, 0xf1,
+	0x60, 0x0b, 0x97, 0xc4, 0xc2, 0xc7, 0x12, 0x69, 0x2e, 0x9c, 0x5f, 0x56, 0x2b, 0x9f, 0x7f, 0x7d,
+	0xbd, 0x6f, 0xb4, 0x74, 0x95, 0xb9, 0x87, 0x6e, 0x10, 0xdf, 0x67, 0x19, 0x15, 0xdc, 0x9a, 0xaa,
+	0x4d, 0xef, 0x2c, 0x1e, 0xac, 0x63, 0x15, 0x14, 0x8f, 0x82, 0xe2, 0x06, 0xcd, 0x5b, 0x7f, 0x28,
+	0x93, 0xa2, 0xd5, 0x36, 0x80, 0x1b, 0x93, 0xb4, 0x0b, 0xc2, 0xd5, 0xe2, 0xd3, 0x52, 0xfc, 0x5e,
+	0xa9, 0xf8, 0x73, 0x80, 0x57, 0x12, 0xd6, 0x2e, 0x6e, 0x17, 0x2e, 0xbe, 0xf7, 0xea, 0x2b, 0x0a,
+	0xae, 0xf3, 0xa0, 0x5b, 0xdb, 0xc3, 0x8f, 0x1e, 0x2a, 0x63, 0x2b, 0xed, 0x71, 0xdc, 0x7c, 0x8f,
+	0x96, 0x3d, 0xc2, 0xc1, 0x0d, 0x49, 0x31, 0x91, 0xc8, 0x07, 0x6b, 0xa6, 0x66, 0xec, 0x2c, 0x34,
+	0x0f, 0x65, 0x9b, 0xcb, 0xea, 0x96, 0x6a, 0xc3, 0x83, 0x2e, 0x8e, 0x98, 0x13, 0x13, 0xd1, 0xc1,
+	0x2f, 0x21, 0x24, 0x7e, 0x7e, 0x08, 0x7e, 0xbf, 0x57, 0x47, 0xda, 0xd2, 0x21, 0xf8, 0x25, 0x9a,
+	0xad, 0xa5, 0xa2, 0xf7, 0x11, 0xe1, 0xc7, 0x45, 0x67, 0x33, 0x46, 0xab, 0x49, 0xe6, 0xb9, 0x5d,
+	0xc8, 0xdd, 0x94, 0x09, 0x22, 0x22, 0x46, 0xb9, 0x35, 0x2b, 0xc7, 0x72, 0xb7, 0x7c, 0xb0, 0x99,
+	0xf7, 0x02, 0xf2, 0x96, 0x66, 0x27, 0x45, 0x4b, 0xc6, 0x68, 0x6e, 0x32, 0xb4, 0x5c, 0x74, 0x03,
+	0x2a, 0x22, 0x9f, 0x08, 0x96, 0x72, 0x6b, 0x4e, 0x6a, 0xed, 0x96, 0x6a, 0x35, 0xd4, 0x17, 0x68,
+	0xfc, 0x5b, 0x31, 0x41, 0xf1, 0x4a, 0x7b, 0xf3, 0x14, 0xdd, 0xca, 0x28, 0x4b, 0x03, 0x48, 0x21,
+	0x70, 0x29, 0xa3, 0x3e, 0x70, 0x6b, 0xfe, 0x3f, 0xf1, 0xde, 0x8e, 0xe0, 0xd7, 0x05, 0x3b, 0x29,
+	0x5e, 0x36, 0x46, 0xf3, 0xed, 0x4f, 0x06, 0x5a, 0x1e, 0x1f, 0x90, 0x79, 0x80, 0xe6, 0x49, 0x10,
+	0xa4, 0xc0, 0xd5, 0x7d, 0x5d, 0x68, 0x5a, 0xfd, 0x5e, 0x7d, 0x5d, 0x4b, 0x37, 0xd4, 0xc9, 0x89,
+	0x48, 0x23, 0x1a, 0xb6, 0x46, 0xa0, 0xf9, 0x04, 0xcd, 0x14, 0xab, 0x66, 0x4d, 0xc9, 0x3b, 0xb6,
+	0x79, 0xed, 0x7a, 0xbe, 0x19, 0xed, 0x61, 0xf3, 0x66, 0xe1, 0xef, 0xec, 0x47, 0xd5, 0x50, 0x86,
+	0x64, 0xd9, 0xe3, 0xb5, 0xfe, 0x75, 0xc3, 0xcd, 0x67, 0xe7, 0x03, 0xdb, 0xb8, 0x18, 0xd8, 0xc6,
+	0xcf, 0x81, 0x6d, 0x9c, 0x0d, 0xed, 0xca, 0xc5, 0xd0, 0xae, 0x7c, 0x1b, 0xda, 0x95, 0x77, 0xbb,
+	0x61, 0x24, 0x3a, 0x99, 0x87, 0x7d, 0x16, 0xeb, 0xd5, 0x73, 0xfe, 0x16, 0x3b, 0x1f, 0xd5, 0x1e,
+	0x8b, 0x3c, 0x01, 0xee, 0xcd, 0x49, 0x0b, 0x0f, 0x7e, 0x0f, 0x00, 0x96, 0x53, 0x89, 0x14, 0x6d,
+	0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +249,68 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UnorderedNonces) > 0 {
+		for iNdEx := len(m.UnorderedNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnorderedNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Authenticators) > 0 {
+		for iNdEx := len(m.Authenticators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authenticators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PubKeyRotations) > 0 {
+		for iNdEx := len(m.PubKeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PubKeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.BaseGasPrice.Size()
+		i -= size
+		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.FeeMarketParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -154,6 +338,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PubKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PubKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -179,6 +401,43 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.FeeMarketParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BaseGasPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PubKeyRotations) > 0 {
+		for _, e := range m.PubKeyRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Authenticators) > 0 {
+		for _, e := range m.Authenticators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnorderedNonces) > 0 {
+		for _, e := range m.UnorderedNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PubKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -284,6 +543,290 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMarketParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeMarketParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKeyRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKeyRotations = append(m.PubKeyRotations, PubKeyRotation{})
+			if err := m.PubKeyRotations[len(m.PubKeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authenticators = append(m.Authenticators, AccountAuthenticator{})
+			if err := m.Authenticators[len(m.Authenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnorderedNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnorderedNonces = append(m.UnorderedNonces, UnorderedNonce{})
+			if err := m.UnorderedNonces[len(m.UnorderedNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubKeyRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubKeyRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	proto "github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
@@ -52,6 +53,29 @@ func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 	require.Error(t, types.ValidateGenAccounts(genAccs))
 }
 
+func TestValidateGenesisRotatedPubKey(t *testing.T) {
+	acc := types.NewBaseAccountWithAddress(sdk.AccAddress(addr1))
+	require.NoError(t, acc.SetPubKey(pk2))
+
+	genAccs, err := types.PackAccounts(types.GenesisAccounts{acc})
+	require.NoError(t, err)
+	genState := *types.DefaultGenesisState()
+	genState.Accounts = genAccs
+
+	// the public key of an account must match its address, unless it was rotated
+	require.Error(t, types.ValidateGenesis(genState))
+
+	rotation := types.PubKeyRotation{Address: sdk.AccAddress(addr1).String(), Time: time.Unix(1000, 0).UTC()}
+	genState.PubKeyRotations = []types.PubKeyRotation{rotation}
+	require.NoError(t, types.ValidateGenesis(genState))
+
+	genState.PubKeyRotations = []types.PubKeyRotation{rotation, rotation}
+	require.ErrorContains(t, types.ValidateGenesis(genState), "duplicate")
+
+	genState.PubKeyRotations = []types.PubKeyRotation{{Address: "foo"}}
+	require.Error(t, types.ValidateGenesis(genState))
+}
+
 func TestGenesisAccountIterator(t *testing.T) {
 	encodingConfig := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{})
 	cdc := encodingConfig.Codec
@@ -25,12 +25,40 @@ var (
 	// account number is stored.
 	GlobalAccountNumberKey = collections.NewPrefix(2)
 
+	// FeeMarketParamsKey is the prefix for the fee market params key
+	FeeMarketParamsKey = collections.NewPrefix(3)
+
+	// BaseGasPriceKey is the prefix for the fee market base gas price key
+	BaseGasPriceKey = collections.NewPrefix(4)
+
+	// PubKeyRotationsKey is the prefix for the last public key rotation time
+	// of accounts
+	PubKeyRotationsKey = collections.NewPrefix(5)
+
+	// AccountAuthenticatorsKey is the prefix for the authenticators of accounts
+	AccountAuthenticatorsKey = collections.NewPrefix(6)
+
+	// NextAuthenticatorIDKey is the prefix for the sequence of authenticator
+	// identifiers
+	NextAuthenticatorIDKey = collections.NewPrefix(7)
+
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
 
 	// UnorderedNoncesKey prefix for the unordered sequence storage.
 	UnorderedNoncesKey = collections.NewPrefix(90)
 
+	// UnorderedNoncesCountKey is the prefix for the number of unordered nonces.
+	UnorderedNoncesCountKey = collections.NewPrefix(91)
+
+	// UnorderedNonceSendersKey is the prefix for the number of unordered nonces
+	// of each sender.
+	UnorderedNonceSendersKey = collections.NewPrefix(92)
+
+	// UnorderedNonceSendersCountKey is the prefix for the number of senders
+	// having unordered nonces.
+	UnorderedNonceSendersCountKey = collections.NewPrefix(93)
+
 	// LegacyGlobalAccountNumberKey is the legacy param key for global account number
 	LegacyGlobalAccountNumberKey = []byte("globalAccountNumber")
 )
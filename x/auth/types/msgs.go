@@ -0,0 +1,32 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = (*MsgRotatePubKey)(nil)
+
+// NewMsgRotatePubKey creates a message replacing the public key of the account
+// at address with newPubKey.
+func NewMsgRotatePubKey(address string, newPubKey cryptotypes.PubKey) (*MsgRotatePubKey, error) {
+	anyPubKey, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MsgRotatePubKey{
+		Address:   address,
+		NewPubKey: anyPubKey,
+	}, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg *MsgRotatePubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if msg.NewPubKey == nil {
+		return nil
+	}
+
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubKey, &pubKey)
+}
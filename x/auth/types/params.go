@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 )
 
 // Default parameter values
@@ -11,6 +12,8 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+
+	DefaultPubKeyRotationCooldown = 24 * time.Hour
 )
 
 // NewParams creates a new Params object
@@ -32,6 +35,7 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		PubKeyRotationCooldown: DefaultPubKeyRotationCooldown,
 	}
 }
 
@@ -47,6 +51,20 @@ func (p Params) SigVerifyCostSecp256r1() uint64 {
 	return p.SigVerifyCostSecp256k1 / 2
 }
 
+// SigVerifyCostWebAuthn returns gas fee of WebAuthn signature verification.
+// On top of a secp256r1 signature verification, it decodes the assertion,
+// parses the client data JSON and hashes the client data and sign bytes.
+func (p Params) SigVerifyCostWebAuthn() uint64 {
+	return p.SigVerifyCostSecp256r1() + p.SigVerifyCostSecp256k1/4
+}
+
+// SigVerifyCostBLS12381 returns gas fee of BLS12-381 signature verification,
+// including the verification of a signature aggregated with others, which
+// requires a pairing per signer.
+func (p Params) SigVerifyCostBLS12381() uint64 {
+	return p.SigVerifyCostSecp256k1 * 4
+}
+
 func validateTxSigLimit(i any) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -112,6 +130,19 @@ func validateTxSizeCostPerByte(i any) error {
 	return nil
 }
 
+func validatePubKeyRotationCooldown(i any) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("pub key rotation cooldown cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -129,6 +160,9 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := validatePubKeyRotationCooldown(p.PubKeyRotationCooldown); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -24,6 +24,9 @@ const (
 	FlagPeriodLimit = "period-limit"
 	FlagSpendLimit  = "spend-limit"
 	FlagAllowedMsgs = "allowed-messages"
+
+	FlagDailyLimit       = "daily-limit"
+	FlagAllowedContracts = "allowed-contracts"
 )
 
 // GetTxCmd returns the transaction commands for feegrant module
@@ -39,6 +42,7 @@ func GetTxCmd(ac address.Codec) *cobra.Command {
 
 	feegrantTxCmd.AddCommand(
 		NewCmdFeeGrant(ac),
+		NewCmdGrantSponsorship(ac),
 	)
 
 	return feegrantTxCmd
@@ -187,6 +191,112 @@ Examples:
 	return cmd
 }
 
+// NewCmdGrantSponsorship returns a CLI command handler to create a MsgGrantSponsorship transaction.
+func NewCmdGrantSponsorship(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-sponsorship --daily-limit [coins]",
+		Short: "Sponsor the fees of the txs of any address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(
+				`Sponsor the fees of the txs of any address containing only the allowed messages
+or only messages targeting the allowed contracts, up to a daily limit per address.
+The sponsored addresses use it by setting your address as their fee granter.
+
+Examples:
+%s tx %s grant-sponsorship --daily-limit 10stake --spend-limit 1000stake
+	--allowed-messages "/cosmos.gov.v1beta1.MsgVote" --from mykey or
+%s tx %s grant-sponsorship --daily-limit 10stake --expiration 2022-01-30T15:04:05Z
+	--allowed-contracts cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr --from mykey
+				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			granter, err := ac.BytesToString(clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			dl, err := cmd.Flags().GetString(FlagDailyLimit)
+			if err != nil {
+				return err
+			}
+
+			dailyLimit, err := sdk.ParseCoinsNormalized(dl)
+			if err != nil {
+				return err
+			}
+
+			sl, err := cmd.Flags().GetString(FlagSpendLimit)
+			if err != nil {
+				return err
+			}
+
+			// if `FlagSpendLimit` isn't set, limit will be nil.
+			// Hence, there won't be any spendlimit for the sponsorship.
+			spendLimit, err := sdk.ParseCoinsNormalized(sl)
+			if err != nil {
+				return err
+			}
+
+			allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
+			if err != nil {
+				return err
+			}
+
+			allowedContracts, err := cmd.Flags().GetStringSlice(FlagAllowedContracts)
+			if err != nil {
+				return err
+			}
+
+			allowance := feegrant.SponsorshipAllowance{
+				SpendLimit:           spendLimit,
+				DailyLimitPerGrantee: dailyLimit,
+				AllowedMessages:      allowedMsgs,
+				AllowedContracts:     allowedContracts,
+			}
+
+			exp, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			if exp != "" {
+				expiresAtTime, err := time.Parse(time.RFC3339, exp)
+				if err != nil {
+					return err
+				}
+				allowance.Expiration = &expiresAtTime
+			}
+
+			if err := allowance.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg := &feegrant.MsgGrantSponsorship{
+				Granter:   granter,
+				Allowance: allowance,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagDailyLimit, "", "The max amount of fees sponsored for each address per day")
+	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max amount of fees sponsored in total, if not mentioned there is no limit")
+	cmd.Flags().String(FlagExpiration, "", "The RFC 3339 timestamp after which the sponsorship expires")
+	cmd.Flags().StringSlice(FlagAllowedMsgs, []string{}, "Set of messages whose txs are sponsored")
+	cmd.Flags().StringSlice(FlagAllowedContracts, []string{}, "Set of contracts whose executions are sponsored")
+
+	return cmd
+}
+
 func getPeriodReset(duration int64) time.Time {
 	return time.Now().Add(getPeriod(duration))
 }
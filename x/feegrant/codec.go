@@ -13,6 +13,8 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgGrantAllowance{}, "cosmos-sdk/MsgGrantAllowance")
 	legacy.RegisterAminoMsg(cdc, &MsgRevokeAllowance{}, "cosmos-sdk/MsgRevokeAllowance")
+	legacy.RegisterAminoMsg(cdc, &MsgGrantSponsorship{}, "cosmos-sdk/MsgGrantSponsorship")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeSponsorship{}, "cosmos-sdk/MsgRevokeSponsorship")
 
 	cdc.RegisterInterface((*FeeAllowanceI)(nil), nil)
 	cdc.RegisterConcrete(&BasicAllowance{}, "cosmos-sdk/BasicAllowance", nil)
@@ -25,6 +27,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgGrantAllowance{},
 		&MsgRevokeAllowance{},
+		&MsgGrantSponsorship{},
+		&MsgRevokeSponsorship{},
 	)
 
 	registry.RegisterInterface(
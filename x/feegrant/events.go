@@ -8,6 +8,9 @@ const (
 	EventTypeUpdateFeeGrant = "update_feegrant"
 	EventTypePruneFeeGrant  = "prune_feegrant"
 
+	EventTypeSetSponsorship    = "set_sponsorship"
+	EventTypeRevokeSponsorship = "revoke_sponsorship"
+
 	AttributeKeyGranter = "granter"
 	AttributeKeyGrantee = "grantee"
 	AttributeKeyPruner  = "pruner"
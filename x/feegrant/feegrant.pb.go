@@ -283,11 +283,235 @@ func (m *Grant) GetAllowance() *any.Any {
 	return nil
 }
 
+// SponsorshipAllowance is the allowance of a sponsorship, through which a granter
+// pays the fees of the txs of any grantee setting it as fee granter, provided the
+// txs only contain allowed messages, up to a daily limit per grantee.
+type SponsorshipAllowance struct {
+	// spend_limit specifies the maximum amount of coins that can be spent by all
+	// the grantees of the sponsorship, and will be updated as coins are spent. If
+	// it is empty, there is no spend limit.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// daily_limit_per_grantee specifies the maximum amount of coins that can be
+	// spent by each grantee in a day.
+	DailyLimitPerGrantee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=daily_limit_per_grantee,json=dailyLimitPerGrantee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"daily_limit_per_grantee"`
+	// expiration specifies an optional time when this sponsorship expires.
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// allowed_messages are the type URLs of the messages the txs of the grantees
+	// can contain. If it is empty, the messages of any type can be sponsored,
+	// provided they target one of the allowed contracts.
+	AllowedMessages []string `protobuf:"bytes,4,rep,name=allowed_messages,json=allowedMessages,proto3" json:"allowed_messages,omitempty"`
+	// allowed_contracts are the addresses of the contracts the messages of the
+	// txs of the grantees can target, through their `contract` field. If it is
+	// set, the messages without a `contract` field cannot be sponsored.
+	AllowedContracts []string `protobuf:"bytes,5,rep,name=allowed_contracts,json=allowedContracts,proto3" json:"allowed_contracts,omitempty"`
+}
+
+func (m *SponsorshipAllowance) Reset()         { *m = SponsorshipAllowance{} }
+func (m *SponsorshipAllowance) String() string { return proto.CompactTextString(m) }
+func (*SponsorshipAllowance) ProtoMessage()    {}
+func (*SponsorshipAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{4}
+}
+func (m *SponsorshipAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsorshipAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsorshipAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsorshipAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsorshipAllowance.Merge(m, src)
+}
+func (m *SponsorshipAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsorshipAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsorshipAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsorshipAllowance proto.InternalMessageInfo
+
+func (m *SponsorshipAllowance) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *SponsorshipAllowance) GetDailyLimitPerGrantee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DailyLimitPerGrantee
+	}
+	return nil
+}
+
+func (m *SponsorshipAllowance) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *SponsorshipAllowance) GetAllowedMessages() []string {
+	if m != nil {
+		return m.AllowedMessages
+	}
+	return nil
+}
+
+func (m *SponsorshipAllowance) GetAllowedContracts() []string {
+	if m != nil {
+		return m.AllowedContracts
+	}
+	return nil
+}
+
+// Sponsorship is stored in the KVStore to record the sponsorship of a granter.
+type Sponsorship struct {
+	// granter is the address of the user sponsoring the txs of any grantee.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// allowance is the allowance of the sponsorship.
+	Allowance SponsorshipAllowance `protobuf:"bytes,2,opt,name=allowance,proto3" json:"allowance"`
+}
+
+func (m *Sponsorship) Reset()         { *m = Sponsorship{} }
+func (m *Sponsorship) String() string { return proto.CompactTextString(m) }
+func (*Sponsorship) ProtoMessage()    {}
+func (*Sponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{5}
+}
+func (m *Sponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Sponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Sponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Sponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sponsorship.Merge(m, src)
+}
+func (m *Sponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *Sponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Sponsorship proto.InternalMessageInfo
+
+func (m *Sponsorship) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *Sponsorship) GetAllowance() SponsorshipAllowance {
+	if m != nil {
+		return m.Allowance
+	}
+	return SponsorshipAllowance{}
+}
+
+// SponsorshipUsage is stored in the KVStore to record the fees paid by the
+// sponsorship of a granter for a grantee in the current day.
+type SponsorshipUsage struct {
+	// granter is the address of the user sponsoring the txs of the grantee.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee is the address of the user whose txs are sponsored.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// period_spent is the amount of coins spent by the grantee in the current day.
+	PeriodSpent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=period_spent,json=periodSpent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spent"`
+	// period_reset is the time at which the current day ends and the amount of
+	// coins spent by the grantee is reset.
+	PeriodReset time.Time `protobuf:"bytes,4,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *SponsorshipUsage) Reset()         { *m = SponsorshipUsage{} }
+func (m *SponsorshipUsage) String() string { return proto.CompactTextString(m) }
+func (*SponsorshipUsage) ProtoMessage()    {}
+func (*SponsorshipUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{6}
+}
+func (m *SponsorshipUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsorshipUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsorshipUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsorshipUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsorshipUsage.Merge(m, src)
+}
+func (m *SponsorshipUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsorshipUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsorshipUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsorshipUsage proto.InternalMessageInfo
+
+func (m *SponsorshipUsage) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *SponsorshipUsage) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+func (m *SponsorshipUsage) GetPeriodSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodSpent
+	}
+	return nil
+}
+
+func (m *SponsorshipUsage) GetPeriodReset() time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
+	proto.RegisterType((*SponsorshipAllowance)(nil), "cosmos.feegrant.v1beta1.SponsorshipAllowance")
+	proto.RegisterType((*Sponsorship)(nil), "cosmos.feegrant.v1beta1.Sponsorship")
+	proto.RegisterType((*SponsorshipUsage)(nil), "cosmos.feegrant.v1beta1.SponsorshipUsage")
 }
 
 func init() {
@@ -295,48 +519,59 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 655 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x41, 0x4f, 0xd4, 0x40,
-	0x14, 0xde, 0x61, 0x01, 0xb3, 0xb3, 0x88, 0x50, 0x49, 0xec, 0x12, 0xd3, 0x25, 0x9b, 0x28, 0x0b,
-	0x09, 0x6d, 0xc0, 0x1b, 0x27, 0xb6, 0x18, 0x50, 0x03, 0x09, 0x29, 0x9e, 0x4c, 0x4c, 0x33, 0x6d,
-	0x87, 0x3a, 0x71, 0xdb, 0x69, 0x3a, 0x45, 0xd9, 0xab, 0x27, 0xa3, 0x07, 0x39, 0x1a, 0x4f, 0x1c,
-	0x8d, 0x27, 0x0e, 0xfc, 0x08, 0xe2, 0xc1, 0x10, 0x4f, 0x7a, 0x11, 0x03, 0x07, 0xce, 0xfe, 0x03,
-	0xd3, 0x99, 0x69, 0xb7, 0xb0, 0xa2, 0x90, 0x18, 0x2e, 0xbb, 0x33, 0x6f, 0xde, 0xf7, 0xbd, 0xef,
-	0x7b, 0xef, 0x6d, 0x16, 0xde, 0x75, 0x29, 0x0b, 0x28, 0x33, 0x36, 0x30, 0xf6, 0x63, 0x14, 0x26,
-	0xc6, 0x8b, 0x59, 0x07, 0x27, 0x68, 0x36, 0x0f, 0xe8, 0x51, 0x4c, 0x13, 0xaa, 0xdc, 0x12, 0x79,
-	0x7a, 0x1e, 0x96, 0x79, 0xe3, 0x63, 0x3e, 0xf5, 0x29, 0xcf, 0x31, 0xd2, 0x93, 0x48, 0x1f, 0xaf,
-	0xf9, 0x94, 0xfa, 0x6d, 0x6c, 0xf0, 0x9b, 0xb3, 0xb9, 0x61, 0xa0, 0xb0, 0x93, 0x3d, 0x09, 0x26,
-	0x5b, 0x60, 0x24, 0xad, 0x78, 0xd2, 0xa4, 0x18, 0x07, 0x31, 0x9c, 0x0b, 0x71, 0x29, 0x09, 0xe5,
-	0xfb, 0x28, 0x0a, 0x48, 0x48, 0x0d, 0xfe, 0x29, 0x43, 0xf5, 0xb3, 0x85, 0x12, 0x12, 0x60, 0x96,
-	0xa0, 0x20, 0xca, 0x38, 0xcf, 0x26, 0x78, 0x9b, 0x31, 0x4a, 0x08, 0x95, 0x9c, 0x8d, 0x9d, 0x3e,
-	0x38, 0x6c, 0x22, 0x46, 0xdc, 0x56, 0xbb, 0x4d, 0x5f, 0xa2, 0xd0, 0xc5, 0xca, 0x2b, 0x00, 0xab,
-	0x2c, 0xc2, 0xa1, 0x67, 0xb7, 0x49, 0x40, 0x12, 0x15, 0x4c, 0x94, 0x9b, 0xd5, 0xb9, 0x9a, 0x2e,
-	0xb5, 0xa6, 0xea, 0x32, 0xfb, 0xfa, 0x22, 0x25, 0xa1, 0xb9, 0xb4, 0xff, 0xa3, 0x5e, 0xfa, 0x74,
-	0x58, 0x6f, 0xfa, 0x24, 0x79, 0xb6, 0xe9, 0xe8, 0x2e, 0x0d, 0xa4, 0x31, 0xf9, 0x35, 0xc3, 0xbc,
-	0xe7, 0x46, 0xd2, 0x89, 0x30, 0xe3, 0x00, 0xf6, 0xe1, 0x64, 0x77, 0x7a, 0xa8, 0x8d, 0x7d, 0xe4,
-	0x76, 0xec, 0xd4, 0x1f, 0xfb, 0x78, 0xb2, 0x3b, 0x0d, 0x2c, 0xc8, 0xab, 0xae, 0xa4, 0x45, 0x95,
-	0x05, 0x08, 0xf1, 0x56, 0x44, 0x84, 0x56, 0xb5, 0x6f, 0x02, 0x34, 0xab, 0x73, 0xe3, 0xba, 0x30,
-	0xa3, 0x67, 0x66, 0xf4, 0xc7, 0x99, 0x5b, 0xb3, 0x7f, 0xfb, 0xb0, 0x0e, 0xac, 0x02, 0x66, 0x7e,
-	0xf9, 0xf3, 0xde, 0xcc, 0x9d, 0x73, 0xc6, 0xa6, 0x2f, 0x61, 0x9c, 0x1b, 0x7e, 0xf8, 0xe6, 0x64,
-	0x77, 0xba, 0x56, 0x50, 0x7a, 0xba, 0x1f, 0x8d, 0xef, 0xfd, 0x70, 0x74, 0x0d, 0xc7, 0x84, 0x7a,
-	0xc5, 0x2e, 0x3d, 0x80, 0x03, 0x4e, 0x9a, 0xa7, 0x02, 0xae, 0x6d, 0x52, 0x3f, 0xaf, 0xd4, 0x69,
-	0x36, 0xb3, 0x92, 0x36, 0x4b, 0xf8, 0x15, 0x04, 0xca, 0x02, 0x1c, 0x8c, 0x38, 0xbd, 0xb4, 0x59,
-	0xeb, 0xb1, 0x79, 0x5f, 0xce, 0xcc, 0xbc, 0x9e, 0x82, 0xdf, 0x1f, 0xd6, 0x81, 0x20, 0x90, 0x38,
-	0xe5, 0x1d, 0x80, 0x8a, 0x38, 0xda, 0xc5, 0xc1, 0x95, 0xaf, 0x6a, 0x70, 0x23, 0xa2, 0xf8, 0x7a,
-	0x77, 0x7c, 0x6f, 0x01, 0x94, 0x41, 0xdb, 0x45, 0xa1, 0x50, 0xa5, 0xf6, 0x5f, 0x95, 0x9e, 0x61,
-	0x51, 0x7a, 0x11, 0x85, 0x5c, 0x92, 0xb2, 0x02, 0x87, 0xa4, 0x98, 0x18, 0x33, 0x9c, 0xa8, 0x03,
-	0xff, 0x5c, 0x27, 0xde, 0xe8, 0xed, 0xbc, 0xd1, 0x55, 0x01, 0xb7, 0x52, 0xf4, 0xfc, 0xa3, 0x4b,
-	0x2d, 0xd6, 0xed, 0x82, 0xf2, 0x9e, 0x2d, 0x6a, 0xfc, 0x02, 0xf0, 0x26, 0xbf, 0x61, 0x6f, 0x95,
-	0xf9, 0xdd, 0xed, 0x7a, 0x0a, 0x2b, 0x28, 0xbb, 0xc8, 0x0d, 0x1b, 0xeb, 0x91, 0xdb, 0x0a, 0x3b,
-	0xe6, 0xd4, 0x85, 0xc5, 0x58, 0x5d, 0x46, 0x65, 0x0a, 0x8e, 0x20, 0x51, 0xd5, 0x0e, 0x30, 0x63,
-	0xc8, 0xc7, 0x4c, 0xed, 0x9b, 0x28, 0x37, 0x2b, 0xd6, 0x0d, 0x19, 0x5f, 0x95, 0xe1, 0xf9, 0xb5,
-	0xd7, 0x3b, 0xf5, 0xd2, 0xa5, 0x1c, 0x6b, 0x05, 0xc7, 0x7f, 0xf0, 0xd6, 0xf8, 0x02, 0xe0, 0xc0,
-	0x72, 0x4a, 0xa1, 0xcc, 0xc1, 0x6b, 0x9c, 0x0b, 0xc7, 0xdc, 0x63, 0xc5, 0x54, 0xbf, 0xee, 0xcd,
-	0x8c, 0xc9, 0x42, 0x2d, 0xcf, 0x8b, 0x31, 0x63, 0xeb, 0x49, 0x4c, 0x42, 0xdf, 0xca, 0x12, 0xbb,
-	0x18, 0xcc, 0x7f, 0x2e, 0x17, 0xc0, 0x9c, 0xe9, 0x66, 0xf9, 0x7f, 0x77, 0xd3, 0x6c, 0xed, 0x1f,
-	0x69, 0xe0, 0xe0, 0x48, 0x03, 0x3f, 0x8f, 0x34, 0xb0, 0x7d, 0xac, 0x95, 0x0e, 0x8e, 0xb5, 0xd2,
-	0xb7, 0x63, 0xad, 0xf4, 0x64, 0xf2, 0xaf, 0x8b, 0xbc, 0x95, 0xff, 0xcb, 0x38, 0x83, 0x5c, 0xc6,
-	0xbd, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x31, 0x3d, 0x53, 0x70, 0x90, 0x06, 0x00, 0x00,
+	// 818 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x4f, 0x1b, 0x47,
+	0x14, 0xf6, 0xf8, 0x07, 0x95, 0xc7, 0x94, 0x1f, 0x8b, 0x25, 0xd6, 0xa8, 0x5a, 0x5b, 0x96, 0x5a,
+	0x0c, 0x95, 0xd7, 0x85, 0xb6, 0x17, 0x9f, 0xf0, 0x52, 0x41, 0x5b, 0x81, 0x84, 0x4c, 0xdb, 0x43,
+	0xa5, 0x6a, 0x35, 0xde, 0x1d, 0x96, 0x55, 0xed, 0x9d, 0xd5, 0xce, 0xd2, 0xe2, 0x6b, 0xd5, 0x43,
+	0xd5, 0x1e, 0xca, 0x11, 0xe5, 0xc4, 0x31, 0xca, 0x21, 0xe2, 0xc0, 0x1f, 0x81, 0x72, 0x88, 0x10,
+	0xa7, 0xe4, 0x12, 0x22, 0x48, 0xc4, 0x39, 0xff, 0x41, 0xb4, 0x33, 0xb3, 0xde, 0x05, 0x9b, 0x04,
+	0x92, 0xc8, 0xca, 0xc5, 0xde, 0x7d, 0xf3, 0xde, 0xf7, 0xbe, 0xef, 0xbd, 0xe7, 0x37, 0x86, 0x5f,
+	0x18, 0x84, 0x76, 0x08, 0xad, 0x6d, 0x61, 0x6c, 0x79, 0xc8, 0xf1, 0x6b, 0x7f, 0x2c, 0xb4, 0xb0,
+	0x8f, 0x16, 0x7a, 0x06, 0xd5, 0xf5, 0x88, 0x4f, 0xa4, 0x69, 0xee, 0xa7, 0xf6, 0xcc, 0xc2, 0x6f,
+	0x26, 0x6f, 0x11, 0x8b, 0x30, 0x9f, 0x5a, 0xf0, 0xc4, 0xdd, 0x67, 0x0a, 0x16, 0x21, 0x56, 0x1b,
+	0xd7, 0xd8, 0x5b, 0x6b, 0x67, 0xab, 0x86, 0x9c, 0x6e, 0x78, 0xc4, 0x91, 0x74, 0x1e, 0x23, 0x60,
+	0xf9, 0x91, 0x22, 0xc8, 0xb4, 0x10, 0xc5, 0x3d, 0x22, 0x06, 0xb1, 0x1d, 0x71, 0x3e, 0x89, 0x3a,
+	0xb6, 0x43, 0x6a, 0xec, 0x53, 0x98, 0x8a, 0xd7, 0x13, 0xf9, 0x76, 0x07, 0x53, 0x1f, 0x75, 0xdc,
+	0x10, 0xf3, 0xba, 0x83, 0xb9, 0xe3, 0x21, 0xdf, 0x26, 0x02, 0xb3, 0x7c, 0x90, 0x84, 0x63, 0x1a,
+	0xa2, 0xb6, 0xd1, 0x68, 0xb7, 0xc9, 0x9f, 0xc8, 0x31, 0xb0, 0xf4, 0x17, 0x80, 0x39, 0xea, 0x62,
+	0xc7, 0xd4, 0xdb, 0x76, 0xc7, 0xf6, 0x65, 0x50, 0x4a, 0x55, 0x72, 0x8b, 0x05, 0x55, 0x70, 0x0d,
+	0xd8, 0x85, 0xf2, 0xd5, 0x65, 0x62, 0x3b, 0xda, 0xca, 0xf1, 0xb3, 0x62, 0xe2, 0xc1, 0x59, 0xb1,
+	0x62, 0xd9, 0xfe, 0xf6, 0x4e, 0x4b, 0x35, 0x48, 0x47, 0x08, 0x13, 0x5f, 0x55, 0x6a, 0xfe, 0x5e,
+	0xf3, 0xbb, 0x2e, 0xa6, 0x2c, 0x80, 0xde, 0xbb, 0x3c, 0x9c, 0x1f, 0x6d, 0x63, 0x0b, 0x19, 0x5d,
+	0x3d, 0xd0, 0x47, 0xef, 0x5f, 0x1e, 0xce, 0x83, 0x26, 0x64, 0x59, 0xd7, 0x82, 0xa4, 0xd2, 0x12,
+	0x84, 0x78, 0xd7, 0xb5, 0x39, 0x57, 0x39, 0x59, 0x02, 0x95, 0xdc, 0xe2, 0x8c, 0xca, 0xc5, 0xa8,
+	0xa1, 0x18, 0xf5, 0xa7, 0x50, 0xad, 0x96, 0xde, 0x3b, 0x2b, 0x82, 0x66, 0x2c, 0xa6, 0xbe, 0xfa,
+	0xe8, 0xa8, 0xfa, 0xf9, 0x0d, 0x6d, 0x53, 0x57, 0x30, 0xee, 0x09, 0xfe, 0xe1, 0xdf, 0xcb, 0xc3,
+	0xf9, 0x42, 0x8c, 0xe9, 0xd5, 0x7a, 0x94, 0x9f, 0xa6, 0xe1, 0xe4, 0x06, 0xf6, 0x6c, 0x62, 0xc6,
+	0xab, 0xf4, 0x3d, 0xcc, 0xb4, 0x02, 0x3f, 0x19, 0x30, 0x6e, 0xb3, 0xea, 0x4d, 0xa9, 0xae, 0xa2,
+	0x69, 0xd9, 0xa0, 0x58, 0x5c, 0x2f, 0x07, 0x90, 0x96, 0xe0, 0x88, 0xcb, 0xe0, 0x85, 0xcc, 0x42,
+	0x9f, 0xcc, 0xef, 0x44, 0xcf, 0xb4, 0x4f, 0x83, 0xe0, 0xfd, 0xb3, 0x22, 0xe0, 0x00, 0x22, 0x4e,
+	0xfa, 0x1f, 0x40, 0x89, 0x3f, 0xea, 0xf1, 0xc6, 0xa5, 0x86, 0xd5, 0xb8, 0x09, 0x9e, 0x7c, 0x33,
+	0x6a, 0xdf, 0x7f, 0x00, 0x0a, 0xa3, 0x6e, 0x20, 0x87, 0xb3, 0x92, 0xd3, 0xc3, 0xe2, 0x33, 0xc6,
+	0x53, 0x2f, 0x23, 0x87, 0x51, 0x92, 0xd6, 0xe0, 0xa8, 0x20, 0xe3, 0x61, 0x8a, 0x7d, 0x39, 0xf3,
+	0xd6, 0x71, 0x62, 0x85, 0xde, 0xeb, 0x15, 0x3a, 0xc7, 0xc3, 0x9b, 0x41, 0x74, 0xfd, 0xc7, 0x3b,
+	0x0d, 0xd6, 0x67, 0x31, 0xe6, 0x7d, 0x53, 0x54, 0x7e, 0x05, 0xe0, 0x14, 0x7b, 0xc3, 0xe6, 0x3a,
+	0xb5, 0xa2, 0xe9, 0xfa, 0x0d, 0x66, 0x51, 0xf8, 0x22, 0x26, 0x2c, 0xdf, 0x47, 0xb7, 0xe1, 0x74,
+	0xb5, 0xb9, 0x5b, 0x93, 0x69, 0x46, 0x88, 0xd2, 0x1c, 0x9c, 0x40, 0x3c, 0xab, 0xde, 0xc1, 0x94,
+	0x22, 0x0b, 0x53, 0x39, 0x59, 0x4a, 0x55, 0xb2, 0xcd, 0x71, 0x61, 0x5f, 0x17, 0xe6, 0xfa, 0xc6,
+	0x3f, 0x07, 0xc5, 0xc4, 0x9d, 0x14, 0x2b, 0x31, 0xc5, 0x03, 0xb4, 0x95, 0x1f, 0x03, 0x98, 0x59,
+	0x0d, 0x20, 0xa4, 0x45, 0xf8, 0x09, 0xc3, 0xc2, 0x1e, 0xd3, 0x98, 0xd5, 0xe4, 0xd3, 0xa3, 0x6a,
+	0x5e, 0x24, 0x6a, 0x98, 0xa6, 0x87, 0x29, 0xdd, 0xf4, 0x3d, 0xdb, 0xb1, 0x9a, 0xa1, 0x63, 0x14,
+	0x83, 0xe5, 0xe4, 0xed, 0x62, 0xae, 0x55, 0x33, 0xf5, 0xa1, 0xab, 0x59, 0x7e, 0x99, 0x82, 0xf9,
+	0x4d, 0x97, 0x38, 0x94, 0x78, 0x74, 0xdb, 0x76, 0x3f, 0xb2, 0x4d, 0xba, 0x0f, 0xe0, 0xb4, 0x89,
+	0xec, 0x76, 0x97, 0x93, 0xd0, 0x5d, 0xec, 0xe9, 0x51, 0x05, 0x87, 0x44, 0x28, 0xcf, 0x18, 0x30,
+	0x42, 0x1b, 0xd8, 0x5b, 0x15, 0x7d, 0xb9, 0xba, 0xe4, 0x53, 0x77, 0x5f, 0xf2, 0x03, 0x07, 0x39,
+	0x3d, 0x70, 0x90, 0xa5, 0x2f, 0xe1, 0x64, 0xe8, 0x6a, 0x10, 0xc7, 0xf7, 0x90, 0xe1, 0x53, 0x39,
+	0xc3, 0x7c, 0x43, 0x8c, 0xe5, 0xd0, 0x5e, 0x9f, 0x3a, 0x3d, 0xaa, 0x8e, 0x47, 0x0a, 0x4b, 0x5f,
+	0xa9, 0xdf, 0x7e, 0x53, 0x7e, 0x08, 0x60, 0x2e, 0xd6, 0xe7, 0x77, 0x1a, 0xdf, 0x5f, 0xe2, 0xa3,
+	0xc8, 0xf7, 0x7d, 0xf5, 0xc6, 0xab, 0x63, 0xd0, 0x50, 0xc5, 0x2f, 0x90, 0x08, 0x6a, 0x30, 0xe1,
+	0x17, 0x49, 0x38, 0x11, 0xc3, 0xf8, 0x39, 0x28, 0xc4, 0xd0, 0x7e, 0x74, 0x7f, 0x83, 0xde, 0xd6,
+	0x0d, 0xa6, 0x71, 0x88, 0xd7, 0x51, 0x2e, 0xba, 0x8e, 0xfc, 0xbe, 0xdd, 0x9f, 0x7e, 0xaf, 0xdd,
+	0x3f, 0xa8, 0xcc, 0x5a, 0xe3, 0xf8, 0x5c, 0x01, 0x27, 0xe7, 0x0a, 0x78, 0x7e, 0xae, 0x80, 0xbd,
+	0x0b, 0x25, 0x71, 0x72, 0xa1, 0x24, 0x9e, 0x5c, 0x28, 0x89, 0x5f, 0x67, 0xdf, 0xa8, 0x64, 0xb7,
+	0xf7, 0x2f, 0xb3, 0x35, 0xc2, 0x78, 0x7c, 0xfd, 0x7a, 0x00, 0x7d, 0x38, 0xb4, 0x58, 0x90, 0x0a,
+	0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -556,110 +791,820 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFeegrant(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SponsorshipAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *BasicAllowance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *SponsorshipAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsorshipAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.SpendLimit) > 0 {
-		for _, e := range m.SpendLimit {
-			l = e.Size()
-			n += 1 + l + sovFeegrant(uint64(l))
+	if len(m.AllowedContracts) > 0 {
+		for iNdEx := len(m.AllowedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContracts[iNdEx])
+			copy(dAtA[i:], m.AllowedContracts[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AllowedMessages) > 0 {
+		for iNdEx := len(m.AllowedMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMessages[iNdEx])
+			copy(dAtA[i:], m.AllowedMessages[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
 	}
 	if m.Expiration != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
-		n += 1 + l + sovFeegrant(uint64(l))
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintFeegrant(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.DailyLimitPerGrantee) > 0 {
+		for iNdEx := len(m.DailyLimitPerGrantee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailyLimitPerGrantee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PeriodicAllowance) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Sponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Sponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Sponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Basic.Size()
-	n += 1 + l + sovFeegrant(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
-	n += 1 + l + sovFeegrant(uint64(l))
-	if len(m.PeriodSpendLimit) > 0 {
-		for _, e := range m.PeriodSpendLimit {
-			l = e.Size()
-			n += 1 + l + sovFeegrant(uint64(l))
+	{
+		size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintFeegrant(dAtA, i, uint64(size))
 	}
-	if len(m.PeriodCanSpend) > 0 {
-		for _, e := range m.PeriodCanSpend {
-			l = e.Size()
-			n += 1 + l + sovFeegrant(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
-	n += 1 + l + sovFeegrant(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AllowedMsgAllowance) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SponsorshipUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return dAtA[:n], nil
+}
+
+func (m *SponsorshipUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsorshipUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintFeegrant(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	if len(m.PeriodSpent) > 0 {
+		for iNdEx := len(m.PeriodSpent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeegrant(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BasicAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	return n
+}
+
+func (m *PeriodicAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Basic.Size()
+	n += 1 + l + sovFeegrant(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovFeegrant(uint64(l))
+	if len(m.PeriodSpendLimit) > 0 {
+		for _, e := range m.PeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for _, e := range m.PeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovFeegrant(uint64(l))
+	return n
+}
+
+func (m *AllowedMsgAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.AllowedMessages) > 0 {
+		for _, s := range m.AllowedMessages {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Grant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
 	if m.Allowance != nil {
 		l = m.Allowance.Size()
 		n += 1 + l + sovFeegrant(uint64(l))
 	}
+	return n
+}
+
+func (m *SponsorshipAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if len(m.DailyLimitPerGrantee) > 0 {
+		for _, e := range m.DailyLimitPerGrantee {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
 	if len(m.AllowedMessages) > 0 {
 		for _, s := range m.AllowedMessages {
 			l = len(s)
 			n += 1 + l + sovFeegrant(uint64(l))
 		}
 	}
-	return n
-}
+	if len(m.AllowedContracts) > 0 {
+		for _, s := range m.AllowedContracts {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Sponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = m.Allowance.Size()
+	n += 1 + l + sovFeegrant(uint64(l))
+	return n
+}
+
+func (m *SponsorshipUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.PeriodSpent) > 0 {
+		for _, e := range m.PeriodSpent {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovFeegrant(uint64(l))
+	return n
+}
+
+func sovFeegrant(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeegrant(x uint64) (n int) {
+	return sovFeegrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasicAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasicAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodicAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodicAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.Coin{})
+			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodCanSpend = append(m.PeriodCanSpend, types.Coin{})
+			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedMsgAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedMsgAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &any.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *Grant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovFeegrant(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovFeegrant(uint64(l))
-	}
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovFeegrant(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovFeegrant(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFeegrant(x uint64) (n int) {
-	return sovFeegrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
+func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -682,17 +1627,17 @@ func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BasicAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BasicAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeegrant
@@ -702,29 +1647,59 @@ func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFeegrant
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFeegrant
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpendLimit = append(m.SpendLimit, types.Coin{})
-			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -751,10 +1726,10 @@ func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expiration == nil {
-				m.Expiration = new(time.Time)
+			if m.Allowance == nil {
+				m.Allowance = &any.Any{}
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -779,7 +1754,7 @@ func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
+func (m *SponsorshipAllowance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -802,15 +1777,15 @@ func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PeriodicAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: SponsorshipAllowance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PeriodicAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SponsorshipAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -837,13 +1812,14 @@ func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyLimitPerGrantee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -870,13 +1846,14 @@ func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+			m.DailyLimitPerGrantee = append(m.DailyLimitPerGrantee, types.Coin{})
+			if err := m.DailyLimitPerGrantee[len(m.DailyLimitPerGrantee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -903,16 +1880,18 @@ func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.Coin{})
-			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeegrant
@@ -922,31 +1901,29 @@ func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFeegrant
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFeegrant
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeriodCanSpend = append(m.PeriodCanSpend, types.Coin{})
-			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContracts", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeegrant
@@ -956,24 +1933,23 @@ func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFeegrant
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFeegrant
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AllowedContracts = append(m.AllowedContracts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -996,7 +1972,7 @@ func (m *PeriodicAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
+func (m *Sponsorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1019,17 +1995,17 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowedMsgAllowance: wiretype end group for non-group")
+			return fmt.Errorf("proto: Sponsorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowedMsgAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Sponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeegrant
@@ -1039,33 +2015,29 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFeegrant
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFeegrant
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Allowance == nil {
-				m.Allowance = &any.Any{}
-			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMessages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeegrant
@@ -1075,23 +2047,24 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFeegrant
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFeegrant
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedMessages = append(m.AllowedMessages, string(dAtA[iNdEx:postIndex]))
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1114,7 +2087,7 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Grant) Unmarshal(dAtA []byte) error {
+func (m *SponsorshipUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1137,10 +2110,10 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
+			return fmt.Errorf("proto: SponsorshipUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SponsorshipUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1209,7 +2182,7 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1236,10 +2209,41 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Allowance == nil {
-				m.Allowance = &any.Any{}
+			m.PeriodSpent = append(m.PeriodSpent, types.Coin{})
+			if err := m.PeriodSpent[len(m.PeriodSpent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -37,6 +37,35 @@ func ValidateGenesis(data GenesisState) error {
 			return err
 		}
 	}
+
+	sponsors := make(map[string]struct{})
+	for _, s := range data.Sponsorships {
+		if _, exists := sponsors[s.Granter]; exists {
+			return fmt.Errorf("duplicate sponsorship found from granter %q", s.Granter)
+		}
+		sponsors[s.Granter] = struct{}{}
+
+		if err := s.Allowance.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	seen = make(map[string]struct{})
+	for _, u := range data.SponsorshipUsages {
+		key := fmt.Sprintf("%s|%s", u.Granter, u.Grantee)
+		if _, exists := seen[key]; exists {
+			return fmt.Errorf("duplicate sponsorship usage found from granter %q to grantee %q", u.Granter, u.Grantee)
+		}
+		seen[key] = struct{}{}
+
+		if _, exists := sponsors[u.Granter]; !exists {
+			return fmt.Errorf("sponsorship usage found for granter %q without sponsorship", u.Granter)
+		}
+		if !u.PeriodSpent.IsValid() {
+			return fmt.Errorf("invalid sponsorship usage from granter %q to grantee %q: %s", u.Granter, u.Grantee, u.PeriodSpent)
+		}
+	}
+
 	return nil
 }
 
@@ -44,3 +44,32 @@ func TestDuplicateGrantsInGenesis(t *testing.T) {
 	err = ValidateGenesis(*genesisState)
 	assert.ErrorContains(t, err, "duplicate feegrant found")
 }
+
+func TestSponsorshipsInGenesis(t *testing.T) {
+	granter := sdk.AccAddress("granter_address____").String()
+	grantee := sdk.AccAddress("grantee_address____").String()
+
+	sponsorship := Sponsorship{
+		Granter: granter,
+		Allowance: SponsorshipAllowance{
+			DailyLimitPerGrantee: sdk.NewCoins(sdk.NewCoin("foo", math.NewInt(100))),
+			AllowedMessages:      []string{"/cosmos.gov.v1.MsgVote"},
+		},
+	}
+	usage := SponsorshipUsage{Granter: granter, Grantee: grantee}
+
+	err := ValidateGenesis(GenesisState{Sponsorships: []Sponsorship{sponsorship}, SponsorshipUsages: []SponsorshipUsage{usage}})
+	assert.NilError(t, err)
+
+	err = ValidateGenesis(GenesisState{Sponsorships: []Sponsorship{sponsorship, sponsorship}})
+	assert.ErrorContains(t, err, "duplicate sponsorship found")
+
+	err = ValidateGenesis(GenesisState{Sponsorships: []Sponsorship{{Granter: granter}}})
+	assert.ErrorContains(t, err, "daily limit per grantee must be positive")
+
+	err = ValidateGenesis(GenesisState{Sponsorships: []Sponsorship{sponsorship}, SponsorshipUsages: []SponsorshipUsage{usage, usage}})
+	assert.ErrorContains(t, err, "duplicate sponsorship usage found")
+
+	err = ValidateGenesis(GenesisState{SponsorshipUsages: []SponsorshipUsage{usage}})
+	assert.ErrorContains(t, err, "without sponsorship")
+}
@@ -71,8 +71,16 @@ func TestImportExportGenesis(t *testing.T) {
 	err := f.feegrantKeeper.GrantAllowance(f.ctx, granterAddr, granteeAddr, allowance)
 	assert.NilError(t, err)
 
+	sponsorship := feegrant.SponsorshipAllowance{DailyLimitPerGrantee: coins, AllowedMessages: []string{sdk.MsgTypeURL(&feegrant.MsgRevokeAllowance{})}}
+	err = f.feegrantKeeper.GrantSponsorship(f.ctx, granteeAddr, sponsorship)
+	assert.NilError(t, err)
+	err = f.feegrantKeeper.UseGrantedFees(f.ctx, granteeAddr, granterAddr, coins, []sdk.Msg{&feegrant.MsgRevokeAllowance{}})
+	assert.NilError(t, err)
+
 	genesis, err := f.feegrantKeeper.ExportGenesis(f.ctx)
 	assert.NilError(t, err)
+	assert.Equal(t, len(genesis.Sponsorships), 1)
+	assert.Equal(t, len(genesis.SponsorshipUsages), 1)
 
 	// revoke fee allowance
 	_, err = msgSrvr.RevokeAllowance(f.ctx, &feegrant.MsgRevokeAllowance{
@@ -81,6 +89,36 @@ func TestImportExportGenesis(t *testing.T) {
 	})
 	assert.NilError(t, err)
 
+	// revoke sponsorship
+	_, err = msgSrvr.RevokeSponsorship(f.ctx, &feegrant.MsgRevokeSponsorship{Granter: granteeAddr.String()})
+	assert.NilError(t, err)
+
+	err = f.feegrantKeeper.InitGenesis(f.ctx, genesis)
+	assert.NilError(t, err)
+
+	newGenesis, err := f.feegrantKeeper.ExportGenesis(f.ctx)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, genesis, newGenesis)
+}
+
+func TestImportExportGenesisExpiredSponsorship(t *testing.T) {
+	f := initFixture(t)
+
+	coins := sdk.NewCoins(sdk.NewCoin("foo", math.NewInt(1_000)))
+	now := f.ctx.BlockHeader().Time
+	tomorrow := now.AddDate(0, 0, 1)
+
+	sponsorship := feegrant.SponsorshipAllowance{DailyLimitPerGrantee: coins, AllowedMessages: []string{sdk.MsgTypeURL(&feegrant.MsgRevokeAllowance{})}, Expiration: &tomorrow}
+	err := f.feegrantKeeper.GrantSponsorship(f.ctx, granterAddr, sponsorship)
+	assert.NilError(t, err)
+
+	genesis, err := f.feegrantKeeper.ExportGenesis(f.ctx)
+	assert.NilError(t, err)
+	assert.Equal(t, len(genesis.Sponsorships), 1)
+
+	// the sponsorship expired by the time the genesis is imported
+	f = initFixture(t)
+	f.ctx = f.ctx.WithBlockTime(now.AddDate(0, 0, 2))
 	err = f.feegrantKeeper.InitGenesis(f.ctx, genesis)
 	assert.NilError(t, err)
 
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"github.com/cosmos/gogoproto/proto"
 	"google.golang.org/grpc/codes"
@@ -118,3 +119,53 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// Sponsorship queries the sponsorship of the given granter.
+func (q Keeper) Sponsorship(ctx context.Context, req *feegrant.QuerySponsorshipRequest) (*feegrant.QuerySponsorshipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	sponsorship, err := q.Sponsorships.Get(ctx, granterAddr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no sponsorship from granter %s", req.Granter)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QuerySponsorshipResponse{Sponsorship: &sponsorship}, nil
+}
+
+// SponsorshipUsage queries the fees paid by the sponsorship of the given granter
+// for the given grantee in the current day.
+func (q Keeper) SponsorshipUsage(ctx context.Context, req *feegrant.QuerySponsorshipUsageRequest) (*feegrant.QuerySponsorshipUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	granteeAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := q.SponsorshipUsages.Get(ctx, collections.Join(sdk.AccAddress(granterAddr), sdk.AccAddress(granteeAddr)))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no sponsorship usage from granter %s to grantee %s", req.Granter, req.Grantee)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &feegrant.QuerySponsorshipUsageResponse{Usage: &usage}, nil
+}
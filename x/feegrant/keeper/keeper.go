@@ -30,6 +30,11 @@ type Keeper struct {
 	Schema            collections.Schema
 	FeeAllowance      collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.Grant]
 	FeeAllowanceQueue collections.Map[collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], bool]
+	Sponsorships      collections.Map[sdk.AccAddress, feegrant.Sponsorship]
+	// SponsorshipUsages key: granter | grantee | value: SponsorshipUsage
+	SponsorshipUsages collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.SponsorshipUsage]
+	// SponsorshipUsageQueue key: period reset | granter | grantee | value: bool
+	SponsorshipUsageQueue collections.Map[collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], bool]
 }
 
 var _ ante.FeegrantKeeper = &Keeper{}
@@ -56,6 +61,27 @@ func NewKeeper(cdc codec.BinaryCodec, storeService store.KVStoreService, ak feeg
 			collections.TripleKeyCodec(sdk.TimeKey, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), // nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			collections.BoolValue,
 		),
+		Sponsorships: collections.NewMap(
+			sb,
+			feegrant.SponsorshipKeyPrefix,
+			"sponsorships",
+			sdk.AccAddressKey,
+			codec.CollValue[feegrant.Sponsorship](cdc),
+		),
+		SponsorshipUsages: collections.NewMap(
+			sb,
+			feegrant.SponsorshipUsageKeyPrefix,
+			"sponsorship_usages",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+			codec.CollValue[feegrant.SponsorshipUsage](cdc),
+		),
+		SponsorshipUsageQueue: collections.NewMap(
+			sb,
+			feegrant.SponsorshipUsageQueueKeyPrefix,
+			"sponsorship_usages_queue",
+			collections.TripleKeyCodec(sdk.TimeKey, sdk.AccAddressKey, sdk.AccAddressKey),
+			collections.BoolValue,
+		),
 	}
 }
 
@@ -233,6 +259,13 @@ func (k Keeper) IterateAllFeeAllowances(ctx context.Context, cb func(grant feegr
 func (k Keeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
 	grant, err := k.GetAllowance(ctx, granter, grantee)
 	if err != nil {
+		// without an allowance for the grantee, the fees can still be paid by
+		// the sponsorship of the granter
+		if errors.Is(err, collections.ErrNotFound) {
+			if has, hasErr := k.Sponsorships.Has(ctx, granter); hasErr == nil && has {
+				return k.useSponsorship(ctx, granter, grantee, fee, msgs)
+			}
+		}
 		return err
 	}
 
@@ -292,6 +325,39 @@ func (k Keeper) InitGenesis(ctx context.Context, data *feegrant.GenesisState) er
 			return err
 		}
 	}
+
+	// Sponsorships are imported as is, rather than through GrantSponsorship,
+	// since an exported sponsorship may have expired in the meantime.
+	for _, s := range data.Sponsorships {
+		granter, err := k.authKeeper.AddressCodec().StringToBytes(s.Granter)
+		if err != nil {
+			return err
+		}
+
+		if err := s.Allowance.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if err := k.Sponsorships.Set(ctx, granter, s); err != nil {
+			return err
+		}
+	}
+
+	for _, u := range data.SponsorshipUsages {
+		granter, err := k.authKeeper.AddressCodec().StringToBytes(u.Granter)
+		if err != nil {
+			return err
+		}
+		grantee, err := k.authKeeper.AddressCodec().StringToBytes(u.Grantee)
+		if err != nil {
+			return err
+		}
+
+		if err := k.setSponsorshipUsage(ctx, granter, grantee, nil, u); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -304,9 +370,33 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*feegrant.GenesisState, erro
 		return false
 	})
 
+	if err != nil {
+		return nil, err
+	}
+
+	var sponsorships []feegrant.Sponsorship
+	err = k.Sponsorships.Walk(ctx, nil, func(_ sdk.AccAddress, sponsorship feegrant.Sponsorship) (stop bool, err error) {
+		sponsorships = append(sponsorships, sponsorship)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []feegrant.SponsorshipUsage
+	err = k.SponsorshipUsages.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], usage feegrant.SponsorshipUsage) (stop bool, err error) {
+		usages = append(usages, usage)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &feegrant.GenesisState{
-		Allowances: grants,
-	}, err
+		Allowances:        grants,
+		Sponsorships:      sponsorships,
+		SponsorshipUsages: usages,
+	}, nil
 }
 
 // RemoveExpiredAllowances iterates grantsByExpiryQueue and deletes the expired grants.
@@ -108,3 +108,35 @@ func (k msgServer) PruneAllowances(ctx context.Context, req *feegrant.MsgPruneAl
 
 	return &feegrant.MsgPruneAllowancesResponse{}, nil
 }
+
+// GrantSponsorship sets the sponsorship of the granter, through which it pays the fees of the txs of any grantee.
+func (k msgServer) GrantSponsorship(ctx context.Context, msg *feegrant.MsgGrantSponsorship) (*feegrant.MsgGrantSponsorshipResponse, error) {
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := msg.Allowance.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.GrantSponsorship(ctx, granter, msg.Allowance); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgGrantSponsorshipResponse{}, nil
+}
+
+// RevokeSponsorship revokes the sponsorship of the granter.
+func (k msgServer) RevokeSponsorship(ctx context.Context, msg *feegrant.MsgRevokeSponsorship) (*feegrant.MsgRevokeSponsorshipResponse, error) {
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RevokeSponsorship(ctx, granter); err != nil {
+		return nil, err
+	}
+
+	return &feegrant.MsgRevokeSponsorshipResponse{}, nil
+}
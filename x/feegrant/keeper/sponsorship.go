@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// GrantSponsorship sets the sponsorship of the granter, replacing its existing
+// sponsorship. The fees already paid for the grantees in the current day still
+// count towards the new daily limit per grantee.
+func (k Keeper) GrantSponsorship(ctx context.Context, granter sdk.AccAddress, allowance feegrant.SponsorshipAllowance) error {
+	// expiration shouldn't be in the past.
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if allowance.Expiration != nil && allowance.Expiration.Before(sdkCtx.BlockTime()) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "expiration is before current block time")
+	}
+
+	granterStr, err := k.authKeeper.AddressCodec().BytesToString(granter)
+	if err != nil {
+		return err
+	}
+
+	sponsorship := feegrant.Sponsorship{
+		Granter:   granterStr,
+		Allowance: allowance,
+	}
+	if err := k.Sponsorships.Set(ctx, granter, sponsorship); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			feegrant.EventTypeSetSponsorship,
+			sdk.NewAttribute(feegrant.AttributeKeyGranter, granterStr),
+		),
+	)
+
+	return nil
+}
+
+// RevokeSponsorship removes the sponsorship of the granter, along with the fees
+// it paid for its grantees in the current day.
+func (k Keeper) RevokeSponsorship(ctx context.Context, granter sdk.AccAddress) error {
+	sponsorship, err := k.Sponsorships.Get(ctx, granter)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrapf(feegrant.ErrNoAllowance, "no sponsorship from granter %s", granter)
+		}
+		return err
+	}
+
+	if err := k.Sponsorships.Remove(ctx, granter); err != nil {
+		return err
+	}
+
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](granter)
+	err = k.SponsorshipUsages.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], usage feegrant.SponsorshipUsage) (bool, error) {
+		return false, k.SponsorshipUsageQueue.Remove(ctx, collections.Join3(usage.PeriodReset, key.K1(), key.K2()))
+	})
+	if err != nil {
+		return err
+	}
+	if err := k.SponsorshipUsages.Clear(ctx, rng); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			feegrant.EventTypeRevokeSponsorship,
+			sdk.NewAttribute(feegrant.AttributeKeyGranter, sponsorship.Granter),
+		),
+	)
+
+	return nil
+}
+
+// useSponsorship pays the given fee of a tx of the grantee from the sponsorship
+// of the granter, provided the tx is allowed by the sponsorship and the daily
+// limit of the grantee is not exceeded.
+func (k Keeper) useSponsorship(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	sponsorship, err := k.Sponsorships.Get(ctx, granter)
+	if err != nil {
+		return err
+	}
+
+	var prevPeriodReset *time.Time
+	usage, err := k.SponsorshipUsages.Get(ctx, collections.Join(granter, grantee))
+	switch {
+	case err == nil:
+		periodReset := usage.PeriodReset
+		prevPeriodReset = &periodReset
+	case errors.Is(err, collections.ErrNotFound):
+		granteeStr, err := k.authKeeper.AddressCodec().BytesToString(grantee)
+		if err != nil {
+			return err
+		}
+		usage = feegrant.SponsorshipUsage{Granter: sponsorship.Granter, Grantee: granteeStr}
+	default:
+		return err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if err := usage.Spend(blockTime, fee, sponsorship.Allowance.DailyLimitPerGrantee); err != nil {
+		return err
+	}
+
+	remove, err := sponsorship.Allowance.Accept(ctx, fee, msgs)
+	if remove {
+		// Ignoring the `RevokeSponsorship` error, because the sponsorship has enough funds to pay for this transaction.
+		_ = k.RevokeSponsorship(ctx, granter)
+		if err != nil {
+			return err
+		}
+
+		emitUseGrantEvent(ctx, sponsorship.Granter, usage.Grantee)
+
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	emitUseGrantEvent(ctx, sponsorship.Granter, usage.Grantee)
+
+	if err := k.setSponsorshipUsage(ctx, granter, grantee, prevPeriodReset, usage); err != nil {
+		return err
+	}
+
+	return k.Sponsorships.Set(ctx, granter, sponsorship)
+}
+
+// setSponsorshipUsage sets the usage of the sponsorship of the granter by the
+// grantee, queuing it for removal at the end of its period. prevPeriodReset is
+// the end of the period of the usage being replaced, if any.
+func (k Keeper) setSponsorshipUsage(ctx context.Context, granter, grantee sdk.AccAddress, prevPeriodReset *time.Time, usage feegrant.SponsorshipUsage) error {
+	if prevPeriodReset != nil && !prevPeriodReset.Equal(usage.PeriodReset) {
+		if err := k.SponsorshipUsageQueue.Remove(ctx, collections.Join3(*prevPeriodReset, granter, grantee)); err != nil {
+			return err
+		}
+	}
+
+	if err := k.SponsorshipUsageQueue.Set(ctx, collections.Join3(usage.PeriodReset, granter, grantee), true); err != nil {
+		return err
+	}
+
+	return k.SponsorshipUsages.Set(ctx, collections.Join(granter, grantee), usage)
+}
+
+// RemoveExpiredSponsorshipUsages removes, up to limit, the sponsorship usages
+// whose period ended. The fees paid for a grantee in an ended period no longer
+// count towards the daily limit, so they do not need to be kept.
+func (k Keeper) RemoveExpiredSponsorshipUsages(ctx context.Context, limit int32) error {
+	rng := collections.NewPrefixUntilTripleRange[time.Time, sdk.AccAddress, sdk.AccAddress](sdk.UnwrapSDKContext(ctx).BlockTime())
+
+	var keys []collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress]
+	err := k.SponsorshipUsageQueue.Walk(ctx, rng, func(key collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], _ bool) (bool, error) {
+		keys = append(keys, key)
+		return int32(len(keys)) == limit, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.SponsorshipUsages.Remove(ctx, collections.Join(key.K2(), key.K3())); err != nil {
+			return err
+		}
+		if err := k.SponsorshipUsageQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+func (suite *KeeperTestSuite) TestSponsorship() {
+	ctx := suite.ctx.WithBlockTime(time.Now())
+	granter, grantee, otherGrantee := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	send := &banktypes.MsgSend{}
+	atom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("atom", amount)) }
+
+	allowance := feegrant.SponsorshipAllowance{
+		SpendLimit:           atom(150),
+		DailyLimitPerGrantee: atom(60),
+		AllowedMessages:      []string{sdk.MsgTypeURL(send)},
+	}
+
+	// a sponsorship cannot be granted with an invalid allowance or expiring in the past
+	_, err := suite.msgSrvr.GrantSponsorship(ctx, &feegrant.MsgGrantSponsorship{Granter: granter.String()})
+	suite.Require().Error(err)
+	yesterday := ctx.BlockTime().AddDate(0, 0, -1)
+	expired := allowance
+	expired.Expiration = &yesterday
+	_, err = suite.msgSrvr.GrantSponsorship(ctx, &feegrant.MsgGrantSponsorship{Granter: granter.String(), Allowance: expired})
+	suite.Require().Error(err)
+
+	// without a sponsorship, the fees of the grantee cannot be paid
+	err = suite.feegrantKeeper.UseGrantedFees(ctx, granter, grantee, atom(10), []sdk.Msg{send})
+	suite.Require().Error(err)
+
+	_, err = suite.msgSrvr.GrantSponsorship(ctx, &feegrant.MsgGrantSponsorship{Granter: granter.String(), Allowance: allowance})
+	suite.Require().NoError(err)
+
+	res, err := suite.feegrantKeeper.Sponsorship(ctx, &feegrant.QuerySponsorshipRequest{Granter: granter.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(allowance, res.Sponsorship.Allowance)
+
+	// the fees of any grantee are paid, as long as the tx only contains allowed messages
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, granter, grantee, atom(40), []sdk.Msg{send}))
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, granter, otherGrantee, atom(50), []sdk.Msg{send}))
+	err = suite.feegrantKeeper.UseGrantedFees(ctx, granter, grantee, atom(10), []sdk.Msg{send, &feegrant.MsgRevokeSponsorship{}})
+	suite.Require().ErrorIs(err, feegrant.ErrMessageNotAllowed)
+
+	// the daily limit applies per grantee
+	err = suite.feegrantKeeper.UseGrantedFees(ctx, granter, grantee, atom(30), []sdk.Msg{send})
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, granter, grantee, atom(20), []sdk.Msg{send}))
+
+	usage, err := suite.feegrantKeeper.SponsorshipUsage(ctx, &feegrant.QuerySponsorshipUsageRequest{Granter: granter.String(), Grantee: grantee.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(atom(60), usage.Usage.PeriodSpent)
+	suite.Require().Equal(ctx.BlockTime().Add(feegrant.SponsorshipPeriod), usage.Usage.PeriodReset)
+
+	sponsorship, err := suite.feegrantKeeper.Sponsorships.Get(ctx, granter)
+	suite.Require().NoError(err)
+	suite.Require().Equal(atom(40), sponsorship.Allowance.SpendLimit)
+
+	// an explicit allowance of the grantee takes precedence over the sponsorship
+	err = suite.feegrantKeeper.GrantAllowance(ctx, granter, grantee, &feegrant.BasicAllowance{SpendLimit: atom(100)})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, granter, grantee, atom(100), []sdk.Msg{send}))
+
+	// the daily limit is reset the next day, and the sponsorship is removed once its spend limit is used up
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(feegrant.SponsorshipPeriod))
+	err = suite.feegrantKeeper.UseGrantedFees(ctx, granter, grantee, atom(50), []sdk.Msg{send})
+	suite.Require().ErrorIs(err, feegrant.ErrFeeLimitExceeded)
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, granter, grantee, atom(40), []sdk.Msg{send}))
+
+	has, err := suite.feegrantKeeper.Sponsorships.Has(ctx, granter)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+	has, err = suite.feegrantKeeper.SponsorshipUsages.Has(ctx, collections.Join(granter, otherGrantee))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	_, err = suite.feegrantKeeper.Sponsorship(ctx, &feegrant.QuerySponsorshipRequest{Granter: granter.String()})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestRevokeSponsorship() {
+	ctx := suite.ctx.WithBlockTime(time.Now())
+	granter, grantee := suite.addrs[0], suite.addrs[1]
+	send := &banktypes.MsgSend{}
+
+	_, err := suite.msgSrvr.RevokeSponsorship(ctx, &feegrant.MsgRevokeSponsorship{Granter: granter.String()})
+	suite.Require().ErrorIs(err, feegrant.ErrNoAllowance)
+
+	err = suite.feegrantKeeper.GrantSponsorship(ctx, granter, feegrant.SponsorshipAllowance{
+		DailyLimitPerGrantee: suite.atom,
+		AllowedMessages:      []string{sdk.MsgTypeURL(send)},
+	})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, granter, grantee, suite.atom, []sdk.Msg{send}))
+
+	_, err = suite.msgSrvr.RevokeSponsorship(ctx, &feegrant.MsgRevokeSponsorship{Granter: granter.String()})
+	suite.Require().NoError(err)
+
+	err = suite.feegrantKeeper.UseGrantedFees(ctx, granter, grantee, suite.atom, []sdk.Msg{send})
+	suite.Require().Error(err)
+
+	_, err = suite.feegrantKeeper.SponsorshipUsage(ctx, &feegrant.QuerySponsorshipUsageRequest{Granter: granter.String(), Grantee: grantee.String()})
+	suite.Require().Error(err)
+
+	// the usages are no longer queued for removal
+	iter, err := suite.feegrantKeeper.SponsorshipUsageQueue.Iterate(ctx, nil)
+	suite.Require().NoError(err)
+	keys, err := iter.Keys()
+	suite.Require().NoError(err)
+	suite.Require().Empty(keys)
+}
+
+func (suite *KeeperTestSuite) TestRemoveExpiredSponsorshipUsages() {
+	ctx := suite.ctx.WithBlockTime(time.Now())
+	granter, grantee, otherGrantee := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	send := &banktypes.MsgSend{}
+
+	err := suite.feegrantKeeper.GrantSponsorship(ctx, granter, feegrant.SponsorshipAllowance{
+		DailyLimitPerGrantee: suite.atom,
+		AllowedMessages:      []string{sdk.MsgTypeURL(send)},
+	})
+	suite.Require().NoError(err)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 1))
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, granter, grantee, fee, []sdk.Msg{send}))
+
+	// the usage of the other grantee starts later, and ends later
+	otherCtx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(otherCtx, granter, otherGrantee, fee, []sdk.Msg{send}))
+
+	hasUsage := func(grantee sdk.AccAddress) bool {
+		has, err := suite.feegrantKeeper.SponsorshipUsages.Has(ctx, collections.Join(granter, grantee))
+		suite.Require().NoError(err)
+		return has
+	}
+
+	// usages are kept until their period ends
+	suite.Require().NoError(suite.feegrantKeeper.RemoveExpiredSponsorshipUsages(otherCtx, 5))
+	suite.Require().True(hasUsage(grantee))
+	suite.Require().True(hasUsage(otherGrantee))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(feegrant.SponsorshipPeriod))
+	suite.Require().NoError(suite.feegrantKeeper.RemoveExpiredSponsorshipUsages(ctx, 5))
+	suite.Require().False(hasUsage(grantee))
+	suite.Require().True(hasUsage(otherGrantee))
+
+	// a usage spent again in a new period before being removed is kept until
+	// the new period ends
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	suite.Require().NoError(suite.feegrantKeeper.UseGrantedFees(ctx, granter, otherGrantee, fee, []sdk.Msg{send}))
+	suite.Require().NoError(suite.feegrantKeeper.RemoveExpiredSponsorshipUsages(ctx, 5))
+	suite.Require().True(hasUsage(otherGrantee))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(feegrant.SponsorshipPeriod))
+	suite.Require().NoError(suite.feegrantKeeper.RemoveExpiredSponsorshipUsages(ctx, 5))
+	suite.Require().False(hasUsage(otherGrantee))
+
+	iter, err := suite.feegrantKeeper.SponsorshipUsageQueue.Iterate(ctx, nil)
+	suite.Require().NoError(err)
+	keys, err := iter.Keys()
+	suite.Require().NoError(err)
+	suite.Require().Empty(keys)
+}
@@ -26,4 +26,17 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = collections.NewPrefix(1)
+
+	// SponsorshipKeyPrefix is the set of the kvstore for sponsorship data
+	// - 0x02<granter_address_bytes>: sponsorship
+	SponsorshipKeyPrefix = collections.NewPrefix(2)
+
+	// SponsorshipUsageKeyPrefix is the set of the kvstore for sponsorship usage data
+	// - 0x03<granter_address_bytes><grantee_address_bytes>: sponsorship usage
+	SponsorshipUsageKeyPrefix = collections.NewPrefix(3)
+
+	// SponsorshipUsageQueueKeyPrefix is the set of the kvstore for the sponsorship
+	// usages by end of period
+	// - 0x04<period_reset><granter_address_bytes><grantee_address_bytes>: <empty value>
+	SponsorshipUsageQueueKeyPrefix = collections.NewPrefix(4)
 )
@@ -8,5 +8,9 @@ import (
 
 func EndBlocker(ctx context.Context, k keeper.Keeper) error {
 	// 200 is an arbitrary value, we can change it later if needed
-	return k.RemoveExpiredAllowances(ctx, 200)
+	if err := k.RemoveExpiredAllowances(ctx, 200); err != nil {
+		return err
+	}
+
+	return k.RemoveExpiredSponsorshipUsages(ctx, 200)
 }
@@ -44,6 +44,25 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "granter"},
 					},
 				},
+				{
+					RpcMethod: "Sponsorship",
+					Use:       "sponsorship [granter]",
+					Short:     "Query the sponsorship of a granter",
+					Example:   fmt.Sprintf(`$ %s query feegrant sponsorship [granter]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+					},
+				},
+				{
+					RpcMethod: "SponsorshipUsage",
+					Use:       "sponsorship-usage [granter] [grantee]",
+					Short:     "Query the fees sponsored by a granter for a grantee in the current day",
+					Example:   fmt.Sprintf(`$ %s query feegrant sponsorship-usage [granter] [grantee]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+						{ProtoField: "grantee"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -60,6 +79,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "grantee"},
 					},
 				},
+				{
+					RpcMethod: "RevokeSponsorship",
+					Use:       "revoke-sponsorship",
+					Short:     "Revoke the fee sponsorship of the granter",
+					Example:   fmt.Sprintf(`$ %s tx feegrant revoke-sponsorship --from [granter]`, version.AppName),
+				},
 				{
 					RpcMethod: "PruneAllowances",
 					Use:       "prune",
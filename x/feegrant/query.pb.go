@@ -349,6 +349,199 @@ func (m *QueryAllowancesByGranterResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QuerySponsorshipRequest is the request type for the Query/Sponsorship RPC method.
+type QuerySponsorshipRequest struct {
+	// granter is the address of the user sponsoring the txs of any grantee.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+}
+
+func (m *QuerySponsorshipRequest) Reset()         { *m = QuerySponsorshipRequest{} }
+func (m *QuerySponsorshipRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipRequest) ProtoMessage()    {}
+func (*QuerySponsorshipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{6}
+}
+func (m *QuerySponsorshipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorshipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipRequest.Merge(m, src)
+}
+func (m *QuerySponsorshipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorshipRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+// QuerySponsorshipResponse is the response type for the Query/Sponsorship RPC method.
+type QuerySponsorshipResponse struct {
+	// sponsorship is the sponsorship of the granter.
+	Sponsorship *Sponsorship `protobuf:"bytes,1,opt,name=sponsorship,proto3" json:"sponsorship,omitempty"`
+}
+
+func (m *QuerySponsorshipResponse) Reset()         { *m = QuerySponsorshipResponse{} }
+func (m *QuerySponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipResponse) ProtoMessage()    {}
+func (*QuerySponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{7}
+}
+func (m *QuerySponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipResponse.Merge(m, src)
+}
+func (m *QuerySponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorshipResponse) GetSponsorship() *Sponsorship {
+	if m != nil {
+		return m.Sponsorship
+	}
+	return nil
+}
+
+// QuerySponsorshipUsageRequest is the request type for the Query/SponsorshipUsage RPC method.
+type QuerySponsorshipUsageRequest struct {
+	// granter is the address of the user sponsoring the txs of the grantee.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee is the address of the user whose txs are sponsored.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+}
+
+func (m *QuerySponsorshipUsageRequest) Reset()         { *m = QuerySponsorshipUsageRequest{} }
+func (m *QuerySponsorshipUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipUsageRequest) ProtoMessage()    {}
+func (*QuerySponsorshipUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{8}
+}
+func (m *QuerySponsorshipUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorshipUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipUsageRequest.Merge(m, src)
+}
+func (m *QuerySponsorshipUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipUsageRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorshipUsageRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *QuerySponsorshipUsageRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+// QuerySponsorshipUsageResponse is the response type for the Query/SponsorshipUsage RPC method.
+type QuerySponsorshipUsageResponse struct {
+	// usage is the usage of the sponsorship by the grantee.
+	Usage *SponsorshipUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (m *QuerySponsorshipUsageResponse) Reset()         { *m = QuerySponsorshipUsageResponse{} }
+func (m *QuerySponsorshipUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipUsageResponse) ProtoMessage()    {}
+func (*QuerySponsorshipUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{9}
+}
+func (m *QuerySponsorshipUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorshipUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipUsageResponse.Merge(m, src)
+}
+func (m *QuerySponsorshipUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipUsageResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorshipUsageResponse) GetUsage() *SponsorshipUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAllowanceRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceRequest")
 	proto.RegisterType((*QueryAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceResponse")
@@ -356,6 +549,10 @@ func init() {
 	proto.RegisterType((*QueryAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesResponse")
 	proto.RegisterType((*QueryAllowancesByGranterRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterRequest")
 	proto.RegisterType((*QueryAllowancesByGranterResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterResponse")
+	proto.RegisterType((*QuerySponsorshipRequest)(nil), "cosmos.feegrant.v1beta1.QuerySponsorshipRequest")
+	proto.RegisterType((*QuerySponsorshipResponse)(nil), "cosmos.feegrant.v1beta1.QuerySponsorshipResponse")
+	proto.RegisterType((*QuerySponsorshipUsageRequest)(nil), "cosmos.feegrant.v1beta1.QuerySponsorshipUsageRequest")
+	proto.RegisterType((*QuerySponsorshipUsageResponse)(nil), "cosmos.feegrant.v1beta1.QuerySponsorshipUsageResponse")
 }
 
 func init() {
@@ -363,42 +560,51 @@ func init() {
 }
 
 var fileDescriptor_59efc303945de53f = []byte{
-	// 548 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x4f, 0x6b, 0x13, 0x41,
-	0x18, 0xc6, 0x33, 0xf5, 0x1f, 0x99, 0x1e, 0x84, 0xa9, 0xda, 0xb8, 0xc8, 0x1a, 0x56, 0x68, 0xfd,
-	0x43, 0x66, 0xda, 0xa8, 0xa5, 0x8a, 0x14, 0x12, 0xc4, 0x5c, 0x35, 0x82, 0x07, 0x2f, 0x32, 0x49,
-	0x5e, 0xd7, 0xc5, 0x74, 0x27, 0xdd, 0xd9, 0xa8, 0x45, 0x8a, 0xe0, 0x27, 0x10, 0xf4, 0x13, 0x78,
-	0xf0, 0xe4, 0xa9, 0xf4, 0x03, 0x78, 0x14, 0x4f, 0xa1, 0x5e, 0x3c, 0x4a, 0xe2, 0x07, 0x91, 0xcc,
-	0xce, 0xec, 0xc6, 0x64, 0x97, 0x2c, 0xea, 0xa1, 0xb7, 0x4c, 0xe6, 0x79, 0xde, 0xf9, 0x3d, 0xef,
-	0x3b, 0x93, 0xe0, 0x4b, 0x6d, 0x21, 0xb7, 0x85, 0x64, 0x4f, 0x01, 0xdc, 0x80, 0xfb, 0x21, 0x7b,
-	0xb1, 0xde, 0x82, 0x90, 0xaf, 0xb3, 0x9d, 0x3e, 0x04, 0xbb, 0xb4, 0x17, 0x88, 0x50, 0x90, 0xe5,
-	0x48, 0x44, 0x8d, 0x88, 0x6a, 0x91, 0xb5, 0x92, 0xe5, 0x8e, 0x95, 0xaa, 0x80, 0x75, 0x55, 0xeb,
-	0x5a, 0x5c, 0x42, 0x54, 0x39, 0x56, 0xf6, 0xb8, 0xeb, 0xf9, 0x3c, 0xf4, 0x84, 0xaf, 0xb5, 0x17,
-	0x5c, 0x21, 0xdc, 0x2e, 0x30, 0xde, 0xf3, 0x18, 0xf7, 0x7d, 0x11, 0xaa, 0x4d, 0xa9, 0x77, 0xcf,
-	0x47, 0x95, 0x9e, 0xa8, 0x15, 0xd3, 0x5c, 0x6a, 0xe1, 0xbc, 0xc1, 0x67, 0x1f, 0x8c, 0x4b, 0xd7,
-	0xba, 0x5d, 0xf1, 0x92, 0xfb, 0x6d, 0x68, 0xc2, 0x4e, 0x1f, 0x64, 0x48, 0xaa, 0xf8, 0x94, 0x82,
-	0x81, 0xa0, 0x84, 0xca, 0xe8, 0x72, 0xb1, 0x5e, 0x3a, 0x3c, 0xa8, 0x9c, 0xd1, 0xde, 0x5a, 0xa7,
-	0x13, 0x80, 0x94, 0x0f, 0xc3, 0xc0, 0xf3, 0xdd, 0xa6, 0x11, 0x26, 0x1e, 0x28, 0x2d, 0xe4, 0xf3,
-	0x80, 0xf3, 0x08, 0x9f, 0x9b, 0x06, 0x90, 0x3d, 0xe1, 0x4b, 0x20, 0x77, 0x70, 0x91, 0x9b, 0x2f,
-	0x15, 0xc3, 0x62, 0xd5, 0xa6, 0x19, 0x4d, 0xa5, 0x8d, 0xf1, 0xaa, 0x99, 0x18, 0x9c, 0x0f, 0x68,
-	0xba, 0xb0, 0x9c, 0x89, 0x06, 0x79, 0xa3, 0x01, 0xb9, 0x87, 0x71, 0xd2, 0x74, 0x95, 0x6e, 0xb1,
-	0xba, 0x62, 0x68, 0xc6, 0x13, 0xa2, 0xd1, 0xec, 0x0d, 0xcf, 0x7d, 0xee, 0x9a, 0x56, 0x36, 0x27,
-	0x9c, 0xce, 0x47, 0x84, 0x97, 0x67, 0xb0, 0x74, 0xe0, 0x2d, 0x8c, 0x63, 0x7e, 0x59, 0x42, 0xe5,
-	0x63, 0x39, 0x12, 0x4f, 0x38, 0x48, 0x23, 0x85, 0x71, 0x75, 0x2e, 0x63, 0x74, 0xf8, 0x1f, 0x90,
-	0xfb, 0x08, 0x5f, 0x9c, 0x82, 0xac, 0xef, 0x36, 0xa2, 0x21, 0xff, 0xcb, 0xfd, 0xf8, 0x4f, 0x4d,
-	0xbc, 0xbd, 0x74, 0x78, 0x50, 0x39, 0x1d, 0xd9, 0x2a, 0xb2, 0xf3, 0xbc, 0xbc, 0x46, 0x6f, 0x6c,
-	0x38, 0x5f, 0x10, 0x2e, 0x67, 0x43, 0x1f, 0xb1, 0x16, 0xa7, 0x46, 0xa8, 0xee, 0x1f, 0xc7, 0x27,
-	0x54, 0x04, 0xf2, 0x19, 0xe1, 0x62, 0x9c, 0x83, 0xd0, 0x4c, 0xc2, 0xd4, 0xb7, 0x6b, 0xb1, 0xdc,
-	0xfa, 0x88, 0xcc, 0xd9, 0x7a, 0xfb, 0xfd, 0xd7, 0xfb, 0x85, 0x4d, 0xb2, 0xc1, 0xb2, 0x7e, 0x9b,
-	0xe2, 0x1e, 0xb0, 0xd7, 0x7a, 0x9a, 0x7b, 0xe6, 0x13, 0xec, 0x91, 0x4f, 0x08, 0xe3, 0xa4, 0xed,
-	0x24, 0xef, 0xf9, 0xe6, 0x45, 0x5a, 0x6b, 0xf9, 0x0d, 0x9a, 0xf8, 0xa6, 0x22, 0x66, 0xa4, 0x32,
-	0x9f, 0x58, 0x4e, 0x80, 0x0e, 0x10, 0x5e, 0x4a, 0xb9, 0x1f, 0x64, 0x33, 0x2f, 0xc0, 0xf4, 0x3b,
-	0xb0, 0x6e, 0xfd, 0x85, 0x53, 0x67, 0xb8, 0xfb, 0x6d, 0xf6, 0x0e, 0xa8, 0x58, 0xd7, 0xc8, 0x95,
-	0xcc, 0x58, 0x9e, 0x94, 0x7d, 0xe8, 0x24, 0x53, 0xa8, 0xd7, 0xbe, 0x0e, 0x6d, 0x34, 0x18, 0xda,
-	0xe8, 0xe7, 0xd0, 0x46, 0xef, 0x46, 0x76, 0x61, 0x30, 0xb2, 0x0b, 0x3f, 0x46, 0x76, 0xe1, 0xf1,
-	0xaa, 0xeb, 0x85, 0xcf, 0xfa, 0x2d, 0xda, 0x16, 0xdb, 0xa6, 0x5c, 0x72, 0x1a, 0x7b, 0x15, 0xd7,
-	0x6e, 0x9d, 0x54, 0xff, 0x05, 0xd7, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x6e, 0x7e, 0x91, 0x26,
-	0xd8, 0x06, 0x00, 0x00,
+	// 694 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x4d, 0x6b, 0xd4, 0x5c,
+	0x14, 0xc7, 0x7b, 0xfb, 0xd0, 0xa7, 0xf4, 0xcc, 0x42, 0xb9, 0x55, 0x3b, 0x86, 0x1a, 0x87, 0x28,
+	0xad, 0x55, 0x26, 0x69, 0xc7, 0xb6, 0xd4, 0x22, 0x95, 0x16, 0x69, 0x71, 0xa7, 0x53, 0x74, 0xe1,
+	0x46, 0x32, 0x33, 0xd7, 0x34, 0x38, 0xcd, 0x9d, 0xe6, 0x26, 0xd6, 0x22, 0x45, 0xf0, 0x13, 0x08,
+	0xba, 0x73, 0xe7, 0xc2, 0x95, 0x2b, 0xe9, 0x56, 0x70, 0x29, 0x75, 0x33, 0x54, 0x10, 0x97, 0x32,
+	0xe3, 0x07, 0x91, 0xb9, 0xb9, 0x79, 0x31, 0x2f, 0x4c, 0xc6, 0x8a, 0xb8, 0x6b, 0x92, 0xf3, 0x3f,
+	0xe7, 0x77, 0xfe, 0xf7, 0xdc, 0xd3, 0x81, 0x0b, 0x75, 0xca, 0xb6, 0x29, 0xd3, 0x1e, 0x12, 0x62,
+	0xd8, 0xba, 0xe5, 0x68, 0x8f, 0xe7, 0x6a, 0xc4, 0xd1, 0xe7, 0xb4, 0x1d, 0x97, 0xd8, 0x7b, 0x6a,
+	0xcb, 0xa6, 0x0e, 0xc5, 0x13, 0x5e, 0x90, 0xea, 0x07, 0xa9, 0x22, 0x48, 0x9a, 0xca, 0x52, 0x07,
+	0x91, 0x3c, 0x81, 0x74, 0x59, 0xc4, 0xd5, 0x74, 0x46, 0xbc, 0xcc, 0x41, 0x64, 0x4b, 0x37, 0x4c,
+	0x4b, 0x77, 0x4c, 0x6a, 0x89, 0xd8, 0x49, 0x83, 0x52, 0xa3, 0x49, 0x34, 0xbd, 0x65, 0x6a, 0xba,
+	0x65, 0x51, 0x87, 0x7f, 0x64, 0xe2, 0xeb, 0x59, 0x2f, 0xd3, 0x03, 0xfe, 0xa4, 0x09, 0x2e, 0xfe,
+	0xa0, 0x3c, 0x83, 0xd3, 0x77, 0x7a, 0xa9, 0x57, 0x9b, 0x4d, 0xba, 0xab, 0x5b, 0x75, 0x52, 0x25,
+	0x3b, 0x2e, 0x61, 0x0e, 0xae, 0xc0, 0x28, 0x87, 0x21, 0x76, 0x11, 0x95, 0xd0, 0xa5, 0xb1, 0xb5,
+	0xe2, 0xd1, 0x41, 0xf9, 0x94, 0xd0, 0xae, 0x36, 0x1a, 0x36, 0x61, 0x6c, 0xd3, 0xb1, 0x4d, 0xcb,
+	0xa8, 0xfa, 0x81, 0xa1, 0x86, 0x14, 0x87, 0xf3, 0x69, 0x88, 0x72, 0x0f, 0xce, 0xc4, 0x01, 0x58,
+	0x8b, 0x5a, 0x8c, 0xe0, 0xeb, 0x30, 0xa6, 0xfb, 0x2f, 0x39, 0x43, 0xa1, 0x22, 0xab, 0x19, 0xa6,
+	0xaa, 0x1b, 0xbd, 0xa7, 0x6a, 0x28, 0x50, 0x5e, 0xa1, 0x78, 0x62, 0x96, 0x68, 0x8d, 0xe4, 0x6d,
+	0x8d, 0xe0, 0x75, 0x80, 0xd0, 0x74, 0xde, 0x5d, 0xa1, 0x32, 0xe5, 0xd3, 0xf4, 0x4e, 0x48, 0xf5,
+	0xce, 0xde, 0xe7, 0xb9, 0xad, 0x1b, 0xbe, 0x95, 0xd5, 0x88, 0x52, 0x79, 0x83, 0x60, 0x22, 0x81,
+	0x25, 0x1a, 0x5e, 0x01, 0x08, 0xf8, 0x59, 0x11, 0x95, 0xfe, 0xcb, 0xd1, 0x71, 0x44, 0x81, 0x37,
+	0x52, 0x18, 0xa7, 0xfb, 0x32, 0x7a, 0xc5, 0x7f, 0x81, 0x7c, 0x8f, 0xe0, 0x7c, 0x0c, 0x72, 0x6d,
+	0x6f, 0xc3, 0x3b, 0xe4, 0xe3, 0xcc, 0xc7, 0x1f, 0x32, 0x71, 0x79, 0xfc, 0xe8, 0xa0, 0x7c, 0xc2,
+	0x93, 0x95, 0x59, 0xe3, 0x51, 0x69, 0x56, 0x9d, 0x5f, 0x54, 0x3e, 0x22, 0x28, 0x65, 0x43, 0xff,
+	0x63, 0x16, 0xa7, 0xb7, 0x50, 0x13, 0xb3, 0xb1, 0xd9, 0x8b, 0xa7, 0x36, 0xdb, 0x32, 0x5b, 0xc7,
+	0xb0, 0x3b, 0xa5, 0xc6, 0xc2, 0xbc, 0xb2, 0x0b, 0xc5, 0x64, 0x0d, 0xe1, 0xce, 0x3a, 0x14, 0x58,
+	0xf8, 0x5a, 0xdc, 0xb9, 0x8b, 0x99, 0xf6, 0x44, 0x53, 0x44, 0x85, 0xe9, 0x85, 0x5f, 0x23, 0x98,
+	0x8c, 0x57, 0xbe, 0xcb, 0xc2, 0x13, 0xfe, 0x5b, 0x1b, 0x27, 0x9d, 0xce, 0x85, 0x73, 0x19, 0x70,
+	0xc2, 0x9b, 0x1b, 0x30, 0xe2, 0xf6, 0x5e, 0x08, 0x57, 0x66, 0xf2, 0xb8, 0xe2, 0x65, 0xf0, 0x74,
+	0xa9, 0x65, 0x2b, 0x9f, 0x47, 0x61, 0x84, 0xd7, 0xc5, 0xef, 0x10, 0x8c, 0x05, 0x93, 0x8b, 0xd5,
+	0xcc, 0xf4, 0xa9, 0xdb, 0x5a, 0xd2, 0x72, 0xc7, 0x7b, 0xed, 0x28, 0x2b, 0xcf, 0xbf, 0xfc, 0x78,
+	0x39, 0xbc, 0x84, 0x17, 0xb5, 0xac, 0xff, 0x46, 0xc1, 0xd4, 0x6b, 0x4f, 0x85, 0xdb, 0xfb, 0xfe,
+	0x5f, 0x64, 0x1f, 0xbf, 0x45, 0x00, 0xe1, 0x45, 0xc3, 0x79, 0xeb, 0xfb, 0x3b, 0x58, 0x9a, 0xcd,
+	0x2f, 0x10, 0xc4, 0x0b, 0x9c, 0x58, 0xc3, 0xe5, 0xfe, 0xc4, 0x2c, 0x02, 0xda, 0x46, 0x30, 0x9e,
+	0xb2, 0x11, 0xf0, 0x52, 0x5e, 0x80, 0xf8, 0xe6, 0x93, 0xae, 0xfd, 0x86, 0x52, 0xf4, 0x70, 0xf3,
+	0x30, 0x79, 0xeb, 0x79, 0x5b, 0x57, 0xf0, 0x4c, 0x66, 0x5b, 0x26, 0x63, 0x2e, 0x69, 0x84, 0xa7,
+	0x80, 0x3f, 0x20, 0x28, 0x44, 0xa6, 0x0c, 0xf7, 0xf1, 0x32, 0xb9, 0x4d, 0xa4, 0xb9, 0x01, 0x14,
+	0x02, 0xfd, 0xd6, 0x61, 0x72, 0x7c, 0x39, 0xfa, 0x2c, 0x56, 0x33, 0xd1, 0x23, 0x4b, 0x21, 0xc2,
+	0xff, 0x15, 0xc1, 0xc9, 0xf8, 0x2d, 0xc1, 0x0b, 0xb9, 0x91, 0xa2, 0x4b, 0x43, 0x5a, 0x1c, 0x54,
+	0x26, 0xda, 0xd9, 0xcc, 0x6a, 0x67, 0x19, 0x2f, 0x0d, 0xd6, 0x4e, 0x38, 0x6b, 0x6b, 0xab, 0x9f,
+	0x3a, 0x32, 0x6a, 0x77, 0x64, 0xf4, 0xbd, 0x23, 0xa3, 0x17, 0x5d, 0x79, 0xa8, 0xdd, 0x95, 0x87,
+	0xbe, 0x75, 0xe5, 0xa1, 0xfb, 0xd3, 0x86, 0xe9, 0x6c, 0xb9, 0x35, 0xb5, 0x4e, 0xb7, 0xfd, 0xec,
+	0x61, 0x71, 0xed, 0x49, 0x50, 0xaa, 0xf6, 0x3f, 0xff, 0x59, 0x76, 0xf5, 0xe7, 0x00, 0x23, 0x7d,
+	0x46, 0xc2, 0x63, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -413,12 +619,17 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
-	// Allowance returns granted allwance to the grantee by the granter.
+	// Allowance returns granted allowance to the grantee by the granter.
 	Allowance(ctx context.Context, in *QueryAllowanceRequest, opts ...grpc.CallOption) (*QueryAllowanceResponse, error)
 	// Allowances returns all the grants for the given grantee address.
 	Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// Sponsorship returns the sponsorship of a granter.
+	Sponsorship(ctx context.Context, in *QuerySponsorshipRequest, opts ...grpc.CallOption) (*QuerySponsorshipResponse, error)
+	// SponsorshipUsage returns the fees paid by the sponsorship of a granter for a
+	// grantee in the current day.
+	SponsorshipUsage(ctx context.Context, in *QuerySponsorshipUsageRequest, opts ...grpc.CallOption) (*QuerySponsorshipUsageResponse, error)
 }
 
 type queryClient struct {
@@ -456,14 +667,37 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) Sponsorship(ctx context.Context, in *QuerySponsorshipRequest, opts ...grpc.CallOption) (*QuerySponsorshipResponse, error) {
+	out := new(QuerySponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/Sponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SponsorshipUsage(ctx context.Context, in *QuerySponsorshipUsageRequest, opts ...grpc.CallOption) (*QuerySponsorshipUsageResponse, error) {
+	out := new(QuerySponsorshipUsageResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/SponsorshipUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
-	// Allowance returns granted allwance to the grantee by the granter.
+	// Allowance returns granted allowance to the grantee by the granter.
 	Allowance(context.Context, *QueryAllowanceRequest) (*QueryAllowanceResponse, error)
 	// Allowances returns all the grants for the given grantee address.
 	Allowances(context.Context, *QueryAllowancesRequest) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// Sponsorship returns the sponsorship of a granter.
+	Sponsorship(context.Context, *QuerySponsorshipRequest) (*QuerySponsorshipResponse, error)
+	// SponsorshipUsage returns the fees paid by the sponsorship of a granter for a
+	// grantee in the current day.
+	SponsorshipUsage(context.Context, *QuerySponsorshipUsageRequest) (*QuerySponsorshipUsageResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -479,6 +713,12 @@ func (*UnimplementedQueryServer) Allowances(ctx context.Context, req *QueryAllow
 func (*UnimplementedQueryServer) AllowancesByGranter(ctx context.Context, req *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (*UnimplementedQueryServer) Sponsorship(ctx context.Context, req *QuerySponsorshipRequest) (*QuerySponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sponsorship not implemented")
+}
+func (*UnimplementedQueryServer) SponsorshipUsage(ctx context.Context, req *QuerySponsorshipUsageRequest) (*QuerySponsorshipUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsorshipUsage not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -538,6 +778,42 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Sponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/Sponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sponsorship(ctx, req.(*QuerySponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SponsorshipUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorshipUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsorshipUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/SponsorshipUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsorshipUsage(ctx, req.(*QuerySponsorshipUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Query",
@@ -555,6 +831,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "Sponsorship",
+			Handler:    _Query_Sponsorship_Handler,
+		},
+		{
+			MethodName: "SponsorshipUsage",
+			Handler:    _Query_SponsorshipUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
@@ -814,91 +1098,228 @@ func (m *QueryAllowancesByGranterResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySponsorshipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAllowanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Granter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QuerySponsorshipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllowanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySponsorshipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllowancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllowancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Allowances) > 0 {
-		for _, e := range m.Allowances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QuerySponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllowancesByGranterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Granter)
-	if l > 0 {
+	if m.Sponsorship != nil {
+		{
+			size, err := m.Sponsorship.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorshipUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorshipUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorshipUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorshipUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorshipUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorshipUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Usage != nil {
+		{
+			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllowancesByGranterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	if m.Pagination != nil {
@@ -927,13 +1348,269 @@ func (m *QueryAllowancesByGranterResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySponsorshipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sponsorship != nil {
+		l = m.Sponsorship.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorshipUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorshipUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &Grant{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -956,15 +1633,15 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowancesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -992,13 +1669,13 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Granter = string(dAtA[iNdEx:postIndex])
+			m.Grantee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1008,23 +1685,27 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1047,7 +1728,7 @@ func (m *QueryAllowanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1070,15 +1751,15 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1105,10 +1786,44 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Allowance == nil {
-				m.Allowance = &Grant{}
+			m.Allowances = append(m.Allowances, &Grant{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1133,7 +1848,7 @@ func (m *QueryAllowanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1156,15 +1871,15 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1192,7 +1907,7 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantee = string(dAtA[iNdEx:postIndex])
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1251,7 +1966,7 @@ func (m *QueryAllowancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1274,10 +1989,10 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1371,7 +2086,7 @@ func (m *QueryAllowancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySponsorshipRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1394,10 +2109,10 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySponsorshipRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySponsorshipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1432,9 +2147,59 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorshipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorshipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorship", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1461,10 +2226,10 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.Sponsorship == nil {
+				m.Sponsorship = &Sponsorship{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Sponsorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1489,7 +2254,7 @@ func (m *QueryAllowancesByGranterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySponsorshipUsageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1512,17 +2277,17 @@ func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySponsorshipUsageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllowancesByGranterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySponsorshipUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1532,29 +2297,109 @@ func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowances = append(m.Allowances, &Grant{})
-			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorshipUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorshipUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorshipUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1581,10 +2426,10 @@ func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Usage == nil {
+				m.Usage = &SponsorshipUsage{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
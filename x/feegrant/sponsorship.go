@@ -0,0 +1,131 @@
+package feegrant
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SponsorshipPeriod is the period over which the daily limit of the grantees
+// of a sponsorship applies.
+const SponsorshipPeriod = 24 * time.Hour
+
+// Accept checks the fee payment of a tx of a grantee of the sponsorship, and
+// deducts the fee from the spend limit of the sponsorship. The daily limit per
+// grantee is checked by Keeper.UseGrantedFees, which tracks the fees paid for
+// each grantee.
+//
+// If remove is true (regardless of the error), the sponsorship is deleted from
+// storage, i.e. when it expired or its spend limit is used up.
+func (a *SponsorshipAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	if a.Expiration != nil && sdk.UnwrapSDKContext(ctx).BlockTime().After(*a.Expiration) {
+		return true, errorsmod.Wrap(ErrFeeLimitExpired, "sponsorship")
+	}
+
+	for _, msg := range msgs {
+		if err := a.acceptMsg(msg); err != nil {
+			return false, err
+		}
+	}
+
+	if a.SpendLimit != nil {
+		left, isNeg := a.SpendLimit.SafeSub(fee...)
+		if isNeg {
+			return false, errorsmod.Wrap(ErrFeeLimitExceeded, "sponsorship limit")
+		}
+
+		a.SpendLimit = left
+		return left.IsZero(), nil
+	}
+
+	return false, nil
+}
+
+// acceptMsg returns an error if msg cannot be sponsored, i.e. if its type is not
+// allowed or it does not target an allowed contract.
+func (a *SponsorshipAllowance) acceptMsg(msg sdk.Msg) error {
+	typeURL := sdk.MsgTypeURL(msg)
+	if len(a.AllowedMessages) > 0 && !slices.Contains(a.AllowedMessages, typeURL) {
+		return errorsmod.Wrapf(ErrMessageNotAllowed, "message %s is not sponsored", typeURL)
+	}
+
+	if len(a.AllowedContracts) > 0 {
+		contractMsg, ok := msg.(ContractMsg)
+		if !ok {
+			return errorsmod.Wrapf(ErrMessageNotAllowed, "message %s does not target a contract", typeURL)
+		}
+		if contract := contractMsg.GetContract(); !slices.Contains(a.AllowedContracts, contract) {
+			return errorsmod.Wrapf(ErrMessageNotAllowed, "contract %s is not sponsored", contract)
+		}
+	}
+
+	return nil
+}
+
+// ContractMsg is implemented by the messages targeting a contract, such as the
+// CosmWasm execute messages, which a sponsorship can restrict to its allowed
+// contracts.
+type ContractMsg interface {
+	sdk.Msg
+
+	// GetContract returns the address of the contract targeted by the message.
+	GetContract() string
+}
+
+// ValidateBasic performs basic sanity checks of the sponsorship allowance.
+func (a SponsorshipAllowance) ValidateBasic() error {
+	if a.SpendLimit != nil {
+		if !a.SpendLimit.IsValid() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "send amount is invalid: %s", a.SpendLimit)
+		}
+		if !a.SpendLimit.IsAllPositive() {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "spend limit must be positive")
+		}
+	}
+
+	if !a.DailyLimitPerGrantee.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "daily limit per grantee is invalid: %s", a.DailyLimitPerGrantee)
+	}
+	if !a.DailyLimitPerGrantee.IsAllPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "daily limit per grantee must be positive")
+	}
+
+	if a.Expiration != nil && a.Expiration.Unix() < 0 {
+		return errorsmod.Wrap(ErrInvalidDuration, "expiration time cannot be negative")
+	}
+
+	if len(a.AllowedMessages) == 0 && len(a.AllowedContracts) == 0 {
+		return errorsmod.Wrap(ErrNoMessages, "sponsorship must allow messages or contracts")
+	}
+	if slices.Contains(a.AllowedMessages, "") {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "allowed message type URL cannot be empty")
+	}
+	if slices.Contains(a.AllowedContracts, "") {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "allowed contract address cannot be empty")
+	}
+
+	return nil
+}
+
+// Spend adds fee to the coins spent by the grantee in the current day, starting
+// a new day at blockTime if the current one ended, and returns an error if the
+// daily limit is exceeded.
+func (u *SponsorshipUsage) Spend(blockTime time.Time, fee sdk.Coins, dailyLimit sdk.Coins) error {
+	if !blockTime.Before(u.PeriodReset) {
+		u.PeriodSpent = sdk.NewCoins()
+		u.PeriodReset = blockTime.Add(SponsorshipPeriod)
+	}
+
+	spent := u.PeriodSpent.Add(fee...)
+	if !spent.IsAllLTE(dailyLimit) {
+		return errorsmod.Wrap(ErrFeeLimitExceeded, "sponsorship daily limit")
+	}
+
+	u.PeriodSpent = spent
+	return nil
+}
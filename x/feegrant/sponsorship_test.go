@@ -0,0 +1,211 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v2"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// executeContractMsg mimics the CosmWasm messages executing a contract.
+type executeContractMsg struct {
+	testdata.TestMsg
+	Contract string
+}
+
+func (*executeContractMsg) XXX_MessageName() string { return "cosmwasm.wasm.v1.MsgExecuteContract" }
+
+func (msg *executeContractMsg) GetContract() string { return msg.Contract }
+
+func TestSponsorshipValidateBasic(t *testing.T) {
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	msgs := []string{"/cosmos.gov.v1.MsgVote"}
+	negative := time.Unix(-1, 0)
+
+	cases := map[string]struct {
+		allowance feegrant.SponsorshipAllowance
+		valid     bool
+	}{
+		"messages": {
+			allowance: feegrant.SponsorshipAllowance{DailyLimitPerGrantee: atom, AllowedMessages: msgs},
+			valid:     true,
+		},
+		"contracts with spend limit": {
+			allowance: feegrant.SponsorshipAllowance{SpendLimit: atom, DailyLimitPerGrantee: atom, AllowedContracts: []string{"contract"}},
+			valid:     true,
+		},
+		"no daily limit": {
+			allowance: feegrant.SponsorshipAllowance{AllowedMessages: msgs},
+		},
+		"zero spend limit": {
+			allowance: feegrant.SponsorshipAllowance{SpendLimit: sdk.Coins{sdk.NewInt64Coin("atom", 0)}, DailyLimitPerGrantee: atom, AllowedMessages: msgs},
+		},
+		"negative expiration": {
+			allowance: feegrant.SponsorshipAllowance{DailyLimitPerGrantee: atom, AllowedMessages: msgs, Expiration: &negative},
+		},
+		"nothing allowed": {
+			allowance: feegrant.SponsorshipAllowance{DailyLimitPerGrantee: atom},
+		},
+		"empty message": {
+			allowance: feegrant.SponsorshipAllowance{DailyLimitPerGrantee: atom, AllowedMessages: []string{""}},
+		},
+		"empty contract": {
+			allowance: feegrant.SponsorshipAllowance{DailyLimitPerGrantee: atom, AllowedContracts: []string{""}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.allowance.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSponsorshipAccept(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeader(cmtproto.Header{Time: time.Now()})
+
+	atom := sdk.NewCoins(sdk.NewInt64Coin("atom", 555))
+	smallAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 43))
+	leftAtom := sdk.NewCoins(sdk.NewInt64Coin("atom", 512))
+	now := ctx.BlockTime()
+	oneHour := now.Add(time.Hour)
+
+	send := &banktypes.MsgSend{}
+	execute := &executeContractMsg{Contract: "contract"}
+	executeOther := &executeContractMsg{Contract: "other"}
+
+	cases := map[string]struct {
+		allowance feegrant.SponsorshipAllowance
+		msgs      []sdk.Msg
+		fee       sdk.Coins
+		blockTime time.Time
+		accept    bool
+		remove    bool
+		remains   sdk.Coins
+	}{
+		"allowed message": {
+			allowance: feegrant.SponsorshipAllowance{AllowedMessages: []string{sdk.MsgTypeURL(send)}},
+			msgs:      []sdk.Msg{send},
+			fee:       smallAtom,
+			accept:    true,
+		},
+		"message not allowed": {
+			allowance: feegrant.SponsorshipAllowance{AllowedMessages: []string{"/cosmos.gov.v1.MsgVote"}},
+			msgs:      []sdk.Msg{send},
+			fee:       smallAtom,
+		},
+		"allowed contract": {
+			allowance: feegrant.SponsorshipAllowance{AllowedContracts: []string{"contract"}},
+			msgs:      []sdk.Msg{execute, execute},
+			fee:       smallAtom,
+			accept:    true,
+		},
+		"contract not allowed": {
+			allowance: feegrant.SponsorshipAllowance{AllowedContracts: []string{"contract"}},
+			msgs:      []sdk.Msg{execute, executeOther},
+			fee:       smallAtom,
+		},
+		"no contract": {
+			allowance: feegrant.SponsorshipAllowance{AllowedContracts: []string{"contract"}},
+			msgs:      []sdk.Msg{send},
+			fee:       smallAtom,
+		},
+		"allowed message and contract": {
+			allowance: feegrant.SponsorshipAllowance{AllowedMessages: []string{sdk.MsgTypeURL(execute)}, AllowedContracts: []string{"contract"}},
+			msgs:      []sdk.Msg{execute},
+			fee:       smallAtom,
+			accept:    true,
+		},
+		"spend limit": {
+			allowance: feegrant.SponsorshipAllowance{SpendLimit: atom, AllowedMessages: []string{sdk.MsgTypeURL(send)}},
+			msgs:      []sdk.Msg{send},
+			fee:       smallAtom,
+			accept:    true,
+			remains:   leftAtom,
+		},
+		"spend limit used up": {
+			allowance: feegrant.SponsorshipAllowance{SpendLimit: atom, AllowedMessages: []string{sdk.MsgTypeURL(send)}},
+			msgs:      []sdk.Msg{send},
+			fee:       atom,
+			accept:    true,
+			remove:    true,
+		},
+		"spend limit exceeded": {
+			allowance: feegrant.SponsorshipAllowance{SpendLimit: smallAtom, AllowedMessages: []string{sdk.MsgTypeURL(send)}},
+			msgs:      []sdk.Msg{send},
+			fee:       atom,
+		},
+		"expired": {
+			allowance: feegrant.SponsorshipAllowance{Expiration: &now, AllowedMessages: []string{sdk.MsgTypeURL(send)}},
+			msgs:      []sdk.Msg{send},
+			fee:       smallAtom,
+			blockTime: oneHour,
+			remove:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := ctx
+			if !tc.blockTime.IsZero() {
+				ctx = ctx.WithBlockTime(tc.blockTime)
+			}
+
+			remove, err := tc.allowance.Accept(ctx, tc.fee, tc.msgs)
+			if tc.accept {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+			require.Equal(t, tc.remove, remove)
+
+			if tc.accept && !tc.remove && tc.allowance.SpendLimit != nil {
+				require.Equal(t, tc.remains, tc.allowance.SpendLimit)
+			}
+		})
+	}
+}
+
+func TestSponsorshipUsageSpend(t *testing.T) {
+	dailyLimit := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	now := time.Now()
+
+	usage := feegrant.SponsorshipUsage{}
+
+	// the first use starts the day
+	require.NoError(t, usage.Spend(now, sdk.NewCoins(sdk.NewInt64Coin("atom", 60)), dailyLimit))
+	require.Equal(t, now.Add(feegrant.SponsorshipPeriod), usage.PeriodReset)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 60)), usage.PeriodSpent)
+
+	// the daily limit cannot be exceeded, nor fees paid in other denoms
+	err := usage.Spend(now.Add(time.Hour), sdk.NewCoins(sdk.NewInt64Coin("atom", 41)), dailyLimit)
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+	err = usage.Spend(now.Add(time.Hour), sdk.NewCoins(sdk.NewInt64Coin("eth", 1)), dailyLimit)
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 60)), usage.PeriodSpent)
+
+	require.NoError(t, usage.Spend(now.Add(time.Hour), sdk.NewCoins(sdk.NewInt64Coin("atom", 40)), dailyLimit))
+	require.Equal(t, dailyLimit, usage.PeriodSpent)
+
+	// a new day starts once the current one ended
+	nextDay := now.Add(feegrant.SponsorshipPeriod)
+	require.NoError(t, usage.Spend(nextDay, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), dailyLimit))
+	require.Equal(t, nextDay.Add(feegrant.SponsorshipPeriod), usage.PeriodReset)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), usage.PeriodSpent)
+}
@@ -137,6 +137,10 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 
 		var tagValue, logMsg string
 
+		if err := keeper.EmitGovernorVotes(ctx, proposal); err != nil {
+			return err
+		}
+
 		passes, burnDeposits, tallyResults, err := keeper.Tally(ctx, proposal)
 		if err != nil {
 			return err
@@ -175,6 +179,13 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 			// message is logged.
 			cacheCtx, writeCache := ctx.CacheContext()
 			messages, err := proposal.GetMsgs()
+
+			// only the messages of the winning option of a multiple choice
+			// proposal are executed
+			if proposal.ProposalType == v1.ProposalTypeMultipleChoice {
+				proposal.WinningOption = tallyResults.WinningOption()
+				messages, err = proposal.GetOptionMsgs(proposal.WinningOption)
+			}
 			if err != nil {
 				proposal.Status = v1.StatusFailed
 				proposal.FailedReason = err.Error()
@@ -202,6 +213,9 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 				proposal.Status = v1.StatusPassed
 				tagValue = types.AttributeValueProposalPassed
 				logMsg = "passed"
+				if proposal.ProposalType == v1.ProposalTypeMultipleChoice {
+					logMsg = fmt.Sprintf("passed with option %d", proposal.WinningOption)
+				}
 
 				// write state to the underlying multi-store
 				writeCache()
@@ -234,6 +248,11 @@ func EndBlocker(ctx sdk.Context, keeper *keeper.Keeper) error {
 
 			tagValue = types.AttributeValueExpeditedProposalRejected
 			logMsg = "expedited proposal converted to regular"
+		case proposal.ProposalType == v1.ProposalTypeOptimistic:
+			proposal.Status = v1.StatusRejected
+			proposal.FailedReason = "optimistic proposal got too many votes against it"
+			tagValue = types.AttributeValueOptimisticProposalRejected
+			logMsg = "optimistic proposal rejected"
 		default:
 			proposal.Status = v1.StatusRejected
 			proposal.FailedReason = "proposal did not get enough votes to pass"
@@ -302,6 +321,7 @@ func failUnsupportedProposal(
 	proposal.Status = v1.StatusFailed
 	proposal.FailedReason = fmt.Sprintf("proposal failed because it cannot be processed by gov: %s", errMsg)
 	proposal.Messages = nil // clear out the messages
+	proposal.Options = nil
 
 	if err := keeper.SetProposal(ctx, proposal); err != nil {
 		return err
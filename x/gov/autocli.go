@@ -90,6 +90,57 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "constitution",
 					Short:     "Query the current chain constitution",
 				},
+				{
+					RpcMethod: "MessageBasedParams",
+					Use:       "message-params [msg-url]",
+					Short:     "Query the tally params of the proposals containing a message type",
+					Example:   fmt.Sprintf("%s query gov message-params /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "msg_url"},
+					},
+				},
+				{
+					RpcMethod: "ProposalTallyParams",
+					Use:       "tally-params [proposal-id]",
+					Short:     "Query the tally params applying to a proposal",
+					Example:   fmt.Sprintf("%s query gov tally-params 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "proposal_id"},
+					},
+				},
+				{
+					RpcMethod: "Governor",
+					Use:       "governor [governor-address]",
+					Short:     "Query a governor and its voting power",
+					Example:   fmt.Sprintf("%s query gov governor cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "governor_address"},
+					},
+				},
+				{
+					RpcMethod: "Governors",
+					Use:       "governors",
+					Short:     "Query all the governors",
+					Example:   fmt.Sprintf("%s query gov governors", version.AppName),
+				},
+				{
+					RpcMethod: "GovernorDelegations",
+					Use:       "governor-delegations [governor-address]",
+					Short:     "Query the delegations of a governor",
+					Example:   fmt.Sprintf("%s query gov governor-delegations cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "governor_address"},
+					},
+				},
+				{
+					RpcMethod: "GovernorDelegation",
+					Use:       "governor-delegation [delegator-address]",
+					Short:     "Query the governor delegation of a delegator",
+					Example:   fmt.Sprintf("%s query gov governor-delegation cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
 			},
 			EnhanceCustomCommand: true, // We still have manual commands in gov that we want to keep
 		},
@@ -139,6 +190,38 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "UpdateMessageParams",
+					Use:            "update-message-params-proposal [msg-url] [params]",
+					Short:          "Submit a proposal to set the tally params of the proposals containing a message type. Note: the params are removed if not provided.",
+					Example:        fmt.Sprintf(`%s tx gov update-message-params-proposal /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade '{"quorum":"0.4","threshold":"0.67","veto_threshold":"0.334"}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "msg_url"}, {ProtoField: "params", Optional: true}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod: "CreateGovernor",
+					Use:       "create-governor [metadata]",
+					Short:     "Register the sender as a governor, which accounts can delegate their voting power to",
+					Example:   fmt.Sprintf(`$ %s tx gov create-governor ipfs://CID --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "metadata", Optional: true},
+					},
+				},
+				{
+					RpcMethod: "DelegateGovernor",
+					Use:       "delegate-governor [governor-address]",
+					Short:     "Delegate the voting power of the sender to a governor, replacing its current governor if any",
+					Example:   fmt.Sprintf(`$ %s tx gov delegate-governor cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "governor_address"},
+					},
+				},
+				{
+					RpcMethod: "UndelegateGovernor",
+					Use:       "undelegate-governor",
+					Short:     "Remove the governor delegation of the sender",
+					Example:   fmt.Sprintf(`$ %s tx gov undelegate-governor --from mykey`, version.AppName),
+				},
 			},
 			EnhanceCustomCommand: false, // use custom commands only until v0.51
 		},
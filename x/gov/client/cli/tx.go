@@ -113,7 +113,31 @@ Where proposal.json contains:
   "deposit": "10stake",
   "title": "My proposal",
   "summary": "A short summary of my proposal",
-  "expedited": false
+  "expedited": false,
+  // optimistic proposals pass unless enough stake votes against them, and can
+  // only be submitted by the addresses authorized in the gov params
+  "optimistic": false
+}
+
+A multiple choice proposal defines options instead of messages, and only the
+messages of its winning option are executed:
+
+{
+  "options": [
+    {
+      "title": "Option one",
+      // array of proto-JSON-encoded sdk.Msgs executed if this option wins
+      "messages": []
+    },
+    {
+      "title": "Option two",
+      "messages": []
+    }
+  ],
+  "metadata": "4pIMOgIGx1vZGU=",
+  "deposit": "10stake",
+  "title": "My proposal",
+  "summary": "A short summary of my proposal"
 }
 
 metadata example: 
@@ -144,6 +168,16 @@ metadata example:
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			if proposal.Optimistic {
+				msg.ProposalType = v1.ProposalTypeOptimistic
+			}
+			if len(proposal.Options) > 0 {
+				msg.ProposalType = v1.ProposalTypeMultipleChoice
+				msg.Options, err = proposal.parseOptions(clientCtx.Codec)
+				if err != nil {
+					return fmt.Errorf("invalid proposal options: %w", err)
+				}
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -367,8 +401,11 @@ find the proposal-id by running "%s query gov proposals".
 
 Example:
 $ %s tx gov weighted-vote 1 yes=0.6,no=0.3,abstain=0.05,no_with_veto=0.05 --from mykey
+
+The options of a multiple choice proposal are voted for by their 1-based index:
+$ %s tx gov weighted-vote 2 1=0.6,3=0.3,abstain=0.1 --from mykey
 `,
-				version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
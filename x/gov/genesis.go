@@ -28,6 +28,39 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, k
 		panic(err)
 	}
 
+	for _, entry := range data.MessageBasedParams {
+		err := k.MessageBasedParams.Set(ctx, entry.MsgUrl, entry.Params)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, governor := range data.Governors {
+		addr, err := ak.AddressCodec().StringToBytes(governor.Address)
+		if err != nil {
+			panic(err)
+		}
+		err = k.Governors.Set(ctx, addr, governor)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, delegation := range data.GovernorDelegations {
+		delegator, err := ak.AddressCodec().StringToBytes(delegation.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		governor, err := ak.AddressCodec().StringToBytes(delegation.GovernorAddress)
+		if err != nil {
+			panic(err)
+		}
+		err = k.GovernorDelegations.Set(ctx, collections.Join(sdk.AccAddress(governor), sdk.AccAddress(delegator)), delegation)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	// check if the deposits pool account exists
 	moduleAcc := k.GetGovernanceAccount(ctx)
 	if moduleAcc == nil {
@@ -130,12 +163,42 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) (*v1.GenesisState, error)
 		panic(err)
 	}
 
+	var messageBasedParams []v1.MessageBasedParamsEntry
+	err = k.MessageBasedParams.Walk(ctx, nil, func(msgURL string, value v1.MessageBasedParams) (stop bool, err error) {
+		messageBasedParams = append(messageBasedParams, v1.MessageBasedParamsEntry{MsgUrl: msgURL, Params: value})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var governors []v1.Governor
+	err = k.Governors.Walk(ctx, nil, func(_ sdk.AccAddress, value v1.Governor) (stop bool, err error) {
+		governors = append(governors, value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var governorDelegations []v1.GovernorDelegation
+	err = k.GovernorDelegations.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], value v1.GovernorDelegation) (stop bool, err error) {
+		governorDelegations = append(governorDelegations, value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
-		Constitution:       constitution,
+		StartingProposalId:  startingProposalID,
+		Deposits:            proposalsDeposits,
+		Votes:               proposalsVotes,
+		Proposals:           proposals,
+		Params:              &params,
+		Constitution:        constitution,
+		MessageBasedParams:  messageBasedParams,
+		Governors:           governors,
+		GovernorDelegations: governorDelegations,
 	}, nil
 }
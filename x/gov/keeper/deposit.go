@@ -282,7 +282,7 @@ func (k Keeper) RefundAndDeleteDeposits(ctx context.Context, proposalID uint64)
 // validateInitialDeposit validates if initial deposit is greater than or equal to the minimum
 // required at the time of proposal submission. This threshold amount is determined by
 // the deposit parameters. Returns nil on success, error otherwise.
-func (k Keeper) validateInitialDeposit(ctx context.Context, params v1.Params, initialDeposit sdk.Coins, expedited bool) error {
+func (k Keeper) validateInitialDeposit(ctx context.Context, params v1.Params, initialDeposit sdk.Coins, proposalType v1.ProposalType, expedited bool) error {
 	if !initialDeposit.IsValid() || initialDeposit.IsAnyNegative() {
 		return errors.Wrap(sdkerrors.ErrInvalidCoins, initialDeposit.String())
 	}
@@ -296,9 +296,12 @@ func (k Keeper) validateInitialDeposit(ctx context.Context, params v1.Params, in
 	}
 
 	var minDepositCoins sdk.Coins
-	if expedited {
+	switch {
+	case proposalType == v1.ProposalTypeOptimistic:
+		minDepositCoins = params.OptimisticMinDeposit
+	case expedited:
 		minDepositCoins = params.ExpeditedMinDeposit
-	} else {
+	default:
 		minDepositCoins = params.MinDeposit
 	}
 
@@ -0,0 +1,276 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GovernorDelegationsIndexes defines the indexes of the governor delegations.
+type GovernorDelegationsIndexes struct {
+	// Delegator is a unique index that indexes the governor delegations by
+	// delegator, an account delegating to at most one governor.
+	Delegator *indexes.Unique[sdk.AccAddress, collections.Pair[sdk.AccAddress, sdk.AccAddress], v1.GovernorDelegation]
+}
+
+func newGovernorDelegationsIndexes(sb *collections.SchemaBuilder) GovernorDelegationsIndexes {
+	return GovernorDelegationsIndexes{
+		Delegator: indexes.NewUnique(
+			sb, types.GovernorDelegatorIndexPrefix, "governor_delegation_by_delegator",
+			sdk.AccAddressKey, collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+			func(pk collections.Pair[sdk.AccAddress, sdk.AccAddress], _ v1.GovernorDelegation) (sdk.AccAddress, error) {
+				return pk.K2(), nil
+			},
+		),
+	}
+}
+
+func (i GovernorDelegationsIndexes) IndexesList() []collections.Index[collections.Pair[sdk.AccAddress, sdk.AccAddress], v1.GovernorDelegation] {
+	return []collections.Index[collections.Pair[sdk.AccAddress, sdk.AccAddress], v1.GovernorDelegation]{i.Delegator}
+}
+
+// CreateGovernor registers an account as a governor. An account delegating
+// its voting power to a governor cannot be a governor.
+func (k Keeper) CreateGovernor(ctx context.Context, address sdk.AccAddress, metadata string) error {
+	if err := k.assertMetadataLength(metadata); err != nil {
+		return err
+	}
+
+	addressStr, err := k.authKeeper.AddressCodec().BytesToString(address)
+	if err != nil {
+		return err
+	}
+
+	exists, err := k.Governors.Has(ctx, address)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return errors.Wrap(types.ErrGovernorExists, addressStr)
+	}
+
+	_, err = k.GovernorDelegations.Indexes.Delegator.MatchExact(ctx, address)
+	switch {
+	case err == nil:
+		return errors.Wrapf(types.ErrInvalidGovernorDelegation, "%s delegates to a governor", addressStr)
+	case !errors.IsOf(err, collections.ErrNotFound):
+		return err
+	}
+
+	if err := k.Governors.Set(ctx, address, v1.NewGovernor(addressStr, metadata)); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateGovernor,
+			sdk.NewAttribute(types.AttributeKeyGovernor, addressStr),
+		),
+	)
+
+	return nil
+}
+
+// DelegateGovernor delegates the voting power of an account to a governor,
+// replacing its current governor if any. Governors cannot delegate their
+// voting power.
+func (k Keeper) DelegateGovernor(ctx context.Context, delegator, governor sdk.AccAddress) error {
+	delegatorStr, err := k.authKeeper.AddressCodec().BytesToString(delegator)
+	if err != nil {
+		return err
+	}
+	governorStr, err := k.authKeeper.AddressCodec().BytesToString(governor)
+	if err != nil {
+		return err
+	}
+
+	exists, err := k.Governors.Has(ctx, governor)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.Wrap(types.ErrGovernorNotFound, governorStr)
+	}
+
+	isGovernor, err := k.Governors.Has(ctx, delegator)
+	if err != nil {
+		return err
+	}
+	if isGovernor {
+		return errors.Wrapf(types.ErrInvalidGovernorDelegation, "governor %s cannot delegate to a governor", delegatorStr)
+	}
+
+	if err := k.removeGovernorDelegation(ctx, delegator); err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+		return err
+	}
+
+	delegation := v1.NewGovernorDelegation(delegatorStr, governorStr)
+	if err := k.GovernorDelegations.Set(ctx, collections.Join(governor, delegator), delegation); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDelegateGovernor,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegatorStr),
+			sdk.NewAttribute(types.AttributeKeyGovernor, governorStr),
+		),
+	)
+
+	return nil
+}
+
+// UndelegateGovernor removes the governor delegation of an account.
+func (k Keeper) UndelegateGovernor(ctx context.Context, delegator sdk.AccAddress) error {
+	delegatorStr, err := k.authKeeper.AddressCodec().BytesToString(delegator)
+	if err != nil {
+		return err
+	}
+
+	err = k.removeGovernorDelegation(ctx, delegator)
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return errors.Wrapf(types.ErrInvalidGovernorDelegation, "%s doesn't delegate to a governor", delegatorStr)
+	} else if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUndelegateGovernor,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegatorStr),
+		),
+	)
+
+	return nil
+}
+
+// removeGovernorDelegation removes the governor delegation of an account, or
+// returns collections.ErrNotFound if it has none.
+func (k Keeper) removeGovernorDelegation(ctx context.Context, delegator sdk.AccAddress) error {
+	key, err := k.GovernorDelegations.Indexes.Delegator.MatchExact(ctx, delegator)
+	if err != nil {
+		return err
+	}
+
+	return k.GovernorDelegations.Remove(ctx, key)
+}
+
+// GetGovernorDelegation returns the governor delegation of an account, or
+// collections.ErrNotFound if it has none.
+func (k Keeper) GetGovernorDelegation(ctx context.Context, delegator sdk.AccAddress) (v1.GovernorDelegation, error) {
+	key, err := k.GovernorDelegations.Indexes.Delegator.MatchExact(ctx, delegator)
+	if err != nil {
+		return v1.GovernorDelegation{}, err
+	}
+
+	return k.GovernorDelegations.Get(ctx, key)
+}
+
+// GovernorVotingPower returns the voting power of a governor: the bonded stake
+// of the governor and of its delegators.
+func (k Keeper) GovernorVotingPower(ctx context.Context, governor sdk.AccAddress) (math.LegacyDec, error) {
+	validators, err := k.getCurrentValidators(ctx)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	votingPower, err := k.delegatorVotingPower(ctx, governor, validators)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](governor)
+	err = k.GovernorDelegations.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], _ v1.GovernorDelegation) (bool, error) {
+		delegatorPower, err := k.delegatorVotingPower(ctx, key.K2(), validators)
+		if err != nil {
+			return false, err
+		}
+
+		votingPower = votingPower.Add(delegatorPower)
+		return false, nil
+	})
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	return votingPower, nil
+}
+
+// EmitGovernorVotes emits, for each governor having voted on a proposal, the
+// voting power it votes with on behalf of its delegators not having voted
+// themselves. It is called by the EndBlocker before tallying the proposal, as
+// the tally removes the votes.
+func (k Keeper) EmitGovernorVotes(ctx context.Context, proposal v1.Proposal) error {
+	validators, err := k.getCurrentValidators(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
+	return k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+		isGovernor, err := k.Governors.Has(ctx, key.K2())
+		if err != nil || !isGovernor {
+			return false, err
+		}
+
+		delegatedPower := math.LegacyZeroDec()
+		rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](key.K2())
+		err = k.GovernorDelegations.Walk(ctx, rng, func(delegationKey collections.Pair[sdk.AccAddress, sdk.AccAddress], _ v1.GovernorDelegation) (bool, error) {
+			hasVoted, err := k.Votes.Has(ctx, collections.Join(proposal.Id, delegationKey.K2()))
+			if err != nil || hasVoted {
+				return false, err
+			}
+
+			votingPower, err := k.delegatorVotingPower(ctx, delegationKey.K2(), validators)
+			if err != nil {
+				return false, err
+			}
+
+			delegatedPower = delegatedPower.Add(votingPower)
+			return false, nil
+		})
+		if err != nil {
+			return false, err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeGovernorVote,
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+				sdk.NewAttribute(types.AttributeKeyGovernor, vote.Voter),
+				sdk.NewAttribute(types.AttributeKeyVotingPower, delegatedPower.String()),
+			),
+		)
+
+		return false, nil
+	})
+}
+
+// delegatorVotingPower returns the voting power of the delegations of an
+// account to the given bonded validators.
+func (k Keeper) delegatorVotingPower(ctx context.Context, delegator sdk.AccAddress, validators map[string]v1.ValidatorGovInfo) (math.LegacyDec, error) {
+	votingPower := math.LegacyZeroDec()
+	err := k.sk.IterateDelegations(ctx, delegator, func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
+		if val, ok := validators[delegation.GetValidatorAddr()]; ok {
+			// delegation shares * bonded / total shares
+			votingPower = votingPower.Add(delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+		}
+
+		return false
+	})
+
+	return votingPower, err
+}
@@ -275,6 +275,157 @@ func (q queryServer) TallyResult(ctx context.Context, req *v1.QueryTallyResultRe
 	return &v1.QueryTallyResultResponse{Tally: &tallyResult}, nil
 }
 
+// MessageBasedParams queries the message based params of a message type.
+func (q queryServer) MessageBasedParams(ctx context.Context, req *v1.QueryMessageBasedParamsRequest) (*v1.QueryMessageBasedParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.MsgUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty msg url")
+	}
+
+	params, err := q.k.MessageBasedParams.Get(ctx, req.MsgUrl)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "message based params of %s don't exist", req.MsgUrl)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryMessageBasedParamsResponse{Params: &params}, nil
+}
+
+// ProposalTallyParams queries the tally params applying to a proposal.
+func (q queryServer) ProposalTallyParams(ctx context.Context, req *v1.QueryProposalTallyParamsRequest) (*v1.QueryProposalTallyParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProposalId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "proposal id can not be 0")
+	}
+
+	proposal, err := q.k.Proposals.Get(ctx, req.ProposalId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "proposal %d doesn't exist", req.ProposalId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	quorum, threshold, vetoThreshold, msgURLs, err := q.k.tallyParams(ctx, params, proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	tallyParams := v1.NewMessageBasedParams(quorum.String(), threshold.String(), vetoThreshold.String(), nil)
+	return &v1.QueryProposalTallyParamsResponse{Params: &tallyParams, MsgUrls: msgURLs}, nil
+}
+
+// Governor queries a governor and its voting power.
+func (q queryServer) Governor(ctx context.Context, req *v1.QueryGovernorRequest) (*v1.QueryGovernorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GovernorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty governor address")
+	}
+
+	address, err := q.k.authKeeper.AddressCodec().StringToBytes(req.GovernorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	governor, err := q.k.Governors.Get(ctx, address)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "governor %s doesn't exist", req.GovernorAddress)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	votingPower, err := q.k.GovernorVotingPower(ctx, address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorResponse{Governor: &governor, VotingPower: votingPower.String()}, nil
+}
+
+// Governors queries all the governors.
+func (q queryServer) Governors(ctx context.Context, req *v1.QueryGovernorsRequest) (*v1.QueryGovernorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	governors, pageRes, err := query.CollectionPaginate(ctx, q.k.Governors, req.Pagination, func(_ sdk.AccAddress, value v1.Governor) (*v1.Governor, error) {
+		return &value, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorsResponse{Governors: governors, Pagination: pageRes}, nil
+}
+
+// GovernorDelegations queries the delegations of a governor.
+func (q queryServer) GovernorDelegations(ctx context.Context, req *v1.QueryGovernorDelegationsRequest) (*v1.QueryGovernorDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GovernorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty governor address")
+	}
+
+	governor, err := q.k.authKeeper.AddressCodec().StringToBytes(req.GovernorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	delegations, pageRes, err := query.CollectionPaginate(ctx, q.k.GovernorDelegations, req.Pagination, func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], value v1.GovernorDelegation) (*v1.GovernorDelegation, error) {
+		return &value, nil
+	}, query.WithCollectionPaginationPairPrefix[sdk.AccAddress, sdk.AccAddress](governor))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorDelegationsResponse{Delegations: delegations, Pagination: pageRes}, nil
+}
+
+// GovernorDelegation queries the governor delegation of a delegator.
+func (q queryServer) GovernorDelegation(ctx context.Context, req *v1.QueryGovernorDelegationRequest) (*v1.QueryGovernorDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	delegator, err := q.k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	delegation, err := q.k.GetGovernorDelegation(ctx, delegator)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%s doesn't delegate to a governor", req.DelegatorAddress)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorDelegationResponse{Delegation: &delegation}, nil
+}
+
 var _ v1beta1.QueryServer = legacyQueryServer{}
 
 type legacyQueryServer struct{ qs v1.QueryServer }
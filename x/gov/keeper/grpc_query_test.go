@@ -11,6 +11,8 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	v3 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v3"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
@@ -97,6 +99,175 @@ func (suite *KeeperTestSuite) TestGRPCQueryConstitution() {
 	suite.Require().Equal(expRes, constitution)
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryMessageBasedParams() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+	msgURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	_, err := queryClient.MessageBasedParams(gocontext.Background(), &v1.QueryMessageBasedParamsRequest{})
+	suite.Require().ErrorContains(err, "empty msg url")
+
+	_, err = queryClient.MessageBasedParams(gocontext.Background(), &v1.QueryMessageBasedParamsRequest{MsgUrl: msgURL})
+	suite.Require().ErrorContains(err, "don't exist")
+
+	params := v1.NewMessageBasedParams("0.5", "0.75", "0.2", nil)
+	suite.Require().NoError(suite.govKeeper.MessageBasedParams.Set(ctx, msgURL, params))
+
+	res, err := queryClient.MessageBasedParams(gocontext.Background(), &v1.QueryMessageBasedParamsRequest{MsgUrl: msgURL})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&params, res.Params)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryGovernors() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+	governor, delegator := suite.addrs[0], suite.addrs[1]
+
+	_, err := queryClient.Governor(gocontext.Background(), &v1.QueryGovernorRequest{})
+	suite.Require().ErrorContains(err, "empty governor address")
+
+	_, err = queryClient.Governor(gocontext.Background(), &v1.QueryGovernorRequest{GovernorAddress: governor.String()})
+	suite.Require().ErrorContains(err, "doesn't exist")
+
+	_, err = queryClient.GovernorDelegation(gocontext.Background(), &v1.QueryGovernorDelegationRequest{DelegatorAddress: delegator.String()})
+	suite.Require().ErrorContains(err, "doesn't delegate to a governor")
+
+	suite.Require().NoError(suite.govKeeper.CreateGovernor(ctx, governor, "metadata"))
+	suite.Require().NoError(suite.govKeeper.DelegateGovernor(ctx, delegator, governor))
+
+	governorRes, err := queryClient.Governor(gocontext.Background(), &v1.QueryGovernorRequest{GovernorAddress: governor.String()})
+	suite.Require().NoError(err)
+	expGovernor := v1.NewGovernor(governor.String(), "metadata")
+	suite.Require().Equal(&expGovernor, governorRes.Governor)
+	suite.Require().Equal(math.LegacyZeroDec().String(), governorRes.VotingPower)
+
+	governorsRes, err := queryClient.Governors(gocontext.Background(), &v1.QueryGovernorsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.Governor{&expGovernor}, governorsRes.Governors)
+
+	expDelegation := v1.NewGovernorDelegation(delegator.String(), governor.String())
+	delegationsRes, err := queryClient.GovernorDelegations(gocontext.Background(), &v1.QueryGovernorDelegationsRequest{GovernorAddress: governor.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]*v1.GovernorDelegation{&expDelegation}, delegationsRes.Delegations)
+
+	delegationRes, err := queryClient.GovernorDelegation(gocontext.Background(), &v1.QueryGovernorDelegationRequest{DelegatorAddress: delegator.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&expDelegation, delegationRes.Delegation)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryProposalTallyParams() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+	// a community pool spend implements v1.MsgWithAmount, which min amounts apply to
+	spend := &disttypes.MsgCommunityPoolSpend{
+		Authority: govAcct.String(),
+		Recipient: addr.String(),
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+	}
+	msgs := []sdk.Msg{spend, TestProposal[1]}
+	msgURL := sdk.MsgTypeURL(spend)
+
+	_, err := queryClient.ProposalTallyParams(gocontext.Background(), &v1.QueryProposalTallyParamsRequest{})
+	suite.Require().ErrorContains(err, "proposal id can not be 0")
+
+	_, err = queryClient.ProposalTallyParams(gocontext.Background(), &v1.QueryProposalTallyParamsRequest{ProposalId: 1})
+	suite.Require().ErrorContains(err, "doesn't exist")
+
+	proposal, err := suite.govKeeper.SubmitProposal(ctx, msgs, "", "title", "summary", suite.addrs[0], false)
+	suite.Require().NoError(err)
+	params, err := suite.govKeeper.Params.Get(ctx)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		msg       string
+		msgParams *v1.MessageBasedParams
+		expParams v1.MessageBasedParams
+		expURLs   []string
+	}{
+		{
+			msg:       "module params",
+			expParams: v1.NewMessageBasedParams(params.Quorum, params.Threshold, params.VetoThreshold, nil),
+		},
+		{
+			msg: "message based params below min amount",
+			msgParams: &v1.MessageBasedParams{
+				Quorum: "0.5", Threshold: "0.75", VetoThreshold: "0.2",
+				MinAmount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1001)),
+			},
+			expParams: v1.NewMessageBasedParams(params.Quorum, params.Threshold, params.VetoThreshold, nil),
+		},
+		{
+			// the proposal also has a legacy content message, tallied with the module params
+			msg: "strictest of message based params and module params",
+			msgParams: &v1.MessageBasedParams{
+				Quorum: "0.1", Threshold: "0.75", VetoThreshold: "0.2",
+				MinAmount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)),
+			},
+			expParams: v1.NewMessageBasedParams(params.Quorum, "0.75", "0.2", nil),
+			expURLs:   []string{msgURL},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			if tc.msgParams != nil {
+				suite.Require().NoError(suite.govKeeper.MessageBasedParams.Set(ctx, msgURL, *tc.msgParams))
+			}
+
+			res, err := queryClient.ProposalTallyParams(gocontext.Background(), &v1.QueryProposalTallyParamsRequest{ProposalId: proposal.Id})
+			suite.Require().NoError(err)
+
+			expQuorum, threshold, vetoThreshold := math.LegacyMustNewDecFromStr(tc.expParams.Quorum), math.LegacyMustNewDecFromStr(tc.expParams.Threshold), math.LegacyMustNewDecFromStr(tc.expParams.VetoThreshold)
+			suite.Require().Equal(expQuorum.String(), res.Params.Quorum)
+			suite.Require().Equal(threshold.String(), res.Params.Threshold)
+			suite.Require().Equal(vetoThreshold.String(), res.Params.VetoThreshold)
+			suite.Require().Equal(tc.expURLs, res.MsgUrls)
+		})
+	}
+
+	// the messages of a multiple choice proposal are the ones of its options
+	suite.Require().NoError(suite.govKeeper.MessageBasedParams.Set(ctx, msgURL, v1.NewMessageBasedParams("0.1", "0.75", "0.2", nil)))
+	withMsgs, err := v1.NewProposalOption("with messages", msgs[:1])
+	suite.Require().NoError(err)
+	withoutMsgs, err := v1.NewProposalOption("without messages", nil)
+	suite.Require().NoError(err)
+
+	for _, tc := range []struct {
+		msg       string
+		options   []*v1.ProposalOption
+		expQuorum string
+	}{
+		{"options with messages", []*v1.ProposalOption{withMsgs, withMsgs}, "0.1"},
+		{"option without messages", []*v1.ProposalOption{withMsgs, withoutMsgs}, params.Quorum},
+	} {
+		suite.Run(tc.msg, func() {
+			proposal, err := suite.govKeeper.SubmitMultipleChoiceProposal(ctx, tc.options, "", "title", "summary", suite.addrs[0])
+			suite.Require().NoError(err)
+
+			res, err := queryClient.ProposalTallyParams(gocontext.Background(), &v1.QueryProposalTallyParamsRequest{ProposalId: proposal.Id})
+			suite.Require().NoError(err)
+			suite.Require().Equal(math.LegacyMustNewDecFromStr(tc.expQuorum).String(), res.Params.Quorum)
+			suite.Require().Equal(math.LegacyMustNewDecFromStr("0.75").String(), res.Params.Threshold)
+			suite.Require().Equal(math.LegacyMustNewDecFromStr("0.2").String(), res.Params.VetoThreshold)
+			suite.Require().Equal([]string{msgURL}, res.MsgUrls)
+		})
+	}
+
+	// a min amount never applies to a message without amount
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	suite.Require().NoError(suite.govKeeper.MessageBasedParams.Set(ctx, sendURL, v1.MessageBasedParams{
+		Quorum: "0.1", Threshold: "0.75", VetoThreshold: "0.2",
+		MinAmount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)),
+	}))
+	proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal[:1], "", "title", "summary", suite.addrs[0], false)
+	suite.Require().NoError(err)
+
+	res, err := queryClient.ProposalTallyParams(gocontext.Background(), &v1.QueryProposalTallyParamsRequest{ProposalId: proposal.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.LegacyMustNewDecFromStr(params.Quorum).String(), res.Params.Quorum)
+	suite.Require().Empty(res.MsgUrls)
+}
+
 func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposal() {
 	suite.reset()
 	ctx, queryClient, addrs := suite.ctx, suite.legacyQueryClient, suite.addrs
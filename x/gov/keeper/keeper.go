@@ -52,6 +52,7 @@ type Keeper struct {
 	Schema                 collections.Schema
 	Constitution           collections.Item[string]
 	Params                 collections.Item[v1.Params]
+	MessageBasedParams     collections.Map[string, v1.MessageBasedParams]
 	Deposits               collections.Map[collections.Pair[uint64, sdk.AccAddress], v1.Deposit]
 	Votes                  collections.Map[collections.Pair[uint64, sdk.AccAddress], v1.Vote]
 	ProposalID             collections.Sequence
@@ -59,6 +60,8 @@ type Keeper struct {
 	ActiveProposalsQueue   collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	InactiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	VotingPeriodProposals  collections.Map[uint64, []byte]                              // TODO(tip): this could be a keyset or index.
+	Governors              collections.Map[sdk.AccAddress, v1.Governor]
+	GovernorDelegations    *collections.IndexedMap[collections.Pair[sdk.AccAddress, sdk.AccAddress], v1.GovernorDelegation, GovernorDelegationsIndexes]
 }
 
 type InitOption func(*Keeper)
@@ -120,6 +123,7 @@ func NewKeeper(
 		authority:                            authority,
 		Constitution:                         collections.NewItem(sb, types.ConstitutionKey, "constitution", collections.StringValue),
 		Params:                               collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[v1.Params](cdc)),
+		MessageBasedParams:                   collections.NewMap(sb, types.MessageBasedParamsKey, "message_based_params", collections.StringKey, codec.CollValue[v1.MessageBasedParams](cdc)),
 		Deposits:                             collections.NewMap(sb, types.DepositsKeyPrefix, "deposits", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Deposit](cdc)), // nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 		Votes:                                collections.NewMap(sb, types.VotesKeyPrefix, "votes", collections.PairKeyCodec(collections.Uint64Key, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), codec.CollValue[v1.Vote](cdc)),          // nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 		ProposalID:                           collections.NewSequence(sb, types.ProposalIDKey, "proposal_id"),
@@ -127,6 +131,8 @@ func NewKeeper(
 		ActiveProposalsQueue:                 collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
 		InactiveProposalsQueue:               collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
 		VotingPeriodProposals:                collections.NewMap(sb, types.VotingPeriodProposalKeyPrefix, "voting_period_proposals", collections.Uint64Key, collections.BytesValue),
+		Governors:                            collections.NewMap(sb, types.GovernorsKeyPrefix, "governors", sdk.AccAddressKey, codec.CollValue[v1.Governor](cdc)),
+		GovernorDelegations:                  collections.NewIndexedMap(sb, types.GovernorDelegationsKeyPrefix, "governor_delegations", collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey), codec.CollValue[v1.GovernorDelegation](cdc), newGovernorDelegationsIndexes(sb)),
 	}
 
 	for _, opt := range initOptions {
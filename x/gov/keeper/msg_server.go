@@ -41,8 +41,8 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
 	}
 
-	// check that either metadata or Msgs length is non nil.
-	if len(msg.Messages) == 0 && len(msg.Metadata) == 0 {
+	// check that either metadata, Msgs or options length is non nil.
+	if len(msg.Messages) == 0 && len(msg.Options) == 0 && len(msg.Metadata) == 0 {
 		return nil, errors.Wrap(govtypes.ErrNoProposalMsgs, "either metadata or Msgs length must be non-nil")
 	}
 
@@ -76,7 +76,7 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, fmt.Errorf("failed to get governance parameters: %w", err)
 	}
 
-	if err := k.validateInitialDeposit(ctx, params, initialDeposit, msg.Expedited); err != nil {
+	if err := k.validateInitialDeposit(ctx, params, initialDeposit, msg.ProposalType, msg.Expedited); err != nil {
 		return nil, err
 	}
 
@@ -84,7 +84,7 @@ func (k msgServer) SubmitProposal(goCtx context.Context, msg *v1.MsgSubmitPropos
 		return nil, err
 	}
 
-	proposal, err := k.Keeper.SubmitProposal(ctx, proposalMsgs, msg.Metadata, msg.Title, msg.Summary, proposer, msg.Expedited)
+	proposal, err := k.Keeper.submitProposal(ctx, proposalMsgs, msg.Options, msg.Metadata, msg.Title, msg.Summary, proposer, msg.ProposalType, msg.Expedited)
 	if err != nil {
 		return nil, err
 	}
@@ -204,7 +204,7 @@ func (k msgServer) VoteWeighted(goCtx context.Context, msg *v1.MsgVoteWeighted)
 	}
 
 	totalWeight := math.LegacyNewDec(0)
-	usedOptions := make(map[v1.VoteOption]bool)
+	usedOptions := make(map[v1.WeightedVoteOption]bool)
 	for _, option := range msg.Options {
 		if !option.IsValid() {
 			return nil, errors.Wrap(govtypes.ErrInvalidVote, option.String())
@@ -214,10 +214,11 @@ func (k msgServer) VoteWeighted(goCtx context.Context, msg *v1.MsgVoteWeighted)
 			return nil, errors.Wrapf(govtypes.ErrInvalidVote, "invalid weight: %s", err)
 		}
 		totalWeight = totalWeight.Add(weight)
-		if usedOptions[option.Option] {
+		usedOption := v1.WeightedVoteOption{Option: option.Option, Choice: option.Choice}
+		if usedOptions[usedOption] {
 			return nil, errors.Wrap(govtypes.ErrInvalidVote, "duplicated vote option")
 		}
-		usedOptions[option.Option] = true
+		usedOptions[usedOption] = true
 	}
 
 	if totalWeight.GT(math.LegacyNewDec(1)) {
@@ -284,6 +285,83 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *v1.MsgUpdateParams)
 	return &v1.MsgUpdateParamsResponse{}, nil
 }
 
+// UpdateMessageParams implements the MsgServer.UpdateMessageParams method.
+func (k msgServer) UpdateMessageParams(ctx context.Context, msg *v1.MsgUpdateMessageParams) (*v1.MsgUpdateMessageParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if msg.Params == nil {
+		if err := k.MessageBasedParams.Remove(ctx, msg.MsgUrl); err != nil {
+			return nil, err
+		}
+
+		return &v1.MsgUpdateMessageParamsResponse{}, nil
+	}
+
+	// only the messages routable by the msg service router can be in proposals
+	if k.router.HandlerByTypeURL(msg.MsgUrl) == nil {
+		return nil, errors.Wrap(govtypes.ErrUnroutableProposalMsg, msg.MsgUrl)
+	}
+
+	if err := msg.Params.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.MessageBasedParams.Set(ctx, msg.MsgUrl, *msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUpdateMessageParamsResponse{}, nil
+}
+
+// CreateGovernor implements the MsgServer.CreateGovernor method.
+func (k msgServer) CreateGovernor(ctx context.Context, msg *v1.MsgCreateGovernor) (*v1.MsgCreateGovernorResponse, error) {
+	address, err := k.authKeeper.AddressCodec().StringToBytes(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	if err := k.Keeper.CreateGovernor(ctx, address, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgCreateGovernorResponse{}, nil
+}
+
+// DelegateGovernor implements the MsgServer.DelegateGovernor method.
+func (k msgServer) DelegateGovernor(ctx context.Context, msg *v1.MsgDelegateGovernor) (*v1.MsgDelegateGovernorResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	governor, err := k.authKeeper.AddressCodec().StringToBytes(msg.GovernorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid governor address: %s", err)
+	}
+
+	if err := k.Keeper.DelegateGovernor(ctx, delegator, governor); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgDelegateGovernorResponse{}, nil
+}
+
+// UndelegateGovernor implements the MsgServer.UndelegateGovernor method.
+func (k msgServer) UndelegateGovernor(ctx context.Context, msg *v1.MsgUndelegateGovernor) (*v1.MsgUndelegateGovernorResponse, error) {
+	delegator, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if err := k.Keeper.UndelegateGovernor(ctx, delegator); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgUndelegateGovernorResponse{}, nil
+}
+
 type legacyMsgServer struct {
 	govAcct string
 	server  v1.MsgServer
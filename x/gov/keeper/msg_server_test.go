@@ -4,6 +4,7 @@ import (
 	"strings"
 	"time"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
@@ -237,6 +238,109 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 			expErr:    true,
 			expErrMsg: "deposited 100invalid,100000stake, but gov accepts only the following denom(s): [stake]: invalid deposit denom",
 		},
+		"invalid proposal type": {
+			preRun: func() (*v1.MsgSubmitProposal, error) {
+				msg, err := v1.NewMsgSubmitProposal(
+					[]sdk.Msg{bankMsg},
+					initialDeposit,
+					proposer.String(),
+					"",
+					"Proposal",
+					"description of proposal",
+					false,
+				)
+				msg.ProposalType = 10
+				return msg, err
+			},
+			expErr:    true,
+			expErrMsg: "invalid proposal type",
+		},
+		"expedited optimistic proposal": {
+			preRun: func() (*v1.MsgSubmitProposal, error) {
+				msg, err := v1.NewMsgSubmitProposal(
+					[]sdk.Msg{bankMsg},
+					initialDeposit,
+					proposer.String(),
+					"",
+					"Proposal",
+					"description of proposal",
+					true,
+				)
+				msg.ProposalType = v1.ProposalTypeOptimistic
+				return msg, err
+			},
+			expErr:    true,
+			expErrMsg: "optimistic proposals cannot be expedited",
+		},
+		"unauthorized optimistic proposal": {
+			preRun: func() (*v1.MsgSubmitProposal, error) {
+				msg, err := v1.NewMsgSubmitProposal(
+					[]sdk.Msg{bankMsg},
+					initialDeposit,
+					proposer.String(),
+					"",
+					"Proposal",
+					"description of proposal",
+					false,
+				)
+				msg.ProposalType = v1.ProposalTypeOptimistic
+				return msg, err
+			},
+			expErr:    true,
+			expErrMsg: "is not authorized to submit optimistic proposals",
+		},
+		"options on a standard proposal": {
+			preRun: func() (*v1.MsgSubmitProposal, error) {
+				msg, err := v1.NewMsgSubmitProposal(
+					[]sdk.Msg{bankMsg},
+					initialDeposit,
+					proposer.String(),
+					"",
+					"Proposal",
+					"description of proposal",
+					false,
+				)
+				msg.Options = []*v1.ProposalOption{{Title: "one"}, {Title: "two"}}
+				return msg, err
+			},
+			expErr:    true,
+			expErrMsg: "only multiple choice proposals can have options",
+		},
+		"multiple choice proposal with messages outside of its options": {
+			preRun: func() (*v1.MsgSubmitProposal, error) {
+				msg, err := v1.NewMsgSubmitProposal(
+					[]sdk.Msg{bankMsg},
+					initialDeposit,
+					proposer.String(),
+					"",
+					"Proposal",
+					"description of proposal",
+					false,
+				)
+				msg.ProposalType = v1.ProposalTypeMultipleChoice
+				msg.Options = []*v1.ProposalOption{{Title: "one"}, {Title: "two"}}
+				return msg, err
+			},
+			expErr:    true,
+			expErrMsg: "multiple choice proposals can only have messages in their options",
+		},
+		"all good multiple choice proposal": {
+			preRun: func() (*v1.MsgSubmitProposal, error) {
+				optionOne, err := v1.NewProposalOption("one", []sdk.Msg{bankMsg})
+				if err != nil {
+					return nil, err
+				}
+				return v1.NewMsgSubmitMultipleChoiceProposal(
+					[]*v1.ProposalOption{optionOne, {Title: "two"}},
+					initialDeposit,
+					proposer.String(),
+					"",
+					"Proposal",
+					"description of proposal",
+				), nil
+			},
+			expErr: false,
+		},
 		"all good": {
 			preRun: func() (*v1.MsgSubmitProposal, error) {
 				return v1.NewMsgSubmitProposal(
@@ -1708,6 +1812,171 @@ func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgUpdateMessageParams() {
+	authority := suite.govKeeper.GetAuthority()
+	msgURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	params := v1.NewMessageBasedParams("0.5", "0.75", "0.2", sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)))
+	testCases := []struct {
+		name      string
+		input     *v1.MsgUpdateMessageParams
+		expParams *v1.MessageBasedParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			input:     v1.NewMsgUpdateMessageParams("authority", msgURL, &params),
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:      "unroutable msg url",
+			input:     v1.NewMsgUpdateMessageParams(authority, "/cosmos.unknown.v1.MsgUnknown", &params),
+			expErr:    true,
+			expErrMsg: "proposal message not recognized by router",
+		},
+		{
+			name:      "invalid threshold",
+			input:     v1.NewMsgUpdateMessageParams(authority, msgURL, &v1.MessageBasedParams{Quorum: "0.5", Threshold: "1.1", VetoThreshold: "0.2"}),
+			expErr:    true,
+			expErrMsg: "vote threshold too large",
+		},
+		{
+			name:      "set",
+			input:     v1.NewMsgUpdateMessageParams(authority, msgURL, &params),
+			expParams: &params,
+		},
+		{
+			name:  "remove",
+			input: v1.NewMsgUpdateMessageParams(authority, msgURL, nil),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.UpdateMessageParams(suite.ctx, tc.input)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			msgParams, err := suite.govKeeper.MessageBasedParams.Get(suite.ctx, msgURL)
+			if tc.expParams == nil {
+				suite.Require().ErrorIs(err, collections.ErrNotFound)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(*tc.expParams, msgParams)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgGovernors() {
+	governor, delegator, other := suite.addrs[0], suite.addrs[1], suite.addrs[2]
+	testCases := []struct {
+		name      string
+		exec      func() error
+		expErrMsg string
+	}{
+		{
+			name: "create governor with invalid address",
+			exec: func() error {
+				_, err := suite.msgSrvr.CreateGovernor(suite.ctx, v1.NewMsgCreateGovernor("invalid", ""))
+				return err
+			},
+			expErrMsg: "invalid governor address",
+		},
+		{
+			name: "create governor",
+			exec: func() error {
+				_, err := suite.msgSrvr.CreateGovernor(suite.ctx, v1.NewMsgCreateGovernor(governor.String(), "metadata"))
+				return err
+			},
+		},
+		{
+			name: "create existing governor",
+			exec: func() error {
+				_, err := suite.msgSrvr.CreateGovernor(suite.ctx, v1.NewMsgCreateGovernor(governor.String(), ""))
+				return err
+			},
+			expErrMsg: "governor already exists",
+		},
+		{
+			name: "delegate to non-existent governor",
+			exec: func() error {
+				_, err := suite.msgSrvr.DelegateGovernor(suite.ctx, v1.NewMsgDelegateGovernor(delegator.String(), other.String()))
+				return err
+			},
+			expErrMsg: "governor not found",
+		},
+		{
+			name: "governor delegating to a governor",
+			exec: func() error {
+				_, err := suite.msgSrvr.DelegateGovernor(suite.ctx, v1.NewMsgDelegateGovernor(governor.String(), governor.String()))
+				return err
+			},
+			expErrMsg: "cannot delegate to a governor",
+		},
+		{
+			name: "delegate to governor",
+			exec: func() error {
+				_, err := suite.msgSrvr.DelegateGovernor(suite.ctx, v1.NewMsgDelegateGovernor(delegator.String(), governor.String()))
+				if err != nil {
+					return err
+				}
+
+				delegation, err := suite.govKeeper.GetGovernorDelegation(suite.ctx, delegator)
+				suite.Require().NoError(err)
+				suite.Require().Equal(v1.NewGovernorDelegation(delegator.String(), governor.String()), delegation)
+				return nil
+			},
+		},
+		{
+			name: "create governor delegating to a governor",
+			exec: func() error {
+				_, err := suite.msgSrvr.CreateGovernor(suite.ctx, v1.NewMsgCreateGovernor(delegator.String(), ""))
+				return err
+			},
+			expErrMsg: "delegates to a governor",
+		},
+		{
+			name: "undelegate from governor",
+			exec: func() error {
+				_, err := suite.msgSrvr.UndelegateGovernor(suite.ctx, v1.NewMsgUndelegateGovernor(delegator.String()))
+				if err != nil {
+					return err
+				}
+
+				_, err = suite.govKeeper.GetGovernorDelegation(suite.ctx, delegator)
+				suite.Require().ErrorIs(err, collections.ErrNotFound)
+				return nil
+			},
+		},
+		{
+			name: "undelegate without governor",
+			exec: func() error {
+				_, err := suite.msgSrvr.UndelegateGovernor(suite.ctx, v1.NewMsgUndelegateGovernor(delegator.String()))
+				return err
+			},
+			expErrMsg: "doesn't delegate to a governor",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.exec()
+			if tc.expErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestSubmitProposal_InitialDeposit() {
 	const meetsDepositValue = baseDepositTestAmount * baseDepositTestPercent / 100
 	baseDepositRatioDec := sdkmath.LegacyNewDec(baseDepositTestPercent).Quo(sdkmath.LegacyNewDec(100))
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"cosmossdk.io/collections"
@@ -17,7 +18,39 @@ import (
 
 // SubmitProposal creates a new proposal given an array of messages
 func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress, expedited bool) (v1.Proposal, error) {
+	return k.submitProposal(ctx, messages, nil, metadata, title, summary, proposer, v1.ProposalTypeStandard, expedited)
+}
+
+// SubmitOptimisticProposal creates a new optimistic proposal given an array of
+// messages. The proposer must be authorized to submit optimistic proposals and
+// the messages must be allowed in optimistic proposals.
+func (k Keeper) SubmitOptimisticProposal(ctx context.Context, messages []sdk.Msg, metadata, title, summary string, proposer sdk.AccAddress) (v1.Proposal, error) {
+	return k.submitProposal(ctx, messages, nil, metadata, title, summary, proposer, v1.ProposalTypeOptimistic, false)
+}
+
+// SubmitMultipleChoiceProposal creates a new multiple choice proposal given an
+// array of options. Only the messages of the winning option are executed.
+func (k Keeper) SubmitMultipleChoiceProposal(ctx context.Context, options []*v1.ProposalOption, metadata, title, summary string, proposer sdk.AccAddress) (v1.Proposal, error) {
+	return k.submitProposal(ctx, nil, options, metadata, title, summary, proposer, v1.ProposalTypeMultipleChoice, false)
+}
+
+// submitProposal creates a new proposal of the given type given an array of
+// messages, or of options for a multiple choice proposal
+func (k Keeper) submitProposal(ctx context.Context, messages []sdk.Msg, options []*v1.ProposalOption, metadata, title, summary string, proposer sdk.AccAddress, proposalType v1.ProposalType, expedited bool) (v1.Proposal, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !v1.ValidProposalType(proposalType) {
+		return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalType, proposalType.String())
+	}
+	if proposalType == v1.ProposalType_PROPOSAL_TYPE_UNSPECIFIED {
+		proposalType = v1.ProposalTypeStandard
+	}
+	if proposalType == v1.ProposalTypeOptimistic && expedited {
+		return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalType, "optimistic proposals cannot be expedited")
+	}
+	if proposalType == v1.ProposalTypeMultipleChoice && expedited {
+		return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalType, "multiple choice proposals cannot be expedited")
+	}
+
 	err := k.assertMetadataLength(metadata)
 	if err != nil {
 		return v1.Proposal{}, err
@@ -35,55 +68,34 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 		return v1.Proposal{}, err
 	}
 
+	if proposalType == v1.ProposalTypeMultipleChoice {
+		if err := k.validateMultipleChoiceProposal(messages, options); err != nil {
+			return v1.Proposal{}, err
+		}
+	} else if len(options) != 0 {
+		return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalType, "only multiple choice proposals can have options")
+	}
+
 	// Will hold a comma-separated string of all Msg type URLs.
 	msgsStr := ""
 
-	// Loop through all messages and confirm that each has a handler and the gov module account
-	// as the only signer
-	for _, msg := range messages {
-		msgsStr += fmt.Sprintf(",%s", sdk.MsgTypeURL(msg))
-
-		// perform a basic validation of the message
-		if m, ok := msg.(sdk.HasValidateBasic); ok {
-			if err := m.ValidateBasic(); err != nil {
-				return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
-			}
-		}
-
-		signers, _, err := k.cdc.GetMsgV1Signers(msg)
+	// Loop through all messages, including the messages of the options, and
+	// confirm that each has a handler and the gov module account as the only
+	// signer
+	allMsgs := slices.Clone(messages)
+	for _, option := range options {
+		optionMsgs, err := option.GetMsgs()
 		if err != nil {
 			return v1.Proposal{}, err
 		}
-		if len(signers) != 1 {
-			return v1.Proposal{}, types.ErrInvalidSigner
-		}
-
-		// assert that the governance module account is the only signer of the messages
-		if !bytes.Equal(signers[0], k.GetGovernanceAccount(ctx).GetAddress()) {
-			return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidSigner, sdk.AccAddress(signers[0]).String())
-		}
-
-		// use the msg service router to see that there is a valid route for that message.
-		handler := k.router.Handler(msg)
-		if handler == nil {
-			return v1.Proposal{}, errorsmod.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
-		}
+		allMsgs = append(allMsgs, optionMsgs...)
+	}
+	for _, msg := range allMsgs {
+		msgsStr += fmt.Sprintf(",%s", sdk.MsgTypeURL(msg))
 
-		// Only if it's a MsgExecLegacyContent do we try to execute the
-		// proposal in a cached context.
-		// For other Msgs, we do not verify the proposal messages any further.
-		// They may fail upon execution.
-		// ref: https://github.com/cosmos/cosmos-sdk/pull/10868#discussion_r784872842
-		if msg, ok := msg.(*v1.MsgExecLegacyContent); ok {
-			cacheCtx, _ := sdkCtx.CacheContext()
-			if _, err := handler(cacheCtx, msg); err != nil {
-				if errors.Is(err, types.ErrNoProposalHandlerExists) {
-					return v1.Proposal{}, err
-				}
-				return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalContent, err.Error())
-			}
+		if err := k.validateProposalMsg(sdkCtx, msg); err != nil {
+			return v1.Proposal{}, err
 		}
-
 	}
 
 	proposalID, err := k.ProposalID.Next(ctx)
@@ -96,6 +108,12 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 		return v1.Proposal{}, err
 	}
 
+	if proposalType == v1.ProposalTypeOptimistic {
+		if err := k.validateOptimisticProposal(params, messages, proposer); err != nil {
+			return v1.Proposal{}, err
+		}
+	}
+
 	submitTime := sdkCtx.BlockHeader().Time
 	depositPeriod := params.MaxDepositPeriod
 
@@ -103,6 +121,8 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 	if err != nil {
 		return v1.Proposal{}, err
 	}
+	proposal.ProposalType = proposalType
+	proposal.Options = options
 
 	err = k.SetProposal(ctx, proposal)
 	if err != nil {
@@ -131,6 +151,95 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 	return proposal, nil
 }
 
+// validateProposalMsg confirms that a proposal message has a handler and the
+// gov module account as the only signer.
+func (k Keeper) validateProposalMsg(ctx sdk.Context, msg sdk.Msg) error {
+	// perform a basic validation of the message
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
+		}
+	}
+
+	signers, _, err := k.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return err
+	}
+	if len(signers) != 1 {
+		return types.ErrInvalidSigner
+	}
+
+	// assert that the governance module account is the only signer of the messages
+	if !bytes.Equal(signers[0], k.GetGovernanceAccount(ctx).GetAddress()) {
+		return errorsmod.Wrapf(types.ErrInvalidSigner, sdk.AccAddress(signers[0]).String())
+	}
+
+	// use the msg service router to see that there is a valid route for that message.
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return errorsmod.Wrap(types.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg))
+	}
+
+	// Only if it's a MsgExecLegacyContent do we try to execute the
+	// proposal in a cached context.
+	// For other Msgs, we do not verify the proposal messages any further.
+	// They may fail upon execution.
+	// ref: https://github.com/cosmos/cosmos-sdk/pull/10868#discussion_r784872842
+	if msg, ok := msg.(*v1.MsgExecLegacyContent); ok {
+		cacheCtx, _ := ctx.CacheContext()
+		if _, err := handler(cacheCtx, msg); err != nil {
+			if errors.Is(err, types.ErrNoProposalHandlerExists) {
+				return err
+			}
+			return errorsmod.Wrap(types.ErrInvalidProposalContent, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// validateMultipleChoiceProposal checks that a multiple choice proposal has at
+// least two options with a title and no messages outside of its options.
+func (k Keeper) validateMultipleChoiceProposal(messages []sdk.Msg, options []*v1.ProposalOption) error {
+	if len(messages) != 0 {
+		return errorsmod.Wrap(types.ErrInvalidProposalMsg, "multiple choice proposals can only have messages in their options")
+	}
+	if len(options) < 2 {
+		return errorsmod.Wrapf(types.ErrInvalidProposalContent, "multiple choice proposals must have at least 2 options, got %d", len(options))
+	}
+
+	for i, option := range options {
+		if option == nil || option.Title == "" {
+			return errorsmod.Wrapf(types.ErrInvalidProposalContent, "option %d title cannot be empty", i+1)
+		}
+		if err := k.assertMetadataLength(option.Title); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateOptimisticProposal checks that the proposer is authorized to submit
+// optimistic proposals and that the messages are allowed in optimistic proposals.
+func (k Keeper) validateOptimisticProposal(params v1.Params, messages []sdk.Msg, proposer sdk.AccAddress) error {
+	proposerAddr, err := k.authKeeper.AddressCodec().BytesToString(proposer)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(params.OptimisticAuthorizedAddresses, proposerAddr) {
+		return errorsmod.Wrapf(types.ErrInvalidProposer, "%s is not authorized to submit optimistic proposals", proposerAddr)
+	}
+
+	for _, msg := range messages {
+		if msgURL := sdk.MsgTypeURL(msg); !slices.Contains(params.OptimisticAllowedMsgUrls, msgURL) {
+			return errorsmod.Wrapf(types.ErrInvalidProposalMsg, "%s is not allowed in optimistic proposals", msgURL)
+		}
+	}
+
+	return nil
+}
+
 // CancelProposal will cancel proposal before the voting period ends
 func (k Keeper) CancelProposal(ctx context.Context, proposalID uint64, proposer string) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -249,9 +358,12 @@ func (k Keeper) ActivateVotingPeriod(ctx context.Context, proposal v1.Proposal)
 		return err
 	}
 
-	if proposal.Expedited {
+	switch {
+	case proposal.ProposalType == v1.ProposalTypeOptimistic:
+		votingPeriod = params.OptimisticVotingPeriod
+	case proposal.Expedited:
 		votingPeriod = params.ExpeditedVotingPeriod
-	} else {
+	default:
 		votingPeriod = params.VotingPeriod
 	}
 	endTime := proposal.VotingStartTime.Add(*votingPeriod)
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -173,6 +174,86 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitOptimisticProposal() {
+	suite.reset()
+	ctx, proposer := suite.ctx, suite.addrs[0]
+	msgs := TestProposal[:1]
+	params, err := suite.govKeeper.Params.Get(ctx)
+	suite.Require().NoError(err)
+
+	// the proposer is not authorized to submit optimistic proposals
+	_, err = suite.govKeeper.SubmitOptimisticProposal(ctx, msgs, "", "title", "summary", proposer)
+	suite.Require().ErrorIs(err, types.ErrInvalidProposer)
+
+	// the proposal messages are not allowed in optimistic proposals
+	params.OptimisticAuthorizedAddresses = []string{proposer.String()}
+	suite.Require().NoError(suite.govKeeper.Params.Set(ctx, params))
+	_, err = suite.govKeeper.SubmitOptimisticProposal(ctx, msgs, "", "title", "summary", proposer)
+	suite.Require().ErrorIs(err, types.ErrInvalidProposalMsg)
+
+	params.OptimisticAllowedMsgUrls = []string{sdk.MsgTypeURL(msgs[0])}
+	suite.Require().NoError(suite.govKeeper.Params.Set(ctx, params))
+	proposal, err := suite.govKeeper.SubmitOptimisticProposal(ctx, msgs, "", "title", "summary", proposer)
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.ProposalTypeOptimistic, proposal.ProposalType)
+	suite.Require().Equal(sdk.Coins(params.OptimisticMinDeposit), proposal.GetMinDepositFromParams(params))
+
+	// the voting period of optimistic proposals is the optimistic voting period
+	suite.Require().NoError(suite.govKeeper.ActivateVotingPeriod(ctx, proposal))
+	proposal, err = suite.govKeeper.Proposals.Get(ctx, proposal.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(proposal.VotingStartTime.Add(*params.OptimisticVotingPeriod), *proposal.VotingEndTime)
+
+	// optimistic proposals can only be voted against
+	err = suite.govKeeper.AddVote(ctx, proposal.Id, suite.addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	suite.Require().ErrorIs(err, types.ErrInvalidVote)
+	suite.Require().NoError(suite.govKeeper.AddVote(ctx, proposal.Id, suite.addrs[1], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	suite.Require().NoError(suite.govKeeper.AddVote(ctx, proposal.Id, suite.addrs[2], v1.NewNonSplitVoteOption(v1.OptionNoWithVeto), ""))
+}
+
+func (suite *KeeperTestSuite) TestSubmitMultipleChoiceProposal() {
+	suite.reset()
+	ctx, proposer := suite.ctx, suite.addrs[0]
+	optionOne, err := v1.NewProposalOption("one", TestProposal[:1])
+	suite.Require().NoError(err)
+	optionTwo, err := v1.NewProposalOption("two", nil)
+	suite.Require().NoError(err)
+
+	// multiple choice proposals must have at least 2 options
+	_, err = suite.govKeeper.SubmitMultipleChoiceProposal(ctx, []*v1.ProposalOption{optionOne}, "", "title", "summary", proposer)
+	suite.Require().ErrorIs(err, types.ErrInvalidProposalContent)
+
+	// the options must have a title
+	_, err = suite.govKeeper.SubmitMultipleChoiceProposal(ctx, []*v1.ProposalOption{optionOne, {}}, "", "title", "summary", proposer)
+	suite.Require().ErrorIs(err, types.ErrInvalidProposalContent)
+
+	proposal, err := suite.govKeeper.SubmitMultipleChoiceProposal(ctx, []*v1.ProposalOption{optionOne, optionTwo}, "", "title", "summary", proposer)
+	suite.Require().NoError(err)
+	suite.Require().Equal(v1.ProposalTypeMultipleChoice, proposal.ProposalType)
+	suite.Require().Len(proposal.Options, 2)
+	msgs, err := proposal.GetOptionMsgs(1)
+	suite.Require().NoError(err)
+	suite.Require().Len(msgs, 1)
+	_, err = proposal.GetOptionMsgs(3)
+	suite.Require().Error(err)
+
+	// multiple choice proposals can only be voted for one of their options or abstained on
+	suite.Require().NoError(suite.govKeeper.ActivateVotingPeriod(ctx, proposal))
+	err = suite.govKeeper.AddVote(ctx, proposal.Id, suite.addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	suite.Require().ErrorIs(err, types.ErrInvalidVote)
+	err = suite.govKeeper.AddVote(ctx, proposal.Id, suite.addrs[1], v1.WeightedVoteOptions{v1.NewWeightedChoiceVoteOption(3, sdkmath.LegacyOneDec())}, "")
+	suite.Require().ErrorIs(err, types.ErrInvalidVote)
+	suite.Require().NoError(suite.govKeeper.AddVote(ctx, proposal.Id, suite.addrs[1], v1.WeightedVoteOptions{v1.NewWeightedChoiceVoteOption(2, sdkmath.LegacyOneDec())}, ""))
+	suite.Require().NoError(suite.govKeeper.AddVote(ctx, proposal.Id, suite.addrs[2], v1.NewNonSplitVoteOption(v1.OptionAbstain), ""))
+
+	// standard proposals cannot be voted for an option
+	proposal, err = suite.govKeeper.SubmitProposal(ctx, TestProposal[:1], "", "title", "summary", proposer, false)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.govKeeper.ActivateVotingPeriod(ctx, proposal))
+	err = suite.govKeeper.AddVote(ctx, proposal.Id, suite.addrs[1], v1.WeightedVoteOptions{v1.NewWeightedChoiceVoteOption(1, sdkmath.LegacyOneDec())}, "")
+	suite.Require().ErrorIs(err, types.ErrInvalidVote)
+}
+
 func (suite *KeeperTestSuite) TestCancelProposal() {
 	govAcct := suite.govKeeper.GetGovernanceAccount(suite.ctx).GetAddress().String()
 	tp := v1beta1.TextProposal{Title: "title", Description: "description"}
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
@@ -37,9 +39,37 @@ func defaultCalculateVoteResultsAndVotingPower(
 	results[v1.OptionNo] = math.LegacyZeroDec()
 	results[v1.OptionNoWithVeto] = math.LegacyZeroDec()
 
+	err = k.iterateVotingPower(ctx, proposal, validators, func(options v1.WeightedVoteOptions, votingPower math.LegacyDec) {
+		for _, option := range options {
+			weight, _ := math.LegacyNewDecFromStr(option.Weight)
+			subPower := votingPower.Mul(weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
+		totalVotingPower = totalVotingPower.Add(votingPower)
+	})
+	if err != nil {
+		return math.LegacyZeroDec(), nil, err
+	}
+
+	return totalVotingPower, results, nil
+}
+
+// iterateVotingPower calls fn with the vote options and the voting power of
+// each delegation of the voters of a proposal, then of each delegation of the
+// delegators not having voted of the governors having voted, and then of each
+// validator having voted with the voting power of its delegators not having
+// voted. The votes are removed from the store.
+func (k Keeper) iterateVotingPower(
+	ctx context.Context,
+	proposal v1.Proposal,
+	validators map[string]v1.ValidatorGovInfo,
+	fn func(options v1.WeightedVoteOptions, votingPower math.LegacyDec),
+) error {
 	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposal.Id)
 	votesToRemove := []collections.Pair[uint64, sdk.AccAddress]{}
-	err = k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
+	voters := make(map[string]struct{})
+	governorVotes := []v1.Vote{}
+	err := k.Votes.Walk(ctx, rng, func(key collections.Pair[uint64, sdk.AccAddress], vote v1.Vote) (bool, error) {
 		// if validator, just record it in the map
 		voter, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
 		if err != nil {
@@ -55,44 +85,52 @@ func defaultCalculateVoteResultsAndVotingPower(
 			validators[valAddrStr] = val
 		}
 
-		// iterate over all delegations from voter, deduct from any delegated-to validators
-		err = k.sk.IterateDelegations(ctx, voter, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
-			valAddrStr := delegation.GetValidatorAddr()
-
-			if val, ok := validators[valAddrStr]; ok {
-				// There is no need to handle the special case that validator address equal to voter address.
-				// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
-				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
-				validators[valAddrStr] = val
-
-				// delegation shares * bonded / total shares
-				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
-
-				for _, option := range vote.Options {
-					weight, _ := math.LegacyNewDecFromStr(option.Weight)
-					subPower := votingPower.Mul(weight)
-					results[option.Option] = results[option.Option].Add(subPower)
-				}
-				totalVotingPower = totalVotingPower.Add(votingPower)
-			}
-
-			return false
-		})
+		// if governor, record its vote to tally its delegators not having voted
+		isGovernor, err := k.Governors.Has(ctx, voter)
 		if err != nil {
 			return false, err
 		}
+		if isGovernor {
+			governorVotes = append(governorVotes, vote)
+		}
+		voters[string(voter)] = struct{}{}
+
+		if err := k.iterateDelegationsVotingPower(ctx, voter, vote.Options, validators, fn); err != nil {
+			return false, err
+		}
 
 		votesToRemove = append(votesToRemove, key)
 		return false, nil
 	})
 	if err != nil {
-		return math.LegacyZeroDec(), nil, fmt.Errorf("error while iterating delegations: %w", err)
+		return fmt.Errorf("error while iterating delegations: %w", err)
+	}
+
+	// iterate over the delegators of the governors having voted, voting on behalf
+	// of those not having voted themselves
+	for _, vote := range governorVotes {
+		governor, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
+		if err != nil {
+			return err
+		}
+
+		rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](governor)
+		err = k.GovernorDelegations.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.AccAddress], _ v1.GovernorDelegation) (bool, error) {
+			if _, ok := voters[string(key.K2())]; ok {
+				return false, nil
+			}
+
+			return false, k.iterateDelegationsVotingPower(ctx, key.K2(), vote.Options, validators, fn)
+		})
+		if err != nil {
+			return fmt.Errorf("error while iterating governor delegations: %w", err)
+		}
 	}
 
 	// remove all votes from store
 	for _, key := range votesToRemove {
 		if err := k.Votes.Remove(ctx, key); err != nil {
-			return math.LegacyDec{}, nil, fmt.Errorf("error while removing vote (%d/%s): %w", key.K1(), key.K2(), err)
+			return fmt.Errorf("error while removing vote (%d/%s): %w", key.K1(), key.K2(), err)
 		}
 	}
 
@@ -104,16 +142,39 @@ func defaultCalculateVoteResultsAndVotingPower(
 
 		sharesAfterDeductions := val.DelegatorShares.Sub(val.DelegatorDeductions)
 		votingPower := sharesAfterDeductions.MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+		fn(val.Vote, votingPower)
+	}
 
-		for _, option := range val.Vote {
-			weight, _ := math.LegacyNewDecFromStr(option.Weight)
-			subPower := votingPower.Mul(weight)
-			results[option.Option] = results[option.Option].Add(subPower)
+	return nil
+}
+
+// iterateDelegationsVotingPower calls fn with the vote options and the voting
+// power of each delegation of a delegator to the given bonded validators, and
+// deducts the delegation shares from the validators.
+func (k Keeper) iterateDelegationsVotingPower(
+	ctx context.Context,
+	delegator sdk.AccAddress,
+	options v1.WeightedVoteOptions,
+	validators map[string]v1.ValidatorGovInfo,
+	fn func(options v1.WeightedVoteOptions, votingPower math.LegacyDec),
+) error {
+	// iterate over all delegations from delegator, deduct from any delegated-to validators
+	return k.sk.IterateDelegations(ctx, delegator, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+		valAddrStr := delegation.GetValidatorAddr()
+
+		if val, ok := validators[valAddrStr]; ok {
+			// There is no need to handle the special case that validator address equal to voter address.
+			// Because voter's voting power will tally again even if there will be deduction of voter's voting power from validator.
+			val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
+			validators[valAddrStr] = val
+
+			// delegation shares * bonded / total shares
+			votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
+			fn(options, votingPower)
 		}
-		totalVotingPower = totalVotingPower.Add(votingPower)
-	}
 
-	return totalVotingPower, results, nil
+		return false
+	})
 }
 
 // getCurrentValidators fetches all the bonded validators, insert them into currValidators
@@ -148,6 +209,10 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 		return false, false, tallyResults, fmt.Errorf("error while getting current validators: %w", err)
 	}
 
+	if proposal.ProposalType == v1.ProposalTypeMultipleChoice {
+		return k.tallyMultipleChoice(ctx, proposal, currValidators)
+	}
+
 	tallyFn := k.calculateVoteResultsAndVotingPowerFn
 	totalVotingPower, results, err := tallyFn(ctx, k, proposal, currValidators)
 	if err != nil {
@@ -172,9 +237,29 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 		return false, false, tallyResults, fmt.Errorf("error while getting params: %w", err)
 	}
 
+	// An optimistic proposal passes unless enough of the bonded stake votes No
+	// or NoWithVeto
+	if proposal.ProposalType == v1.ProposalTypeOptimistic {
+		rejectedThreshold, err := math.LegacyNewDecFromStr(params.OptimisticRejectedThreshold)
+		if err != nil {
+			return false, false, tallyResults, fmt.Errorf("invalid optimistic rejected threshold: %w", err)
+		}
+
+		votesAgainst := results[v1.OptionNo].Add(results[v1.OptionNoWithVeto])
+		if votesAgainst.Quo(math.LegacyNewDecFromInt(totalBonded)).GT(rejectedThreshold) {
+			return false, false, tallyResults, nil
+		}
+
+		return true, false, tallyResults, nil
+	}
+
+	quorum, threshold, vetoThreshold, _, err := k.tallyParams(ctx, params, proposal)
+	if err != nil {
+		return false, false, tallyResults, fmt.Errorf("error while getting tally params: %w", err)
+	}
+
 	// If there is not enough quorum of votes, the proposal fails
 	percentVoting := totalVotingPower.Quo(math.LegacyNewDecFromInt(totalBonded))
-	quorum, _ := math.LegacyNewDecFromStr(params.Quorum)
 	if percentVoting.LT(quorum) {
 		return false, params.BurnVoteQuorum, tallyResults, nil
 	}
@@ -185,22 +270,12 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 	}
 
 	// If more than 1/3 of voters veto, proposal fails
-	vetoThreshold, _ := math.LegacyNewDecFromStr(params.VetoThreshold)
 	if results[v1.OptionNoWithVeto].Quo(totalVotingPower).GT(vetoThreshold) {
 		return false, params.BurnVoteVeto, tallyResults, nil
 	}
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
 	// For expedited 2/3
-	var thresholdStr string
-	if proposal.Expedited {
-		thresholdStr = params.GetExpeditedThreshold()
-	} else {
-		thresholdStr = params.GetThreshold()
-	}
-
-	threshold, _ := math.LegacyNewDecFromStr(thresholdStr)
-
 	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults, nil
 	}
@@ -208,3 +283,158 @@ func (k Keeper) Tally(ctx context.Context, proposal v1.Proposal) (passes, burnDe
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
 	return false, false, tallyResults, nil
 }
+
+// tallyMultipleChoice tallies a multiple choice proposal, which passes if the
+// quorum is reached and one of its options gets strictly more votes than all
+// the others. The winning option is given by the WinningOption of the tally
+// results.
+func (k Keeper) tallyMultipleChoice(ctx context.Context, proposal v1.Proposal, validators map[string]v1.ValidatorGovInfo) (passes, burnDeposits bool, tallyResults v1.TallyResult, err error) {
+	totalVotingPower, abstain := math.LegacyZeroDec(), math.LegacyZeroDec()
+	optionCounts := make([]math.LegacyDec, len(proposal.Options))
+	for i := range optionCounts {
+		optionCounts[i] = math.LegacyZeroDec()
+	}
+
+	err = k.iterateVotingPower(ctx, proposal, validators, func(options v1.WeightedVoteOptions, votingPower math.LegacyDec) {
+		for _, option := range options {
+			weight, _ := math.LegacyNewDecFromStr(option.Weight)
+			subPower := votingPower.Mul(weight)
+			switch {
+			case option.Choice == 0:
+				abstain = abstain.Add(subPower)
+			case int(option.Choice) <= len(optionCounts):
+				optionCounts[option.Choice-1] = optionCounts[option.Choice-1].Add(subPower)
+			}
+		}
+		totalVotingPower = totalVotingPower.Add(votingPower)
+	})
+	if err != nil {
+		return false, false, tallyResults, fmt.Errorf("error while calculating tally results: %w", err)
+	}
+
+	counts := make([]math.Int, len(optionCounts))
+	for i, count := range optionCounts {
+		counts[i] = count.TruncateInt()
+	}
+	tallyResults = v1.NewMultipleChoiceTallyResult(abstain.TruncateInt(), counts)
+
+	// If there is no staked coins, the proposal fails
+	totalBonded, err := k.sk.TotalBondedTokens(ctx)
+	if err != nil {
+		return false, false, tallyResults, err
+	}
+
+	if totalBonded.IsZero() {
+		return false, false, tallyResults, nil
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, false, tallyResults, fmt.Errorf("error while getting params: %w", err)
+	}
+
+	quorum, _, _, _, err := k.tallyParams(ctx, params, proposal)
+	if err != nil {
+		return false, false, tallyResults, fmt.Errorf("error while getting tally params: %w", err)
+	}
+
+	// If there is not enough quorum of votes, the proposal fails
+	percentVoting := totalVotingPower.Quo(math.LegacyNewDecFromInt(totalBonded))
+	if percentVoting.LT(quorum) {
+		return false, params.BurnVoteQuorum, tallyResults, nil
+	}
+
+	// If no option has strictly more votes than all the others, the proposal fails
+	return tallyResults.WinningOption() != 0, false, tallyResults, nil
+}
+
+// tallyParams returns the quorum, threshold and veto threshold tallying a
+// proposal, and the type URLs of its messages whose message based params apply.
+// Each message is tallied with the message based params of its type if they
+// apply to it, or else with the tally params of the module params, and the
+// proposal with the strictest of them: the highest quorum and threshold and the
+// lowest veto threshold. Expedited proposals require at least the expedited
+// threshold.
+func (k Keeper) tallyParams(ctx context.Context, params v1.Params, proposal v1.Proposal) (quorum, threshold, vetoThreshold math.LegacyDec, msgURLs []string, err error) {
+	msgs, err := proposal.GetMsgs()
+	if err != nil {
+		return quorum, threshold, vetoThreshold, nil, err
+	}
+	useModuleParams := len(msgs) == 0
+
+	// any option of a multiple choice proposal may win, so the messages of all
+	// its options are tallied, and an option without messages is tallied with
+	// the module params.
+	if proposal.ProposalType == v1.ProposalTypeMultipleChoice {
+		useModuleParams = false
+		for _, option := range proposal.Options {
+			optionMsgs, err := option.GetMsgs()
+			if err != nil {
+				return quorum, threshold, vetoThreshold, nil, err
+			}
+			msgs = append(msgs, optionMsgs...)
+			useModuleParams = useModuleParams || len(optionMsgs) == 0
+		}
+	}
+
+	var tallyParams []v1.MessageBasedParams
+	for _, msg := range msgs {
+		msgURL := sdk.MsgTypeURL(msg)
+		msgParams, err := k.MessageBasedParams.Get(ctx, msgURL)
+		if errors.Is(err, collections.ErrNotFound) || (err == nil && !msgParams.AppliesTo(msgAmount(msg))) {
+			useModuleParams = true
+			continue
+		} else if err != nil {
+			return quorum, threshold, vetoThreshold, nil, err
+		}
+
+		tallyParams = append(tallyParams, msgParams)
+		if !slices.Contains(msgURLs, msgURL) {
+			msgURLs = append(msgURLs, msgURL)
+		}
+	}
+
+	if useModuleParams {
+		tallyParams = append(tallyParams, v1.NewMessageBasedParams(params.Quorum, params.Threshold, params.VetoThreshold, nil))
+	}
+
+	quorum, threshold, vetoThreshold = math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyOneDec()
+	for _, p := range tallyParams {
+		pQuorum, err := math.LegacyNewDecFromStr(p.Quorum)
+		if err != nil {
+			return quorum, threshold, vetoThreshold, nil, fmt.Errorf("invalid quorum: %w", err)
+		}
+		pThreshold, err := math.LegacyNewDecFromStr(p.Threshold)
+		if err != nil {
+			return quorum, threshold, vetoThreshold, nil, fmt.Errorf("invalid threshold: %w", err)
+		}
+		pVetoThreshold, err := math.LegacyNewDecFromStr(p.VetoThreshold)
+		if err != nil {
+			return quorum, threshold, vetoThreshold, nil, fmt.Errorf("invalid veto threshold: %w", err)
+		}
+
+		quorum = math.LegacyMaxDec(quorum, pQuorum)
+		threshold = math.LegacyMaxDec(threshold, pThreshold)
+		vetoThreshold = math.LegacyMinDec(vetoThreshold, pVetoThreshold)
+	}
+
+	if proposal.Expedited {
+		expeditedThreshold, err := math.LegacyNewDecFromStr(params.ExpeditedThreshold)
+		if err != nil {
+			return quorum, threshold, vetoThreshold, nil, fmt.Errorf("invalid expedited threshold: %w", err)
+		}
+		threshold = math.LegacyMaxDec(threshold, expeditedThreshold)
+	}
+
+	return quorum, threshold, vetoThreshold, msgURLs, nil
+}
+
+// msgAmount returns the amount of a message implementing v1.MsgWithAmount, or
+// nil if the message does not.
+func msgAmount(msg sdk.Msg) sdk.Coins {
+	if msg, ok := msg.(v1.MsgWithAmount); ok {
+		return msg.GetAmount()
+	}
+
+	return nil
+}
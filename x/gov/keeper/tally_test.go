@@ -10,6 +10,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
@@ -113,3 +115,47 @@ func TestMultipleProposalsVoteRemoval(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestGovernorVoteEvents(t *testing.T) {
+	govKeeper, authKeeper, bankKeeper, stakingKeeper, _, _, ctx := setupGovKeeper(t)
+	authKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	addrs := simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 3, math.NewInt(30000000))
+	governor := addrs[0]
+	require.NoError(t, govKeeper.CreateGovernor(ctx, governor, ""))
+	require.NoError(t, govKeeper.DelegateGovernor(ctx, addrs[1], governor))
+	require.NoError(t, govKeeper.DelegateGovernor(ctx, addrs[2], governor))
+
+	proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "test", "summary", governor, false)
+	require.NoError(t, err)
+	proposal.Status = v1.StatusVotingPeriod
+	require.NoError(t, govKeeper.SetProposal(ctx, proposal))
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, governor, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+	require.NoError(t, govKeeper.AddVote(ctx, proposal.Id, addrs[2], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+
+	governorVotes := func(ctx sdk.Context) []sdk.Event {
+		var events []sdk.Event
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeGovernorVote {
+				events = append(events, event)
+			}
+		}
+		return events
+	}
+
+	// the governor vote is emitted for the governor having voted
+	emitCtx := ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, govKeeper.EmitGovernorVotes(emitCtx, proposal))
+	events := governorVotes(emitCtx)
+	require.Len(t, events, 1)
+	governorAttr, ok := events[0].GetAttribute(types.AttributeKeyGovernor)
+	require.True(t, ok)
+	require.Equal(t, governor.String(), governorAttr.Value)
+
+	// the tally, also run by the TallyResult query, emits no governor vote
+	tallyCtx := ctx.WithEventManager(sdk.NewEventManager())
+	_, _, _, err = govKeeper.Tally(tallyCtx, proposal)
+	require.NoError(t, err)
+	require.Empty(t, governorVotes(tallyCtx))
+}
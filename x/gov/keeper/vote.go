@@ -35,6 +35,29 @@ func (k Keeper) AddVote(ctx context.Context, proposalID uint64, voterAddr sdk.Ac
 		}
 	}
 
+	proposal, err := k.Proposals.Get(ctx, proposalID)
+	if err != nil {
+		return err
+	}
+
+	for _, option := range options {
+		switch proposal.ProposalType {
+		case v1.ProposalTypeOptimistic:
+			// optimistic proposals can only be voted against
+			if option.Option != v1.OptionNo && option.Option != v1.OptionNoWithVeto {
+				return errors.Wrapf(types.ErrInvalidVote, "optimistic proposals can only be voted no or no with veto: %s", option.Option)
+			}
+		case v1.ProposalTypeMultipleChoice:
+			// multiple choice proposals can only be voted for one of their options
+			if !v1.ValidMultipleChoiceVoteOption(*option, len(proposal.Options)) {
+				return errors.Wrapf(types.ErrInvalidVote, "multiple choice proposals can only be voted yes on one of their %d options or abstain: %s", len(proposal.Options), option)
+			}
+		}
+		if proposal.ProposalType != v1.ProposalTypeMultipleChoice && option.Choice != 0 {
+			return errors.Wrapf(types.ErrInvalidVote, "only multiple choice proposals can be voted for an option: %s", option)
+		}
+	}
+
 	vote := v1.NewVote(proposalID, voterAddr, options, metadata)
 	err = k.Votes.Set(ctx, collections.Join(proposalID, voterAddr), vote)
 	if err != nil {
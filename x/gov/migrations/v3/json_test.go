@@ -86,6 +86,9 @@ func TestMigrateJSON(t *testing.T) {
 		]
 	},
 	"deposits": [],
+	"governor_delegations": [],
+	"governors": [],
+	"message_based_params": [],
 	"params": null,
 	"proposals": [
 		{
@@ -96,6 +99,7 @@ func TestMigrateJSON(t *testing.T) {
 				"abstain_count": "0",
 				"no_count": "0",
 				"no_with_veto_count": "0",
+				"option_counts": [],
 				"yes_count": "0"
 			},
 			"id": "1",
@@ -111,6 +115,8 @@ func TestMigrateJSON(t *testing.T) {
 				}
 			],
 			"metadata": "",
+			"options": [],
+			"proposal_type": "PROPOSAL_TYPE_UNSPECIFIED",
 			"proposer": "",
 			"status": "PROPOSAL_STATUS_DEPOSIT_PERIOD",
 			"submit_time": "2001-09-09T01:46:40Z",
@@ -123,7 +129,8 @@ func TestMigrateJSON(t *testing.T) {
 				}
 			],
 			"voting_end_time": "2001-09-09T01:46:40Z",
-			"voting_start_time": "2001-09-09T01:46:40Z"
+			"voting_start_time": "2001-09-09T01:46:40Z",
+			"winning_option": 0
 		}
 	],
 	"starting_proposal_id": "1",
@@ -137,6 +144,7 @@ func TestMigrateJSON(t *testing.T) {
 			"metadata": "",
 			"options": [
 				{
+					"choice": 0,
 					"option": "VOTE_OPTION_ABSTAIN",
 					"weight": "1.000000000000000000"
 				}
@@ -148,6 +156,7 @@ func TestMigrateJSON(t *testing.T) {
 			"metadata": "",
 			"options": [
 				{
+					"choice": 0,
 					"option": "VOTE_OPTION_NO",
 					"weight": "1.000000000000000000"
 				}
@@ -60,6 +60,9 @@ func TestMigrateJSON(t *testing.T) {
 	"constitution": "",
 	"deposit_params": null,
 	"deposits": [],
+	"governor_delegations": [],
+	"governors": [],
+	"message_based_params": [],
 	"params": {
 		"burn_proposal_deposit_prevote": false,
 		"burn_vote_quorum": false,
@@ -81,6 +84,16 @@ func TestMigrateJSON(t *testing.T) {
 		],
 		"min_deposit_ratio": "0.010000000000000000",
 		"min_initial_deposit_ratio": "0.000000000000000000",
+		"optimistic_allowed_msg_urls": [],
+		"optimistic_authorized_addresses": [],
+		"optimistic_min_deposit": [
+			{
+				"amount": "10000000",
+				"denom": "stake"
+			}
+		],
+		"optimistic_rejected_threshold": "0.100000000000000000",
+		"optimistic_voting_period": "172800s",
 		"proposal_cancel_dest": "",
 		"proposal_cancel_ratio": "0.500000000000000000",
 		"quorum": "0.334000000000000000",
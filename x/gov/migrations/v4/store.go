@@ -43,6 +43,11 @@ func migrateParams(ctx sdk.Context, store storetypes.KVStore, legacySubspace exp
 		defaultParams.BurnVoteQuorum,
 		defaultParams.BurnVoteVeto,
 		defaultParams.MinDepositRatio,
+		defaultParams.OptimisticAuthorizedAddresses,
+		defaultParams.OptimisticAllowedMsgUrls,
+		defaultParams.OptimisticMinDeposit,
+		*defaultParams.OptimisticVotingPeriod,
+		defaultParams.OptimisticRejectedThreshold,
 	)
 
 	bz, err := cdc.Marshal(&params)
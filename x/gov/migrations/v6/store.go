@@ -0,0 +1,49 @@
+package v6
+
+import (
+	corestoretypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// ParamsKey is the key of x/gov params
+var ParamsKey = []byte{0x30}
+
+// MigrateStore performs in-place store migrations from v5 (v0.50) to v6. The
+// migration includes:
+//
+// Addition of the new optimistic proposal parameters that are set to their
+// default values, which authorize no one to submit optimistic proposals.
+func MigrateStore(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	store := storeService.OpenKVStore(ctx)
+	paramsBz, err := store.Get(ParamsKey)
+	if err != nil {
+		return err
+	}
+
+	var params govv1.Params
+	err = cdc.Unmarshal(paramsBz, &params)
+	if err != nil {
+		return err
+	}
+
+	defaultParams := govv1.DefaultParams()
+	if len(params.OptimisticMinDeposit) == 0 {
+		params.OptimisticMinDeposit = params.MinDeposit
+	}
+	if params.OptimisticVotingPeriod == nil {
+		params.OptimisticVotingPeriod = params.VotingPeriod
+	}
+	if params.OptimisticRejectedThreshold == "" {
+		params.OptimisticRejectedThreshold = defaultParams.OptimisticRejectedThreshold
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(ParamsKey, bz)
+}
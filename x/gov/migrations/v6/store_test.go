@@ -0,0 +1,48 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	v6 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v6"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(gov.AppModuleBasic{}, bank.AppModuleBasic{}).Codec
+	govKey := storetypes.NewKVStoreKey("gov")
+	ctx := testutil.DefaultContext(govKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(govKey)
+	storeService := runtime.NewKVStoreService(govKey)
+
+	// Set the params of a v5 chain, without the optimistic params.
+	oldParams := v1.DefaultParams()
+	oldParams.OptimisticMinDeposit = nil
+	oldParams.OptimisticVotingPeriod = nil
+	oldParams.OptimisticRejectedThreshold = ""
+	bz, err := cdc.Marshal(&oldParams)
+	require.NoError(t, err)
+	store.Set(v6.ParamsKey, bz)
+
+	// Run migrations.
+	err = v6.MigrateStore(ctx, storeService, cdc)
+	require.NoError(t, err)
+
+	// Check params
+	var params v1.Params
+	bz = store.Get(v6.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &params))
+	require.NoError(t, params.ValidateBasic())
+	require.Empty(t, params.OptimisticAuthorizedAddresses)
+	require.Equal(t, oldParams.MinDeposit, params.OptimisticMinDeposit)
+	require.Equal(t, oldParams.VotingPeriod, params.OptimisticVotingPeriod)
+	require.Equal(t, v1.DefaultParams().OptimisticRejectedThreshold, params.OptimisticRejectedThreshold)
+}
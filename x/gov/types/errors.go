@@ -25,4 +25,8 @@ var (
 	ErrInvalidProposal         = errors.Register(ModuleName, 21, "invalid proposal")
 	ErrSummaryTooLong          = errors.Register(ModuleName, 22, "summary too long")
 	ErrInvalidDepositDenom     = errors.Register(ModuleName, 23, "invalid deposit denom")
+
+	ErrGovernorNotFound          = errors.Register(ModuleName, 24, "governor not found")
+	ErrGovernorExists            = errors.Register(ModuleName, 25, "governor already exists")
+	ErrInvalidGovernorDelegation = errors.Register(ModuleName, 26, "invalid governor delegation")
 )
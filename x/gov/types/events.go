@@ -9,23 +9,33 @@ const (
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeCancelProposal   = "cancel_proposal"
 
-	AttributeKeyProposalResult              = "proposal_result"
-	AttributeKeyVoter                       = "voter"
-	AttributeKeyOption                      = "option"
-	AttributeKeyProposalID                  = "proposal_id"
-	AttributeKeyDepositor                   = "depositor"
-	AttributeKeyProposalMessages            = "proposal_messages" // Msg type_urls in the proposal
-	AttributeKeyVotingPeriodStart           = "voting_period_start"
-	AttributeKeyProposalLog                 = "proposal_log"                // log of proposal execution
-	AttributeValueProposalDropped           = "proposal_dropped"            // didn't meet min deposit
-	AttributeValueProposalPassed            = "proposal_passed"             // met vote quorum
-	AttributeValueProposalRejected          = "proposal_rejected"           // didn't meet vote quorum
-	AttributeValueExpeditedProposalRejected = "expedited_proposal_rejected" // didn't meet expedited vote quorum
-	AttributeValueProposalFailed            = "proposal_failed"             // error on proposal handler
-	AttributeValueProposalCanceled          = "proposal_canceled"           // error on proposal handler
-	AttributeKeyProposalProposer            = "proposal_proposer"           // account address of the proposer
+	AttributeKeyProposalResult               = "proposal_result"
+	AttributeKeyVoter                        = "voter"
+	AttributeKeyOption                       = "option"
+	AttributeKeyProposalID                   = "proposal_id"
+	AttributeKeyDepositor                    = "depositor"
+	AttributeKeyProposalMessages             = "proposal_messages" // Msg type_urls in the proposal
+	AttributeKeyVotingPeriodStart            = "voting_period_start"
+	AttributeKeyProposalLog                  = "proposal_log"                 // log of proposal execution
+	AttributeValueProposalDropped            = "proposal_dropped"             // didn't meet min deposit
+	AttributeValueProposalPassed             = "proposal_passed"              // met vote quorum
+	AttributeValueProposalRejected           = "proposal_rejected"            // didn't meet vote quorum
+	AttributeValueExpeditedProposalRejected  = "expedited_proposal_rejected"  // didn't meet expedited vote quorum
+	AttributeValueOptimisticProposalRejected = "optimistic_proposal_rejected" // met optimistic rejected threshold
+	AttributeValueProposalFailed             = "proposal_failed"              // error on proposal handler
+	AttributeValueProposalCanceled           = "proposal_canceled"            // error on proposal handler
+	AttributeKeyProposalProposer             = "proposal_proposer"            // account address of the proposer
 
 	AttributeKeyProposalType   = "proposal_type"
 	AttributeSignalTitle       = "signal_title"
 	AttributeSignalDescription = "signal_description"
+
+	EventTypeCreateGovernor     = "create_governor"
+	EventTypeDelegateGovernor   = "delegate_governor"
+	EventTypeUndelegateGovernor = "undelegate_governor"
+	EventTypeGovernorVote       = "governor_vote"
+
+	AttributeKeyGovernor    = "governor"
+	AttributeKeyDelegator   = "delegator"
+	AttributeKeyVotingPower = "voting_power" // voting power of the delegators a governor votes on behalf of
 )
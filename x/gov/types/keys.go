@@ -25,4 +25,8 @@ var (
 	VotesKeyPrefix                = collections.NewPrefix(32) // VotesKeyPrefix stores the votes of proposals.
 	ParamsKey                     = collections.NewPrefix(48) // ParamsKey stores the module's params.
 	ConstitutionKey               = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
+	MessageBasedParamsKey         = collections.NewPrefix(50) // MessageBasedParamsKey stores the message based params, by message type URL.
+	GovernorsKeyPrefix            = collections.NewPrefix(51) // GovernorsKeyPrefix stores the governors.
+	GovernorDelegationsKeyPrefix  = collections.NewPrefix(52) // GovernorDelegationsKeyPrefix stores the governor delegations, by governor and delegator.
+	GovernorDelegatorIndexPrefix  = collections.NewPrefix(53) // GovernorDelegatorIndexPrefix indexes the governor delegations by delegator.
 )
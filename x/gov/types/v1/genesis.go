@@ -102,6 +102,63 @@ func ValidateGenesis(data *GenesisState) error {
 		return data.Params.ValidateBasic()
 	})
 
+	// verify message based params
+	errGroup.Go(func() error {
+		msgURLs := make(map[string]struct{})
+		for _, entry := range data.MessageBasedParams {
+			if entry.MsgUrl == "" {
+				return errors.New("message based params msg url cannot be empty")
+			}
+
+			if _, ok := msgURLs[entry.MsgUrl]; ok {
+				return fmt.Errorf("duplicate message based params: %s", entry.MsgUrl)
+			}
+
+			if err := entry.Params.ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid message based params of %s: %w", entry.MsgUrl, err)
+			}
+
+			msgURLs[entry.MsgUrl] = struct{}{}
+		}
+
+		return nil
+	})
+
+	// verify governors and governor delegations
+	errGroup.Go(func() error {
+		governors := make(map[string]struct{})
+		for _, g := range data.Governors {
+			if g.Address == "" {
+				return errors.New("governor address cannot be empty")
+			}
+
+			if _, ok := governors[g.Address]; ok {
+				return fmt.Errorf("duplicate governor: %s", g.Address)
+			}
+
+			governors[g.Address] = struct{}{}
+		}
+
+		delegators := make(map[string]struct{})
+		for _, d := range data.GovernorDelegations {
+			if _, ok := governors[d.GovernorAddress]; !ok {
+				return fmt.Errorf("governor delegation of %s has non-existent governor: %s", d.DelegatorAddress, d.GovernorAddress)
+			}
+
+			if _, ok := governors[d.DelegatorAddress]; ok {
+				return fmt.Errorf("governor %s cannot delegate to a governor", d.DelegatorAddress)
+			}
+
+			if _, ok := delegators[d.DelegatorAddress]; ok {
+				return fmt.Errorf("duplicate governor delegation: %s", d.DelegatorAddress)
+			}
+
+			delegators[d.DelegatorAddress] = struct{}{}
+		}
+
+		return nil
+	})
+
 	return errGroup.Wait()
 }
 
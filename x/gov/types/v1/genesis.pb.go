@@ -6,6 +6,7 @@ package v1
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -49,6 +50,12 @@ type GenesisState struct {
 	// There are no amendments, to go outside of scope, just fork.
 	// constitution is an immutable string in genesis for a chain builder to lay out their vision, ideas and ideals.
 	Constitution string `protobuf:"bytes,9,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// message_based_params defines the message based params of the message types.
+	MessageBasedParams []MessageBasedParamsEntry `protobuf:"bytes,10,rep,name=message_based_params,json=messageBasedParams,proto3" json:"message_based_params"`
+	// governors defines all the governors present at genesis.
+	Governors []Governor `protobuf:"bytes,11,rep,name=governors,proto3" json:"governors"`
+	// governor_delegations defines all the governor delegations present at genesis.
+	GovernorDelegations []GovernorDelegation `protobuf:"bytes,12,rep,name=governor_delegations,json=governorDelegations,proto3" json:"governor_delegations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -150,6 +157,27 @@ func (m *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (m *GenesisState) GetMessageBasedParams() []MessageBasedParamsEntry {
+	if m != nil {
+		return m.MessageBasedParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGovernors() []Governor {
+	if m != nil {
+		return m.Governors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGovernorDelegations() []GovernorDelegation {
+	if m != nil {
+		return m.GovernorDelegations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
 }
@@ -157,33 +185,39 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x4e, 0xea, 0x40,
-	0x18, 0xc5, 0x19, 0xfe, 0x5d, 0x18, 0xca, 0xbd, 0xc9, 0x70, 0xef, 0xa5, 0x82, 0x69, 0x1a, 0x57,
-	0x35, 0x86, 0x16, 0x50, 0xc2, 0x5a, 0x82, 0x21, 0xee, 0x48, 0x35, 0x2e, 0xdc, 0x90, 0x42, 0x9b,
-	0xda, 0x08, 0x9d, 0x86, 0x19, 0x26, 0xf2, 0x16, 0xbe, 0x84, 0x6f, 0xe0, 0x43, 0xb8, 0x64, 0x69,
-	0x5c, 0x19, 0x78, 0x11, 0xc3, 0x4c, 0x6b, 0xa1, 0xb2, 0x9c, 0xef, 0xfc, 0xce, 0x99, 0x93, 0x2f,
-	0x1f, 0xac, 0x4f, 0x30, 0x99, 0x61, 0x62, 0xb8, 0x98, 0x19, 0xac, 0x65, 0xb8, 0x8e, 0xef, 0x10,
-	0x8f, 0xe8, 0xc1, 0x1c, 0x53, 0x8c, 0xca, 0x42, 0xd4, 0x5d, 0xcc, 0x74, 0xd6, 0xaa, 0x55, 0x13,
-	0x2c, 0x66, 0x82, 0xab, 0x1d, 0x09, 0x61, 0xc4, 0x5f, 0x46, 0x68, 0xe2, 0x8f, 0x93, 0x97, 0x2c,
-	0x94, 0x06, 0x22, 0xf4, 0x86, 0x5a, 0xd4, 0x41, 0x4d, 0xf8, 0x97, 0x50, 0x6b, 0x4e, 0x3d, 0xdf,
-	0xdd, 0xf2, 0x01, 0x26, 0xd6, 0x74, 0xe4, 0xd9, 0x32, 0x50, 0x81, 0x96, 0x35, 0x51, 0xa4, 0x0d,
-	0x43, 0xe9, 0xda, 0x46, 0x6d, 0x58, 0xb0, 0x9d, 0x00, 0x13, 0x8f, 0x12, 0x39, 0xad, 0x66, 0xb4,
-	0x52, 0xfb, 0xbf, 0xbe, 0x57, 0x4c, 0xef, 0x0b, 0xd9, 0xfc, 0xe6, 0xd0, 0x29, 0xcc, 0x31, 0x4c,
-	0x1d, 0x22, 0x67, 0xb8, 0xa1, 0x92, 0x30, 0xdc, 0x61, 0xea, 0x98, 0x82, 0x40, 0x1d, 0x58, 0x8c,
-	0x7a, 0x10, 0x39, 0xcb, 0xf1, 0x6a, 0x02, 0x8f, 0xca, 0x98, 0x31, 0x89, 0x06, 0xf0, 0x77, 0xf8,
-	0xdb, 0x28, 0xb0, 0xe6, 0xd6, 0x8c, 0xc8, 0x39, 0x15, 0x68, 0xa5, 0xf6, 0xf1, 0xe1, 0x6e, 0x43,
-	0xce, 0xf4, 0xd2, 0x32, 0x30, 0xcb, 0xf6, 0xee, 0x08, 0xf5, 0x61, 0x99, 0x61, 0xb1, 0x0e, 0x91,
-	0x93, 0xe7, 0x39, 0xf5, 0x9f, 0x95, 0xb7, 0x6b, 0x89, 0x63, 0x24, 0xb6, 0x33, 0x41, 0x97, 0x50,
-	0xa2, 0xd6, 0x74, 0xba, 0x8c, 0x42, 0x7e, 0xf1, 0x90, 0x5a, 0x22, 0xe4, 0x76, 0x8b, 0xec, 0x64,
-	0x94, 0x68, 0x3c, 0x40, 0x3d, 0x98, 0x0f, 0xcd, 0x05, 0x6e, 0xfe, 0x97, 0xdc, 0x82, 0xf0, 0x55,
-	0x3e, 0x5e, 0x1b, 0x7f, 0x84, 0xd2, 0x20, 0xf6, 0xa3, 0xda, 0xd4, 0x2f, 0xba, 0x66, 0xe8, 0x44,
-	0x5d, 0x28, 0x4d, 0xb0, 0x4f, 0xa8, 0x47, 0x17, 0xd4, 0xc3, 0xbe, 0x5c, 0x54, 0x81, 0x56, 0x3c,
-	0x60, 0xe9, 0x34, 0xcd, 0x3d, 0xb0, 0x77, 0xf5, 0xb6, 0x56, 0xc0, 0x6a, 0xad, 0x80, 0xcf, 0xb5,
-	0x02, 0x9e, 0x37, 0x4a, 0x6a, 0xb5, 0x51, 0x52, 0xef, 0x1b, 0x25, 0x75, 0x7f, 0xe6, 0x7a, 0xf4,
-	0x61, 0x31, 0xd6, 0x27, 0x78, 0x16, 0x9e, 0x96, 0x11, 0x47, 0x19, 0x4f, 0xfc, 0x1a, 0xe9, 0x32,
-	0x70, 0x88, 0xc1, 0x5a, 0xe3, 0x3c, 0xbf, 0xba, 0xf3, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x28,
-	0xf7, 0x72, 0x00, 0xd7, 0x02, 0x00, 0x00,
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x4f, 0x8f, 0xd2, 0x40,
+	0x18, 0xc6, 0xe9, 0xfe, 0xc1, 0x65, 0x00, 0x4d, 0x06, 0x94, 0xca, 0x9a, 0x8a, 0x1e, 0x0c, 0xc6,
+	0x6c, 0x0b, 0xb8, 0x9b, 0x3d, 0xdb, 0xb0, 0x21, 0x1e, 0x34, 0x9b, 0x6a, 0x3c, 0x78, 0x69, 0x0a,
+	0x9d, 0x8c, 0x8d, 0xb4, 0x53, 0xfb, 0xce, 0x4e, 0xe4, 0x5b, 0xf8, 0x61, 0xfc, 0x10, 0x7b, 0xdc,
+	0x9b, 0xc6, 0x83, 0x31, 0xf0, 0x45, 0x4c, 0x67, 0xda, 0x2d, 0x5b, 0xe0, 0xd6, 0xbe, 0xcf, 0xef,
+	0x79, 0xde, 0xa7, 0xd3, 0x0c, 0x3a, 0x9e, 0x31, 0x08, 0x19, 0x58, 0x94, 0x09, 0x4b, 0x0c, 0x2d,
+	0x4a, 0x22, 0x02, 0x01, 0x98, 0x71, 0xc2, 0x38, 0xc3, 0x4d, 0x25, 0x9a, 0x94, 0x09, 0x53, 0x0c,
+	0xbb, 0x9d, 0x12, 0xcb, 0x84, 0xe2, 0xba, 0x8f, 0x95, 0xe0, 0xca, 0x37, 0x2b, 0x33, 0x29, 0xa9,
+	0x4d, 0x19, 0x65, 0x6a, 0x9e, 0x3e, 0xa9, 0xe9, 0xf3, 0x5f, 0x55, 0xd4, 0x98, 0xa8, 0x55, 0x1f,
+	0xb8, 0xc7, 0x09, 0x1e, 0xa0, 0x36, 0x70, 0x2f, 0xe1, 0x41, 0x44, 0xd3, 0x94, 0x98, 0x81, 0x37,
+	0x77, 0x03, 0x5f, 0xd7, 0x7a, 0x5a, 0xff, 0xc0, 0xc1, 0xb9, 0x76, 0x99, 0x49, 0x6f, 0x7d, 0x3c,
+	0x42, 0x47, 0x3e, 0x89, 0x19, 0x04, 0x1c, 0xf4, 0xbd, 0xde, 0x7e, 0xbf, 0x3e, 0x7a, 0x64, 0xde,
+	0xa9, 0x6b, 0x8e, 0x95, 0xec, 0xdc, 0x72, 0xf8, 0x25, 0x3a, 0x14, 0x8c, 0x13, 0xd0, 0xf7, 0xa5,
+	0xa1, 0x55, 0x32, 0x7c, 0x62, 0x9c, 0x38, 0x8a, 0xc0, 0x67, 0xa8, 0x96, 0xf7, 0x00, 0xfd, 0x40,
+	0xe2, 0x9d, 0x12, 0x9e, 0x97, 0x71, 0x0a, 0x12, 0x4f, 0xd0, 0xfd, 0x6c, 0x9b, 0x1b, 0x7b, 0x89,
+	0x17, 0x82, 0x7e, 0xd8, 0xd3, 0xfa, 0xf5, 0xd1, 0x93, 0xed, 0xdd, 0x2e, 0x25, 0x63, 0xef, 0xe9,
+	0x9a, 0xd3, 0xf4, 0xd7, 0x47, 0x78, 0x8c, 0x9a, 0x82, 0xa9, 0xe3, 0x50, 0x39, 0x55, 0x99, 0x73,
+	0xbc, 0x59, 0x39, 0x3d, 0x96, 0x22, 0xa6, 0x21, 0xd6, 0x26, 0xf8, 0x0d, 0x6a, 0x70, 0x6f, 0x3e,
+	0x5f, 0xe4, 0x21, 0xf7, 0x64, 0x48, 0xb7, 0x14, 0xf2, 0x31, 0x45, 0xd6, 0x32, 0xea, 0xbc, 0x18,
+	0x60, 0x1b, 0x55, 0x33, 0xf3, 0x91, 0x34, 0x3f, 0x2c, 0x9f, 0x82, 0xf2, 0xb5, 0xfe, 0xfc, 0x3c,
+	0x79, 0xa0, 0x94, 0x13, 0xf0, 0xbf, 0xf6, 0x06, 0xe6, 0xe9, 0xb9, 0x93, 0x39, 0xf1, 0x39, 0x6a,
+	0xcc, 0x58, 0x04, 0x3c, 0xe0, 0x57, 0x3c, 0x60, 0x91, 0x5e, 0xeb, 0x69, 0xfd, 0xda, 0x16, 0xcb,
+	0xd9, 0xc0, 0xb9, 0x03, 0xe2, 0x6f, 0xa8, 0x1d, 0x12, 0x00, 0x8f, 0x12, 0x77, 0xea, 0x01, 0xf1,
+	0xf3, 0xef, 0x40, 0xf2, 0x87, 0xbc, 0x28, 0x55, 0x79, 0xa7, 0x50, 0x3b, 0x25, 0x55, 0xad, 0x8b,
+	0x88, 0x27, 0x0b, 0xbb, 0x73, 0xfd, 0xf7, 0x69, 0x65, 0x73, 0xd9, 0xa9, 0x83, 0xc3, 0x0d, 0x07,
+	0x7e, 0x8f, 0x6a, 0x94, 0x09, 0x92, 0x44, 0x2c, 0x01, 0xbd, 0xbe, 0xf5, 0xc7, 0x4f, 0x32, 0x7d,
+	0x77, 0x70, 0x11, 0x81, 0x43, 0xd4, 0xce, 0x5f, 0x5c, 0x9f, 0xcc, 0x09, 0xf5, 0xd2, 0x2f, 0x03,
+	0xbd, 0x21, 0xa3, 0x9f, 0xed, 0x88, 0x1e, 0xdf, 0x92, 0xbb, 0x97, 0xb4, 0xe8, 0x06, 0x0c, 0xf6,
+	0xc5, 0xf5, 0xd2, 0xd0, 0x6e, 0x96, 0x86, 0xf6, 0x6f, 0x69, 0x68, 0x3f, 0x56, 0x46, 0xe5, 0x66,
+	0x65, 0x54, 0x7e, 0xaf, 0x8c, 0xca, 0xe7, 0x57, 0x34, 0xe0, 0x5f, 0xae, 0xa6, 0xe6, 0x8c, 0x85,
+	0xd9, 0x15, 0xb5, 0x8a, 0x44, 0xeb, 0xbb, 0xbc, 0xd5, 0x7c, 0x11, 0x13, 0xb0, 0xc4, 0x70, 0x5a,
+	0x95, 0xf7, 0xf4, 0xf5, 0xff, 0x01, 0x00, 0x94, 0xd5, 0xc6, 0x48, 0x1f, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -206,6 +240,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GovernorDelegations) > 0 {
+		for iNdEx := len(m.GovernorDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovernorDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Governors) > 0 {
+		for iNdEx := len(m.Governors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Governors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.MessageBasedParams) > 0 {
+		for iNdEx := len(m.MessageBasedParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageBasedParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Constitution) > 0 {
 		i -= len(m.Constitution)
 		copy(dAtA[i:], m.Constitution)
@@ -369,6 +445,24 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.MessageBasedParams) > 0 {
+		for _, e := range m.MessageBasedParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Governors) > 0 {
+		for _, e := range m.Governors {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GovernorDelegations) > 0 {
+		for _, e := range m.GovernorDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -704,6 +798,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageBasedParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageBasedParams = append(m.MessageBasedParams, MessageBasedParamsEntry{})
+			if err := m.MessageBasedParams[len(m.MessageBasedParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Governors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Governors = append(m.Governors, Governor{})
+			if err := m.Governors[len(m.Governors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorDelegations = append(m.GovernorDelegations, GovernorDelegation{})
+			if err := m.GovernorDelegations[len(m.GovernorDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -170,6 +170,100 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErrMsg: "deposit proposal_id:1 depositor:\"depositor\"",
 		},
+		{
+			name: "valid message based params",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.MessageBasedParams = []v1.MessageBasedParamsEntry{{
+					MsgUrl: "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade",
+					Params: v1.NewMessageBasedParams("0.4", "0.67", "0.334", nil),
+				}}
+
+				return state
+			},
+		},
+		{
+			name: "duplicate message based params",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				entry := v1.MessageBasedParamsEntry{
+					MsgUrl: "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade",
+					Params: v1.NewMessageBasedParams("0.4", "0.67", "0.334", nil),
+				}
+				state.MessageBasedParams = []v1.MessageBasedParamsEntry{entry, entry}
+
+				return state
+			},
+			expErrMsg: "duplicate message based params: /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade",
+		},
+		{
+			name: "invalid message based params",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.MessageBasedParams = []v1.MessageBasedParamsEntry{{
+					MsgUrl: "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade",
+					Params: v1.NewMessageBasedParams("0.4", "1.1", "0.334", nil),
+				}}
+
+				return state
+			},
+			expErrMsg: "invalid message based params of /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade: vote threshold too large",
+		},
+		{
+			name: "valid governors",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Governors = []v1.Governor{v1.NewGovernor("governor", "")}
+				state.GovernorDelegations = []v1.GovernorDelegation{v1.NewGovernorDelegation("delegator", "governor")}
+
+				return state
+			},
+		},
+		{
+			name: "duplicate governor",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Governors = []v1.Governor{v1.NewGovernor("governor", ""), v1.NewGovernor("governor", "")}
+
+				return state
+			},
+			expErrMsg: "duplicate governor: governor",
+		},
+		{
+			name: "governor delegation to non-existent governor",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.GovernorDelegations = []v1.GovernorDelegation{v1.NewGovernorDelegation("delegator", "governor")}
+
+				return state
+			},
+			expErrMsg: "governor delegation of delegator has non-existent governor: governor",
+		},
+		{
+			name: "governor delegating to a governor",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Governors = []v1.Governor{v1.NewGovernor("governor1", ""), v1.NewGovernor("governor2", "")}
+				state.GovernorDelegations = []v1.GovernorDelegation{v1.NewGovernorDelegation("governor1", "governor2")}
+
+				return state
+			},
+			expErrMsg: "governor governor1 cannot delegate to a governor",
+		},
+		{
+			name: "duplicate governor delegation",
+			genesisState: func() *v1.GenesisState {
+				state := v1.NewGenesisState(v1.DefaultStartingProposalID, params)
+				state.Governors = []v1.Governor{v1.NewGovernor("governor1", ""), v1.NewGovernor("governor2", "")}
+				state.GovernorDelegations = []v1.GovernorDelegation{
+					v1.NewGovernorDelegation("delegator", "governor1"),
+					v1.NewGovernorDelegation("delegator", "governor2"),
+				}
+
+				return state
+			},
+			expErrMsg: "duplicate governor delegation: delegator",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -6,6 +6,7 @@ package v1
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -72,6 +73,46 @@ func (VoteOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e05cb1c0d030febb, []int{0}
 }
 
+// ProposalType enumerates the valid proposal types.
+type ProposalType int32
+
+const (
+	// PROPOSAL_TYPE_UNSPECIFIED defines no proposal type, which falls back to
+	// PROPOSAL_TYPE_STANDARD.
+	ProposalType_PROPOSAL_TYPE_UNSPECIFIED ProposalType = 0
+	// PROPOSAL_TYPE_STANDARD defines the type of a standard proposal.
+	ProposalType_PROPOSAL_TYPE_STANDARD ProposalType = 1
+	// PROPOSAL_TYPE_OPTIMISTIC defines the type of an optimistic proposal, which
+	// passes at the end of its voting period unless enough stake votes against it.
+	ProposalType_PROPOSAL_TYPE_OPTIMISTIC ProposalType = 2
+	// PROPOSAL_TYPE_MULTIPLE_CHOICE defines the type of a multiple choice
+	// proposal, whose voters choose between its options. Only the messages of the
+	// winning option are executed.
+	ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE ProposalType = 3
+)
+
+var ProposalType_name = map[int32]string{
+	0: "PROPOSAL_TYPE_UNSPECIFIED",
+	1: "PROPOSAL_TYPE_STANDARD",
+	2: "PROPOSAL_TYPE_OPTIMISTIC",
+	3: "PROPOSAL_TYPE_MULTIPLE_CHOICE",
+}
+
+var ProposalType_value = map[string]int32{
+	"PROPOSAL_TYPE_UNSPECIFIED":     0,
+	"PROPOSAL_TYPE_STANDARD":        1,
+	"PROPOSAL_TYPE_OPTIMISTIC":      2,
+	"PROPOSAL_TYPE_MULTIPLE_CHOICE": 3,
+}
+
+func (x ProposalType) String() string {
+	return proto.EnumName(ProposalType_name, int32(x))
+}
+
+func (ProposalType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{1}
+}
+
 // ProposalStatus enumerates the valid statuses of a proposal.
 type ProposalStatus int32
 
@@ -118,7 +159,7 @@ func (x ProposalStatus) String() string {
 }
 
 func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{1}
+	return fileDescriptor_e05cb1c0d030febb, []int{2}
 }
 
 // WeightedVoteOption defines a unit of vote for vote split.
@@ -127,6 +168,10 @@ type WeightedVoteOption struct {
 	Option VoteOption `protobuf:"varint,1,opt,name=option,proto3,enum=cosmos.gov.v1.VoteOption" json:"option,omitempty"`
 	// weight is the vote weight associated with the vote option.
 	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	// choice is the 1-based index of the option of a multiple choice proposal
+	// voted for with VOTE_OPTION_YES. It must be 0 when abstaining on a multiple
+	// choice proposal and when voting on other proposals.
+	Choice uint32 `protobuf:"varint,3,opt,name=choice,proto3" json:"choice,omitempty"`
 }
 
 func (m *WeightedVoteOption) Reset()         { *m = WeightedVoteOption{} }
@@ -176,6 +221,13 @@ func (m *WeightedVoteOption) GetWeight() string {
 	return ""
 }
 
+func (m *WeightedVoteOption) GetChoice() uint32 {
+	if m != nil {
+		return m.Choice
+	}
+	return 0
+}
+
 // Deposit defines an amount deposited by an account address to an active
 // proposal.
 type Deposit struct {
@@ -271,12 +323,19 @@ type Proposal struct {
 	Title string `protobuf:"bytes,11,opt,name=title,proto3" json:"title,omitempty"`
 	// summary is a short summary of the proposal
 	Summary string `protobuf:"bytes,12,opt,name=summary,proto3" json:"summary,omitempty"`
-	// proposer is the address of the proposal sumbitter
+	// proposer is the address of the proposal submitter
 	Proposer string `protobuf:"bytes,13,opt,name=proposer,proto3" json:"proposer,omitempty"`
 	// expedited defines if the proposal is expedited
 	Expedited bool `protobuf:"varint,14,opt,name=expedited,proto3" json:"expedited,omitempty"`
 	// failed_reason defines the reason why the proposal failed
 	FailedReason string `protobuf:"bytes,15,opt,name=failed_reason,json=failedReason,proto3" json:"failed_reason,omitempty"`
+	// proposal_type defines the type of the proposal
+	ProposalType ProposalType `protobuf:"varint,16,opt,name=proposal_type,json=proposalType,proto3,enum=cosmos.gov.v1.ProposalType" json:"proposal_type,omitempty"`
+	// options are the options of a multiple choice proposal
+	Options []*ProposalOption `protobuf:"bytes,17,rep,name=options,proto3" json:"options,omitempty"`
+	// winning_option is the 1-based index of the winning option of a passed
+	// multiple choice proposal
+	WinningOption uint32 `protobuf:"varint,18,opt,name=winning_option,json=winningOption,proto3" json:"winning_option,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -417,6 +476,82 @@ func (m *Proposal) GetFailedReason() string {
 	return ""
 }
 
+func (m *Proposal) GetProposalType() ProposalType {
+	if m != nil {
+		return m.ProposalType
+	}
+	return ProposalType_PROPOSAL_TYPE_UNSPECIFIED
+}
+
+func (m *Proposal) GetOptions() []*ProposalOption {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *Proposal) GetWinningOption() uint32 {
+	if m != nil {
+		return m.WinningOption
+	}
+	return 0
+}
+
+// ProposalOption defines an option of a multiple choice proposal.
+type ProposalOption struct {
+	// title is the title of the option.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// messages are the arbitrary messages to be executed if the option wins.
+	Messages []*any.Any `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *ProposalOption) Reset()         { *m = ProposalOption{} }
+func (m *ProposalOption) String() string { return proto.CompactTextString(m) }
+func (*ProposalOption) ProtoMessage()    {}
+func (*ProposalOption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{3}
+}
+func (m *ProposalOption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposalOption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposalOption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposalOption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalOption.Merge(m, src)
+}
+func (m *ProposalOption) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposalOption) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalOption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalOption proto.InternalMessageInfo
+
+func (m *ProposalOption) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ProposalOption) GetMessages() []*any.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	// yes_count is the number of yes votes on a proposal.
@@ -427,13 +562,16 @@ type TallyResult struct {
 	NoCount string `protobuf:"bytes,3,opt,name=no_count,json=noCount,proto3" json:"no_count,omitempty"`
 	// no_with_veto_count is the number of no with veto votes on a proposal.
 	NoWithVetoCount string `protobuf:"bytes,4,opt,name=no_with_veto_count,json=noWithVetoCount,proto3" json:"no_with_veto_count,omitempty"`
+	// option_counts are the numbers of votes on each option of a multiple choice
+	// proposal.
+	OptionCounts []string `protobuf:"bytes,5,rep,name=option_counts,json=optionCounts,proto3" json:"option_counts,omitempty"`
 }
 
 func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{3}
+	return fileDescriptor_e05cb1c0d030febb, []int{4}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,6 +628,13 @@ func (m *TallyResult) GetNoWithVetoCount() string {
 	return ""
 }
 
+func (m *TallyResult) GetOptionCounts() []string {
+	if m != nil {
+		return m.OptionCounts
+	}
+	return nil
+}
+
 // Vote defines a vote on a governance proposal.
 // A Vote consists of a proposal ID, the voter, and the vote option.
 type Vote struct {
@@ -508,7 +653,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{4}
+	return fileDescriptor_e05cb1c0d030febb, []int{5}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,7 +725,7 @@ func (m *DepositParams) Reset()         { *m = DepositParams{} }
 func (m *DepositParams) String() string { return proto.CompactTextString(m) }
 func (*DepositParams) ProtoMessage()    {}
 func (*DepositParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{5}
+	return fileDescriptor_e05cb1c0d030febb, []int{6}
 }
 func (m *DepositParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -635,7 +780,7 @@ func (m *VotingParams) Reset()         { *m = VotingParams{} }
 func (m *VotingParams) String() string { return proto.CompactTextString(m) }
 func (*VotingParams) ProtoMessage()    {}
 func (*VotingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{6}
+	return fileDescriptor_e05cb1c0d030febb, []int{7}
 }
 func (m *VotingParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,7 +834,7 @@ func (m *TallyParams) Reset()         { *m = TallyParams{} }
 func (m *TallyParams) String() string { return proto.CompactTextString(m) }
 func (*TallyParams) ProtoMessage()    {}
 func (*TallyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{7}
+	return fileDescriptor_e05cb1c0d030febb, []int{8}
 }
 func (m *TallyParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -748,15 +893,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	ProposalCancelRatio string `protobuf:"bytes,8,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
@@ -767,7 +912,7 @@ type Params struct {
 	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,10,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
 	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.67.
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -779,13 +924,24 @@ type Params struct {
 	// Default value: 0.01. Meaning that for a chain with a min_deposit of 100stake, a deposit of 1stake would be
 	// required.
 	MinDepositRatio string `protobuf:"bytes,16,opt,name=min_deposit_ratio,json=minDepositRatio,proto3" json:"min_deposit_ratio,omitempty"`
+	// Addresses authorized to submit optimistic proposals.
+	OptimisticAuthorizedAddresses []string `protobuf:"bytes,17,rep,name=optimistic_authorized_addresses,json=optimisticAuthorizedAddresses,proto3" json:"optimistic_authorized_addresses,omitempty"`
+	// Type URLs of the messages optimistic proposals are allowed to execute.
+	OptimisticAllowedMsgUrls []string `protobuf:"bytes,18,rep,name=optimistic_allowed_msg_urls,json=optimisticAllowedMsgUrls,proto3" json:"optimistic_allowed_msg_urls,omitempty"`
+	// Minimum deposit for an optimistic proposal to enter voting period.
+	OptimisticMinDeposit []types.Coin `protobuf:"bytes,19,rep,name=optimistic_min_deposit,json=optimisticMinDeposit,proto3" json:"optimistic_min_deposit"`
+	// Duration of the voting period of an optimistic proposal.
+	OptimisticVotingPeriod *time.Duration `protobuf:"bytes,20,opt,name=optimistic_voting_period,json=optimisticVotingPeriod,proto3,stdduration" json:"optimistic_voting_period,omitempty"`
+	// Minimum proportion of the bonded stake voting No or NoWithVeto for an
+	// optimistic proposal to be rejected. Default value: 0.1.
+	OptimisticRejectedThreshold string `protobuf:"bytes,21,opt,name=optimistic_rejected_threshold,json=optimisticRejectedThreshold,proto3" json:"optimistic_rejected_threshold,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e05cb1c0d030febb, []int{8}
+	return fileDescriptor_e05cb1c0d030febb, []int{9}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -926,117 +1082,436 @@ func (m *Params) GetMinDepositRatio() string {
 	return ""
 }
 
+func (m *Params) GetOptimisticAuthorizedAddresses() []string {
+	if m != nil {
+		return m.OptimisticAuthorizedAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetOptimisticAllowedMsgUrls() []string {
+	if m != nil {
+		return m.OptimisticAllowedMsgUrls
+	}
+	return nil
+}
+
+func (m *Params) GetOptimisticMinDeposit() []types.Coin {
+	if m != nil {
+		return m.OptimisticMinDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetOptimisticVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.OptimisticVotingPeriod
+	}
+	return nil
+}
+
+func (m *Params) GetOptimisticRejectedThreshold() string {
+	if m != nil {
+		return m.OptimisticRejectedThreshold
+	}
+	return ""
+}
+
+// MessageBasedParams defines the tally params of the proposals containing a
+// message type, used instead of the tally params of Params.
+type MessageBasedParams struct {
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
+	Quorum string `protobuf:"bytes,1,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	//  Minimum proportion of Yes votes for proposal to pass.
+	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed.
+	VetoThreshold string `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// min_amount is the minimum amount of the messages the params apply to. If
+	// set, the params only apply to the messages with an amount, at least
+	// min_amount in one of its denoms.
+	MinAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=min_amount,json=minAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_amount"`
+}
+
+func (m *MessageBasedParams) Reset()         { *m = MessageBasedParams{} }
+func (m *MessageBasedParams) String() string { return proto.CompactTextString(m) }
+func (*MessageBasedParams) ProtoMessage()    {}
+func (*MessageBasedParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{10}
+}
+func (m *MessageBasedParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageBasedParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageBasedParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageBasedParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageBasedParams.Merge(m, src)
+}
+func (m *MessageBasedParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageBasedParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageBasedParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageBasedParams proto.InternalMessageInfo
+
+func (m *MessageBasedParams) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *MessageBasedParams) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *MessageBasedParams) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return ""
+}
+
+func (m *MessageBasedParams) GetMinAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinAmount
+	}
+	return nil
+}
+
+// MessageBasedParamsEntry defines the message based params of a message type.
+type MessageBasedParamsEntry struct {
+	// msg_url is the type URL of the message.
+	MsgUrl string `protobuf:"bytes,1,opt,name=msg_url,json=msgUrl,proto3" json:"msg_url,omitempty"`
+	// params defines the message based params of the message type.
+	Params MessageBasedParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MessageBasedParamsEntry) Reset()         { *m = MessageBasedParamsEntry{} }
+func (m *MessageBasedParamsEntry) String() string { return proto.CompactTextString(m) }
+func (*MessageBasedParamsEntry) ProtoMessage()    {}
+func (*MessageBasedParamsEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
+}
+func (m *MessageBasedParamsEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageBasedParamsEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageBasedParamsEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageBasedParamsEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageBasedParamsEntry.Merge(m, src)
+}
+func (m *MessageBasedParamsEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageBasedParamsEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageBasedParamsEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageBasedParamsEntry proto.InternalMessageInfo
+
+func (m *MessageBasedParamsEntry) GetMsgUrl() string {
+	if m != nil {
+		return m.MsgUrl
+	}
+	return ""
+}
+
+func (m *MessageBasedParamsEntry) GetParams() MessageBasedParams {
+	if m != nil {
+		return m.Params
+	}
+	return MessageBasedParams{}
+}
+
+// Governor defines an account the other accounts can delegate their voting
+// power to. A governor votes on behalf of its delegators not having voted
+// themselves.
+type Governor struct {
+	// address is the account address of the governor.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// metadata is any arbitrary metadata attached to the governor.
+	Metadata string `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *Governor) Reset()         { *m = Governor{} }
+func (m *Governor) String() string { return proto.CompactTextString(m) }
+func (*Governor) ProtoMessage()    {}
+func (*Governor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{12}
+}
+func (m *Governor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Governor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Governor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Governor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Governor.Merge(m, src)
+}
+func (m *Governor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Governor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Governor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Governor proto.InternalMessageInfo
+
+func (m *Governor) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Governor) GetMetadata() string {
+	if m != nil {
+		return m.Metadata
+	}
+	return ""
+}
+
+// GovernorDelegation defines the delegation of the voting power of an account
+// to a governor.
+type GovernorDelegation struct {
+	// delegator_address is the account address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// governor_address is the account address of the governor.
+	GovernorAddress string `protobuf:"bytes,2,opt,name=governor_address,json=governorAddress,proto3" json:"governor_address,omitempty"`
+}
+
+func (m *GovernorDelegation) Reset()         { *m = GovernorDelegation{} }
+func (m *GovernorDelegation) String() string { return proto.CompactTextString(m) }
+func (*GovernorDelegation) ProtoMessage()    {}
+func (*GovernorDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{13}
+}
+func (m *GovernorDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernorDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernorDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernorDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernorDelegation.Merge(m, src)
+}
+func (m *GovernorDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernorDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernorDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernorDelegation proto.InternalMessageInfo
+
+func (m *GovernorDelegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *GovernorDelegation) GetGovernorAddress() string {
+	if m != nil {
+		return m.GovernorAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterType((*WeightedVoteOption)(nil), "cosmos.gov.v1.WeightedVoteOption")
 	proto.RegisterType((*Deposit)(nil), "cosmos.gov.v1.Deposit")
 	proto.RegisterType((*Proposal)(nil), "cosmos.gov.v1.Proposal")
+	proto.RegisterType((*ProposalOption)(nil), "cosmos.gov.v1.ProposalOption")
 	proto.RegisterType((*TallyResult)(nil), "cosmos.gov.v1.TallyResult")
 	proto.RegisterType((*Vote)(nil), "cosmos.gov.v1.Vote")
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
+	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1.MessageBasedParams")
+	proto.RegisterType((*MessageBasedParamsEntry)(nil), "cosmos.gov.v1.MessageBasedParamsEntry")
+	proto.RegisterType((*Governor)(nil), "cosmos.gov.v1.Governor")
+	proto.RegisterType((*GovernorDelegation)(nil), "cosmos.gov.v1.GovernorDelegation")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1485 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
-	0x1a, 0x36, 0x25, 0x59, 0x96, 0x5e, 0x7d, 0x58, 0x19, 0x3b, 0x31, 0xed, 0xc4, 0xb2, 0x23, 0x04,
-	0x81, 0xf3, 0x61, 0xc9, 0x4e, 0xd6, 0xbb, 0x8b, 0x64, 0x2f, 0xb2, 0xc5, 0x24, 0x0a, 0x12, 0x4b,
-	0x4b, 0x29, 0x4e, 0xb2, 0xc0, 0x82, 0xa0, 0xcd, 0x89, 0xcc, 0x5d, 0x91, 0xa3, 0x92, 0x23, 0xc7,
-	0x3a, 0xf7, 0xd4, 0x5b, 0x8e, 0x3d, 0x15, 0x3d, 0xf6, 0xd8, 0x43, 0xd0, 0xdf, 0x90, 0x53, 0x11,
-	0xe4, 0xd2, 0xa2, 0x40, 0xd3, 0x22, 0x39, 0x14, 0x48, 0xff, 0x44, 0x31, 0x1f, 0x14, 0x25, 0x59,
-	0x86, 0xed, 0x5c, 0x6c, 0x6a, 0xe6, 0x79, 0x9e, 0xf7, 0x9d, 0xf7, 0x6b, 0x48, 0x98, 0xdb, 0x23,
-	0xbe, 0x43, 0xfc, 0x52, 0x8b, 0x1c, 0x94, 0x0e, 0xd6, 0xd9, 0xbf, 0x62, 0xc7, 0x23, 0x94, 0xa0,
-	0x8c, 0xd8, 0x28, 0xb2, 0x95, 0x83, 0xf5, 0x85, 0xbc, 0xc4, 0xed, 0x9a, 0x3e, 0x2e, 0x1d, 0xac,
-	0xef, 0x62, 0x6a, 0xae, 0x97, 0xf6, 0x88, 0xed, 0x0a, 0xf8, 0xc2, 0x6c, 0x8b, 0xb4, 0x08, 0x7f,
-	0x2c, 0xb1, 0x27, 0xb9, 0xba, 0xd4, 0x22, 0xa4, 0xd5, 0xc6, 0x25, 0xfe, 0x6b, 0xb7, 0xfb, 0xa2,
-	0x44, 0x6d, 0x07, 0xfb, 0xd4, 0x74, 0x3a, 0x12, 0x30, 0x3f, 0x0a, 0x30, 0xdd, 0x9e, 0xdc, 0xca,
-	0x8f, 0x6e, 0x59, 0x5d, 0xcf, 0xa4, 0x36, 0x09, 0x2c, 0xce, 0x0b, 0x8f, 0x0c, 0x61, 0x54, 0x7a,
-	0x2b, 0xb6, 0xce, 0x99, 0x8e, 0xed, 0x92, 0x12, 0xff, 0x2b, 0x96, 0x0a, 0x04, 0xd0, 0x53, 0x6c,
-	0xb7, 0xf6, 0x29, 0xb6, 0x76, 0x08, 0xc5, 0xb5, 0x0e, 0x53, 0x42, 0xeb, 0x10, 0x27, 0xfc, 0x49,
-	0x55, 0x96, 0x95, 0x95, 0xec, 0xad, 0xf9, 0xe2, 0xd0, 0xa9, 0x8b, 0x21, 0x54, 0x97, 0x40, 0x74,
-	0x15, 0xe2, 0x2f, 0xb9, 0x90, 0x1a, 0x59, 0x56, 0x56, 0x92, 0x9b, 0xd9, 0x77, 0xaf, 0x57, 0x41,
-	0xb2, 0x2a, 0x78, 0x4f, 0x97, 0xbb, 0x85, 0x6f, 0x15, 0x98, 0xaa, 0xe0, 0x0e, 0xf1, 0x6d, 0x8a,
-	0x96, 0x20, 0xd5, 0xf1, 0x48, 0x87, 0xf8, 0x66, 0xdb, 0xb0, 0x2d, 0x6e, 0x2b, 0xa6, 0x43, 0xb0,
-	0x54, 0xb5, 0xd0, 0xdf, 0x21, 0x69, 0x09, 0x2c, 0xf1, 0xa4, 0xae, 0xfa, 0xee, 0xf5, 0xea, 0xac,
-	0xd4, 0x2d, 0x5b, 0x96, 0x87, 0x7d, 0xbf, 0x41, 0x3d, 0xdb, 0x6d, 0xe9, 0x21, 0x14, 0xfd, 0x0b,
-	0xe2, 0xa6, 0x43, 0xba, 0x2e, 0x55, 0xa3, 0xcb, 0xd1, 0x95, 0x54, 0xe8, 0x3f, 0x4b, 0x53, 0x51,
-	0xa6, 0xa9, 0xb8, 0x45, 0x6c, 0x77, 0x33, 0xf9, 0xe6, 0xfd, 0xd2, 0xc4, 0x77, 0x7f, 0x7c, 0x7f,
-	0x5d, 0xd1, 0x25, 0xa7, 0xf0, 0x67, 0x1c, 0x12, 0x75, 0xe9, 0x04, 0xca, 0x42, 0xa4, 0xef, 0x5a,
-	0xc4, 0xb6, 0xd0, 0x1a, 0x24, 0x1c, 0xec, 0xfb, 0x66, 0x0b, 0xfb, 0x6a, 0x84, 0x8b, 0xcf, 0x16,
-	0x45, 0x46, 0x8a, 0x41, 0x46, 0x8a, 0x65, 0xb7, 0xa7, 0xf7, 0x51, 0x68, 0x03, 0xe2, 0x3e, 0x35,
-	0x69, 0xd7, 0x57, 0xa3, 0x3c, 0x98, 0x8b, 0x23, 0xc1, 0x0c, 0x4c, 0x35, 0x38, 0x48, 0x97, 0x60,
-	0xf4, 0x00, 0xd0, 0x0b, 0xdb, 0x35, 0xdb, 0x06, 0x35, 0xdb, 0xed, 0x9e, 0xe1, 0x61, 0xbf, 0xdb,
-	0xa6, 0x6a, 0x6c, 0x59, 0x59, 0x49, 0xdd, 0x5a, 0x18, 0x91, 0x68, 0x32, 0x88, 0xce, 0x11, 0x7a,
-	0x8e, 0xb3, 0x06, 0x56, 0x50, 0x19, 0x52, 0x7e, 0x77, 0xd7, 0xb1, 0xa9, 0xc1, 0xca, 0x4c, 0x9d,
-	0x94, 0x12, 0xa3, 0x5e, 0x37, 0x83, 0x1a, 0xdc, 0x8c, 0xbd, 0xfa, 0x6d, 0x49, 0xd1, 0x41, 0x90,
-	0xd8, 0x32, 0x7a, 0x08, 0x39, 0x19, 0x5d, 0x03, 0xbb, 0x96, 0xd0, 0x89, 0x9f, 0x52, 0x27, 0x2b,
-	0x99, 0x9a, 0x6b, 0x71, 0xad, 0x2a, 0x64, 0x28, 0xa1, 0x66, 0xdb, 0x90, 0xeb, 0xea, 0xd4, 0x19,
-	0x72, 0x94, 0xe6, 0xd4, 0xa0, 0x80, 0x1e, 0xc1, 0xb9, 0x03, 0x42, 0x6d, 0xb7, 0x65, 0xf8, 0xd4,
-	0xf4, 0xe4, 0xf9, 0x12, 0xa7, 0xf4, 0x6b, 0x5a, 0x50, 0x1b, 0x8c, 0xc9, 0x1d, 0x7b, 0x00, 0x72,
-	0x29, 0x3c, 0x63, 0xf2, 0x94, 0x5a, 0x19, 0x41, 0x0c, 0x8e, 0xb8, 0xc0, 0x8a, 0x84, 0x9a, 0x96,
-	0x49, 0x4d, 0x15, 0x58, 0xd9, 0xea, 0xfd, 0xdf, 0xe8, 0x1a, 0x4c, 0x52, 0x9b, 0xb6, 0xb1, 0x9a,
-	0xe2, 0xf5, 0x3c, 0xf3, 0xcb, 0xeb, 0xd5, 0x69, 0x71, 0xf2, 0x55, 0xdf, 0xfa, 0xff, 0xf2, 0x5a,
-	0xf1, 0x6f, 0xff, 0xd0, 0x05, 0x02, 0xad, 0xc2, 0x94, 0xdf, 0x75, 0x1c, 0xd3, 0xeb, 0xa9, 0xe9,
-	0xe3, 0xc1, 0x01, 0x06, 0xdd, 0x87, 0x84, 0xe8, 0x1d, 0xec, 0xa9, 0x19, 0x8e, 0xbf, 0x71, 0x5c,
-	0xb3, 0x8c, 0xd3, 0xe9, 0x93, 0xd1, 0x3a, 0x24, 0xf1, 0x61, 0x07, 0x5b, 0x36, 0xc5, 0x96, 0x9a,
-	0x5d, 0x56, 0x56, 0x12, 0x63, 0x2c, 0x6f, 0xac, 0xe9, 0x21, 0x0a, 0xfd, 0x13, 0x32, 0x2f, 0x4c,
-	0xbb, 0x8d, 0x2d, 0xc3, 0xc3, 0xa6, 0x4f, 0x5c, 0x75, 0xfa, 0x18, 0x87, 0x37, 0xd6, 0xf4, 0xb4,
-	0x40, 0xea, 0x1c, 0x58, 0xf8, 0x49, 0x81, 0xd4, 0x60, 0xb5, 0xde, 0x80, 0x64, 0x0f, 0xfb, 0xc6,
-	0x1e, 0x6f, 0x5f, 0xe5, 0xc8, 0x2c, 0xa9, 0xba, 0x54, 0x4f, 0xf4, 0xb0, 0xbf, 0xc5, 0xf6, 0xd1,
-	0x6d, 0xc8, 0x98, 0xbb, 0x3e, 0x35, 0x6d, 0x57, 0x12, 0x22, 0x63, 0x09, 0x69, 0x09, 0x12, 0xa4,
-	0x6b, 0x90, 0x70, 0x89, 0xc4, 0x47, 0xc7, 0xe2, 0xa7, 0x5c, 0x22, 0xa0, 0x77, 0x01, 0xb9, 0xc4,
-	0x78, 0x69, 0xd3, 0x7d, 0xe3, 0x00, 0xd3, 0x80, 0x14, 0x1b, 0x4b, 0x9a, 0x76, 0xc9, 0x53, 0x9b,
-	0xee, 0xef, 0x60, 0x2a, 0xc8, 0x85, 0x1f, 0x14, 0x88, 0xb1, 0x49, 0x79, 0xf2, 0x9c, 0x2b, 0xc2,
-	0xe4, 0x01, 0xa1, 0xf8, 0xe4, 0x19, 0x27, 0x60, 0xe8, 0x2e, 0x4c, 0x89, 0xb1, 0xeb, 0xab, 0x31,
-	0xde, 0x3c, 0x97, 0x47, 0x06, 0xc2, 0xd1, 0x99, 0xae, 0x07, 0x8c, 0xa1, 0xe2, 0x9c, 0x1c, 0x2e,
-	0xce, 0x87, 0xb1, 0x44, 0x34, 0x17, 0x2b, 0xfc, 0xaa, 0x40, 0x46, 0xb6, 0x58, 0xdd, 0xf4, 0x4c,
-	0xc7, 0x47, 0xcf, 0x21, 0xe5, 0xd8, 0x6e, 0xbf, 0x63, 0x95, 0x93, 0x3a, 0x76, 0x91, 0x75, 0xec,
-	0xa7, 0xf7, 0x4b, 0xe7, 0x07, 0x58, 0x37, 0x89, 0x63, 0x53, 0xec, 0x74, 0x68, 0x4f, 0x07, 0xc7,
-	0x76, 0x83, 0x1e, 0x76, 0x00, 0x39, 0xe6, 0x61, 0x00, 0x32, 0x3a, 0xd8, 0xb3, 0x89, 0xc5, 0x03,
-	0xc1, 0x2c, 0x8c, 0x36, 0x5e, 0x45, 0x5e, 0x76, 0x9b, 0x57, 0x3e, 0xbd, 0x5f, 0xba, 0x74, 0x94,
-	0x18, 0x1a, 0xf9, 0x9a, 0xf5, 0x65, 0xce, 0x31, 0x0f, 0x83, 0x93, 0xf0, 0xfd, 0x3b, 0x11, 0x55,
-	0x29, 0x3c, 0x83, 0xf4, 0x0e, 0xef, 0x57, 0x79, 0xba, 0x0a, 0xc8, 0xfe, 0x0d, 0xac, 0x2b, 0x27,
-	0x59, 0x8f, 0x71, 0xf5, 0xb4, 0x60, 0x0d, 0x28, 0x7f, 0x13, 0x14, 0xb3, 0x54, 0xbe, 0x0a, 0xf1,
-	0x2f, 0xba, 0xc4, 0xeb, 0x3a, 0x63, 0x2a, 0x99, 0xdf, 0x8a, 0x62, 0x17, 0xdd, 0x84, 0x24, 0xdd,
-	0xf7, 0xb0, 0xbf, 0x4f, 0xda, 0xd6, 0x31, 0x17, 0x68, 0x08, 0x40, 0x1b, 0x90, 0xe5, 0xd5, 0x18,
-	0x52, 0xa2, 0x63, 0x29, 0x19, 0x86, 0x6a, 0x06, 0x20, 0xee, 0xe0, 0x97, 0x49, 0x88, 0x4b, 0xdf,
-	0xb4, 0x33, 0xe6, 0x74, 0x60, 0x0a, 0x0f, 0xe6, 0xef, 0xf1, 0xe7, 0xe5, 0x2f, 0x36, 0x3e, 0x3f,
-	0x47, 0x73, 0x11, 0xfd, 0x8c, 0x5c, 0x0c, 0xc4, 0x3d, 0x76, 0xfa, 0xb8, 0x4f, 0x9e, 0x3d, 0xee,
-	0xf1, 0x53, 0xc4, 0x1d, 0x55, 0x61, 0x9e, 0x05, 0xda, 0x76, 0x6d, 0x6a, 0x87, 0xd7, 0x9e, 0xc1,
-	0xdd, 0x57, 0xa7, 0xc6, 0x2a, 0x5c, 0x70, 0x6c, 0xb7, 0x2a, 0xf0, 0x32, 0x3c, 0x3a, 0x43, 0xa3,
-	0x27, 0x70, 0xbe, 0x3f, 0x49, 0xf6, 0x4c, 0x77, 0x0f, 0xb7, 0xa5, 0x4c, 0x82, 0xcb, 0x5c, 0x1e,
-	0x96, 0x19, 0x37, 0x7c, 0x67, 0x02, 0xfe, 0x16, 0xa7, 0x0b, 0xd9, 0xff, 0xc2, 0xec, 0xa8, 0xac,
-	0x85, 0x7d, 0xca, 0xaf, 0xbf, 0xb3, 0xdc, 0x22, 0x1b, 0x6b, 0x3a, 0x1a, 0xd6, 0xaf, 0x60, 0x9f,
-	0xa2, 0xff, 0xc1, 0x5c, 0xff, 0xa6, 0x30, 0x86, 0xb3, 0x0b, 0x27, 0x65, 0x77, 0x8e, 0x65, 0x77,
-	0x9c, 0xa1, 0xf3, 0x7d, 0xc9, 0x9d, 0xc1, 0xcc, 0xeb, 0x30, 0x13, 0xda, 0x0a, 0x13, 0x95, 0x3a,
-	0x6d, 0x7c, 0x50, 0x9f, 0x1d, 0x26, 0xf0, 0x19, 0x84, 0xc6, 0x8c, 0xc1, 0x9e, 0x49, 0x9f, 0xa1,
-	0x67, 0x42, 0xb7, 0x1e, 0x87, 0xcd, 0xb3, 0x02, 0xb9, 0xdd, 0xae, 0xe7, 0xb2, 0xa0, 0x60, 0x43,
-	0x56, 0x2c, 0xbb, 0xba, 0x13, 0x7a, 0x96, 0xad, 0xb3, 0xf1, 0xfd, 0x6f, 0x51, 0xa9, 0x65, 0x58,
-	0xe4, 0xc8, 0x7e, 0x9e, 0xfa, 0x0d, 0xe7, 0x61, 0xc6, 0x16, 0xf7, 0xb4, 0xbe, 0xc0, 0x40, 0xc1,
-	0x1b, 0x65, 0xd0, 0x59, 0x02, 0x81, 0xae, 0x40, 0x36, 0x34, 0xc6, 0x4a, 0x94, 0x5f, 0xd2, 0x09,
-	0x3d, 0x1d, 0x98, 0x62, 0x57, 0x17, 0x7a, 0x0c, 0xe7, 0x06, 0x8e, 0x28, 0xcb, 0x2b, 0x77, 0xda,
-	0xf0, 0x4d, 0x87, 0x93, 0x81, 0x97, 0xd6, 0x9d, 0x99, 0x77, 0x47, 0x5f, 0x35, 0xae, 0x7f, 0xa5,
-	0x00, 0x0c, 0x7c, 0x6e, 0x5c, 0x84, 0xb9, 0x9d, 0x5a, 0x53, 0x33, 0x6a, 0xf5, 0x66, 0xb5, 0xb6,
-	0x6d, 0x3c, 0xd9, 0x6e, 0xd4, 0xb5, 0xad, 0xea, 0xbd, 0xaa, 0x56, 0xc9, 0x4d, 0xa0, 0x19, 0x98,
-	0x1e, 0xdc, 0x7c, 0xae, 0x35, 0x72, 0x0a, 0x9a, 0x83, 0x99, 0xc1, 0xc5, 0xf2, 0x66, 0xa3, 0x59,
-	0xae, 0x6e, 0xe7, 0x22, 0x08, 0x41, 0x76, 0x70, 0x63, 0xbb, 0x96, 0x8b, 0xa2, 0x4b, 0xa0, 0x0e,
-	0xaf, 0x19, 0x4f, 0xab, 0xcd, 0x07, 0xc6, 0x8e, 0xd6, 0xac, 0xe5, 0x62, 0xd7, 0x7f, 0x54, 0x20,
-	0x3b, 0xfc, 0x0a, 0x8e, 0x96, 0xe0, 0x62, 0x5d, 0xaf, 0xd5, 0x6b, 0x8d, 0xf2, 0x23, 0xa3, 0xd1,
-	0x2c, 0x37, 0x9f, 0x34, 0x46, 0x7c, 0x2a, 0x40, 0x7e, 0x14, 0x50, 0xd1, 0xea, 0xb5, 0x46, 0xb5,
-	0x69, 0xd4, 0x35, 0xbd, 0x5a, 0xab, 0xe4, 0x14, 0x74, 0x19, 0x16, 0x47, 0x31, 0x3b, 0xb5, 0x66,
-	0x75, 0xfb, 0x7e, 0x00, 0x89, 0xa0, 0x05, 0xb8, 0x30, 0x0a, 0xa9, 0x97, 0x1b, 0x0d, 0xad, 0x22,
-	0x9c, 0x1e, 0xdd, 0xd3, 0xb5, 0x87, 0xda, 0x56, 0x53, 0xab, 0xe4, 0x62, 0xe3, 0x98, 0xf7, 0xca,
-	0xd5, 0x47, 0x5a, 0x25, 0x37, 0xb9, 0xa9, 0xbd, 0xf9, 0x90, 0x57, 0xde, 0x7e, 0xc8, 0x2b, 0xbf,
-	0x7f, 0xc8, 0x2b, 0xaf, 0x3e, 0xe6, 0x27, 0xde, 0x7e, 0xcc, 0x4f, 0xfc, 0xfc, 0x31, 0x3f, 0xf1,
-	0x9f, 0x1b, 0x2d, 0x9b, 0xee, 0x77, 0x77, 0x8b, 0x7b, 0xc4, 0x91, 0x1f, 0x86, 0xa5, 0x30, 0x33,
-	0xa5, 0x43, 0xfe, 0xb1, 0x4b, 0x7b, 0x1d, 0xec, 0xb3, 0x2f, 0xd9, 0x38, 0xef, 0xc5, 0xdb, 0x7f,
-	0x05, 0x00, 0x00, 0xff, 0xff, 0x0e, 0xb4, 0x23, 0x3e, 0x0a, 0x0f, 0x00, 0x00,
+	// 1998 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x92, 0x14, 0x45, 0x3d, 0x91, 0x14, 0x3d, 0x92, 0xad, 0xb5, 0x6c, 0xfd, 0x31, 0x11,
+	0x04, 0x8a, 0x1d, 0x91, 0x92, 0x23, 0x35, 0x85, 0xd3, 0x0b, 0x25, 0xae, 0x6d, 0x1a, 0x92, 0xc8,
+	0x2e, 0x29, 0x39, 0x2e, 0x50, 0x2c, 0x56, 0xdc, 0x31, 0xb5, 0x29, 0x77, 0x87, 0xdd, 0x1d, 0xca,
+	0x66, 0xfb, 0x09, 0x5a, 0xa0, 0x80, 0x8f, 0x45, 0x51, 0xb4, 0x3d, 0x16, 0x3d, 0x05, 0xa8, 0xdb,
+	0xcf, 0x90, 0x43, 0x51, 0x04, 0x3e, 0x15, 0x05, 0xea, 0x14, 0xf6, 0x21, 0x40, 0x3e, 0x45, 0x31,
+	0x7f, 0x96, 0xbb, 0xa4, 0x56, 0x95, 0xe4, 0x53, 0x2e, 0x12, 0x39, 0xf3, 0xfb, 0xbd, 0xf7, 0xe6,
+	0xfd, 0x9b, 0xc7, 0x81, 0xf9, 0x36, 0xf1, 0x1d, 0xe2, 0x97, 0x3b, 0xe4, 0xa4, 0x7c, 0xb2, 0xc1,
+	0xfe, 0x95, 0x7a, 0x1e, 0xa1, 0x04, 0xe5, 0xc4, 0x46, 0x89, 0xad, 0x9c, 0x6c, 0x2c, 0x2c, 0x49,
+	0xdc, 0x91, 0xe9, 0xe3, 0xf2, 0xc9, 0xc6, 0x11, 0xa6, 0xe6, 0x46, 0xb9, 0x4d, 0x6c, 0x57, 0xc0,
+	0x17, 0xe6, 0x3a, 0xa4, 0x43, 0xf8, 0xc7, 0x32, 0xfb, 0x24, 0x57, 0x97, 0x3b, 0x84, 0x74, 0xba,
+	0xb8, 0xcc, 0xbf, 0x1d, 0xf5, 0x9f, 0x95, 0xa9, 0xed, 0x60, 0x9f, 0x9a, 0x4e, 0x4f, 0x02, 0x6e,
+	0x8c, 0x03, 0x4c, 0x77, 0x20, 0xb7, 0x96, 0xc6, 0xb7, 0xac, 0xbe, 0x67, 0x52, 0x9b, 0x04, 0x1a,
+	0x6f, 0x08, 0x8b, 0x0c, 0xa1, 0x54, 0x5a, 0x2b, 0xb6, 0xae, 0x9a, 0x8e, 0xed, 0x92, 0x32, 0xff,
+	0x2b, 0x96, 0x8a, 0xbf, 0x57, 0x00, 0x3d, 0xc1, 0x76, 0xe7, 0x98, 0x62, 0xeb, 0x90, 0x50, 0x5c,
+	0xef, 0x31, 0x51, 0x68, 0x03, 0xd2, 0x84, 0x7f, 0x52, 0x95, 0x15, 0x65, 0x35, 0x7f, 0xef, 0x46,
+	0x69, 0xe4, 0xd8, 0xa5, 0x10, 0xaa, 0x4b, 0x20, 0xfa, 0x10, 0xd2, 0xcf, 0xb9, 0x20, 0x35, 0xb1,
+	0xa2, 0xac, 0x4e, 0x6d, 0xe7, 0x5f, 0xbf, 0x5a, 0x03, 0xc9, 0xaa, 0xe2, 0xb6, 0x2e, 0x77, 0xd1,
+	0x5d, 0x48, 0xb7, 0x8f, 0x89, 0xdd, 0xc6, 0x6a, 0x72, 0x45, 0x59, 0xcd, 0x6d, 0xcf, 0xfe, 0xfb,
+	0xd5, 0xda, 0x8c, 0xc0, 0xad, 0xf9, 0xd6, 0xcf, 0x56, 0xd6, 0x4b, 0x5b, 0x9b, 0xba, 0x84, 0x14,
+	0xff, 0xa4, 0xc0, 0x64, 0x15, 0xf7, 0x88, 0x6f, 0x53, 0xb4, 0x0c, 0xd3, 0x3d, 0x8f, 0xf4, 0x88,
+	0x6f, 0x76, 0x0d, 0xdb, 0xe2, 0x86, 0xa5, 0x74, 0x08, 0x96, 0x6a, 0x16, 0xfa, 0x01, 0x4c, 0x59,
+	0x02, 0x4b, 0x3c, 0x69, 0x84, 0xfa, 0xfa, 0xd5, 0xda, 0x9c, 0x34, 0xa2, 0x62, 0x59, 0x1e, 0xf6,
+	0xfd, 0x26, 0xf5, 0x6c, 0xb7, 0xa3, 0x87, 0x50, 0xf4, 0x23, 0x48, 0x9b, 0x0e, 0xe9, 0xbb, 0x54,
+	0x4d, 0xae, 0x24, 0x57, 0xa7, 0xc3, 0xc3, 0xb2, 0xa0, 0x96, 0x64, 0x50, 0x4b, 0x3b, 0xc4, 0x76,
+	0xb7, 0xa7, 0xbe, 0x7a, 0xb3, 0x7c, 0xe5, 0xcf, 0xdf, 0x7e, 0x79, 0x47, 0xd1, 0x25, 0xa7, 0xf8,
+	0x8f, 0x0c, 0x64, 0x1a, 0xd2, 0x08, 0x94, 0x87, 0xc4, 0xd0, 0xb4, 0x84, 0x6d, 0xa1, 0x75, 0xc8,
+	0x38, 0xd8, 0xf7, 0xcd, 0x0e, 0xf6, 0xd5, 0x04, 0x17, 0x3e, 0x57, 0x12, 0xf1, 0x2b, 0x05, 0xf1,
+	0x2b, 0x55, 0xdc, 0x81, 0x3e, 0x44, 0xa1, 0x2d, 0x48, 0xfb, 0xd4, 0xa4, 0x7d, 0x9f, 0xbb, 0x27,
+	0x7f, 0x6f, 0x71, 0xcc, 0xf3, 0x81, 0xaa, 0x26, 0x07, 0xe9, 0x12, 0x8c, 0x1e, 0x01, 0x7a, 0x66,
+	0xbb, 0x66, 0xd7, 0xa0, 0x66, 0xb7, 0x3b, 0x30, 0x3c, 0xec, 0xf7, 0xbb, 0x54, 0x4d, 0xad, 0x28,
+	0xab, 0xd3, 0xf7, 0x16, 0xc6, 0x44, 0xb4, 0x18, 0x44, 0xe7, 0x08, 0xbd, 0xc0, 0x59, 0x91, 0x15,
+	0x54, 0x81, 0x69, 0xbf, 0x7f, 0xe4, 0xd8, 0xd4, 0x60, 0x49, 0xa9, 0x4e, 0x48, 0x11, 0xe3, 0x56,
+	0xb7, 0x82, 0x8c, 0xdd, 0x4e, 0xbd, 0xfc, 0x66, 0x59, 0xd1, 0x41, 0x90, 0xd8, 0x32, 0x7a, 0x0c,
+	0x05, 0xe9, 0x5d, 0x03, 0xbb, 0x96, 0x90, 0x93, 0xbe, 0xa0, 0x9c, 0xbc, 0x64, 0x6a, 0xae, 0xc5,
+	0x65, 0xd5, 0x20, 0x47, 0x09, 0x35, 0xbb, 0x86, 0x5c, 0x57, 0x27, 0x2f, 0x11, 0xa3, 0x2c, 0xa7,
+	0x06, 0x09, 0xb4, 0x0b, 0x57, 0x4f, 0x08, 0xb5, 0xdd, 0x8e, 0xe1, 0x53, 0xd3, 0x93, 0xe7, 0xcb,
+	0x5c, 0xd0, 0xae, 0x19, 0x41, 0x6d, 0x32, 0x26, 0x37, 0xec, 0x11, 0xc8, 0xa5, 0xf0, 0x8c, 0x53,
+	0x17, 0x94, 0x95, 0x13, 0xc4, 0xe0, 0x88, 0x0b, 0x2c, 0x49, 0xa8, 0x69, 0x99, 0xd4, 0x54, 0x81,
+	0xa5, 0xad, 0x3e, 0xfc, 0x8e, 0x3e, 0x82, 0x09, 0x6a, 0xd3, 0x2e, 0x56, 0xa7, 0x79, 0x3e, 0x9f,
+	0x2e, 0x96, 0xcd, 0x4f, 0x75, 0x81, 0x40, 0x6b, 0x30, 0xe9, 0xf7, 0x1d, 0xc7, 0xf4, 0x06, 0x6a,
+	0xf6, 0x6c, 0x70, 0x80, 0x41, 0x0f, 0x21, 0x23, 0x6a, 0x07, 0x7b, 0x6a, 0x8e, 0xe3, 0xef, 0x9e,
+	0x55, 0x2c, 0x71, 0x72, 0x86, 0x64, 0xb4, 0x01, 0x53, 0xf8, 0x45, 0x0f, 0x5b, 0x36, 0xc5, 0x96,
+	0x9a, 0x5f, 0x51, 0x56, 0x33, 0x71, 0x35, 0xbd, 0xae, 0x87, 0x28, 0xf4, 0x43, 0xc8, 0x3d, 0x33,
+	0xed, 0x2e, 0xb6, 0x0c, 0x0f, 0x9b, 0x3e, 0x71, 0xd5, 0x99, 0x33, 0x0c, 0xde, 0x5a, 0xd7, 0xb3,
+	0x02, 0xa9, 0x73, 0x20, 0x3a, 0x80, 0xdc, 0xb0, 0x09, 0xd0, 0x41, 0x0f, 0xab, 0x05, 0x5e, 0x25,
+	0x37, 0xcf, 0xa8, 0x92, 0xd6, 0xa0, 0x87, 0xe3, 0x3b, 0x4c, 0xb6, 0x17, 0x81, 0xa0, 0x5d, 0x98,
+	0x14, 0x6d, 0xcc, 0x57, 0xaf, 0xf2, 0xfc, 0x3a, 0xab, 0xec, 0x44, 0xd3, 0x8b, 0x17, 0x19, 0x88,
+	0x40, 0xf7, 0x21, 0xff, 0xdc, 0x76, 0x5d, 0x96, 0x1b, 0x62, 0x49, 0x45, 0x67, 0xb7, 0xba, 0x9c,
+	0x84, 0x0a, 0xc9, 0x45, 0x07, 0xf2, 0xa3, 0xba, 0xd0, 0x5c, 0x90, 0x02, 0x0a, 0xcf, 0x0d, 0x19,
+	0xed, 0x4b, 0x77, 0x96, 0xfb, 0xb3, 0xaf, 0x4f, 0x6b, 0x2f, 0xfe, 0x31, 0x01, 0xd3, 0xd1, 0xea,
+	0xbf, 0x0b, 0x53, 0x03, 0xec, 0x1b, 0x6d, 0xde, 0x0e, 0x95, 0x53, 0x8d, 0xbc, 0xe6, 0x52, 0x3d,
+	0x33, 0xc0, 0xfe, 0x0e, 0xdb, 0x47, 0x9f, 0x40, 0xce, 0x3c, 0xf2, 0xa9, 0x69, 0xbb, 0x92, 0x90,
+	0x88, 0x25, 0x64, 0x25, 0x48, 0x90, 0x3e, 0x82, 0x8c, 0x4b, 0x24, 0x3e, 0x19, 0x8b, 0x9f, 0x74,
+	0x89, 0x80, 0x7e, 0x06, 0xc8, 0x25, 0xc6, 0x73, 0x9b, 0x1e, 0x1b, 0x27, 0x98, 0x06, 0xa4, 0x54,
+	0x2c, 0x69, 0xc6, 0x25, 0x4f, 0x6c, 0x7a, 0x7c, 0x88, 0xa9, 0x24, 0x3f, 0x80, 0x9c, 0x70, 0xbe,
+	0xa0, 0xf9, 0xea, 0xc4, 0x4a, 0x72, 0x75, 0x6a, 0xfb, 0xf6, 0x28, 0x2f, 0x36, 0x35, 0x04, 0x8f,
+	0x8b, 0xf1, 0x8b, 0x7f, 0x57, 0x20, 0xc5, 0xae, 0xbb, 0xf3, 0xef, 0x9f, 0x12, 0x4c, 0x9c, 0x10,
+	0x8a, 0xcf, 0xbf, 0x7b, 0x04, 0x0c, 0x7d, 0x16, 0x26, 0x5d, 0x8a, 0x47, 0xf0, 0xf6, 0x58, 0xd2,
+	0x9d, 0xbe, 0x98, 0xc3, 0x1c, 0x8b, 0x36, 0x8d, 0x89, 0xd1, 0xa6, 0xf1, 0x38, 0x95, 0x49, 0x16,
+	0x52, 0xc5, 0xff, 0x28, 0x90, 0x93, 0xad, 0xaf, 0x61, 0x7a, 0xa6, 0xe3, 0xa3, 0xa7, 0x30, 0xed,
+	0xd8, 0xee, 0xb0, 0x93, 0x2a, 0xe7, 0x75, 0xd2, 0x45, 0xd6, 0x49, 0xbf, 0x7b, 0xb3, 0x7c, 0x2d,
+	0xc2, 0xfa, 0x98, 0x38, 0x36, 0xc5, 0x4e, 0x8f, 0x0e, 0x74, 0x70, 0x6c, 0x37, 0xe8, 0xad, 0x0e,
+	0x20, 0xc7, 0x7c, 0x11, 0x80, 0x8c, 0x1e, 0xf6, 0x6c, 0x62, 0x71, 0x47, 0x30, 0x0d, 0xe3, 0x89,
+	0x59, 0x95, 0x23, 0xcb, 0xf6, 0x07, 0xdf, 0xbd, 0x59, 0xbe, 0x75, 0x9a, 0x18, 0x2a, 0xf9, 0x2d,
+	0xeb, 0x97, 0x05, 0xc7, 0x7c, 0x11, 0x9c, 0x84, 0xef, 0xdf, 0x4f, 0xa8, 0x4a, 0xf1, 0x73, 0xc8,
+	0x1e, 0xf2, 0x3e, 0x2a, 0x4f, 0x57, 0x05, 0xd9, 0x57, 0x03, 0xed, 0xca, 0x79, 0xda, 0x53, 0x5c,
+	0x7a, 0x56, 0xb0, 0x22, 0x92, 0xff, 0xa0, 0xc8, 0xa2, 0x90, 0x92, 0x3f, 0x84, 0xf4, 0xcf, 0xfb,
+	0xc4, 0xeb, 0x3b, 0xaa, 0x12, 0x3f, 0xda, 0x88, 0x5d, 0xf4, 0x31, 0x4c, 0xd1, 0x63, 0x0f, 0xfb,
+	0xc7, 0xa4, 0x6b, 0x9d, 0x31, 0x05, 0x85, 0x00, 0xb4, 0x05, 0x79, 0x9e, 0xd5, 0x21, 0x25, 0x19,
+	0x4b, 0xc9, 0x31, 0x54, 0x2b, 0x00, 0x71, 0x03, 0x5f, 0xe6, 0x20, 0x2d, 0x6d, 0xd3, 0x2e, 0x19,
+	0xd3, 0xc8, 0xed, 0x18, 0x8d, 0xdf, 0xde, 0xfb, 0xc5, 0x2f, 0x15, 0x1f, 0x9f, 0xd3, 0xb1, 0x48,
+	0xbe, 0x47, 0x2c, 0x22, 0x7e, 0x4f, 0x5d, 0xdc, 0xef, 0x13, 0x97, 0xf7, 0x7b, 0xfa, 0x02, 0x7e,
+	0x47, 0x35, 0xb8, 0xc1, 0x1c, 0x6d, 0xbb, 0x36, 0xb5, 0xc3, 0x71, 0xc4, 0xe0, 0xe6, 0xab, 0x93,
+	0xb1, 0x12, 0xae, 0x3b, 0xb6, 0x5b, 0x13, 0x78, 0xe9, 0x1e, 0x9d, 0xa1, 0xd1, 0x01, 0x5c, 0x1b,
+	0x76, 0x92, 0xb6, 0xe9, 0xb6, 0x71, 0x57, 0x8a, 0xc9, 0xac, 0x28, 0x63, 0x2d, 0xaa, 0x8a, 0xdb,
+	0x71, 0x97, 0xe2, 0x6c, 0xc0, 0xdf, 0xe1, 0x74, 0x21, 0xf6, 0xa7, 0x30, 0x37, 0x2e, 0xd6, 0xc2,
+	0x3e, 0xe5, 0x63, 0xc9, 0x65, 0x6e, 0xf7, 0xad, 0x75, 0x1d, 0x8d, 0xca, 0xaf, 0x62, 0x9f, 0xa2,
+	0x2f, 0x60, 0x7e, 0x78, 0x83, 0x1b, 0xa3, 0xd1, 0x85, 0xf3, 0xa2, 0x3b, 0xcf, 0xa2, 0x1b, 0xa7,
+	0xe8, 0xda, 0x50, 0xe4, 0x61, 0x34, 0xf2, 0x3a, 0xcc, 0x86, 0xba, 0xc2, 0x40, 0x4d, 0x5f, 0xd4,
+	0x3f, 0x68, 0xc8, 0x0e, 0x03, 0xf8, 0x39, 0x84, 0xca, 0x8c, 0x68, 0xcd, 0x64, 0x2f, 0x51, 0x33,
+	0xa1, 0x59, 0x7b, 0x61, 0xf1, 0xac, 0x42, 0xe1, 0xa8, 0xef, 0xb9, 0xcc, 0x29, 0xd8, 0x90, 0x19,
+	0xcb, 0x46, 0xaa, 0x8c, 0x9e, 0x67, 0xeb, 0xac, 0x7d, 0xff, 0x58, 0x64, 0x6a, 0x05, 0x16, 0x39,
+	0x72, 0x18, 0xa7, 0x61, 0xc1, 0x79, 0x98, 0xb1, 0xc5, 0xfc, 0xa4, 0x2f, 0x30, 0x50, 0x30, 0x06,
+	0x04, 0x95, 0x25, 0x10, 0xe8, 0x03, 0xc8, 0x87, 0xca, 0x58, 0x8a, 0xf2, 0xe1, 0x29, 0xa3, 0x67,
+	0x03, 0x55, 0xec, 0x0a, 0x44, 0x7b, 0x70, 0x35, 0x72, 0x44, 0x99, 0x5e, 0x85, 0x8b, 0xba, 0x6f,
+	0x26, 0xec, 0x0c, 0x22, 0xb5, 0x7c, 0x58, 0x66, 0x17, 0x8f, 0x63, 0xfb, 0xd4, 0x6e, 0x1b, 0x66,
+	0x9f, 0x1e, 0x13, 0xcf, 0xfe, 0x05, 0xb6, 0x0c, 0x53, 0x64, 0x12, 0x16, 0x73, 0xd3, 0xa5, 0xb2,
+	0x6c, 0x53, 0x5f, 0x0c, 0x65, 0x56, 0x86, 0x22, 0x2b, 0x81, 0x44, 0xa4, 0xc3, 0xcd, 0xa8, 0xd2,
+	0x6e, 0x97, 0x3c, 0x67, 0x91, 0xf3, 0x3b, 0x46, 0xdf, 0xeb, 0xfa, 0x2a, 0xe2, 0x0a, 0x63, 0x67,
+	0x2a, 0x35, 0x22, 0x58, 0xd0, 0xf6, 0xfc, 0xce, 0x81, 0xd7, 0xf5, 0x91, 0x03, 0xd7, 0x23, 0x32,
+	0xa3, 0x59, 0x30, 0x7b, 0x5e, 0x16, 0xdc, 0x62, 0x59, 0x10, 0xa3, 0x4d, 0x24, 0xc6, 0x5c, 0x28,
+	0x36, 0x92, 0x19, 0x5d, 0x88, 0x98, 0x32, 0x56, 0x34, 0x73, 0xef, 0x59, 0x34, 0x9b, 0x7a, 0xe4,
+	0x08, 0x23, 0x55, 0x83, 0x21, 0xe2, 0x51, 0xc3, 0xc3, 0x5f, 0xe0, 0xf6, 0x68, 0xfd, 0x5c, 0xbb,
+	0x58, 0x02, 0x6c, 0xea, 0x11, 0xc7, 0xeb, 0x52, 0x4c, 0x78, 0x03, 0x9d, 0x1e, 0x24, 0x37, 0x3f,
+	0x2d, 0xfe, 0x35, 0x01, 0x68, 0x4f, 0x8c, 0x9a, 0xdb, 0xa6, 0x8f, 0xad, 0xef, 0xe1, 0xd5, 0x89,
+	0x7e, 0x09, 0xec, 0xca, 0x33, 0xe4, 0x8f, 0xfd, 0xd4, 0x79, 0x01, 0xaf, 0xb0, 0x80, 0xff, 0xe5,
+	0x9b, 0xe5, 0xd5, 0x8e, 0x4d, 0x8f, 0xfb, 0x47, 0xa5, 0x36, 0x71, 0xe4, 0x7b, 0x4a, 0x39, 0x3c,
+	0x7d, 0x99, 0xfd, 0x0a, 0xf1, 0x39, 0xc1, 0xff, 0xdd, 0xb7, 0x5f, 0xde, 0xc9, 0x76, 0x71, 0xc7,
+	0x6c, 0x0f, 0x0c, 0xf6, 0x06, 0xe4, 0xeb, 0x53, 0x8e, 0xed, 0x56, 0xb8, 0xba, 0xf8, 0xf1, 0xfb,
+	0x37, 0x0a, 0xcc, 0x9f, 0xf6, 0x9a, 0xe6, 0x52, 0x6f, 0x80, 0xe6, 0x61, 0x52, 0xe6, 0xba, 0x9c,
+	0xfc, 0xd3, 0x0e, 0x4f, 0x62, 0x54, 0x85, 0x74, 0x8f, 0xe3, 0xe4, 0xfd, 0x3c, 0x3e, 0x36, 0x9e,
+	0x16, 0x38, 0xf2, 0x6e, 0x21, 0xb8, 0xf1, 0xf6, 0x10, 0xc8, 0x3c, 0x24, 0x27, 0xd8, 0x73, 0x89,
+	0x87, 0xee, 0xc1, 0xa4, 0xac, 0x6e, 0x55, 0x39, 0x67, 0xa0, 0x0d, 0x80, 0x23, 0x53, 0x69, 0x62,
+	0x74, 0x2a, 0x8d, 0x57, 0xf8, 0x37, 0x05, 0x50, 0xa0, 0xb1, 0x8a, 0x99, 0xef, 0xf8, 0x6f, 0x1e,
+	0x0d, 0xae, 0x5a, 0xe2, 0x1b, 0xf1, 0x8c, 0x8b, 0x5a, 0x51, 0x18, 0x52, 0xe4, 0x3a, 0xda, 0x81,
+	0x42, 0x47, 0x0a, 0x1f, 0x4a, 0x39, 0x6f, 0x38, 0x9f, 0x09, 0x18, 0x72, 0x39, 0xd6, 0xee, 0x3b,
+	0xbf, 0x52, 0x00, 0x22, 0xef, 0x65, 0x37, 0x61, 0xfe, 0xb0, 0xde, 0xd2, 0x8c, 0x7a, 0xa3, 0x55,
+	0xab, 0xef, 0x1b, 0x07, 0xfb, 0xcd, 0x86, 0xb6, 0x53, 0x7b, 0x50, 0xd3, 0xaa, 0x85, 0x2b, 0x68,
+	0x16, 0x66, 0xa2, 0x9b, 0x4f, 0xb5, 0x66, 0x41, 0x41, 0xf3, 0x30, 0x1b, 0x5d, 0xac, 0x6c, 0x37,
+	0x5b, 0x95, 0xda, 0x7e, 0x21, 0x81, 0x10, 0xe4, 0xa3, 0x1b, 0xfb, 0xf5, 0x42, 0x12, 0xdd, 0x02,
+	0x75, 0x74, 0xcd, 0x78, 0x52, 0x6b, 0x3d, 0x32, 0x0e, 0xb5, 0x56, 0xbd, 0x90, 0xba, 0xf3, 0x6b,
+	0x05, 0xb2, 0xd1, 0x1f, 0xbc, 0x68, 0x11, 0x6e, 0x34, 0xf4, 0x7a, 0xa3, 0xde, 0xac, 0xec, 0x1a,
+	0xad, 0xa7, 0x0d, 0x6d, 0xcc, 0x9e, 0x05, 0xb8, 0x3e, 0xba, 0xdd, 0x6c, 0x55, 0xf6, 0xab, 0x15,
+	0xbd, 0x5a, 0x50, 0x98, 0xa6, 0xd1, 0x3d, 0xa6, 0x72, 0xaf, 0xd6, 0x6c, 0xd5, 0x76, 0x0a, 0x09,
+	0x74, 0x1b, 0x16, 0x47, 0x77, 0xf7, 0x0e, 0x76, 0x5b, 0xb5, 0xc6, 0xae, 0x66, 0xec, 0x3c, 0xaa,
+	0xd7, 0x76, 0xb4, 0x42, 0xf2, 0xce, 0x3f, 0x15, 0xc8, 0x8f, 0xbe, 0x51, 0xa1, 0x65, 0xb8, 0x39,
+	0x64, 0x35, 0x5b, 0x95, 0xd6, 0x41, 0x73, 0xcc, 0xa0, 0x22, 0x2c, 0x8d, 0x03, 0xaa, 0x5a, 0xa3,
+	0xde, 0xac, 0xb5, 0x8c, 0x86, 0xa6, 0xd7, 0xea, 0xcc, 0xb0, 0xa8, 0x6a, 0x89, 0x39, 0xac, 0xb7,
+	0x6a, 0xfb, 0x0f, 0x03, 0x48, 0x62, 0xe4, 0x5c, 0x12, 0xd2, 0xa8, 0x34, 0x9b, 0x5a, 0x55, 0x78,
+	0x70, 0x7c, 0x4f, 0xd7, 0x1e, 0x6b, 0x3b, 0x2d, 0xad, 0x5a, 0x48, 0xc5, 0x31, 0x1f, 0x54, 0x6a,
+	0xbb, 0x5a, 0xb5, 0x30, 0xb1, 0xad, 0x7d, 0xf5, 0x76, 0x49, 0xf9, 0xfa, 0xed, 0x92, 0xf2, 0xdf,
+	0xb7, 0x4b, 0xca, 0xcb, 0x77, 0x4b, 0x57, 0xbe, 0x7e, 0xb7, 0x74, 0xe5, 0x5f, 0xef, 0x96, 0xae,
+	0xfc, 0xe4, 0xee, 0xff, 0xed, 0x0b, 0x2f, 0xf8, 0xdb, 0x31, 0xef, 0x0e, 0xec, 0x61, 0x38, 0xcd,
+	0xfb, 0xfb, 0x27, 0xff, 0x1b, 0x00, 0xf0, 0x0f, 0x27, 0xd3, 0x59, 0x16, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -1059,6 +1534,11 @@ func (m *WeightedVoteOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Choice != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Choice))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Weight) > 0 {
 		i -= len(m.Weight)
 		copy(dAtA[i:], m.Weight)
@@ -1143,6 +1623,36 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WinningOption != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.WinningOption))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.ProposalType != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.ProposalType))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if len(m.FailedReason) > 0 {
 		i -= len(m.FailedReason)
 		copy(dAtA[i:], m.FailedReason)
@@ -1281,6 +1791,50 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposalOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposalOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TallyResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1301,6 +1855,15 @@ func (m *TallyResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OptionCounts) > 0 {
+		for iNdEx := len(m.OptionCounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptionCounts[iNdEx])
+			copy(dAtA[i:], m.OptionCounts[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.OptionCounts[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.NoWithVetoCount) > 0 {
 		i -= len(m.NoWithVetoCount)
 		copy(dAtA[i:], m.NoWithVetoCount)
@@ -1532,6 +2095,65 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OptimisticRejectedThreshold) > 0 {
+		i -= len(m.OptimisticRejectedThreshold)
+		copy(dAtA[i:], m.OptimisticRejectedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticRejectedThreshold)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.OptimisticVotingPeriod != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.OptimisticVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.OptimisticVotingPeriod):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.OptimisticMinDeposit) > 0 {
+		for iNdEx := len(m.OptimisticMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OptimisticMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.OptimisticAllowedMsgUrls) > 0 {
+		for iNdEx := len(m.OptimisticAllowedMsgUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptimisticAllowedMsgUrls[iNdEx])
+			copy(dAtA[i:], m.OptimisticAllowedMsgUrls[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticAllowedMsgUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.OptimisticAuthorizedAddresses) > 0 {
+		for iNdEx := len(m.OptimisticAuthorizedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptimisticAuthorizedAddresses[iNdEx])
+			copy(dAtA[i:], m.OptimisticAuthorizedAddresses[iNdEx])
+			i = encodeVarintGov(dAtA, i, uint64(len(m.OptimisticAuthorizedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
 	if len(m.MinDepositRatio) > 0 {
 		i -= len(m.MinDepositRatio)
 		copy(dAtA[i:], m.MinDepositRatio)
@@ -1593,12 +2215,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 	}
 	if m.ExpeditedVotingPeriod != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
-		if err8 != nil {
-			return 0, err8
+		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err9 != nil {
+			return 0, err9
 		}
-		i -= n8
-		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -1645,22 +2267,22 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 	}
 	if m.VotingPeriod != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
-		if err9 != nil {
-			return 0, err9
+		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err10 != nil {
+			return 0, err10
 		}
-		i -= n9
-		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.MaxDepositPeriod != nil {
-		n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
-		if err10 != nil {
-			return 0, err10
+		n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err11 != nil {
+			return 0, err11
 		}
-		i -= n10
-		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i -= n11
+		i = encodeVarintGov(dAtA, i, uint64(n11))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1681,6 +2303,178 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MessageBasedParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageBasedParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinAmount) > 0 {
+		for iNdEx := len(m.MinAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageBasedParamsEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageBasedParamsEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageBasedParamsEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgUrl) > 0 {
+		i -= len(m.MsgUrl)
+		copy(dAtA[i:], m.MsgUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Governor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Governor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Governor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GovernorDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernorDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernorDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GovernorAddress) > 0 {
+		i -= len(m.GovernorAddress)
+		copy(dAtA[i:], m.GovernorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.GovernorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1705,6 +2499,9 @@ func (m *WeightedVoteOption) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.Choice != 0 {
+		n += 1 + sovGov(uint64(m.Choice))
+	}
 	return n
 }
 
@@ -1797,7 +2594,38 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
-	return n
+	if m.ProposalType != 0 {
+		n += 2 + sovGov(uint64(m.ProposalType))
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if m.WinningOption != 0 {
+		n += 2 + sovGov(uint64(m.WinningOption))
+	}
+	return n
+}
+
+func (m *ProposalOption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
 }
 
 func (m *TallyResult) Size() (n int) {
@@ -1822,6 +2650,12 @@ func (m *TallyResult) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if len(m.OptionCounts) > 0 {
+		for _, s := range m.OptionCounts {
+			l = len(s)
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1975,6 +2809,108 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovGov(uint64(l))
 	}
+	if len(m.OptimisticAuthorizedAddresses) > 0 {
+		for _, s := range m.OptimisticAuthorizedAddresses {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.OptimisticAllowedMsgUrls) > 0 {
+		for _, s := range m.OptimisticAllowedMsgUrls {
+			l = len(s)
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.OptimisticMinDeposit) > 0 {
+		for _, e := range m.OptimisticMinDeposit {
+			l = e.Size()
+			n += 2 + l + sovGov(uint64(l))
+		}
+	}
+	if m.OptimisticVotingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.OptimisticVotingPeriod)
+		n += 2 + l + sovGov(uint64(l))
+	}
+	l = len(m.OptimisticRejectedThreshold)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *MessageBasedParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.MinAmount) > 0 {
+		for _, e := range m.MinAmount {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MessageBasedParamsEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
+func (m *Governor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *GovernorDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.GovernorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2064,6 +3000,25 @@ func (m *WeightedVoteOption) Unmarshal(dAtA []byte) error {
 			}
 			m.Weight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Choice", wireType)
+			}
+			m.Choice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Choice |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2715,6 +3670,78 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.FailedReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalType", wireType)
+			}
+			m.ProposalType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalType |= ProposalType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &ProposalOption{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningOption", wireType)
+			}
+			m.WinningOption = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WinningOption |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2736,7 +3763,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TallyResult) Unmarshal(dAtA []byte) error {
+func (m *ProposalOption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2759,15 +3786,15 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposalOption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposalOption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2795,13 +3822,13 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.YesCount = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -2811,71 +3838,187 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGov
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGov
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AbstainCount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Messages = append(m.Messages, &any.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGov
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGov
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NoCount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGov
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field YesCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.YesCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbstainCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NoCount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoWithVetoCount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2893,6 +4036,38 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 			}
 			m.NoWithVetoCount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionCounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionCounts = append(m.OptionCounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -3954,6 +5129,695 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.MinDepositRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticAuthorizedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimisticAuthorizedAddresses = append(m.OptimisticAuthorizedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticAllowedMsgUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimisticAllowedMsgUrls = append(m.OptimisticAllowedMsgUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticMinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimisticMinDeposit = append(m.OptimisticMinDeposit, types.Coin{})
+			if err := m.OptimisticMinDeposit[len(m.OptimisticMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticVotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptimisticVotingPeriod == nil {
+				m.OptimisticVotingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.OptimisticVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptimisticRejectedThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptimisticRejectedThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageBasedParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageBasedParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinAmount = append(m.MinAmount, types.Coin{})
+			if err := m.MinAmount[len(m.MinAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageBasedParamsEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageBasedParamsEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageBasedParamsEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Governor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Governor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Governor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernorDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernorDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernorDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
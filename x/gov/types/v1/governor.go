@@ -0,0 +1,11 @@
+package v1
+
+// NewGovernor creates a new Governor instance
+func NewGovernor(address, metadata string) Governor {
+	return Governor{Address: address, Metadata: metadata}
+}
+
+// NewGovernorDelegation creates a new GovernorDelegation instance
+func NewGovernorDelegation(delegator, governor string) GovernorDelegation {
+	return GovernorDelegation{DelegatorAddress: delegator, GovernorAddress: governor}
+}
@@ -8,8 +8,9 @@ import (
 )
 
 var (
-	_, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}
-	_, _                codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgUpdateParams{}, &MsgCancelProposal{}, &MsgUpdateMessageParams{}
+	_, _, _                sdk.Msg                            = &MsgCreateGovernor{}, &MsgDelegateGovernor{}, &MsgUndelegateGovernor{}
+	_, _                   codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -38,6 +39,24 @@ func NewMsgSubmitProposal(
 	return m, nil
 }
 
+// NewMsgSubmitMultipleChoiceProposal creates a new MsgSubmitProposal of a
+// multiple choice proposal.
+func NewMsgSubmitMultipleChoiceProposal(
+	options []*ProposalOption,
+	initialDeposit sdk.Coins,
+	proposer, metadata, title, summary string,
+) *MsgSubmitProposal {
+	return &MsgSubmitProposal{
+		InitialDeposit: initialDeposit,
+		Proposer:       proposer,
+		Metadata:       metadata,
+		Title:          title,
+		Summary:        summary,
+		ProposalType:   ProposalTypeMultipleChoice,
+		Options:        options,
+	}
+}
+
 // GetMsgs unpacks m.Messages Any's into sdk.Msg's
 func (m *MsgSubmitProposal) GetMsgs() ([]sdk.Msg, error) {
 	return sdktx.GetMsgs(m.Messages, "sdk.MsgProposal")
@@ -57,7 +76,11 @@ func (m *MsgSubmitProposal) SetMsgs(msgs []sdk.Msg) error {
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (m MsgSubmitProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
-	return sdktx.UnpackInterfaces(unpacker, m.Messages)
+	if err := sdktx.UnpackInterfaces(unpacker, m.Messages); err != nil {
+		return err
+	}
+
+	return ProposalOptions(m.Options).UnpackInterfaces(unpacker)
 }
 
 // NewMsgDeposit creates a new MsgDeposit instance
@@ -106,3 +129,36 @@ func NewMsgCancelProposal(proposalID uint64, proposer string) *MsgCancelProposal
 		Proposer:   proposer,
 	}
 }
+
+// NewMsgUpdateMessageParams creates a new MsgUpdateMessageParams instance. If
+// params is nil, the message based params of msgURL are removed.
+func NewMsgUpdateMessageParams(authority, msgURL string, params *MessageBasedParams) *MsgUpdateMessageParams {
+	return &MsgUpdateMessageParams{
+		Authority: authority,
+		MsgUrl:    msgURL,
+		Params:    params,
+	}
+}
+
+// NewMsgCreateGovernor creates a new MsgCreateGovernor instance.
+func NewMsgCreateGovernor(address, metadata string) *MsgCreateGovernor {
+	return &MsgCreateGovernor{
+		Address:  address,
+		Metadata: metadata,
+	}
+}
+
+// NewMsgDelegateGovernor creates a new MsgDelegateGovernor instance.
+func NewMsgDelegateGovernor(delegator, governor string) *MsgDelegateGovernor {
+	return &MsgDelegateGovernor{
+		DelegatorAddress: delegator,
+		GovernorAddress:  governor,
+	}
+}
+
+// NewMsgUndelegateGovernor creates a new MsgUndelegateGovernor instance.
+func NewMsgUndelegateGovernor(delegator string) *MsgUndelegateGovernor {
+	return &MsgUndelegateGovernor{
+		DelegatorAddress: delegator,
+	}
+}
@@ -1,6 +1,7 @@
 package v1
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -18,19 +19,20 @@ const (
 
 // Default governance params
 var (
-	DefaultMinDepositTokens          = sdkmath.NewInt(10000000)
-	DefaultMinExpeditedDepositTokens = DefaultMinDepositTokens.Mul(sdkmath.NewInt(DefaultMinExpeditedDepositTokensRatio))
-	DefaultQuorum                    = sdkmath.LegacyNewDecWithPrec(334, 3)
-	DefaultThreshold                 = sdkmath.LegacyNewDecWithPrec(5, 1)
-	DefaultExpeditedThreshold        = sdkmath.LegacyNewDecWithPrec(667, 3)
-	DefaultVetoThreshold             = sdkmath.LegacyNewDecWithPrec(334, 3)
-	DefaultMinInitialDepositRatio    = sdkmath.LegacyZeroDec()
-	DefaultProposalCancelRatio       = sdkmath.LegacyMustNewDecFromStr("0.5")
-	DefaultProposalCancelDestAddress = ""
-	DefaultBurnProposalPrevote       = false // set to false to replicate behavior of when this change was made (0.47)
-	DefaultBurnVoteQuorom            = false // set to false to  replicate behavior of when this change was made (0.47)
-	DefaultBurnVoteVeto              = true  // set to true to replicate behavior of when this change was made (0.47)
-	DefaultMinDepositRatio           = sdkmath.LegacyMustNewDecFromStr("0.01")
+	DefaultMinDepositTokens            = sdkmath.NewInt(10000000)
+	DefaultMinExpeditedDepositTokens   = DefaultMinDepositTokens.Mul(sdkmath.NewInt(DefaultMinExpeditedDepositTokensRatio))
+	DefaultQuorum                      = sdkmath.LegacyNewDecWithPrec(334, 3)
+	DefaultThreshold                   = sdkmath.LegacyNewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold          = sdkmath.LegacyNewDecWithPrec(667, 3)
+	DefaultVetoThreshold               = sdkmath.LegacyNewDecWithPrec(334, 3)
+	DefaultMinInitialDepositRatio      = sdkmath.LegacyZeroDec()
+	DefaultProposalCancelRatio         = sdkmath.LegacyMustNewDecFromStr("0.5")
+	DefaultProposalCancelDestAddress   = ""
+	DefaultBurnProposalPrevote         = false // set to false to replicate behavior of when this change was made (0.47)
+	DefaultBurnVoteQuorom              = false // set to false to  replicate behavior of when this change was made (0.47)
+	DefaultBurnVoteVeto                = true  // set to true to replicate behavior of when this change was made (0.47)
+	DefaultMinDepositRatio             = sdkmath.LegacyMustNewDecFromStr("0.01")
+	DefaultOptimisticRejectedThreshold = sdkmath.LegacyNewDecWithPrec(1, 1)
 )
 
 // Deprecated: NewDepositParams creates a new DepositParams object
@@ -62,24 +64,31 @@ func NewParams(
 	minDeposit, expeditedminDeposit sdk.Coins, maxDepositPeriod, votingPeriod, expeditedVotingPeriod time.Duration,
 	quorum, threshold, expeditedThreshold, vetoThreshold, minInitialDepositRatio, proposalCancelRatio, proposalCancelDest string,
 	burnProposalDeposit, burnVoteQuorum, burnVoteVeto bool, minDepositRatio string,
+	optimisticAuthorizedAddresses, optimisticAllowedMsgURLs []string, optimisticMinDeposit sdk.Coins,
+	optimisticVotingPeriod time.Duration, optimisticRejectedThreshold string,
 ) Params {
 	return Params{
-		MinDeposit:                 minDeposit,
-		ExpeditedMinDeposit:        expeditedminDeposit,
-		MaxDepositPeriod:           &maxDepositPeriod,
-		VotingPeriod:               &votingPeriod,
-		ExpeditedVotingPeriod:      &expeditedVotingPeriod,
-		Quorum:                     quorum,
-		Threshold:                  threshold,
-		ExpeditedThreshold:         expeditedThreshold,
-		VetoThreshold:              vetoThreshold,
-		MinInitialDepositRatio:     minInitialDepositRatio,
-		ProposalCancelRatio:        proposalCancelRatio,
-		ProposalCancelDest:         proposalCancelDest,
-		BurnProposalDepositPrevote: burnProposalDeposit,
-		BurnVoteQuorum:             burnVoteQuorum,
-		BurnVoteVeto:               burnVoteVeto,
-		MinDepositRatio:            minDepositRatio,
+		MinDeposit:                    minDeposit,
+		ExpeditedMinDeposit:           expeditedminDeposit,
+		MaxDepositPeriod:              &maxDepositPeriod,
+		VotingPeriod:                  &votingPeriod,
+		ExpeditedVotingPeriod:         &expeditedVotingPeriod,
+		Quorum:                        quorum,
+		Threshold:                     threshold,
+		ExpeditedThreshold:            expeditedThreshold,
+		VetoThreshold:                 vetoThreshold,
+		MinInitialDepositRatio:        minInitialDepositRatio,
+		ProposalCancelRatio:           proposalCancelRatio,
+		ProposalCancelDest:            proposalCancelDest,
+		BurnProposalDepositPrevote:    burnProposalDeposit,
+		BurnVoteQuorum:                burnVoteQuorum,
+		BurnVoteVeto:                  burnVoteVeto,
+		MinDepositRatio:               minDepositRatio,
+		OptimisticAuthorizedAddresses: optimisticAuthorizedAddresses,
+		OptimisticAllowedMsgUrls:      optimisticAllowedMsgURLs,
+		OptimisticMinDeposit:          optimisticMinDeposit,
+		OptimisticVotingPeriod:        &optimisticVotingPeriod,
+		OptimisticRejectedThreshold:   optimisticRejectedThreshold,
 	}
 }
 
@@ -102,6 +111,11 @@ func DefaultParams() Params {
 		DefaultBurnVoteQuorom,
 		DefaultBurnVoteVeto,
 		DefaultMinDepositRatio.String(),
+		nil,
+		nil,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
+		DefaultPeriod,
+		DefaultOptimisticRejectedThreshold.String(),
 	)
 }
 
@@ -219,5 +233,104 @@ func (p Params) ValidateBasic() error {
 		}
 	}
 
+	for _, addr := range p.OptimisticAuthorizedAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("optimistic authorized address is invalid: %s", addr)
+		}
+	}
+
+	for _, msgURL := range p.OptimisticAllowedMsgUrls {
+		if len(msgURL) == 0 {
+			return errors.New("optimistic allowed msg url cannot be empty")
+		}
+	}
+
+	if minOptimisticDeposit := sdk.Coins(p.OptimisticMinDeposit); minOptimisticDeposit.Empty() || !minOptimisticDeposit.IsValid() {
+		return fmt.Errorf("invalid optimistic minimum deposit: %s", minOptimisticDeposit)
+	}
+
+	if p.OptimisticVotingPeriod == nil {
+		return fmt.Errorf("optimistic voting period must not be nil: %d", p.OptimisticVotingPeriod)
+	}
+	if p.OptimisticVotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("optimistic voting period must be positive: %s", p.OptimisticVotingPeriod)
+	}
+
+	optimisticRejectedThreshold, err := sdkmath.LegacyNewDecFromStr(p.OptimisticRejectedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid optimistic rejected threshold string: %w", err)
+	}
+	if !optimisticRejectedThreshold.IsPositive() {
+		return fmt.Errorf("optimistic rejected threshold must be positive: %s", optimisticRejectedThreshold)
+	}
+	if optimisticRejectedThreshold.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("optimistic rejected threshold too large: %s", optimisticRejectedThreshold)
+	}
+
+	return nil
+}
+
+// NewMessageBasedParams creates a new MessageBasedParams instance with given values.
+func NewMessageBasedParams(quorum, threshold, vetoThreshold string, minAmount sdk.Coins) MessageBasedParams {
+	return MessageBasedParams{
+		Quorum:        quorum,
+		Threshold:     threshold,
+		VetoThreshold: vetoThreshold,
+		MinAmount:     minAmount,
+	}
+}
+
+// ValidateBasic performs basic validation on message based params.
+func (p MessageBasedParams) ValidateBasic() error {
+	quorum, err := sdkmath.LegacyNewDecFromStr(p.Quorum)
+	if err != nil {
+		return fmt.Errorf("invalid quorum string: %w", err)
+	}
+	if quorum.IsNegative() {
+		return fmt.Errorf("quorom cannot be negative: %s", quorum)
+	}
+	if quorum.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("quorom too large: %s", p.Quorum)
+	}
+
+	threshold, err := sdkmath.LegacyNewDecFromStr(p.Threshold)
+	if err != nil {
+		return fmt.Errorf("invalid threshold string: %w", err)
+	}
+	if !threshold.IsPositive() {
+		return fmt.Errorf("vote threshold must be positive: %s", threshold)
+	}
+	if threshold.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("vote threshold too large: %s", threshold)
+	}
+
+	vetoThreshold, err := sdkmath.LegacyNewDecFromStr(p.VetoThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid vetoThreshold string: %w", err)
+	}
+	if !vetoThreshold.IsPositive() {
+		return fmt.Errorf("veto threshold must be positive: %s", vetoThreshold)
+	}
+	if vetoThreshold.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("veto threshold too large: %s", vetoThreshold)
+	}
+
+	if !p.MinAmount.IsValid() {
+		return fmt.Errorf("invalid minimum amount: %s", p.MinAmount)
+	}
+
 	return nil
 }
+
+// MsgWithAmount is implemented by messages moving an amount of coins. Message
+// based params with a minimum amount only apply to messages implementing it.
+type MsgWithAmount interface {
+	GetAmount() sdk.Coins
+}
+
+// AppliesTo returns true if the message based params apply to a message
+// moving amount, that is if they have no minimum amount or if amount is at
+// least the minimum amount in one of its denoms.
+func (p MessageBasedParams) AppliesTo(amount sdk.Coins) bool {
+	return p.MinAmount.Empty() || amount.IsAnyGTE(p.MinAmount)
+}
@@ -20,6 +20,10 @@ const (
 	StatusPassed        = ProposalStatus_PROPOSAL_STATUS_PASSED
 	StatusRejected      = ProposalStatus_PROPOSAL_STATUS_REJECTED
 	StatusFailed        = ProposalStatus_PROPOSAL_STATUS_FAILED
+
+	ProposalTypeStandard       = ProposalType_PROPOSAL_TYPE_STANDARD
+	ProposalTypeOptimistic     = ProposalType_PROPOSAL_TYPE_OPTIMISTIC
+	ProposalTypeMultipleChoice = ProposalType_PROPOSAL_TYPE_MULTIPLE_CHOICE
 )
 
 // NewProposal creates a new Proposal instance
@@ -53,19 +57,72 @@ func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
 	return sdktx.GetMsgs(p.Messages, "sdk.MsgProposal")
 }
 
-// GetMinDepositFromParams returns min expedited deposit from the gov params if
-// the proposal is expedited. Otherwise, returns the regular min deposit from
-// gov params.
+// GetOptionMsgs returns the messages of the option of a multiple choice
+// proposal with the given 1-based index.
+func (p Proposal) GetOptionMsgs(choice uint32) ([]sdk.Msg, error) {
+	if choice == 0 || int(choice) > len(p.Options) {
+		return nil, fmt.Errorf("proposal %d has no option %d", p.Id, choice)
+	}
+
+	return p.Options[choice-1].GetMsgs()
+}
+
+// GetMinDepositFromParams returns min optimistic deposit from the gov params if
+// the proposal is optimistic, and min expedited deposit if the proposal is
+// expedited. Otherwise, returns the regular min deposit from gov params.
 func (p Proposal) GetMinDepositFromParams(params Params) sdk.Coins {
-	if p.Expedited {
+	switch {
+	case p.ProposalType == ProposalTypeOptimistic:
+		return params.OptimisticMinDeposit
+	case p.Expedited:
 		return params.ExpeditedMinDeposit
+	default:
+		return params.MinDeposit
 	}
-	return params.MinDeposit
 }
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
-	return sdktx.UnpackInterfaces(unpacker, p.Messages)
+	if err := sdktx.UnpackInterfaces(unpacker, p.Messages); err != nil {
+		return err
+	}
+
+	return ProposalOptions(p.Options).UnpackInterfaces(unpacker)
+}
+
+// NewProposalOption creates a new ProposalOption instance
+func NewProposalOption(title string, messages []sdk.Msg) (*ProposalOption, error) {
+	msgs, err := sdktx.SetMsgs(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProposalOption{Title: title, Messages: msgs}, nil
+}
+
+// GetMsgs returns the proposal option messages
+func (o ProposalOption) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(o.Messages, "sdk.MsgProposal")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (o ProposalOption) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, o.Messages)
+}
+
+// ProposalOptions is an array of proposal options
+type ProposalOptions []*ProposalOption
+
+var _ types.UnpackInterfacesMessage = ProposalOptions{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (o ProposalOptions) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, x := range o {
+		if err := x.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Proposals is an array of proposal
@@ -119,6 +176,15 @@ func (status ProposalStatus) Format(s fmt.State, verb rune) {
 	}
 }
 
+// ValidProposalType returns true if the proposal type is valid and false
+// otherwise. An unspecified proposal type is a standard proposal.
+func ValidProposalType(proposalType ProposalType) bool {
+	return proposalType == ProposalType_PROPOSAL_TYPE_UNSPECIFIED ||
+		proposalType == ProposalTypeStandard ||
+		proposalType == ProposalTypeOptimistic ||
+		proposalType == ProposalTypeMultipleChoice
+}
+
 // ValidProposalStatus returns true if the proposal status is valid and false
 // otherwise.
 func ValidProposalStatus(status ProposalStatus) bool {
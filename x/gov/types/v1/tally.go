@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"slices"
+
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -48,6 +50,47 @@ func NewTallyResultFromMap(results map[VoteOption]math.LegacyDec) TallyResult {
 	)
 }
 
+// NewMultipleChoiceTallyResult creates a new TallyResult instance of a multiple
+// choice proposal from its abstain votes and the votes on each of its options.
+func NewMultipleChoiceTallyResult(abstain math.Int, optionCounts []math.Int) TallyResult {
+	tallyResult := NewTallyResult(math.ZeroInt(), abstain, math.ZeroInt(), math.ZeroInt())
+	for _, count := range optionCounts {
+		tallyResult.OptionCounts = append(tallyResult.OptionCounts, count.String())
+	}
+
+	return tallyResult
+}
+
+// WinningOption returns the 1-based index of the option of a multiple choice
+// proposal with the most votes, or 0 if no option has strictly more votes than
+// all the others.
+func (tr TallyResult) WinningOption() uint32 {
+	var (
+		winner   uint32
+		maxCount = math.ZeroInt()
+		tied     bool
+	)
+	for i, countStr := range tr.OptionCounts {
+		count, ok := math.NewIntFromString(countStr)
+		if !ok {
+			return 0
+		}
+
+		switch {
+		case count.GT(maxCount):
+			winner, maxCount, tied = uint32(i+1), count, false
+		case count.Equal(maxCount):
+			tied = true
+		}
+	}
+
+	if tied {
+		return 0
+	}
+
+	return winner
+}
+
 // EmptyTallyResult returns an empty TallyResult.
 func EmptyTallyResult() TallyResult {
 	return NewTallyResult(math.ZeroInt(), math.ZeroInt(), math.ZeroInt(), math.ZeroInt())
@@ -58,5 +101,6 @@ func (tr TallyResult) Equals(comp TallyResult) bool {
 	return tr.YesCount == comp.YesCount &&
 		tr.AbstainCount == comp.AbstainCount &&
 		tr.NoCount == comp.NoCount &&
-		tr.NoWithVetoCount == comp.NoWithVetoCount
+		tr.NoWithVetoCount == comp.NoWithVetoCount &&
+		slices.Equal(tr.OptionCounts, comp.OptionCounts)
 }
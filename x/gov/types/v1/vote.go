@@ -3,6 +3,7 @@ package v1
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"cosmossdk.io/math"
@@ -62,6 +63,12 @@ func NewWeightedVoteOption(option VoteOption, weight math.LegacyDec) *WeightedVo
 	return &WeightedVoteOption{Option: option, Weight: weight.String()}
 }
 
+// NewWeightedChoiceVoteOption creates a sub vote for the option of a multiple
+// choice proposal with the given 1-based index.
+func NewWeightedChoiceVoteOption(choice uint32, weight math.LegacyDec) *WeightedVoteOption {
+	return &WeightedVoteOption{Option: OptionYes, Weight: weight.String(), Choice: choice}
+}
+
 // IsValid returns true if the sub vote is valid and false otherwise.
 func (w *WeightedVoteOption) IsValid() bool {
 	weight, err := math.LegacyNewDecFromStr(w.Weight)
@@ -78,7 +85,7 @@ func (w *WeightedVoteOption) IsValid() bool {
 
 // NewNonSplitVoteOption creates a single option vote with weight 1
 func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
-	return WeightedVoteOptions{{option, math.LegacyNewDec(1).String()}}
+	return WeightedVoteOptions{{Option: option, Weight: math.LegacyNewDec(1).String()}}
 }
 
 // ValidWeightedVoteOption returns true if the sub vote is valid and false otherwise.
@@ -90,6 +97,21 @@ func ValidWeightedVoteOption(option WeightedVoteOption) bool {
 	return ValidVoteOption(option.Option)
 }
 
+// ValidMultipleChoiceVoteOption returns true if the sub vote is valid on a
+// multiple choice proposal with the given number of options and false
+// otherwise. A sub vote on a multiple choice proposal either abstains or votes
+// yes on one of its options.
+func ValidMultipleChoiceVoteOption(option WeightedVoteOption, numOptions int) bool {
+	switch option.Option {
+	case OptionAbstain:
+		return option.Choice == 0
+	case OptionYes:
+		return option.Choice > 0 && int(option.Choice) <= numOptions
+	default:
+		return false
+	}
+}
+
 // WeightedVoteOptions describes array of WeightedVoteOptions
 type WeightedVoteOptions []*WeightedVoteOption
 
@@ -109,14 +131,22 @@ func VoteOptionFromString(str string) (VoteOption, error) {
 }
 
 // WeightedVoteOptionsFromString returns weighted vote options from string. It returns an error
-// if the string is invalid.
+// if the string is invalid. An option given by its 1-based index votes yes on
+// that option of a multiple choice proposal.
 func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
 	options := WeightedVoteOptions{}
 	for _, option := range strings.Split(str, ",") {
 		fields := strings.Split(option, "=")
-		option, err := VoteOptionFromString(fields[0])
+		choice, err := strconv.ParseUint(fields[0], 10, 32)
 		if err != nil {
-			return options, err
+			choice = 0
+		}
+		voteOption := OptionYes
+		if choice == 0 {
+			voteOption, err = VoteOptionFromString(fields[0])
+			if err != nil {
+				return options, err
+			}
 		}
 		if len(fields) < 2 {
 			return options, fmt.Errorf("weight field does not exist for %s option", fields[0])
@@ -125,7 +155,7 @@ func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
 		if err != nil {
 			return options, err
 		}
-		options = append(options, NewWeightedVoteOption(option, weight))
+		options = append(options, &WeightedVoteOption{Option: voteOption, Weight: weight.String(), Choice: uint32(choice)})
 	}
 	return options, nil
 }
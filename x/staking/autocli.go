@@ -137,6 +137,29 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the current staking parameters information",
 					Long:      "Query values set as staking parameters.",
 				},
+				{
+					RpcMethod: "TokenizeShareRecord",
+					Use:       "tokenize-share-record [id]",
+					Short:     "Query a tokenize share record by id",
+					Example:   fmt.Sprintf("$ %s query staking tokenize-share-record 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "LiquidValidator",
+					Use:       "liquid-validator [validator-addr]",
+					Short:     "Query the liquid shares and validator bond shares of a validator",
+					Example:   fmt.Sprintf("$ %s query staking liquid-validator cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "validator_addr"},
+					},
+				},
+				{
+					RpcMethod: "TotalLiquidStaked",
+					Use:       "total-liquid-staked",
+					Short:     "Query the total liquid staked tokens",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -182,6 +205,30 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
 					GovProposal:    true,
 				},
+				{
+					RpcMethod:      "TokenizeShares",
+					Use:            "tokenize-share [validator-addr] [amount] [rewards-owner] --from [delegator_address]",
+					Short:          "Tokenize delegation shares",
+					Long:           "Convert an amount of a delegation into share tokens of the validator, whose rewards are received by the rewards owner.",
+					Example:        fmt.Sprintf(`%s tx staking tokenize-share cosmosvaloper... 100stake cosmos1... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "amount"}, {ProtoField: "tokenized_share_owner"}},
+				},
+				{
+					RpcMethod:      "RedeemTokensForShares",
+					Use:            "redeem-tokens [amount] --from [delegator_address]",
+					Short:          "Redeem share tokens for a delegation",
+					Long:           "Convert an amount of share tokens of a validator back into a delegation to the validator.",
+					Example:        fmt.Sprintf(`%s tx staking redeem-tokens 100cosmosvaloper.../1 --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "ValidatorBond",
+					Use:            "validator-bond [validator-addr] --from [delegator_address]",
+					Short:          "Designate a delegation as validator bond",
+					Long:           "Designate a delegation as validator bond, which allows the validator to receive liquid delegations according to the validator bond factor.",
+					Example:        fmt.Sprintf(`%s tx staking validator-bond cosmosvaloper... --from mykey`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}},
+				},
 			},
 			EnhanceCustomCommand: false, // use custom commands only until v0.51
 		},
@@ -48,9 +48,35 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastRecordID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+
+	for _, record := range records {
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+
+		if record.Id > lastRecordID {
+			return fmt.Errorf("tokenize share record id %d is greater than the last tokenize share record id %d", record.Id, lastRecordID)
+		}
+
+		if record.ModuleAccount != types.NewTokenizeShareRecord(record.Id, record.Owner, record.Validator).ModuleAccount {
+			return fmt.Errorf("invalid module account %s of tokenize share record %d", record.ModuleAccount, record.Id)
+		}
+
+		ids[record.Id] = true
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
@@ -168,6 +168,10 @@ func (k Keeper) RemoveDelegation(ctx context.Context, delegation types.Delegatio
 		return err
 	}
 
+	if err = k.RemoveValidatorBond(ctx, delegatorAddress, valAddr); err != nil {
+		return err
+	}
+
 	return store.Delete(types.GetDelegationsByValKey(valAddr, delegatorAddress))
 }
 
@@ -965,6 +969,10 @@ func (k Keeper) Delegate(
 		return newShares, err
 	}
 
+	if err = k.updateValidatorBondShares(ctx, delAddr, valbz, newShares); err != nil {
+		return newShares, err
+	}
+
 	// Call the after-modification hook
 	if err := k.Hooks().AfterDelegationModified(ctx, delAddr, valbz); err != nil {
 		return newShares, err
@@ -1004,6 +1012,10 @@ func (k Keeper) Unbond(
 	// subtract shares from delegation
 	delegation.Shares = delegation.Shares.Sub(shares)
 
+	if err = k.updateValidatorBondShares(ctx, delAddr, valAddr, shares.Neg()); err != nil {
+		return amount, err
+	}
+
 	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(delegation.DelegatorAddress)
 	if err != nil {
 		return amount, err
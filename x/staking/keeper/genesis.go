@@ -137,6 +137,44 @@ func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) (res
 		}
 	}
 
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.SetTokenizeShareRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId); err != nil {
+		panic(err)
+	}
+
+	if !data.TotalLiquidStakedTokens.IsNil() {
+		if err := k.SetTotalLiquidStakedTokens(ctx, data.TotalLiquidStakedTokens); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, liquidValidator := range data.LiquidValidators {
+		if err := k.SetLiquidValidator(ctx, liquidValidator); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, validatorBond := range data.ValidatorBonds {
+		delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(validatorBond.DelegatorAddress)
+		if err != nil {
+			panic(fmt.Errorf("invalid delegator address: %w", err))
+		}
+
+		valAddr, err := k.validatorAddressCodec.StringToBytes(validatorBond.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.SetValidatorBond(ctx, delegatorAddress, valAddr); err != nil {
+			panic(err)
+		}
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -266,14 +304,44 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	tokenizeShareRecords, err := k.GetAllTokenizeShareRecords(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	lastTokenizeShareRecordID, err := k.GetLastTokenizeShareRecordID(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	totalLiquidStakedTokens, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	liquidValidators, err := k.GetAllLiquidValidators(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	validatorBonds, err := k.GetAllValidatorBonds(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params:               params,
-		LastTotalPower:       totalPower,
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           allValidators,
-		Delegations:          allDelegations,
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    params,
+		LastTotalPower:            totalPower,
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                allValidators,
+		Delegations:               allDelegations,
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		Exported:                  true,
+		TokenizeShareRecords:      tokenizeShareRecords,
+		LastTokenizeShareRecordId: lastTokenizeShareRecordID,
+		TotalLiquidStakedTokens:   totalLiquidStakedTokens,
+		LiquidValidators:          liquidValidators,
+		ValidatorBonds:            validatorBonds,
 	}
 }
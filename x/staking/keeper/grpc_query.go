@@ -498,6 +498,53 @@ func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*type
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// TokenizeShareRecord queries a tokenize share record by id
+func (k Querier) TokenizeShareRecord(ctx context.Context, req *types.QueryTokenizeShareRecordRequest) (*types.QueryTokenizeShareRecordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	record, err := k.GetTokenizeShareRecord(ctx, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "tokenize share record %d not found", req.Id)
+	}
+
+	return &types.QueryTokenizeShareRecordResponse{Record: record}, nil
+}
+
+// LiquidValidator queries the liquid staking accounting of a validator
+func (k Querier) LiquidValidator(ctx context.Context, req *types.QueryLiquidValidatorRequest) (*types.QueryLiquidValidatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	liquidValidator, err := k.GetLiquidValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLiquidValidatorResponse{LiquidValidator: liquidValidator}, nil
+}
+
+// TotalLiquidStaked queries the total liquid staked tokens
+func (k Querier) TotalLiquidStaked(ctx context.Context, _ *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	tokens, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTotalLiquidStakedResponse{Tokens: tokens}, nil
+}
+
 func queryRedelegation(ctx context.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
@@ -0,0 +1,343 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetTokenizeShareRecord returns a tokenize share record by id.
+func (k Keeper) GetTokenizeShareRecord(ctx context.Context, id uint64) (record types.TokenizeShareRecord, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetTokenizeShareRecordKey(id))
+	if err != nil {
+		return record, err
+	}
+
+	if bz == nil {
+		return record, types.ErrNoTokenizeShareRecord
+	}
+
+	err = k.cdc.Unmarshal(bz, &record)
+	return record, err
+}
+
+// SetTokenizeShareRecord sets a tokenize share record.
+func (k Keeper) SetTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&record)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.GetTokenizeShareRecordKey(record.Id), bz)
+}
+
+// DeleteTokenizeShareRecord deletes a tokenize share record.
+func (k Keeper) DeleteTokenizeShareRecord(ctx context.Context, id uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.GetTokenizeShareRecordKey(id))
+}
+
+// GetAllTokenizeShareRecords returns all tokenize share records, sorted by id.
+func (k Keeper) GetAllTokenizeShareRecords(ctx context.Context) (records []types.TokenizeShareRecord, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.TokenizeShareRecordKey, storetypes.PrefixEndBytes(types.TokenizeShareRecordKey))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		if err := k.cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// GetLastTokenizeShareRecordID returns the id of the last tokenize share record.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx context.Context) (uint64, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.LastTokenizeShareRecordIDKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+
+	return sdk.BigEndianToUint64(bz), nil
+}
+
+// SetLastTokenizeShareRecordID sets the id of the last tokenize share record.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx context.Context, id uint64) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTotalLiquidStakedTokens returns the total liquid staked tokens.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx context.Context) (math.Int, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.TotalLiquidStakedTokensKey)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	if bz == nil {
+		return math.ZeroInt(), nil
+	}
+
+	ip := sdk.IntProto{}
+	if err := k.cdc.Unmarshal(bz, &ip); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	return ip.Int, nil
+}
+
+// SetTotalLiquidStakedTokens sets the total liquid staked tokens.
+func (k Keeper) SetTotalLiquidStakedTokens(ctx context.Context, tokens math.Int) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&sdk.IntProto{Int: tokens})
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.TotalLiquidStakedTokensKey, bz)
+}
+
+// GetLiquidValidator returns the liquid staking accounting of a validator,
+// which has no liquid shares and no validator bond shares if it is not set.
+func (k Keeper) GetLiquidValidator(ctx context.Context, valAddr sdk.ValAddress) (types.LiquidValidator, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetLiquidValidatorKey(valAddr))
+	if err != nil {
+		return types.LiquidValidator{}, err
+	}
+
+	if bz == nil {
+		operator, err := k.validatorAddressCodec.BytesToString(valAddr)
+		if err != nil {
+			return types.LiquidValidator{}, err
+		}
+		return types.NewLiquidValidator(operator), nil
+	}
+
+	var liquidValidator types.LiquidValidator
+	err = k.cdc.Unmarshal(bz, &liquidValidator)
+	return liquidValidator, err
+}
+
+// SetLiquidValidator sets the liquid staking accounting of a validator. It is
+// deleted if the validator has no liquid shares and no validator bond shares.
+func (k Keeper) SetLiquidValidator(ctx context.Context, liquidValidator types.LiquidValidator) error {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(liquidValidator.OperatorAddress)
+	if err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if liquidValidator.LiquidShares.IsZero() && liquidValidator.ValidatorBondShares.IsZero() {
+		return store.Delete(types.GetLiquidValidatorKey(valAddr))
+	}
+
+	bz, err := k.cdc.Marshal(&liquidValidator)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.GetLiquidValidatorKey(valAddr), bz)
+}
+
+// GetAllLiquidValidators returns the liquid staking accounting of all the
+// validators having liquid shares or validator bond shares.
+func (k Keeper) GetAllLiquidValidators(ctx context.Context) (liquidValidators []types.LiquidValidator, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.LiquidValidatorKey, storetypes.PrefixEndBytes(types.LiquidValidatorKey))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var liquidValidator types.LiquidValidator
+		if err := k.cdc.Unmarshal(iterator.Value(), &liquidValidator); err != nil {
+			return nil, err
+		}
+		liquidValidators = append(liquidValidators, liquidValidator)
+	}
+
+	return liquidValidators, nil
+}
+
+// IsValidatorBond returns whether a delegation is designated as validator bond.
+func (k Keeper) IsValidatorBond(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (bool, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Has(types.GetValidatorBondKey(delAddr, valAddr))
+}
+
+// SetValidatorBond designates a delegation as validator bond.
+func (k Keeper) SetValidatorBond(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Set(types.GetValidatorBondKey(delAddr, valAddr), []byte{})
+}
+
+// RemoveValidatorBond removes the validator bond designation of a delegation.
+func (k Keeper) RemoveValidatorBond(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.GetValidatorBondKey(delAddr, valAddr))
+}
+
+// GetAllValidatorBonds returns all the delegations designated as validator bond.
+func (k Keeper) GetAllValidatorBonds(ctx context.Context) (validatorBonds []types.DVPair, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.ValidatorBondKey, storetypes.PrefixEndBytes(types.ValidatorBondKey))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		delAddr, valAddr, err := types.ParseValidatorBondKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+
+		delAddrStr, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+		if err != nil {
+			return nil, err
+		}
+		valAddrStr, err := k.validatorAddressCodec.BytesToString(valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		validatorBonds = append(validatorBonds, types.DVPair{DelegatorAddress: delAddrStr, ValidatorAddress: valAddrStr})
+	}
+
+	return validatorBonds, nil
+}
+
+// updateValidatorBondShares adds delta to the validator bond shares of the
+// validator if the delegation is designated as validator bond.
+func (k Keeper) updateValidatorBondShares(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, delta math.LegacyDec) error {
+	isValidatorBond, err := k.IsValidatorBond(ctx, delAddr, valAddr)
+	if err != nil || !isValidatorBond {
+		return err
+	}
+
+	liquidValidator, err := k.GetLiquidValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	liquidValidator.ValidatorBondShares = math.LegacyMaxDec(liquidValidator.ValidatorBondShares.Add(delta), math.LegacyZeroDec())
+	return k.SetLiquidValidator(ctx, liquidValidator)
+}
+
+// checkValidatorBondDecrease returns an error if removing shares from the
+// delegation would leave the validator with less validator bond shares than
+// required by the validator bond factor for its liquid shares.
+func (k Keeper) checkValidatorBondDecrease(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	isValidatorBond, err := k.IsValidatorBond(ctx, delAddr, valAddr)
+	if err != nil || !isValidatorBond {
+		return err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.ValidatorBondFactor.IsNegative() {
+		return nil
+	}
+
+	liquidValidator, err := k.GetLiquidValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	remainingShares := math.LegacyMaxDec(liquidValidator.ValidatorBondShares.Sub(shares), math.LegacyZeroDec())
+	if liquidValidator.LiquidShares.GT(remainingShares.Mul(params.ValidatorBondFactor)) {
+		return types.ErrInsufficientValidatorBondShares.Wrapf(
+			"%s liquid shares require more than %s validator bond shares", liquidValidator.LiquidShares, remainingShares,
+		)
+	}
+
+	return nil
+}
+
+// checkLiquidStakingCaps returns an error if tokenizing shares of the
+// validator, worth tokens, would exceed the validator bond factor or the
+// liquid staking caps.
+func (k Keeper) checkLiquidStakingCaps(
+	ctx context.Context, validator types.Validator, liquidValidator types.LiquidValidator, shares math.LegacyDec, tokens math.Int,
+) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	liquidShares := liquidValidator.LiquidShares.Add(shares)
+
+	if !params.ValidatorBondFactor.IsNegative() && liquidShares.GT(liquidValidator.ValidatorBondShares.Mul(params.ValidatorBondFactor)) {
+		return types.ErrInsufficientValidatorBondShares.Wrapf(
+			"%s liquid shares require more than %s validator bond shares", liquidShares, liquidValidator.ValidatorBondShares,
+		)
+	}
+
+	if params.ValidatorLiquidStakingCap.LT(math.LegacyOneDec()) &&
+		liquidShares.GT(validator.DelegatorShares.Mul(params.ValidatorLiquidStakingCap)) {
+		return types.ErrValidatorLiquidStakingCapExceeded.Wrapf(
+			"%s liquid shares of %s delegator shares", liquidShares, validator.DelegatorShares,
+		)
+	}
+
+	if params.GlobalLiquidStakingCap.LT(math.LegacyOneDec()) {
+		totalLiquidStakedTokens, err := k.GetTotalLiquidStakedTokens(ctx)
+		if err != nil {
+			return err
+		}
+
+		totalBondedTokens, err := k.TotalBondedTokens(ctx)
+		if err != nil {
+			return err
+		}
+
+		totalLiquidStakedTokens = totalLiquidStakedTokens.Add(tokens)
+		if math.LegacyNewDecFromInt(totalLiquidStakedTokens).GT(math.LegacyNewDecFromInt(totalBondedTokens).Mul(params.GlobalLiquidStakingCap)) {
+			return types.ErrGlobalLiquidStakingCapExceeded.Wrapf(
+				"%s liquid staked tokens of %s bonded tokens", totalLiquidStakedTokens, totalBondedTokens,
+			)
+		}
+	}
+
+	return nil
+}
+
+// slashLiquidStakedTokens decreases the total liquid staked tokens by the
+// share of the slashed tokens of the validator held by its liquid shares.
+func (k Keeper) slashLiquidStakedTokens(ctx context.Context, validator types.Validator, valAddr sdk.ValAddress, tokensToBurn math.Int) error {
+	if validator.DelegatorShares.IsZero() {
+		return nil
+	}
+
+	liquidValidator, err := k.GetLiquidValidator(ctx, valAddr)
+	if err != nil || liquidValidator.LiquidShares.IsZero() {
+		return err
+	}
+
+	totalLiquidStakedTokens, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	slashedTokens := liquidValidator.LiquidShares.MulInt(tokensToBurn).Quo(validator.DelegatorShares).TruncateInt()
+	return k.SetTotalLiquidStakedTokens(ctx, math.MaxInt(totalLiquidStakedTokens.Sub(slashedTokens), math.ZeroInt()))
+}
@@ -0,0 +1,225 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (s *KeeperTestSuite) TestLiquidStakingStore() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	_, err := keeper.GetTokenizeShareRecord(ctx, 1)
+	require.ErrorIs(err, stakingtypes.ErrNoTokenizeShareRecord)
+
+	records := []stakingtypes.TokenizeShareRecord{
+		stakingtypes.NewTokenizeShareRecord(1, Addr.String(), ValAddr.String()),
+		stakingtypes.NewTokenizeShareRecord(2, Addr.String(), ValAddr.String()),
+	}
+	for _, record := range records {
+		require.NoError(keeper.SetTokenizeShareRecord(ctx, record))
+	}
+	require.NoError(keeper.SetLastTokenizeShareRecordID(ctx, 2))
+
+	record, err := keeper.GetTokenizeShareRecord(ctx, 2)
+	require.NoError(err)
+	require.Equal(records[1], record)
+
+	allRecords, err := keeper.GetAllTokenizeShareRecords(ctx)
+	require.NoError(err)
+	require.Equal(records, allRecords)
+
+	require.NoError(keeper.DeleteTokenizeShareRecord(ctx, 1))
+	allRecords, err = keeper.GetAllTokenizeShareRecords(ctx)
+	require.NoError(err)
+	require.Equal(records[1:], allRecords)
+
+	lastID, err := keeper.GetLastTokenizeShareRecordID(ctx)
+	require.NoError(err)
+	require.Equal(uint64(2), lastID)
+
+	total, err := keeper.GetTotalLiquidStakedTokens(ctx)
+	require.NoError(err)
+	require.True(total.IsZero())
+	require.NoError(keeper.SetTotalLiquidStakedTokens(ctx, math.NewInt(100)))
+	total, err = keeper.GetTotalLiquidStakedTokens(ctx)
+	require.NoError(err)
+	require.Equal(math.NewInt(100), total)
+
+	// the liquid validator is not stored while it has no shares
+	liquidValidator, err := keeper.GetLiquidValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(stakingtypes.NewLiquidValidator(ValAddr.String()), liquidValidator)
+
+	liquidValidator.LiquidShares = math.LegacyNewDec(10)
+	require.NoError(keeper.SetLiquidValidator(ctx, liquidValidator))
+	liquidValidators, err := keeper.GetAllLiquidValidators(ctx)
+	require.NoError(err)
+	require.Len(liquidValidators, 1)
+	require.True(liquidValidators[0].Equal(liquidValidator))
+
+	liquidValidator.LiquidShares = math.LegacyZeroDec()
+	require.NoError(keeper.SetLiquidValidator(ctx, liquidValidator))
+	liquidValidators, err = keeper.GetAllLiquidValidators(ctx)
+	require.NoError(err)
+	require.Empty(liquidValidators)
+
+	isValidatorBond, err := keeper.IsValidatorBond(ctx, Addr, ValAddr)
+	require.NoError(err)
+	require.False(isValidatorBond)
+
+	require.NoError(keeper.SetValidatorBond(ctx, Addr, ValAddr))
+	isValidatorBond, err = keeper.IsValidatorBond(ctx, Addr, ValAddr)
+	require.NoError(err)
+	require.True(isValidatorBond)
+
+	validatorBonds, err := keeper.GetAllValidatorBonds(ctx)
+	require.NoError(err)
+	require.Equal([]stakingtypes.DVPair{{DelegatorAddress: Addr.String(), ValidatorAddress: ValAddr.String()}}, validatorBonds)
+
+	require.NoError(keeper.RemoveValidatorBond(ctx, Addr, ValAddr))
+	validatorBonds, err = keeper.GetAllValidatorBonds(ctx)
+	require.NoError(err)
+	require.Empty(validatorBonds)
+}
+
+func (s *KeeperTestSuite) TestMsgValidatorBond() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+
+	comm := stakingtypes.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.NewCoin(sdk.DefaultBondDenom, keeper.TokensFromConsensusPower(ctx, 100))
+	msg, err := stakingtypes.NewMsgCreateValidator(ValAddr.String(), ed25519.GenPrivKey().PubKey(), amt, stakingtypes.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	_, err = msgServer.ValidatorBond(ctx, stakingtypes.NewMsgValidatorBond(sdk.AccAddress(PKS[1].Address()).String(), ValAddr.String()))
+	require.ErrorIs(err, stakingtypes.ErrNoDelegation)
+
+	_, err = msgServer.ValidatorBond(ctx, stakingtypes.NewMsgValidatorBond(Addr.String(), ValAddr.String()))
+	require.NoError(err)
+
+	_, err = msgServer.ValidatorBond(ctx, stakingtypes.NewMsgValidatorBond(Addr.String(), ValAddr.String()))
+	require.ErrorIs(err, stakingtypes.ErrValidatorBondAlreadySet)
+
+	liquidValidator, err := keeper.GetLiquidValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(amt.Amount), liquidValidator.ValidatorBondShares)
+
+	// the validator bond cannot be undelegated below the validator bond factor
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+	params.ValidatorBondFactor = math.LegacyNewDec(2)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	liquidValidator.LiquidShares = math.LegacyNewDecFromInt(amt.Amount)
+	require.NoError(keeper.SetLiquidValidator(ctx, liquidValidator))
+
+	_, err = msgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(Addr.String(), ValAddr.String(), sdk.NewCoin(sdk.DefaultBondDenom, amt.Amount.QuoRaw(2).AddRaw(1))))
+	require.ErrorIs(err, stakingtypes.ErrInsufficientValidatorBondShares)
+
+	_, err = msgServer.Undelegate(ctx, stakingtypes.NewMsgUndelegate(Addr.String(), ValAddr.String(), sdk.NewCoin(sdk.DefaultBondDenom, amt.Amount.QuoRaw(2))))
+	require.NoError(err)
+
+	liquidValidator, err = keeper.GetLiquidValidator(ctx, ValAddr)
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(amt.Amount.QuoRaw(2)), liquidValidator.ValidatorBondShares)
+}
+
+func (s *KeeperTestSuite) TestMsgTokenizeSharesRejected() {
+	ctx, keeper, msgServer := s.ctx, s.stakingKeeper, s.msgServer
+	require := s.Require()
+	s.execExpectCalls()
+	s.accountKeeper.EXPECT().GetModuleAddress(stakingtypes.ModuleName).Return(authtypes.NewModuleAddress(stakingtypes.ModuleName)).AnyTimes()
+
+	delegator := sdk.AccAddress(PKS[1].Address())
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), delegator, stakingtypes.NotBondedPoolName, gomock.Any()).AnyTimes()
+
+	comm := stakingtypes.NewCommissionRates(math.LegacyNewDec(0), math.LegacyNewDec(0), math.LegacyNewDec(0))
+	amt := sdk.NewCoin(sdk.DefaultBondDenom, keeper.TokensFromConsensusPower(ctx, 100))
+	msg, err := stakingtypes.NewMsgCreateValidator(ValAddr.String(), ed25519.GenPrivKey().PubKey(), amt, stakingtypes.Description{Moniker: "NewVal"}, comm, math.OneInt())
+	require.NoError(err)
+	_, err = msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(delegator.String(), ValAddr.String(), amt))
+	require.NoError(err)
+
+	params, err := keeper.GetParams(ctx)
+	require.NoError(err)
+
+	testCases := []struct {
+		name     string
+		malleate func(params *stakingtypes.Params)
+		input    *stakingtypes.MsgTokenizeShares
+		expErr   error
+	}{
+		{
+			name:   "invalid denom",
+			input:  stakingtypes.NewMsgTokenizeShares(delegator.String(), ValAddr.String(), sdk.NewCoin("atom", amt.Amount), delegator.String()),
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:   "invalid owner",
+			input:  stakingtypes.NewMsgTokenizeShares(delegator.String(), ValAddr.String(), amt, "invalid"),
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name:   "self-delegation",
+			input:  stakingtypes.NewMsgTokenizeShares(Addr.String(), ValAddr.String(), amt, Addr.String()),
+			expErr: stakingtypes.ErrSelfDelegationNotTokenizable,
+		},
+		{
+			name:   "amount greater than the delegation",
+			input:  stakingtypes.NewMsgTokenizeShares(delegator.String(), ValAddr.String(), amt.Add(amt), delegator.String()),
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "validator bond factor",
+			malleate: func(params *stakingtypes.Params) {
+				params.ValidatorBondFactor = math.LegacyNewDec(250)
+			},
+			input:  stakingtypes.NewMsgTokenizeShares(delegator.String(), ValAddr.String(), amt, delegator.String()),
+			expErr: stakingtypes.ErrInsufficientValidatorBondShares,
+		},
+		{
+			name: "validator liquid staking cap",
+			malleate: func(params *stakingtypes.Params) {
+				params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(49, 2)
+			},
+			input:  stakingtypes.NewMsgTokenizeShares(delegator.String(), ValAddr.String(), amt, delegator.String()),
+			expErr: stakingtypes.ErrValidatorLiquidStakingCapExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			tcParams := params
+			if tc.malleate != nil {
+				tc.malleate(&tcParams)
+			}
+			require.NoError(keeper.SetParams(ctx, tcParams))
+
+			_, err := msgServer.TokenizeShares(ctx, tc.input)
+			require.ErrorIs(err, tc.expErr)
+		})
+	}
+
+	// a validator bond cannot be tokenized
+	require.NoError(keeper.SetParams(ctx, params))
+	_, err = msgServer.ValidatorBond(ctx, stakingtypes.NewMsgValidatorBond(delegator.String(), ValAddr.String()))
+	require.NoError(err)
+	_, err = msgServer.TokenizeShares(ctx, stakingtypes.NewMsgTokenizeShares(delegator.String(), ValAddr.String(), amt, delegator.String()))
+	require.ErrorIs(err, stakingtypes.ErrValidatorBondNotTokenizable)
+}
@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"slices"
 	"strconv"
 	"time"
@@ -338,6 +341,10 @@ func (k msgServer) BeginRedelegate(ctx context.Context, msg *types.MsgBeginRedel
 		return nil, err
 	}
 
+	if err := k.checkValidatorBondDecrease(ctx, delegatorAddress, valSrcAddr, shares); err != nil {
+		return nil, err
+	}
+
 	bondDenom, err := k.BondDenom(ctx)
 	if err != nil {
 		return nil, err
@@ -409,6 +416,10 @@ func (k msgServer) Undelegate(ctx context.Context, msg *types.MsgUndelegate) (*t
 		return nil, err
 	}
 
+	if err := k.checkValidatorBondDecrease(ctx, delegatorAddress, addr, shares); err != nil {
+		return nil, err
+	}
+
 	bondDenom, err := k.BondDenom(ctx)
 	if err != nil {
 		return nil, err
@@ -600,3 +611,346 @@ func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// TokenizeShares defines a method for converting a delegation into share tokens of the validator
+func (k msgServer) TokenizeShares(ctx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.TokenizedShareOwner); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid tokenized share owner address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.Denom != bondDenom {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	if k.authKeeper.GetModuleAddress(types.ModuleName) == nil {
+		return nil, fmt.Errorf("%s module account has not been set", types.ModuleName)
+	}
+
+	// the self-delegation of the operator backs the minimum self-delegation of the validator
+	if bytes.Equal(delegatorAddress, valAddr) {
+		return nil, types.ErrSelfDelegationNotTokenizable
+	}
+
+	isValidatorBond, err := k.IsValidatorBond(ctx, delegatorAddress, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	if isValidatorBond {
+		return nil, types.ErrValidatorBondNotTokenizable
+	}
+
+	// the redelegated tokens must stay slashable for the infractions of the source validator
+	hasRedelegation, err := k.HasReceivingRedelegation(ctx, delegatorAddress, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	if hasRedelegation {
+		return nil, types.ErrRedelegationInProgress
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delegatorAddress, valAddr, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	liquidValidator, err := k.GetLiquidValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, validator, liquidValidator, shares, validator.TokensFromShares(shares).TruncateInt()); err != nil {
+		return nil, err
+	}
+
+	recordID, err := k.GetLastTokenizeShareRecordID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	recordID++
+	if err := k.SetLastTokenizeShareRecordID(ctx, recordID); err != nil {
+		return nil, err
+	}
+
+	record := types.NewTokenizeShareRecord(recordID, msg.TokenizedShareOwner, msg.ValidatorAddress)
+	if err := k.SetTokenizeShareRecord(ctx, record); err != nil {
+		return nil, err
+	}
+
+	// move the delegation to the module account of the record, the tokens
+	// stay in the pool of the validator
+	tokens, err := k.Unbond(ctx, delegatorAddress, valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	recordShares, err := k.Keeper.Delegate(ctx, record.GetModuleAddress(), tokens, validator.GetStatus(), validator, false)
+	if err != nil {
+		return nil, err
+	}
+
+	shareTokens := sdk.NewCoin(record.GetShareTokenDenom(), recordShares.TruncateInt())
+	if !shareTokens.IsPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "too few shares to tokenize")
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareTokens)); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegatorAddress, sdk.NewCoins(shareTokens)); err != nil {
+		return nil, err
+	}
+
+	liquidValidator.LiquidShares = liquidValidator.LiquidShares.Add(recordShares)
+	if err := k.SetLiquidValidator(ctx, liquidValidator); err != nil {
+		return nil, err
+	}
+
+	totalLiquidStakedTokens, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SetTotalLiquidStakedTokens(ctx, totalLiquidStakedTokens.Add(tokens)); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTokenizeShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.TokenizedShareOwner),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(recordID, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgTokenizeSharesResponse{Amount: shareTokens}, nil
+}
+
+// RedeemTokensForShares defines a method for converting share tokens of a validator back into a delegation
+func (k msgServer) RedeemTokensForShares(ctx context.Context, msg *types.MsgRedeemTokensForShares) (*types.MsgRedeemTokensForSharesResponse, error) {
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid shares amount",
+		)
+	}
+
+	recordID, err := types.ParseShareTokenDenom(msg.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := k.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.GetShareTokenDenom() != msg.Amount.Denom {
+		return nil, types.ErrNotTokenizeShareDenom
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	recordAddress := record.GetModuleAddress()
+	delegation, err := k.GetDelegation(ctx, recordAddress, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// the share tokens are redeemed for their part of the delegation of the record
+	supply := k.bankKeeper.GetSupply(ctx, msg.Amount.Denom).Amount
+	if msg.Amount.Amount.GT(supply) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is greater than the supply %s", msg.Amount, supply)
+	}
+
+	shares := delegation.Shares
+	if msg.Amount.Amount.LT(supply) {
+		shares = shares.MulInt(msg.Amount.Amount).QuoInt(supply)
+	}
+
+	shareTokens := sdk.NewCoins(msg.Amount)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegatorAddress, types.ModuleName, shareTokens); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, shareTokens); err != nil {
+		return nil, err
+	}
+
+	tokens, err := k.Unbond(ctx, recordAddress, valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	if !tokens.IsPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "too few share tokens to redeem")
+	}
+
+	// the rewards withdrawn to the module account of the record belong to its owner
+	if rewards := k.bankKeeper.GetAllBalances(ctx, recordAddress); !rewards.IsZero() {
+		owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, recordAddress, owner, rewards); err != nil {
+			return nil, err
+		}
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	switch {
+	case errors.Is(err, types.ErrNoValidatorFound):
+		// the unbonded validator was removed with its last delegation
+		coins := sdk.NewCoins(sdk.NewCoin(bondDenom, tokens))
+		if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delegatorAddress, coins); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	default:
+		if _, err := k.Keeper.Delegate(ctx, delegatorAddress, tokens, validator.GetStatus(), validator, false); err != nil {
+			return nil, err
+		}
+	}
+
+	liquidValidator, err := k.GetLiquidValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	liquidValidator.LiquidShares = math.LegacyMaxDec(liquidValidator.LiquidShares.Sub(shares), math.LegacyZeroDec())
+	if err := k.SetLiquidValidator(ctx, liquidValidator); err != nil {
+		return nil, err
+	}
+
+	totalLiquidStakedTokens, err := k.GetTotalLiquidStakedTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.SetTotalLiquidStakedTokens(ctx, math.MaxInt(totalLiquidStakedTokens.Sub(tokens), math.ZeroInt())); err != nil {
+		return nil, err
+	}
+
+	if shares.Equal(delegation.Shares) {
+		if err := k.DeleteTokenizeShareRecord(ctx, recordID); err != nil {
+			return nil, err
+		}
+	}
+
+	amount := sdk.NewCoin(bondDenom, tokens)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRedeemShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, record.Validator),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(recordID, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return &types.MsgRedeemTokensForSharesResponse{Amount: amount}, nil
+}
+
+// ValidatorBond defines a method for designating a delegation as a validator bond
+func (k msgServer) ValidatorBond(ctx context.Context, msg *types.MsgValidatorBond) (*types.MsgValidatorBondResponse, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if _, err := k.GetValidator(ctx, valAddr); err != nil {
+		return nil, err
+	}
+
+	delegation, err := k.GetDelegation(ctx, delegatorAddress, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	isValidatorBond, err := k.IsValidatorBond(ctx, delegatorAddress, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	if isValidatorBond {
+		return nil, types.ErrValidatorBondAlreadySet
+	}
+
+	if err := k.SetValidatorBond(ctx, delegatorAddress, valAddr); err != nil {
+		return nil, err
+	}
+
+	if err := k.updateValidatorBondShares(ctx, delegatorAddress, valAddr, delegation.Shares); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorBond,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		),
+	)
+
+	return &types.MsgValidatorBondResponse{}, nil
+}
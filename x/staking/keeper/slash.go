@@ -172,6 +172,12 @@ func (k Keeper) Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionH
 		}
 	}
 
+	// The slashed tokens are taken from the liquid shares of the validator
+	// through the exchange rate, like from any other share.
+	if err := k.slashLiquidStakedTokens(ctx, validator, operatorAddress, tokensToBurn); err != nil {
+		return math.NewInt(0), err
+	}
+
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
 	validator, err = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
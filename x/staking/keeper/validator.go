@@ -246,6 +246,10 @@ func (k Keeper) RemoveValidator(ctx context.Context, address sdk.ValAddress) err
 		return err
 	}
 
+	if err = store.Delete(types.GetLiquidValidatorKey(address)); err != nil {
+		return err
+	}
+
 	str, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
 	if err != nil {
 		return err
@@ -32,6 +32,18 @@ func migrateParams(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCo
 	var legacyParams types.Params
 	legacySubspace.GetParamSet(ctx, &legacyParams)
 
+	// the liquid staking params are not part of the legacy param set, they are
+	// set by the v6 migration
+	if legacyParams.ValidatorBondFactor.IsNil() {
+		legacyParams.ValidatorBondFactor = types.DefaultValidatorBondFactor
+	}
+	if legacyParams.GlobalLiquidStakingCap.IsNil() {
+		legacyParams.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	}
+	if legacyParams.ValidatorLiquidStakingCap.IsNil() {
+		legacyParams.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+	}
+
 	if err := legacyParams.Validate(); err != nil {
 		return err
 	}
@@ -0,0 +1,51 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v6 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v6"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+
+	storeKey := storetypes.NewKVStoreKey(v6.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// params of v5, without the liquid staking params
+	oldParams := &stakingv1beta1.Params{
+		UnbondingTime:     durationpb.New(types.DefaultUnbondingTime),
+		MaxValidators:     10,
+		MaxEntries:        types.DefaultMaxEntries,
+		HistoricalEntries: types.DefaultHistoricalEntries,
+		BondDenom:         "stake",
+		MinCommissionRate: math.LegacyNewDecWithPrec(5, 2).BigInt().String(),
+	}
+	bz, err := proto.Marshal(oldParams)
+	require.NoError(t, err)
+	store.Set(v6.ParamsKey, bz)
+
+	require.NoError(t, v6.MigrateStore(ctx, store, cdc))
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(v6.ParamsKey), &params)
+	require.NoError(t, params.Validate())
+
+	expParams := types.NewParams(types.DefaultUnbondingTime, 10, types.DefaultMaxEntries,
+		types.DefaultHistoricalEntries, "stake", math.LegacyNewDecWithPrec(5, 2))
+	require.True(t, expParams.Equal(params), params)
+}
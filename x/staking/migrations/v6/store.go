@@ -0,0 +1,45 @@
+package v6
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6.
+func MigrateStore(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	return migrateParams(store, cdc)
+}
+
+// migrateParams sets the liquid staking params to their default values, which
+// disable the validator bond factor and the liquid staking caps.
+func migrateParams(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	if bz := store.Get(ParamsKey); bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	if params.ValidatorBondFactor.IsNil() {
+		params.ValidatorBondFactor = types.DefaultValidatorBondFactor
+	}
+
+	if params.GlobalLiquidStakingCap.IsNil() {
+		params.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	}
+
+	if params.ValidatorLiquidStakingCap.IsNil() {
+		params.ValidatorLiquidStakingCap = types.DefaultValidatorLiquidStakingCap
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, bz)
+	return nil
+}
@@ -60,6 +60,12 @@ var (
 
 	// NOTE: keys in range 0x81–0x87 were previously used in liquid staking forks of the staking module.
 	// Module developers MUST NOT use these keys and MUST consider them "reserved".
+
+	TokenizeShareRecordKey       = []byte{0x91} // prefix for each key to a tokenize share record, by id
+	LastTokenizeShareRecordIDKey = []byte{0x92} // key for the id of the last tokenize share record
+	TotalLiquidStakedTokensKey   = []byte{0x93} // key for the total liquid staked tokens
+	LiquidValidatorKey           = []byte{0x94} // prefix for each key to the liquid staking accounting of a validator
+	ValidatorBondKey             = []byte{0x95} // prefix for each key to a delegation designated as validator bond
 )
 
 // UnbondingType defines the type of unbonding operation
@@ -426,3 +432,39 @@ func GetHistoricalInfoKey(height int64) []byte {
 	binary.BigEndian.PutUint64(heightBytes, uint64(height))
 	return append(HistoricalInfoKey, heightBytes...)
 }
+
+// GetTokenizeShareRecordKey returns the key of a tokenize share record.
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordKey(id uint64) []byte {
+	return append(TokenizeShareRecordKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetLiquidValidatorKey returns the key of the liquid staking accounting of a validator.
+// VALUE: staking/LiquidValidator
+func GetLiquidValidatorKey(valAddr sdk.ValAddress) []byte {
+	return append(LiquidValidatorKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetValidatorBondKey returns the key of a delegation designated as validator bond.
+// VALUE: none
+func GetValidatorBondKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(append(ValidatorBondKey, address.MustLengthPrefix(delAddr)...), address.MustLengthPrefix(valAddr)...)
+}
+
+// ParseValidatorBondKey returns the delegator and validator addresses of a validator bond key.
+func ParseValidatorBondKey(key []byte) (sdk.AccAddress, sdk.ValAddress, error) {
+	if len(key) < 2 || !bytes.HasPrefix(key, ValidatorBondKey) {
+		return nil, nil, fmt.Errorf("invalid validator bond key %X", key)
+	}
+	delAddrLen := int(key[1])
+	if len(key) < delAddrLen+3 {
+		return nil, nil, fmt.Errorf("invalid validator bond key %X", key)
+	}
+	delAddr := key[2 : 2+delAddrLen]
+	valAddrLen := int(key[2+delAddrLen])
+	if len(key) != delAddrLen+valAddrLen+3 {
+		return nil, nil, fmt.Errorf("invalid validator bond key %X", key)
+	}
+
+	return delAddr, key[3+delAddrLen:], nil
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// TokenizeShareModuleAccountPrefix is the prefix of the names of the module
+// accounts holding the delegations of the tokenize share records.
+const TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance.
+func NewTokenizeShareRecord(id uint64, owner, validator string) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner,
+		ModuleAccount: fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, id),
+		Validator:     validator,
+	}
+}
+
+// GetModuleAddress returns the address of the module account holding the
+// delegation of the record.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return address.Module(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the bank denom of the shares of the record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", r.Validator, r.Id)
+}
+
+// ParseShareTokenDenom returns the id of the tokenize share record of a share
+// token denom.
+func ParseShareTokenDenom(denom string) (uint64, error) {
+	i := strings.LastIndexByte(denom, '/')
+	if i < 0 {
+		return 0, ErrNotTokenizeShareDenom
+	}
+
+	id, err := strconv.ParseUint(denom[i+1:], 10, 64)
+	if err != nil {
+		return 0, ErrNotTokenizeShareDenom
+	}
+
+	return id, nil
+}
+
+// NewLiquidValidator creates a new LiquidValidator instance with no liquid
+// shares and no validator bond shares.
+func NewLiquidValidator(operator string) LiquidValidator {
+	return LiquidValidator{
+		OperatorAddress:     operator,
+		LiquidShares:        math.LegacyZeroDec(),
+		ValidatorBondShares: math.LegacyZeroDec(),
+	}
+}
@@ -0,0 +1,26 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestTokenizeShareRecord(t *testing.T) {
+	record := types.NewTokenizeShareRecord(12, "cosmos1owner", "cosmosvaloper1validator")
+	require.Equal(t, "tokenizeshare_12", record.ModuleAccount)
+	require.Equal(t, "cosmosvaloper1validator/12", record.GetShareTokenDenom())
+	require.NotEqual(t, types.NewTokenizeShareRecord(13, "cosmos1owner", "cosmosvaloper1validator").GetModuleAddress(), record.GetModuleAddress())
+
+	id, err := types.ParseShareTokenDenom(record.GetShareTokenDenom())
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), id)
+
+	_, err = types.ParseShareTokenDenom("stake")
+	require.ErrorIs(t, err, types.ErrNotTokenizeShareDenom)
+
+	_, err = types.ParseShareTokenDenom("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2")
+	require.ErrorIs(t, err, types.ErrNotTokenizeShareDenom)
+}
@@ -31,18 +31,32 @@ const (
 	DefaultHistoricalEntries uint32 = 10000
 )
 
-// DefaultMinCommissionRate is set to 0%
-var DefaultMinCommissionRate = math.LegacyZeroDec()
+var (
+	// DefaultMinCommissionRate is set to 0%
+	DefaultMinCommissionRate = math.LegacyZeroDec()
 
-// NewParams creates a new Params instance
+	// DefaultValidatorBondFactor is set to -1, which disables the validator bond check
+	DefaultValidatorBondFactor = math.LegacyNewDec(-1)
+
+	// DefaultGlobalLiquidStakingCap is set to 100%, which disables the global cap
+	DefaultGlobalLiquidStakingCap = math.LegacyOneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%, which disables the validator cap
+	DefaultValidatorLiquidStakingCap = math.LegacyOneDec()
+)
+
+// NewParams creates a new Params instance, with the default liquid staking parameters.
 func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate math.LegacyDec) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
+		UnbondingTime:             unbondingTime,
+		MaxValidators:             maxValidators,
+		MaxEntries:                maxEntries,
+		HistoricalEntries:         historicalEntries,
+		BondDenom:                 bondDenom,
+		MinCommissionRate:         minCommissionRate,
+		ValidatorBondFactor:       DefaultValidatorBondFactor,
+		GlobalLiquidStakingCap:    DefaultGlobalLiquidStakingCap,
+		ValidatorLiquidStakingCap: DefaultValidatorLiquidStakingCap,
 	}
 }
 
@@ -104,6 +118,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateValidatorBondFactor(p.ValidatorBondFactor); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -203,3 +229,38 @@ func validateMinCommissionRate(i any) error {
 
 	return nil
 }
+
+func validateValidatorBondFactor(i any) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("validator bond factor cannot be nil: %s", v)
+	}
+	if v.IsNegative() && !v.Equal(DefaultValidatorBondFactor) {
+		return fmt.Errorf("validator bond factor must be -1 or non-negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateLiquidStakingCap(i any) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquid staking cap cannot be nil: %s", v)
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
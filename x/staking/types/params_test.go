@@ -37,3 +37,29 @@ func TestValidateParams(t *testing.T) {
 	params.MinCommissionRate = math.LegacyNewDec(2)
 	require.Error(t, params.Validate())
 }
+
+func TestValidateLiquidStakingParams(t *testing.T) {
+	params := types.DefaultParams()
+	require.True(t, params.ValidatorBondFactor.Equal(math.LegacyNewDec(-1)))
+
+	params.ValidatorBondFactor = math.LegacyNewDec(250)
+	require.NoError(t, params.Validate())
+
+	params.ValidatorBondFactor = math.LegacyNewDec(-2)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(25, 2)
+	params.ValidatorLiquidStakingCap = math.LegacyZeroDec()
+	require.NoError(t, params.Validate())
+
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(101, 2)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.ValidatorLiquidStakingCap = math.LegacyNewDec(-1)
+	require.Error(t, params.Validate())
+
+	params.ValidatorLiquidStakingCap = math.LegacyDec{}
+	require.Error(t, params.Validate())
+}
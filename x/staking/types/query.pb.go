@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
@@ -1391,6 +1392,266 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryTokenizeShareRecordRequest is request type for the Query/TokenizeShareRecord RPC method.
+type QueryTokenizeShareRecordRequest struct {
+	// id defines the id of the record to query for.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryTokenizeShareRecordRequest) Reset()         { *m = QueryTokenizeShareRecordRequest{} }
+func (m *QueryTokenizeShareRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenizeShareRecordRequest) ProtoMessage()    {}
+func (*QueryTokenizeShareRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{28}
+}
+func (m *QueryTokenizeShareRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenizeShareRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenizeShareRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenizeShareRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenizeShareRecordRequest.Merge(m, src)
+}
+func (m *QueryTokenizeShareRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenizeShareRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenizeShareRecordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenizeShareRecordRequest proto.InternalMessageInfo
+
+func (m *QueryTokenizeShareRecordRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryTokenizeShareRecordResponse is response type for the Query/TokenizeShareRecord RPC method.
+type QueryTokenizeShareRecordResponse struct {
+	// record defines the tokenize share record.
+	Record TokenizeShareRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+}
+
+func (m *QueryTokenizeShareRecordResponse) Reset()         { *m = QueryTokenizeShareRecordResponse{} }
+func (m *QueryTokenizeShareRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenizeShareRecordResponse) ProtoMessage()    {}
+func (*QueryTokenizeShareRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{29}
+}
+func (m *QueryTokenizeShareRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenizeShareRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenizeShareRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenizeShareRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenizeShareRecordResponse.Merge(m, src)
+}
+func (m *QueryTokenizeShareRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenizeShareRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenizeShareRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenizeShareRecordResponse proto.InternalMessageInfo
+
+func (m *QueryTokenizeShareRecordResponse) GetRecord() TokenizeShareRecord {
+	if m != nil {
+		return m.Record
+	}
+	return TokenizeShareRecord{}
+}
+
+// QueryLiquidValidatorRequest is request type for the Query/LiquidValidator RPC method.
+type QueryLiquidValidatorRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryLiquidValidatorRequest) Reset()         { *m = QueryLiquidValidatorRequest{} }
+func (m *QueryLiquidValidatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidValidatorRequest) ProtoMessage()    {}
+func (*QueryLiquidValidatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{30}
+}
+func (m *QueryLiquidValidatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidValidatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidValidatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidValidatorRequest.Merge(m, src)
+}
+func (m *QueryLiquidValidatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidValidatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidValidatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidValidatorRequest proto.InternalMessageInfo
+
+func (m *QueryLiquidValidatorRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+// QueryLiquidValidatorResponse is response type for the Query/LiquidValidator RPC method.
+type QueryLiquidValidatorResponse struct {
+	// liquid_validator defines the liquid staking accounting of the validator.
+	LiquidValidator LiquidValidator `protobuf:"bytes,1,opt,name=liquid_validator,json=liquidValidator,proto3" json:"liquid_validator"`
+}
+
+func (m *QueryLiquidValidatorResponse) Reset()         { *m = QueryLiquidValidatorResponse{} }
+func (m *QueryLiquidValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidValidatorResponse) ProtoMessage()    {}
+func (*QueryLiquidValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{31}
+}
+func (m *QueryLiquidValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidValidatorResponse.Merge(m, src)
+}
+func (m *QueryLiquidValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidValidatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidValidatorResponse proto.InternalMessageInfo
+
+func (m *QueryLiquidValidatorResponse) GetLiquidValidator() LiquidValidator {
+	if m != nil {
+		return m.LiquidValidator
+	}
+	return LiquidValidator{}
+}
+
+// QueryTotalLiquidStakedRequest is request type for the Query/TotalLiquidStaked RPC method.
+type QueryTotalLiquidStakedRequest struct {
+}
+
+func (m *QueryTotalLiquidStakedRequest) Reset()         { *m = QueryTotalLiquidStakedRequest{} }
+func (m *QueryTotalLiquidStakedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidStakedRequest) ProtoMessage()    {}
+func (*QueryTotalLiquidStakedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{32}
+}
+func (m *QueryTotalLiquidStakedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalLiquidStakedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidStakedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalLiquidStakedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidStakedRequest.Merge(m, src)
+}
+func (m *QueryTotalLiquidStakedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalLiquidStakedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidStakedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalLiquidStakedRequest proto.InternalMessageInfo
+
+// QueryTotalLiquidStakedResponse is response type for the Query/TotalLiquidStaked RPC method.
+type QueryTotalLiquidStakedResponse struct {
+	// tokens defines the total liquid staked tokens.
+	Tokens cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=tokens,proto3,customtype=cosmossdk.io/math.Int" json:"tokens"`
+}
+
+func (m *QueryTotalLiquidStakedResponse) Reset()         { *m = QueryTotalLiquidStakedResponse{} }
+func (m *QueryTotalLiquidStakedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidStakedResponse) ProtoMessage()    {}
+func (*QueryTotalLiquidStakedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f270127f442bbcd8, []int{33}
+}
+func (m *QueryTotalLiquidStakedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalLiquidStakedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidStakedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalLiquidStakedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidStakedResponse.Merge(m, src)
+}
+func (m *QueryTotalLiquidStakedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalLiquidStakedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidStakedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalLiquidStakedResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryValidatorsRequest)(nil), "cosmos.staking.v1beta1.QueryValidatorsRequest")
 	proto.RegisterType((*QueryValidatorsResponse)(nil), "cosmos.staking.v1beta1.QueryValidatorsResponse")
@@ -1420,6 +1681,12 @@ func init() {
 	proto.RegisterType((*QueryPoolResponse)(nil), "cosmos.staking.v1beta1.QueryPoolResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.staking.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.staking.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryTokenizeShareRecordRequest)(nil), "cosmos.staking.v1beta1.QueryTokenizeShareRecordRequest")
+	proto.RegisterType((*QueryTokenizeShareRecordResponse)(nil), "cosmos.staking.v1beta1.QueryTokenizeShareRecordResponse")
+	proto.RegisterType((*QueryLiquidValidatorRequest)(nil), "cosmos.staking.v1beta1.QueryLiquidValidatorRequest")
+	proto.RegisterType((*QueryLiquidValidatorResponse)(nil), "cosmos.staking.v1beta1.QueryLiquidValidatorResponse")
+	proto.RegisterType((*QueryTotalLiquidStakedRequest)(nil), "cosmos.staking.v1beta1.QueryTotalLiquidStakedRequest")
+	proto.RegisterType((*QueryTotalLiquidStakedResponse)(nil), "cosmos.staking.v1beta1.QueryTotalLiquidStakedResponse")
 }
 
 func init() {
@@ -1427,94 +1694,112 @@ func init() {
 }
 
 var fileDescriptor_f270127f442bbcd8 = []byte{
-	// 1389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xdb, 0x6b, 0x1c, 0x65,
-	0x1b, 0xdf, 0x37, 0xcd, 0x17, 0xbe, 0x3c, 0xa5, 0xa5, 0x7d, 0x77, 0x1b, 0xd3, 0x69, 0xba, 0xd9,
-	0x0c, 0xa5, 0xe6, 0xd0, 0xec, 0x98, 0xa4, 0xb6, 0xb1, 0xa2, 0xed, 0xc6, 0x52, 0x5b, 0x5b, 0xda,
-	0x74, 0xc5, 0xe0, 0x91, 0x30, 0xc9, 0x4c, 0x67, 0x87, 0x6e, 0x66, 0xb6, 0xf3, 0x4e, 0x42, 0x4b,
-	0x08, 0x82, 0x17, 0xd2, 0x2b, 0x11, 0xbc, 0x97, 0x5e, 0x8a, 0x28, 0xe4, 0x22, 0x15, 0xbd, 0xd0,
-	0x4b, 0xe9, 0x85, 0x48, 0xa8, 0x54, 0xf4, 0xa6, 0x4a, 0x22, 0xe8, 0x8d, 0xff, 0x81, 0x88, 0xec,
-	0xcc, 0x33, 0xa7, 0xcc, 0x61, 0x67, 0x37, 0x1b, 0x48, 0x6e, 0xda, 0xdd, 0x77, 0x9e, 0xc3, 0xef,
-	0xf7, 0x1c, 0xde, 0x79, 0x9e, 0x0d, 0xf0, 0xf3, 0x3a, 0x5b, 0xd0, 0x99, 0xc0, 0x4c, 0xf1, 0xb6,
-	0xaa, 0x29, 0xc2, 0xd2, 0xd8, 0x9c, 0x6c, 0x8a, 0x63, 0xc2, 0x9d, 0x45, 0xd9, 0xb8, 0x57, 0xac,
-	0x19, 0xba, 0xa9, 0xd3, 0x1e, 0x5b, 0xa6, 0x88, 0x32, 0x45, 0x94, 0xe1, 0x86, 0x51, 0x77, 0x4e,
-	0x64, 0xb2, 0xad, 0xe0, 0xaa, 0xd7, 0x44, 0x45, 0xd5, 0x44, 0x53, 0xd5, 0x35, 0xdb, 0x06, 0x97,
-	0x53, 0x74, 0x45, 0xb7, 0x3e, 0x0a, 0xf5, 0x4f, 0x78, 0xda, 0xa7, 0xe8, 0xba, 0x52, 0x95, 0x05,
-	0xb1, 0xa6, 0x0a, 0xa2, 0xa6, 0xe9, 0xa6, 0xa5, 0xc2, 0xf0, 0xe9, 0x89, 0x18, 0x6c, 0x0e, 0x0e,
-	0x5b, 0xea, 0xa8, 0x2d, 0x35, 0x6b, 0x1b, 0x47, 0xa8, 0xf6, 0xa3, 0x63, 0x68, 0xc0, 0xc1, 0xe6,
-	0x67, 0xc5, 0x1d, 0x16, 0x17, 0x54, 0x4d, 0x17, 0xac, 0x7f, 0xed, 0x23, 0xfe, 0x2e, 0xf4, 0xdc,
-	0xac, 0x4b, 0xcc, 0x88, 0x55, 0x55, 0x12, 0x4d, 0xdd, 0x60, 0x65, 0xf9, 0xce, 0xa2, 0xcc, 0x4c,
-	0xda, 0x03, 0x5d, 0xcc, 0x14, 0xcd, 0x45, 0xd6, 0x4b, 0x0a, 0x64, 0xb0, 0xbb, 0x8c, 0xdf, 0xe8,
-	0x25, 0x00, 0x8f, 0x6a, 0x6f, 0x47, 0x81, 0x0c, 0xee, 0x1f, 0x3f, 0x59, 0x44, 0x10, 0xf5, 0xb8,
-	0x14, 0x6d, 0x97, 0x08, 0xbd, 0x38, 0x2d, 0x2a, 0x32, 0xda, 0x2c, 0xfb, 0x34, 0xf9, 0x55, 0x02,
-	0xcf, 0x84, 0x5c, 0xb3, 0x9a, 0xae, 0x31, 0x99, 0x5e, 0x03, 0x58, 0x72, 0x4f, 0x7b, 0x49, 0x61,
-	0xdf, 0xe0, 0xfe, 0xf1, 0x81, 0x62, 0x74, 0x4e, 0x8a, 0xae, 0xfe, 0x54, 0xf7, 0xa3, 0xa7, 0xfd,
-	0x99, 0xcf, 0xfe, 0x5c, 0x1d, 0x26, 0x65, 0x9f, 0x3e, 0x7d, 0x35, 0x02, 0xf1, 0xb3, 0x0d, 0x11,
-	0xdb, 0x50, 0x02, 0x90, 0x45, 0x38, 0x12, 0x44, 0xec, 0xc4, 0xea, 0x32, 0x1c, 0x74, 0xfd, 0xcd,
-	0x8a, 0x92, 0x64, 0xd8, 0x31, 0x9b, 0x1a, 0x78, 0xbc, 0x36, 0x7a, 0x1c, 0x1d, 0xb9, 0x4a, 0x25,
-	0x49, 0x32, 0x64, 0xc6, 0x5e, 0x37, 0x0d, 0x55, 0x53, 0xca, 0x07, 0x96, 0xfc, 0xe7, 0xbc, 0xb4,
-	0x35, 0x1f, 0x6e, 0x4c, 0x5e, 0x83, 0x6e, 0x57, 0xd4, 0x32, 0xdf, 0x6c, 0x48, 0x3c, 0x75, 0x7e,
-	0x8d, 0x40, 0x21, 0xe8, 0xe6, 0xa2, 0x5c, 0x95, 0x15, 0xbb, 0x14, 0xdb, 0x4e, 0xaa, 0x6d, 0x25,
-	0xf3, 0x37, 0x81, 0x81, 0x04, 0xd8, 0x18, 0xa8, 0xf7, 0x21, 0x27, 0xb9, 0xc7, 0xb3, 0x06, 0x1e,
-	0x3b, 0x65, 0x34, 0x1c, 0x17, 0x33, 0xcf, 0x94, 0x63, 0x69, 0xaa, 0x50, 0x0f, 0xde, 0xe7, 0xbf,
-	0xf5, 0x67, 0xc3, 0xcf, 0x98, 0x1d, 0xd3, 0xac, 0x14, 0x7e, 0xd2, 0xbe, 0x7a, 0xfb, 0x96, 0xc0,
-	0x50, 0x90, 0xef, 0x1b, 0xda, 0x9c, 0xae, 0x49, 0xaa, 0xa6, 0xec, 0x89, 0x7c, 0x3d, 0x25, 0x30,
-	0x9c, 0x06, 0x3f, 0x26, 0x4e, 0x81, 0xec, 0xa2, 0xf3, 0x3c, 0x94, 0xb7, 0x91, 0xb8, 0xbc, 0x45,
-	0x98, 0xf4, 0x57, 0x3d, 0x75, 0x4d, 0xee, 0x40, 0x82, 0xbe, 0x24, 0xd8, 0xae, 0xfe, 0x02, 0xb1,
-	0xb3, 0x71, 0x1e, 0x0e, 0x62, 0x6d, 0x04, 0xb3, 0xd1, 0xfb, 0x78, 0x6d, 0x34, 0x87, 0xae, 0xb6,
-	0x24, 0xc1, 0x95, 0xb7, 0x92, 0x10, 0x4e, 0x67, 0x47, 0x6b, 0xe9, 0x3c, 0xf7, 0xff, 0xfb, 0x0f,
-	0xfa, 0x33, 0x7f, 0x3d, 0xe8, 0xcf, 0xf0, 0x4b, 0x78, 0xe5, 0x86, 0xeb, 0x99, 0xbe, 0x03, 0xd9,
-	0x88, 0xae, 0xc1, 0x8b, 0xa6, 0x89, 0xa6, 0x29, 0xd3, 0x70, 0x4b, 0xf0, 0x5f, 0x11, 0xe8, 0xb7,
-	0x1c, 0x47, 0x24, 0x6b, 0x57, 0x07, 0xcc, 0xc0, 0x7b, 0x32, 0x12, 0x37, 0x46, 0xee, 0x3a, 0x74,
-	0xd9, 0x35, 0x86, 0xc1, 0x6a, 0xb5, 0x52, 0xd1, 0x0a, 0xff, 0xd0, 0xb9, 0x9c, 0x2f, 0x3a, 0xf4,
-	0x22, 0x9a, 0x7d, 0xdb, 0xd1, 0x6a, 0x53, 0x8f, 0xfb, 0x62, 0xf5, 0xb3, 0x73, 0x3b, 0x47, 0xe3,
-	0xc6, 0x68, 0x55, 0xda, 0x76, 0x3b, 0xfb, 0x42, 0xb7, 0xb3, 0xd7, 0xf0, 0x77, 0xce, 0x35, 0xec,
-	0x12, 0x4b, 0xba, 0x86, 0x77, 0x61, 0x66, 0xdc, 0x7b, 0xb8, 0x01, 0x81, 0x3d, 0x7b, 0x0f, 0xaf,
-	0x77, 0xc0, 0x51, 0x8b, 0x60, 0x59, 0x96, 0x76, 0x20, 0x23, 0x37, 0x80, 0x32, 0x63, 0x7e, 0xb6,
-	0xd5, 0xdb, 0xe5, 0x10, 0x33, 0xe6, 0x03, 0x8f, 0xea, 0x06, 0x25, 0x66, 0x6e, 0x35, 0xb8, 0x2f,
-	0xb5, 0x41, 0x89, 0x99, 0x33, 0x09, 0x6f, 0xec, 0xce, 0x36, 0xd4, 0xcc, 0x13, 0x02, 0x5c, 0x54,
-	0x48, 0xb1, 0x46, 0x34, 0xe8, 0x31, 0xe4, 0x84, 0x46, 0x3e, 0x15, 0x57, 0x26, 0x7e, 0x73, 0x51,
-	0xad, 0x7c, 0xc4, 0x90, 0x77, 0xb4, 0x99, 0xd7, 0x9c, 0x57, 0x91, 0xdb, 0x0b, 0xe1, 0xd5, 0x67,
-	0x17, 0xb6, 0xf0, 0x37, 0xa1, 0x97, 0xc2, 0xde, 0x59, 0x9b, 0x1e, 0x12, 0xc8, 0xc7, 0x60, 0xdf,
-	0xd5, 0x2f, 0xff, 0x85, 0xd8, 0x4a, 0xd9, 0x91, 0xa5, 0xec, 0x34, 0x36, 0xdc, 0x65, 0x95, 0x99,
-	0xba, 0xa1, 0xce, 0x8b, 0xd5, 0x2b, 0xda, 0x2d, 0xdd, 0xb7, 0x8e, 0x57, 0x64, 0x55, 0xa9, 0x98,
-	0x96, 0x9b, 0x7d, 0x65, 0xfc, 0xc6, 0xbf, 0x05, 0xc7, 0x22, 0xb5, 0x10, 0xe0, 0x39, 0xe8, 0xac,
-	0xa8, 0xcc, 0x44, 0x6c, 0x27, 0xe3, 0xb0, 0x6d, 0xd1, 0xb6, 0x74, 0x78, 0x0a, 0x87, 0x2c, 0xd3,
-	0xd3, 0xba, 0x5e, 0x45, 0x18, 0xfc, 0x34, 0x1c, 0xf6, 0x9d, 0xa1, 0x93, 0x17, 0xa1, 0xb3, 0xa6,
-	0xeb, 0x55, 0x74, 0xd2, 0x17, 0xe7, 0xa4, 0xae, 0xe3, 0xe7, 0x6e, 0x29, 0xf1, 0x39, 0xa0, 0xb6,
-	0x45, 0xd1, 0x10, 0x17, 0x9c, 0x16, 0xe4, 0xdf, 0x84, 0x6c, 0xe0, 0x14, 0x3d, 0x95, 0xa0, 0xab,
-	0x66, 0x9d, 0xa0, 0xaf, 0x7c, 0xac, 0x2f, 0x4b, 0x2a, 0x30, 0x5e, 0xd9, 0x8a, 0xe3, 0xab, 0x3d,
-	0xf0, 0x3f, 0xcb, 0x34, 0xfd, 0x94, 0x00, 0x78, 0x5d, 0x44, 0x8b, 0x71, 0xb6, 0xa2, 0x7f, 0x20,
-	0xe1, 0x84, 0xd4, 0xf2, 0x38, 0x05, 0x0b, 0xf7, 0xeb, 0x40, 0x3e, 0xf8, 0xe9, 0x8f, 0x4f, 0x3a,
-	0x4e, 0x50, 0x5e, 0x88, 0xf9, 0xa9, 0xc7, 0xd7, 0x81, 0x5f, 0x10, 0xe8, 0x76, 0xed, 0xd0, 0xd1,
-	0x74, 0xfe, 0x1c, 0x78, 0xc5, 0xb4, 0xe2, 0x88, 0xee, 0x82, 0x87, 0xee, 0x79, 0x3a, 0xd1, 0x18,
-	0x9d, 0xb0, 0x1c, 0x6c, 0xb8, 0x15, 0xfa, 0x2b, 0x81, 0x5c, 0xd4, 0x66, 0x4e, 0x27, 0xd3, 0x41,
-	0x09, 0x0f, 0x53, 0xdc, 0x0b, 0x2d, 0x68, 0x22, 0x9f, 0x6b, 0x1e, 0x9f, 0x12, 0x3d, 0xdf, 0x02,
-	0x1f, 0xc1, 0xf7, 0xde, 0xa3, 0xff, 0x12, 0x38, 0x9e, 0xb8, 0xc5, 0xd2, 0x52, 0x3a, 0xa8, 0x09,
-	0xa3, 0x23, 0x37, 0xb5, 0x1d, 0x13, 0x48, 0x7b, 0xc6, 0xa3, 0x7d, 0x95, 0x5e, 0x69, 0x85, 0xb6,
-	0x37, 0xfb, 0xf9, 0x03, 0xf0, 0x03, 0x01, 0xf0, 0xfc, 0x35, 0x68, 0x96, 0xd0, 0x76, 0xd7, 0xa0,
-	0x59, 0xc2, 0xd3, 0x3d, 0xff, 0x9e, 0xc7, 0xa3, 0x4c, 0xa7, 0xb7, 0x99, 0x3e, 0x61, 0x39, 0xf8,
-	0x76, 0x59, 0xa1, 0xff, 0x10, 0xc8, 0x46, 0xc4, 0x91, 0x9e, 0x4d, 0xc4, 0x19, 0xbf, 0xbe, 0x72,
-	0x93, 0xcd, 0x2b, 0x22, 0x53, 0xc3, 0x63, 0xaa, 0x50, 0xb9, 0xdd, 0x4c, 0x23, 0xd3, 0x49, 0x7f,
-	0x24, 0x90, 0x8b, 0x5a, 0xd3, 0x1a, 0xb4, 0x6a, 0xc2, 0x46, 0xda, 0xa0, 0x55, 0x93, 0x76, 0x42,
-	0xbe, 0xe4, 0x45, 0xe0, 0x0c, 0x3d, 0x1d, 0x17, 0x81, 0xc4, 0x7c, 0xd6, 0xfb, 0x33, 0x71, 0xbb,
-	0x69, 0xd0, 0x9f, 0x69, 0x56, 0xbb, 0x06, 0xfd, 0x99, 0x6a, 0xb9, 0x4a, 0xd9, 0x9f, 0x2e, 0xbd,
-	0x94, 0x09, 0x65, 0xf4, 0x7b, 0x02, 0x07, 0x02, 0xa3, 0x3a, 0x1d, 0x4b, 0x44, 0x1b, 0xb5, 0x29,
-	0x71, 0xe3, 0xcd, 0xa8, 0x20, 0xa1, 0xeb, 0x1e, 0xa1, 0x57, 0x68, 0xa9, 0x15, 0x42, 0x46, 0x00,
-	0xf6, 0x13, 0x02, 0xd9, 0x88, 0x21, 0xb7, 0x41, 0x67, 0xc6, 0x4f, 0xf3, 0xdc, 0x64, 0xf3, 0x8a,
-	0x48, 0xed, 0xaa, 0x47, 0xed, 0x02, 0x7d, 0xb9, 0x15, 0x6a, 0xbe, 0x97, 0xf9, 0x26, 0x01, 0x1a,
-	0x76, 0x46, 0xcf, 0x34, 0x89, 0xce, 0x61, 0x75, 0xb6, 0x69, 0x3d, 0x24, 0xf5, 0xae, 0x47, 0xea,
-	0x26, 0xbd, 0xb1, 0x3d, 0x52, 0xe1, 0x19, 0xe0, 0x6b, 0x02, 0x07, 0x83, 0xc3, 0x24, 0x4d, 0x2e,
-	0xaa, 0xc8, 0x69, 0x97, 0x9b, 0x68, 0x4a, 0x07, 0x99, 0xbd, 0xe4, 0x31, 0x1b, 0xa7, 0xcf, 0xc5,
-	0x31, 0xab, 0xb8, 0xca, 0xb3, 0xaa, 0x76, 0x4b, 0x17, 0x96, 0xed, 0x41, 0x7a, 0x85, 0x7e, 0x48,
-	0xa0, 0xb3, 0x3e, 0xa2, 0xd2, 0xc1, 0x44, 0xe7, 0xbe, 0x69, 0x98, 0x1b, 0x4a, 0x21, 0x89, 0xe0,
-	0x86, 0x3c, 0x70, 0x79, 0xda, 0x17, 0x07, 0xae, 0x3e, 0x11, 0xd3, 0x8f, 0x08, 0x74, 0xd9, 0xf3,
-	0x2b, 0x1d, 0x4e, 0x76, 0xe0, 0x1f, 0x99, 0xb9, 0x91, 0x54, 0xb2, 0x08, 0x67, 0xc4, 0x83, 0x53,
-	0xa0, 0xf9, 0x58, 0x38, 0xf6, 0x14, 0x7d, 0xe9, 0xd1, 0x46, 0x9e, 0xac, 0x6f, 0xe4, 0xc9, 0xef,
-	0x1b, 0x79, 0xf2, 0xf1, 0x66, 0x3e, 0xb3, 0xbe, 0x99, 0xcf, 0xfc, 0xb2, 0x99, 0xcf, 0xbc, 0x7d,
-	0x4a, 0x51, 0xcd, 0xca, 0xe2, 0x5c, 0x71, 0x5e, 0x5f, 0x70, 0x6c, 0xd8, 0xff, 0x8d, 0x32, 0xe9,
-	0xb6, 0x70, 0xd7, 0x35, 0x68, 0xde, 0xab, 0xc9, 0x6c, 0xae, 0xcb, 0xfa, 0x9b, 0xe3, 0xc4, 0x7f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x44, 0xdb, 0xeb, 0x04, 0x82, 0x1d, 0x00, 0x00,
+	// 1679 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xdd, 0x6f, 0x14, 0x55,
+	0x1b, 0xef, 0x29, 0x7d, 0x9b, 0xb7, 0x0f, 0xa1, 0xc0, 0xd9, 0x52, 0xca, 0x50, 0xb6, 0xcb, 0x84,
+	0xbc, 0x94, 0x96, 0xee, 0x40, 0x29, 0xd0, 0xb7, 0x7e, 0x40, 0x2b, 0x41, 0x2a, 0x04, 0xca, 0x56,
+	0x89, 0xa2, 0x64, 0x33, 0xed, 0x0c, 0xbb, 0x93, 0x6e, 0x67, 0xca, 0xcc, 0xb4, 0x01, 0x6b, 0x43,
+	0xe2, 0x85, 0x72, 0x65, 0x8c, 0xde, 0x1b, 0x2e, 0x8d, 0xd1, 0x84, 0x8b, 0x62, 0xf4, 0x02, 0x2f,
+	0x0d, 0x31, 0x46, 0x49, 0x0d, 0x06, 0x4d, 0x44, 0x43, 0x4d, 0xf4, 0xc6, 0xff, 0xc0, 0x18, 0xb3,
+	0x33, 0xcf, 0x7c, 0xed, 0x9c, 0x99, 0x9d, 0xdd, 0x6e, 0x93, 0x72, 0x43, 0xba, 0xe7, 0x3c, 0x1f,
+	0xbf, 0xdf, 0xf3, 0x71, 0xe6, 0x3c, 0x27, 0x00, 0x3f, 0xad, 0x19, 0xb3, 0x9a, 0x21, 0x18, 0xa6,
+	0x38, 0xa3, 0xa8, 0x05, 0x61, 0xe1, 0xf0, 0x94, 0x6c, 0x8a, 0x87, 0x85, 0x6b, 0xf3, 0xb2, 0x7e,
+	0x23, 0x3b, 0xa7, 0x6b, 0xa6, 0x46, 0x3b, 0x6d, 0x99, 0x2c, 0xca, 0x64, 0x51, 0x86, 0xeb, 0x43,
+	0xdd, 0x29, 0xd1, 0x90, 0x6d, 0x05, 0x57, 0x7d, 0x4e, 0x2c, 0x28, 0xaa, 0x68, 0x2a, 0x9a, 0x6a,
+	0xdb, 0xe0, 0x3a, 0x0a, 0x5a, 0x41, 0xb3, 0xfe, 0x14, 0xca, 0x7f, 0xe1, 0x6a, 0x77, 0x41, 0xd3,
+	0x0a, 0x25, 0x59, 0x10, 0xe7, 0x14, 0x41, 0x54, 0x55, 0xcd, 0xb4, 0x54, 0x0c, 0xdc, 0xdd, 0x17,
+	0x81, 0xcd, 0xc1, 0x61, 0x4b, 0xed, 0xb2, 0xa5, 0xf2, 0xb6, 0x71, 0x84, 0x6a, 0x6f, 0xed, 0x46,
+	0x03, 0x0e, 0x36, 0x3f, 0x2b, 0x6e, 0xbb, 0x38, 0xab, 0xa8, 0x9a, 0x60, 0xfd, 0x6b, 0x2f, 0xf1,
+	0xd7, 0xa1, 0xf3, 0x62, 0x59, 0xe2, 0x92, 0x58, 0x52, 0x24, 0xd1, 0xd4, 0x74, 0x23, 0x27, 0x5f,
+	0x9b, 0x97, 0x0d, 0x93, 0x76, 0x42, 0xab, 0x61, 0x8a, 0xe6, 0xbc, 0xd1, 0x45, 0x32, 0xa4, 0xb7,
+	0x2d, 0x87, 0xbf, 0xe8, 0x69, 0x00, 0x8f, 0x6a, 0x57, 0x73, 0x86, 0xf4, 0x6e, 0x1e, 0xfc, 0x5f,
+	0x16, 0x41, 0x94, 0xe3, 0x92, 0xb5, 0x5d, 0x22, 0xf4, 0xec, 0x84, 0x58, 0x90, 0xd1, 0x66, 0xce,
+	0xa7, 0xc9, 0xdf, 0x21, 0xb0, 0x33, 0xe4, 0xda, 0x98, 0xd3, 0x54, 0x43, 0xa6, 0xe7, 0x00, 0x16,
+	0xdc, 0xd5, 0x2e, 0x92, 0xd9, 0xd4, 0xbb, 0x79, 0x70, 0x6f, 0x96, 0x9d, 0x93, 0xac, 0xab, 0x3f,
+	0xd6, 0x76, 0xff, 0x71, 0x4f, 0xd3, 0xc7, 0x7f, 0xdc, 0xe9, 0x23, 0x39, 0x9f, 0x3e, 0x7d, 0x91,
+	0x81, 0x78, 0x7f, 0x55, 0xc4, 0x36, 0x94, 0x00, 0x64, 0x11, 0x76, 0x04, 0x11, 0x3b, 0xb1, 0x3a,
+	0x03, 0xed, 0xae, 0xbf, 0xbc, 0x28, 0x49, 0xba, 0x1d, 0xb3, 0xb1, 0xbd, 0x2b, 0xcb, 0x03, 0x7b,
+	0xd0, 0x91, 0xab, 0x34, 0x2a, 0x49, 0xba, 0x6c, 0x18, 0x93, 0xa6, 0xae, 0xa8, 0x85, 0xdc, 0x96,
+	0x05, 0xff, 0x3a, 0x2f, 0x55, 0xe6, 0xc3, 0x8d, 0xc9, 0x4b, 0xd0, 0xe6, 0x8a, 0x5a, 0xe6, 0x6b,
+	0x0d, 0x89, 0xa7, 0xce, 0x2f, 0x13, 0xc8, 0x04, 0xdd, 0x9c, 0x92, 0x4b, 0x72, 0xc1, 0x2e, 0xc5,
+	0x86, 0x93, 0x6a, 0x58, 0xc9, 0xfc, 0x45, 0x60, 0x6f, 0x0c, 0x6c, 0x0c, 0xd4, 0x4d, 0xe8, 0x90,
+	0xdc, 0xe5, 0xbc, 0x8e, 0xcb, 0x4e, 0x19, 0xf5, 0x45, 0xc5, 0xcc, 0x33, 0xe5, 0x58, 0x1a, 0xcb,
+	0x94, 0x83, 0xf7, 0xc9, 0xaf, 0x3d, 0xa9, 0xf0, 0x9e, 0x61, 0xc7, 0x34, 0x25, 0x85, 0x77, 0x1a,
+	0x57, 0x6f, 0xf7, 0x08, 0x1c, 0x08, 0xf2, 0x7d, 0x45, 0x9d, 0xd2, 0x54, 0x49, 0x51, 0x0b, 0x4f,
+	0x45, 0xbe, 0x1e, 0x13, 0xe8, 0x4b, 0x82, 0x1f, 0x13, 0x57, 0x80, 0xd4, 0xbc, 0xb3, 0x1f, 0xca,
+	0x5b, 0x7f, 0x54, 0xde, 0x18, 0x26, 0xfd, 0x55, 0x4f, 0x5d, 0x93, 0xeb, 0x90, 0xa0, 0xcf, 0x08,
+	0xb6, 0xab, 0xbf, 0x40, 0xec, 0x6c, 0x9c, 0x80, 0x76, 0xac, 0x8d, 0x60, 0x36, 0xba, 0x56, 0x96,
+	0x07, 0x3a, 0xd0, 0x55, 0x45, 0x12, 0x5c, 0x79, 0x2b, 0x09, 0xe1, 0x74, 0x36, 0xd7, 0x97, 0xce,
+	0x91, 0xff, 0xde, 0xba, 0xdd, 0xd3, 0xf4, 0xe7, 0xed, 0x9e, 0x26, 0x7e, 0x01, 0x76, 0x86, 0xe0,
+	0x62, 0xf0, 0x5f, 0x87, 0x14, 0xa3, 0x6b, 0xf0, 0xa0, 0xa9, 0xa1, 0x69, 0x72, 0x34, 0xdc, 0x12,
+	0xfc, 0xe7, 0x04, 0x7a, 0x2c, 0xc7, 0x8c, 0x64, 0x6d, 0xe8, 0x80, 0xe9, 0x90, 0x89, 0xc6, 0x8d,
+	0x91, 0x3b, 0x0f, 0xad, 0x76, 0x8d, 0x61, 0xb0, 0xea, 0xad, 0x54, 0xb4, 0xc2, 0xdf, 0x75, 0x0e,
+	0xe7, 0x53, 0x0e, 0x3d, 0x46, 0xb3, 0xaf, 0x39, 0x5a, 0x0d, 0xea, 0x71, 0x5f, 0xac, 0x7e, 0x74,
+	0x4e, 0x67, 0x36, 0x6e, 0x8c, 0x56, 0xb1, 0x61, 0xa7, 0xb3, 0x2f, 0x74, 0xeb, 0x7b, 0x0c, 0x7f,
+	0xe5, 0x1c, 0xc3, 0x2e, 0xb1, 0xb8, 0x63, 0x78, 0x03, 0x66, 0xc6, 0x3d, 0x87, 0xab, 0x10, 0x78,
+	0x6a, 0xcf, 0xe1, 0x07, 0xcd, 0xb0, 0xcb, 0x22, 0x98, 0x93, 0xa5, 0x75, 0xc8, 0xc8, 0x05, 0xa0,
+	0x86, 0x3e, 0x9d, 0xaf, 0xf7, 0x74, 0xd9, 0x66, 0xe8, 0xd3, 0x81, 0xad, 0xb2, 0x41, 0xc9, 0x30,
+	0x2b, 0x0d, 0x6e, 0x4a, 0x6c, 0x50, 0x32, 0xcc, 0x4b, 0x31, 0x5f, 0xec, 0x96, 0x06, 0xd4, 0xcc,
+	0x43, 0x02, 0x1c, 0x2b, 0xa4, 0x58, 0x23, 0x2a, 0x74, 0xea, 0x72, 0x4c, 0x23, 0x1f, 0x8c, 0x2a,
+	0x13, 0xbf, 0x39, 0x56, 0x2b, 0xef, 0xd0, 0xe5, 0x75, 0x6d, 0xe6, 0x65, 0xe7, 0x53, 0xe4, 0xf6,
+	0x42, 0x78, 0xf4, 0xd9, 0x80, 0x2d, 0xfc, 0x65, 0xe8, 0xa3, 0xf0, 0xf4, 0x8c, 0x4d, 0x77, 0x09,
+	0xa4, 0x23, 0xb0, 0x6f, 0xe8, 0x8f, 0xff, 0x6c, 0x64, 0xa5, 0xac, 0xcb, 0x50, 0x36, 0x84, 0x0d,
+	0x77, 0x46, 0x31, 0x4c, 0x4d, 0x57, 0xa6, 0xc5, 0xd2, 0xb8, 0x7a, 0x55, 0xf3, 0x8d, 0xe3, 0x45,
+	0x59, 0x29, 0x14, 0x4d, 0xcb, 0xcd, 0xa6, 0x1c, 0xfe, 0xe2, 0x5f, 0x83, 0xdd, 0x4c, 0x2d, 0x04,
+	0x38, 0x02, 0x2d, 0x45, 0xc5, 0x30, 0xbb, 0x48, 0xb0, 0x06, 0x2b, 0xb1, 0x55, 0x68, 0x5b, 0x3a,
+	0x3c, 0x85, 0x6d, 0x96, 0xe9, 0x09, 0x4d, 0x2b, 0x21, 0x0c, 0x7e, 0x02, 0xb6, 0xfb, 0xd6, 0xd0,
+	0xc9, 0x33, 0xd0, 0x32, 0xa7, 0x69, 0x25, 0x74, 0xd2, 0x1d, 0xe5, 0xa4, 0xac, 0xe3, 0xe7, 0x6e,
+	0x29, 0xf1, 0x1d, 0x40, 0x6d, 0x8b, 0xa2, 0x2e, 0xce, 0x3a, 0x2d, 0xc8, 0xbf, 0x0a, 0xa9, 0xc0,
+	0x2a, 0x7a, 0x1a, 0x85, 0xd6, 0x39, 0x6b, 0x05, 0x7d, 0xa5, 0x23, 0x7d, 0x59, 0x52, 0x81, 0xeb,
+	0x95, 0xad, 0xc8, 0x9f, 0xc6, 0xac, 0xbe, 0xac, 0xcd, 0xc8, 0xaa, 0xf2, 0xa6, 0x3c, 0x59, 0x14,
+	0x75, 0x39, 0x27, 0x4f, 0x6b, 0xba, 0xe4, 0xc4, 0xba, 0x1d, 0x9a, 0x15, 0xfb, 0x36, 0xd7, 0x92,
+	0x6b, 0x56, 0xa4, 0x91, 0xd4, 0xca, 0xf2, 0xc0, 0x56, 0xdb, 0xd1, 0x80, 0x21, 0xcd, 0x64, 0x0e,
+	0x65, 0x8f, 0x0e, 0xf1, 0xef, 0x3a, 0x1d, 0xc9, 0x34, 0xe4, 0xdd, 0x0d, 0x75, 0x6b, 0xa5, 0xda,
+	0xdd, 0x90, 0x61, 0x24, 0x00, 0xde, 0xb6, 0xc2, 0x46, 0xf2, 0x16, 0x96, 0xc0, 0x39, 0xe5, 0xda,
+	0xbc, 0x22, 0xad, 0xdf, 0xe3, 0x04, 0xdb, 0xfb, 0x07, 0x04, 0xba, 0xd9, 0xee, 0x31, 0x06, 0x57,
+	0x60, 0x5b, 0xc9, 0xda, 0xca, 0x57, 0xb6, 0xca, 0xfe, 0xa8, 0x68, 0x54, 0x98, 0xf2, 0x47, 0x62,
+	0x6b, 0x29, 0xb8, 0xc7, 0x06, 0x35, 0x04, 0x7b, 0x30, 0x37, 0xa6, 0x58, 0xb2, 0xad, 0x4d, 0x9a,
+	0xe2, 0x8c, 0xec, 0xa4, 0x98, 0xad, 0x75, 0x13, 0xd2, 0x51, 0x5a, 0xc8, 0xe5, 0x0c, 0xb4, 0x9a,
+	0xe5, 0x4c, 0xe1, 0xa3, 0xd8, 0xd8, 0xa1, 0x32, 0xb0, 0x9f, 0x1f, 0xf7, 0xec, 0xb0, 0x4d, 0x19,
+	0xd2, 0x4c, 0x56, 0xd1, 0x84, 0x59, 0xd1, 0x2c, 0x66, 0xc7, 0x55, 0x73, 0x65, 0x79, 0x00, 0x90,
+	0xe1, 0xb8, 0x6a, 0x62, 0x26, 0x6d, 0x7d, 0x26, 0x80, 0xc1, 0x7b, 0xbb, 0xe1, 0x3f, 0x16, 0x02,
+	0xfa, 0x11, 0x01, 0xf0, 0x4e, 0x78, 0x9a, 0x8d, 0x8a, 0x14, 0xfb, 0xf1, 0x8e, 0x13, 0x12, 0xcb,
+	0xe3, 0x84, 0x26, 0xdc, 0x2a, 0xa3, 0x7b, 0xfb, 0x87, 0xdf, 0x3f, 0x6c, 0xde, 0x47, 0x79, 0x21,
+	0xe2, 0x19, 0xd2, 0xf7, 0x75, 0xf8, 0x94, 0x40, 0x9b, 0x6b, 0x87, 0x0e, 0x24, 0xf3, 0xe7, 0xc0,
+	0xcb, 0x26, 0x15, 0x47, 0x74, 0x27, 0x3d, 0x74, 0x47, 0xe9, 0x91, 0xea, 0xe8, 0x84, 0xc5, 0x60,
+	0xc5, 0x2f, 0xd1, 0x9f, 0x08, 0x74, 0xb0, 0x5e, 0x8d, 0xe8, 0x70, 0x32, 0x28, 0xe1, 0x8b, 0x3e,
+	0xf7, 0xff, 0x3a, 0x34, 0x91, 0xcf, 0x39, 0x8f, 0xcf, 0x28, 0x3d, 0x51, 0x07, 0x1f, 0xc1, 0x77,
+	0x27, 0xa3, 0xff, 0x10, 0xd8, 0x13, 0xfb, 0xc2, 0x42, 0x47, 0x93, 0x41, 0x8d, 0x19, 0x6b, 0xb8,
+	0xb1, 0xb5, 0x98, 0x40, 0xda, 0x97, 0x3c, 0xda, 0x67, 0xe9, 0x78, 0x3d, 0xb4, 0xbd, 0xb9, 0xc4,
+	0x1f, 0x80, 0x6f, 0x09, 0x80, 0xe7, 0xaf, 0x4a, 0xb3, 0x84, 0x5e, 0x1e, 0x38, 0x21, 0xb1, 0x3c,
+	0xf2, 0xb8, 0xe2, 0xf1, 0xc8, 0xd1, 0x89, 0x35, 0xa6, 0x4f, 0x58, 0x0c, 0xde, 0x7c, 0x96, 0xe8,
+	0xdf, 0x04, 0x52, 0x8c, 0x38, 0xd2, 0xe3, 0xb1, 0x38, 0xa3, 0x9f, 0x56, 0xb8, 0xe1, 0xda, 0x15,
+	0x91, 0xa9, 0xee, 0x31, 0x2d, 0x50, 0xb9, 0xd1, 0x4c, 0x99, 0xe9, 0xa4, 0xdf, 0x11, 0xe8, 0x60,
+	0x3d, 0x21, 0x54, 0x69, 0xd5, 0x98, 0xd7, 0x92, 0x2a, 0xad, 0x1a, 0xf7, 0x5e, 0xc1, 0x8f, 0x7a,
+	0x11, 0x38, 0x46, 0x87, 0xa2, 0x22, 0x10, 0x9b, 0xcf, 0x72, 0x7f, 0xc6, 0x4e, 0xde, 0x55, 0xfa,
+	0x33, 0xc9, 0xb3, 0x43, 0x95, 0xfe, 0x4c, 0x34, 0xf8, 0x27, 0xec, 0x4f, 0x97, 0x5e, 0xc2, 0x84,
+	0x1a, 0xf4, 0x6b, 0x02, 0x5b, 0x02, 0x63, 0x24, 0x3d, 0x1c, 0x8b, 0x96, 0x35, 0xc5, 0x73, 0x83,
+	0xb5, 0xa8, 0x20, 0xa1, 0xf3, 0x1e, 0xa1, 0x17, 0xe8, 0x68, 0x3d, 0x84, 0xf4, 0x00, 0xec, 0x87,
+	0x04, 0x52, 0x8c, 0x01, 0xac, 0x4a, 0x67, 0x46, 0x4f, 0x9a, 0xdc, 0x70, 0xed, 0x8a, 0x48, 0xed,
+	0xac, 0x47, 0xed, 0x24, 0x7d, 0xbe, 0x1e, 0x6a, 0xbe, 0x8f, 0xf9, 0x2a, 0x01, 0x1a, 0x76, 0x46,
+	0x8f, 0xd5, 0x88, 0xce, 0x61, 0x75, 0xbc, 0x66, 0x3d, 0x24, 0xf5, 0x86, 0x47, 0xea, 0x22, 0xbd,
+	0xb0, 0x36, 0x52, 0xe1, 0x3b, 0xc0, 0x17, 0x04, 0xda, 0x83, 0x83, 0x0e, 0x8d, 0x2f, 0x2a, 0xe6,
+	0x24, 0xc6, 0x1d, 0xa9, 0x49, 0x07, 0x99, 0x3d, 0xe7, 0x31, 0x1b, 0xa4, 0x87, 0xa2, 0x98, 0x15,
+	0x5d, 0xe5, 0xbc, 0xa2, 0x5e, 0xd5, 0x84, 0x45, 0x7b, 0xc8, 0x5b, 0xa2, 0xef, 0x10, 0x68, 0x29,
+	0x8f, 0x4f, 0xb4, 0x37, 0xd6, 0xb9, 0x6f, 0x52, 0xe3, 0x0e, 0x24, 0x90, 0x44, 0x70, 0x07, 0x3c,
+	0x70, 0x69, 0xda, 0x1d, 0x05, 0xae, 0x3c, 0xad, 0xd1, 0xf7, 0x08, 0xb4, 0xda, 0xb3, 0x15, 0xed,
+	0x8b, 0x77, 0xe0, 0x1f, 0xe7, 0xb8, 0xfe, 0x44, 0xb2, 0x08, 0xa7, 0xdf, 0x83, 0x93, 0xa1, 0xe9,
+	0x48, 0x38, 0x36, 0x8a, 0x5f, 0x08, 0xa4, 0x18, 0xc3, 0x53, 0x95, 0x96, 0x8c, 0x1e, 0xfe, 0xb8,
+	0xe1, 0xda, 0x15, 0x11, 0xf7, 0xe4, 0x37, 0xe1, 0x2b, 0x7d, 0xa2, 0x8b, 0xab, 0x89, 0x46, 0xf3,
+	0x46, 0xd9, 0x6a, 0xde, 0x9e, 0xf5, 0x0c, 0x61, 0x51, 0x91, 0x96, 0xe8, 0x23, 0x02, 0x5b, 0x2b,
+	0xc6, 0x21, 0x1a, 0x5f, 0x81, 0xec, 0x31, 0x90, 0x1b, 0xaa, 0x4d, 0x09, 0x39, 0x5d, 0x8e, 0xe5,
+	0xf4, 0x2c, 0x1d, 0x89, 0xe2, 0x54, 0x39, 0xf2, 0x31, 0xfa, 0xf1, 0x7b, 0x02, 0xdb, 0x43, 0xa3,
+	0x16, 0x3d, 0x5a, 0x25, 0xfe, 0xec, 0x81, 0x8e, 0x3b, 0x56, 0xab, 0x9a, 0x73, 0x8e, 0xc6, 0x12,
+	0x1c, 0xa0, 0xfd, 0xd1, 0x49, 0x33, 0xc5, 0x52, 0x1e, 0x69, 0x1a, 0x96, 0xd1, 0xb1, 0xd3, 0xf7,
+	0x9f, 0xa4, 0xc9, 0x83, 0x27, 0x69, 0xf2, 0xdb, 0x93, 0x34, 0x79, 0x7f, 0x35, 0xdd, 0xf4, 0x60,
+	0x35, 0xdd, 0xf4, 0x68, 0x35, 0xdd, 0x74, 0xf9, 0x60, 0x41, 0x31, 0x8b, 0xf3, 0x53, 0xd9, 0x69,
+	0x6d, 0xd6, 0x31, 0xe8, 0x39, 0x15, 0xae, 0xbb, 0xd6, 0xcd, 0x1b, 0x73, 0xb2, 0x31, 0xd5, 0x6a,
+	0xfd, 0xe7, 0x8c, 0x23, 0xff, 0x0e, 0x00, 0x0a, 0xd4, 0xab, 0xfe, 0xab, 0x22, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1582,6 +1867,12 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// TokenizeShareRecord queries a tokenize share record by id.
+	TokenizeShareRecord(ctx context.Context, in *QueryTokenizeShareRecordRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordResponse, error)
+	// LiquidValidator queries the liquid staking accounting of a validator.
+	LiquidValidator(ctx context.Context, in *QueryLiquidValidatorRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorResponse, error)
+	// TotalLiquidStaked queries the total liquid staked tokens.
+	TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error)
 }
 
 type queryClient struct {
@@ -1718,6 +2009,33 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) TokenizeShareRecord(ctx context.Context, in *QueryTokenizeShareRecordRequest, opts ...grpc.CallOption) (*QueryTokenizeShareRecordResponse, error) {
+	out := new(QueryTokenizeShareRecordResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/TokenizeShareRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidValidator(ctx context.Context, in *QueryLiquidValidatorRequest, opts ...grpc.CallOption) (*QueryLiquidValidatorResponse, error) {
+	out := new(QueryLiquidValidatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/LiquidValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalLiquidStaked(ctx context.Context, in *QueryTotalLiquidStakedRequest, opts ...grpc.CallOption) (*QueryTotalLiquidStakedResponse, error) {
+	out := new(QueryTotalLiquidStakedResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.staking.v1beta1.Query/TotalLiquidStaked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Validators queries all validators that match the given status.
@@ -1773,6 +2091,12 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// TokenizeShareRecord queries a tokenize share record by id.
+	TokenizeShareRecord(context.Context, *QueryTokenizeShareRecordRequest) (*QueryTokenizeShareRecordResponse, error)
+	// LiquidValidator queries the liquid staking accounting of a validator.
+	LiquidValidator(context.Context, *QueryLiquidValidatorRequest) (*QueryLiquidValidatorResponse, error)
+	// TotalLiquidStaked queries the total liquid staked tokens.
+	TotalLiquidStaked(context.Context, *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1821,6 +2145,15 @@ func (*UnimplementedQueryServer) Pool(ctx context.Context, req *QueryPoolRequest
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) TokenizeShareRecord(ctx context.Context, req *QueryTokenizeShareRecordRequest) (*QueryTokenizeShareRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenizeShareRecord not implemented")
+}
+func (*UnimplementedQueryServer) LiquidValidator(ctx context.Context, req *QueryLiquidValidatorRequest) (*QueryLiquidValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidValidator not implemented")
+}
+func (*UnimplementedQueryServer) TotalLiquidStaked(ctx context.Context, req *QueryTotalLiquidStakedRequest) (*QueryTotalLiquidStakedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidStaked not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2078,6 +2411,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TokenizeShareRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenizeShareRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenizeShareRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/TokenizeShareRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenizeShareRecord(ctx, req.(*QueryTokenizeShareRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/LiquidValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidValidator(ctx, req.(*QueryLiquidValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalLiquidStaked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalLiquidStakedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.staking.v1beta1.Query/TotalLiquidStaked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalLiquidStaked(ctx, req.(*QueryTotalLiquidStakedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.staking.v1beta1.Query",
@@ -2139,6 +2526,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "TokenizeShareRecord",
+			Handler:    _Query_TokenizeShareRecord_Handler,
+		},
+		{
+			MethodName: "LiquidValidator",
+			Handler:    _Query_LiquidValidator_Handler,
+		},
+		{
+			MethodName: "TotalLiquidStaked",
+			Handler:    _Query_TotalLiquidStaked_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
@@ -3245,40 +3644,220 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTokenizeShareRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryTokenizeShareRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenizeShareRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *QueryTokenizeShareRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenizeShareRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenizeShareRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LiquidValidator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidStakedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidStakedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidStakedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidStakedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidStakedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidStakedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if len(m.Validators) > 0 {
 		for _, e := range m.Validators {
 			l = e.Size()
@@ -3691,6 +4270,73 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTokenizeShareRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryTokenizeShareRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidValidator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalLiquidStakedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalLiquidStakedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6607,6 +7253,457 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTokenizeShareRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenizeShareRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenizeShareRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidValidator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidValidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalLiquidStakedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalLiquidStakedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalLiquidStakedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalLiquidStakedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalLiquidStakedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalLiquidStakedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -930,6 +930,15 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// validator_bond_factor is the maximum ratio of the liquid shares of a validator
+	// to its validator bond shares. A negative value disables the check.
+	ValidatorBondFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=validator_bond_factor,json=validatorBondFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_bond_factor"`
+	// global_liquid_staking_cap is the maximum ratio of the liquid staked tokens
+	// to the total bonded tokens of the chain.
+	GlobalLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum ratio of the liquid shares of a
+	// validator to its delegator shares.
+	ValidatorLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_liquid_staking_cap"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1000,6 +1009,131 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+// TokenizeShareRecord represents the delegation of tokenized shares. The
+// delegation is held by the module account of the record, and its shares are
+// represented by the bank denom <validator address>/<id>.
+type TokenizeShareRecord struct {
+	// id is the unique id of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address receiving the rewards of the delegation.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account holding the delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the operator address of the validator of the delegation.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{16}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+// LiquidValidator holds the liquid staking accounting of a validator.
+type LiquidValidator struct {
+	// operator_address is the operator address of the validator.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// liquid_shares is the number of shares of the validator that are tokenized.
+	LiquidShares cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=liquid_shares,json=liquidShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquid_shares"`
+	// validator_bond_shares is the number of shares of the validator bond delegations.
+	ValidatorBondShares cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=validator_bond_shares,json=validatorBondShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_bond_shares"`
+}
+
+func (m *LiquidValidator) Reset()         { *m = LiquidValidator{} }
+func (m *LiquidValidator) String() string { return proto.CompactTextString(m) }
+func (*LiquidValidator) ProtoMessage()    {}
+func (*LiquidValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{17}
+}
+func (m *LiquidValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidValidator.Merge(m, src)
+}
+func (m *LiquidValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidValidator proto.InternalMessageInfo
+
+func (m *LiquidValidator) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1011,7 +1145,7 @@ func (m *DelegationResponse) Reset()         { *m = DelegationResponse{} }
 func (m *DelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*DelegationResponse) ProtoMessage()    {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{16}
+	return fileDescriptor_64c30c6cf92913c9, []int{18}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1066,7 +1200,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{17}
+	return fileDescriptor_64c30c6cf92913c9, []int{19}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1114,7 +1248,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{18}
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1168,7 +1302,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{19}
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1207,7 +1341,7 @@ func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
 func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdates) ProtoMessage()    {}
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{20}
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
 }
 func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1262,6 +1396,8 @@ func init() {
 	proto.RegisterType((*RedelegationEntry)(nil), "cosmos.staking.v1beta1.RedelegationEntry")
 	proto.RegisterType((*Redelegation)(nil), "cosmos.staking.v1beta1.Redelegation")
 	proto.RegisterType((*Params)(nil), "cosmos.staking.v1beta1.Params")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
+	proto.RegisterType((*LiquidValidator)(nil), "cosmos.staking.v1beta1.LiquidValidator")
 	proto.RegisterType((*DelegationResponse)(nil), "cosmos.staking.v1beta1.DelegationResponse")
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
@@ -1274,128 +1410,142 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1928 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4f, 0x6c, 0x23, 0x57,
-	0x19, 0xcf, 0xc4, 0xa9, 0x93, 0x7c, 0x4e, 0x62, 0xe7, 0x6d, 0x76, 0xd7, 0xeb, 0x65, 0x63, 0xaf,
-	0xbb, 0xd0, 0x74, 0x69, 0xec, 0x6e, 0x40, 0x7b, 0x88, 0xaa, 0xa2, 0x38, 0x76, 0xba, 0x2e, 0x6d,
-	0x12, 0xc6, 0x49, 0x80, 0x0a, 0x18, 0x3d, 0xcf, 0x3c, 0x3b, 0x43, 0xec, 0x19, 0x33, 0xef, 0x39,
-	0xc4, 0x77, 0x0e, 0x55, 0x10, 0x52, 0x0f, 0x08, 0x21, 0x41, 0xc4, 0x4a, 0x5c, 0xca, 0xad, 0x87,
-	0x15, 0x77, 0x6e, 0x05, 0x09, 0x69, 0xb5, 0x27, 0x54, 0x89, 0x80, 0x76, 0x0f, 0xad, 0xe0, 0x82,
-	0x38, 0x71, 0x44, 0xef, 0xcf, 0xfc, 0xb1, 0x1d, 0x6f, 0x36, 0xdb, 0x0a, 0x55, 0xf4, 0x32, 0x9a,
-	0xf7, 0xde, 0xf7, 0xfd, 0xde, 0xfb, 0x7e, 0xdf, 0x9f, 0xf7, 0x07, 0x6e, 0x99, 0x2e, 0x6d, 0xbb,
-	0xb4, 0x48, 0x19, 0x3e, 0xb0, 0x9d, 0x66, 0xf1, 0xf0, 0x4e, 0x9d, 0x30, 0x7c, 0xc7, 0x6f, 0x17,
-	0x3a, 0x9e, 0xcb, 0x5c, 0x74, 0x45, 0x4a, 0x15, 0xfc, 0x5e, 0x25, 0x95, 0x59, 0x68, 0xba, 0x4d,
-	0x57, 0x88, 0x14, 0xf9, 0x9f, 0x94, 0xce, 0x5c, 0x6b, 0xba, 0x6e, 0xb3, 0x45, 0x8a, 0xa2, 0x55,
-	0xef, 0x36, 0x8a, 0xd8, 0xe9, 0xa9, 0xa1, 0xc5, 0xc1, 0x21, 0xab, 0xeb, 0x61, 0x66, 0xbb, 0x8e,
-	0x1a, 0xcf, 0x0e, 0x8e, 0x33, 0xbb, 0x4d, 0x28, 0xc3, 0xed, 0x8e, 0x8f, 0x2d, 0x57, 0x62, 0xc8,
-	0x49, 0xd5, 0xb2, 0x14, 0xb6, 0x32, 0xa5, 0x8e, 0x29, 0x09, 0xec, 0x30, 0x5d, 0xdb, 0xc7, 0x9e,
-	0xc7, 0x6d, 0xdb, 0x71, 0x8b, 0xe2, 0xab, 0xba, 0x6e, 0x98, 0x6e, 0x9b, 0xb0, 0x7a, 0x83, 0x15,
-	0x59, 0xaf, 0x43, 0x68, 0xf1, 0x70, 0x45, 0xfe, 0xa8, 0xe1, 0x2f, 0x05, 0xc3, 0xb8, 0x6e, 0xda,
-	0x03, 0xa3, 0xf9, 0x9f, 0x6b, 0x30, 0x77, 0xcf, 0xa6, 0xcc, 0xf5, 0x6c, 0x13, 0xb7, 0xaa, 0x4e,
-	0xc3, 0x45, 0xaf, 0x41, 0x7c, 0x9f, 0x60, 0x8b, 0x78, 0x69, 0x2d, 0xa7, 0x2d, 0x25, 0x56, 0xae,
-	0x15, 0x7c, 0x84, 0x82, 0xd4, 0x3c, 0x5c, 0x29, 0xdc, 0x13, 0x02, 0xa5, 0xe9, 0x0f, 0x4f, 0xb3,
-	0x63, 0xef, 0x7f, 0xfc, 0xc1, 0x6d, 0x4d, 0x57, 0x3a, 0xa8, 0x0c, 0xf1, 0x43, 0xdc, 0xa2, 0x84,
-	0xa5, 0xc7, 0x73, 0xb1, 0xa5, 0xc4, 0xca, 0xcd, 0xc2, 0xd9, 0xb4, 0x17, 0xf6, 0x70, 0xcb, 0xb6,
-	0x30, 0x73, 0xfb, 0x51, 0xa4, 0x6e, 0xfe, 0x17, 0xe3, 0x90, 0x5c, 0x77, 0xdb, 0x6d, 0x9b, 0x52,
-	0xdb, 0x75, 0x74, 0xcc, 0x08, 0x45, 0x6f, 0xc2, 0x84, 0x87, 0x19, 0x11, 0xab, 0x9a, 0x2e, 0xdd,
-	0xe5, 0x4a, 0x1f, 0x9d, 0x66, 0xaf, 0x4b, 0x78, 0x6a, 0x1d, 0x14, 0x6c, 0xb7, 0xd8, 0xc6, 0x6c,
-	0xbf, 0xf0, 0x16, 0x69, 0x62, 0xb3, 0x57, 0x26, 0xe6, 0xa3, 0x07, 0xcb, 0xa0, 0x66, 0x2f, 0x13,
-	0x53, 0xce, 0x20, 0x30, 0xd0, 0xb7, 0x60, 0xaa, 0x8d, 0x8f, 0x0c, 0x81, 0x37, 0xfe, 0xa9, 0xf0,
-	0x26, 0xdb, 0xf8, 0x88, 0xaf, 0x0f, 0xfd, 0x00, 0x92, 0x1c, 0xd2, 0xdc, 0xc7, 0x4e, 0x93, 0x48,
-	0xe4, 0xd8, 0xa7, 0x42, 0x9e, 0x6d, 0xe3, 0xa3, 0x75, 0x81, 0xc6, 0xf1, 0x57, 0x27, 0x3e, 0xb9,
-	0x9f, 0xd5, 0xf2, 0x7f, 0xd0, 0x00, 0x42, 0x62, 0x10, 0x86, 0x94, 0x19, 0xb4, 0xc4, 0xa4, 0x54,
-	0x79, 0xed, 0xa5, 0x51, 0xbc, 0x0f, 0xd0, 0x5a, 0x9a, 0xe5, 0xcb, 0x7b, 0x78, 0x9a, 0xd5, 0xe4,
-	0xac, 0x49, 0x73, 0x88, 0xf6, 0x44, 0xb7, 0x63, 0x61, 0x46, 0x0c, 0x1e, 0xc6, 0x82, 0xad, 0xc4,
-	0x4a, 0xa6, 0x20, 0x63, 0xbc, 0xe0, 0xc7, 0x78, 0x61, 0xc7, 0x8f, 0x71, 0x09, 0xf8, 0xde, 0xdf,
-	0x7c, 0x40, 0x90, 0xda, 0x7c, 0x5c, 0xd9, 0xf0, 0xbe, 0x06, 0x89, 0x32, 0xa1, 0xa6, 0x67, 0x77,
-	0x78, 0xd6, 0xa0, 0x34, 0x4c, 0xb6, 0x5d, 0xc7, 0x3e, 0x50, 0x11, 0x37, 0xad, 0xfb, 0x4d, 0x94,
-	0x81, 0x29, 0xdb, 0x22, 0x0e, 0xb3, 0x59, 0x4f, 0xba, 0x49, 0x0f, 0xda, 0x5c, 0xeb, 0xc7, 0xa4,
-	0x4e, 0x6d, 0x9f, 0x67, 0xdd, 0x6f, 0xa2, 0x97, 0x21, 0x45, 0x89, 0xd9, 0xf5, 0x6c, 0xd6, 0x33,
-	0x4c, 0xd7, 0x61, 0xd8, 0x64, 0xe9, 0x09, 0x21, 0x92, 0xf4, 0xfb, 0xd7, 0x65, 0x37, 0x07, 0xb1,
-	0x08, 0xc3, 0x76, 0x8b, 0xa6, 0x5f, 0x90, 0x20, 0xaa, 0xa9, 0x96, 0x7a, 0x32, 0x09, 0xd3, 0x41,
-	0xa0, 0xa2, 0x75, 0x48, 0xb9, 0x1d, 0xe2, 0xf1, 0x7f, 0x03, 0x5b, 0x96, 0x47, 0x28, 0x55, 0xd1,
-	0x98, 0x7e, 0xf4, 0x60, 0x79, 0x41, 0x11, 0xbe, 0x26, 0x47, 0x6a, 0xcc, 0xb3, 0x9d, 0xa6, 0x9e,
-	0xf4, 0x35, 0x54, 0x37, 0xfa, 0x2e, 0x77, 0x99, 0x43, 0x89, 0x43, 0xbb, 0xd4, 0xe8, 0x74, 0xeb,
-	0x07, 0xa4, 0xa7, 0x48, 0x5d, 0x18, 0x22, 0x75, 0xcd, 0xe9, 0x95, 0xd2, 0x7f, 0x0a, 0xa1, 0x4d,
-	0xaf, 0xd7, 0x61, 0x6e, 0x61, 0xbb, 0x5b, 0xff, 0x26, 0xe9, 0x71, 0x57, 0x29, 0x9c, 0x6d, 0x01,
-	0x83, 0xae, 0x40, 0xfc, 0x87, 0xd8, 0x6e, 0x11, 0x4b, 0x30, 0x32, 0xa5, 0xab, 0x16, 0x5a, 0x85,
-	0x38, 0x65, 0x98, 0x75, 0xa9, 0xa0, 0x61, 0x6e, 0x25, 0x3f, 0x2a, 0x36, 0x4a, 0xae, 0x63, 0xd5,
-	0x84, 0xa4, 0xae, 0x34, 0xd0, 0x3a, 0xc4, 0x99, 0x7b, 0x40, 0x1c, 0x45, 0x50, 0xe9, 0xab, 0x2a,
-	0x9a, 0x2f, 0x0f, 0x47, 0x73, 0xd5, 0x61, 0x91, 0x38, 0xae, 0x3a, 0x4c, 0x57, 0xaa, 0xe8, 0x7b,
-	0x90, 0xb2, 0x48, 0x8b, 0x34, 0x05, 0x73, 0x74, 0x1f, 0x7b, 0x84, 0xa6, 0xe3, 0x02, 0xee, 0xce,
-	0x85, 0x93, 0x43, 0x4f, 0x06, 0x50, 0x35, 0x81, 0x84, 0xb6, 0x21, 0x61, 0x85, 0xe1, 0x94, 0x9e,
-	0x14, 0x64, 0xbe, 0x38, 0xca, 0xc6, 0x48, 0xe4, 0x45, 0x2b, 0x4f, 0x14, 0x82, 0x47, 0x50, 0xd7,
-	0xa9, 0xbb, 0x8e, 0x65, 0x3b, 0x4d, 0x63, 0x9f, 0xd8, 0xcd, 0x7d, 0x96, 0x9e, 0xca, 0x69, 0x4b,
-	0x31, 0x3d, 0x19, 0xf4, 0xdf, 0x13, 0xdd, 0x68, 0x1b, 0xe6, 0x42, 0x51, 0x91, 0x21, 0xd3, 0x17,
-	0xcd, 0x90, 0xd9, 0x00, 0x80, 0x8b, 0xa0, 0xb7, 0x01, 0xc2, 0x1c, 0x4c, 0x83, 0x40, 0xcb, 0x9f,
-	0x9f, 0xcd, 0x51, 0x63, 0x22, 0x00, 0xc8, 0x81, 0x4b, 0x6d, 0xdb, 0x31, 0x28, 0x69, 0x35, 0x0c,
-	0xc5, 0x1c, 0xc7, 0x4d, 0x08, 0xfa, 0x5f, 0xbf, 0x80, 0x37, 0x3f, 0x7a, 0xb0, 0x9c, 0x94, 0xad,
-	0x65, 0x6a, 0x1d, 0xe4, 0x5e, 0x2d, 0x7c, 0xfd, 0xae, 0x3e, 0xdf, 0xb6, 0x9d, 0x1a, 0x69, 0x35,
-	0xca, 0x01, 0x30, 0x7a, 0x0d, 0xae, 0x87, 0x84, 0xb8, 0x8e, 0xb1, 0xef, 0xb6, 0x2c, 0xc3, 0x23,
-	0x0d, 0xc3, 0x74, 0xbb, 0x0e, 0x4b, 0xcf, 0x08, 0x1a, 0xaf, 0x06, 0x22, 0x5b, 0xce, 0x3d, 0xb7,
-	0x65, 0xe9, 0xa4, 0xb1, 0xce, 0x87, 0xd1, 0x8b, 0x10, 0xb2, 0x61, 0xd8, 0x16, 0x4d, 0xcf, 0xe6,
-	0x62, 0x4b, 0x13, 0xfa, 0x4c, 0xd0, 0x59, 0xb5, 0xe8, 0xea, 0xd4, 0xbb, 0xf7, 0xb3, 0x63, 0x9f,
-	0xdc, 0xcf, 0x8e, 0xe5, 0x37, 0x60, 0x66, 0x0f, 0xb7, 0x54, 0x6a, 0x11, 0x8a, 0xee, 0xc2, 0x34,
-	0xf6, 0x1b, 0x69, 0x2d, 0x17, 0x7b, 0x6a, 0x6a, 0x86, 0xa2, 0xf9, 0xdf, 0x69, 0x10, 0x2f, 0xef,
-	0x6d, 0x63, 0xdb, 0x43, 0x15, 0x98, 0x0f, 0x63, 0xf5, 0x59, 0xb3, 0x3c, 0x0c, 0x6f, 0x3f, 0xcd,
-	0x37, 0x61, 0xfe, 0xd0, 0x2f, 0x1c, 0x01, 0x8c, 0xdc, 0x6a, 0x6e, 0x3e, 0x7a, 0xb0, 0x7c, 0x43,
-	0xc1, 0x04, 0xc5, 0x65, 0x00, 0xef, 0x70, 0xa0, 0x3f, 0x62, 0xf3, 0x9b, 0x30, 0x29, 0x97, 0x4a,
-	0xd1, 0x37, 0xe0, 0x85, 0x0e, 0xff, 0x11, 0xa6, 0x26, 0x56, 0x16, 0x47, 0xc6, 0xbc, 0x90, 0x8f,
-	0x46, 0x88, 0xd4, 0xcb, 0xff, 0x74, 0x1c, 0xa0, 0xbc, 0xb7, 0xb7, 0xe3, 0xd9, 0x9d, 0x16, 0x61,
-	0x9f, 0x95, 0xed, 0xbb, 0x70, 0x39, 0xb4, 0x9d, 0x7a, 0xe6, 0xc5, 0xed, 0xbf, 0x14, 0xe8, 0xd7,
-	0x3c, 0xf3, 0x4c, 0x58, 0x8b, 0xb2, 0x00, 0x36, 0x76, 0x71, 0xd8, 0x32, 0x65, 0xc3, 0xcc, 0x7e,
-	0x07, 0x12, 0x21, 0x19, 0x14, 0x55, 0x61, 0x8a, 0xa9, 0x7f, 0x45, 0x70, 0x7e, 0x34, 0xc1, 0xbe,
-	0x5a, 0x94, 0xe4, 0x40, 0x3d, 0xff, 0x1f, 0x0d, 0x20, 0x92, 0x23, 0x9f, 0xcf, 0x18, 0x43, 0x55,
-	0x88, 0xab, 0xe2, 0x1c, 0x7b, 0xde, 0xe2, 0xac, 0x00, 0x22, 0xa4, 0xfe, 0x6c, 0x1c, 0x2e, 0xed,
-	0xfa, 0xd9, 0xfb, 0xf9, 0xe7, 0x60, 0x17, 0x26, 0x89, 0xc3, 0x3c, 0x5b, 0x90, 0xc0, 0x7d, 0xfe,
-	0xea, 0x28, 0x9f, 0x9f, 0x61, 0x54, 0xc5, 0x61, 0x5e, 0x2f, 0x1a, 0x01, 0x3e, 0x56, 0x84, 0x8f,
-	0x5f, 0xc5, 0x20, 0x3d, 0x4a, 0x15, 0xbd, 0x04, 0x49, 0xd3, 0x23, 0xa2, 0xc3, 0xdf, 0x77, 0x34,
-	0x51, 0x30, 0xe7, 0xfc, 0x6e, 0xb5, 0xed, 0xe8, 0xc0, 0x0f, 0x6a, 0x3c, 0xb8, 0xb8, 0xe8, 0xf3,
-	0x9d, 0xcc, 0xe6, 0x42, 0x04, 0xb1, 0xf1, 0xec, 0x40, 0xd2, 0x76, 0x6c, 0x66, 0xe3, 0x96, 0x51,
-	0xc7, 0x2d, 0xec, 0x98, 0xfe, 0x09, 0xf6, 0x42, 0x7b, 0xfe, 0x9c, 0xc2, 0x28, 0x49, 0x08, 0x54,
-	0x81, 0x49, 0x1f, 0x6d, 0xe2, 0xe2, 0x68, 0xbe, 0x2e, 0xba, 0x09, 0x33, 0xd1, 0x8d, 0x41, 0x9c,
-	0x46, 0x26, 0xf4, 0x44, 0x64, 0x5f, 0x38, 0x6f, 0xe7, 0x89, 0x3f, 0x75, 0xe7, 0x51, 0x07, 0xbe,
-	0xdf, 0xc4, 0x60, 0x5e, 0x27, 0xd6, 0xff, 0xbf, 0x5b, 0xb6, 0x01, 0x64, 0xaa, 0xf2, 0x4a, 0xaa,
-	0x3c, 0xf3, 0x1c, 0xf9, 0x3e, 0x2d, 0x41, 0xca, 0x94, 0xfd, 0xaf, 0x3c, 0xf4, 0xd7, 0x71, 0x98,
-	0x89, 0x7a, 0xe8, 0x0b, 0xb9, 0x69, 0xa1, 0xcd, 0xb0, 0x4c, 0x4d, 0x88, 0x32, 0xf5, 0xf2, 0xa8,
-	0x32, 0x35, 0x14, 0xcd, 0xe7, 0xd4, 0xa7, 0x5f, 0xc7, 0x20, 0xbe, 0x8d, 0x3d, 0xdc, 0xa6, 0x68,
-	0x6b, 0xe8, 0x6c, 0xeb, 0xbf, 0x08, 0x0c, 0x06, 0x73, 0x59, 0xbd, 0x80, 0xc8, 0x58, 0xfe, 0xe5,
-	0xa8, 0xa3, 0xed, 0x97, 0x61, 0x8e, 0xdf, 0x91, 0x03, 0x83, 0x24, 0xb9, 0xb3, 0xe2, 0xaa, 0x1b,
-	0x58, 0x4f, 0x51, 0x16, 0x12, 0x5c, 0x2c, 0xac, 0xc3, 0x5c, 0x06, 0xda, 0xf8, 0xa8, 0x22, 0x7b,
-	0xd0, 0x32, 0xa0, 0xfd, 0xe0, 0xd1, 0xc2, 0x08, 0x89, 0xe0, 0x72, 0xf3, 0xe1, 0x88, 0x2f, 0x7e,
-	0x03, 0x80, 0xaf, 0xc2, 0xb0, 0x88, 0xe3, 0xb6, 0xd5, 0x45, 0x6f, 0x9a, 0xf7, 0x94, 0x79, 0x07,
-	0xfa, 0x89, 0x26, 0x8f, 0xc8, 0x03, 0x37, 0x69, 0x75, 0x43, 0xd9, 0x79, 0x86, 0xa4, 0xf8, 0xf7,
-	0x69, 0x36, 0xd3, 0xc3, 0xed, 0xd6, 0x6a, 0xfe, 0x0c, 0x9c, 0xfc, 0x59, 0x97, 0x7b, 0x7e, 0x70,
-	0xee, 0xbf, 0x89, 0xaf, 0xde, 0xe2, 0xe1, 0x7d, 0xfc, 0xf1, 0x07, 0xb7, 0xaf, 0x87, 0xa7, 0xec,
-	0xe2, 0x51, 0xf0, 0xa8, 0x25, 0x7d, 0xc2, 0x4f, 0xaa, 0x28, 0xdc, 0x35, 0x74, 0x42, 0x3b, 0xfc,
-	0x12, 0xc8, 0x2f, 0x0d, 0x91, 0xc3, 0xbd, 0xf6, 0xf4, 0x4b, 0x43, 0xa8, 0xdf, 0x77, 0x69, 0x88,
-	0xe4, 0xd4, 0xeb, 0x61, 0xd1, 0x1e, 0x0f, 0x1e, 0x81, 0x04, 0x56, 0x1d, 0x53, 0x12, 0xb9, 0x7d,
-	0xd8, 0x7d, 0x10, 0xbe, 0x92, 0x48, 0xd5, 0xb1, 0xfc, 0x9f, 0x35, 0xb8, 0x36, 0x14, 0x7e, 0xc1,
-	0x92, 0x4d, 0x40, 0x5e, 0x64, 0x50, 0xb8, 0xb1, 0xa7, 0x96, 0xfe, 0x7c, 0xd1, 0x3c, 0xef, 0x0d,
-	0x55, 0xee, 0xcf, 0x66, 0xf7, 0x51, 0xa5, 0xe7, 0x8f, 0x1a, 0x2c, 0x44, 0x17, 0x10, 0x98, 0x52,
-	0x83, 0x99, 0xe8, 0xd4, 0xca, 0x88, 0x5b, 0xcf, 0x62, 0x44, 0x74, 0xfd, 0x7d, 0x20, 0x68, 0x2f,
-	0x4c, 0x71, 0xf9, 0x94, 0x76, 0xe7, 0x99, 0x49, 0xf1, 0x17, 0x76, 0x66, 0xaa, 0x4b, 0xdf, 0xfc,
-	0x53, 0x83, 0x89, 0x6d, 0xd7, 0x6d, 0xa1, 0x1f, 0xc1, 0xbc, 0xe3, 0x32, 0x83, 0xa7, 0x03, 0xb1,
-	0x0c, 0x75, 0xd7, 0x97, 0xe5, 0xb3, 0xf2, 0x54, 0xae, 0xfe, 0x71, 0x9a, 0x1d, 0xd6, 0xec, 0x27,
-	0x50, 0x3d, 0x29, 0x39, 0x2e, 0x2b, 0x09, 0xa1, 0x1d, 0xf9, 0x1c, 0xd0, 0x80, 0xd9, 0xfe, 0xe9,
-	0x64, 0x89, 0x5d, 0x3b, 0x6f, 0xba, 0xd9, 0x73, 0xa7, 0x9a, 0xa9, 0x47, 0xe6, 0x59, 0x9d, 0xe2,
-	0x5e, 0xfb, 0x17, 0xf7, 0xdc, 0x3b, 0x90, 0x0a, 0xea, 0xcb, 0xae, 0x78, 0x8f, 0xa2, 0x68, 0x03,
-	0x26, 0xe5, 0xd3, 0x94, 0x7f, 0xba, 0xbf, 0x19, 0x3e, 0x74, 0xe2, 0xba, 0x69, 0x17, 0x0e, 0x57,
-	0x0a, 0x03, 0x4a, 0x7d, 0x7c, 0x2a, 0xe5, 0xdb, 0xbf, 0xd7, 0x00, 0xc2, 0x87, 0x13, 0xf4, 0x0a,
-	0x5c, 0x2d, 0x6d, 0x6d, 0x96, 0x8d, 0xda, 0xce, 0xda, 0xce, 0x6e, 0xcd, 0xd8, 0xdd, 0xac, 0x6d,
-	0x57, 0xd6, 0xab, 0x1b, 0xd5, 0x4a, 0x39, 0x35, 0x96, 0x49, 0x1e, 0x9f, 0xe4, 0x12, 0xbb, 0x0e,
-	0xed, 0x10, 0xd3, 0x6e, 0xd8, 0xc4, 0x42, 0x5f, 0x81, 0x85, 0x7e, 0x69, 0xde, 0xaa, 0x94, 0x53,
-	0x5a, 0x66, 0xe6, 0xf8, 0x24, 0x37, 0x25, 0x0f, 0x8a, 0xc4, 0x42, 0x4b, 0x70, 0x79, 0x58, 0xae,
-	0xba, 0xf9, 0x46, 0x6a, 0x3c, 0x33, 0x7b, 0x7c, 0x92, 0x9b, 0x0e, 0x4e, 0x94, 0x28, 0x0f, 0x28,
-	0x2a, 0xa9, 0xf0, 0x62, 0x19, 0x38, 0x3e, 0xc9, 0xc5, 0xa5, 0x1b, 0x32, 0x13, 0xef, 0xfe, 0x76,
-	0x71, 0xec, 0xf6, 0xf7, 0x01, 0xaa, 0x4e, 0xc3, 0xc3, 0xa6, 0x08, 0xb7, 0x0c, 0x5c, 0xa9, 0x6e,
-	0x6e, 0xe8, 0x6b, 0xeb, 0x3b, 0xd5, 0xad, 0xcd, 0xfe, 0x65, 0x0f, 0x8c, 0x95, 0xb7, 0x76, 0x4b,
-	0x6f, 0x55, 0x8c, 0x5a, 0xf5, 0x8d, 0xcd, 0x94, 0x86, 0xae, 0xc2, 0xa5, 0xbe, 0xb1, 0x6f, 0x6f,
-	0xee, 0x54, 0xdf, 0xae, 0xa4, 0xc6, 0x4b, 0x1b, 0x1f, 0x3e, 0x5e, 0xd4, 0x1e, 0x3e, 0x5e, 0xd4,
-	0xfe, 0xfe, 0x78, 0x51, 0x7b, 0xef, 0xc9, 0xe2, 0xd8, 0xc3, 0x27, 0x8b, 0x63, 0x7f, 0x79, 0xb2,
-	0x38, 0xf6, 0xce, 0x2b, 0x4d, 0x9b, 0xed, 0x77, 0xeb, 0x9c, 0x6e, 0xf5, 0xfa, 0x5d, 0x3c, 0xb3,
-	0xe4, 0x89, 0xe7, 0xe6, 0x7a, 0x5c, 0xec, 0x32, 0x5f, 0xfb, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x16, 0x93, 0xee, 0x56, 0xe6, 0x17, 0x00, 0x00,
+	// 2146 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xd6, 0x52, 0x34, 0x25, 0x3e, 0x4a, 0xa2, 0x34, 0xfe, 0xa3, 0xe9, 0x58, 0x92, 0x19, 0xb7,
+	0x71, 0xdc, 0x88, 0x8c, 0xd5, 0xd4, 0x07, 0x21, 0x48, 0x20, 0x8a, 0x52, 0xcc, 0xd4, 0x91, 0xd5,
+	0xa5, 0xa4, 0xb6, 0xe9, 0xcf, 0x62, 0xb8, 0x3b, 0xa4, 0x36, 0x5a, 0xee, 0x32, 0x3b, 0x43, 0xc5,
+	0x2c, 0xd0, 0x5b, 0x0f, 0x81, 0x8a, 0x02, 0x39, 0x14, 0x45, 0x81, 0xc2, 0xa8, 0x81, 0x5e, 0x92,
+	0x53, 0x73, 0x30, 0x7a, 0xcf, 0x2d, 0x2d, 0x50, 0xc0, 0xf0, 0xa1, 0x28, 0x02, 0xd4, 0x2d, 0xec,
+	0x43, 0x82, 0xf6, 0x52, 0xf4, 0xd4, 0x63, 0x31, 0x3f, 0xfb, 0x43, 0x52, 0xb4, 0x24, 0xdb, 0x28,
+	0x82, 0xf6, 0x42, 0x70, 0x66, 0xde, 0xfb, 0xde, 0x9b, 0x6f, 0xde, 0x7b, 0xf3, 0xb3, 0x70, 0xc9,
+	0xf4, 0x68, 0xcb, 0xa3, 0x25, 0xca, 0xf0, 0xae, 0xed, 0x36, 0x4b, 0x7b, 0x57, 0xeb, 0x84, 0xe1,
+	0xab, 0x41, 0xbb, 0xd8, 0xf6, 0x3d, 0xe6, 0xa1, 0x33, 0x52, 0xaa, 0x18, 0xf4, 0x2a, 0xa9, 0xfc,
+	0xa9, 0xa6, 0xd7, 0xf4, 0x84, 0x48, 0x89, 0xff, 0x93, 0xd2, 0xf9, 0x73, 0x4d, 0xcf, 0x6b, 0x3a,
+	0xa4, 0x24, 0x5a, 0xf5, 0x4e, 0xa3, 0x84, 0xdd, 0xae, 0x1a, 0x9a, 0xed, 0x1f, 0xb2, 0x3a, 0x3e,
+	0x66, 0xb6, 0xe7, 0xaa, 0xf1, 0xb9, 0xfe, 0x71, 0x66, 0xb7, 0x08, 0x65, 0xb8, 0xd5, 0x0e, 0xb0,
+	0xa5, 0x27, 0x86, 0x34, 0xaa, 0xdc, 0x52, 0xd8, 0x6a, 0x2a, 0x75, 0x4c, 0x49, 0x38, 0x0f, 0xd3,
+	0xb3, 0x03, 0xec, 0x19, 0xdc, 0xb2, 0x5d, 0xaf, 0x24, 0x7e, 0x55, 0xd7, 0x05, 0xd3, 0x6b, 0x11,
+	0x56, 0x6f, 0xb0, 0x12, 0xeb, 0xb6, 0x09, 0x2d, 0xed, 0x2d, 0xca, 0x3f, 0x6a, 0xf8, 0xb9, 0x70,
+	0x18, 0xd7, 0x4d, 0xbb, 0x6f, 0xb4, 0xf0, 0x73, 0x0d, 0xa6, 0xae, 0xdb, 0x94, 0x79, 0xbe, 0x6d,
+	0x62, 0xa7, 0xea, 0x36, 0x3c, 0xf4, 0x2a, 0xa4, 0x76, 0x08, 0xb6, 0x88, 0x9f, 0xd3, 0xe6, 0xb5,
+	0xcb, 0x99, 0xc5, 0x73, 0xc5, 0x00, 0xa1, 0x28, 0x35, 0xf7, 0x16, 0x8b, 0xd7, 0x85, 0x40, 0x39,
+	0xfd, 0xe9, 0x83, 0xb9, 0x91, 0x0f, 0x3f, 0xff, 0xf8, 0x8a, 0xa6, 0x2b, 0x1d, 0x54, 0x81, 0xd4,
+	0x1e, 0x76, 0x28, 0x61, 0xb9, 0xc4, 0xfc, 0xe8, 0xe5, 0xcc, 0xe2, 0xc5, 0xe2, 0xc1, 0xb4, 0x17,
+	0xb7, 0xb1, 0x63, 0x5b, 0x98, 0x79, 0xbd, 0x28, 0x52, 0xb7, 0xf0, 0x8b, 0x04, 0x64, 0x57, 0xbc,
+	0x56, 0xcb, 0xa6, 0xd4, 0xf6, 0x5c, 0x1d, 0x33, 0x42, 0xd1, 0x9b, 0x90, 0xf4, 0x31, 0x23, 0xc2,
+	0xab, 0x74, 0xf9, 0x1a, 0x57, 0xfa, 0xec, 0xc1, 0xdc, 0x79, 0x09, 0x4f, 0xad, 0xdd, 0xa2, 0xed,
+	0x95, 0x5a, 0x98, 0xed, 0x14, 0x6f, 0x90, 0x26, 0x36, 0xbb, 0x15, 0x62, 0xde, 0xbf, 0xbb, 0x00,
+	0xca, 0x7a, 0x85, 0x98, 0xd2, 0x82, 0xc0, 0x40, 0xdf, 0x82, 0xf1, 0x16, 0xbe, 0x65, 0x08, 0xbc,
+	0xc4, 0x53, 0xe1, 0x8d, 0xb5, 0xf0, 0x2d, 0xee, 0x1f, 0xfa, 0x21, 0x64, 0x39, 0xa4, 0xb9, 0x83,
+	0xdd, 0x26, 0x91, 0xc8, 0xa3, 0x4f, 0x85, 0x3c, 0xd9, 0xc2, 0xb7, 0x56, 0x04, 0x1a, 0xc7, 0x5f,
+	0x4a, 0x7e, 0x71, 0x67, 0x4e, 0x2b, 0x7c, 0xa2, 0x01, 0x44, 0xc4, 0x20, 0x0c, 0xd3, 0x66, 0xd8,
+	0x12, 0x46, 0xa9, 0x5a, 0xb5, 0x17, 0x86, 0xf1, 0xde, 0x47, 0x6b, 0x79, 0x92, 0xbb, 0x77, 0xef,
+	0xc1, 0x9c, 0x26, 0xad, 0x66, 0xcd, 0x01, 0xda, 0x33, 0x9d, 0xb6, 0x85, 0x19, 0x31, 0x78, 0x18,
+	0x0b, 0xb6, 0x32, 0x8b, 0xf9, 0xa2, 0x8c, 0xf1, 0x62, 0x10, 0xe3, 0xc5, 0xcd, 0x20, 0xc6, 0x25,
+	0xe0, 0x07, 0x7f, 0x0d, 0x00, 0x41, 0x6a, 0xf3, 0x71, 0x35, 0x87, 0x0f, 0x35, 0xc8, 0x54, 0x08,
+	0x35, 0x7d, 0xbb, 0xcd, 0xb3, 0x06, 0xe5, 0x60, 0xac, 0xe5, 0xb9, 0xf6, 0xae, 0x8a, 0xb8, 0xb4,
+	0x1e, 0x34, 0x51, 0x1e, 0xc6, 0x6d, 0x8b, 0xb8, 0xcc, 0x66, 0x5d, 0xb9, 0x4c, 0x7a, 0xd8, 0xe6,
+	0x5a, 0xef, 0x91, 0x3a, 0xb5, 0x03, 0x9e, 0xf5, 0xa0, 0x89, 0x5e, 0x84, 0x69, 0x4a, 0xcc, 0x8e,
+	0x6f, 0xb3, 0xae, 0x61, 0x7a, 0x2e, 0xc3, 0x26, 0xcb, 0x25, 0x85, 0x48, 0x36, 0xe8, 0x5f, 0x91,
+	0xdd, 0x1c, 0xc4, 0x22, 0x0c, 0xdb, 0x0e, 0xcd, 0x9d, 0x90, 0x20, 0xaa, 0xa9, 0x5c, 0xbd, 0x3d,
+	0x06, 0xe9, 0x30, 0x50, 0xd1, 0x0a, 0x4c, 0x7b, 0x6d, 0xe2, 0xf3, 0xff, 0x06, 0xb6, 0x2c, 0x9f,
+	0x50, 0xaa, 0xa2, 0x31, 0x77, 0xff, 0xee, 0xc2, 0x29, 0x45, 0xf8, 0xb2, 0x1c, 0xa9, 0x31, 0xdf,
+	0x76, 0x9b, 0x7a, 0x36, 0xd0, 0x50, 0xdd, 0xe8, 0xbb, 0x7c, 0xc9, 0x5c, 0x4a, 0x5c, 0xda, 0xa1,
+	0x46, 0xbb, 0x53, 0xdf, 0x25, 0x5d, 0x45, 0xea, 0xa9, 0x01, 0x52, 0x97, 0xdd, 0x6e, 0x39, 0xf7,
+	0x87, 0x08, 0xda, 0xf4, 0xbb, 0x6d, 0xe6, 0x15, 0x37, 0x3a, 0xf5, 0x6f, 0x92, 0xae, 0x9e, 0x0d,
+	0x71, 0x36, 0x04, 0x0c, 0x3a, 0x03, 0xa9, 0x77, 0xb0, 0xed, 0x10, 0x4b, 0x30, 0x32, 0xae, 0xab,
+	0x16, 0x5a, 0x82, 0x14, 0x65, 0x98, 0x75, 0xa8, 0xa0, 0x61, 0x6a, 0xb1, 0x30, 0x2c, 0x36, 0xca,
+	0x9e, 0x6b, 0xd5, 0x84, 0xa4, 0xae, 0x34, 0xd0, 0x0a, 0xa4, 0x98, 0xb7, 0x4b, 0x5c, 0x45, 0x50,
+	0xf9, 0x6b, 0x2a, 0x9a, 0x4f, 0x0f, 0x46, 0x73, 0xd5, 0x65, 0xb1, 0x38, 0xae, 0xba, 0x4c, 0x57,
+	0xaa, 0xe8, 0xfb, 0x30, 0x6d, 0x11, 0x87, 0x34, 0x05, 0x73, 0x74, 0x07, 0xfb, 0x84, 0xe6, 0x52,
+	0x02, 0xee, 0xea, 0xb1, 0x93, 0x43, 0xcf, 0x86, 0x50, 0x35, 0x81, 0x84, 0x36, 0x20, 0x63, 0x45,
+	0xe1, 0x94, 0x1b, 0x13, 0x64, 0x3e, 0x3f, 0x6c, 0x8e, 0xb1, 0xc8, 0x8b, 0x57, 0x9e, 0x38, 0x04,
+	0x8f, 0xa0, 0x8e, 0x5b, 0xf7, 0x5c, 0xcb, 0x76, 0x9b, 0xc6, 0x0e, 0xb1, 0x9b, 0x3b, 0x2c, 0x37,
+	0x3e, 0xaf, 0x5d, 0x1e, 0xd5, 0xb3, 0x61, 0xff, 0x75, 0xd1, 0x8d, 0x36, 0x60, 0x2a, 0x12, 0x15,
+	0x19, 0x92, 0x3e, 0x6e, 0x86, 0x4c, 0x86, 0x00, 0x5c, 0x04, 0xbd, 0x05, 0x10, 0xe5, 0x60, 0x0e,
+	0x04, 0x5a, 0xe1, 0xf0, 0x6c, 0x8e, 0x4f, 0x26, 0x06, 0x80, 0x5c, 0x38, 0xd9, 0xb2, 0x5d, 0x83,
+	0x12, 0xa7, 0x61, 0x28, 0xe6, 0x38, 0x6e, 0x46, 0xd0, 0xff, 0xda, 0x31, 0x56, 0xf3, 0xb3, 0xbb,
+	0x0b, 0x59, 0xd9, 0x5a, 0xa0, 0xd6, 0xee, 0xfc, 0xcb, 0xc5, 0x57, 0xae, 0xe9, 0x33, 0x2d, 0xdb,
+	0xad, 0x11, 0xa7, 0x51, 0x09, 0x81, 0xd1, 0xab, 0x70, 0x3e, 0x22, 0xc4, 0x73, 0x8d, 0x1d, 0xcf,
+	0xb1, 0x0c, 0x9f, 0x34, 0x0c, 0xd3, 0xeb, 0xb8, 0x2c, 0x37, 0x21, 0x68, 0x3c, 0x1b, 0x8a, 0xdc,
+	0x74, 0xaf, 0x7b, 0x8e, 0xa5, 0x93, 0xc6, 0x0a, 0x1f, 0x46, 0xcf, 0x43, 0xc4, 0x86, 0x61, 0x5b,
+	0x34, 0x37, 0x39, 0x3f, 0x7a, 0x39, 0xa9, 0x4f, 0x84, 0x9d, 0x55, 0x8b, 0x2e, 0x8d, 0xbf, 0x7f,
+	0x67, 0x6e, 0xe4, 0x8b, 0x3b, 0x73, 0x23, 0x85, 0x35, 0x98, 0xd8, 0xc6, 0x8e, 0x4a, 0x2d, 0x42,
+	0xd1, 0x35, 0x48, 0xe3, 0xa0, 0x91, 0xd3, 0xe6, 0x47, 0x1f, 0x9b, 0x9a, 0x91, 0x68, 0xe1, 0x23,
+	0x0d, 0x52, 0x95, 0xed, 0x0d, 0x6c, 0xfb, 0x68, 0x15, 0x66, 0xa2, 0x58, 0x3d, 0x6a, 0x96, 0x47,
+	0xe1, 0x1d, 0xa4, 0xf9, 0x3a, 0xcc, 0xec, 0x05, 0x85, 0x23, 0x84, 0x91, 0x5b, 0xcd, 0xc5, 0xfb,
+	0x77, 0x17, 0x2e, 0x28, 0x98, 0xb0, 0xb8, 0xf4, 0xe1, 0xed, 0xf5, 0xf5, 0xc7, 0xe6, 0xfc, 0x26,
+	0x8c, 0x49, 0x57, 0x29, 0x7a, 0x1d, 0x4e, 0xb4, 0xf9, 0x1f, 0x31, 0xd5, 0xcc, 0xe2, 0xec, 0xd0,
+	0x98, 0x17, 0xf2, 0xf1, 0x08, 0x91, 0x7a, 0x85, 0x9f, 0x26, 0x00, 0x2a, 0xdb, 0xdb, 0x9b, 0xbe,
+	0xdd, 0x76, 0x08, 0x7b, 0x56, 0x73, 0xdf, 0x82, 0xd3, 0xd1, 0xdc, 0xa9, 0x6f, 0x1e, 0x7f, 0xfe,
+	0x27, 0x43, 0xfd, 0x9a, 0x6f, 0x1e, 0x08, 0x6b, 0x51, 0x16, 0xc2, 0x8e, 0x1e, 0x1f, 0xb6, 0x42,
+	0xd9, 0x20, 0xb3, 0xdf, 0x81, 0x4c, 0x44, 0x06, 0x45, 0x55, 0x18, 0x67, 0xea, 0xbf, 0x22, 0xb8,
+	0x30, 0x9c, 0xe0, 0x40, 0x2d, 0x4e, 0x72, 0xa8, 0x5e, 0xf8, 0xb7, 0x06, 0x10, 0xcb, 0x91, 0x2f,
+	0x67, 0x8c, 0xa1, 0x2a, 0xa4, 0x54, 0x71, 0x1e, 0x7d, 0xd2, 0xe2, 0xac, 0x00, 0x62, 0xa4, 0xfe,
+	0x2c, 0x01, 0x27, 0xb7, 0x82, 0xec, 0xfd, 0xf2, 0x73, 0xb0, 0x05, 0x63, 0xc4, 0x65, 0xbe, 0x2d,
+	0x48, 0xe0, 0x6b, 0xfe, 0xf2, 0xb0, 0x35, 0x3f, 0x60, 0x52, 0xab, 0x2e, 0xf3, 0xbb, 0xf1, 0x08,
+	0x08, 0xb0, 0x62, 0x7c, 0xfc, 0x6a, 0x14, 0x72, 0xc3, 0x54, 0xd1, 0x0b, 0x90, 0x35, 0x7d, 0x22,
+	0x3a, 0x82, 0x7d, 0x47, 0x13, 0x05, 0x73, 0x2a, 0xe8, 0x56, 0xdb, 0x8e, 0x0e, 0xfc, 0xa0, 0xc6,
+	0x83, 0x8b, 0x8b, 0x3e, 0xd9, 0xc9, 0x6c, 0x2a, 0x42, 0x10, 0x1b, 0xcf, 0x26, 0x64, 0x6d, 0xd7,
+	0x66, 0x36, 0x76, 0x8c, 0x3a, 0x76, 0xb0, 0x6b, 0x06, 0x27, 0xd8, 0x63, 0xed, 0xf9, 0x53, 0x0a,
+	0xa3, 0x2c, 0x21, 0xd0, 0x2a, 0x8c, 0x05, 0x68, 0xc9, 0xe3, 0xa3, 0x05, 0xba, 0xe8, 0x22, 0x4c,
+	0xc4, 0x37, 0x06, 0x71, 0x1a, 0x49, 0xea, 0x99, 0xd8, 0xbe, 0x70, 0xd8, 0xce, 0x93, 0x7a, 0xec,
+	0xce, 0xa3, 0x0e, 0x7c, 0xbf, 0x1e, 0x85, 0x19, 0x9d, 0x58, 0xff, 0xfb, 0xcb, 0xb2, 0x01, 0x20,
+	0x53, 0x95, 0x57, 0xd2, 0x5c, 0xf2, 0x49, 0xf3, 0x3d, 0x2d, 0x41, 0x2a, 0x94, 0xfd, 0xb7, 0x56,
+	0xe8, 0x2f, 0x09, 0x98, 0x88, 0xaf, 0xd0, 0xff, 0xe5, 0xa6, 0x85, 0xd6, 0xa3, 0x32, 0x95, 0x14,
+	0x65, 0xea, 0xc5, 0x61, 0x65, 0x6a, 0x20, 0x9a, 0x0f, 0xa9, 0x4f, 0xbf, 0x4d, 0x41, 0x6a, 0x03,
+	0xfb, 0xb8, 0x45, 0xd1, 0xcd, 0x81, 0xb3, 0x6d, 0xf0, 0x22, 0xd0, 0x1f, 0xcc, 0x15, 0xf5, 0x02,
+	0x22, 0x63, 0xf9, 0x97, 0xc3, 0x8e, 0xb6, 0x5f, 0x81, 0x29, 0x7e, 0x47, 0x0e, 0x27, 0x24, 0xc9,
+	0x9d, 0x14, 0x57, 0xdd, 0x70, 0xf6, 0x14, 0xcd, 0x41, 0x86, 0x8b, 0x45, 0x75, 0x98, 0xcb, 0x40,
+	0x0b, 0xdf, 0x5a, 0x95, 0x3d, 0x68, 0x01, 0xd0, 0x4e, 0xf8, 0x68, 0x61, 0x44, 0x44, 0x70, 0xb9,
+	0x99, 0x68, 0x24, 0x10, 0xbf, 0x00, 0xc0, 0xbd, 0x30, 0x2c, 0xe2, 0x7a, 0x2d, 0x75, 0xd1, 0x4b,
+	0xf3, 0x9e, 0x0a, 0xef, 0x40, 0x3f, 0xd1, 0xe4, 0x11, 0xb9, 0xef, 0x26, 0xad, 0x6e, 0x28, 0x9b,
+	0x47, 0x48, 0x8a, 0x7f, 0x3d, 0x98, 0xcb, 0x77, 0x71, 0xcb, 0x59, 0x2a, 0x1c, 0x80, 0x53, 0x38,
+	0xe8, 0x72, 0xcf, 0x0f, 0xce, 0xbd, 0x37, 0x71, 0xf4, 0xe3, 0x78, 0xa4, 0x08, 0x7f, 0x1b, 0xd8,
+	0x64, 0x9e, 0x2f, 0x2e, 0x34, 0xe9, 0x72, 0xf5, 0xd8, 0xc9, 0x39, 0x70, 0x60, 0xff, 0xc6, 0x2b,
+	0xd2, 0x78, 0x14, 0x51, 0xfc, 0xc2, 0xb7, 0x26, 0xac, 0x70, 0x16, 0xce, 0x35, 0x1d, 0xaf, 0x8e,
+	0x1d, 0xc3, 0xb1, 0xdf, 0xed, 0xd8, 0x96, 0xa1, 0x22, 0xc9, 0x30, 0x71, 0x3b, 0x37, 0xfe, 0xac,
+	0x7d, 0x38, 0x23, 0x6d, 0xdd, 0x10, 0xa6, 0x6a, 0xd2, 0xd2, 0x0a, 0x6e, 0xa3, 0x7d, 0x0d, 0x9e,
+	0x8b, 0x68, 0x38, 0xc0, 0x93, 0xf4, 0xb3, 0xf6, 0xe4, 0x5c, 0x68, 0xae, 0xdf, 0x99, 0xa5, 0x4b,
+	0xbc, 0xe2, 0xec, 0x7f, 0xfe, 0xf1, 0x95, 0xf3, 0x91, 0x66, 0xe9, 0x56, 0xf8, 0xce, 0x28, 0xd3,
+	0xa4, 0xf0, 0x27, 0x0d, 0x4e, 0x6e, 0xf2, 0x8b, 0xae, 0xfd, 0x23, 0x22, 0xae, 0xa4, 0x3a, 0x31,
+	0x3d, 0xdf, 0x42, 0x53, 0x90, 0xb0, 0x2d, 0x91, 0x32, 0x49, 0x3d, 0x61, 0x5b, 0xa8, 0x08, 0x27,
+	0xbc, 0xf7, 0x5c, 0xe2, 0xe7, 0x12, 0x87, 0x14, 0x27, 0x29, 0x26, 0xb2, 0xc5, 0xb3, 0x3a, 0x0e,
+	0x31, 0xb0, 0x29, 0x0b, 0xa4, 0x7c, 0xe8, 0x98, 0x94, 0xbd, 0xcb, 0xb2, 0x13, 0xbd, 0x0e, 0xe9,
+	0x70, 0x06, 0xb9, 0xe4, 0x51, 0xab, 0x4a, 0xa4, 0xb3, 0x74, 0x96, 0xcf, 0xf2, 0xfe, 0x20, 0x3d,
+	0x85, 0x4f, 0x12, 0x90, 0x95, 0x9c, 0x84, 0x20, 0xe8, 0xc6, 0xd0, 0x37, 0x90, 0x23, 0x18, 0x1d,
+	0x78, 0x0c, 0xf9, 0x1e, 0x4c, 0x06, 0x4b, 0x2c, 0x0f, 0x9e, 0x4f, 0xf7, 0x18, 0x37, 0x21, 0xc1,
+	0xd4, 0xbb, 0xc0, 0x3b, 0x03, 0x09, 0xd5, 0x73, 0xba, 0x7d, 0x52, 0x23, 0xbd, 0xd9, 0x23, 0x6d,
+	0x0d, 0xe7, 0xf0, 0x23, 0x0d, 0x50, 0x74, 0xca, 0xd3, 0x09, 0x6d, 0x7b, 0x2e, 0x15, 0x97, 0xfc,
+	0xd8, 0x65, 0x5c, 0x7b, 0xfc, 0x25, 0x3f, 0xd2, 0xef, 0xb9, 0xe4, 0xc7, 0xf6, 0xc0, 0xd7, 0xa2,
+	0x43, 0x56, 0x22, 0x7c, 0xb4, 0x15, 0x58, 0x75, 0x4c, 0x49, 0xec, 0xb5, 0xc0, 0xee, 0x81, 0x08,
+	0x94, 0xc4, 0xd6, 0x3a, 0x52, 0xf8, 0xa3, 0x06, 0xe7, 0x06, 0xb6, 0x8b, 0xd0, 0x65, 0x13, 0x90,
+	0x1f, 0x1b, 0x14, 0x65, 0xb7, 0xab, 0x5c, 0x7f, 0xb2, 0xdd, 0x67, 0xc6, 0xef, 0x1f, 0x7d, 0x46,
+	0xa7, 0x45, 0x75, 0x54, 0xf8, 0xbd, 0x06, 0xa7, 0xe2, 0x0e, 0x84, 0x53, 0xa9, 0xc1, 0x44, 0xdc,
+	0xb4, 0x9a, 0xc4, 0xa5, 0xa3, 0x4c, 0x22, 0xee, 0x7f, 0x0f, 0x08, 0xda, 0x8e, 0xb6, 0x64, 0xf9,
+	0xf4, 0x7d, 0xf5, 0xc8, 0xa4, 0x04, 0x8e, 0x1d, 0xb8, 0x35, 0xcb, 0xb5, 0xf9, 0x87, 0x06, 0xc9,
+	0x0d, 0xcf, 0x73, 0xd0, 0xbb, 0x30, 0xe3, 0x7a, 0x4c, 0xc4, 0x33, 0xb1, 0x0c, 0xf5, 0x36, 0x27,
+	0x33, 0x70, 0xf5, 0xb1, 0x5c, 0xfd, 0xfd, 0xc1, 0xdc, 0xa0, 0x66, 0x2f, 0x81, 0xea, 0x09, 0xd8,
+	0xf5, 0x58, 0x59, 0x08, 0x89, 0xaa, 0x46, 0x51, 0x03, 0x26, 0x7b, 0xcd, 0xc9, 0x2c, 0x5d, 0x3e,
+	0xcc, 0xdc, 0xe4, 0xa1, 0xa6, 0x26, 0xea, 0x31, 0x3b, 0x4b, 0xe3, 0x7c, 0xd5, 0xfe, 0xc9, 0x57,
+	0xee, 0x6d, 0x98, 0x0e, 0x4b, 0xc8, 0x96, 0x78, 0x3f, 0xa6, 0x68, 0x0d, 0xc6, 0xe4, 0x53, 0x72,
+	0x70, 0x1b, 0xbf, 0x18, 0x7d, 0x98, 0xe0, 0x9f, 0x36, 0xf8, 0x77, 0x89, 0x3e, 0xa5, 0x1e, 0x3e,
+	0x95, 0xf2, 0x95, 0xdf, 0x69, 0x00, 0xd1, 0x43, 0x27, 0x7a, 0x09, 0xce, 0x96, 0x6f, 0xae, 0x57,
+	0x8c, 0xda, 0xe6, 0xf2, 0xe6, 0x56, 0xcd, 0xd8, 0x5a, 0xaf, 0x6d, 0xac, 0xae, 0x54, 0xd7, 0xaa,
+	0xab, 0x95, 0xe9, 0x91, 0x7c, 0x76, 0xff, 0xf6, 0x7c, 0x66, 0xcb, 0xa5, 0x6d, 0x62, 0xda, 0x0d,
+	0x9b, 0x58, 0xe8, 0xab, 0x70, 0xaa, 0x57, 0x9a, 0xb7, 0x56, 0x2b, 0xd3, 0x5a, 0x7e, 0x62, 0xff,
+	0xf6, 0xfc, 0xb8, 0xbc, 0xd8, 0x11, 0x0b, 0x5d, 0x86, 0xd3, 0x83, 0x72, 0xd5, 0xf5, 0x37, 0xa6,
+	0x13, 0xf9, 0xc9, 0xfd, 0xdb, 0xf3, 0xe9, 0xf0, 0x06, 0x88, 0x0a, 0x80, 0xe2, 0x92, 0x0a, 0x6f,
+	0x34, 0x0f, 0xfb, 0xb7, 0xe7, 0x53, 0x72, 0x19, 0xf2, 0xc9, 0xf7, 0x7f, 0x33, 0x3b, 0x72, 0xe5,
+	0x07, 0x00, 0x55, 0xb7, 0xe1, 0x63, 0x53, 0x84, 0x5b, 0x1e, 0xce, 0x54, 0xd7, 0xd7, 0xf4, 0xe5,
+	0x95, 0xcd, 0xea, 0xcd, 0xf5, 0x5e, 0xb7, 0xfb, 0xc6, 0x2a, 0x37, 0xb7, 0xca, 0x37, 0x56, 0x8d,
+	0x5a, 0xf5, 0x8d, 0xf5, 0x69, 0x0d, 0x9d, 0x85, 0x93, 0x3d, 0x63, 0xdf, 0x5e, 0xdf, 0xac, 0xbe,
+	0xb5, 0x3a, 0x9d, 0x28, 0xaf, 0x7d, 0xfa, 0x70, 0x56, 0xbb, 0xf7, 0x70, 0x56, 0xfb, 0xdb, 0xc3,
+	0x59, 0xed, 0x83, 0x47, 0xb3, 0x23, 0xf7, 0x1e, 0xcd, 0x8e, 0xfc, 0xf9, 0xd1, 0xec, 0xc8, 0xdb,
+	0x2f, 0x35, 0x6d, 0xb6, 0xd3, 0xa9, 0x73, 0xba, 0xd5, 0xd7, 0xaa, 0xd2, 0x81, 0xfb, 0xa1, 0xf8,
+	0x3c, 0x54, 0x4f, 0x89, 0x53, 0xe1, 0xd7, 0xff, 0x33, 0x00, 0xe1, 0xdf, 0x46, 0x04, 0x96, 0x1b,
+	0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1404,847 +1554,1021 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 13435 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x74, 0x63, 0xd7,
-		0x75, 0x18, 0x3c, 0x17, 0x00, 0xf1, 0xd8, 0x00, 0x81, 0xcb, 0x43, 0xce, 0x0c, 0x86, 0x23, 0x0d,
-		0x39, 0x18, 0x3d, 0x46, 0x23, 0x89, 0xa3, 0xa1, 0x46, 0x23, 0x89, 0xb2, 0xac, 0xe0, 0x35, 0x33,
-		0x18, 0x91, 0x00, 0x7d, 0x01, 0x52, 0x1a, 0xe5, 0x71, 0x73, 0x09, 0x1c, 0x92, 0x57, 0x03, 0xdc,
-		0x0b, 0xe3, 0x5e, 0x50, 0xa4, 0xb2, 0x56, 0x96, 0x9d, 0x38, 0xf9, 0x1c, 0x39, 0xce, 0xe7, 0x7c,
-		0xc9, 0x97, 0x28, 0xb6, 0xc7, 0xb1, 0x9d, 0x2f, 0xb1, 0x9d, 0xd7, 0xe7, 0x24, 0x4e, 0x1a, 0xa7,
-		0x2b, 0x6d, 0xd3, 0x26, 0x69, 0xe2, 0xb6, 0x89, 0x93, 0xb5, 0xda, 0x64, 0x65, 0xad, 0xaa, 0xad,
-		0x9d, 0xc6, 0xf2, 0x23, 0xcd, 0xa3, 0x4e, 0xeb, 0xb5, 0xdc, 0x2c, 0x77, 0x9d, 0xd7, 0x7d, 0x00,
-		0x17, 0x04, 0x38, 0xb6, 0x53, 0x37, 0xe9, 0x1f, 0x12, 0xe7, 0x9c, 0xbd, 0xf7, 0xd9, 0x67, 0x9f,
-		0x7d, 0xf6, 0xd9, 0x67, 0x9f, 0xc7, 0x85, 0xbf, 0xd5, 0x61, 0x71, 0xc7, 0x34, 0x77, 0xda, 0xf8,
-		0x62, 0xb7, 0x67, 0xda, 0xe6, 0x56, 0x7f, 0xfb, 0x62, 0x0b, 0x5b, 0xcd, 0x9e, 0xde, 0xb5, 0xcd,
-		0xde, 0x12, 0xcd, 0x43, 0x19, 0x06, 0xb1, 0x24, 0x20, 0x72, 0x9f, 0x90, 0x60, 0xe6, 0xaa, 0xde,
-		0xc6, 0x25, 0x07, 0xb2, 0x8e, 0x6d, 0xf4, 0x04, 0x44, 0xb6, 0xf5, 0x36, 0xce, 0x4a, 0x8b, 0xe1,
-		0xf3, 0xc9, 0xe5, 0x7b, 0x96, 0x06, 0xb0, 0x96, 0xfc, 0x18, 0xeb, 0x24, 0x5b, 0xa1, 0x18, 0x17,
-		0xbe, 0x23, 0xfe, 0x96, 0x2f, 0x7c, 0xe2, 0x2b, 0x92, 0xfc, 0x56, 0xf2, 0x77, 0xbe, 0x83, 0x6e,
-		0xb1, 0x34, 0xba, 0xbc, 0x44, 0xf0, 0x3c, 0xfc, 0xec, 0x5d, 0x22, 0x39, 0x2a, 0x41, 0x52, 0xdd,
-		0x6c, 0xd5, 0xc2, 0xb6, 0x8a, 0xf7, 0x6d, 0x6c, 0x58, 0xba, 0x69, 0xcc, 0x3f, 0x1c, 0x80, 0x35,
-		0xc4, 0x6d, 0x59, 0x80, 0xe7, 0x7e, 0x6b, 0x0a, 0x66, 0x03, 0x58, 0x43, 0x08, 0x22, 0x86, 0xd6,
-		0x21, 0xcd, 0x91, 0xce, 0x27, 0x14, 0xfa, 0x1b, 0x65, 0x21, 0xd6, 0xd5, 0x9a, 0xb7, 0xb4, 0x1d,
-		0x9c, 0x0d, 0xd1, 0x6c, 0x91, 0x44, 0x67, 0x00, 0x5a, 0xb8, 0x8b, 0x8d, 0x16, 0x36, 0x9a, 0x07,
-		0xd9, 0xf0, 0x62, 0xf8, 0x7c, 0x42, 0xf1, 0xe4, 0xa0, 0x07, 0x61, 0xa6, 0xdb, 0xdf, 0x6a, 0xeb,
-		0x4d, 0xd5, 0x03, 0x06, 0x8b, 0xe1, 0xf3, 0x53, 0x8a, 0xcc, 0x0a, 0x4a, 0x2e, 0xf0, 0xfd, 0x90,
-		0x79, 0x09, 0x6b, 0xb7, 0xbc, 0xa0, 0x49, 0x0a, 0x9a, 0x26, 0xd9, 0x25, 0x1f, 0x55, 0xb3, 0x6b,
-		0xeb, 0xa6, 0xe1, 0x05, 0xcd, 0xd0, 0xca, 0x65, 0x56, 0xe0, 0x01, 0x2e, 0x42, 0xaa, 0x83, 0x2d,
-		0x4b, 0xdb, 0xc1, 0xaa, 0x7d, 0xd0, 0xc5, 0xd9, 0x08, 0xed, 0xa7, 0xc5, 0xa1, 0x7e, 0x1a, 0xec,
-		0xa3, 0x24, 0xc7, 0x6a, 0x1c, 0x74, 0x31, 0xca, 0x43, 0x02, 0x1b, 0xfd, 0x0e, 0xa3, 0x30, 0x35,
-		0xa2, 0xa7, 0xcb, 0x46, 0xbf, 0x33, 0x48, 0x25, 0x4e, 0xd0, 0x38, 0x89, 0x98, 0x85, 0x7b, 0x7b,
-		0x7a, 0x13, 0x67, 0xa3, 0x94, 0xc0, 0xfd, 0x43, 0x04, 0xea, 0xac, 0x7c, 0x90, 0x86, 0xc0, 0x43,
-		0x45, 0x48, 0x38, 0xfd, 0x9d, 0x8d, 0x51, 0x22, 0xf7, 0x06, 0xe8, 0x1b, 0x6e, 0xb7, 0x06, 0x49,
-		0xb8, 0x78, 0xe8, 0x0a, 0xc4, 0x98, 0x8c, 0xac, 0x6c, 0x7c, 0x51, 0x3a, 0x9f, 0x5c, 0xbe, 0x2b,
-		0x50, 0x65, 0x6b, 0x0c, 0x46, 0x11, 0xc0, 0xa8, 0x02, 0xb2, 0x65, 0xf6, 0x7b, 0x4d, 0xac, 0x36,
-		0xcd, 0x16, 0x56, 0x75, 0x63, 0xdb, 0xcc, 0x26, 0x28, 0x81, 0x85, 0xe1, 0x86, 0x50, 0xc0, 0xa2,
-		0xd9, 0xc2, 0x15, 0x63, 0xdb, 0x54, 0xd2, 0x96, 0x2f, 0x8d, 0x4e, 0x40, 0xd4, 0x3a, 0x30, 0x6c,
-		0x6d, 0x3f, 0x9b, 0xa2, 0xea, 0xc4, 0x53, 0x68, 0x19, 0x62, 0xb8, 0xa5, 0x93, 0xea, 0xb2, 0xe9,
-		0x45, 0xe9, 0x7c, 0x7a, 0x39, 0x3b, 0x2c, 0x63, 0x56, 0xae, 0x08, 0xc0, 0xdc, 0xff, 0x88, 0x42,
-		0x66, 0x12, 0x1d, 0x7e, 0x0a, 0xa6, 0xb6, 0x89, 0x64, 0xb2, 0xa1, 0xa3, 0xc8, 0x8d, 0xe1, 0xf8,
-		0x05, 0x1f, 0xbd, 0x43, 0xc1, 0xe7, 0x21, 0x69, 0x60, 0xcb, 0xc6, 0x2d, 0xa6, 0x45, 0xe1, 0x09,
-		0xf5, 0x10, 0x18, 0xd2, 0xb0, 0x1a, 0x46, 0xee, 0x48, 0x0d, 0x9f, 0x87, 0x8c, 0xc3, 0x92, 0xda,
-		0xd3, 0x8c, 0x1d, 0xa1, 0xcf, 0x17, 0xc7, 0x71, 0xb2, 0xe4, 0x18, 0x0f, 0x85, 0xa0, 0x29, 0x69,
-		0xec, 0x4b, 0xa3, 0x12, 0x80, 0x69, 0x60, 0x73, 0x5b, 0x6d, 0xe1, 0x66, 0x3b, 0x1b, 0x1f, 0x21,
-		0xa5, 0x1a, 0x01, 0x19, 0x92, 0x92, 0xc9, 0x72, 0x9b, 0x6d, 0xf4, 0xa4, 0xab, 0x9e, 0xb1, 0x11,
-		0xda, 0xb5, 0xc6, 0x06, 0xe6, 0x90, 0x86, 0x6e, 0x40, 0xba, 0x87, 0xc9, 0x58, 0xc1, 0x2d, 0xde,
-		0xb2, 0x04, 0x65, 0x62, 0x69, 0x6c, 0xcb, 0x14, 0x8e, 0xc6, 0x1a, 0x36, 0xdd, 0xf3, 0x26, 0xd1,
-		0x39, 0x70, 0x32, 0x54, 0xaa, 0x56, 0x40, 0x2d, 0x4d, 0x4a, 0x64, 0x56, 0x89, 0x7a, 0xe5, 0x01,
-		0xf6, 0x74, 0x4b, 0xdf, 0xd2, 0xdb, 0xba, 0x4d, 0xcc, 0x16, 0xd1, 0xde, 0xb3, 0xc3, 0xe3, 0xe2,
-		0xa0, 0xb3, 0x65, 0xb6, 0x37, 0x1d, 0x40, 0xc5, 0x83, 0x34, 0xff, 0x32, 0xa4, 0xfd, 0x12, 0x46,
-		0x73, 0x30, 0x65, 0xd9, 0x5a, 0xcf, 0xa6, 0x8a, 0x3c, 0xa5, 0xb0, 0x04, 0x92, 0x21, 0x8c, 0x8d,
-		0x16, 0xb5, 0xc4, 0x53, 0x0a, 0xf9, 0x89, 0xbe, 0xc9, 0x95, 0x59, 0x98, 0xca, 0xec, 0xbe, 0x61,
-		0xa5, 0xf0, 0x51, 0x1e, 0x14, 0xdd, 0xfc, 0xe3, 0x30, 0xed, 0x93, 0xc1, 0xa4, 0x55, 0xe7, 0xfe,
-		0x75, 0x04, 0x8e, 0x07, 0xd2, 0x46, 0xcf, 0xc3, 0x5c, 0xdf, 0xd0, 0x0d, 0x1b, 0xf7, 0xba, 0x3d,
-		0x4c, 0xb4, 0x9e, 0xd5, 0x95, 0xfd, 0x4c, 0x6c, 0x84, 0xde, 0x6e, 0x78, 0xa1, 0x19, 0x15, 0x65,
-		0xb6, 0x3f, 0x9c, 0x89, 0x6e, 0x42, 0x92, 0xa8, 0x98, 0xd6, 0xd3, 0x28, 0x41, 0x36, 0xa0, 0x97,
-		0x27, 0x6b, 0xf2, 0x52, 0xc9, 0xc5, 0x2c, 0x84, 0xdf, 0x2e, 0x85, 0x14, 0x2f, 0x2d, 0xf4, 0x38,
-		0xc4, 0xb7, 0xb1, 0x66, 0xf7, 0x7b, 0xd8, 0xca, 0x2e, 0x53, 0x51, 0x9e, 0x1e, 0x1e, 0xe7, 0x0c,
-		0xa0, 0x8e, 0x6d, 0xc5, 0x01, 0x46, 0x1d, 0x48, 0xed, 0xe1, 0x9e, 0xbe, 0xad, 0x37, 0x19, 0x53,
-		0x61, 0xaa, 0x01, 0x4f, 0x4c, 0xc8, 0xd4, 0xa6, 0x07, 0xb5, 0x6e, 0x6b, 0x36, 0x5e, 0x81, 0x8d,
-		0xea, 0x66, 0x59, 0xa9, 0x5c, 0xad, 0x94, 0x4b, 0x8c, 0x4d, 0x1f, 0xf9, 0xf9, 0x1f, 0x96, 0x20,
-		0xe9, 0x69, 0x09, 0xb1, 0xa8, 0x46, 0xbf, 0xb3, 0x85, 0x7b, 0xbc, 0xbf, 0x78, 0x0a, 0x9d, 0x86,
-		0xc4, 0x76, 0xbf, 0xdd, 0x66, 0x7a, 0xcb, 0xe6, 0xee, 0x38, 0xc9, 0xa0, 0x3a, 0x8b, 0x20, 0xc2,
-		0x2d, 0x11, 0x35, 0x93, 0xe4, 0x37, 0x9a, 0x87, 0xb8, 0xd0, 0xeb, 0xec, 0xd4, 0xa2, 0x74, 0x3e,
-		0xae, 0x38, 0x69, 0x56, 0xd6, 0xc5, 0x9a, 0x8d, 0x5b, 0xd9, 0xa8, 0x28, 0x63, 0xe9, 0x1b, 0x91,
-		0x78, 0x44, 0x9e, 0xca, 0x5d, 0x86, 0x99, 0xa1, 0xa6, 0xa0, 0x0c, 0x24, 0x4b, 0xe5, 0xe2, 0x6a,
-		0x5e, 0xc9, 0x37, 0x2a, 0xb5, 0xaa, 0x7c, 0x0c, 0xa5, 0xc1, 0xd3, 0x3a, 0x59, 0xba, 0x90, 0x88,
-		0xbf, 0x1e, 0x93, 0xdf, 0xf2, 0x96, 0xb7, 0xbc, 0x25, 0x94, 0xfb, 0x8d, 0x28, 0xcc, 0x05, 0xd9,
-		0xd1, 0x40, 0x93, 0xee, 0x36, 0x3a, 0xec, 0x6b, 0x74, 0x1e, 0xa6, 0xda, 0xda, 0x16, 0x6e, 0x67,
-		0x23, 0xb4, 0x13, 0x1e, 0x9c, 0xc8, 0x52, 0x2f, 0xad, 0x12, 0x14, 0x85, 0x61, 0xa2, 0x37, 0x72,
-		0xd1, 0x4c, 0x51, 0x0a, 0x17, 0x26, 0xa3, 0x40, 0xec, 0x2b, 0x17, 0xe3, 0x69, 0x48, 0x90, 0xff,
-		0x4c, 0xee, 0x51, 0x26, 0x77, 0x92, 0x41, 0xe5, 0x3e, 0x0f, 0x71, 0x6a, 0x3a, 0x5b, 0xd8, 0xe9,
-		0x13, 0x91, 0x26, 0xc6, 0xa6, 0x85, 0xb7, 0xb5, 0x7e, 0xdb, 0x56, 0xf7, 0xb4, 0x76, 0x1f, 0x53,
-		0x23, 0x98, 0x50, 0x52, 0x3c, 0x73, 0x93, 0xe4, 0xa1, 0x05, 0x48, 0x32, 0x4b, 0xab, 0x1b, 0x2d,
-		0xbc, 0x4f, 0x67, 0xe1, 0x29, 0x85, 0x19, 0xdf, 0x0a, 0xc9, 0x21, 0xd5, 0xbf, 0x68, 0x99, 0x86,
-		0x30, 0x57, 0xb4, 0x0a, 0x92, 0x41, 0xab, 0x7f, 0x7c, 0xd0, 0x01, 0xb8, 0x3b, 0xb8, 0x79, 0x43,
-		0xf6, 0xf5, 0x7e, 0xc8, 0x50, 0x88, 0x47, 0xf9, 0x50, 0xd6, 0xda, 0xd9, 0x19, 0xaa, 0x06, 0x69,
-		0x96, 0x5d, 0xe3, 0xb9, 0xb9, 0x5f, 0x09, 0x41, 0x84, 0x4e, 0x36, 0x19, 0x48, 0x36, 0x6e, 0xae,
-		0x97, 0xd5, 0x52, 0x6d, 0xa3, 0xb0, 0x5a, 0x96, 0x25, 0xd2, 0xf5, 0x34, 0xe3, 0xea, 0x6a, 0x2d,
-		0xdf, 0x90, 0x43, 0x4e, 0xba, 0x52, 0x6d, 0x5c, 0xb9, 0x2c, 0x87, 0x1d, 0x84, 0x0d, 0x96, 0x11,
-		0xf1, 0x02, 0x3c, 0xba, 0x2c, 0x4f, 0x21, 0x19, 0x52, 0x8c, 0x40, 0xe5, 0xf9, 0x72, 0xe9, 0xca,
-		0x65, 0x39, 0xea, 0xcf, 0x79, 0x74, 0x59, 0x8e, 0xa1, 0x69, 0x48, 0xd0, 0x9c, 0x42, 0xad, 0xb6,
-		0x2a, 0xc7, 0x1d, 0x9a, 0xf5, 0x86, 0x52, 0xa9, 0x5e, 0x93, 0x13, 0x0e, 0xcd, 0x6b, 0x4a, 0x6d,
-		0x63, 0x5d, 0x06, 0x87, 0xc2, 0x5a, 0xb9, 0x5e, 0xcf, 0x5f, 0x2b, 0xcb, 0x49, 0x07, 0xa2, 0x70,
-		0xb3, 0x51, 0xae, 0xcb, 0x29, 0x1f, 0x5b, 0x8f, 0x2e, 0xcb, 0xd3, 0x4e, 0x15, 0xe5, 0xea, 0xc6,
-		0x9a, 0x9c, 0x46, 0x33, 0x30, 0xcd, 0xaa, 0x10, 0x4c, 0x64, 0x06, 0xb2, 0xae, 0x5c, 0x96, 0x65,
-		0x97, 0x11, 0x46, 0x65, 0xc6, 0x97, 0x71, 0xe5, 0xb2, 0x8c, 0x72, 0x45, 0x98, 0xa2, 0x6a, 0x88,
-		0x10, 0xa4, 0x57, 0xf3, 0x85, 0xf2, 0xaa, 0x5a, 0x5b, 0x27, 0x83, 0x26, 0xbf, 0x2a, 0x4b, 0x6e,
-		0x9e, 0x52, 0x5e, 0x2f, 0xe7, 0x1b, 0xe5, 0x92, 0x1c, 0xf6, 0xe6, 0xbd, 0x69, 0xa3, 0xa2, 0x94,
-		0x4b, 0x72, 0x28, 0xd7, 0x84, 0xb9, 0xa0, 0x49, 0x36, 0x70, 0x08, 0x79, 0x74, 0x21, 0x34, 0x42,
-		0x17, 0x28, 0xad, 0x41, 0x5d, 0xc8, 0xfd, 0x54, 0x18, 0x66, 0x03, 0x1c, 0x8d, 0xc0, 0x4a, 0x9e,
-		0x81, 0x29, 0xa6, 0xcb, 0xcc, 0x52, 0x3f, 0x10, 0xe8, 0xb1, 0x50, 0xcd, 0x1e, 0x72, 0xbf, 0x28,
-		0x9e, 0xd7, 0x65, 0x0d, 0x8f, 0x70, 0x59, 0x09, 0x89, 0x21, 0x85, 0xfd, 0xd6, 0x21, 0x87, 0x80,
-		0xf9, 0x4c, 0x57, 0x26, 0xf1, 0x99, 0x68, 0xde, 0xd1, 0x1c, 0x83, 0xa9, 0xb1, 0x8e, 0x41, 0xf4,
-		0x4e, 0x1c, 0x83, 0xa7, 0x60, 0x66, 0x88, 0x97, 0x89, 0x27, 0xe8, 0xef, 0x96, 0x20, 0x3b, 0x4a,
-		0xbe, 0x63, 0xac, 0x6a, 0xc8, 0x67, 0x55, 0x9f, 0x1a, 0xec, 0x84, 0xb3, 0xa3, 0xfb, 0x71, 0x48,
-		0x5d, 0x3e, 0x24, 0xc1, 0x89, 0xe0, 0xd5, 0x4d, 0x20, 0x0f, 0x6f, 0x84, 0x68, 0x07, 0xdb, 0xbb,
-		0xa6, 0xf0, 0xd6, 0xef, 0x0b, 0xf0, 0x01, 0x49, 0xf1, 0xa0, 0xbe, 0x70, 0x2c, 0xaf, 0x13, 0x19,
-		0x1e, 0xb5, 0x44, 0x61, 0xdc, 0x0c, 0x71, 0xfa, 0x7d, 0x21, 0x38, 0x1e, 0x48, 0x3c, 0x90, 0xd1,
-		0xbb, 0x01, 0x74, 0xa3, 0xdb, 0xb7, 0x99, 0x47, 0xce, 0x8c, 0x79, 0x82, 0xe6, 0x50, 0xfb, 0x47,
-		0x0c, 0x75, 0xdf, 0x76, 0xca, 0xd9, 0x44, 0x0b, 0x2c, 0x8b, 0x02, 0x3c, 0xe1, 0x32, 0x1a, 0xa1,
-		0x8c, 0x9e, 0x19, 0xd1, 0xd2, 0x21, 0xdd, 0x7e, 0x04, 0xe4, 0x66, 0x5b, 0xc7, 0x86, 0xad, 0x5a,
-		0x76, 0x0f, 0x6b, 0x1d, 0xdd, 0xd8, 0x61, 0x13, 0xf6, 0xca, 0xd4, 0xb6, 0xd6, 0xb6, 0xb0, 0x92,
-		0x61, 0xc5, 0x75, 0x51, 0x4a, 0x30, 0xa8, 0x02, 0xf5, 0x3c, 0x18, 0x51, 0x1f, 0x06, 0x2b, 0x76,
-		0x30, 0x72, 0x3f, 0x97, 0x80, 0xa4, 0x67, 0x2d, 0x88, 0xce, 0x42, 0xea, 0x45, 0x6d, 0x4f, 0x53,
-		0x45, 0x30, 0x80, 0x49, 0x22, 0x49, 0xf2, 0xd6, 0x79, 0x40, 0xe0, 0x11, 0x98, 0xa3, 0x20, 0x66,
-		0xdf, 0xc6, 0x3d, 0xb5, 0xd9, 0xd6, 0x2c, 0x8b, 0x0a, 0x2d, 0x4e, 0x41, 0x11, 0x29, 0xab, 0x91,
-		0xa2, 0xa2, 0x28, 0x41, 0x8f, 0xc1, 0x2c, 0xc5, 0xe8, 0xf4, 0xdb, 0xb6, 0xde, 0x6d, 0x63, 0x1a,
-		0xe6, 0xb0, 0xe8, 0xac, 0xe5, 0x70, 0x36, 0x43, 0x20, 0xd6, 0x38, 0x00, 0xe1, 0xc8, 0x42, 0x25,
-		0xb8, 0x9b, 0xa2, 0xed, 0x60, 0x03, 0xf7, 0x34, 0x1b, 0xab, 0xf8, 0xcd, 0x7d, 0xad, 0x6d, 0xa9,
-		0x9a, 0xd1, 0x52, 0x77, 0x35, 0x6b, 0x37, 0x3b, 0x47, 0x08, 0x14, 0x42, 0x59, 0x49, 0x39, 0x45,
-		0x00, 0xaf, 0x71, 0xb8, 0x32, 0x05, 0xcb, 0x1b, 0xad, 0xeb, 0x9a, 0xb5, 0x8b, 0x56, 0xe0, 0x04,
-		0xa5, 0x62, 0xd9, 0x3d, 0xdd, 0xd8, 0x51, 0x9b, 0xbb, 0xb8, 0x79, 0x4b, 0xed, 0xdb, 0xdb, 0x4f,
-		0x64, 0x4f, 0x7b, 0xeb, 0xa7, 0x1c, 0xd6, 0x29, 0x4c, 0x91, 0x80, 0x6c, 0xd8, 0xdb, 0x4f, 0xa0,
-		0x3a, 0xa4, 0x48, 0x67, 0x74, 0xf4, 0x97, 0xb1, 0xba, 0x6d, 0xf6, 0xe8, 0x34, 0x9c, 0x0e, 0xb0,
-		0x6e, 0x1e, 0x09, 0x2e, 0xd5, 0x38, 0xc2, 0x9a, 0xd9, 0xc2, 0x2b, 0x53, 0xf5, 0xf5, 0x72, 0xb9,
-		0xa4, 0x24, 0x05, 0x95, 0xab, 0x66, 0x8f, 0x28, 0xd4, 0x8e, 0xe9, 0x08, 0x38, 0xc9, 0x14, 0x6a,
-		0xc7, 0x14, 0xe2, 0x7d, 0x0c, 0x66, 0x9b, 0x4d, 0xd6, 0x66, 0xbd, 0xa9, 0xf2, 0xb8, 0x80, 0x95,
-		0x95, 0x7d, 0xc2, 0x6a, 0x36, 0xaf, 0x31, 0x00, 0xae, 0xe3, 0x16, 0x7a, 0x12, 0x8e, 0xbb, 0xc2,
-		0xf2, 0x22, 0xce, 0x0c, 0xb5, 0x72, 0x10, 0xf5, 0x31, 0x98, 0xed, 0x1e, 0x0c, 0x23, 0x22, 0x5f,
-		0x8d, 0xdd, 0x83, 0x41, 0xb4, 0x7b, 0x69, 0x60, 0xa8, 0x87, 0x9b, 0xd4, 0x5b, 0x3c, 0xe9, 0x85,
-		0xf6, 0x14, 0xa0, 0x25, 0x90, 0x9b, 0x4d, 0x15, 0x1b, 0xda, 0x56, 0x1b, 0xab, 0x5a, 0x0f, 0x1b,
-		0x9a, 0x95, 0x5d, 0xa0, 0xc0, 0x11, 0xbb, 0xd7, 0xc7, 0x4a, 0xba, 0xd9, 0x2c, 0xd3, 0xc2, 0x3c,
-		0x2d, 0x43, 0x17, 0x60, 0xc6, 0xdc, 0x7a, 0xb1, 0xc9, 0x14, 0x4b, 0xed, 0xf6, 0xf0, 0xb6, 0xbe,
-		0x9f, 0xbd, 0x87, 0x4a, 0x29, 0x43, 0x0a, 0xa8, 0x5a, 0xad, 0xd3, 0x6c, 0xf4, 0x00, 0xc8, 0x4d,
-		0x6b, 0x57, 0xeb, 0x75, 0xa9, 0x71, 0xb6, 0xba, 0x5a, 0x13, 0x67, 0xef, 0x65, 0xa0, 0x2c, 0xbf,
-		0x2a, 0xb2, 0x89, 0x62, 0x5b, 0x2f, 0xe9, 0xdb, 0xb6, 0xa0, 0x78, 0x3f, 0x53, 0x6c, 0x9a, 0xc7,
-		0xa9, 0x9d, 0x07, 0xb9, 0xbb, 0xdb, 0xf5, 0x57, 0x7c, 0x9e, 0x82, 0xa5, 0xbb, 0xbb, 0x5d, 0x6f,
-		0xbd, 0xe7, 0x60, 0x9a, 0x40, 0xba, 0x95, 0x3e, 0xc0, 0x5c, 0xb8, 0xee, 0xae, 0xa7, 0xc6, 0xcb,
-		0x70, 0x82, 0x00, 0x75, 0xb0, 0xad, 0xb5, 0x34, 0x5b, 0xf3, 0x40, 0x3f, 0x44, 0xa1, 0xe7, 0xba,
-		0xbb, 0xdd, 0x35, 0x5e, 0xe8, 0xe3, 0xb3, 0xd7, 0xdf, 0x3a, 0x70, 0xf4, 0xe3, 0x61, 0xc6, 0x27,
-		0xc9, 0x13, 0x1a, 0x72, 0xc7, 0x2b, 0x98, 0xaf, 0xdb, 0x7a, 0x2d, 0xb7, 0x02, 0x29, 0xaf, 0xde,
-		0xa3, 0x04, 0x30, 0xcd, 0x97, 0x25, 0xe2, 0x47, 0x15, 0x6b, 0x25, 0xe2, 0x01, 0xbd, 0x50, 0x96,
-		0x43, 0xc4, 0x13, 0x5b, 0xad, 0x34, 0xca, 0xaa, 0xb2, 0x51, 0x6d, 0x54, 0xd6, 0xca, 0x72, 0xd8,
-		0xb3, 0x36, 0xb8, 0x11, 0x89, 0x5f, 0x90, 0x1f, 0xbc, 0x11, 0x89, 0xdf, 0x27, 0xdf, 0x4f, 0xc5,
-		0x33, 0xa4, 0x94, 0xb9, 0x2f, 0x86, 0x21, 0xed, 0x0f, 0x0e, 0xa0, 0x37, 0xc0, 0x49, 0x11, 0xfd,
-		0xb3, 0xb0, 0xad, 0xbe, 0xa4, 0xf7, 0xe8, 0x60, 0xed, 0x68, 0x6c, 0xe2, 0x74, 0x94, 0x72, 0x8e,
-		0x43, 0xd5, 0xb1, 0xfd, 0x9c, 0xde, 0x23, 0x43, 0xb1, 0xa3, 0xd9, 0x68, 0x15, 0x16, 0x0c, 0x53,
-		0xb5, 0x6c, 0xcd, 0x68, 0x69, 0xbd, 0x96, 0x37, 0xf6, 0xaa, 0x35, 0x9b, 0xd8, 0xb2, 0x4c, 0x36,
-		0x49, 0x3a, 0x54, 0xee, 0x32, 0xcc, 0x3a, 0x07, 0x76, 0x67, 0x8f, 0x3c, 0x07, 0x1d, 0x18, 0x13,
-		0xe1, 0x51, 0x63, 0xe2, 0x34, 0x24, 0x3a, 0x5a, 0x57, 0xc5, 0x86, 0xdd, 0x3b, 0xa0, 0xee, 0x7f,
-		0x5c, 0x89, 0x77, 0xb4, 0x6e, 0x99, 0xa4, 0xd1, 0x26, 0xdc, 0xe7, 0x82, 0xaa, 0x6d, 0xbc, 0xa3,
-		0x35, 0x0f, 0x54, 0xea, 0xeb, 0xd3, 0x48, 0x95, 0xda, 0x34, 0x8d, 0xed, 0xb6, 0xde, 0xb4, 0x2d,
-		0x6a, 0x3b, 0x98, 0xfd, 0xcb, 0xb9, 0x18, 0xab, 0x14, 0xe1, 0x86, 0x65, 0x1a, 0xd4, 0xc5, 0x2f,
-		0x0a, 0x68, 0x9f, 0xda, 0xa4, 0xbe, 0x21, 0xd4, 0xc6, 0xdf, 0xf5, 0x11, 0x79, 0xea, 0x46, 0x24,
-		0x3e, 0x25, 0x47, 0x6f, 0x44, 0xe2, 0x51, 0x39, 0x76, 0x23, 0x12, 0x8f, 0xcb, 0x89, 0x1b, 0x91,
-		0x78, 0x42, 0x86, 0xdc, 0xed, 0x69, 0x48, 0x79, 0x57, 0x2c, 0x64, 0x01, 0xd8, 0xa4, 0x13, 0xae,
-		0x44, 0x4d, 0xf2, 0xb9, 0x43, 0xd7, 0x37, 0x4b, 0x45, 0x32, 0x13, 0xaf, 0x44, 0xd9, 0xf2, 0x40,
-		0x61, 0x98, 0xc4, 0x0b, 0x22, 0x83, 0x0c, 0x33, 0x5f, 0x2a, 0xae, 0xf0, 0x14, 0xba, 0x06, 0xd1,
-		0x17, 0x2d, 0x4a, 0x9b, 0xb9, 0x72, 0xf7, 0x1c, 0x4e, 0xfb, 0x46, 0x9d, 0x12, 0x4f, 0xdc, 0xa8,
-		0xab, 0xd5, 0x9a, 0xb2, 0x96, 0x5f, 0x55, 0x38, 0x3a, 0x3a, 0x05, 0x91, 0xb6, 0xf6, 0xf2, 0x81,
-		0x7f, 0xce, 0xa6, 0x59, 0x68, 0x09, 0x32, 0x7d, 0x83, 0x2d, 0xf7, 0x49, 0x1f, 0x13, 0xa8, 0x8c,
-		0x17, 0x2a, 0xed, 0x96, 0xae, 0x12, 0xf8, 0x09, 0xf5, 0xea, 0x14, 0x44, 0x5e, 0xc2, 0xda, 0x2d,
-		0xff, 0xcc, 0x4a, 0xb3, 0xd0, 0x79, 0x48, 0xb5, 0xf0, 0x56, 0x7f, 0x47, 0xed, 0xe1, 0x96, 0xd6,
-		0xb4, 0xfd, 0xf3, 0x49, 0x92, 0x16, 0x29, 0xb4, 0x04, 0x3d, 0x0b, 0x09, 0xd2, 0x47, 0x06, 0xed,
-		0xe3, 0x19, 0x2a, 0x82, 0x87, 0x0f, 0x17, 0x01, 0xef, 0x62, 0x81, 0xa4, 0xb8, 0xf8, 0xe8, 0x3a,
-		0xc4, 0x6c, 0xad, 0xb7, 0x83, 0x6d, 0x2b, 0x3b, 0xbb, 0x18, 0x3e, 0x9f, 0x0e, 0x88, 0xd4, 0x05,
-		0x90, 0x6a, 0x50, 0x14, 0xba, 0xd8, 0x16, 0xe8, 0xe8, 0x39, 0x90, 0x79, 0x40, 0x58, 0xe5, 0x2b,
-		0x65, 0x2b, 0x3b, 0x47, 0x15, 0xf0, 0xa1, 0xc3, 0x49, 0xf2, 0x78, 0x72, 0x89, 0x21, 0x29, 0x19,
-		0xec, 0x4b, 0xfb, 0xc7, 0xc5, 0xf1, 0xa3, 0x8c, 0x8b, 0x0d, 0xc8, 0xf0, 0xdf, 0xaa, 0xd5, 0xef,
-		0x76, 0xcd, 0x9e, 0x9d, 0x3d, 0x41, 0xf1, 0xc7, 0x30, 0x24, 0x88, 0x31, 0x1c, 0x25, 0xbd, 0xed,
-		0x4b, 0x7f, 0xfd, 0x86, 0xdb, 0xfc, 0x0b, 0x90, 0xf6, 0x0b, 0xc3, 0x1b, 0x8e, 0x0f, 0x4f, 0x18,
-		0x8e, 0x27, 0xcb, 0x12, 0xb1, 0xd6, 0x23, 0x53, 0x13, 0x4b, 0xcc, 0xff, 0x48, 0x08, 0xd2, 0xfe,
-		0x86, 0xa1, 0x6b, 0x80, 0x44, 0x8f, 0xe9, 0x86, 0xdd, 0x33, 0x5b, 0xfd, 0x26, 0x6e, 0xf1, 0x01,
-		0x3b, 0xba, 0x9e, 0x19, 0x8e, 0x53, 0x71, 0x50, 0xbc, 0x84, 0x3c, 0xa3, 0x20, 0x34, 0x21, 0xa1,
-		0x92, 0x3b, 0x3e, 0x2e, 0xc2, 0xac, 0x20, 0x40, 0x88, 0xbd, 0xa4, 0xf5, 0x0c, 0xe2, 0x22, 0x33,
-		0xa7, 0x1d, 0x79, 0x8a, 0x9e, 0x63, 0x25, 0x28, 0x0f, 0x42, 0x5d, 0xd4, 0x1e, 0xee, 0x98, 0x7b,
-		0xb8, 0xc5, 0x23, 0x4e, 0xa3, 0xab, 0x4d, 0x73, 0x04, 0x85, 0xc1, 0xe7, 0x2e, 0xc2, 0x14, 0x35,
-		0x3f, 0x08, 0x80, 0x1b, 0x20, 0xf9, 0x18, 0x8a, 0x43, 0xa4, 0x58, 0x53, 0xc8, 0xf4, 0x28, 0x43,
-		0x8a, 0xe5, 0xaa, 0xeb, 0x95, 0x72, 0xb1, 0x2c, 0x87, 0x72, 0x8f, 0x41, 0x94, 0xd9, 0x14, 0x32,
-		0x75, 0x3a, 0x56, 0x45, 0x3e, 0xc6, 0x93, 0x9c, 0x86, 0x24, 0x4a, 0x37, 0xd6, 0x0a, 0x65, 0x45,
-		0x0e, 0xe5, 0x36, 0x20, 0x33, 0x30, 0x0e, 0xd1, 0x71, 0x98, 0x51, 0xca, 0x8d, 0x72, 0xb5, 0x51,
-		0xa9, 0x55, 0xd5, 0x8d, 0xea, 0xb3, 0xd5, 0xda, 0x73, 0x55, 0xf9, 0x98, 0x3f, 0x5b, 0xcc, 0xc3,
-		0x12, 0x9a, 0x03, 0xd9, 0xcd, 0xae, 0xd7, 0x36, 0x14, 0xca, 0xcd, 0xf7, 0x87, 0x40, 0x1e, 0x1c,
-		0x94, 0xe8, 0x24, 0xcc, 0x36, 0xf2, 0xca, 0xb5, 0x72, 0x43, 0x65, 0x31, 0x13, 0x87, 0xf4, 0x1c,
-		0xc8, 0xde, 0x82, 0xab, 0x15, 0x1a, 0x12, 0x5a, 0x80, 0xd3, 0xde, 0xdc, 0xf2, 0xf3, 0x8d, 0x72,
-		0xb5, 0x4e, 0x2b, 0xcf, 0x57, 0xaf, 0x11, 0xa7, 0x60, 0x80, 0x9e, 0x88, 0xd2, 0x84, 0x09, 0xab,
-		0x7e, 0x7a, 0xe5, 0xd5, 0x92, 0x1c, 0x19, 0xcc, 0xae, 0x55, 0xcb, 0xb5, 0xab, 0xf2, 0xd4, 0x60,
-		0xed, 0x34, 0x72, 0x13, 0x45, 0xf3, 0x70, 0x62, 0x30, 0x57, 0x2d, 0x57, 0x1b, 0xca, 0x4d, 0x39,
-		0x36, 0x58, 0x71, 0xbd, 0xac, 0x6c, 0x56, 0x8a, 0x65, 0x39, 0x8e, 0x4e, 0x00, 0xf2, 0x73, 0xd4,
-		0xb8, 0x5e, 0x2b, 0xc9, 0x89, 0xa0, 0x19, 0x0b, 0xc9, 0xb3, 0xb9, 0x9f, 0x95, 0x20, 0xe5, 0x8d,
-		0xa2, 0xf8, 0x8c, 0x8a, 0xf4, 0x8d, 0x36, 0xd9, 0xe6, 0xfe, 0x20, 0x04, 0x49, 0x4f, 0x38, 0x85,
-		0x2c, 0x62, 0xb5, 0x76, 0xdb, 0x7c, 0x49, 0xd5, 0xda, 0xba, 0x66, 0xf1, 0xf9, 0x10, 0x68, 0x56,
-		0x9e, 0xe4, 0x4c, 0x3a, 0xff, 0x4c, 0xee, 0xba, 0x44, 0xef, 0xd8, 0x75, 0x89, 0x7d, 0x03, 0xba,
-		0x2e, 0x53, 0x72, 0x34, 0xf7, 0xc7, 0x21, 0x90, 0x07, 0xa3, 0x23, 0x03, 0x72, 0x93, 0x46, 0xc9,
-		0xcd, 0xdb, 0xbe, 0xd0, 0x51, 0xda, 0x37, 0x38, 0xab, 0x87, 0x47, 0xce, 0xea, 0x01, 0x93, 0x55,
-		0xe4, 0x1b, 0x79, 0xb2, 0xf2, 0xaa, 0xeb, 0xbf, 0x95, 0x20, 0xed, 0x0f, 0xe6, 0xf8, 0x24, 0x96,
-		0x3b, 0x8a, 0xc4, 0xfc, 0x3d, 0x72, 0x76, 0x54, 0x8f, 0xfc, 0x9d, 0xb4, 0xeb, 0xc7, 0xc2, 0x30,
-		0xed, 0x8b, 0xfd, 0x4c, 0xca, 0xdd, 0x9b, 0x61, 0x46, 0x6f, 0xe1, 0x4e, 0xd7, 0xb4, 0xb1, 0xd1,
-		0x3c, 0x50, 0xdb, 0x78, 0x0f, 0xb7, 0xa9, 0x18, 0xd2, 0x01, 0x7b, 0xbc, 0xbe, 0x1a, 0x96, 0x2a,
-		0x2e, 0xde, 0x2a, 0x41, 0x5b, 0x99, 0xad, 0x94, 0xca, 0x6b, 0xeb, 0xb5, 0x46, 0xb9, 0x5a, 0xbc,
-		0x29, 0x2c, 0xb9, 0x22, 0xeb, 0x03, 0x60, 0x3e, 0x81, 0x9f, 0xfb, 0xc6, 0x58, 0x74, 0xae, 0x83,
-		0x3c, 0xd8, 0x1a, 0x62, 0xd0, 0x03, 0xda, 0x23, 0x1f, 0x43, 0xb3, 0x90, 0xa9, 0xd6, 0xd4, 0x7a,
-		0xa5, 0x54, 0x56, 0xcb, 0x57, 0xaf, 0x96, 0x8b, 0x8d, 0x3a, 0xdb, 0xab, 0x70, 0xa0, 0x1b, 0x72,
-		0xc8, 0xdb, 0x37, 0xef, 0x0e, 0xc3, 0x6c, 0x00, 0x27, 0x28, 0xcf, 0x43, 0x84, 0x2c, 0x6a, 0xf9,
-		0xf0, 0x24, 0xdc, 0x2f, 0x91, 0xd5, 0xfd, 0xba, 0xd6, 0xb3, 0x79, 0x44, 0xf1, 0x01, 0x20, 0xe2,
-		0x35, 0x6c, 0xe2, 0xde, 0xf7, 0xf8, 0x1e, 0x10, 0x73, 0x41, 0x32, 0x6e, 0x3e, 0xdb, 0x06, 0x7a,
-		0x08, 0x50, 0xd7, 0xb4, 0x74, 0x5b, 0xdf, 0xc3, 0xc4, 0x87, 0xe2, 0xc0, 0x64, 0xe0, 0x46, 0x14,
-		0x59, 0x94, 0x54, 0x0c, 0xdb, 0x81, 0x36, 0xf0, 0x8e, 0x36, 0x00, 0x4d, 0x96, 0x1f, 0x61, 0x45,
-		0x16, 0x25, 0x0e, 0xf4, 0x59, 0x48, 0xb5, 0xcc, 0xfe, 0x56, 0x1b, 0x73, 0x38, 0x62, 0x92, 0x25,
-		0x25, 0xc9, 0xf2, 0x1c, 0x10, 0x1e, 0x36, 0x73, 0x77, 0xaa, 0x52, 0x4a, 0x92, 0xe5, 0x31, 0x90,
-		0xfb, 0x21, 0xa3, 0xed, 0xec, 0xf4, 0x08, 0x71, 0x41, 0x88, 0x05, 0x02, 0xd3, 0x4e, 0x36, 0x05,
-		0x9c, 0xbf, 0x01, 0x71, 0x21, 0x07, 0xb2, 0xfe, 0x25, 0x92, 0x50, 0xbb, 0x2c, 0xba, 0x1d, 0x3a,
-		0x9f, 0x50, 0xe2, 0x86, 0x28, 0x3c, 0x0b, 0x29, 0xdd, 0x72, 0x4f, 0x3d, 0x65, 0x43, 0x8b, 0xa1,
-		0xf3, 0x71, 0x25, 0xa9, 0x5b, 0xee, 0xc9, 0xa6, 0x8f, 0xcb, 0x00, 0xae, 0xb2, 0xa1, 0x1f, 0x94,
-		0x20, 0xcd, 0x26, 0x98, 0x6e, 0x0f, 0x5b, 0xd8, 0x68, 0x8a, 0x65, 0xe1, 0x03, 0x87, 0xa8, 0x28,
-		0x33, 0x73, 0xeb, 0x1c, 0xa1, 0xf0, 0xcc, 0xdb, 0x25, 0xe9, 0x55, 0x29, 0xf2, 0xaa, 0x24, 0x7d,
-		0x50, 0x9a, 0x46, 0xf1, 0xf2, 0xf3, 0xeb, 0xab, 0x95, 0x62, 0xa5, 0x91, 0x7d, 0x5b, 0x8c, 0xa6,
-		0x2b, 0x6b, 0x3c, 0xfd, 0x99, 0x98, 0xbf, 0xfc, 0xf5, 0xd8, 0x2f, 0x4a, 0xe1, 0xf8, 0xeb, 0x31,
-		0x65, 0x7a, 0xdb, 0x4b, 0x0f, 0xb5, 0xbd, 0xe7, 0x38, 0x42, 0xa3, 0x16, 0x92, 0x2e, 0x37, 0x65,
-		0x7e, 0x7a, 0xa3, 0xf0, 0x00, 0x65, 0x24, 0x4a, 0x19, 0x49, 0xa2, 0x68, 0x71, 0xb5, 0x56, 0x2f,
-		0x97, 0x28, 0x1b, 0x09, 0x14, 0xa9, 0xad, 0x97, 0xab, 0xd9, 0xcf, 0x88, 0x2a, 0xdd, 0x23, 0x1f,
-		0xaf, 0x4a, 0x70, 0x52, 0x6c, 0xd4, 0xf2, 0xb9, 0x16, 0x1b, 0x4d, 0xb3, 0x25, 0xbc, 0xdb, 0xf4,
-		0xf2, 0xa5, 0xc3, 0x2a, 0x57, 0x38, 0x2a, 0x15, 0x49, 0x99, 0x23, 0x16, 0x1e, 0x1e, 0x12, 0x49,
-		0xbe, 0x5a, 0xe2, 0xbc, 0x24, 0x51, 0x74, 0x3d, 0x5f, 0x7c, 0xb6, 0x5c, 0x72, 0xb9, 0x39, 0xde,
-		0x0b, 0xa2, 0x82, 0xbe, 0x13, 0x32, 0x7d, 0x7b, 0xfb, 0x09, 0xa2, 0x1b, 0x7a, 0x8b, 0xed, 0x9c,
-		0x47, 0x46, 0x6d, 0xb9, 0xba, 0x1c, 0x6d, 0xd8, 0xdb, 0x4f, 0x6c, 0x3a, 0x18, 0x5c, 0x28, 0x8c,
-		0x95, 0x04, 0x8a, 0x54, 0x6b, 0xd5, 0xb2, 0x60, 0x83, 0xee, 0x32, 0xdf, 0x74, 0xd9, 0x48, 0xf7,
-		0x7d, 0xa8, 0xe8, 0x3b, 0x41, 0x16, 0xe1, 0x21, 0x47, 0x24, 0x53, 0xa3, 0x76, 0x8d, 0x5d, 0x06,
-		0x78, 0x90, 0xc9, 0x11, 0xc6, 0x7d, 0x1e, 0x0e, 0xe6, 0x50, 0x66, 0xb5, 0x5c, 0xbd, 0xd6, 0xb8,
-		0xae, 0xae, 0x2b, 0x65, 0xba, 0xf9, 0x97, 0x7d, 0x9b, 0xa8, 0x3e, 0xd3, 0xf1, 0x23, 0xa2, 0xef,
-		0x92, 0x20, 0xc9, 0x5c, 0x20, 0x16, 0x93, 0x62, 0x41, 0x85, 0xfb, 0x0e, 0xab, 0x9b, 0x7a, 0x40,
-		0x14, 0xba, 0xf0, 0x24, 0xad, 0x36, 0x2c, 0x14, 0xe2, 0x24, 0x42, 0xab, 0xe5, 0x6b, 0xf9, 0xe2,
-		0x4d, 0xb5, 0x50, 0xae, 0x37, 0x88, 0x25, 0xab, 0x29, 0x4c, 0x47, 0x01, 0x4d, 0xe5, 0x57, 0x57,
-		0x6b, 0xcf, 0xb9, 0x82, 0x80, 0x17, 0x1d, 0x32, 0xe8, 0x67, 0x24, 0x98, 0xc3, 0xc6, 0xb6, 0xd9,
-		0x6b, 0xd2, 0x0d, 0x6b, 0x32, 0xa2, 0x2d, 0xfb, 0xa0, 0xcd, 0x46, 0x74, 0xe0, 0xa2, 0xdc, 0xab,
-		0x99, 0x14, 0xaf, 0x4a, 0xd1, 0xea, 0x04, 0xab, 0x50, 0x79, 0xbb, 0x14, 0x7a, 0x95, 0x30, 0x16,
-		0xa2, 0xbc, 0x45, 0x5e, 0x95, 0xa6, 0x28, 0x87, 0xb1, 0x57, 0xa5, 0xf8, 0xab, 0x52, 0xe2, 0x83,
-		0xd2, 0x0c, 0x4a, 0xd5, 0x1b, 0x37, 0x57, 0xcb, 0x2a, 0xe3, 0x96, 0x72, 0x98, 0x46, 0x09, 0x9a,
-		0xb7, 0xfc, 0xc8, 0xf2, 0xe5, 0xec, 0x67, 0x29, 0x97, 0x9f, 0x8d, 0x29, 0x08, 0x0f, 0x91, 0x47,
-		0x1f, 0x97, 0xe0, 0x94, 0xd8, 0x22, 0xb7, 0xe8, 0xb6, 0x99, 0xea, 0xd9, 0x60, 0x8b, 0x53, 0x96,
-		0xcb, 0x87, 0xb1, 0xec, 0xee, 0xb2, 0xf1, 0xcc, 0x25, 0xbe, 0xe0, 0x1d, 0xdc, 0x84, 0x2b, 0x5c,
-		0x61, 0x2d, 0xf9, 0xa0, 0x94, 0x41, 0x50, 0x7e, 0x7e, 0xbd, 0xa6, 0x34, 0xd4, 0xfc, 0xea, 0x2a,
-		0xe5, 0xf7, 0x38, 0x92, 0x79, 0x4e, 0xa3, 0xb6, 0xae, 0xae, 0x96, 0x37, 0xcb, 0xab, 0x2e, 0xdb,
-		0x27, 0x5b, 0xc1, 0x04, 0xe7, 0x3f, 0x20, 0xc1, 0xcc, 0x50, 0xf5, 0xb9, 0xb7, 0x4a, 0x70, 0x72,
-		0x04, 0x0b, 0xe8, 0x5e, 0x38, 0x5b, 0x2a, 0x5f, 0xcd, 0x6f, 0xac, 0x36, 0xd4, 0xfa, 0xcd, 0xb5,
-		0x42, 0x6d, 0x55, 0xdd, 0xac, 0xd4, 0x2b, 0x85, 0xca, 0x6a, 0xa5, 0xe1, 0x9d, 0xc0, 0xd2, 0xe0,
-		0x61, 0x90, 0x2d, 0xd7, 0x06, 0xd9, 0x93, 0x43, 0x64, 0x51, 0xb8, 0x5a, 0x2b, 0xe6, 0x57, 0x29,
-		0x50, 0x58, 0xac, 0x39, 0x8b, 0x0d, 0x39, 0x72, 0x23, 0x1e, 0x97, 0xf8, 0xdc, 0xf6, 0x2d, 0x30,
-		0xed, 0x33, 0x7e, 0x64, 0x89, 0x44, 0x97, 0x56, 0x44, 0x9f, 0xeb, 0xe5, 0x6a, 0xd1, 0xbb, 0xa4,
-		0x4b, 0x81, 0x63, 0xec, 0x64, 0x89, 0xa4, 0x84, 0x29, 0x94, 0x43, 0x64, 0x52, 0xe5, 0xea, 0xe8,
-		0x6c, 0x4e, 0x87, 0x73, 0x8f, 0x43, 0x5c, 0x18, 0x33, 0xb2, 0x50, 0xa3, 0xeb, 0xad, 0x81, 0x65,
-		0x62, 0x1c, 0xa8, 0x25, 0x93, 0x25, 0xc2, 0x20, 0xb3, 0x70, 0x72, 0x28, 0xb7, 0x09, 0xc7, 0x03,
-		0x0d, 0x11, 0x3a, 0x07, 0x0b, 0x62, 0x43, 0x9c, 0x2d, 0x01, 0xd5, 0x72, 0xb5, 0x58, 0x2b, 0x91,
-		0x45, 0xb3, 0x4b, 0x13, 0x80, 0x5b, 0x24, 0xc6, 0xa5, 0xb0, 0x56, 0x72, 0x28, 0x57, 0x81, 0xb4,
-		0xdf, 0x9c, 0xa0, 0xd3, 0x70, 0x72, 0xa3, 0x71, 0xf5, 0x09, 0x75, 0x33, 0xbf, 0x5a, 0x29, 0xe5,
-		0x07, 0x96, 0xc7, 0x00, 0xdc, 0xa6, 0xc8, 0x21, 0xc2, 0x28, 0xb1, 0x35, 0x72, 0x38, 0x17, 0x89,
-		0x4b, 0xb2, 0x94, 0xab, 0x43, 0x66, 0xc0, 0x30, 0xa0, 0xbb, 0x20, 0xcb, 0xd7, 0xab, 0x41, 0x5c,
-		0x51, 0x09, 0xf9, 0x4c, 0x05, 0x5b, 0xb9, 0x97, 0xca, 0xab, 0x95, 0xb5, 0x4a, 0x83, 0xf2, 0x77,
-		0x1d, 0xc0, 0x1d, 0xf1, 0xc4, 0x83, 0xb9, 0x51, 0xaf, 0x55, 0xd5, 0xab, 0x64, 0xd9, 0xdf, 0xf0,
-		0x90, 0x4a, 0x00, 0x1b, 0xe1, 0xb2, 0x44, 0x56, 0xa7, 0xc3, 0x66, 0x40, 0x0e, 0xe5, 0x9e, 0x03,
-		0x34, 0x3c, 0x5a, 0xd1, 0x22, 0xdc, 0x55, 0xae, 0x5e, 0xad, 0x29, 0xc5, 0xb2, 0x5a, 0xcd, 0xaf,
-		0x11, 0xfe, 0xd8, 0xd8, 0x74, 0x49, 0x4f, 0x83, 0x3b, 0x34, 0x45, 0x4c, 0xc2, 0x1d, 0xbd, 0x72,
-		0xe8, 0xc2, 0x47, 0x25, 0xe2, 0x19, 0xbd, 0xa3, 0x3a, 0xff, 0x21, 0x09, 0xdd, 0x1d, 0x7f, 0x3d,
-		0x86, 0x62, 0x4b, 0xdd, 0xad, 0xa5, 0x66, 0xb7, 0x3b, 0x9f, 0x21, 0x3f, 0x8a, 0xdd, 0xee, 0x55,
-		0xe1, 0xef, 0x2d, 0xc4, 0x3f, 0x1b, 0x43, 0x71, 0x92, 0xfb, 0xa2, 0xb6, 0xa7, 0xcd, 0xcb, 0xe4,
-		0xd7, 0x0d, 0x6d, 0x4f, 0x73, 0x00, 0x4e, 0xc7, 0x3f, 0x17, 0x43, 0x51, 0x92, 0xbd, 0x63, 0xce,
-		0xa7, 0xc9, 0xff, 0x6b, 0xa6, 0x53, 0x78, 0x2e, 0xfe, 0xf9, 0x18, 0x02, 0x92, 0xd9, 0x3d, 0xb0,
-		0x77, 0x4d, 0x63, 0x1e, 0x91, 0xdf, 0xeb, 0xf4, 0xb7, 0x07, 0xe8, 0x7b, 0xab, 0x1c, 0x88, 0x0c,
-		0xfe, 0x4b, 0x1c, 0x88, 0xfe, 0x16, 0x40, 0x17, 0xa2, 0xf1, 0x77, 0x54, 0xe5, 0x77, 0x55, 0x2f,
-		0x44, 0xe3, 0xef, 0xaa, 0xca, 0x3f, 0x58, 0xbd, 0x11, 0x8d, 0x7f, 0x26, 0x26, 0xbf, 0x1e, 0xcb,
-		0xfd, 0x45, 0x18, 0x90, 0x6b, 0x2a, 0x9c, 0x28, 0xe0, 0xf3, 0x10, 0x77, 0xc2, 0x8a, 0xec, 0x9c,
-		0xf7, 0x1b, 0x0e, 0xb1, 0x30, 0x02, 0xcd, 0x93, 0x35, 0x10, 0x66, 0x74, 0xa8, 0xa1, 0x3c, 0x64,
-		0x3a, 0xba, 0xa1, 0x77, 0xfa, 0x1d, 0x55, 0xc4, 0xda, 0xc6, 0xc6, 0x90, 0x38, 0x02, 0x4f, 0x53,
-		0x12, 0xda, 0xbe, 0x8f, 0xc4, 0xd4, 0x58, 0x12, 0x0c, 0x81, 0xa7, 0xe7, 0xbf, 0x24, 0x41, 0x76,
-		0x14, 0xb3, 0x77, 0x14, 0x06, 0xac, 0xc2, 0x9c, 0xb9, 0x87, 0x7b, 0x3d, 0xbd, 0x45, 0x77, 0xf6,
-		0x9c, 0xc5, 0x41, 0x64, 0xfc, 0xe2, 0x60, 0xd6, 0x83, 0xe8, 0x74, 0x6a, 0x81, 0xf8, 0x70, 0xfb,
-		0xc4, 0x7d, 0x11, 0x94, 0xa6, 0xc6, 0x53, 0x9a, 0xa6, 0x28, 0x82, 0xc6, 0x0d, 0x32, 0x48, 0xc9,
-		0x7a, 0x3c, 0x24, 0x87, 0xdd, 0x15, 0x48, 0xee, 0x7d, 0x61, 0x48, 0xfb, 0x8f, 0x2b, 0xa3, 0x12,
-		0xc4, 0xdb, 0x26, 0x3f, 0xc7, 0xc7, 0x7a, 0xfb, 0xfc, 0x98, 0x13, 0xce, 0x4b, 0xab, 0x1c, 0x5e,
-		0x71, 0x30, 0xe7, 0x7f, 0x4f, 0x82, 0xb8, 0xc8, 0x46, 0x27, 0x20, 0xd2, 0xd5, 0xec, 0x5d, 0x4a,
-		0x6e, 0xaa, 0x10, 0x92, 0x25, 0x85, 0xa6, 0x49, 0xbe, 0xd5, 0xd5, 0xd8, 0x19, 0x46, 0x9e, 0x4f,
-		0xd2, 0x64, 0x15, 0xd0, 0xc6, 0x5a, 0x8b, 0xee, 0x49, 0x9b, 0x9d, 0x0e, 0x36, 0x6c, 0x4b, 0xac,
-		0x02, 0x78, 0x7e, 0x91, 0x67, 0xa3, 0x07, 0x61, 0xc6, 0xee, 0x69, 0x7a, 0xdb, 0x07, 0x1b, 0xa1,
-		0xb0, 0xb2, 0x28, 0x70, 0x80, 0x57, 0xe0, 0x94, 0xa0, 0xdb, 0xc2, 0xb6, 0xd6, 0xdc, 0xc5, 0x2d,
-		0x17, 0x29, 0x4a, 0x8f, 0xaf, 0x9c, 0xe4, 0x00, 0x25, 0x5e, 0x2e, 0x70, 0x2f, 0xf4, 0x7d, 0xd7,
-		0x15, 0x76, 0x10, 0xe6, 0xd7, 0x15, 0x2e, 0x8d, 0xb8, 0xae, 0x30, 0x78, 0x5a, 0xdc, 0x73, 0x57,
-		0xe1, 0x42, 0x00, 0x8a, 0x5f, 0xa2, 0xae, 0x3b, 0xff, 0xc9, 0x10, 0xcc, 0x88, 0xcd, 0xfb, 0x96,
-		0xd3, 0x47, 0x6b, 0x00, 0x9a, 0x61, 0x98, 0xb6, 0xb7, 0x97, 0x86, 0xd7, 0x5b, 0x43, 0x78, 0x4b,
-		0x79, 0x07, 0x49, 0xf1, 0x10, 0x98, 0xff, 0xbc, 0x04, 0xe0, 0x16, 0x8d, 0xec, 0xae, 0x05, 0x48,
-		0xf2, 0x56, 0xd1, 0x2b, 0x1f, 0x2c, 0xc6, 0x0d, 0x2c, 0xeb, 0xaa, 0xde, 0xa6, 0xa7, 0x72, 0xb6,
-		0xf0, 0x8e, 0x6e, 0xf0, 0x13, 0x89, 0x2c, 0x21, 0x4e, 0xe5, 0x44, 0xdc, 0x13, 0xbb, 0x0a, 0xc4,
-		0x2d, 0xdc, 0xd1, 0x0c, 0x5b, 0x6f, 0xf2, 0xc1, 0x7a, 0xe5, 0x48, 0xcc, 0x2f, 0xd5, 0x39, 0xb6,
-		0xe2, 0xd0, 0xc9, 0x9d, 0x87, 0xb8, 0xc8, 0x75, 0xa6, 0xa6, 0x63, 0x28, 0x06, 0xe1, 0x7a, 0x99,
-		0x4c, 0xce, 0x74, 0x86, 0xa8, 0xe4, 0xeb, 0x72, 0xe8, 0xc2, 0x87, 0x42, 0x10, 0x13, 0xd6, 0x63,
-		0x16, 0x32, 0xe5, 0x52, 0x65, 0x60, 0x96, 0x9b, 0x85, 0xb4, 0xc8, 0xe4, 0x56, 0xfe, 0x6d, 0x31,
-		0x6f, 0xe6, 0xba, 0x52, 0x6b, 0xd4, 0x96, 0xe5, 0x3f, 0x1b, 0xce, 0x7c, 0x54, 0xfe, 0x4c, 0x0c,
-		0xcd, 0x40, 0x4a, 0x64, 0x2e, 0x3f, 0xb2, 0xfc, 0xa8, 0xfc, 0xfa, 0x60, 0xd6, 0x65, 0xf9, 0xb3,
-		0x34, 0xbc, 0x2a, 0xb2, 0x2e, 0xa9, 0x0d, 0x32, 0x55, 0xd5, 0xaa, 0xab, 0x37, 0x65, 0xc9, 0x5b,
-		0xb0, 0xec, 0x29, 0x08, 0xa1, 0xbb, 0xe1, 0xa4, 0x28, 0x78, 0xf2, 0xc9, 0x27, 0x9f, 0x7c, 0xdc,
-		0x53, 0x78, 0xfb, 0x9d, 0xd1, 0xc1, 0xe2, 0x27, 0x3c, 0xc5, 0xef, 0x1b, 0x2e, 0x7e, 0xd2, 0x53,
-		0xfc, 0xe3, 0xef, 0x8c, 0xa2, 0x59, 0x48, 0x8a, 0xe2, 0xb5, 0xfc, 0xf3, 0xf2, 0x57, 0xbe, 0xf2,
-		0x95, 0xaf, 0xc4, 0x2e, 0x6c, 0x80, 0x3c, 0xe4, 0x92, 0xcd, 0x81, 0xec, 0xf3, 0xc1, 0x88, 0x78,
-		0x8f, 0x0d, 0xe4, 0x52, 0x37, 0x4b, 0x96, 0x88, 0x8b, 0xe3, 0xc9, 0x65, 0x2e, 0x99, 0x1c, 0x2a,
-		0x7c, 0x27, 0xcc, 0x36, 0xcd, 0xce, 0x60, 0x8f, 0x17, 0xe4, 0x81, 0x23, 0x47, 0xd6, 0x75, 0xe9,
-		0x85, 0x87, 0x39, 0xd0, 0x8e, 0xd9, 0xd6, 0x8c, 0x9d, 0x25, 0xb3, 0xb7, 0xe3, 0x5e, 0x59, 0x22,
-		0xcb, 0x47, 0xcb, 0x73, 0x71, 0xa9, 0xbb, 0xf5, 0x25, 0x49, 0xfa, 0x60, 0x28, 0x7c, 0x6d, 0xbd,
-		0xf0, 0xd3, 0xa1, 0xf9, 0x6b, 0x0c, 0x71, 0x5d, 0xe8, 0x93, 0x82, 0xb7, 0xdb, 0xb8, 0x49, 0x3a,
-		0x1d, 0xfe, 0xfc, 0x41, 0x98, 0xdb, 0x31, 0x77, 0x4c, 0x4a, 0xe9, 0x22, 0xf9, 0xc5, 0xef, 0x3c,
-		0x25, 0x9c, 0xdc, 0xf9, 0xb1, 0x17, 0xa4, 0x56, 0xaa, 0x30, 0xcb, 0x81, 0x55, 0xba, 0x9a, 0x65,
-		0xa7, 0x22, 0xd0, 0xa1, 0x87, 0xf3, 0xb2, 0xbf, 0xf0, 0xa7, 0x34, 0x0c, 0xa9, 0xcc, 0x70, 0x54,
-		0x52, 0xc6, 0x0e, 0x4e, 0xac, 0x28, 0x70, 0xdc, 0x47, 0x8f, 0x45, 0x12, 0x70, 0x6f, 0x0c, 0xc5,
-		0xdf, 0xe2, 0x14, 0x67, 0x3d, 0x14, 0xeb, 0x1c, 0x75, 0xa5, 0x08, 0xd3, 0x47, 0xa1, 0xf5, 0xdb,
-		0x9c, 0x56, 0x0a, 0x7b, 0x89, 0x5c, 0x83, 0x0c, 0x25, 0xd2, 0xec, 0x5b, 0xb6, 0xd9, 0xa1, 0x61,
-		0x9a, 0xc3, 0xc9, 0xfc, 0xcb, 0x3f, 0x65, 0xc6, 0x3a, 0x4d, 0xd0, 0x8a, 0x0e, 0xd6, 0xca, 0x0a,
-		0xd0, 0x55, 0x79, 0x0b, 0x37, 0xdb, 0x63, 0x28, 0xfc, 0x0e, 0x67, 0xc4, 0x81, 0x5f, 0xd9, 0x24,
-		0x8b, 0xb4, 0x7e, 0x87, 0x46, 0x51, 0xbc, 0x9c, 0x8c, 0x3f, 0x86, 0x97, 0xfd, 0x83, 0xef, 0x66,
-		0xf3, 0xc1, 0xac, 0x43, 0xc0, 0xc3, 0x93, 0xa7, 0x17, 0x77, 0xb0, 0x6d, 0xe3, 0x9e, 0xa5, 0x6a,
-		0xed, 0x20, 0xf6, 0x3c, 0xe7, 0x98, 0xb2, 0x3f, 0xf6, 0x05, 0x7f, 0x2f, 0x5e, 0x63, 0x98, 0xf9,
-		0x76, 0x7b, 0x65, 0x03, 0x4e, 0x06, 0x68, 0xc5, 0x04, 0x34, 0xdf, 0xcd, 0x69, 0xce, 0x0d, 0x69,
-		0x06, 0x21, 0xbb, 0x0e, 0x22, 0xdf, 0xe9, 0xcb, 0x09, 0x68, 0xbe, 0x87, 0xd3, 0x44, 0x1c, 0x57,
-		0x74, 0x29, 0xa1, 0x78, 0x03, 0x66, 0xf6, 0x70, 0x6f, 0xcb, 0xb4, 0xf8, 0xd9, 0xb1, 0x09, 0xc8,
-		0xbd, 0x97, 0x93, 0xcb, 0x70, 0x44, 0x7a, 0x98, 0x8c, 0xd0, 0x7a, 0x12, 0xe2, 0xdb, 0x5a, 0x13,
-		0x4f, 0x40, 0xe2, 0x36, 0x27, 0x11, 0x23, 0xf0, 0x04, 0x35, 0x0f, 0xa9, 0x1d, 0x93, 0x07, 0xd2,
-		0xc6, 0xa3, 0xbf, 0x8f, 0xa3, 0x27, 0x05, 0x0e, 0x27, 0xd1, 0x35, 0xbb, 0xfd, 0xb6, 0x66, 0x4f,
-		0xc2, 0xc1, 0x8f, 0x0b, 0x12, 0x02, 0x87, 0x93, 0x38, 0x82, 0x58, 0xdf, 0x2f, 0x48, 0x58, 0x1e,
-		0x79, 0x3e, 0x03, 0x49, 0xd3, 0x68, 0x1f, 0x98, 0xc6, 0x24, 0x4c, 0x7c, 0x80, 0x53, 0x00, 0x8e,
-		0x42, 0x08, 0x3c, 0x05, 0x89, 0x49, 0x3b, 0xe2, 0x27, 0xbf, 0x20, 0x86, 0x87, 0xe8, 0x81, 0x6b,
-		0x90, 0x11, 0x06, 0x4a, 0x37, 0x8d, 0x09, 0x48, 0xfc, 0x14, 0x27, 0x91, 0xf6, 0xa0, 0xf1, 0x66,
-		0xd8, 0xd8, 0xb2, 0x77, 0xf0, 0x24, 0x44, 0x3e, 0x24, 0x9a, 0xc1, 0x51, 0xb8, 0x28, 0xb7, 0xb0,
-		0xd1, 0xdc, 0x9d, 0x8c, 0xc2, 0x87, 0x85, 0x28, 0x05, 0x0e, 0x21, 0x51, 0x84, 0xe9, 0x8e, 0xd6,
-		0xb3, 0x76, 0xb5, 0xf6, 0x44, 0xdd, 0xf1, 0x11, 0x4e, 0x23, 0xe5, 0x20, 0x71, 0x89, 0xf4, 0x8d,
-		0xa3, 0x90, 0xf9, 0x69, 0x21, 0x11, 0x0f, 0x1a, 0x1f, 0x7a, 0x96, 0x4d, 0xfd, 0xf8, 0xa3, 0x50,
-		0xfb, 0x19, 0x31, 0xf4, 0x18, 0xee, 0x9a, 0x97, 0xe2, 0x53, 0x90, 0xb0, 0xf4, 0x97, 0x27, 0x22,
-		0xf3, 0xb3, 0xa2, 0xa7, 0x29, 0x02, 0x41, 0xbe, 0x09, 0xa7, 0x02, 0xa7, 0x89, 0x09, 0x88, 0xfd,
-		0x1c, 0x27, 0x76, 0x22, 0x60, 0xaa, 0xe0, 0x26, 0xe1, 0xa8, 0x24, 0x7f, 0x5e, 0x98, 0x04, 0x3c,
-		0x40, 0x6b, 0x1d, 0xe6, 0xfa, 0x86, 0xa5, 0x6d, 0x1f, 0x4d, 0x6a, 0xff, 0xbf, 0x90, 0x1a, 0xc3,
-		0xf5, 0x49, 0xad, 0x01, 0x27, 0x38, 0xc5, 0xa3, 0xf5, 0xeb, 0x47, 0x85, 0x61, 0x65, 0xd8, 0x1b,
-		0xfe, 0xde, 0xfd, 0x66, 0x98, 0x77, 0xc4, 0x29, 0x9c, 0x6e, 0x4b, 0xed, 0x68, 0xdd, 0x09, 0x28,
-		0xff, 0x02, 0xa7, 0x2c, 0x2c, 0xbe, 0xe3, 0xb5, 0x5b, 0x6b, 0x5a, 0x97, 0x10, 0x7f, 0x1e, 0xb2,
-		0x82, 0x78, 0xdf, 0xe8, 0xe1, 0xa6, 0xb9, 0x63, 0xe8, 0x2f, 0xe3, 0xd6, 0x04, 0xa4, 0x7f, 0x71,
-		0xa0, 0xab, 0x36, 0x3c, 0xe8, 0x84, 0x72, 0x05, 0x64, 0xc7, 0x57, 0x51, 0xf5, 0x0e, 0xdd, 0x6f,
-		0x3c, 0x9c, 0xe2, 0x2f, 0x89, 0x9e, 0x72, 0xf0, 0x2a, 0x14, 0x6d, 0xa5, 0x0c, 0xec, 0x02, 0xcb,
-		0xa4, 0x2a, 0xf9, 0x31, 0x4e, 0x68, 0xda, 0xc5, 0xe2, 0x86, 0xa3, 0x69, 0x76, 0xba, 0x5a, 0x6f,
-		0x12, 0xfb, 0xf7, 0xcb, 0xc2, 0x70, 0x70, 0x14, 0x6e, 0x38, 0x88, 0x47, 0x47, 0x66, 0xfb, 0x09,
-		0x28, 0xfc, 0x8a, 0x30, 0x1c, 0x02, 0x87, 0x93, 0x10, 0x0e, 0xc3, 0x04, 0x24, 0xfe, 0x91, 0x20,
-		0x21, 0x70, 0x08, 0x89, 0x37, 0xb9, 0x13, 0x6d, 0x0f, 0xef, 0xe8, 0x96, 0xcd, 0xaf, 0x98, 0x1d,
-		0x4e, 0xea, 0x57, 0xbf, 0xe0, 0x77, 0xc2, 0x14, 0x0f, 0x2a, 0xb1, 0x44, 0x3c, 0xf0, 0x4d, 0x37,
-		0x76, 0xc6, 0x33, 0xf6, 0x71, 0x61, 0x89, 0x3c, 0x68, 0x84, 0x37, 0x8f, 0x87, 0x48, 0xc4, 0xde,
-		0x24, 0x0b, 0xd4, 0x09, 0xc8, 0xfd, 0xda, 0x00, 0x73, 0x75, 0x81, 0x4b, 0x68, 0x7a, 0xfc, 0x9f,
-		0xbe, 0x71, 0x0b, 0x1f, 0x4c, 0xa4, 0x9d, 0xff, 0x78, 0xc0, 0xff, 0xd9, 0x60, 0x98, 0xcc, 0x86,
-		0x64, 0x06, 0xfc, 0x29, 0x34, 0xee, 0x0a, 0x6b, 0xf6, 0xad, 0x5f, 0xe4, 0xed, 0xf5, 0xbb, 0x53,
-		0x2b, 0xab, 0x44, 0xc9, 0xfd, 0x4e, 0xcf, 0x78, 0x62, 0xdf, 0xfd, 0x45, 0x47, 0xcf, 0x7d, 0x3e,
-		0xcf, 0xca, 0x55, 0x98, 0xf6, 0x39, 0x3c, 0xe3, 0x49, 0xbd, 0x8d, 0x93, 0x4a, 0x79, 0xfd, 0x9d,
-		0x95, 0xc7, 0x20, 0x42, 0x9c, 0x97, 0xf1, 0xe8, 0xdf, 0xc3, 0xd1, 0x29, 0xf8, 0xca, 0xd3, 0x10,
-		0x17, 0x4e, 0xcb, 0x78, 0xd4, 0xef, 0xe5, 0xa8, 0x0e, 0x0a, 0x41, 0x17, 0x0e, 0xcb, 0x78, 0xf4,
-		0xff, 0x4b, 0xa0, 0x0b, 0x14, 0x82, 0x3e, 0xb9, 0x08, 0xff, 0xd9, 0x3b, 0x22, 0x7c, 0xd2, 0x11,
-		0xb2, 0x7b, 0x0a, 0x62, 0xdc, 0x53, 0x19, 0x8f, 0xfd, 0x7d, 0xbc, 0x72, 0x81, 0xb1, 0xf2, 0x38,
-		0x4c, 0x4d, 0x28, 0xf0, 0x77, 0x72, 0x54, 0x06, 0xbf, 0x52, 0x84, 0xa4, 0xc7, 0x3b, 0x19, 0x8f,
-		0xfe, 0x03, 0x1c, 0xdd, 0x8b, 0x45, 0x58, 0xe7, 0xde, 0xc9, 0x78, 0x02, 0xff, 0xb7, 0x60, 0x9d,
-		0x63, 0x10, 0xb1, 0x09, 0xc7, 0x64, 0x3c, 0xf6, 0xbb, 0x84, 0xd4, 0x05, 0xca, 0xca, 0x33, 0x90,
-		0x70, 0x26, 0x9b, 0xf1, 0xf8, 0x3f, 0xc8, 0xf1, 0x5d, 0x1c, 0x22, 0x01, 0xcf, 0x64, 0x37, 0x9e,
-		0xc4, 0xff, 0x23, 0x24, 0xe0, 0xc1, 0x22, 0xc3, 0x68, 0xd0, 0x81, 0x19, 0x4f, 0xe9, 0x87, 0xc4,
-		0x30, 0x1a, 0xf0, 0x5f, 0x48, 0x6f, 0x52, 0x9b, 0x3f, 0x9e, 0xc4, 0x0f, 0x8b, 0xde, 0xa4, 0xf0,
-		0x84, 0x8d, 0x41, 0x8f, 0x60, 0x3c, 0x8d, 0x1f, 0x15, 0x6c, 0x0c, 0x38, 0x04, 0x2b, 0xeb, 0x80,
-		0x86, 0xbd, 0x81, 0xf1, 0xf4, 0x5e, 0xe5, 0xf4, 0x66, 0x86, 0x9c, 0x81, 0x95, 0xe7, 0xe0, 0x44,
-		0xb0, 0x27, 0x30, 0x9e, 0xea, 0x8f, 0x7d, 0x71, 0x60, 0xed, 0xe6, 0x75, 0x04, 0x56, 0x1a, 0xee,
-		0x94, 0xe2, 0xf5, 0x02, 0xc6, 0x93, 0x7d, 0xf7, 0x17, 0xfd, 0x86, 0xdb, 0xeb, 0x04, 0xac, 0xe4,
-		0x01, 0xdc, 0x09, 0x78, 0x3c, 0xad, 0xf7, 0x72, 0x5a, 0x1e, 0x24, 0x32, 0x34, 0xf8, 0xfc, 0x3b,
-		0x1e, 0xff, 0xb6, 0x18, 0x1a, 0x1c, 0x83, 0x0c, 0x0d, 0x31, 0xf5, 0x8e, 0xc7, 0x7e, 0x9f, 0x18,
-		0x1a, 0x02, 0x85, 0x68, 0xb6, 0x67, 0x76, 0x1b, 0x4f, 0xe1, 0x03, 0x42, 0xb3, 0x3d, 0x58, 0x2b,
-		0x55, 0x98, 0x19, 0x9a, 0x10, 0xc7, 0x93, 0xfa, 0x20, 0x27, 0x25, 0x0f, 0xce, 0x87, 0xde, 0xc9,
-		0x8b, 0x4f, 0x86, 0xe3, 0xa9, 0xfd, 0xc4, 0xc0, 0xe4, 0xc5, 0xe7, 0xc2, 0x95, 0xa7, 0x20, 0x6e,
-		0xf4, 0xdb, 0x6d, 0x32, 0x78, 0xd0, 0xe1, 0x57, 0x8c, 0xb3, 0x9f, 0xfd, 0x32, 0x97, 0x8e, 0x40,
-		0x58, 0x79, 0x0c, 0xa6, 0x70, 0x67, 0x0b, 0xb7, 0xc6, 0x61, 0x7e, 0xee, 0xcb, 0xc2, 0x60, 0x12,
-		0xe8, 0x95, 0x67, 0x00, 0x58, 0x68, 0x84, 0x9e, 0xb1, 0x1f, 0x83, 0xfb, 0xf9, 0x2f, 0xf3, 0x0b,
-		0x79, 0x2e, 0x8a, 0x4b, 0x80, 0x5d, 0xef, 0x3b, 0x9c, 0xc0, 0x17, 0xfc, 0x04, 0x68, 0x8f, 0x3c,
-		0x09, 0xb1, 0x17, 0x2d, 0xd3, 0xb0, 0xb5, 0x9d, 0x71, 0xd8, 0x7f, 0xce, 0xb1, 0x05, 0x3c, 0x11,
-		0x58, 0xc7, 0xec, 0x61, 0x5b, 0xdb, 0xb1, 0xc6, 0xe1, 0xfe, 0x17, 0x8e, 0xeb, 0x20, 0x10, 0xe4,
-		0xa6, 0x66, 0xd9, 0x93, 0xb4, 0xfb, 0x2f, 0x04, 0xb2, 0x40, 0x20, 0x4c, 0x93, 0xdf, 0xb7, 0xf0,
-		0xc1, 0x38, 0xdc, 0xbf, 0x14, 0x4c, 0x73, 0xf8, 0x95, 0xa7, 0x21, 0x41, 0x7e, 0xb2, 0x8b, 0xba,
-		0x63, 0x90, 0xff, 0x8a, 0x23, 0xbb, 0x18, 0xa4, 0x66, 0xcb, 0x6e, 0xd9, 0xfa, 0x78, 0x61, 0xff,
-		0x35, 0xef, 0x69, 0x01, 0xbf, 0x92, 0x87, 0xa4, 0x65, 0xb7, 0x5a, 0x7d, 0xee, 0x9f, 0x8e, 0x41,
-		0xff, 0xaf, 0x5f, 0x76, 0x42, 0x16, 0x0e, 0x0e, 0xe9, 0xed, 0x97, 0x6e, 0xd9, 0x5d, 0x93, 0x9e,
-		0xca, 0x1a, 0x47, 0xe1, 0x8b, 0x9c, 0x82, 0x07, 0x65, 0xa5, 0x08, 0x29, 0xd2, 0x16, 0x71, 0xb8,
-		0x65, 0x1c, 0x89, 0xbf, 0xe1, 0x02, 0xf0, 0x21, 0x15, 0xbe, 0xfd, 0x77, 0x3e, 0x75, 0x46, 0xfa,
-		0xe4, 0xa7, 0xce, 0x48, 0xff, 0xf1, 0x53, 0x67, 0xa4, 0x77, 0x7d, 0xfa, 0xcc, 0xb1, 0x4f, 0x7e,
-		0xfa, 0xcc, 0xb1, 0x3f, 0xfe, 0xf4, 0x99, 0x63, 0xc1, 0x51, 0x62, 0xb8, 0x66, 0x5e, 0x33, 0x59,
-		0x7c, 0xf8, 0x85, 0x7b, 0x77, 0x74, 0x7b, 0xb7, 0xbf, 0xb5, 0xd4, 0x34, 0x3b, 0x17, 0x9b, 0xa6,
-		0xd5, 0x31, 0xad, 0x8b, 0xfe, 0xb8, 0x2e, 0xfd, 0x05, 0x7f, 0x2b, 0x91, 0x35, 0xb3, 0x3f, 0x9c,
-		0xab, 0x19, 0x07, 0xa3, 0x1e, 0xba, 0xba, 0x02, 0xe1, 0xbc, 0x71, 0x80, 0x4e, 0x31, 0x03, 0xa7,
-		0xf6, 0x7b, 0x6d, 0x7e, 0xd5, 0x33, 0x46, 0xd2, 0x1b, 0xbd, 0xb6, 0xff, 0x98, 0x7f, 0x8a, 0x1f,
-		0xf3, 0x2f, 0xfc, 0x80, 0x74, 0xb4, 0x96, 0xc4, 0xf3, 0xc6, 0x01, 0x6d, 0xc8, 0xba, 0xf4, 0xc2,
-		0x43, 0x63, 0xe3, 0xdc, 0xb7, 0x0c, 0xf3, 0x25, 0x83, 0xb0, 0xdd, 0xdd, 0x12, 0x31, 0xee, 0x33,
-		0x83, 0x31, 0xee, 0xe7, 0x70, 0xbb, 0xfd, 0x2c, 0x81, 0x6b, 0x10, 0x94, 0xad, 0x28, 0x7b, 0x98,
-		0x00, 0x7e, 0x28, 0x04, 0x67, 0x86, 0xc2, 0xd9, 0x5c, 0x09, 0x46, 0x09, 0x61, 0x05, 0xe2, 0x25,
-		0xa1, 0x5b, 0x59, 0x88, 0x59, 0xb8, 0x69, 0x1a, 0x2d, 0x76, 0x5e, 0x3b, 0xac, 0x88, 0x24, 0x11,
-		0x84, 0xa1, 0x19, 0xa6, 0xc5, 0x2f, 0x4b, 0xb3, 0x44, 0xe1, 0x3d, 0x47, 0x14, 0xc4, 0xb4, 0xa8,
-		0x49, 0x48, 0xe3, 0xd2, 0x84, 0xd2, 0x10, 0x8d, 0xf0, 0x45, 0xfe, 0x27, 0x95, 0xca, 0x8f, 0x86,
-		0x60, 0x61, 0x50, 0x2a, 0x64, 0x64, 0x59, 0xb6, 0xd6, 0xe9, 0x8e, 0x12, 0xcb, 0x53, 0x90, 0x68,
-		0x08, 0x98, 0x23, 0xcb, 0xe5, 0xf6, 0x11, 0xe5, 0x92, 0x76, 0xaa, 0x12, 0x82, 0x59, 0x9e, 0x50,
-		0x30, 0x4e, 0x3b, 0xee, 0x48, 0x32, 0xef, 0x49, 0xc0, 0x29, 0x36, 0x9c, 0x54, 0x36, 0x94, 0x58,
-		0x82, 0xcb, 0x24, 0xe5, 0x2d, 0x1a, 0xbf, 0x4f, 0x92, 0x7b, 0x16, 0x66, 0x2b, 0xc4, 0x5a, 0x90,
-		0x55, 0x90, 0xbb, 0xc3, 0x13, 0x78, 0x9f, 0x7c, 0xd1, 0xe7, 0xf0, 0xf3, 0x0d, 0x46, 0x6f, 0x56,
-		0xee, 0xad, 0x12, 0xc8, 0xf5, 0xa6, 0xd6, 0xd6, 0x7a, 0x5f, 0x2d, 0x29, 0xf4, 0x38, 0x00, 0x3b,
-		0x0d, 0xe8, 0xbc, 0x47, 0x95, 0x5e, 0xce, 0x2e, 0x79, 0x1b, 0xb7, 0xc4, 0x6a, 0xa2, 0x77, 0xad,
-		0x12, 0x14, 0x96, 0xfc, 0xbc, 0xf0, 0x3c, 0x80, 0x5b, 0x80, 0x4e, 0xc3, 0xc9, 0x7a, 0x31, 0xbf,
-		0x9a, 0x57, 0xc4, 0x41, 0x9e, 0xfa, 0x7a, 0xb9, 0xc8, 0xde, 0x76, 0x39, 0x86, 0x4e, 0x00, 0xf2,
-		0x16, 0x3a, 0xf7, 0x53, 0x8e, 0xc3, 0x8c, 0x37, 0x9f, 0x3d, 0xb4, 0x11, 0x5a, 0xb9, 0x0e, 0x19,
-		0x76, 0x85, 0x5f, 0xd5, 0x5a, 0x2d, 0xdc, 0x52, 0x75, 0x03, 0x8d, 0xb9, 0x11, 0x9f, 0xfd, 0xdd,
-		0x7f, 0x37, 0x45, 0x9b, 0x36, 0xcd, 0x10, 0xf3, 0x04, 0xaf, 0x62, 0x10, 0x9f, 0x53, 0xef, 0x74,
-		0xdb, 0x98, 0xee, 0x5d, 0xab, 0xba, 0x90, 0xff, 0x78, 0x77, 0x86, 0xd0, 0x0b, 0x9f, 0x4f, 0x28,
-		0xb3, 0x2e, 0xba, 0xd3, 0x7b, 0x2b, 0xcf, 0xba, 0xe7, 0x05, 0x1d, 0x06, 0xc7, 0x52, 0xfc, 0x04,
-		0xe7, 0x50, 0x44, 0x33, 0x04, 0x8b, 0xab, 0x30, 0xa3, 0x35, 0x9b, 0xb8, 0xeb, 0xe3, 0x6f, 0xcc,
-		0x0c, 0x22, 0x5a, 0x2b, 0x73, 0x4c, 0x97, 0xb5, 0xc7, 0x21, 0x6a, 0xd1, 0x4e, 0x19, 0x47, 0x42,
-		0xb0, 0xc3, 0xc1, 0x57, 0xca, 0xe2, 0x7c, 0xac, 0xd3, 0xa2, 0x31, 0x04, 0xfe, 0x15, 0x27, 0x90,
-		0xa2, 0x68, 0xa2, 0x35, 0x06, 0xcc, 0xb0, 0x97, 0x94, 0xb0, 0xa7, 0x35, 0x87, 0x47, 0x51, 0x7e,
-		0xfd, 0x97, 0x1e, 0xa1, 0x1b, 0xf7, 0x67, 0xfd, 0x4a, 0x17, 0x30, 0x58, 0x14, 0x99, 0xd3, 0x76,
-		0xdb, 0x8b, 0x21, 0x2d, 0xea, 0xe3, 0xed, 0x3e, 0xbc, 0xb2, 0x7f, 0xc2, 0x2b, 0x3b, 0x13, 0xa4,
-		0xe1, 0x9e, 0x9a, 0xa6, 0x39, 0x55, 0x56, 0xb0, 0x52, 0x80, 0x69, 0xfa, 0xee, 0xa2, 0x23, 0x9c,
-		0xc3, 0x6b, 0xf9, 0xa7, 0xbf, 0xf4, 0x08, 0x1b, 0x68, 0x04, 0x89, 0x8b, 0xa6, 0x50, 0x1e, 0x65,
-		0xf5, 0x5e, 0x78, 0x70, 0x78, 0xfe, 0x66, 0xff, 0x1e, 0xa6, 0xd4, 0x9f, 0xf2, 0xb2, 0xea, 0x5a,
-		0xa7, 0x08, 0xcc, 0x68, 0x1d, 0xdd, 0x30, 0x2f, 0xd2, 0xbf, 0xdc, 0x2a, 0x4d, 0xd1, 0xc4, 0x04,
-		0xdb, 0xb6, 0x57, 0x98, 0xb1, 0x18, 0xaf, 0xb7, 0x7f, 0xf5, 0xfd, 0x3f, 0x35, 0xe5, 0x1a, 0x94,
-		0x95, 0xb5, 0xe1, 0xb3, 0xb2, 0xe3, 0x69, 0xfc, 0xb5, 0xa0, 0x31, 0x78, 0xf4, 0x75, 0xe5, 0x0d,
-		0x10, 0x77, 0xc8, 0x8c, 0xf3, 0xdd, 0x04, 0x11, 0x07, 0x83, 0x78, 0x6e, 0x4c, 0x69, 0x27, 0xf1,
-		0xd3, 0xbf, 0x28, 0xf0, 0x99, 0x0d, 0xab, 0x92, 0xd6, 0x5c, 0x83, 0x74, 0xcb, 0x34, 0x6c, 0xd5,
-		0xec, 0xe8, 0x36, 0xee, 0x74, 0xed, 0xb1, 0x9e, 0xef, 0xdf, 0x30, 0x22, 0x71, 0x65, 0x9a, 0xe0,
-		0xd5, 0x04, 0x1a, 0xe1, 0x84, 0x3d, 0xc6, 0x34, 0x09, 0x27, 0xff, 0xcd, 0xe1, 0x84, 0xe2, 0x10,
-		0x4e, 0xee, 0x48, 0x3b, 0xac, 0xd6, 0x2d, 0x3e, 0xdd, 0xd9, 0xfb, 0x4c, 0x0b, 0x1c, 0xed, 0xf8,
-		0x17, 0x11, 0x38, 0xc3, 0x81, 0xb7, 0x34, 0x0b, 0x5f, 0xdc, 0xbb, 0xb4, 0x85, 0x6d, 0xed, 0xd2,
-		0xc5, 0xa6, 0xa9, 0x0b, 0x5f, 0x67, 0x96, 0x4f, 0x67, 0xa4, 0x7c, 0x89, 0x97, 0xcf, 0x07, 0x1e,
-		0x08, 0x98, 0x1f, 0x3d, 0x0d, 0xce, 0x0f, 0xeb, 0x60, 0xae, 0x0d, 0x91, 0xa2, 0xa9, 0xd3, 0x5b,
-		0xa0, 0x2d, 0x6c, 0x98, 0x1d, 0x3e, 0x21, 0xb1, 0x04, 0xba, 0x0e, 0x51, 0xad, 0x63, 0xf6, 0x0d,
-		0x9b, 0x4d, 0x46, 0x85, 0x47, 0x7e, 0xe7, 0xb5, 0x85, 0x63, 0x7f, 0xf2, 0xda, 0xc2, 0x71, 0x46,
-		0xd6, 0x6a, 0xdd, 0x5a, 0xd2, 0xcd, 0x8b, 0x1d, 0xcd, 0xde, 0x25, 0x26, 0xe0, 0x0f, 0x3f, 0xf6,
-		0x30, 0xf0, 0xfa, 0x2a, 0x86, 0xfd, 0xe1, 0xcf, 0x7c, 0xf4, 0x82, 0xa4, 0x70, 0xfc, 0x95, 0xc8,
-		0xeb, 0xef, 0x5f, 0x90, 0x72, 0x5d, 0x88, 0x95, 0x70, 0xf3, 0x90, 0x0a, 0x2b, 0x03, 0x15, 0x5e,
-		0xe2, 0x15, 0x9e, 0x1e, 0xae, 0x90, 0xdd, 0x2e, 0x2b, 0xe1, 0xa6, 0xa7, 0xda, 0x12, 0x6e, 0x0e,
-		0xd4, 0x58, 0x81, 0x78, 0xc5, 0xb0, 0xd9, 0x73, 0x30, 0x4f, 0x43, 0x58, 0x37, 0xd8, 0x2b, 0x02,
-		0x89, 0xc2, 0x83, 0x47, 0x68, 0x8a, 0x42, 0xf0, 0x72, 0x35, 0x88, 0x97, 0x70, 0x93, 0x91, 0x2a,
-		0x42, 0xb8, 0x85, 0x9b, 0x9c, 0xd4, 0x1d, 0x30, 0x49, 0xb0, 0x0b, 0xa5, 0x3f, 0xfe, 0x4f, 0x67,
-		0x8e, 0xbd, 0xe5, 0x53, 0x67, 0x8e, 0x8d, 0x54, 0xa7, 0xdc, 0x78, 0x75, 0x72, 0xb4, 0xe8, 0x23,
-		0x54, 0x8b, 0x3a, 0xd8, 0xde, 0xda, 0xb6, 0x2f, 0x36, 0x7b, 0x07, 0x5d, 0xdb, 0xbc, 0xb8, 0x77,
-		0x89, 0x58, 0x15, 0x73, 0x9b, 0x6b, 0x11, 0x12, 0xe5, 0x4b, 0xac, 0x7c, 0x69, 0x6f, 0x84, 0x12,
-		0xe5, 0xb6, 0x61, 0x6a, 0x9d, 0x20, 0x92, 0x6e, 0xb2, 0x4d, 0x5b, 0x6b, 0x73, 0x6f, 0x91, 0x25,
-		0x48, 0x2e, 0x7b, 0xc8, 0x2c, 0xc4, 0x72, 0x75, 0xf1, 0x86, 0x59, 0x1b, 0x6b, 0xdb, 0xec, 0x31,
-		0x97, 0x30, 0x5d, 0x66, 0xc4, 0x49, 0x06, 0x7d, 0xb7, 0x65, 0x0e, 0xa6, 0xb4, 0x3e, 0x3b, 0xe8,
-		0x16, 0x26, 0xeb, 0x0f, 0x9a, 0xc8, 0xad, 0x42, 0x8c, 0x9f, 0x7b, 0x40, 0x32, 0x84, 0x6f, 0xe1,
-		0x03, 0x5a, 0x4f, 0x4a, 0x21, 0x3f, 0xd1, 0x45, 0x98, 0xa2, 0xdc, 0xf3, 0x3b, 0x74, 0xa7, 0x96,
-		0x86, 0xd9, 0x5f, 0xa2, 0x5c, 0x2a, 0x0c, 0x2e, 0x77, 0x03, 0xe2, 0x25, 0x93, 0x28, 0xb7, 0x9f,
-		0x5c, 0x82, 0x91, 0xa3, 0x4c, 0x77, 0xfb, 0xb6, 0xb8, 0xe8, 0x4c, 0x13, 0xe8, 0x04, 0x44, 0xd9,
-		0xeb, 0x3e, 0xfc, 0xb4, 0x1e, 0x4f, 0xe5, 0x8a, 0x10, 0xa3, 0xb4, 0x6b, 0x5d, 0xe7, 0xd5, 0x3d,
-		0xc9, 0xf3, 0xea, 0x1e, 0x27, 0x1f, 0x72, 0xb9, 0x45, 0x10, 0x69, 0x69, 0xb6, 0xc6, 0x1b, 0x4e,
-		0x7f, 0xe7, 0x9e, 0x81, 0x38, 0x27, 0x62, 0xa1, 0x47, 0x21, 0x6c, 0x76, 0xc5, 0x61, 0xd4, 0xd3,
-		0x23, 0xdb, 0x52, 0xeb, 0x16, 0x22, 0x44, 0x9f, 0x14, 0x02, 0x5d, 0x58, 0x1b, 0xa9, 0x1a, 0x8f,
-		0xfa, 0x54, 0x43, 0x74, 0xbb, 0xf8, 0xa1, 0x75, 0xf5, 0x8b, 0xc3, 0xca, 0xe0, 0xe8, 0xca, 0xab,
-		0x21, 0xb8, 0x3b, 0x40, 0x57, 0x6e, 0xe1, 0x03, 0xeb, 0xc8, 0xaa, 0xb2, 0x07, 0x89, 0x75, 0xfa,
-		0x2e, 0xf0, 0xb3, 0xf8, 0x00, 0xcd, 0x43, 0x0c, 0xb7, 0x96, 0x1f, 0x7b, 0xec, 0xd2, 0x93, 0xac,
-		0x23, 0xaf, 0x1f, 0x53, 0x44, 0x06, 0x3a, 0x03, 0x09, 0x0b, 0x37, 0xbb, 0xcb, 0x8f, 0x5d, 0xb9,
-		0x75, 0x89, 0x09, 0xee, 0xfa, 0x31, 0xc5, 0xcd, 0x42, 0x77, 0x41, 0x7c, 0xab, 0x6d, 0x5d, 0x5a,
-		0x7e, 0xf4, 0x89, 0x4b, 0x4c, 0x88, 0xd7, 0x8f, 0x29, 0x4e, 0xce, 0x4a, 0x9c, 0x0c, 0xe7, 0xd7,
-		0x3f, 0xb0, 0x20, 0x15, 0xa6, 0x20, 0x6c, 0xf5, 0x3b, 0x5f, 0x2f, 0xd1, 0xfc, 0x5a, 0x0c, 0xce,
-		0x3a, 0xc5, 0xcc, 0x60, 0xef, 0x2d, 0x5f, 0xe4, 0xb7, 0x5d, 0x9c, 0x97, 0xa6, 0x67, 0x1c, 0xf1,
-		0x50, 0x90, 0xa5, 0xbd, 0xe5, 0xf9, 0xc3, 0x05, 0x3a, 0x42, 0x78, 0x1f, 0x93, 0x20, 0xb5, 0x29,
-		0x68, 0xd7, 0xb1, 0x8d, 0xde, 0x00, 0xe0, 0xd4, 0x25, 0x94, 0xe5, 0xae, 0xa5, 0xa1, 0xda, 0x96,
-		0x1c, 0x24, 0xc5, 0x03, 0x8f, 0x9e, 0x80, 0x78, 0xb7, 0x67, 0x76, 0x4d, 0x8b, 0xbf, 0x05, 0x36,
-		0x0e, 0xd7, 0x81, 0x46, 0x0f, 0x01, 0xa2, 0x43, 0x5b, 0xdd, 0x33, 0x6d, 0xdd, 0xd8, 0x51, 0xbb,
-		0xe6, 0x4b, 0xfc, 0x95, 0xc6, 0xb0, 0x22, 0xd3, 0x92, 0x4d, 0x5a, 0xb0, 0x4e, 0xf2, 0x73, 0xff,
-		0x5d, 0x82, 0x84, 0x43, 0x85, 0xac, 0x29, 0xb5, 0x56, 0xab, 0x87, 0x2d, 0x8b, 0x8f, 0x5e, 0x91,
-		0x44, 0x2b, 0x10, 0xeb, 0xf6, 0xb7, 0x54, 0x31, 0x52, 0x92, 0xcb, 0x77, 0x07, 0xea, 0xbd, 0x50,
-		0x1f, 0x7a, 0x81, 0x38, 0xda, 0xed, 0x6f, 0x11, 0x55, 0x3a, 0x0b, 0xa9, 0x00, 0x5e, 0x92, 0x7b,
-		0x2e, 0x1b, 0xf4, 0xad, 0x6a, 0xde, 0x00, 0xb5, 0xdb, 0xd3, 0xcd, 0x9e, 0x6e, 0x1f, 0xd0, 0x33,
-		0x9b, 0x61, 0x45, 0x16, 0x05, 0xeb, 0x3c, 0x1f, 0xe5, 0x60, 0x9a, 0xf3, 0xa2, 0x6e, 0x1d, 0xd8,
-		0xfc, 0x3c, 0x72, 0x4a, 0x49, 0xb2, 0xea, 0x0a, 0x24, 0x0b, 0x2d, 0x42, 0x4a, 0xc0, 0x38, 0x2f,
-		0x86, 0x24, 0x14, 0x60, 0x20, 0x64, 0xe9, 0x94, 0x6b, 0x43, 0xa6, 0x4e, 0x97, 0x19, 0x6e, 0xf3,
-		0xaf, 0xb8, 0x8d, 0x94, 0x26, 0x68, 0xe4, 0xc8, 0x06, 0x86, 0x86, 0x1a, 0x78, 0xe1, 0x8f, 0x24,
-		0x48, 0x16, 0xda, 0x66, 0xf3, 0x56, 0xa5, 0x74, 0xb5, 0xad, 0xed, 0xa0, 0x4b, 0x70, 0xbc, 0xb0,
-		0x5a, 0x2b, 0x3e, 0xab, 0x56, 0x4a, 0xea, 0xd5, 0xd5, 0xbc, 0xe7, 0x66, 0xc2, 0xfc, 0x89, 0x57,
-		0x6e, 0x2f, 0x22, 0x0f, 0xec, 0x86, 0x41, 0x97, 0xd5, 0xe8, 0x22, 0xcc, 0xf9, 0x51, 0xf2, 0x85,
-		0x7a, 0xb9, 0xda, 0x90, 0xa5, 0xf9, 0xe3, 0xaf, 0xdc, 0x5e, 0x9c, 0xf1, 0x60, 0xe4, 0xb7, 0x2c,
-		0x6c, 0xd8, 0xc3, 0x08, 0xc5, 0xda, 0xda, 0x5a, 0xa5, 0x21, 0x87, 0x86, 0x10, 0x8a, 0x66, 0xa7,
-		0xa3, 0xdb, 0xe8, 0x01, 0x98, 0xf1, 0x23, 0x54, 0x2b, 0xab, 0x72, 0x78, 0x1e, 0xbd, 0x72, 0x7b,
-		0x31, 0xed, 0x81, 0xae, 0xea, 0xed, 0xf9, 0xf8, 0xdb, 0x7f, 0xe2, 0xcc, 0xb1, 0x0f, 0xff, 0x7f,
-		0x67, 0xa4, 0xc2, 0xea, 0xc8, 0xd1, 0xbb, 0x3c, 0xf9, 0xe8, 0x15, 0xc3, 0xd3, 0x19, 0xbc, 0xef,
-		0x0b, 0xc1, 0x82, 0x53, 0xba, 0x87, 0x7b, 0x96, 0x6e, 0x1a, 0x64, 0x1c, 0x32, 0xd5, 0x77, 0x5c,
-		0x29, 0xde, 0x39, 0x1c, 0x60, 0xb4, 0x69, 0x7b, 0x1a, 0xc2, 0xf9, 0x6e, 0x17, 0xcd, 0xd3, 0x51,
-		0x65, 0x9b, 0x4d, 0x93, 0x4d, 0x83, 0x11, 0xc5, 0x49, 0x93, 0x32, 0xcb, 0xdc, 0xb6, 0x5f, 0xd2,
-		0x7a, 0xce, 0xcb, 0xa0, 0x22, 0x9d, 0x7b, 0x12, 0x12, 0x45, 0xd3, 0xb0, 0xb0, 0x61, 0xf5, 0x69,
-		0x78, 0x65, 0x8b, 0x08, 0x83, 0x53, 0x60, 0x09, 0x32, 0x8d, 0x68, 0xdd, 0x2e, 0xc5, 0x8c, 0x28,
-		0xe4, 0x27, 0x73, 0x5b, 0x0a, 0xd5, 0x91, 0xe2, 0xb9, 0x3c, 0xb9, 0x78, 0x5c, 0x01, 0x38, 0x02,
-		0xfa, 0xd4, 0x19, 0x8f, 0xe1, 0x77, 0xac, 0x9b, 0x57, 0x3c, 0x01, 0x96, 0x6d, 0x8c, 0x5b, 0x31,
-		0x3f, 0xde, 0x5e, 0xce, 0x8f, 0xeb, 0x95, 0x11, 0xbe, 0xec, 0xb8, 0x60, 0x57, 0xee, 0x49, 0x98,
-		0x5e, 0xd7, 0x7a, 0x76, 0x1d, 0xdb, 0xd7, 0xb1, 0xd6, 0xc2, 0x3d, 0xbf, 0xbf, 0x32, 0x2d, 0xfc,
-		0x15, 0x04, 0x11, 0xea, 0x94, 0xb0, 0xe9, 0x9a, 0xfe, 0xce, 0xe9, 0x10, 0xa1, 0xf7, 0x53, 0x1d,
-		0x5f, 0x86, 0x63, 0x30, 0x5f, 0x86, 0x74, 0x17, 0xb5, 0x12, 0x3c, 0x5c, 0x4a, 0x13, 0xe8, 0x31,
-		0xe1, 0x91, 0x84, 0xc7, 0x78, 0x24, 0x7c, 0x0e, 0xe7, 0x7e, 0x49, 0x07, 0x62, 0x7c, 0x20, 0x38,
-		0x9c, 0x48, 0x2e, 0x27, 0xa8, 0x0a, 0x99, 0xae, 0xd6, 0xb3, 0xe9, 0x73, 0x57, 0xbb, 0xb4, 0x19,
-		0xdc, 0x5a, 0x2e, 0x06, 0x18, 0x6f, 0x5f, 0x73, 0x79, 0x35, 0xd3, 0x5d, 0x6f, 0x66, 0xee, 0xf5,
-		0x08, 0x44, 0xb9, 0x38, 0xde, 0x08, 0x31, 0x2e, 0x70, 0x6e, 0x9b, 0xce, 0x2c, 0x05, 0xa8, 0xff,
-		0x92, 0xa3, 0xa6, 0x9c, 0xa0, 0x40, 0x42, 0xf7, 0x41, 0xbc, 0xb9, 0xab, 0xe9, 0x86, 0xaa, 0xb7,
-		0xb8, 0x47, 0x9e, 0xfc, 0xd4, 0x6b, 0x0b, 0xb1, 0x22, 0xc9, 0xab, 0x94, 0x94, 0x18, 0x2d, 0xac,
-		0xb4, 0x88, 0x17, 0xb5, 0x8b, 0xf5, 0x9d, 0x5d, 0x9b, 0x9b, 0x69, 0x9e, 0x42, 0x4f, 0x40, 0x84,
-		0x74, 0x19, 0xbf, 0x45, 0x32, 0x3f, 0xb4, 0xd4, 0x72, 0xa2, 0x85, 0x85, 0x38, 0xa9, 0xf8, 0x5d,
-		0xff, 0x61, 0x41, 0x52, 0x28, 0x06, 0x2a, 0xc1, 0x74, 0x5b, 0xb3, 0x6c, 0x95, 0x8e, 0x13, 0x52,
-		0xfd, 0x14, 0x27, 0x31, 0x2c, 0x12, 0x2e, 0x5b, 0xce, 0x7b, 0x92, 0xa0, 0xb1, 0xac, 0x16, 0x3a,
-		0x0f, 0x32, 0xa5, 0xd2, 0xa4, 0xa6, 0x8a, 0x79, 0xa6, 0x51, 0x2a, 0xfa, 0x34, 0xc9, 0x67, 0x16,
-		0x8c, 0xfa, 0xa7, 0xa7, 0x21, 0x41, 0x9f, 0x75, 0xa3, 0x20, 0xec, 0x62, 0x74, 0x9c, 0x64, 0xd0,
-		0xc2, 0xfb, 0x21, 0xe3, 0xce, 0xb2, 0x0c, 0x24, 0xce, 0xa8, 0xb8, 0xd9, 0x14, 0xf0, 0x11, 0x98,
-		0x33, 0xf0, 0xbe, 0xad, 0x0e, 0x42, 0x27, 0x28, 0x34, 0x22, 0x65, 0x9b, 0x7e, 0x8c, 0x7b, 0x21,
-		0xdd, 0x14, 0xd2, 0x67, 0xb0, 0x40, 0x61, 0xa7, 0x9d, 0x5c, 0x0a, 0x76, 0x0a, 0xe2, 0x5a, 0xb7,
-		0xcb, 0x00, 0x92, 0x7c, 0x92, 0xed, 0x76, 0x69, 0xd1, 0x05, 0x98, 0xa1, 0x6d, 0xec, 0x61, 0xab,
-		0xdf, 0xb6, 0x39, 0x91, 0x14, 0x85, 0xc9, 0x90, 0x02, 0x85, 0xe5, 0x53, 0xd8, 0x73, 0x30, 0x8d,
-		0xf7, 0xf4, 0x16, 0x36, 0x9a, 0x98, 0xc1, 0x4d, 0x53, 0xb8, 0x94, 0xc8, 0xa4, 0x40, 0x0f, 0x80,
-		0x33, 0x7b, 0xaa, 0x62, 0x62, 0x4f, 0x33, 0x7a, 0x22, 0x3f, 0xcf, 0xb2, 0x73, 0x59, 0x88, 0x94,
-		0x34, 0x5b, 0x23, 0x76, 0xcc, 0xde, 0x67, 0xfe, 0x4a, 0x4a, 0x21, 0x3f, 0x73, 0xef, 0x8f, 0x40,
-		0x64, 0xd3, 0xb4, 0x31, 0xba, 0xec, 0xf1, 0x9e, 0xd3, 0x81, 0x2a, 0x5d, 0xd7, 0x77, 0x0c, 0xdc,
-		0x5a, 0xb3, 0x76, 0x3c, 0xcf, 0x31, 0xbb, 0x0a, 0x15, 0xf2, 0x29, 0xd4, 0x1c, 0x4c, 0xf5, 0xcc,
-		0xbe, 0xd1, 0x12, 0xd7, 0x35, 0x68, 0x02, 0x5d, 0x25, 0xae, 0x23, 0xd7, 0x93, 0xc8, 0x58, 0x3d,
-		0xc9, 0x10, 0x3d, 0x21, 0x6a, 0xcc, 0x33, 0x94, 0xd8, 0x16, 0x57, 0x97, 0x02, 0x24, 0x1c, 0x0b,
-		0xe3, 0x28, 0xdc, 0x24, 0x3a, 0xeb, 0xa2, 0x11, 0xa7, 0xc4, 0xe9, 0x7d, 0x47, 0x7c, 0x4c, 0xe7,
-		0x64, 0xa7, 0x80, 0xcb, 0xcf, 0xa7, 0x58, 0xfc, 0x6d, 0xe8, 0x18, 0x6d, 0x98, 0xab, 0x58, 0xec,
-		0x7d, 0xe8, 0xbb, 0x20, 0x61, 0xe9, 0x3b, 0x06, 0xbd, 0x02, 0xc5, 0x75, 0xcf, 0xcd, 0x20, 0xa5,
-		0xee, 0x05, 0x7b, 0xa6, 0x6b, 0x9e, 0xcf, 0x18, 0x5c, 0x84, 0x59, 0xf7, 0x03, 0x02, 0x2e, 0x15,
-		0xa6, 0x67, 0xc8, 0x29, 0xaa, 0x3b, 0xe4, 0xce, 0x83, 0x6c, 0x98, 0x86, 0xda, 0xeb, 0x7a, 0xae,
-		0xed, 0x33, 0xa5, 0x4b, 0x1b, 0xa6, 0xa1, 0x74, 0x9d, 0xb3, 0x02, 0xe8, 0x29, 0x98, 0x1f, 0x84,
-		0xf4, 0xd4, 0xc0, 0x94, 0xf0, 0xa4, 0x1f, 0xc7, 0xa9, 0x26, 0xf7, 0xcf, 0x25, 0x88, 0x72, 0x1f,
-		0xc2, 0xed, 0x6e, 0x29, 0xb8, 0xbb, 0x43, 0xa3, 0xba, 0x3b, 0xfc, 0x55, 0x75, 0x37, 0x38, 0xcc,
-		0x5a, 0xfc, 0xa5, 0xe1, 0x20, 0x87, 0x99, 0x31, 0x59, 0xd7, 0x77, 0xb8, 0x89, 0xf1, 0x60, 0xe5,
-		0x5e, 0x93, 0xc8, 0x2c, 0xcf, 0xcb, 0x51, 0x01, 0xa6, 0x05, 0x67, 0xea, 0x76, 0x5b, 0xdb, 0xe1,
-		0x5a, 0x7f, 0x66, 0x34, 0x7b, 0xc4, 0x35, 0x52, 0x92, 0x9c, 0x23, 0xea, 0xe4, 0x05, 0x2a, 0x50,
-		0x68, 0x84, 0x02, 0xf9, 0x34, 0x36, 0x7c, 0x67, 0x1a, 0xeb, 0xd3, 0xad, 0xc8, 0x80, 0x6e, 0xe5,
-		0x3e, 0x2d, 0xf1, 0xaf, 0x1c, 0xb4, 0xd8, 0xcd, 0xb2, 0xff, 0x65, 0xbd, 0xf5, 0xcd, 0x5c, 0x8d,
-		0x5b, 0xb8, 0xa5, 0x0e, 0x75, 0xdb, 0x3d, 0x01, 0x24, 0xfd, 0x5c, 0xbb, 0xdd, 0x87, 0x04, 0x99,
-		0xba, 0xdb, 0x8d, 0xef, 0x0d, 0xc3, 0xcc, 0x10, 0xfc, 0xdf, 0xc3, 0xee, 0xf4, 0x9b, 0x8a, 0xa9,
-		0x09, 0x4d, 0x45, 0xf4, 0x48, 0xa6, 0x22, 0x76, 0x07, 0xa6, 0x22, 0x7e, 0xb8, 0xa9, 0xf8, 0xe5,
-		0x10, 0x8d, 0xa4, 0x74, 0x4d, 0x4b, 0x6b, 0xff, 0x9d, 0xcc, 0x28, 0xa7, 0x21, 0xd1, 0x35, 0xdb,
-		0x2a, 0x2b, 0x61, 0xd7, 0x00, 0xe3, 0x5d, 0xb3, 0xad, 0x0c, 0x69, 0xf4, 0xd4, 0xd7, 0x6a, 0xba,
-		0x89, 0x7e, 0x0d, 0x7a, 0x3b, 0x36, 0x38, 0x78, 0x6d, 0x48, 0x31, 0x59, 0x70, 0x7f, 0xf0, 0x12,
-		0x11, 0x02, 0xf5, 0x30, 0xa5, 0x41, 0x0f, 0xd6, 0xe1, 0x9b, 0x81, 0x2a, 0x1c, 0x90, 0xa0, 0x30,
-		0xef, 0x69, 0x38, 0x0c, 0x37, 0x60, 0x20, 0x15, 0x0e, 0x98, 0x7b, 0x55, 0x02, 0x58, 0x25, 0xc2,
-		0xa5, 0x2d, 0x26, 0xae, 0x9c, 0x45, 0x99, 0x50, 0x7d, 0x75, 0x2f, 0x8c, 0xec, 0x38, 0xce, 0x41,
-		0xca, 0xf2, 0xb2, 0x5e, 0x82, 0x69, 0x77, 0x1c, 0x59, 0x58, 0xb0, 0xb3, 0x70, 0x58, 0x80, 0xa3,
-		0x8e, 0x6d, 0x25, 0xb5, 0xe7, 0x49, 0xe5, 0x7e, 0x53, 0x82, 0x04, 0xe5, 0x6a, 0x0d, 0xdb, 0x9a,
-		0xaf, 0x23, 0xa5, 0xaf, 0xa2, 0x23, 0xef, 0x06, 0x60, 0x74, 0x2c, 0xfd, 0x65, 0xcc, 0xf5, 0x2b,
-		0x41, 0x73, 0xea, 0xfa, 0xcb, 0x18, 0x3d, 0xee, 0x48, 0x3d, 0x3c, 0x46, 0xea, 0xdc, 0x42, 0x09,
-		0xd9, 0x9f, 0x84, 0x18, 0x7d, 0x74, 0x66, 0xdf, 0xe2, 0x61, 0x8d, 0xa8, 0xd1, 0xef, 0x34, 0xf6,
-		0xad, 0xdc, 0x2d, 0x88, 0x35, 0xf6, 0x59, 0x84, 0xf6, 0x34, 0x24, 0x7a, 0xa6, 0xc9, 0x7d, 0x5b,
-		0xb6, 0xac, 0x88, 0x93, 0x0c, 0xea, 0xca, 0x89, 0xa0, 0x64, 0xc8, 0x0d, 0x4a, 0xba, 0x61, 0xd5,
-		0xf0, 0x64, 0x61, 0xd5, 0x0b, 0x7f, 0x24, 0xc1, 0xb4, 0x6f, 0x44, 0xa1, 0x87, 0xe0, 0x64, 0xbd,
-		0x72, 0xad, 0x5a, 0x2e, 0xa9, 0x6b, 0xf5, 0x6b, 0x03, 0xaf, 0x41, 0xcc, 0x67, 0x5e, 0xb9, 0xbd,
-		0x98, 0xe4, 0xe1, 0x87, 0x51, 0xd0, 0xeb, 0x4a, 0x79, 0xb3, 0xd6, 0x28, 0xcb, 0x12, 0x83, 0x5e,
-		0xef, 0xe1, 0x3d, 0xd3, 0x66, 0x1f, 0xee, 0x7a, 0x04, 0x4e, 0x05, 0x40, 0x3b, 0x41, 0x88, 0x99,
-		0x57, 0x6e, 0x2f, 0x4e, 0xaf, 0xf7, 0x30, 0x53, 0x35, 0x8a, 0xb1, 0x04, 0xd9, 0x61, 0x8c, 0xda,
-		0x7a, 0xad, 0x9e, 0x5f, 0x95, 0x17, 0xe7, 0xe5, 0x57, 0x6e, 0x2f, 0xa6, 0x84, 0xed, 0x20, 0xf0,
-		0x5f, 0xff, 0x28, 0x44, 0x64, 0xf8, 0xec, 0xca, 0x4b, 0x3d, 0xad, 0xdb, 0xc5, 0x3d, 0x6b, 0xd4,
-		0x21, 0x8d, 0x73, 0x90, 0x2c, 0x79, 0x5e, 0x6a, 0x72, 0x4e, 0xeb, 0x48, 0xf4, 0x15, 0x27, 0x96,
-		0xc8, 0xe5, 0x00, 0xae, 0xb6, 0x4d, 0xcd, 0x0e, 0x80, 0x09, 0x79, 0x60, 0x2a, 0x86, 0x7d, 0xe5,
-		0x72, 0x00, 0x4c, 0x58, 0xc0, 0x9c, 0x83, 0xe4, 0xc6, 0x28, 0xa0, 0x88, 0x9f, 0xd0, 0xa3, 0xcb,
-		0x01, 0x30, 0x53, 0x03, 0x84, 0x02, 0x81, 0xa6, 0x05, 0xd0, 0x59, 0x48, 0x14, 0x4c, 0xb3, 0x1d,
-		0x00, 0x12, 0xf7, 0xd0, 0xa9, 0x7b, 0x1e, 0xa1, 0xf2, 0x01, 0x25, 0x3c, 0x0c, 0xd1, 0x28, 0x5d,
-		0x00, 0x8c, 0x73, 0x9e, 0xe9, 0xc8, 0xc7, 0x78, 0x9e, 0xe3, 0xfd, 0x72, 0xd4, 0x63, 0x3c, 0xa2,
-		0x3f, 0xef, 0xec, 0x18, 0x4f, 0xca, 0xb3, 0x55, 0xe3, 0xc4, 0x4c, 0xba, 0x5a, 0x4f, 0xeb, 0x1c,
-		0x12, 0x86, 0x09, 0x0e, 0x91, 0x8c, 0x39, 0x25, 0x35, 0x3f, 0x46, 0x13, 0x73, 0x1f, 0x0f, 0x43,
-		0xc6, 0x59, 0xfe, 0xaf, 0x53, 0x16, 0xd0, 0x65, 0x6f, 0xac, 0x2a, 0x39, 0xda, 0xdd, 0x61, 0xe0,
-		0x22, 0x96, 0xf5, 0x34, 0xc4, 0xc5, 0x32, 0x92, 0xdb, 0xe6, 0xb3, 0x41, 0x4e, 0x19, 0x07, 0xe1,
-		0xb8, 0x0e, 0x0a, 0xfa, 0x26, 0x48, 0x38, 0x96, 0x9a, 0x9b, 0xa6, 0xdc, 0x61, 0xb6, 0x9d, 0x13,
-		0x70, 0x91, 0xd0, 0x8a, 0x1b, 0xec, 0x88, 0x8c, 0x8c, 0x9f, 0x6c, 0x32, 0x08, 0x8e, 0xed, 0x04,
-		0x3a, 0x1e, 0x83, 0x88, 0xb6, 0xd5, 0xd4, 0xf9, 0x74, 0x7e, 0x77, 0x00, 0x62, 0xbe, 0x50, 0xac,
-		0x30, 0x2c, 0x1a, 0xa6, 0xa6, 0xe0, 0x84, 0x69, 0xeb, 0xc0, 0x68, 0xee, 0xf6, 0x4c, 0xe3, 0x80,
-		0xcf, 0xe0, 0x41, 0x4c, 0xd7, 0x05, 0x8c, 0x60, 0xda, 0x41, 0x22, 0x4c, 0xf3, 0x97, 0x2d, 0xf8,
-		0x53, 0x98, 0x41, 0x4c, 0xf3, 0x17, 0x31, 0x04, 0xd3, 0x1c, 0x21, 0x57, 0xe1, 0xd1, 0x61, 0xde,
-		0x6d, 0xf4, 0x19, 0xf2, 0x7d, 0x1e, 0xdd, 0x66, 0x03, 0x3e, 0xde, 0xd1, 0xf6, 0x59, 0x68, 0xfb,
-		0x24, 0xc4, 0x48, 0xe1, 0x0e, 0x7f, 0x0f, 0x34, 0xac, 0x44, 0x3b, 0xda, 0xfe, 0x35, 0xcd, 0xba,
-		0x11, 0x89, 0x87, 0xe5, 0x48, 0xee, 0x23, 0xc4, 0xcb, 0xf7, 0x75, 0x0d, 0x7a, 0x10, 0x10, 0xc1,
-		0xd0, 0x76, 0xb0, 0x4a, 0x26, 0x21, 0xda, 0xc9, 0x82, 0x6e, 0xa6, 0xa3, 0xed, 0xe7, 0x77, 0x70,
-		0xb5, 0xdf, 0xa1, 0x0c, 0x58, 0x68, 0x0d, 0x64, 0x01, 0x2c, 0x14, 0xd0, 0xf1, 0x17, 0x86, 0xbe,
-		0xe5, 0xc6, 0x01, 0x98, 0x43, 0xf3, 0x2a, 0x71, 0x68, 0xd2, 0x8c, 0x9e, 0x73, 0x7c, 0xcf, 0xd7,
-		0x94, 0xb0, 0xbf, 0x29, 0xb9, 0x67, 0x20, 0x33, 0xa0, 0x05, 0xde, 0xe0, 0x3e, 0x15, 0x1a, 0x8d,
-		0x44, 0x24, 0x44, 0x70, 0x9f, 0x8e, 0xbe, 0x95, 0xf8, 0xaf, 0xbe, 0x7f, 0x41, 0xa2, 0x9b, 0xc2,
-		0x0f, 0xc2, 0xb4, 0x4f, 0x0d, 0x44, 0x18, 0x56, 0x72, 0xc3, 0xb0, 0x2e, 0xf0, 0x0b, 0x90, 0x22,
-		0x53, 0x29, 0x6e, 0x71, 0xd8, 0xfb, 0x20, 0xc3, 0xe6, 0xfa, 0x41, 0x59, 0xb3, 0xa5, 0xc2, 0x9a,
-		0x10, 0x78, 0x4e, 0xac, 0x1d, 0xfc, 0x62, 0x4f, 0x0a, 0xa8, 0x6b, 0x9a, 0x95, 0xfb, 0x11, 0x09,
-		0x32, 0x03, 0xba, 0x81, 0x9e, 0x86, 0x44, 0xb7, 0x87, 0x9b, 0xba, 0x27, 0x68, 0x77, 0x88, 0x08,
-		0x23, 0x54, 0x7c, 0x2e, 0x06, 0x71, 0x93, 0xc4, 0x99, 0x8f, 0x16, 0x6e, 0x6b, 0x07, 0xe3, 0x7b,
-		0x81, 0x91, 0x10, 0x9f, 0xdc, 0x2c, 0x11, 0xa4, 0xdc, 0x6f, 0x4b, 0x30, 0xed, 0x53, 0x3a, 0xd4,
-		0x82, 0xbb, 0xc9, 0x14, 0xed, 0xbd, 0x67, 0xc0, 0x3f, 0x02, 0xe1, 0x59, 0x0a, 0x06, 0x3d, 0xef,
-		0xe2, 0x4e, 0x34, 0xd4, 0xb9, 0x91, 0x94, 0x79, 0x42, 0xc7, 0xbd, 0x6e, 0xc0, 0xbe, 0x16, 0x71,
-		0x9d, 0x39, 0xe3, 0x35, 0x40, 0xdd, 0x2d, 0x7b, 0x90, 0x74, 0x68, 0x52, 0xd2, 0x32, 0x41, 0xf6,
-		0x12, 0xcc, 0xd5, 0x01, 0xdc, 0x81, 0x8b, 0xf2, 0x93, 0x34, 0x22, 0x7c, 0x18, 0x87, 0x2b, 0xa1,
-		0xac, 0x54, 0x58, 0xff, 0xf0, 0xa7, 0xce, 0x48, 0x5f, 0x17, 0xd7, 0xe1, 0x5d, 0xcf, 0xc3, 0x5d,
-		0x2e, 0xe8, 0x56, 0x53, 0x1f, 0x0c, 0xcf, 0xcb, 0x8e, 0x71, 0x20, 0xa5, 0x93, 0x44, 0xe7, 0xc7,
-		0xcc, 0x34, 0x93, 0x44, 0xef, 0xef, 0x2c, 0x38, 0x3f, 0x6e, 0x6a, 0xca, 0xfd, 0x7e, 0x02, 0x62,
-		0x0a, 0x7e, 0x73, 0x1f, 0x5b, 0x36, 0x7a, 0x14, 0x22, 0xb8, 0xb9, 0x6b, 0x0e, 0xef, 0xa0, 0xf1,
-		0x66, 0x2e, 0x95, 0x9b, 0xbb, 0x26, 0x07, 0xbe, 0x7e, 0x4c, 0xa1, 0xc0, 0xe8, 0x0a, 0x4c, 0x6d,
-		0xb7, 0xfb, 0x3c, 0xae, 0xef, 0x9b, 0xa7, 0x04, 0xd6, 0x55, 0x52, 0xec, 0xa2, 0x31, 0x70, 0x52,
-		0x19, 0xfd, 0x18, 0x6a, 0x78, 0x54, 0x65, 0xf4, 0x1b, 0xa8, 0x6e, 0x65, 0x04, 0x18, 0x15, 0x01,
-		0x74, 0x43, 0xb7, 0x55, 0x1a, 0xf2, 0xe6, 0xf3, 0x44, 0x2e, 0x08, 0x55, 0xb7, 0x69, 0x78, 0xdc,
-		0xc5, 0x4f, 0xe8, 0x22, 0x8f, 0x70, 0xfc, 0xe6, 0x3e, 0xee, 0x89, 0xb9, 0x22, 0x80, 0xe3, 0x37,
-		0x91, 0x62, 0x0f, 0xc7, 0x14, 0x9c, 0xcc, 0xad, 0xec, 0x4b, 0x37, 0xf6, 0x3e, 0xff, 0x04, 0xdc,
-		0xe2, 0x30, 0x2a, 0xfd, 0xd0, 0x4d, 0x63, 0xdf, 0x45, 0x8e, 0x35, 0x59, 0x0e, 0x7a, 0xd2, 0x59,
-		0xc3, 0x25, 0x07, 0x17, 0x4d, 0x0e, 0x32, 0x5b, 0xc2, 0x39, 0xb8, 0x1c, 0x01, 0xd5, 0x20, 0xdd,
-		0xd6, 0x2d, 0x5b, 0xb5, 0x0c, 0xad, 0x6b, 0xed, 0x9a, 0xb6, 0xf8, 0xd8, 0xc4, 0x7d, 0xc3, 0x24,
-		0x56, 0x75, 0xcb, 0xae, 0x0b, 0x30, 0x97, 0xd2, 0x74, 0xdb, 0x9b, 0x4f, 0x08, 0x9a, 0xdb, 0xdb,
-		0xb8, 0xe7, 0x50, 0xa4, 0x31, 0xe8, 0x40, 0x82, 0x35, 0x02, 0x27, 0x30, 0x3d, 0x04, 0x4d, 0x6f,
-		0x3e, 0xfa, 0x16, 0x98, 0x6d, 0x9b, 0x5a, 0xcb, 0xa1, 0xa7, 0x36, 0x77, 0xfb, 0xc6, 0x2d, 0x1a,
-		0xb1, 0x4e, 0x2e, 0x5f, 0x08, 0x60, 0xd3, 0xd4, 0x5a, 0x02, 0xb9, 0x48, 0x40, 0x5d, 0xca, 0x33,
-		0xed, 0xc1, 0x32, 0xa4, 0xc2, 0x9c, 0xd6, 0xed, 0xb6, 0x0f, 0x06, 0xc9, 0x67, 0x28, 0xf9, 0x07,
-		0x87, 0xc9, 0xe7, 0x09, 0xf4, 0x08, 0xfa, 0x48, 0x1b, 0x2a, 0x44, 0x1b, 0x20, 0x77, 0x7b, 0x98,
-		0x5e, 0x42, 0xee, 0xf2, 0x55, 0x0a, 0xfd, 0x9a, 0x43, 0x72, 0xf9, 0xfc, 0x30, 0xf1, 0x75, 0x06,
-		0x29, 0x96, 0x33, 0x2e, 0xe5, 0x4c, 0xd7, 0x5f, 0xc2, 0xc8, 0x9a, 0x4d, 0x4c, 0xbf, 0x7d, 0xc3,
-		0xc9, 0xce, 0x8c, 0x26, 0x4b, 0x21, 0x03, 0xc9, 0xfa, 0x4a, 0xd0, 0x55, 0x48, 0xb2, 0xe8, 0x99,
-		0x4a, 0x6c, 0x24, 0xfd, 0xa8, 0x50, 0x72, 0xf9, 0x5c, 0xc0, 0x70, 0xa5, 0x40, 0x9b, 0xa6, 0x8d,
-		0x5d, 0x62, 0x80, 0x9d, 0x4c, 0xb4, 0x05, 0xc7, 0xe9, 0x17, 0x31, 0x0e, 0x54, 0xbf, 0x41, 0xce,
-		0xce, 0xf2, 0x57, 0xac, 0x87, 0x28, 0xd2, 0xcf, 0x54, 0x1e, 0x6c, 0x7a, 0x2d, 0xb3, 0x4b, 0x7a,
-		0x76, 0x6f, 0xb8, 0x94, 0x68, 0xda, 0xb6, 0x6e, 0x68, 0x6d, 0xfd, 0x65, 0xcc, 0xbc, 0x17, 0xfa,
-		0x85, 0xa9, 0x40, 0x4d, 0xbb, 0xca, 0xe1, 0xa8, 0x37, 0xe3, 0xd1, 0xb4, 0x6d, 0x6f, 0x7e, 0x21,
-		0xc6, 0xd7, 0x1c, 0xce, 0x5b, 0xf1, 0x31, 0x39, 0xce, 0xbe, 0x68, 0x72, 0x23, 0x12, 0x07, 0x39,
-		0x99, 0xbb, 0x1f, 0x92, 0x1e, 0x3b, 0x85, 0xb2, 0x10, 0xe3, 0xb3, 0xaa, 0xb8, 0x8d, 0xc1, 0x93,
-		0xb9, 0x34, 0xa4, 0xbc, 0xa6, 0x29, 0xf7, 0x2e, 0x09, 0x92, 0x1e, 0xa3, 0x43, 0x30, 0xbd, 0xfb,
-		0x76, 0x09, 0xd7, 0x51, 0x3d, 0x27, 0xdc, 0x0a, 0x51, 0xce, 0xf6, 0x8e, 0x53, 0x34, 0x93, 0x7b,
-		0x35, 0x68, 0x01, 0x92, 0xdd, 0xe5, 0xae, 0x03, 0x12, 0xa6, 0x20, 0xd0, 0x5d, 0xee, 0x0a, 0x80,
-		0xb3, 0x90, 0x22, 0x4d, 0x57, 0xbd, 0xfe, 0x72, 0x42, 0x49, 0x92, 0x3c, 0x0e, 0x92, 0xfb, 0xbd,
-		0x10, 0xc8, 0x83, 0xc6, 0xcc, 0xd9, 0xcf, 0x93, 0x8e, 0xbc, 0x9f, 0x77, 0x6a, 0x70, 0x27, 0xd1,
-		0xdd, 0x3c, 0x5c, 0x03, 0xd9, 0xdd, 0x02, 0x63, 0x73, 0xd3, 0x21, 0x0b, 0x80, 0x81, 0xc5, 0x8a,
-		0x92, 0x69, 0x0e, 0xac, 0x5e, 0xae, 0xf9, 0x8e, 0xd0, 0x44, 0x9c, 0xf3, 0xca, 0x83, 0xfa, 0x24,
-		0x60, 0x36, 0xba, 0x2d, 0xcd, 0xc6, 0x22, 0xb4, 0xef, 0x39, 0x4d, 0x73, 0x1f, 0x64, 0xb4, 0x6e,
-		0x57, 0xb5, 0x6c, 0xcd, 0xc6, 0xbe, 0x33, 0x23, 0xd3, 0x5a, 0xb7, 0x4b, 0xbf, 0x94, 0xca, 0x3c,
-		0xbd, 0x7b, 0x21, 0x4d, 0x2c, 0xbc, 0xae, 0xb5, 0x85, 0x1b, 0x11, 0x65, 0x0e, 0x21, 0xcf, 0xe5,
-		0xae, 0x48, 0x0b, 0x52, 0x5e, 0xe3, 0xee, 0xc4, 0x66, 0x24, 0x4f, 0x6c, 0x06, 0xf1, 0x67, 0xcc,
-		0x98, 0x84, 0xc4, 0x8b, 0x73, 0xc1, 0x7b, 0xab, 0x73, 0x34, 0x8e, 0xb3, 0xc7, 0x62, 0xbc, 0x71,
-		0x85, 0x25, 0x72, 0x37, 0x21, 0xed, 0x9f, 0x07, 0x50, 0x1a, 0x42, 0xf6, 0x3e, 0xaf, 0x25, 0x64,
-		0xef, 0xa3, 0x4b, 0x9e, 0x8f, 0xc8, 0xa6, 0x83, 0x66, 0x3f, 0x8e, 0xef, 0xc6, 0x4e, 0xd9, 0x13,
-		0x7c, 0xb9, 0x0c, 0x4c, 0xfb, 0x66, 0x89, 0xdc, 0x09, 0x98, 0x0b, 0xb2, 0xf9, 0x39, 0x1d, 0xe6,
-		0x82, 0x4c, 0x37, 0xba, 0x02, 0x71, 0xc7, 0xe8, 0x0f, 0x85, 0xdb, 0x44, 0xed, 0x0e, 0x92, 0x03,
-		0xeb, 0xdb, 0xfc, 0x0c, 0xf9, 0x36, 0x3f, 0x73, 0xdf, 0x0e, 0xd9, 0x51, 0xf6, 0x7c, 0x60, 0x9b,
-		0x22, 0xe2, 0x08, 0xee, 0x04, 0x44, 0xf9, 0xe3, 0xbd, 0x21, 0x1a, 0xa7, 0xe0, 0x29, 0x22, 0x50,
-		0x66, 0xdb, 0xc3, 0x2c, 0x7c, 0x41, 0x13, 0x39, 0x15, 0x4e, 0x8d, 0x34, 0xe9, 0xa3, 0x0f, 0x0f,
-		0x30, 0x42, 0xfc, 0xf0, 0x00, 0x4d, 0xd0, 0x6f, 0xa8, 0x63, 0x43, 0x44, 0x01, 0x13, 0x0a, 0x4f,
-		0xe5, 0xde, 0x1d, 0x86, 0x13, 0xc1, 0x76, 0x1d, 0x2d, 0x42, 0x8a, 0xac, 0x1e, 0x6c, 0xff, 0x42,
-		0x03, 0x3a, 0xda, 0x7e, 0x83, 0xaf, 0x32, 0xf8, 0xc6, 0x6b, 0xc8, 0xd9, 0x78, 0x45, 0x9b, 0x30,
-		0xd3, 0x36, 0x9b, 0x5a, 0x5b, 0xf5, 0x6c, 0x7c, 0xf3, 0xe1, 0x74, 0xcf, 0x28, 0x3b, 0x2d, 0xf6,
-		0x3c, 0x88, 0x09, 0xe2, 0x03, 0x21, 0x43, 0x89, 0xac, 0x3a, 0x9b, 0xe4, 0xa8, 0x0c, 0xc9, 0x8e,
-		0x6e, 0x6d, 0xe1, 0x5d, 0x6d, 0x4f, 0x37, 0x7b, 0x7c, 0x5c, 0x05, 0x68, 0xcf, 0x9a, 0x0b, 0x24,
-		0x76, 0xe4, 0x3d, 0x78, 0x9e, 0x4e, 0x99, 0x0a, 0x3c, 0x29, 0x10, 0x3d, 0xb2, 0x65, 0x19, 0xb5,
-		0xe7, 0x1e, 0x1b, 0xb9, 0xe7, 0x1e, 0xb4, 0xc1, 0x1d, 0x0f, 0xde, 0xe0, 0x7e, 0x85, 0x76, 0x4e,
-		0xd0, 0xec, 0x38, 0xbc, 0xe7, 0x8d, 0x1a, 0x30, 0xc7, 0xf1, 0x5b, 0x3e, 0xe9, 0x0f, 0x1d, 0xc5,
-		0xf3, 0x3b, 0x5d, 0x1e, 0xa9, 0x23, 0x81, 0x3f, 0x5a, 0xf0, 0xe1, 0x3b, 0x14, 0xbc, 0x38, 0x79,
-		0x12, 0xf1, 0x9c, 0x3c, 0xf9, 0xdf, 0xac, 0x33, 0xbe, 0xc7, 0xd9, 0xa4, 0xf3, 0x38, 0x16, 0x81,
-		0x47, 0x6a, 0x46, 0x6d, 0xf6, 0x88, 0x86, 0x85, 0x8f, 0xdc, 0x30, 0xde, 0xdb, 0x91, 0xf1, 0xbd,
-		0x3d, 0xf5, 0xb5, 0xec, 0xed, 0xe8, 0x1d, 0xf6, 0xf6, 0xd7, 0xb5, 0x1f, 0x7e, 0x5f, 0x82, 0xf9,
-		0xd1, 0xee, 0x58, 0x60, 0x87, 0x1c, 0x69, 0x17, 0x74, 0xd4, 0x8c, 0x77, 0x2f, 0xa4, 0x07, 0xbc,
-		0x45, 0xa6, 0xcc, 0xd3, 0xbe, 0xf5, 0x3a, 0xba, 0x04, 0xc7, 0xf9, 0x4e, 0xe3, 0x00, 0xf4, 0x14,
-		0x6f, 0xbc, 0x69, 0x28, 0x5d, 0x1f, 0xe7, 0xb9, 0x5f, 0x0c, 0xc3, 0x5c, 0x90, 0x0f, 0x18, 0x30,
-		0xc8, 0x15, 0x98, 0x6d, 0xe1, 0xa6, 0xde, 0xba, 0xe3, 0x31, 0x3e, 0xc3, 0xd1, 0xff, 0xcf, 0x10,
-		0x1f, 0x56, 0x2d, 0x74, 0x01, 0x66, 0xac, 0x03, 0xa3, 0xa9, 0x1b, 0x3b, 0xaa, 0x6d, 0x0a, 0x77,
-		0x2a, 0xc1, 0x62, 0x8e, 0xbc, 0xa0, 0x61, 0x72, 0x87, 0xea, 0x27, 0x01, 0xe2, 0x0a, 0xb6, 0xba,
-		0xc4, 0xff, 0x43, 0x45, 0x48, 0xe0, 0xfd, 0x26, 0xee, 0xda, 0x6e, 0xd8, 0x2c, 0x70, 0x59, 0xc2,
-		0x41, 0x04, 0x1e, 0x59, 0x9e, 0x3b, 0x78, 0xe8, 0x32, 0x8f, 0x42, 0x8c, 0x8c, 0x27, 0x30, 0xef,
-		0xde, 0x41, 0x65, 0x61, 0x88, 0xc7, 0x45, 0x18, 0x22, 0x3c, 0x6a, 0x71, 0xcd, 0x7d, 0x7d, 0x07,
-		0x8f, 0xc7, 0x21, 0x2e, 0xf3, 0x38, 0x44, 0x64, 0x54, 0x75, 0x6c, 0x49, 0xe0, 0x56, 0xa7, 0xb3,
-		0xc7, 0x8e, 0xbd, 0x81, 0x88, 0xe8, 0xa8, 0xa6, 0x7a, 0x7c, 0x77, 0xb7, 0xa9, 0x6e, 0x24, 0xe2,
-		0x71, 0x11, 0x89, 0x88, 0x8d, 0x62, 0x9a, 0x3b, 0xab, 0x2e, 0xd3, 0x2c, 0x14, 0xf1, 0x46, 0x4f,
-		0x28, 0x22, 0x31, 0x18, 0xe6, 0x1f, 0x0a, 0x45, 0x38, 0xd8, 0x4e, 0x2c, 0x62, 0xc5, 0x89, 0x45,
-		0xa4, 0x46, 0x06, 0x32, 0xb8, 0x97, 0xe9, 0x20, 0x8b, 0x60, 0xc4, 0xfa, 0x50, 0x30, 0x82, 0xc5,
-		0x0e, 0xee, 0x1f, 0x1b, 0x8c, 0x70, 0x48, 0x0d, 0x44, 0x23, 0xd6, 0x87, 0xa2, 0x11, 0xe9, 0x51,
-		0x14, 0x07, 0x5c, 0x5a, 0x97, 0xa2, 0x3f, 0x1c, 0xf1, 0xad, 0xc1, 0xe1, 0x88, 0x91, 0xf1, 0x82,
-		0x00, 0xf7, 0xd5, 0x21, 0x1d, 0x10, 0x8f, 0xf8, 0xf6, 0x11, 0xf1, 0x08, 0x79, 0xd4, 0xba, 0x39,
-		0xc8, 0x79, 0x75, 0x2a, 0x08, 0x0a, 0x48, 0x6c, 0x06, 0x04, 0x24, 0x58, 0xe4, 0xe0, 0x81, 0x09,
-		0x02, 0x12, 0x0e, 0xe9, 0xa1, 0x88, 0xc4, 0x66, 0x40, 0x44, 0x02, 0x8d, 0xa6, 0x3b, 0xe0, 0x73,
-		0x79, 0xe9, 0xfa, 0x43, 0x12, 0xd7, 0xfc, 0x21, 0x89, 0xd9, 0xc3, 0x5d, 0x5d, 0xe6, 0x39, 0x38,
-		0xd4, 0xbc, 0x31, 0x89, 0xe6, 0xa8, 0x98, 0x04, 0x0b, 0x1b, 0x3c, 0x3c, 0x61, 0x4c, 0xc2, 0xa1,
-		0x1d, 0x18, 0x94, 0x58, 0x1f, 0x0a, 0x4a, 0x1c, 0x1f, 0xa5, 0x70, 0x03, 0x13, 0x92, 0xab, 0x70,
-		0x23, 0xa3, 0x12, 0xec, 0x6b, 0xab, 0xec, 0x3b, 0xab, 0x20, 0x27, 0x6f, 0x44, 0xe2, 0x49, 0x39,
-		0x95, 0x7b, 0x80, 0x78, 0x4d, 0x03, 0x76, 0x8f, 0xac, 0x51, 0x70, 0xaf, 0x67, 0xf6, 0xc4, 0x1e,
-		0x2b, 0x4d, 0xe4, 0xce, 0x43, 0xca, 0x6b, 0xe2, 0x0e, 0x89, 0x60, 0x64, 0x60, 0xda, 0x67, 0xd5,
-		0x72, 0x5f, 0x0e, 0x41, 0xca, 0x6b, 0xaf, 0x7c, 0xeb, 0xdb, 0x04, 0x5f, 0xdf, 0x7a, 0xe2, 0x1a,
-		0x21, 0x7f, 0x5c, 0x63, 0x01, 0x92, 0x64, 0x8d, 0x37, 0x10, 0xb2, 0xd0, 0xba, 0x4e, 0xc8, 0x42,
-		0x1c, 0x73, 0x65, 0xd1, 0x0f, 0x3e, 0x33, 0xb0, 0x53, 0x11, 0x19, 0xe7, 0xc8, 0x2f, 0xdf, 0x46,
-		0x78, 0x18, 0x66, 0x3d, 0xb0, 0xce, 0xda, 0x91, 0xcd, 0xff, 0xb2, 0x03, 0x9d, 0xe7, 0x27, 0x68,
-		0xbf, 0x19, 0x32, 0x6d, 0xcd, 0xc0, 0xe2, 0x0e, 0x89, 0x8e, 0x2d, 0xee, 0x77, 0x2d, 0x1f, 0x6e,
-		0x92, 0x97, 0x56, 0x35, 0x03, 0xaf, 0x3b, 0x48, 0xf4, 0x7b, 0xbf, 0x4a, 0xba, 0xed, 0xcb, 0xa4,
-		0xdf, 0x65, 0xe2, 0x1f, 0x3f, 0x21, 0x25, 0xd4, 0xde, 0xd2, 0x6b, 0xfd, 0x34, 0x8f, 0x50, 0x98,
-		0xcf, 0xc3, 0x6c, 0x00, 0xa5, 0xe0, 0x2b, 0x6c, 0xee, 0x23, 0x1e, 0x62, 0x83, 0x7d, 0x25, 0xf4,
-		0x84, 0x94, 0xfb, 0x6d, 0x09, 0x66, 0x86, 0x2c, 0x7e, 0x60, 0x64, 0x45, 0xfa, 0x5a, 0x45, 0x56,
-		0x42, 0x77, 0x1e, 0x59, 0xf1, 0x2e, 0xe8, 0xc3, 0xfe, 0x05, 0xfd, 0x97, 0x24, 0x98, 0xf6, 0xcd,
-		0x3c, 0x44, 0x8f, 0x9a, 0x66, 0x4b, 0x1c, 0x2a, 0xa0, 0xbf, 0x89, 0x68, 0xda, 0xa6, 0xf8, 0xd2,
-		0x27, 0xf9, 0x49, 0xa0, 0x9c, 0xb9, 0x34, 0xc1, 0x67, 0x4a, 0x67, 0x75, 0x3e, 0xe5, 0xbd, 0xa6,
-		0xc8, 0xc5, 0x1a, 0x75, 0xaf, 0xee, 0x39, 0x62, 0x8d, 0x79, 0xce, 0x12, 0xa0, 0x27, 0x21, 0x41,
-		0xf7, 0x51, 0x54, 0xb3, 0x6b, 0xf1, 0xa0, 0xfb, 0x5d, 0x87, 0x5c, 0xdb, 0xb3, 0xe8, 0xad, 0x10,
-		0x76, 0xd7, 0xcf, 0x75, 0xba, 0x12, 0x3e, 0xa7, 0xeb, 0x2e, 0x48, 0x10, 0xf6, 0xd9, 0x67, 0xc3,
-		0x81, 0xbf, 0xac, 0x23, 0x32, 0x72, 0xbf, 0x19, 0x82, 0xcc, 0xc0, 0xc4, 0x19, 0xd8, 0xf8, 0xa0,
-		0x43, 0x3d, 0x93, 0x09, 0xe4, 0x0c, 0xc0, 0x8e, 0x66, 0xa9, 0x2f, 0x69, 0x86, 0x8d, 0x5b, 0x5c,
-		0x2a, 0x9e, 0x1c, 0x34, 0x0f, 0x71, 0x92, 0xea, 0x5b, 0xb8, 0xc5, 0x63, 0x58, 0x4e, 0x1a, 0x55,
-		0x20, 0x8a, 0xf7, 0xe8, 0x57, 0x07, 0xd8, 0x97, 0xde, 0x4e, 0x06, 0x58, 0x58, 0x52, 0x5e, 0xc8,
-		0x92, 0xee, 0xfe, 0xdc, 0x6b, 0x0b, 0x32, 0x03, 0x7f, 0xc8, 0xb9, 0xaf, 0xad, 0x70, 0x02, 0x7e,
-		0x31, 0xc4, 0x07, 0xc4, 0x80, 0x4e, 0x42, 0x8c, 0x8e, 0x46, 0xbd, 0x45, 0x3d, 0x84, 0x84, 0x12,
-		0x25, 0xc9, 0x4a, 0x8b, 0x46, 0x5a, 0x53, 0x22, 0x6c, 0x42, 0xa4, 0xcd, 0x6e, 0x76, 0x29, 0xd3,
-		0x1d, 0xdc, 0xe9, 0x9a, 0x66, 0x5b, 0x65, 0x36, 0x2c, 0x0f, 0x69, 0xbf, 0x03, 0x81, 0xce, 0xc1,
-		0x74, 0x0f, 0xdb, 0x9a, 0x6e, 0xa8, 0xbe, 0x65, 0x45, 0x8a, 0x65, 0x32, 0x9b, 0xe1, 0xfd, 0xd8,
-		0x44, 0xee, 0x4d, 0x70, 0x3c, 0xd0, 0x7f, 0x40, 0x4f, 0x40, 0xc2, 0xf5, 0x3d, 0xd8, 0xed, 0xbc,
-		0xc3, 0x42, 0x58, 0x2e, 0x70, 0x6e, 0x13, 0x8e, 0x07, 0x3a, 0x10, 0xe8, 0x69, 0x88, 0xb2, 0x93,
-		0xfb, 0xfc, 0x3c, 0xe3, 0xbd, 0xe3, 0x3d, 0x8f, 0x7e, 0xdb, 0x56, 0x38, 0x52, 0xee, 0x12, 0x9c,
-		0x1a, 0xe9, 0x41, 0xb8, 0x81, 0x28, 0xc9, 0x13, 0x88, 0xca, 0xfd, 0xbc, 0x04, 0xf3, 0xa3, 0xbd,
-		0x02, 0x54, 0x18, 0x60, 0xe8, 0xc2, 0x84, 0x3e, 0x85, 0x87, 0x2b, 0xb2, 0x52, 0xeb, 0xe1, 0x6d,
-		0x6c, 0x37, 0x77, 0x99, 0x7b, 0xc2, 0xac, 0xc5, 0xb4, 0x32, 0xcd, 0x73, 0x29, 0x8e, 0xc5, 0xc0,
-		0x5e, 0xc4, 0x4d, 0x5b, 0x65, 0x9d, 0x6a, 0xd1, 0xa5, 0x4f, 0x82, 0x80, 0x91, 0xdc, 0x3a, 0xcb,
-		0xcc, 0x3d, 0x08, 0x27, 0x47, 0xf8, 0x19, 0x01, 0x17, 0x0f, 0x5e, 0x20, 0xc0, 0x81, 0xce, 0x03,
-		0x7a, 0x06, 0xa2, 0x96, 0xad, 0xd9, 0x7d, 0x8b, 0xb7, 0xec, 0xfe, 0xb1, 0x7e, 0x47, 0x9d, 0x82,
-		0x2b, 0x1c, 0x2d, 0x87, 0x01, 0x0d, 0x7b, 0x11, 0x01, 0xcb, 0x52, 0x29, 0x68, 0x59, 0x1a, 0x74,
-		0x54, 0x36, 0x14, 0x74, 0x54, 0x36, 0xb7, 0x05, 0xa7, 0x0f, 0xf1, 0x2c, 0x50, 0x71, 0xa0, 0x19,
-		0x0f, 0x4e, 0xe4, 0x98, 0x0c, 0x34, 0xe5, 0xd7, 0xc3, 0x70, 0x3c, 0xd0, 0xc1, 0xf0, 0x0c, 0x74,
-		0xe9, 0xab, 0x1d, 0xe8, 0x4f, 0x03, 0xd8, 0xfb, 0xe2, 0x62, 0x0a, 0x9f, 0x30, 0x82, 0x56, 0x55,
-		0xfb, 0xb8, 0x49, 0x6d, 0x1e, 0x51, 0xa1, 0x84, 0xcd, 0x7f, 0x59, 0xa8, 0xe1, 0x0d, 0x1a, 0xf4,
-		0xe9, 0x64, 0x62, 0xf1, 0xc5, 0xf1, 0xc4, 0xd3, 0x8e, 0x1b, 0x5d, 0x60, 0xd9, 0x16, 0x7a, 0x01,
-		0x4e, 0x0e, 0x4c, 0x8a, 0x0e, 0xed, 0xc8, 0xc4, 0x73, 0xe3, 0x71, 0xff, 0xdc, 0x28, 0x68, 0x7b,
-		0x27, 0xb6, 0x29, 0xff, 0x35, 0x9d, 0x35, 0x90, 0xe9, 0xb2, 0x99, 0xf9, 0x24, 0xec, 0x6c, 0x46,
-		0xf4, 0x08, 0x27, 0x64, 0x08, 0x32, 0xed, 0x28, 0x76, 0x42, 0xe3, 0x05, 0x00, 0x37, 0xb2, 0xe0,
-		0x1e, 0x62, 0x96, 0xbc, 0x87, 0x98, 0xaf, 0xc0, 0x14, 0x51, 0x41, 0x21, 0xf9, 0x00, 0x4b, 0x45,
-		0x34, 0xc4, 0x13, 0x9a, 0x60, 0xe0, 0xb9, 0x17, 0x85, 0x9a, 0x7b, 0xe3, 0xc2, 0x23, 0xea, 0x78,
-		0xa3, 0xbf, 0x8e, 0xdc, 0xe8, 0x10, 0x73, 0x70, 0x5d, 0xdf, 0x01, 0x53, 0x54, 0x9b, 0x02, 0x6f,
-		0xd9, 0x7f, 0x1b, 0x80, 0x66, 0xdb, 0x3d, 0x7d, 0xab, 0xef, 0xd6, 0xb0, 0x38, 0x42, 0x1d, 0xf3,
-		0x02, 0xb0, 0x70, 0x17, 0xd7, 0xcb, 0x39, 0x17, 0xd7, 0xa3, 0x9b, 0x1e, 0x8a, 0xb9, 0x2a, 0xa4,
-		0xfd, 0xb8, 0xe3, 0x7c, 0x2e, 0x71, 0x18, 0xd1, 0x75, 0x2d, 0xf8, 0x63, 0x86, 0x34, 0x91, 0x7b,
-		0x6b, 0x08, 0x52, 0x5e, 0x65, 0xfe, 0x07, 0x38, 0x7d, 0xe7, 0xbe, 0x57, 0x82, 0xb8, 0xd3, 0xfe,
-		0x43, 0x6e, 0x8a, 0xb8, 0x0f, 0x48, 0x38, 0xfb, 0x26, 0x6c, 0xa7, 0x2a, 0xec, 0xec, 0x54, 0xbd,
-		0xc1, 0x99, 0x89, 0x46, 0x46, 0x48, 0xbc, 0xd2, 0x16, 0x87, 0xa7, 0xf9, 0xcc, 0xf8, 0xd4, 0x64,
-		0x77, 0xd4, 0xe7, 0x60, 0xca, 0x7b, 0xc1, 0x9c, 0x25, 0x72, 0x96, 0xe7, 0x8c, 0x19, 0x1b, 0xdc,
-		0x2e, 0x60, 0xc8, 0x03, 0x38, 0x7c, 0xad, 0x3c, 0x3c, 0xfe, 0x5a, 0x79, 0x64, 0xf0, 0x5a, 0x39,
-		0x73, 0x3e, 0x72, 0xff, 0xaf, 0x04, 0x71, 0x31, 0x4a, 0xd0, 0x33, 0xde, 0xf3, 0x90, 0xe2, 0x70,
-		0xd5, 0x68, 0xa3, 0xc7, 0x1b, 0xef, 0x39, 0x0e, 0x39, 0x74, 0x79, 0x25, 0x7c, 0xe4, 0xcb, 0x2b,
-		0xdc, 0x1d, 0xfa, 0xcf, 0x21, 0x90, 0x07, 0x47, 0xf1, 0x57, 0xcf, 0xdf, 0xf0, 0xb4, 0x19, 0x0e,
-		0x9a, 0x36, 0x47, 0x5c, 0x49, 0x89, 0x8c, 0xbc, 0x92, 0x32, 0xd4, 0xee, 0xa9, 0xa3, 0x5f, 0xda,
-		0x19, 0x19, 0x42, 0x8e, 0x8e, 0x0a, 0x21, 0x8f, 0xb9, 0xdf, 0x12, 0x3b, 0xf4, 0x7e, 0x0b, 0x97,
-		0xf3, 0xf7, 0x84, 0x20, 0xe9, 0x09, 0xf1, 0xa2, 0xc7, 0x7c, 0x17, 0x5d, 0xce, 0x1e, 0x1a, 0x0f,
-		0xf6, 0xdc, 0x74, 0xf1, 0xf5, 0x4c, 0xe8, 0x0e, 0x7a, 0xe6, 0x6b, 0x7f, 0x9b, 0x37, 0xf8, 0x79,
-		0x89, 0xa9, 0x11, 0xcf, 0x4b, 0x7c, 0x97, 0x04, 0x71, 0x27, 0x9e, 0x76, 0xd4, 0x5d, 0xdc, 0x13,
-		0x10, 0xe5, 0x2e, 0x27, 0xdb, 0xc6, 0xe5, 0xa9, 0xc0, 0xe0, 0xf8, 0x3c, 0xc4, 0x3b, 0xd8, 0xd6,
-		0xa8, 0x39, 0x66, 0xd3, 0xb5, 0x93, 0xbe, 0xb0, 0x05, 0x49, 0xcf, 0x46, 0x38, 0x3a, 0x05, 0xc7,
-		0x8b, 0xd7, 0xcb, 0xc5, 0x67, 0xd5, 0xc6, 0xf3, 0x83, 0x9f, 0xbf, 0x1c, 0x2a, 0x52, 0xca, 0x34,
-		0xcd, 0x3e, 0x8c, 0xe5, 0x2f, 0x62, 0x05, 0xa1, 0xf9, 0xc8, 0xdb, 0x7f, 0xe2, 0xcc, 0xb1, 0x0b,
-		0x7f, 0x23, 0xc1, 0x6c, 0x80, 0x73, 0x8f, 0xce, 0xc2, 0xdd, 0xb5, 0xab, 0x57, 0xcb, 0x8a, 0x5a,
-		0xaf, 0xe6, 0xd7, 0xeb, 0xd7, 0x6b, 0x0d, 0x55, 0x29, 0xd7, 0x37, 0x56, 0xbd, 0x9f, 0x8f, 0x5c,
-		0x84, 0xbb, 0x82, 0x41, 0xf2, 0xc5, 0x62, 0x79, 0xbd, 0x21, 0x4b, 0x68, 0x01, 0x4e, 0x8f, 0x80,
-		0x28, 0xd0, 0x6f, 0x5a, 0x8d, 0x26, 0xa1, 0x94, 0x6f, 0x94, 0x8b, 0x0d, 0x39, 0x8c, 0xee, 0x87,
-		0x73, 0x87, 0x41, 0xf0, 0x8f, 0x5a, 0xca, 0x91, 0xb1, 0x80, 0xf5, 0x72, 0xb5, 0x54, 0x56, 0xe4,
-		0x29, 0xde, 0xee, 0xf7, 0x87, 0x20, 0x3b, 0x6a, 0x0d, 0x41, 0x68, 0xe5, 0xd7, 0xd7, 0x57, 0x6f,
-		0xba, 0xb4, 0x8a, 0xd7, 0x37, 0xaa, 0xcf, 0x0e, 0x8b, 0xe0, 0x3e, 0xc8, 0x1d, 0x06, 0xe8, 0x08,
-		0xe2, 0x5e, 0x38, 0x7b, 0x28, 0x1c, 0x17, 0xc7, 0x18, 0x30, 0xa5, 0xdc, 0x50, 0x6e, 0xca, 0x61,
-		0xb4, 0x04, 0x17, 0xc6, 0x82, 0x39, 0x65, 0x72, 0x04, 0x5d, 0x84, 0x07, 0x0f, 0x87, 0x67, 0x02,
-		0x12, 0x08, 0x42, 0x44, 0xaf, 0x48, 0x70, 0x3c, 0x70, 0x31, 0x82, 0xce, 0xc1, 0xc2, 0xba, 0x52,
-		0x2b, 0x96, 0xeb, 0x75, 0xe7, 0x9a, 0x8b, 0x5a, 0x6f, 0xe4, 0x1b, 0x1b, 0x75, 0x8f, 0x6c, 0x72,
-		0x70, 0x66, 0x14, 0x90, 0x23, 0x97, 0x43, 0x60, 0xb8, 0x06, 0x08, 0x3d, 0xbd, 0x2d, 0xc1, 0xa9,
-		0x91, 0x4b, 0x0a, 0x74, 0x1e, 0xee, 0x61, 0x5f, 0x58, 0x55, 0x37, 0x6b, 0x8d, 0xb2, 0x5a, 0x7e,
-		0xbe, 0x51, 0xae, 0xd6, 0x2b, 0xb5, 0xea, 0x30, 0x57, 0xf7, 0xc3, 0xb9, 0x43, 0x21, 0x1d, 0xd6,
-		0xc6, 0x01, 0x0e, 0xf0, 0xf7, 0x36, 0x09, 0x32, 0x03, 0xb6, 0x90, 0x7e, 0xc8, 0xb5, 0x52, 0x2f,
-		0x94, 0xaf, 0xe7, 0x37, 0x2b, 0x35, 0x65, 0x70, 0xcc, 0x9e, 0x83, 0x85, 0xa1, 0xd2, 0xd2, 0xc6,
-		0xfa, 0x6a, 0xa5, 0x98, 0x6f, 0x94, 0x55, 0x76, 0x37, 0x89, 0x34, 0x6c, 0x08, 0x68, 0xb5, 0x72,
-		0xed, 0x7a, 0x43, 0x2d, 0xae, 0x56, 0xca, 0xd5, 0x86, 0x9a, 0x6f, 0x34, 0xf2, 0xee, 0x70, 0x2e,
-		0x3c, 0x3b, 0xf2, 0x4c, 0xf0, 0xa5, 0xc9, 0xcf, 0x04, 0xf3, 0x53, 0xbf, 0xce, 0x91, 0xe0, 0x3f,
-		0xbb, 0x08, 0xf7, 0xf0, 0xb7, 0xbf, 0x2c, 0x5b, 0xbb, 0xa5, 0x1b, 0x3b, 0xce, 0x03, 0x71, 0x3c,
-		0xcd, 0x8f, 0x06, 0x9f, 0xe0, 0xef, 0x8b, 0x89, 0xdc, 0x31, 0xcf, 0xc4, 0x8d, 0x7c, 0x5d, 0x78,
-		0xec, 0x95, 0x92, 0xb1, 0x07, 0x7f, 0x0f, 0x79, 0x82, 0x6e, 0xcc, 0x43, 0x77, 0x01, 0x4f, 0xd4,
-		0xcd, 0x1f, 0xfe, 0x60, 0xc9, 0xfc, 0xa1, 0xe7, 0xa5, 0x73, 0x3f, 0x2c, 0x41, 0xfa, 0xba, 0x6e,
-		0xd9, 0x66, 0x4f, 0x6f, 0x6a, 0x6d, 0xea, 0xb8, 0xbc, 0x61, 0xe2, 0x3b, 0x90, 0x85, 0x04, 0x99,
-		0xc6, 0xf8, 0x43, 0x76, 0xbb, 0xe2, 0x1a, 0x62, 0x74, 0x4f, 0x6b, 0xb3, 0xfb, 0x87, 0xde, 0x97,
-		0x30, 0x07, 0xc5, 0xee, 0x99, 0x5f, 0xbd, 0x54, 0x18, 0x6e, 0xee, 0x47, 0x42, 0x90, 0xa1, 0x4b,
-		0x2b, 0x8b, 0xae, 0xec, 0xe9, 0xda, 0xf1, 0x06, 0x44, 0x7a, 0x9a, 0xcd, 0x17, 0x40, 0x85, 0x2b,
-		0x47, 0x7e, 0x54, 0x8e, 0xd5, 0x40, 0x69, 0xa0, 0x37, 0x41, 0xbc, 0xa3, 0xed, 0xab, 0x94, 0x5e,
-		0xe8, 0xab, 0xa2, 0x17, 0xeb, 0x68, 0xfb, 0x84, 0x3f, 0xf4, 0x6d, 0xf4, 0xa3, 0xb5, 0x6a, 0x73,
-		0x57, 0x33, 0x76, 0x30, 0xa3, 0x1c, 0xfe, 0xaa, 0x28, 0x4f, 0x77, 0xb4, 0xfd, 0x22, 0xa5, 0x46,
-		0xe8, 0xf3, 0xf7, 0xfa, 0x7e, 0x43, 0xe2, 0xeb, 0x5a, 0x2a, 0x18, 0xa4, 0x81, 0xdc, 0x74, 0x52,
-		0xb4, 0x52, 0x11, 0xc0, 0xbe, 0x7f, 0x94, 0xdc, 0x07, 0xc4, 0x5a, 0x98, 0x26, 0xec, 0x7d, 0xf2,
-		0xb5, 0x05, 0x89, 0xd5, 0x9a, 0x69, 0x0e, 0x89, 0x3d, 0xc9, 0x96, 0xff, 0x2a, 0xf5, 0x6d, 0x42,
-		0x63, 0x7d, 0x9b, 0x69, 0xe1, 0xdb, 0x30, 0x82, 0xc0, 0xb0, 0x49, 0x39, 0x6f, 0xc3, 0x87, 0x25,
-		0x48, 0x96, 0x3c, 0xaf, 0xf6, 0x66, 0x21, 0xd6, 0x31, 0x0d, 0xfd, 0x16, 0xee, 0x39, 0x9b, 0x28,
-		0x2c, 0x49, 0xfc, 0x0f, 0xbd, 0x85, 0x0d, 0x5b, 0xb7, 0x0f, 0xc4, 0xeb, 0x41, 0x22, 0x4d, 0xb0,
-		0x5e, 0xc2, 0x5b, 0x96, 0x2e, 0xe4, 0xac, 0x88, 0x24, 0x7a, 0x00, 0x64, 0x0b, 0x37, 0xfb, 0x3d,
-		0xdd, 0x3e, 0x50, 0x9b, 0xa6, 0x61, 0x6b, 0x4d, 0x9b, 0x2f, 0x29, 0x32, 0x22, 0xbf, 0xc8, 0xb2,
-		0x09, 0x91, 0x16, 0xb6, 0x35, 0xbd, 0xcd, 0x8e, 0x2e, 0x26, 0x14, 0x91, 0xe4, 0xac, 0xde, 0x8e,
-		0x79, 0x17, 0x49, 0x45, 0x90, 0xcd, 0x2e, 0xee, 0xf9, 0xce, 0x68, 0x30, 0x6d, 0xcc, 0xfe, 0xe1,
-		0xc7, 0x1e, 0x9e, 0xe3, 0x02, 0xe7, 0x5b, 0xf5, 0xec, 0xc2, 0x9e, 0x92, 0x11, 0x18, 0x62, 0x07,
-		0xff, 0xa6, 0x6f, 0xcf, 0xa1, 0xbf, 0xe5, 0x3e, 0xfe, 0x35, 0x37, 0x24, 0xd4, 0xbc, 0x71, 0x50,
-		0xc8, 0x7e, 0xc2, 0x25, 0xcd, 0x83, 0xea, 0xeb, 0x74, 0x61, 0xe4, 0xdd, 0x7f, 0xa0, 0x64, 0x88,
-		0x6b, 0xf7, 0xa2, 0xa6, 0xb7, 0x71, 0x8b, 0xaf, 0xba, 0x79, 0x0a, 0xad, 0x38, 0x01, 0x31, 0xf6,
-		0x25, 0xe6, 0xdc, 0x28, 0xdd, 0x28, 0x98, 0x46, 0xcb, 0x1f, 0x07, 0x43, 0x45, 0x88, 0xda, 0xe6,
-		0x2d, 0x6c, 0x70, 0x01, 0x1d, 0xed, 0x5d, 0x48, 0x8e, 0x8a, 0xbe, 0x05, 0xe4, 0x16, 0x6e, 0xe3,
-		0x1d, 0x76, 0x37, 0x79, 0x57, 0xeb, 0x61, 0xf6, 0xe4, 0xc7, 0x1d, 0xbd, 0x0d, 0x99, 0x71, 0x48,
-		0xd5, 0x29, 0x25, 0xb4, 0xee, 0x7f, 0x17, 0x3a, 0xe6, 0xec, 0xf8, 0x07, 0xb6, 0xd1, 0xa3, 0x79,
-		0x5e, 0xcb, 0xe3, 0x7b, 0x47, 0xfa, 0x01, 0x90, 0xfb, 0xc6, 0x96, 0x69, 0xd0, 0xcf, 0x0a, 0x73,
-		0xef, 0x3a, 0xce, 0xb6, 0xd2, 0x9c, 0x7c, 0xbe, 0x95, 0xb6, 0x0e, 0x69, 0x17, 0x94, 0x8e, 0x90,
-		0xc4, 0x51, 0x47, 0xc8, 0xb4, 0x43, 0x80, 0x80, 0xa0, 0x35, 0x00, 0x77, 0x0c, 0xd2, 0x5d, 0x90,
-		0xe4, 0xe8, 0x1e, 0x73, 0x47, 0xb3, 0xb7, 0x31, 0x1e, 0x02, 0xc8, 0x80, 0xd9, 0x8e, 0x6e, 0xa8,
-		0x16, 0x6e, 0x6f, 0xab, 0x5c, 0x72, 0xe2, 0xbd, 0x92, 0x44, 0xe1, 0x8d, 0x47, 0xe8, 0xcd, 0x3f,
-		0xf9, 0xd8, 0xc3, 0x19, 0xf7, 0x75, 0xcd, 0xc5, 0x47, 0x96, 0x2e, 0x5f, 0x51, 0x66, 0x3a, 0xba,
-		0x51, 0xc7, 0xed, 0xed, 0x92, 0x43, 0x18, 0xbd, 0x01, 0x4e, 0xbb, 0x02, 0x31, 0x0d, 0x75, 0xd7,
-		0x6c, 0xb7, 0xd4, 0x1e, 0xde, 0x56, 0x9b, 0xf4, 0xdd, 0xd2, 0x14, 0x15, 0xe3, 0x49, 0x07, 0xa4,
-		0x66, 0x5c, 0x37, 0xdb, 0x2d, 0x05, 0x6f, 0x17, 0x49, 0x31, 0x3a, 0x07, 0xae, 0x34, 0x54, 0xbd,
-		0x65, 0x65, 0xa7, 0x17, 0xc3, 0xe7, 0x23, 0x4a, 0xca, 0xc9, 0xac, 0xb4, 0xac, 0x95, 0xf8, 0xdb,
-		0xdf, 0xbf, 0x70, 0xec, 0xf5, 0xf7, 0x2f, 0x1c, 0xcb, 0x5d, 0xa5, 0xcf, 0x03, 0xf2, 0xa1, 0x85,
-		0x2d, 0x74, 0x05, 0x12, 0x9a, 0x48, 0xb0, 0x3b, 0x6e, 0x87, 0x0c, 0x4d, 0x17, 0x34, 0xf7, 0x11,
-		0x09, 0xa2, 0xa5, 0xcd, 0x75, 0x4d, 0xef, 0xa1, 0x32, 0xcc, 0xb8, 0xba, 0x3a, 0xe9, 0x28, 0x77,
-		0xd5, 0x5b, 0x0c, 0xf3, 0xea, 0xa8, 0x03, 0x5d, 0x89, 0xc2, 0xd9, 0x3f, 0xfc, 0xd8, 0xc3, 0x77,
-		0x73, 0x32, 0x9b, 0x03, 0x67, 0xbb, 0x04, 0xbd, 0xc1, 0x33, 0x5f, 0x9e, 0x36, 0xdf, 0x80, 0x18,
-		0x63, 0xd5, 0x42, 0xcf, 0xc0, 0x54, 0x97, 0xfc, 0xe0, 0x91, 0xe8, 0x33, 0x23, 0x75, 0x9e, 0xc2,
-		0x7b, 0x35, 0x84, 0xe1, 0xe5, 0xde, 0x11, 0x02, 0x28, 0x6d, 0x6e, 0x36, 0x7a, 0x7a, 0xb7, 0x8d,
-		0xed, 0xaf, 0x55, 0xdb, 0x37, 0xe0, 0xb8, 0xe7, 0x29, 0x82, 0x5e, 0xf3, 0xe8, 0xed, 0x9f, 0x75,
-		0x1f, 0x25, 0xe8, 0x35, 0x03, 0xc9, 0xb6, 0x2c, 0xdb, 0x21, 0x1b, 0x3e, 0x3a, 0xd9, 0x92, 0x65,
-		0x0f, 0x4b, 0xf6, 0x79, 0x48, 0xba, 0xc2, 0xb0, 0x50, 0x05, 0xe2, 0x36, 0xff, 0xcd, 0x05, 0x9c,
-		0x1b, 0x2d, 0x60, 0x81, 0xe6, 0x15, 0xb2, 0x83, 0x9e, 0xfb, 0x92, 0x04, 0xe0, 0x19, 0x23, 0xdf,
-		0x98, 0x3a, 0x86, 0x2a, 0x10, 0xe5, 0xc6, 0x39, 0x7c, 0xc7, 0xaf, 0x0b, 0x33, 0x02, 0x1e, 0xa1,
-		0xbe, 0x33, 0x04, 0xb3, 0x1b, 0x62, 0xf4, 0x7e, 0xe3, 0xcb, 0x60, 0x03, 0x62, 0xd8, 0xb0, 0x7b,
-		0xba, 0xb3, 0x93, 0xf2, 0xc8, 0xa8, 0x3e, 0x0f, 0x68, 0x14, 0x3d, 0x97, 0xe0, 0xd5, 0x00, 0x41,
-		0xcb, 0x23, 0x8f, 0xf7, 0x84, 0x21, 0x3b, 0x0a, 0x15, 0xdd, 0x0f, 0x99, 0x66, 0x0f, 0xd3, 0x0c,
-		0xff, 0x95, 0xcb, 0xb4, 0xc8, 0xe6, 0xd3, 0x8e, 0x02, 0xc4, 0x51, 0x23, 0xca, 0x45, 0x40, 0xef,
-		0xcc, 0x33, 0x4b, 0xbb, 0x14, 0xe8, 0xc4, 0xd3, 0x80, 0x8c, 0xb8, 0xa7, 0xb1, 0xa5, 0xb5, 0x35,
-		0xa3, 0x29, 0x3c, 0xd8, 0x23, 0xcd, 0xf9, 0xe2, 0xae, 0x47, 0x81, 0x91, 0x40, 0x65, 0x88, 0x09,
-		0x6a, 0x91, 0xa3, 0x53, 0x13, 0xb8, 0xe8, 0x2c, 0xa4, 0xbc, 0x13, 0x03, 0xf5, 0x46, 0x22, 0x4a,
-		0xd2, 0x33, 0x2f, 0x8c, 0x9b, 0x79, 0xa2, 0x87, 0xce, 0x3c, 0xdc, 0xe1, 0xfb, 0xf1, 0x30, 0xcc,
-		0x28, 0xb8, 0xf5, 0xf7, 0xbf, 0x5b, 0xd6, 0x01, 0xd8, 0x50, 0x25, 0x96, 0x94, 0xf7, 0xcc, 0x1d,
-		0x8c, 0xf7, 0x04, 0x23, 0x52, 0xb2, 0xec, 0xbf, 0xab, 0x1e, 0xfa, 0xf7, 0x21, 0x48, 0x79, 0x7b,
-		0xe8, 0x1f, 0xe4, 0xa4, 0x85, 0xaa, 0xae, 0x99, 0x62, 0x37, 0x4d, 0x1e, 0x18, 0x65, 0xa6, 0x86,
-		0xb4, 0x79, 0x8c, 0x7d, 0x7a, 0x6f, 0x18, 0xa2, 0xfc, 0x3c, 0x53, 0x6d, 0xc8, 0xb7, 0x1d, 0x7b,
-		0xdf, 0x7e, 0x5a, 0x6c, 0xc8, 0x06, 0xba, 0xb6, 0xf7, 0x42, 0x9a, 0xac, 0x91, 0x7d, 0x87, 0xa4,
-		0xa4, 0xf3, 0xd3, 0x74, 0xa9, 0xeb, 0x9e, 0x89, 0x46, 0x0b, 0x90, 0x24, 0x60, 0xae, 0x1d, 0x26,
-		0x30, 0xd0, 0xd1, 0xf6, 0xcb, 0x2c, 0x07, 0x3d, 0x0c, 0x68, 0xd7, 0x09, 0x5a, 0xa8, 0xae, 0x20,
-		0x08, 0xdc, 0x8c, 0x5b, 0x22, 0xc0, 0xef, 0x06, 0x20, 0x5c, 0xa8, 0xec, 0x41, 0x7d, 0xfe, 0xb9,
-		0x02, 0x92, 0x53, 0xa2, 0x8f, 0xea, 0xbf, 0x4d, 0x62, 0x2e, 0xf2, 0xc0, 0x4a, 0x9a, 0xaf, 0x50,
-		0x1a, 0x13, 0x0c, 0x8a, 0xbf, 0x7e, 0x6d, 0x61, 0xfe, 0x40, 0xeb, 0xb4, 0x57, 0x72, 0x01, 0x74,
-		0x72, 0x41, 0x8b, 0x7b, 0xe2, 0x38, 0xfb, 0x57, 0xe2, 0x2b, 0xf7, 0x10, 0xf5, 0x7e, 0xe5, 0x33,
-		0x1f, 0xbd, 0x70, 0xda, 0xf3, 0x86, 0xfd, 0xbe, 0x13, 0xd4, 0x62, 0x7d, 0x42, 0x3c, 0x55, 0xe4,
-		0xce, 0x1a, 0x9e, 0x93, 0x6c, 0xe0, 0x71, 0xee, 0xa5, 0xc3, 0x17, 0x0d, 0x2e, 0xbe, 0x6f, 0xd1,
-		0xe0, 0x19, 0x53, 0x6f, 0x74, 0x8d, 0xb6, 0xfb, 0xaa, 0xd5, 0xd0, 0x17, 0x16, 0x96, 0x8a, 0xa6,
-		0xee, 0x23, 0x21, 0x90, 0xe8, 0x50, 0x3d, 0x96, 0xfb, 0x37, 0x12, 0x9c, 0x1a, 0x52, 0x3f, 0x87,
-		0xe5, 0x26, 0xa0, 0x9e, 0xa7, 0x90, 0x76, 0xa3, 0x78, 0x22, 0xfa, 0xce, 0xb4, 0x79, 0xa6, 0x37,
-		0x64, 0xb9, 0xbf, 0x36, 0xb3, 0x0f, 0x37, 0x3d, 0xbf, 0x2b, 0xc1, 0x9c, 0x97, 0x01, 0xa7, 0x29,
-		0x75, 0x48, 0x79, 0xab, 0xe6, 0x8d, 0xb8, 0x67, 0x92, 0x46, 0x78, 0xf9, 0xf7, 0x11, 0x41, 0x9b,
-		0xee, 0x10, 0x67, 0xa1, 0xb4, 0x4b, 0x13, 0x0b, 0x45, 0x30, 0x16, 0x38, 0xd4, 0x59, 0xdf, 0x7c,
-		0x5e, 0x82, 0xc8, 0xba, 0x69, 0xb6, 0xd1, 0x9b, 0x61, 0xc6, 0x30, 0x6d, 0x95, 0x0c, 0x07, 0xdc,
-		0x52, 0xf9, 0x5a, 0x9f, 0x99, 0xcf, 0xf2, 0xa1, 0xb2, 0xfa, 0xdc, 0x6b, 0x0b, 0xc3, 0x98, 0x41,
-		0xdf, 0xb8, 0xc8, 0x18, 0xa6, 0x5d, 0xa0, 0x40, 0x0d, 0x16, 0x0e, 0xd8, 0x86, 0x69, 0x7f, 0x75,
-		0xcc, 0xc4, 0xe6, 0xc7, 0x55, 0x37, 0x3d, 0xb6, 0xaa, 0xd4, 0x96, 0xa7, 0x1e, 0xf6, 0x26, 0xfe,
-		0x5f, 0xb2, 0x47, 0x4a, 0xe4, 0xcd, 0xc1, 0x73, 0x2e, 0x57, 0x21, 0x26, 0xce, 0xb5, 0x48, 0x93,
-		0x9e, 0x99, 0xf1, 0xca, 0x93, 0x23, 0x5f, 0xf8, 0x15, 0x09, 0xc0, 0x0d, 0x9c, 0xa0, 0x87, 0xe0,
-		0x64, 0xa1, 0x56, 0x2d, 0xb9, 0xc1, 0x7d, 0xcf, 0xc7, 0xa3, 0xc4, 0xdb, 0x5f, 0x56, 0x17, 0x37,
-		0xf5, 0x6d, 0x1d, 0xb7, 0xd0, 0x7d, 0x30, 0xe7, 0x87, 0x26, 0xa9, 0x72, 0x49, 0x96, 0xe6, 0x53,
-		0xaf, 0xdc, 0x5e, 0x8c, 0x33, 0x47, 0x11, 0xb7, 0xd0, 0x79, 0x38, 0x3e, 0x0c, 0x57, 0xa9, 0x5e,
-		0x93, 0x43, 0xf3, 0xd3, 0xaf, 0xdc, 0x5e, 0x4c, 0x38, 0x1e, 0x25, 0xca, 0x01, 0xf2, 0x42, 0x72,
-		0x7a, 0xe1, 0x79, 0x78, 0xe5, 0xf6, 0x62, 0x94, 0x75, 0x03, 0xdf, 0x15, 0xf8, 0x56, 0x80, 0x8a,
-		0xb1, 0xdd, 0xd3, 0x9a, 0x54, 0xdd, 0xe6, 0xe1, 0x44, 0xa5, 0x7a, 0x55, 0xc9, 0x17, 0x1b, 0x95,
-		0x5a, 0x75, 0xe0, 0x9b, 0x57, 0xfe, 0xb2, 0x52, 0x6d, 0xa3, 0xb0, 0x5a, 0x56, 0xeb, 0x95, 0x6b,
-		0x55, 0xb6, 0x85, 0xe7, 0x2b, 0x7b, 0xae, 0xda, 0xa8, 0xac, 0x95, 0xe5, 0x50, 0xe1, 0xea, 0xc8,
-		0x68, 0xff, 0x43, 0x87, 0x7e, 0xb6, 0xc3, 0x35, 0x79, 0xbe, 0x0f, 0x78, 0xfc, 0xcf, 0x00, 0x00,
-		0x00, 0xff, 0xff, 0xd9, 0x65, 0xbe, 0xbe, 0xe3, 0xba, 0x00, 0x00,
+		// 16222 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x78, 0x5c, 0xc9,
+		0x75, 0x20, 0xc6, 0xdb, 0xdd, 0x00, 0xba, 0x0f, 0x1a, 0xc0, 0x45, 0x01, 0x24, 0x9b, 0x4d, 0x0e,
+		0x89, 0xb9, 0xf3, 0x20, 0x87, 0x14, 0xc1, 0x21, 0x86, 0x8f, 0x21, 0xe6, 0xa5, 0x6e, 0xa0, 0x49,
+		0x82, 0x83, 0x97, 0x6e, 0x03, 0x9c, 0x87, 0xa4, 0xbd, 0xbe, 0xe8, 0xbe, 0x00, 0x7a, 0xd8, 0xb8,
+		0xb7, 0xd5, 0xf7, 0x36, 0x87, 0x90, 0x22, 0x5b, 0x96, 0x65, 0xaf, 0x3c, 0x5e, 0xdb, 0xf2, 0xda,
+		0xeb, 0x1d, 0x3f, 0x28, 0xcb, 0xde, 0xf5, 0x5a, 0x7e, 0xae, 0xd6, 0x96, 0x1c, 0x7b, 0xb3, 0xde,
+		0xc4, 0xc9, 0xae, 0xe3, 0x55, 0x76, 0x6d, 0xad, 0xf2, 0xd9, 0x71, 0xfc, 0x7d, 0x3b, 0x9b, 0x48,
+		0x4e, 0x66, 0x6c, 0xc9, 0xd9, 0xb5, 0x23, 0x27, 0xfe, 0xa2, 0x4d, 0x94, 0xef, 0xd4, 0xe3, 0xbe,
+		0x6f, 0x77, 0x03, 0xe4, 0x38, 0x23, 0x3b, 0x7f, 0x80, 0xbe, 0x55, 0xe7, 0x9c, 0x3a, 0x75, 0xea,
+		0x54, 0xd5, 0xa9, 0x53, 0xa7, 0xaa, 0xe0, 0xfb, 0x37, 0x60, 0x6a, 0xcb, 0xb2, 0xb6, 0x9a, 0xc6,
+		0xb9, 0x56, 0xdb, 0x72, 0xac, 0x8d, 0xce, 0xe6, 0xb9, 0xba, 0x61, 0xd7, 0xda, 0x8d, 0x96, 0x63,
+		0xb5, 0xa7, 0x69, 0x1a, 0x19, 0x63, 0x10, 0xd3, 0x02, 0x42, 0x79, 0x2f, 0x8c, 0x5f, 0x6d, 0x34,
+		0x8d, 0x79, 0x17, 0xb0, 0x6a, 0x38, 0xe4, 0x49, 0xc8, 0x6c, 0x36, 0x9a, 0x46, 0x41, 0x9a, 0x4a,
+		0x9f, 0x1a, 0x9e, 0x79, 0x78, 0x3a, 0x84, 0x34, 0x1d, 0xc4, 0x58, 0xc5, 0x64, 0x95, 0x62, 0x9c,
+		0xce, 0x67, 0x3f, 0xf2, 0xd5, 0xcf, 0x7f, 0x43, 0x92, 0xbf, 0x1d, 0xff, 0x2a, 0xaf, 0x0f, 0xc0,
+		0x44, 0x0c, 0x2c, 0x21, 0x90, 0x31, 0xf5, 0x1d, 0xa4, 0x2f, 0x9d, 0xca, 0xa9, 0xf4, 0x37, 0x29,
+		0xc0, 0x50, 0x4b, 0xaf, 0xdd, 0xd2, 0xb7, 0x8c, 0x42, 0x8a, 0x26, 0x8b, 0x4f, 0x72, 0x1c, 0xa0,
+		0x6e, 0xb4, 0x0c, 0xb3, 0x6e, 0x98, 0xb5, 0xdd, 0x42, 0x7a, 0x2a, 0x7d, 0x2a, 0xa7, 0xfa, 0x52,
+		0xc8, 0x19, 0x18, 0x6f, 0x75, 0x36, 0x9a, 0x8d, 0x9a, 0xe6, 0x03, 0x83, 0xa9, 0xf4, 0xa9, 0x01,
+		0x55, 0x66, 0x19, 0xf3, 0x1e, 0xf0, 0x49, 0x18, 0x7b, 0xd5, 0xd0, 0x6f, 0xf9, 0x41, 0x87, 0x29,
+		0xe8, 0x28, 0x26, 0xfb, 0x00, 0xe7, 0x20, 0xbf, 0x63, 0xd8, 0xb6, 0xbe, 0x65, 0x68, 0xce, 0x6e,
+		0xcb, 0x28, 0x64, 0xa8, 0x2c, 0xa6, 0x22, 0xb2, 0x08, 0xcb, 0x61, 0x98, 0x63, 0xad, 0xed, 0xb6,
+		0x0c, 0x52, 0x82, 0x9c, 0x61, 0x76, 0x76, 0x18, 0x85, 0x81, 0x04, 0x69, 0x56, 0xcc, 0xce, 0x4e,
+		0x98, 0x4a, 0x16, 0xd1, 0x38, 0x89, 0x21, 0xdb, 0x68, 0xdf, 0x6e, 0xd4, 0x8c, 0xc2, 0x20, 0x25,
+		0x70, 0x32, 0x42, 0xa0, 0xca, 0xf2, 0xc3, 0x34, 0x04, 0x1e, 0x99, 0x83, 0x9c, 0x71, 0xc7, 0x31,
+		0x4c, 0xbb, 0x61, 0x99, 0x85, 0x21, 0x4a, 0xe4, 0x91, 0x98, 0x36, 0x35, 0x9a, 0xf5, 0x30, 0x09,
+		0x0f, 0x8f, 0x5c, 0x82, 0x21, 0xab, 0xe5, 0x34, 0x2c, 0xd3, 0x2e, 0x64, 0xa7, 0xa4, 0x53, 0xc3,
+		0x33, 0xc7, 0x62, 0xd5, 0x62, 0x85, 0xc1, 0xa8, 0x02, 0x98, 0x2c, 0x80, 0x6c, 0x5b, 0x9d, 0x76,
+		0xcd, 0xd0, 0x6a, 0x56, 0xdd, 0xd0, 0x1a, 0xe6, 0xa6, 0x55, 0xc8, 0x51, 0x02, 0x27, 0xa2, 0x15,
+		0xa1, 0x80, 0x73, 0x56, 0xdd, 0x58, 0x30, 0x37, 0x2d, 0x75, 0xd4, 0x0e, 0x7c, 0x93, 0x43, 0x30,
+		0x68, 0xef, 0x9a, 0x8e, 0x7e, 0xa7, 0x90, 0xa7, 0x1a, 0xc2, 0xbf, 0xc8, 0x0c, 0x0c, 0x19, 0xf5,
+		0x06, 0x16, 0x57, 0x18, 0x9d, 0x92, 0x4e, 0x8d, 0xce, 0x14, 0xa2, 0x32, 0x66, 0xf9, 0xaa, 0x00,
+		0x54, 0x7e, 0x7d, 0x10, 0xc6, 0xfa, 0x51, 0xcb, 0xa7, 0x60, 0x60, 0x13, 0x25, 0x53, 0x48, 0xed,
+		0x45, 0x6e, 0x0c, 0x27, 0x28, 0xf8, 0xc1, 0x7d, 0x0a, 0xbe, 0x04, 0xc3, 0xa6, 0x61, 0x3b, 0x46,
+		0x9d, 0x69, 0x51, 0xba, 0x4f, 0x3d, 0x04, 0x86, 0x14, 0x55, 0xc3, 0xcc, 0xbe, 0xd4, 0xf0, 0x45,
+		0x18, 0x73, 0x59, 0xd2, 0xda, 0xba, 0xb9, 0x25, 0xf4, 0xf9, 0x5c, 0x2f, 0x4e, 0xa6, 0x2b, 0x02,
+		0x4f, 0x45, 0x34, 0x75, 0xd4, 0x08, 0x7c, 0x93, 0x79, 0x00, 0xcb, 0x34, 0xac, 0x4d, 0xad, 0x6e,
+		0xd4, 0x9a, 0x85, 0x6c, 0x82, 0x94, 0x56, 0x10, 0x24, 0x22, 0x25, 0x8b, 0xa5, 0xd6, 0x9a, 0xe4,
+		0x8a, 0xa7, 0x9e, 0x43, 0x09, 0xda, 0xb5, 0xc4, 0x3a, 0x66, 0x44, 0x43, 0xd7, 0x61, 0xb4, 0x6d,
+		0x60, 0x5f, 0x31, 0xea, 0xbc, 0x66, 0x39, 0xca, 0xc4, 0x74, 0xcf, 0x9a, 0xa9, 0x1c, 0x8d, 0x55,
+		0x6c, 0xa4, 0xed, 0xff, 0x24, 0x0f, 0x81, 0x9b, 0xa0, 0x51, 0xb5, 0x02, 0x3a, 0x72, 0xe5, 0x45,
+		0xe2, 0xb2, 0xbe, 0x63, 0x14, 0x3f, 0x08, 0xa3, 0x41, 0xf1, 0x90, 0x49, 0x18, 0xb0, 0x1d, 0xbd,
+		0xed, 0x50, 0x2d, 0x1c, 0x50, 0xd9, 0x07, 0x91, 0x21, 0x6d, 0x98, 0x75, 0x3a, 0x32, 0x0e, 0xa8,
+		0xf8, 0x93, 0xbc, 0xdb, 0xab, 0x70, 0x9a, 0x56, 0xf8, 0xd1, 0x68, 0x8b, 0x06, 0x28, 0x87, 0xeb,
+		0x5d, 0xbc, 0x0c, 0x23, 0x81, 0x0a, 0xf4, 0x5b, 0xb4, 0xf2, 0xaf, 0x33, 0x70, 0x30, 0x96, 0x36,
+		0x79, 0x11, 0x26, 0x3b, 0x66, 0xc3, 0x74, 0x8c, 0x76, 0xab, 0x6d, 0xa0, 0xca, 0xb2, 0xb2, 0x0a,
+		0x6f, 0x0e, 0x25, 0x28, 0xdd, 0xba, 0x1f, 0x9a, 0x51, 0x51, 0x27, 0x3a, 0xd1, 0x44, 0xf2, 0x12,
+		0x0c, 0xa3, 0x7e, 0xe8, 0x6d, 0x9d, 0x12, 0x64, 0xbd, 0x71, 0xa6, 0xbf, 0x2a, 0x4f, 0xcf, 0x7b,
+		0x98, 0xe5, 0xf4, 0xc7, 0xa5, 0x94, 0xea, 0xa7, 0x45, 0x2e, 0x43, 0x76, 0xd3, 0xd0, 0x9d, 0x4e,
+		0xdb, 0xb0, 0x0b, 0x33, 0x54, 0x94, 0x47, 0xa3, 0x9d, 0x94, 0x01, 0x54, 0x0d, 0x47, 0x75, 0x81,
+		0xc9, 0x0e, 0xe4, 0x6f, 0x1b, 0xed, 0xc6, 0x66, 0xa3, 0xc6, 0x98, 0x4a, 0xd3, 0xc1, 0xe7, 0xc9,
+		0x3e, 0x99, 0xba, 0xe9, 0x43, 0xad, 0x3a, 0xba, 0x63, 0xcc, 0xc2, 0xfa, 0xf2, 0xcd, 0x8a, 0xba,
+		0x70, 0x75, 0xa1, 0x32, 0xcf, 0xd8, 0x0c, 0x90, 0x2f, 0xfe, 0x90, 0x04, 0xc3, 0xbe, 0x9a, 0xe0,
+		0x70, 0x68, 0x76, 0x76, 0x36, 0x8c, 0x36, 0x6f, 0x2f, 0xfe, 0x45, 0x8e, 0x42, 0x6e, 0xb3, 0xd3,
+		0x6c, 0x32, 0xa5, 0x63, 0x73, 0x69, 0x16, 0x13, 0x50, 0xe1, 0x70, 0x8c, 0xe3, 0xc3, 0x08, 0x1d,
+		0xe3, 0xf0, 0x37, 0x29, 0x42, 0x56, 0x28, 0x65, 0x61, 0x60, 0x4a, 0x3a, 0x95, 0x55, 0xdd, 0x6f,
+		0x96, 0xd7, 0x32, 0x74, 0xc7, 0xa8, 0x17, 0x06, 0x45, 0x1e, 0xfb, 0xbe, 0x91, 0xc9, 0x66, 0xe4,
+		0x01, 0xe5, 0x02, 0x8c, 0x47, 0xaa, 0x42, 0xc6, 0x60, 0x78, 0xbe, 0x32, 0xb7, 0x58, 0x52, 0x4b,
+		0x6b, 0x0b, 0x2b, 0xcb, 0xf2, 0x01, 0x32, 0x0a, 0xbe, 0xda, 0xc9, 0xd2, 0xe9, 0x5c, 0xf6, 0xad,
+		0x21, 0xf9, 0x23, 0x1f, 0xf9, 0xc8, 0x47, 0x52, 0xca, 0x6f, 0x0e, 0xc2, 0x64, 0xdc, 0x20, 0x18,
+		0x3b, 0x1e, 0x7b, 0x95, 0x4e, 0x07, 0x2a, 0x5d, 0x82, 0x81, 0xa6, 0xbe, 0x61, 0x34, 0x0b, 0x19,
+		0xda, 0x08, 0x67, 0xfa, 0x1a, 0x66, 0xa7, 0x17, 0x11, 0x45, 0x65, 0x98, 0xe4, 0x59, 0x2e, 0x9a,
+		0x01, 0x4a, 0xe1, 0x74, 0x7f, 0x14, 0x70, 0x70, 0xe4, 0x62, 0x3c, 0x0a, 0x39, 0xfc, 0xcf, 0xe4,
+		0x3e, 0xc8, 0xe4, 0x8e, 0x09, 0x54, 0xee, 0x45, 0xc8, 0xd2, 0x71, 0xaf, 0x6e, 0xb8, 0x6d, 0x22,
+		0xbe, 0x71, 0xa4, 0xa8, 0x1b, 0x9b, 0x7a, 0xa7, 0xe9, 0x68, 0xb7, 0xf5, 0x66, 0xc7, 0xa0, 0x23,
+		0x58, 0x4e, 0xcd, 0xf3, 0xc4, 0x9b, 0x98, 0x46, 0x4e, 0xc0, 0x30, 0x1b, 0x26, 0x1b, 0x66, 0xdd,
+		0xb8, 0x43, 0xa7, 0xd0, 0x01, 0x95, 0x8d, 0x9c, 0x0b, 0x98, 0x82, 0xc5, 0xbf, 0x62, 0x5b, 0xa6,
+		0x18, 0x6b, 0x68, 0x11, 0x98, 0x40, 0x8b, 0xbf, 0x1c, 0x9e, 0xbd, 0x1f, 0x88, 0xaf, 0x5e, 0x64,
+		0x70, 0x3c, 0x09, 0x63, 0x14, 0xe2, 0x09, 0xde, 0x95, 0xf5, 0x66, 0x61, 0x9c, 0xaa, 0xc1, 0x28,
+		0x4b, 0x5e, 0xe1, 0xa9, 0xca, 0xaf, 0xa6, 0x20, 0x43, 0x67, 0x8a, 0x31, 0x18, 0x5e, 0x7b, 0x69,
+		0xb5, 0xa2, 0xcd, 0xaf, 0xac, 0x97, 0x17, 0x2b, 0xb2, 0x84, 0x4d, 0x4f, 0x13, 0xae, 0x2e, 0xae,
+		0x94, 0xd6, 0xe4, 0x94, 0xfb, 0xbd, 0xb0, 0xbc, 0x76, 0xe9, 0x82, 0x9c, 0x76, 0x11, 0xd6, 0x59,
+		0x42, 0xc6, 0x0f, 0xf0, 0xc4, 0x8c, 0x3c, 0x40, 0x64, 0xc8, 0x33, 0x02, 0x0b, 0x2f, 0x56, 0xe6,
+		0x2f, 0x5d, 0x90, 0x07, 0x83, 0x29, 0x4f, 0xcc, 0xc8, 0x43, 0x64, 0x04, 0x72, 0x34, 0xa5, 0xbc,
+		0xb2, 0xb2, 0x28, 0x67, 0x5d, 0x9a, 0xd5, 0x35, 0x75, 0x61, 0xf9, 0x9a, 0x9c, 0x73, 0x69, 0x5e,
+		0x53, 0x57, 0xd6, 0x57, 0x65, 0x70, 0x29, 0x2c, 0x55, 0xaa, 0xd5, 0xd2, 0xb5, 0x8a, 0x3c, 0xec,
+		0x42, 0x94, 0x5f, 0x5a, 0xab, 0x54, 0xe5, 0x7c, 0x80, 0xad, 0x27, 0x66, 0xe4, 0x11, 0xb7, 0x88,
+		0xca, 0xf2, 0xfa, 0x92, 0x3c, 0x4a, 0xc6, 0x61, 0x84, 0x15, 0x21, 0x98, 0x18, 0x0b, 0x25, 0x5d,
+		0xba, 0x20, 0xcb, 0x1e, 0x23, 0x8c, 0xca, 0x78, 0x20, 0xe1, 0xd2, 0x05, 0x99, 0x28, 0x73, 0x30,
+		0x40, 0xd5, 0x90, 0x10, 0x18, 0x5d, 0x2c, 0x95, 0x2b, 0x8b, 0xda, 0xca, 0x2a, 0x76, 0x9a, 0xd2,
+		0xa2, 0x2c, 0x79, 0x69, 0x6a, 0x65, 0xb5, 0x52, 0x5a, 0xab, 0xcc, 0xcb, 0x69, 0x7f, 0xda, 0x7b,
+		0xd6, 0x17, 0xd4, 0xca, 0xbc, 0x9c, 0x52, 0x6a, 0x30, 0x19, 0x37, 0x43, 0xc6, 0x76, 0x21, 0x9f,
+		0x2e, 0xa4, 0x12, 0x74, 0x81, 0xd2, 0x0a, 0xeb, 0x82, 0xf2, 0xe5, 0x14, 0x4c, 0xc4, 0x58, 0x09,
+		0xb1, 0x85, 0x3c, 0x07, 0x03, 0x4c, 0x97, 0xd9, 0x48, 0xfd, 0x58, 0xac, 0xb9, 0x41, 0x35, 0x3b,
+		0x62, 0x3b, 0x51, 0x3c, 0xbf, 0xbd, 0x99, 0x4e, 0xb0, 0x37, 0x91, 0x44, 0x44, 0x61, 0xdf, 0x1f,
+		0x99, 0xcd, 0x99, 0xc1, 0x73, 0xa9, 0x1f, 0x83, 0x87, 0xa6, 0xed, 0x6d, 0x56, 0x1f, 0x88, 0x99,
+		0xd5, 0x9f, 0x82, 0xf1, 0x08, 0xa1, 0xbe, 0x67, 0xd7, 0xef, 0x90, 0xa0, 0x90, 0x24, 0x9c, 0x1e,
+		0x43, 0x62, 0x2a, 0x30, 0x24, 0x3e, 0x15, 0x96, 0xe0, 0x83, 0xc9, 0x8d, 0x10, 0x69, 0xeb, 0x9f,
+		0x91, 0xe0, 0x50, 0xfc, 0xba, 0x22, 0x96, 0x87, 0x67, 0x61, 0x70, 0xc7, 0x70, 0xb6, 0x2d, 0x61,
+		0x27, 0x3f, 0x1a, 0x63, 0x7d, 0x61, 0x76, 0xb8, 0xb1, 0x39, 0x16, 0xb9, 0x12, 0xe6, 0xf5, 0x44,
+		0xd2, 0x2a, 0x27, 0xc2, 0xe9, 0x77, 0xa7, 0xe0, 0x60, 0x2c, 0xf1, 0x58, 0x46, 0x1f, 0x00, 0x68,
+		0x98, 0xad, 0x8e, 0xc3, 0x6c, 0x61, 0x36, 0x12, 0xe7, 0x68, 0x0a, 0x1d, 0xbc, 0x70, 0x94, 0xed,
+		0x38, 0x6e, 0x3e, 0x9b, 0x25, 0x81, 0x25, 0x51, 0x80, 0x27, 0x3d, 0x46, 0x33, 0x94, 0xd1, 0xe3,
+		0x09, 0x35, 0x8d, 0x28, 0xe6, 0xe3, 0x20, 0xd7, 0x9a, 0x0d, 0xc3, 0x74, 0x34, 0xdb, 0x69, 0x1b,
+		0xfa, 0x4e, 0xc3, 0xdc, 0x62, 0xb3, 0xed, 0xec, 0xc0, 0xa6, 0xde, 0xb4, 0x0d, 0x75, 0x8c, 0x65,
+		0x57, 0x45, 0x2e, 0x62, 0x50, 0x05, 0x6a, 0xfb, 0x30, 0x06, 0x03, 0x18, 0x2c, 0xdb, 0xc5, 0x50,
+		0x7e, 0x31, 0x07, 0xc3, 0xbe, 0x55, 0x18, 0x79, 0x10, 0xf2, 0xaf, 0xe8, 0xb7, 0x75, 0x4d, 0xac,
+		0xac, 0x99, 0x24, 0x86, 0x31, 0x6d, 0x95, 0x25, 0x91, 0xc7, 0x61, 0x92, 0x82, 0x58, 0x1d, 0xc7,
+		0x68, 0x6b, 0xb5, 0xa6, 0x6e, 0xdb, 0x54, 0x68, 0x59, 0x0a, 0x4a, 0x30, 0x6f, 0x05, 0xb3, 0xe6,
+		0x44, 0x0e, 0xb9, 0x08, 0x13, 0x14, 0x63, 0xa7, 0xd3, 0x74, 0x1a, 0xad, 0xa6, 0xa1, 0xe1, 0xca,
+		0xdf, 0x2e, 0x80, 0x9f, 0xb3, 0x71, 0x84, 0x58, 0xe2, 0x00, 0xc8, 0x91, 0x4d, 0xe6, 0xe1, 0x01,
+		0x8a, 0xb6, 0x65, 0x98, 0x46, 0x5b, 0x77, 0x0c, 0xcd, 0xf8, 0x40, 0x47, 0x6f, 0xda, 0x9a, 0x6e,
+		0xd6, 0xb5, 0x6d, 0xdd, 0xde, 0x2e, 0x4c, 0x22, 0x81, 0x72, 0xaa, 0x20, 0xa9, 0x47, 0x10, 0xf0,
+		0x1a, 0x87, 0xab, 0x50, 0xb0, 0x92, 0x59, 0xbf, 0xae, 0xdb, 0xdb, 0x64, 0x16, 0x0e, 0x51, 0x2a,
+		0xb6, 0xd3, 0x6e, 0x98, 0x5b, 0x5a, 0x6d, 0xdb, 0xa8, 0xdd, 0xd2, 0x3a, 0xce, 0xe6, 0x93, 0x85,
+		0xa3, 0xfe, 0xf2, 0x29, 0x87, 0x55, 0x0a, 0x33, 0x87, 0x20, 0xeb, 0xce, 0xe6, 0x93, 0xa4, 0x0a,
+		0x79, 0x6c, 0x8c, 0x9d, 0xc6, 0x07, 0x0d, 0x6d, 0xd3, 0x6a, 0xd3, 0x39, 0x74, 0x34, 0x66, 0x68,
+		0xf2, 0x49, 0x70, 0x7a, 0x85, 0x23, 0x2c, 0x59, 0x75, 0x63, 0x76, 0xa0, 0xba, 0x5a, 0xa9, 0xcc,
+		0xab, 0xc3, 0x82, 0xca, 0x55, 0xab, 0x8d, 0x0a, 0xb5, 0x65, 0xb9, 0x02, 0x1e, 0x66, 0x0a, 0xb5,
+		0x65, 0x09, 0xf1, 0x5e, 0x84, 0x89, 0x5a, 0x8d, 0xd5, 0xb9, 0x51, 0xd3, 0xf8, 0x8a, 0xdc, 0x2e,
+		0xc8, 0x01, 0x61, 0xd5, 0x6a, 0xd7, 0x18, 0x00, 0xd7, 0x71, 0x9b, 0x5c, 0x81, 0x83, 0x9e, 0xb0,
+		0xfc, 0x88, 0xe3, 0x91, 0x5a, 0x86, 0x51, 0x2f, 0xc2, 0x44, 0x6b, 0x37, 0x8a, 0x48, 0x02, 0x25,
+		0xb6, 0x76, 0xc3, 0x68, 0x8f, 0x50, 0x2f, 0x4b, 0xdb, 0xa8, 0x51, 0x53, 0xef, 0xb0, 0x1f, 0xda,
+		0x97, 0x41, 0xa6, 0x41, 0xae, 0xd5, 0x34, 0xc3, 0xd4, 0x37, 0x9a, 0x86, 0xa6, 0xb7, 0x0d, 0x53,
+		0xb7, 0x0b, 0x27, 0x28, 0x70, 0xc6, 0x69, 0x77, 0x0c, 0x75, 0xb4, 0x56, 0xab, 0xd0, 0xcc, 0x12,
+		0xcd, 0x23, 0xa7, 0x61, 0xdc, 0xda, 0x78, 0xa5, 0xc6, 0x14, 0x4b, 0x6b, 0xb5, 0x8d, 0xcd, 0xc6,
+		0x9d, 0xc2, 0xc3, 0x54, 0x4a, 0x63, 0x98, 0x41, 0xd5, 0x6a, 0x95, 0x26, 0x93, 0xc7, 0x40, 0xae,
+		0xd9, 0xdb, 0x7a, 0xbb, 0x45, 0x47, 0x56, 0xbb, 0xa5, 0xd7, 0x8c, 0xc2, 0x23, 0x0c, 0x94, 0xa5,
+		0x2f, 0x8b, 0x64, 0x54, 0x6c, 0xfb, 0xd5, 0xc6, 0xa6, 0x23, 0x28, 0x9e, 0x64, 0x8a, 0x4d, 0xd3,
+		0x38, 0xb5, 0x53, 0x20, 0xb7, 0xb6, 0x5b, 0xc1, 0x82, 0x4f, 0x51, 0xb0, 0xd1, 0xd6, 0x76, 0xcb,
+		0x5f, 0xee, 0x43, 0x30, 0xd2, 0xda, 0xf6, 0x17, 0xfa, 0x18, 0xb3, 0xbf, 0x5a, 0xdb, 0xbe, 0x12,
+		0x2f, 0xc0, 0x21, 0x04, 0xda, 0x31, 0x1c, 0xbd, 0xae, 0x3b, 0xba, 0x0f, 0xfa, 0x5d, 0x14, 0x7a,
+		0xb2, 0xb5, 0xdd, 0x5a, 0xe2, 0x99, 0x01, 0x3e, 0xdb, 0x9d, 0x8d, 0x5d, 0x57, 0x3f, 0xce, 0x32,
+		0x3e, 0x31, 0x4d, 0x68, 0xc8, 0xbe, 0x97, 0x1f, 0x6f, 0xdb, 0x62, 0x4b, 0x99, 0x85, 0xbc, 0x5f,
+		0xef, 0x49, 0x0e, 0x98, 0xe6, 0xcb, 0x12, 0x1a, 0x41, 0x73, 0x2b, 0xf3, 0x68, 0xbe, 0xbc, 0x5c,
+		0x91, 0x53, 0x68, 0x46, 0x2d, 0x2e, 0xac, 0x55, 0x34, 0x75, 0x7d, 0x79, 0x6d, 0x61, 0xa9, 0x22,
+		0xa7, 0x7d, 0x86, 0xfd, 0x8d, 0x4c, 0xf6, 0xb4, 0x7c, 0xe6, 0x46, 0x26, 0xfb, 0xa8, 0x7c, 0x92,
+		0x8a, 0x27, 0xa2, 0x94, 0xca, 0xd7, 0xd2, 0x30, 0x1a, 0x5c, 0x96, 0x93, 0xa7, 0xe1, 0xb0, 0xf0,
+		0xbb, 0xd9, 0x86, 0xa3, 0xbd, 0xda, 0x68, 0xd3, 0xce, 0xba, 0xa3, 0xb3, 0x89, 0xd3, 0x55, 0xca,
+		0x49, 0x0e, 0x55, 0x35, 0x9c, 0x17, 0x1a, 0x6d, 0xec, 0x8a, 0x3b, 0xba, 0x43, 0x16, 0xe1, 0x84,
+		0x69, 0x69, 0xb6, 0xa3, 0x9b, 0x75, 0xbd, 0x5d, 0xd7, 0x3c, 0xff, 0xa7, 0xa6, 0xd7, 0x6a, 0x86,
+		0x6d, 0x5b, 0x6c, 0x92, 0x74, 0xa9, 0x1c, 0x33, 0xad, 0x2a, 0x07, 0xf6, 0x66, 0x8f, 0x12, 0x07,
+		0x0d, 0xf5, 0x89, 0x74, 0x52, 0x9f, 0x38, 0x0a, 0xb9, 0x1d, 0xbd, 0xa5, 0x19, 0xa6, 0xd3, 0xde,
+		0xa5, 0xb6, 0x7b, 0x56, 0xcd, 0xee, 0xe8, 0xad, 0x0a, 0x7e, 0x93, 0x9b, 0xf0, 0xa8, 0x07, 0xaa,
+		0x35, 0x8d, 0x2d, 0xbd, 0xb6, 0xab, 0x51, 0x43, 0x9d, 0xfa, 0x88, 0xb4, 0x9a, 0x65, 0x6e, 0x36,
+		0x1b, 0x35, 0xc7, 0x2e, 0x0c, 0xbb, 0xe3, 0x9f, 0xe2, 0x61, 0x2c, 0x52, 0x84, 0x1b, 0xb6, 0x65,
+		0x52, 0xfb, 0x7c, 0x4e, 0x40, 0x07, 0xd4, 0x26, 0xff, 0x8e, 0x50, 0x9b, 0x60, 0xd3, 0x67, 0xe4,
+		0x81, 0x1b, 0x99, 0xec, 0x80, 0x3c, 0x78, 0x23, 0x93, 0x1d, 0x94, 0x87, 0x6e, 0x64, 0xb2, 0x59,
+		0x39, 0x77, 0x23, 0x93, 0xcd, 0xc9, 0xa0, 0xdc, 0x1d, 0x81, 0xbc, 0x7f, 0xb9, 0x81, 0xab, 0xb7,
+		0x1a, 0x9d, 0x70, 0x25, 0x3a, 0x24, 0x3f, 0xd4, 0x75, 0x71, 0x32, 0x3d, 0x87, 0x33, 0xf1, 0xec,
+		0x20, 0xb3, 0xed, 0x55, 0x86, 0x89, 0x56, 0x10, 0x76, 0x32, 0x83, 0xd9, 0x52, 0x59, 0x95, 0x7f,
+		0x91, 0x6b, 0x30, 0xf8, 0x8a, 0x4d, 0x69, 0x0f, 0x52, 0xda, 0x0f, 0x77, 0xa7, 0x7d, 0xa3, 0x4a,
+		0x89, 0xe7, 0x6e, 0x54, 0xb5, 0xe5, 0x15, 0x75, 0xa9, 0xb4, 0xa8, 0x72, 0x74, 0x72, 0x04, 0x32,
+		0x4d, 0xfd, 0x83, 0xbb, 0xc1, 0x39, 0x9b, 0x26, 0x91, 0x69, 0x18, 0xeb, 0x98, 0x6c, 0xad, 0x8e,
+		0x6d, 0x8c, 0x50, 0x63, 0x7e, 0xa8, 0x51, 0x2f, 0x77, 0x11, 0xe1, 0xfb, 0xd4, 0xab, 0x23, 0x90,
+		0x41, 0xa7, 0x74, 0x70, 0x66, 0xa5, 0x49, 0xe4, 0x14, 0xe4, 0xeb, 0xc6, 0x46, 0x67, 0x4b, 0x6b,
+		0x1b, 0x75, 0xbd, 0xe6, 0x04, 0xe7, 0x93, 0x61, 0x9a, 0xa5, 0xd2, 0x1c, 0xf2, 0x3c, 0xe4, 0xb0,
+		0x8d, 0x4c, 0xda, 0xc6, 0xe3, 0x54, 0x04, 0x67, 0xbb, 0x8b, 0x80, 0x37, 0xb1, 0x40, 0x52, 0x3d,
+		0x7c, 0x72, 0x1d, 0x86, 0x1c, 0xbd, 0xbd, 0x65, 0x38, 0x76, 0x61, 0x62, 0x2a, 0x7d, 0x6a, 0x74,
+		0x66, 0xba, 0x1f, 0x52, 0x6b, 0x14, 0x85, 0xae, 0x94, 0x05, 0x3a, 0x79, 0x01, 0x64, 0xee, 0x8a,
+		0xd5, 0xf8, 0x32, 0xd7, 0x2e, 0x4c, 0x52, 0x05, 0x7c, 0x57, 0x77, 0x92, 0xdc, 0x93, 0x3b, 0xcf,
+		0x90, 0xd4, 0x31, 0x23, 0xf0, 0x1d, 0xec, 0x17, 0x07, 0xf7, 0xd2, 0x2f, 0xd6, 0x61, 0x8c, 0xff,
+		0xd6, 0xec, 0x4e, 0xab, 0x65, 0xb5, 0x9d, 0xc2, 0xa1, 0x29, 0xa9, 0x37, 0x43, 0x82, 0x18, 0xc3,
+		0x51, 0x47, 0x37, 0x03, 0xdf, 0x6f, 0x5f, 0x77, 0x2b, 0xbe, 0x0c, 0xa3, 0x41, 0x61, 0xf8, 0x1d,
+		0xe1, 0xe9, 0x3e, 0x1d, 0xe1, 0xb8, 0x2c, 0x11, 0x0b, 0x35, 0x9c, 0x9a, 0xd8, 0x47, 0xf1, 0x87,
+		0x53, 0x30, 0x1a, 0xac, 0x18, 0xb9, 0x06, 0x44, 0xb4, 0x58, 0xc3, 0x74, 0xda, 0x56, 0xbd, 0x53,
+		0x33, 0xea, 0x05, 0xa9, 0x47, 0x39, 0xe3, 0x1c, 0x67, 0xc1, 0x45, 0xf1, 0x13, 0xf2, 0xf5, 0x82,
+		0x54, 0x9f, 0x84, 0xe6, 0xbd, 0xfe, 0x71, 0x0e, 0x26, 0x04, 0x01, 0x24, 0xf6, 0xaa, 0xde, 0x36,
+		0xd1, 0x44, 0x66, 0x46, 0x3b, 0xf1, 0x65, 0xbd, 0xc0, 0x72, 0x48, 0x09, 0x84, 0xba, 0x68, 0x6d,
+		0x63, 0xc7, 0x42, 0x7f, 0x57, 0xa6, 0x47, 0xb1, 0xa3, 0x1c, 0x41, 0x65, 0xf0, 0xca, 0x39, 0x18,
+		0xa0, 0xc3, 0x0f, 0x01, 0xe0, 0x03, 0x90, 0x7c, 0x80, 0x64, 0x21, 0x33, 0xb7, 0xa2, 0xe2, 0xf4,
+		0x28, 0x43, 0x9e, 0xa5, 0x6a, 0xab, 0x0b, 0x95, 0xb9, 0x8a, 0x9c, 0x52, 0x2e, 0xc2, 0x20, 0x1b,
+		0x53, 0x70, 0xea, 0x74, 0x47, 0x15, 0xf9, 0x00, 0xff, 0xe4, 0x34, 0x24, 0x91, 0xbb, 0xbe, 0x54,
+		0xae, 0xa8, 0x72, 0x4a, 0x59, 0x87, 0xb1, 0x50, 0x3f, 0x24, 0x07, 0x61, 0x5c, 0xad, 0xac, 0x55,
+		0x96, 0xd1, 0x39, 0xa0, 0xad, 0x2f, 0x3f, 0xbf, 0xbc, 0xf2, 0x02, 0x7a, 0xd6, 0x02, 0xc9, 0x62,
+		0x1e, 0x96, 0xc8, 0x24, 0xc8, 0x5e, 0x72, 0x75, 0x65, 0x5d, 0xa5, 0xdc, 0xfc, 0x9d, 0x14, 0xc8,
+		0xe1, 0x4e, 0x49, 0x0e, 0xc3, 0xc4, 0x5a, 0x49, 0xbd, 0x56, 0x59, 0xd3, 0x98, 0xc3, 0xc3, 0x25,
+		0x3d, 0x09, 0xb2, 0x3f, 0xe3, 0xea, 0x02, 0xf5, 0xe7, 0x9c, 0x80, 0xa3, 0xfe, 0xd4, 0xca, 0x8b,
+		0x6b, 0x95, 0xe5, 0x2a, 0x2d, 0xbc, 0xb4, 0x7c, 0x0d, 0x8d, 0x82, 0x10, 0x3d, 0xe1, 0x62, 0x49,
+		0x23, 0xab, 0x41, 0x7a, 0x95, 0xc5, 0x79, 0x39, 0x13, 0x4e, 0x5e, 0x59, 0xae, 0xac, 0x5c, 0x95,
+		0x07, 0xc2, 0xa5, 0x53, 0xb7, 0xcb, 0x20, 0x29, 0xc2, 0xa1, 0x70, 0xaa, 0x56, 0x59, 0x5e, 0x53,
+		0x5f, 0x92, 0x87, 0xc2, 0x05, 0x57, 0x2b, 0xea, 0xcd, 0x85, 0xb9, 0x8a, 0x9c, 0x25, 0x87, 0x80,
+		0x04, 0x39, 0x5a, 0xbb, 0xbe, 0x32, 0x2f, 0xe7, 0xe2, 0x66, 0x2c, 0x22, 0x4f, 0x28, 0xbf, 0x20,
+		0x41, 0xde, 0xef, 0x02, 0x09, 0x0c, 0x2a, 0xd2, 0x3b, 0x6d, 0xb2, 0x55, 0xfe, 0x6d, 0x0a, 0x86,
+		0x7d, 0xbe, 0x10, 0x5c, 0xc4, 0xea, 0xcd, 0xa6, 0xf5, 0xaa, 0xa6, 0x37, 0x1b, 0xba, 0xcd, 0xe7,
+		0x43, 0xa0, 0x49, 0x25, 0x4c, 0xe9, 0x77, 0xfe, 0xe9, 0xdf, 0x74, 0x19, 0xdc, 0xb7, 0xe9, 0x32,
+		0xf4, 0x0e, 0x34, 0x5d, 0x06, 0xe4, 0x41, 0xe5, 0x0f, 0x52, 0x20, 0x87, 0xbd, 0x23, 0x21, 0xb9,
+		0x49, 0x49, 0x72, 0xf3, 0xd7, 0x2f, 0xb5, 0x97, 0xfa, 0x85, 0x67, 0xf5, 0x74, 0xe2, 0xac, 0x1e,
+		0x33, 0x59, 0x65, 0xde, 0xc9, 0x93, 0x95, 0x5f, 0x5d, 0x7f, 0x4f, 0x82, 0xd1, 0xa0, 0x33, 0x27,
+		0x20, 0x31, 0x65, 0x2f, 0x12, 0x0b, 0xb6, 0xc8, 0x83, 0x49, 0x2d, 0xf2, 0x57, 0x52, 0xaf, 0x1f,
+		0x49, 0xc3, 0x48, 0xc0, 0xf7, 0xd3, 0x2f, 0x77, 0x1f, 0x80, 0xf1, 0x46, 0xdd, 0xd8, 0x69, 0x59,
+		0x0e, 0x46, 0x1e, 0x68, 0x4d, 0xe3, 0xb6, 0xd1, 0xa4, 0x62, 0x18, 0x8d, 0xd9, 0x5d, 0x0d, 0x94,
+		0x30, 0xbd, 0xe0, 0xe1, 0x2d, 0x22, 0xda, 0xec, 0xc4, 0xc2, 0x7c, 0x65, 0x69, 0x75, 0x65, 0xad,
+		0xb2, 0x3c, 0xf7, 0x92, 0x18, 0xc9, 0x55, 0xb9, 0x11, 0x02, 0x0b, 0x08, 0xfc, 0xa1, 0x77, 0xc6,
+		0xa2, 0x73, 0x15, 0xe4, 0x70, 0x6d, 0x70, 0x40, 0x8f, 0xa9, 0x8f, 0x7c, 0x80, 0x4c, 0xc0, 0xd8,
+		0xf2, 0x8a, 0x56, 0x5d, 0x98, 0xaf, 0x68, 0x95, 0xab, 0x57, 0x2b, 0x73, 0x6b, 0x55, 0xb6, 0xd1,
+		0xe0, 0x42, 0xaf, 0xc9, 0x29, 0x7f, 0xdb, 0xfc, 0x68, 0x1a, 0x26, 0x62, 0x38, 0x21, 0x25, 0xee,
+		0x22, 0x64, 0x5e, 0xcb, 0xb3, 0xfd, 0x70, 0x3f, 0x8d, 0xab, 0xfb, 0x55, 0xbd, 0xed, 0x70, 0x8f,
+		0xe2, 0x63, 0x80, 0xe2, 0x35, 0x1d, 0x34, 0xef, 0xdb, 0x7c, 0x03, 0x87, 0x99, 0x20, 0x63, 0x5e,
+		0x3a, 0xdb, 0xc3, 0x79, 0x17, 0x90, 0x96, 0x65, 0x37, 0x9c, 0xc6, 0x6d, 0x0c, 0x84, 0x10, 0xbb,
+		0x3d, 0xd8, 0x71, 0x33, 0xaa, 0x2c, 0x72, 0x16, 0x4c, 0xc7, 0x85, 0x36, 0x8d, 0x2d, 0x3d, 0x04,
+		0x8d, 0xcb, 0x8f, 0xb4, 0x2a, 0x8b, 0x1c, 0x17, 0xfa, 0x41, 0xc8, 0xd7, 0xad, 0x0e, 0x7a, 0x65,
+		0x18, 0x1c, 0x0e, 0xc9, 0x92, 0x3a, 0xcc, 0xd2, 0x5c, 0x10, 0xee, 0x36, 0xf3, 0xb6, 0x99, 0xf2,
+		0xea, 0x30, 0x4b, 0x63, 0x20, 0x27, 0x61, 0x4c, 0xdf, 0xda, 0x6a, 0x23, 0x71, 0x41, 0x88, 0x39,
+		0x02, 0x47, 0xdd, 0x64, 0x0a, 0x58, 0xbc, 0x01, 0x59, 0x21, 0x07, 0x5c, 0xff, 0xa2, 0x24, 0xb4,
+		0x16, 0xf3, 0x6e, 0xa7, 0x70, 0xe7, 0xc9, 0x14, 0x99, 0x0f, 0x42, 0xbe, 0x61, 0x6b, 0x5e, 0x18,
+		0x44, 0x6a, 0x2a, 0x75, 0x2a, 0xab, 0x0e, 0x37, 0x6c, 0x77, 0x57, 0x54, 0xf9, 0xa5, 0x3c, 0x80,
+		0xa7, 0x6c, 0xe4, 0x07, 0x24, 0x18, 0x65, 0x13, 0x4c, 0xab, 0x6d, 0xd8, 0x86, 0x59, 0x13, 0xcb,
+		0xc2, 0xc7, 0xba, 0xa8, 0x28, 0x1b, 0xe6, 0x56, 0x39, 0x42, 0xf9, 0xb9, 0x8f, 0x4b, 0xd2, 0xeb,
+		0x52, 0xe6, 0x75, 0x49, 0xfa, 0x29, 0x69, 0x84, 0x64, 0x2b, 0x2f, 0xae, 0x2e, 0x2e, 0xcc, 0x2d,
+		0xac, 0x15, 0x3e, 0x36, 0x44, 0xbf, 0x17, 0x96, 0xf8, 0xf7, 0x9b, 0x43, 0xc1, 0xfc, 0xb7, 0x86,
+		0x7e, 0x59, 0x4a, 0x67, 0xdf, 0x1a, 0x52, 0x47, 0x36, 0xfd, 0xf4, 0x48, 0xd3, 0x1f, 0x41, 0x91,
+		0x4a, 0x5a, 0x48, 0x7a, 0xdc, 0x54, 0x78, 0xdc, 0x44, 0xf9, 0x31, 0xca, 0xc8, 0x20, 0x65, 0x64,
+		0x98, 0x0c, 0xce, 0x2d, 0xae, 0x54, 0x2b, 0xf3, 0x94, 0x8d, 0x1c, 0xc9, 0xac, 0xac, 0x56, 0x96,
+		0x0b, 0x6f, 0x8a, 0x22, 0xbd, 0x60, 0x8b, 0xd7, 0x25, 0x38, 0x2c, 0x76, 0x59, 0xf9, 0x5c, 0x6b,
+		0x98, 0x35, 0xab, 0x2e, 0xac, 0xdb, 0xd1, 0x99, 0xf3, 0xdd, 0x0a, 0x57, 0x39, 0x2a, 0x15, 0x49,
+		0x85, 0x23, 0x96, 0xcf, 0x46, 0x44, 0x52, 0x5a, 0x9e, 0xe7, 0xbc, 0x0c, 0x93, 0xc1, 0xd5, 0xd2,
+		0xdc, 0xf3, 0x95, 0x79, 0x8f, 0x9b, 0x83, 0xed, 0x38, 0x2a, 0xe4, 0x5b, 0x61, 0x0c, 0xbd, 0xad,
+		0xa8, 0x1b, 0x8d, 0x3a, 0xdb, 0xf6, 0xce, 0x24, 0xed, 0x97, 0x7a, 0x1c, 0xa1, 0xfb, 0xf5, 0xa6,
+		0x8b, 0x51, 0x7e, 0xcc, 0xc7, 0x4a, 0x8e, 0x64, 0x96, 0x57, 0x96, 0x2b, 0x82, 0x0d, 0xba, 0x45,
+		0xfc, 0x92, 0xc7, 0xc6, 0x68, 0x27, 0x80, 0x4a, 0xbe, 0x15, 0x64, 0xe1, 0x1e, 0x72, 0x45, 0x32,
+		0x90, 0xb4, 0xe5, 0xeb, 0x31, 0xc0, 0x9d, 0x4c, 0xae, 0x30, 0x1e, 0xf5, 0x71, 0x30, 0x49, 0xc6,
+		0x16, 0x2b, 0xcb, 0xd7, 0xd6, 0xae, 0x6b, 0xab, 0x6a, 0x85, 0xee, 0xdc, 0x15, 0x3e, 0x26, 0x8a,
+		0x1f, 0xdb, 0x09, 0x22, 0x92, 0x8f, 0x4a, 0x30, 0xcc, 0x4c, 0x20, 0xe6, 0x93, 0x62, 0x4e, 0x85,
+		0x47, 0xbb, 0x95, 0x4d, 0x2d, 0x20, 0x0a, 0x5d, 0xbe, 0x42, 0x8b, 0x4d, 0x0b, 0x85, 0x38, 0x4c,
+		0xc8, 0x62, 0xe5, 0x5a, 0x69, 0xee, 0x25, 0xad, 0x5c, 0xa9, 0xae, 0xe1, 0x48, 0xb6, 0xa2, 0x32,
+		0x1d, 0x05, 0x32, 0x50, 0x5a, 0x5c, 0x5c, 0x79, 0xc1, 0x13, 0x04, 0xbc, 0xe2, 0x92, 0x21, 0x3f,
+		0x2f, 0xc1, 0xa4, 0x61, 0x6e, 0x5a, 0x18, 0x55, 0x65, 0x52, 0xcf, 0xbf, 0x66, 0x3b, 0xbb, 0x4d,
+		0xd6, 0xa3, 0x63, 0x17, 0xe5, 0x7e, 0xcd, 0xa4, 0x78, 0xcb, 0x14, 0xad, 0x8a, 0x58, 0xe5, 0x85,
+		0x8f, 0x4b, 0xa9, 0xd7, 0x91, 0xb1, 0x14, 0xe5, 0x2d, 0xf3, 0xba, 0x34, 0x40, 0x39, 0x1c, 0x7a,
+		0x5d, 0xca, 0xbe, 0x2e, 0xe5, 0x7e, 0x4a, 0x1a, 0x27, 0xf9, 0xea, 0xda, 0x4b, 0x8b, 0x15, 0x8d,
+		0x71, 0x4b, 0x39, 0x1c, 0x25, 0x39, 0x9a, 0x36, 0xf3, 0xf8, 0xcc, 0x85, 0xc2, 0x1f, 0x53, 0x2e,
+		0xff, 0x78, 0x48, 0x25, 0x46, 0x84, 0xbc, 0xf2, 0x3e, 0x18, 0x09, 0x74, 0x4e, 0x34, 0xe1, 0xa9,
+		0xe9, 0x8f, 0xf2, 0xae, 0x56, 0x96, 0xe7, 0xfc, 0x4b, 0x8e, 0x3c, 0xb8, 0x9d, 0x51, 0x96, 0xf0,
+		0x4b, 0x74, 0x55, 0x39, 0x85, 0x83, 0x3e, 0x17, 0x97, 0xbb, 0xf3, 0x99, 0x56, 0x2e, 0x43, 0x56,
+		0x74, 0x36, 0x5c, 0x48, 0xd0, 0xf5, 0x40, 0x68, 0x19, 0x93, 0x05, 0xda, 0xd3, 0x64, 0x09, 0x17,
+		0x6d, 0xac, 0x07, 0xca, 0x29, 0xe5, 0x26, 0x1c, 0x8c, 0xed, 0x28, 0xe4, 0x21, 0x38, 0x21, 0x76,
+		0x5b, 0xd9, 0x12, 0x45, 0xab, 0x2c, 0xcf, 0xad, 0xcc, 0xe3, 0xa2, 0xce, 0xa3, 0x09, 0xc0, 0x7b,
+		0x0c, 0xe3, 0x52, 0xf4, 0x26, 0x39, 0xa5, 0x2c, 0xc0, 0x68, 0x50, 0xdd, 0xc9, 0x51, 0x38, 0xbc,
+		0xbe, 0x76, 0xf5, 0x49, 0xed, 0x66, 0x69, 0x71, 0x61, 0xbe, 0x14, 0x5a, 0xbe, 0x01, 0x70, 0x9d,
+		0x97, 0x53, 0xc8, 0x28, 0xf6, 0x05, 0x39, 0xad, 0x64, 0xb2, 0x92, 0x2c, 0x29, 0x55, 0x18, 0x0b,
+		0x29, 0x2e, 0x39, 0x06, 0x05, 0xbe, 0x9e, 0x8a, 0xe3, 0x6a, 0x02, 0xc2, 0xaa, 0xcc, 0x56, 0x96,
+		0xf3, 0x95, 0xc5, 0x85, 0xa5, 0x85, 0x35, 0xca, 0xdf, 0x75, 0x00, 0x4f, 0x23, 0x71, 0x86, 0xbd,
+		0x51, 0x5d, 0x59, 0xd6, 0xae, 0xe2, 0xb2, 0x74, 0xcd, 0x47, 0x2a, 0x07, 0x4c, 0x03, 0x65, 0x09,
+		0x57, 0x4f, 0x51, 0x35, 0x95, 0x53, 0xca, 0x0b, 0x40, 0xa2, 0xda, 0x44, 0xa6, 0xe0, 0x58, 0x65,
+		0xf9, 0xea, 0x8a, 0x3a, 0x57, 0xd1, 0x96, 0x4b, 0x4b, 0xc8, 0x1f, 0xd3, 0x1d, 0x8f, 0xf4, 0x08,
+		0x78, 0xaa, 0x23, 0xd6, 0xcc, 0x9e, 0x76, 0xc9, 0xa9, 0xd3, 0x83, 0x38, 0x71, 0x7f, 0xcf, 0xf2,
+		0xe9, 0xc1, 0xec, 0xf7, 0x2c, 0xcb, 0x9f, 0xc0, 0xff, 0x9f, 0x58, 0x96, 0x7f, 0x60, 0xf9, 0xc6,
+		0x60, 0xf6, 0xcd, 0x21, 0xf9, 0xad, 0x21, 0xe5, 0x3f, 0xa4, 0x81, 0x78, 0x2a, 0xed, 0xba, 0x7e,
+		0x5e, 0x84, 0xac, 0xeb, 0x4b, 0x62, 0xa1, 0xab, 0x4f, 0x77, 0xe9, 0x09, 0x02, 0xcd, 0x97, 0x14,
+		0xf2, 0x2d, 0xb9, 0xd4, 0xd0, 0x71, 0xb0, 0xd3, 0x30, 0x1b, 0x3b, 0x9d, 0x1d, 0x4d, 0x38, 0x58,
+		0x7a, 0x3a, 0x0e, 0x38, 0x02, 0xff, 0xa6, 0x24, 0xf4, 0x3b, 0x01, 0x12, 0x03, 0x3d, 0x49, 0x30,
+		0x04, 0xfe, 0x5d, 0xfc, 0x4b, 0x09, 0x0a, 0x49, 0xcc, 0xee, 0xcb, 0xf7, 0xb3, 0x0c, 0x93, 0xd6,
+		0x6d, 0xa3, 0xdd, 0x6e, 0xd4, 0xe9, 0x76, 0x8e, 0x6b, 0x11, 0x66, 0x7a, 0x5b, 0x84, 0x13, 0x3e,
+		0x44, 0x9e, 0x6c, 0x93, 0x32, 0x4e, 0xdc, 0x77, 0x70, 0xce, 0x12, 0x94, 0x06, 0x7a, 0x53, 0x1a,
+		0xa1, 0x28, 0x82, 0xc6, 0x0d, 0xd4, 0x7c, 0x5c, 0x84, 0xa5, 0xe4, 0xb4, 0x67, 0x76, 0x2a, 0x9f,
+		0x4b, 0xc1, 0x68, 0x30, 0x3a, 0x94, 0xcc, 0x43, 0xb6, 0x69, 0xf1, 0xc8, 0x2b, 0xd6, 0xda, 0xa7,
+		0x7a, 0x04, 0x94, 0x4e, 0x2f, 0x72, 0x78, 0xd5, 0xc5, 0x2c, 0xfe, 0x8e, 0x04, 0x59, 0x91, 0x4c,
+		0x0e, 0x41, 0xa6, 0xa5, 0x3b, 0xdb, 0x94, 0xdc, 0x40, 0x39, 0x25, 0x4b, 0x2a, 0xfd, 0xc6, 0x74,
+		0xbb, 0xa5, 0xb3, 0xa8, 0x33, 0x9e, 0x8e, 0xdf, 0x68, 0xfa, 0x35, 0x0d, 0xbd, 0x4e, 0x37, 0x22,
+		0xad, 0x9d, 0x1d, 0xc3, 0x74, 0x6c, 0x61, 0xfa, 0xf1, 0xf4, 0x39, 0x9e, 0x8c, 0x41, 0xca, 0x4e,
+		0x5b, 0x6f, 0x34, 0x03, 0xb0, 0x19, 0x0a, 0x2b, 0x8b, 0x0c, 0x17, 0x78, 0x16, 0x8e, 0x08, 0xba,
+		0x75, 0xc3, 0xd1, 0x6b, 0xdb, 0x46, 0xdd, 0x43, 0x1a, 0xa4, 0x01, 0x07, 0x87, 0x39, 0xc0, 0x3c,
+		0xcf, 0x17, 0xb8, 0xa1, 0x08, 0xec, 0x2f, 0xa4, 0x60, 0x5c, 0x6c, 0xa4, 0xd6, 0x5d, 0xd1, 0x2d,
+		0x01, 0xe8, 0xa6, 0x69, 0x39, 0x7e, 0xe1, 0x45, 0x6d, 0xdf, 0x08, 0xde, 0x74, 0xc9, 0x45, 0x52,
+		0x7d, 0x04, 0x8a, 0x5f, 0x91, 0x00, 0xbc, 0xac, 0x44, 0x29, 0x9e, 0x80, 0x61, 0x1e, 0x09, 0x4c,
+		0x83, 0xcb, 0x99, 0xbf, 0x11, 0x58, 0x12, 0x6e, 0xb9, 0xa2, 0x2b, 0x72, 0xc3, 0xd8, 0x6a, 0x98,
+		0x3c, 0xb4, 0x8b, 0x7d, 0x88, 0x08, 0x89, 0x8c, 0x17, 0xfa, 0xa8, 0x42, 0xd6, 0x36, 0x76, 0x74,
+		0xd3, 0x69, 0xd4, 0x78, 0x1f, 0xba, 0xb4, 0x27, 0xe6, 0xa7, 0xab, 0x1c, 0x5b, 0x75, 0xe9, 0x28,
+		0xa7, 0x20, 0x2b, 0x52, 0xdd, 0x61, 0xf8, 0x00, 0x19, 0x82, 0x74, 0xb5, 0x82, 0x13, 0x11, 0x1d,
+		0x0d, 0x17, 0x4a, 0x55, 0x39, 0x75, 0xfa, 0x67, 0x52, 0x30, 0x24, 0x3a, 0xf5, 0x04, 0x8c, 0x55,
+		0xe6, 0x17, 0x42, 0x23, 0xfa, 0x04, 0x8c, 0x8a, 0x44, 0x3e, 0xa2, 0x7d, 0x6c, 0xc8, 0x9f, 0xb8,
+		0xaa, 0xae, 0xac, 0xad, 0xcc, 0xc8, 0xff, 0x6b, 0x34, 0xf1, 0x09, 0xf9, 0xcd, 0x21, 0x32, 0x0e,
+		0x79, 0x91, 0x38, 0xf3, 0xf8, 0xcc, 0x13, 0xf2, 0x5b, 0xe1, 0xa4, 0x0b, 0xf2, 0x1f, 0x53, 0x57,
+		0x97, 0x48, 0x3a, 0xaf, 0xad, 0xe1, 0xb0, 0xbc, 0xb2, 0xbc, 0xf8, 0x92, 0x2c, 0xf9, 0x33, 0x66,
+		0x7c, 0x19, 0x29, 0xf2, 0x00, 0x1c, 0x16, 0x19, 0x57, 0xae, 0x5c, 0xb9, 0x72, 0xd9, 0x97, 0x79,
+		0xf7, 0x7b, 0x07, 0xc3, 0xd9, 0x4f, 0xfa, 0xb2, 0x3f, 0x19, 0xcd, 0xbe, 0xe2, 0xcb, 0xfe, 0x89,
+		0xef, 0x1d, 0x24, 0x13, 0x30, 0x2c, 0xb2, 0x97, 0x4a, 0x2f, 0xca, 0xdf, 0xf8, 0xc6, 0x37, 0xbe,
+		0x31, 0x54, 0xfe, 0x56, 0x98, 0xa8, 0x59, 0x3b, 0xe1, 0xa6, 0x29, 0xcb, 0xa1, 0x38, 0x0d, 0xfb,
+		0xba, 0xf4, 0xf2, 0x59, 0x0e, 0xb4, 0x65, 0x35, 0x75, 0x73, 0x6b, 0xda, 0x6a, 0x6f, 0x79, 0x87,
+		0x18, 0xd0, 0xe6, 0xb6, 0x7d, 0x47, 0x19, 0x5a, 0x1b, 0x7f, 0x29, 0x49, 0x3f, 0x95, 0x4a, 0x5f,
+		0x5b, 0x2d, 0xff, 0x5c, 0xaa, 0x78, 0x8d, 0x21, 0xae, 0x8a, 0x86, 0x57, 0x8d, 0xcd, 0xa6, 0x51,
+		0xc3, 0xd6, 0x81, 0x3f, 0x3d, 0x03, 0x93, 0x5b, 0xd6, 0x96, 0x45, 0x29, 0x9d, 0xc3, 0x5f, 0x8c,
+		0x09, 0x92, 0x73, 0x53, 0x8b, 0x3d, 0x8f, 0x4c, 0xcc, 0x2e, 0xc3, 0x04, 0x07, 0xd6, 0xe8, 0x12,
+		0x80, 0x6d, 0x25, 0x93, 0xae, 0xe1, 0x48, 0x85, 0x7f, 0xf2, 0x47, 0xd4, 0x77, 0xa3, 0x8e, 0x73,
+		0x54, 0xcc, 0x63, 0xbb, 0xcd, 0xb3, 0x2a, 0x1c, 0x0c, 0xd0, 0x63, 0xcb, 0x2f, 0xa3, 0xdd, 0x83,
+		0xe2, 0xbf, 0xe4, 0x14, 0x27, 0x7c, 0x14, 0xab, 0x1c, 0x75, 0x76, 0x0e, 0x46, 0xf6, 0x42, 0xeb,
+		0xb7, 0x38, 0xad, 0xbc, 0xe1, 0x27, 0x72, 0x0d, 0xc6, 0x28, 0x91, 0x5a, 0xc7, 0x76, 0xac, 0x1d,
+		0xba, 0xb6, 0xed, 0x4e, 0xe6, 0xbf, 0xfd, 0x23, 0x36, 0xd8, 0x8d, 0x22, 0xda, 0x9c, 0x8b, 0x35,
+		0x3b, 0x0b, 0x74, 0x29, 0x83, 0x31, 0xb6, 0x3d, 0x28, 0xfc, 0x36, 0x67, 0xc4, 0x85, 0x9f, 0xbd,
+		0x89, 0x96, 0x6d, 0x67, 0x87, 0x2e, 0x3d, 0xfd, 0x9c, 0xf4, 0x8e, 0x5d, 0x2a, 0xfc, 0xdb, 0xef,
+		0x60, 0xe3, 0xe9, 0x84, 0x4b, 0xc0, 0xc7, 0x93, 0xaf, 0x15, 0xb7, 0x0c, 0xc7, 0x31, 0xda, 0xb6,
+		0xa6, 0x37, 0xe3, 0xd8, 0xf3, 0x05, 0x7f, 0x14, 0x7e, 0xe4, 0xab, 0xc1, 0x56, 0xbc, 0xc6, 0x30,
+		0x4b, 0xcd, 0xe6, 0xec, 0x3a, 0x1c, 0x8e, 0xd1, 0x8a, 0x3e, 0x68, 0xfe, 0x28, 0xa7, 0x39, 0x19,
+		0xd1, 0x0c, 0x24, 0xbb, 0x0a, 0x22, 0xdd, 0x6d, 0xcb, 0x3e, 0x68, 0xfe, 0x18, 0xa7, 0x49, 0x38,
+		0xae, 0x68, 0x52, 0xa4, 0x78, 0x03, 0xc6, 0x6f, 0x1b, 0xed, 0x0d, 0xcb, 0xe6, 0x01, 0x37, 0x7d,
+		0x90, 0xfb, 0x71, 0x4e, 0x6e, 0x8c, 0x23, 0xd2, 0x08, 0x1c, 0xa4, 0x75, 0x05, 0xb2, 0x9b, 0x7a,
+		0xcd, 0xe8, 0x83, 0xc4, 0x5d, 0x4e, 0x62, 0x08, 0xe1, 0x11, 0xb5, 0x04, 0xf9, 0x2d, 0x8b, 0x7b,
+		0x1f, 0x7a, 0xa3, 0x7f, 0x92, 0xa3, 0x0f, 0x0b, 0x1c, 0x4e, 0xa2, 0x65, 0xb5, 0x3a, 0x4d, 0x74,
+		0x4d, 0xf4, 0x26, 0xf1, 0x13, 0x82, 0x84, 0xc0, 0xe1, 0x24, 0xf6, 0x20, 0xd6, 0x4f, 0x09, 0x12,
+		0xb6, 0x4f, 0x9e, 0xcf, 0x61, 0x1c, 0x6e, 0x73, 0xd7, 0x32, 0xfb, 0x61, 0xe2, 0x27, 0x39, 0x05,
+		0xe0, 0x28, 0x48, 0xe0, 0x29, 0xc8, 0xf5, 0xdb, 0x10, 0x3f, 0xfd, 0x55, 0xd1, 0x3d, 0x44, 0x0b,
+		0x5c, 0x83, 0x31, 0x31, 0x40, 0xe1, 0x2e, 0x56, 0x6f, 0x12, 0xff, 0x88, 0x93, 0x18, 0xf5, 0xa1,
+		0xf1, 0x6a, 0x38, 0x86, 0xed, 0x6c, 0x19, 0xfd, 0x10, 0xf9, 0x19, 0x51, 0x0d, 0x8e, 0xc2, 0x45,
+		0xb9, 0x61, 0x98, 0xb5, 0xed, 0xfe, 0x28, 0x7c, 0x5a, 0x88, 0x52, 0xe0, 0x20, 0x89, 0x39, 0x18,
+		0xd9, 0xd1, 0xdb, 0xf6, 0xb6, 0xde, 0xec, 0xab, 0x39, 0x7e, 0x96, 0xd3, 0xc8, 0xbb, 0x48, 0x5c,
+		0x22, 0x1d, 0x73, 0x2f, 0x64, 0x7e, 0x4e, 0x48, 0xa4, 0x63, 0x06, 0x08, 0xad, 0xc2, 0xa4, 0xed,
+		0x50, 0x3b, 0x78, 0x2f, 0xd4, 0x7e, 0x5e, 0x74, 0x3d, 0x86, 0xbb, 0xe4, 0xa7, 0xf8, 0x14, 0xe4,
+		0xec, 0xc6, 0x07, 0xfb, 0x22, 0xf3, 0x0b, 0xa2, 0xa5, 0x29, 0x02, 0x22, 0xbf, 0x04, 0x47, 0x62,
+		0xa7, 0x89, 0x3e, 0x88, 0xfd, 0x22, 0x27, 0x76, 0x28, 0x66, 0xaa, 0xe0, 0x43, 0xc2, 0x5e, 0x49,
+		0xfe, 0x92, 0x18, 0x12, 0x8c, 0x10, 0xad, 0x55, 0xf4, 0x07, 0xdb, 0xfa, 0xe6, 0xde, 0xa4, 0xf6,
+		0x8f, 0x85, 0xd4, 0x18, 0x6e, 0x40, 0x6a, 0x6b, 0x70, 0x88, 0x53, 0xdc, 0x5b, 0xbb, 0x7e, 0x46,
+		0x0c, 0xac, 0x0c, 0x7b, 0x3d, 0xd8, 0xba, 0xef, 0x85, 0xa2, 0x2b, 0x4e, 0xe1, 0x78, 0xb4, 0x35,
+		0x0c, 0xdb, 0xe9, 0x4d, 0xf9, 0x9f, 0x70, 0xca, 0x62, 0xc4, 0x77, 0x3d, 0x97, 0xf6, 0x92, 0xde,
+		0x42, 0xe2, 0x2f, 0x42, 0x41, 0x10, 0xef, 0x98, 0x6d, 0xa3, 0x66, 0x6d, 0x99, 0x8d, 0x0f, 0x1a,
+		0xf5, 0x3e, 0x48, 0xff, 0x72, 0xa8, 0xa9, 0xd6, 0x7d, 0xe8, 0x48, 0x79, 0x01, 0x64, 0xd7, 0x56,
+		0xd1, 0x1a, 0x3b, 0x74, 0x93, 0xa6, 0x3b, 0xc5, 0x5f, 0x11, 0x2d, 0xe5, 0xe2, 0x2d, 0x50, 0xb4,
+		0xd9, 0x0a, 0xb0, 0x90, 0xfd, 0x7e, 0x55, 0xf2, 0xb3, 0x9c, 0xd0, 0x88, 0x87, 0xc5, 0x07, 0x8e,
+		0x9a, 0xb5, 0xd3, 0xd2, 0xdb, 0xfd, 0x8c, 0x7f, 0x9f, 0x13, 0x03, 0x07, 0x47, 0xe1, 0x03, 0x07,
+		0x5a, 0x74, 0x38, 0xdb, 0xf7, 0x41, 0xe1, 0x57, 0xc5, 0xc0, 0x21, 0x70, 0x38, 0x09, 0x61, 0x30,
+		0xf4, 0x41, 0xe2, 0x3f, 0x17, 0x24, 0x04, 0x0e, 0x92, 0x78, 0x8f, 0x37, 0xd1, 0xb6, 0x8d, 0xad,
+		0x86, 0xed, 0xf0, 0x43, 0x35, 0xdd, 0x49, 0xfd, 0xda, 0x57, 0x83, 0x46, 0x98, 0xea, 0x43, 0xc5,
+		0x91, 0x88, 0x7b, 0x0b, 0xa9, 0x37, 0xbc, 0x37, 0x63, 0xbf, 0x2e, 0x46, 0x22, 0x1f, 0x1a, 0xf2,
+		0xe6, 0xb3, 0x10, 0x51, 0xec, 0x35, 0x5c, 0xe0, 0xf5, 0x41, 0xee, 0x9f, 0x86, 0x98, 0xab, 0x0a,
+		0x5c, 0xa4, 0xe9, 0xb3, 0x7f, 0x3a, 0xe6, 0x2d, 0x63, 0xb7, 0x2f, 0xed, 0xfc, 0x2f, 0x42, 0xf6,
+		0xcf, 0x3a, 0xc3, 0x64, 0x63, 0xc8, 0x58, 0xc8, 0x9e, 0x22, 0xbd, 0x4e, 0xdc, 0x15, 0xbe, 0xfd,
+		0x6b, 0xbc, 0xbe, 0x41, 0x73, 0x6a, 0x76, 0x11, 0x64, 0x9e, 0xe2, 0x19, 0xb0, 0x3d, 0x89, 0x7d,
+		0xc7, 0xd7, 0x5c, 0x3d, 0x0f, 0xd8, 0x3c, 0xb3, 0x57, 0x61, 0x24, 0x60, 0xf0, 0xf4, 0x26, 0xf5,
+		0x31, 0x4e, 0x2a, 0xef, 0xb7, 0x77, 0x66, 0x2f, 0x42, 0x06, 0x8d, 0x97, 0xde, 0xe8, 0xdf, 0xc9,
+		0xd1, 0x29, 0xf8, 0xec, 0x33, 0x90, 0x15, 0x46, 0x4b, 0x6f, 0xd4, 0xef, 0xe2, 0xa8, 0x2e, 0x0a,
+		0xa2, 0x0b, 0x83, 0xa5, 0x37, 0xfa, 0xdf, 0x16, 0xe8, 0x02, 0x05, 0xd1, 0xfb, 0x17, 0xe1, 0x7f,
+		0xf5, 0x3d, 0x19, 0x86, 0x2e, 0x50, 0x66, 0xf1, 0xc8, 0x00, 0xb3, 0x54, 0x7a, 0x63, 0x7f, 0x37,
+		0x2f, 0x5c, 0x60, 0xcc, 0x5e, 0x86, 0x81, 0x3e, 0x05, 0xfe, 0xbd, 0x1c, 0x95, 0xc1, 0xcf, 0xce,
+		0xc1, 0xb0, 0xcf, 0x3a, 0xe9, 0x8d, 0xfe, 0x7d, 0x1c, 0xdd, 0x8f, 0x85, 0xac, 0x73, 0xeb, 0xa4,
+		0x37, 0x81, 0xef, 0x17, 0xac, 0x73, 0x0c, 0x14, 0x9b, 0x30, 0x4c, 0x7a, 0x63, 0x7f, 0x42, 0x48,
+		0x5d, 0xa0, 0xcc, 0x3e, 0x07, 0x39, 0x77, 0xb2, 0xe9, 0x8d, 0xff, 0x03, 0x1c, 0xdf, 0xc3, 0x41,
+		0x09, 0x74, 0xcc, 0x3d, 0x90, 0xf8, 0xbb, 0x42, 0x02, 0x3e, 0x2c, 0xec, 0x46, 0x61, 0x03, 0xa6,
+		0x37, 0xa5, 0x1f, 0x14, 0xdd, 0x28, 0x64, 0xbf, 0x60, 0x6b, 0xd2, 0x31, 0xbf, 0x37, 0x89, 0x1f,
+		0x12, 0xad, 0x49, 0xe1, 0x91, 0x8d, 0xb0, 0x45, 0xd0, 0x9b, 0xc6, 0xdf, 0x17, 0x6c, 0x84, 0x0c,
+		0x82, 0xd9, 0x55, 0x20, 0x51, 0x6b, 0xa0, 0x37, 0xbd, 0xd7, 0x39, 0xbd, 0xf1, 0x88, 0x31, 0x30,
+		0xfb, 0x02, 0x1c, 0x8a, 0xb7, 0x04, 0x7a, 0x53, 0xfd, 0x91, 0xaf, 0x85, 0xd6, 0x6e, 0x7e, 0x43,
+		0x60, 0x76, 0x0d, 0x26, 0xe3, 0xac, 0x80, 0xde, 0x64, 0x7f, 0xf4, 0x6b, 0xc1, 0x81, 0xdb, 0x6f,
+		0x04, 0xcc, 0x96, 0x00, 0xbc, 0x09, 0xb8, 0x37, 0xad, 0x1f, 0xe7, 0xb4, 0x7c, 0x48, 0xd8, 0x35,
+		0xf8, 0xfc, 0xdb, 0x1b, 0xff, 0xae, 0xe8, 0x1a, 0x1c, 0x03, 0xbb, 0x86, 0x98, 0x7a, 0x7b, 0x63,
+		0x7f, 0x52, 0x74, 0x0d, 0x81, 0x82, 0x9a, 0xed, 0x9b, 0xdd, 0x7a, 0x53, 0xf8, 0x49, 0xa1, 0xd9,
+		0x3e, 0xac, 0xd9, 0x65, 0x18, 0x8f, 0x4c, 0x88, 0xbd, 0x49, 0xfd, 0x14, 0x27, 0x25, 0x87, 0xe7,
+		0x43, 0xff, 0xe4, 0xc5, 0x27, 0xc3, 0xde, 0xd4, 0xfe, 0x41, 0x68, 0xf2, 0xe2, 0x73, 0xe1, 0xec,
+		0x53, 0x90, 0x35, 0x3b, 0xcd, 0x26, 0x76, 0x1e, 0xd2, 0xfd, 0x50, 0x65, 0xe1, 0x8f, 0xbf, 0xce,
+		0xa5, 0x23, 0x10, 0x66, 0x2f, 0xc2, 0x80, 0xb1, 0xb3, 0x61, 0xd4, 0x7b, 0x61, 0xfe, 0xc9, 0xd7,
+		0xc5, 0x80, 0x89, 0xd0, 0xb3, 0xcf, 0x01, 0x30, 0xd7, 0x08, 0x0d, 0x4c, 0xee, 0x81, 0xfb, 0x95,
+		0xaf, 0xf3, 0x53, 0x4c, 0x1e, 0x8a, 0x47, 0x80, 0x9d, 0x89, 0xea, 0x4e, 0xe0, 0xab, 0x41, 0x02,
+		0xb4, 0x45, 0xae, 0xc0, 0x10, 0xee, 0x47, 0x3a, 0xfa, 0x56, 0x2f, 0xec, 0x3f, 0xe5, 0xd8, 0x02,
+		0x1e, 0x05, 0xb6, 0x63, 0xb5, 0x0d, 0x47, 0xdf, 0xb2, 0x7b, 0xe1, 0xfe, 0x6f, 0x1c, 0xd7, 0x45,
+		0x40, 0xe4, 0x9a, 0x6e, 0x3b, 0xfd, 0xd4, 0xfb, 0x3f, 0x08, 0x64, 0x81, 0x80, 0x4c, 0xe3, 0xef,
+		0x5b, 0xc6, 0x6e, 0x2f, 0xdc, 0xff, 0x28, 0x98, 0xe6, 0xf0, 0xb3, 0xcf, 0x40, 0x0e, 0x7f, 0xb2,
+		0xa3, 0x89, 0x3d, 0x90, 0xff, 0x8c, 0x23, 0x7b, 0x18, 0x58, 0xb2, 0xed, 0xd4, 0x9d, 0x46, 0x6f,
+		0x61, 0xff, 0x39, 0x6f, 0x69, 0x01, 0x3f, 0x5b, 0x82, 0x61, 0xdb, 0xa9, 0xd7, 0x3b, 0xdc, 0x3e,
+		0xed, 0x81, 0xfe, 0xbf, 0x7f, 0xdd, 0x75, 0x59, 0xb8, 0x38, 0xd8, 0xda, 0xaf, 0xde, 0x72, 0x5a,
+		0x16, 0x0d, 0x65, 0xe9, 0x45, 0xe1, 0x6b, 0x9c, 0x82, 0x0f, 0x65, 0x76, 0x0e, 0xf2, 0x58, 0x17,
+		0x11, 0x11, 0xd0, 0x8b, 0xc4, 0x5f, 0x70, 0x01, 0x04, 0x90, 0xca, 0xdf, 0xf2, 0xdb, 0x5f, 0x3a,
+		0x2e, 0x7d, 0xe1, 0x4b, 0xc7, 0xa5, 0xff, 0xe9, 0x4b, 0xc7, 0xa5, 0x4f, 0x7c, 0xf9, 0xf8, 0x81,
+		0x2f, 0x7c, 0xf9, 0xf8, 0x81, 0x3f, 0xf8, 0xf2, 0xf1, 0x03, 0xf1, 0x5e, 0x62, 0xb8, 0x66, 0x5d,
+		0xb3, 0x98, 0x7f, 0xf8, 0xe5, 0x47, 0xb6, 0x1a, 0xce, 0x76, 0x67, 0x63, 0xba, 0x66, 0xed, 0x9c,
+		0xab, 0x59, 0xf6, 0x8e, 0x65, 0x9f, 0x0b, 0xfa, 0x75, 0xe9, 0x2f, 0xf8, 0xbf, 0x25, 0x38, 0xc2,
+		0xc8, 0x78, 0xee, 0x5c, 0xdd, 0xdc, 0x4d, 0xba, 0xfa, 0xe6, 0x12, 0xa4, 0x4b, 0xe6, 0x2e, 0x39,
+		0xc2, 0x06, 0x38, 0xad, 0xd3, 0x6e, 0xf2, 0xf3, 0x71, 0x43, 0xf8, 0xbd, 0xde, 0x6e, 0x06, 0x63,
+		0xa3, 0xf3, 0x3c, 0x36, 0xba, 0xfc, 0x7d, 0xd2, 0xde, 0x6a, 0x92, 0x2d, 0x99, 0xbb, 0xb4, 0x22,
+		0xab, 0xd2, 0xcb, 0xef, 0xea, 0xe9, 0xe7, 0xbe, 0x65, 0x5a, 0xaf, 0x9a, 0xc8, 0x76, 0x6b, 0x43,
+		0xf8, 0xb8, 0x8f, 0x87, 0x7d, 0xdc, 0x2f, 0x18, 0xcd, 0xe6, 0xf3, 0x08, 0x87, 0xbb, 0xde, 0xf6,
+		0xc6, 0x20, 0x3b, 0x8a, 0x0d, 0x3f, 0x98, 0x82, 0xe3, 0x11, 0x77, 0x36, 0x57, 0x82, 0x24, 0x21,
+		0xcc, 0x42, 0x76, 0x5e, 0xe8, 0x56, 0x01, 0xaf, 0x9a, 0xa9, 0x59, 0x66, 0x9d, 0x05, 0xb9, 0xa6,
+		0x55, 0xf1, 0x89, 0x82, 0x30, 0x75, 0xd3, 0xb2, 0xf9, 0x09, 0x53, 0xf6, 0x51, 0xfe, 0xb1, 0x3d,
+		0x0a, 0x62, 0x44, 0x94, 0x24, 0xa4, 0x71, 0xbe, 0x4f, 0x69, 0x88, 0x4a, 0x04, 0x3c, 0xff, 0xfd,
+		0x4a, 0xe5, 0xef, 0xa7, 0xe0, 0x44, 0x58, 0x2a, 0xd8, 0xb3, 0x6c, 0x47, 0xdf, 0x69, 0x25, 0x89,
+		0xe5, 0x29, 0xc8, 0xad, 0x09, 0x98, 0x3d, 0xcb, 0xe5, 0xee, 0x1e, 0xe5, 0x32, 0xea, 0x16, 0x25,
+		0x04, 0x33, 0xd3, 0xa7, 0x60, 0xdc, 0x7a, 0xec, 0x4b, 0x32, 0x3f, 0x96, 0x83, 0x23, 0xac, 0x3b,
+		0x69, 0xac, 0x2b, 0xb1, 0x0f, 0x2e, 0x93, 0xbc, 0x3f, 0xab, 0xf7, 0x3e, 0x89, 0xf2, 0x3c, 0x4c,
+		0x2c, 0xe0, 0x68, 0x81, 0xab, 0x20, 0x6f, 0x87, 0x27, 0xf6, 0x10, 0xee, 0x54, 0xc0, 0xe0, 0xe7,
+		0x3b, 0x81, 0xfe, 0x24, 0xe5, 0xdb, 0x25, 0x90, 0xab, 0x35, 0xbd, 0xa9, 0xb7, 0xef, 0x95, 0x14,
+		0xb9, 0x0c, 0xc0, 0x42, 0xa8, 0xdc, 0xeb, 0x73, 0x70, 0x6f, 0xdc, 0x5f, 0xb9, 0x69, 0x56, 0x12,
+		0x3d, 0xa0, 0x92, 0xa3, 0xb0, 0xf8, 0xf3, 0xf4, 0x8b, 0x00, 0x5e, 0x06, 0x06, 0x71, 0x54, 0xe7,
+		0x4a, 0x8b, 0x25, 0x55, 0x44, 0x97, 0x54, 0x57, 0x2b, 0x73, 0xec, 0x36, 0x8b, 0x03, 0x18, 0x21,
+		0xe1, 0xcf, 0x74, 0x83, 0xfa, 0x0f, 0xc2, 0xb8, 0x3f, 0x9d, 0x5d, 0x2d, 0x90, 0x9a, 0xbd, 0x8e,
+		0x9e, 0x02, 0x0c, 0xe3, 0xd4, 0xf4, 0x7a, 0xdd, 0xa8, 0x6b, 0x0d, 0x93, 0xf4, 0x38, 0x46, 0x5c,
+		0xf8, 0x57, 0xbf, 0x3f, 0x40, 0xab, 0x36, 0xc2, 0x10, 0x4b, 0x88, 0xb7, 0x60, 0xa2, 0xcd, 0xd9,
+		0xd8, 0x69, 0x35, 0x0d, 0xba, 0xf7, 0xab, 0x35, 0x84, 0xfc, 0x7b, 0x9b, 0x33, 0x48, 0x0f, 0xf7,
+		0x91, 0x27, 0x3c, 0x74, 0xb7, 0xf5, 0x66, 0x9f, 0xf7, 0x82, 0xac, 0x5c, 0x06, 0x7b, 0x52, 0xfc,
+		0x3c, 0xe7, 0x50, 0x78, 0x33, 0x04, 0x8b, 0x8b, 0x30, 0x8e, 0x67, 0xef, 0x5a, 0x01, 0xfe, 0x7a,
+		0xcc, 0x20, 0xa2, 0xb6, 0x32, 0xc7, 0xf4, 0x58, 0xbb, 0x0c, 0x83, 0x36, 0x6d, 0x94, 0x5e, 0x24,
+		0x04, 0x3b, 0x1c, 0x1c, 0xdd, 0x5f, 0x4c, 0x0d, 0xdc, 0x1a, 0xf5, 0x20, 0xf0, 0xdf, 0x71, 0x02,
+		0x79, 0x8a, 0x26, 0x6a, 0x63, 0xc2, 0x38, 0xbb, 0x3b, 0xc6, 0xf0, 0xd5, 0xa6, 0xbb, 0x17, 0xe5,
+		0x37, 0x7e, 0xe5, 0x71, 0xba, 0xc3, 0xfe, 0x60, 0x50, 0xe9, 0x62, 0x3a, 0x8b, 0x2a, 0x73, 0xda,
+		0x5e, 0x7d, 0x0d, 0x18, 0x15, 0xe5, 0xf1, 0x7a, 0x77, 0x2f, 0xec, 0x9f, 0xf3, 0xc2, 0x8e, 0xc7,
+		0x69, 0xb8, 0xaf, 0xa4, 0x11, 0x4e, 0x95, 0x65, 0xcc, 0x96, 0x61, 0x04, 0x37, 0xe5, 0x3d, 0xe1,
+		0x74, 0x2f, 0xe5, 0xbf, 0xfc, 0x95, 0xc7, 0x59, 0x47, 0x43, 0x24, 0x2e, 0x9a, 0x72, 0x25, 0x69,
+		0xd4, 0x7b, 0xf9, 0x4c, 0x74, 0xfe, 0x66, 0xff, 0xce, 0x52, 0xea, 0x4f, 0xf9, 0x59, 0xf5, 0x46,
+		0xa7, 0x0c, 0x8c, 0x63, 0x58, 0x91, 0x75, 0x8e, 0xfe, 0xe5, 0xa3, 0xd2, 0x00, 0xfd, 0xe8, 0x63,
+		0xdb, 0xf6, 0x12, 0x1b, 0x2c, 0x7a, 0xeb, 0xed, 0x9f, 0xfd, 0x9d, 0x7f, 0x34, 0xe0, 0x0d, 0x28,
+		0xb3, 0x4b, 0xd1, 0x00, 0xc3, 0xde, 0x34, 0xfe, 0x5c, 0xd0, 0x08, 0xc7, 0x0b, 0xce, 0x3e, 0x8d,
+		0x7b, 0xa1, 0x9c, 0x4c, 0x2f, 0xdb, 0x4d, 0x10, 0x71, 0x31, 0xd0, 0x72, 0x63, 0x4a, 0xdb, 0x8f,
+		0x9d, 0xfe, 0x35, 0x81, 0xcf, 0xc6, 0x30, 0x0c, 0xcf, 0x9d, 0xbd, 0x06, 0xa3, 0x75, 0xcb, 0x74,
+		0x34, 0x6b, 0xa7, 0xe1, 0x18, 0x3b, 0x2d, 0xa7, 0xa7, 0xe5, 0xfb, 0x17, 0x8c, 0x48, 0x56, 0x1d,
+		0x41, 0xbc, 0x15, 0x81, 0x86, 0x9c, 0xb0, 0xeb, 0x67, 0xfa, 0xe1, 0xe4, 0xff, 0x70, 0x39, 0xa1,
+		0x38, 0xc8, 0xc9, 0xbe, 0xb4, 0xc3, 0xae, 0xdf, 0xe2, 0xd3, 0x9d, 0x73, 0x87, 0x69, 0x81, 0xab,
+		0x1d, 0xff, 0x4d, 0x06, 0x8e, 0x73, 0xe0, 0x0d, 0xdd, 0x36, 0xce, 0xdd, 0x3e, 0xbf, 0x61, 0x38,
+		0xfa, 0xf9, 0x73, 0x35, 0xab, 0x21, 0x6c, 0x9d, 0x09, 0x96, 0x3f, 0x8d, 0xf9, 0xd3, 0x3c, 0xbf,
+		0x18, 0x1b, 0x10, 0x50, 0x4c, 0x9e, 0x06, 0x8b, 0x51, 0x1d, 0x54, 0x9a, 0x90, 0x99, 0xb3, 0x1a,
+		0xf4, 0xe8, 0x5c, 0xdd, 0x30, 0xad, 0x1d, 0x3e, 0x21, 0xb1, 0x0f, 0x72, 0x1d, 0x06, 0xf5, 0x1d,
+		0xab, 0x63, 0x3a, 0x6c, 0x32, 0x2a, 0x3f, 0xfe, 0xdb, 0x6f, 0x9c, 0x38, 0xf0, 0x87, 0x6f, 0x9c,
+		0x38, 0xc8, 0xc8, 0xda, 0xf5, 0x5b, 0xd3, 0x0d, 0xeb, 0xdc, 0x8e, 0xee, 0x6c, 0xe3, 0x10, 0xf0,
+		0xc5, 0xcf, 0x9e, 0x05, 0x5e, 0xde, 0x82, 0xe9, 0x7c, 0xfa, 0xcd, 0xcf, 0x9c, 0x96, 0x54, 0x8e,
+		0x3f, 0x9b, 0x79, 0xeb, 0x53, 0x27, 0x24, 0xa5, 0x05, 0x43, 0xf3, 0x46, 0xad, 0x4b, 0x81, 0x0b,
+		0xa1, 0x02, 0xcf, 0xf3, 0x02, 0x8f, 0x46, 0x0b, 0x64, 0x47, 0x72, 0xe6, 0x8d, 0x9a, 0xaf, 0xd8,
+		0x79, 0xa3, 0x16, 0x2a, 0x71, 0x01, 0xb2, 0x0b, 0xa6, 0xc3, 0xee, 0xd0, 0x78, 0x06, 0xd2, 0x0d,
+		0x93, 0x1d, 0xbd, 0xce, 0x95, 0xcf, 0xec, 0xa1, 0x2a, 0x2a, 0xe2, 0x29, 0x2b, 0x90, 0x9d, 0x37,
+		0x6a, 0x8c, 0xd4, 0x1c, 0xa4, 0xeb, 0x46, 0xad, 0x20, 0xed, 0x97, 0x49, 0xc4, 0x2e, 0xcf, 0xff,
+		0xc1, 0xff, 0x7c, 0xfc, 0xc0, 0x47, 0xbe, 0x74, 0xfc, 0x40, 0xa2, 0x3a, 0x29, 0xbd, 0xd5, 0xc9,
+		0xd5, 0xa2, 0x9f, 0xa5, 0x5a, 0xb4, 0x63, 0x38, 0x1b, 0x9b, 0xce, 0xb9, 0x5a, 0x7b, 0xb7, 0xe5,
+		0x58, 0xe7, 0x6e, 0x9f, 0xc7, 0x51, 0xc5, 0xda, 0xe4, 0x5a, 0x44, 0x44, 0xfe, 0x34, 0xcb, 0x9f,
+		0xbe, 0x9d, 0xa0, 0x44, 0xca, 0x26, 0x0c, 0xac, 0x22, 0x22, 0x36, 0x93, 0x63, 0x39, 0x7a, 0x93,
+		0x5b, 0x8b, 0xec, 0x03, 0x53, 0xd9, 0xd5, 0x4d, 0x29, 0x96, 0xda, 0x10, 0xb7, 0x36, 0x35, 0x0d,
+		0x7d, 0x93, 0xdd, 0x80, 0x91, 0xa6, 0xcb, 0x8c, 0x2c, 0x26, 0xd0, 0xcb, 0x2e, 0x26, 0x61, 0x40,
+		0xef, 0xb0, 0x40, 0xb1, 0x34, 0xae, 0x3f, 0xe8, 0x87, 0xb2, 0x08, 0x43, 0x3c, 0xee, 0x01, 0x63,
+		0xa3, 0x6e, 0x19, 0xbb, 0xb4, 0x9c, 0xbc, 0x8a, 0x3f, 0xc9, 0x39, 0x18, 0xa0, 0xdc, 0xf3, 0x83,
+		0x47, 0x47, 0xa6, 0xa3, 0xec, 0x4f, 0x53, 0x2e, 0x55, 0x06, 0xa7, 0xdc, 0x80, 0xec, 0xbc, 0x85,
+		0xca, 0x1d, 0x24, 0x97, 0x63, 0xe4, 0x28, 0xd3, 0xad, 0x8e, 0x23, 0x4e, 0x87, 0xd2, 0x0f, 0x3c,
+		0x6b, 0xcd, 0xae, 0x44, 0xe1, 0xd1, 0x6e, 0xfc, 0x4b, 0x99, 0x83, 0x21, 0x4a, 0x7b, 0xa5, 0xe5,
+		0xde, 0x33, 0x26, 0xf9, 0xee, 0x19, 0xe3, 0xe4, 0x53, 0x1e, 0xb7, 0x04, 0x32, 0x75, 0xdd, 0xd1,
+		0x79, 0xc5, 0xe9, 0x6f, 0xe5, 0x39, 0xc8, 0x72, 0x22, 0x36, 0x79, 0x02, 0xd2, 0x56, 0x4b, 0x04,
+		0x73, 0x1e, 0x4d, 0xac, 0xcb, 0x4a, 0xab, 0x9c, 0x41, 0x7d, 0x52, 0x11, 0xba, 0xbc, 0x94, 0xa8,
+		0x1a, 0x4f, 0x04, 0x54, 0x43, 0x34, 0xbb, 0xf8, 0xa1, 0xb7, 0x1a, 0xe7, 0xa2, 0xca, 0xe0, 0xea,
+		0xca, 0xeb, 0x29, 0x78, 0x20, 0x46, 0x57, 0x6e, 0x19, 0xbb, 0xf6, 0x9e, 0x55, 0xe5, 0x36, 0xe4,
+		0x56, 0xe9, 0xcd, 0xa4, 0xcf, 0x1b, 0xbb, 0xa4, 0x88, 0x91, 0x9b, 0x33, 0x17, 0x2f, 0x9e, 0xbf,
+		0xc2, 0x1a, 0xf2, 0xfa, 0x01, 0x55, 0x24, 0x90, 0xe3, 0x90, 0xb3, 0x8d, 0x5a, 0x6b, 0xe6, 0xe2,
+		0xa5, 0x5b, 0xe7, 0x99, 0xe0, 0xae, 0x1f, 0x50, 0xbd, 0x24, 0x72, 0x0c, 0xb2, 0x1b, 0x4d, 0xfb,
+		0xfc, 0xcc, 0x13, 0x4f, 0x9e, 0x67, 0x42, 0xbc, 0x7e, 0x40, 0x75, 0x53, 0x66, 0xb3, 0xd8, 0x9d,
+		0xdf, 0xfa, 0xc9, 0x13, 0x52, 0x79, 0x00, 0xd2, 0x76, 0x67, 0xe7, 0xed, 0x12, 0xcd, 0x3f, 0x1d,
+		0x82, 0x07, 0xdd, 0x6c, 0x36, 0x60, 0xdf, 0x9e, 0x39, 0xc7, 0x8f, 0x08, 0xb8, 0x77, 0xcf, 0x8e,
+		0xbb, 0xe2, 0xa1, 0x20, 0xd3, 0xb7, 0x67, 0x8a, 0xdd, 0x05, 0x9a, 0x20, 0xbc, 0xcf, 0x4a, 0x90,
+		0xbf, 0x29, 0x68, 0xe3, 0x81, 0x91, 0xa7, 0x01, 0xdc, 0xb2, 0x84, 0xb2, 0x1c, 0x9b, 0x8e, 0x94,
+		0x36, 0xed, 0x22, 0xa9, 0x3e, 0x78, 0xf2, 0x24, 0x64, 0x5b, 0x6d, 0xab, 0x65, 0xd9, 0xfc, 0x02,
+		0xa5, 0x5e, 0xb8, 0x2e, 0x34, 0x1e, 0xd0, 0xa1, 0x5d, 0x5b, 0xbb, 0x6d, 0x39, 0x18, 0xd7, 0xd2,
+		0xb2, 0x5e, 0xe5, 0xf7, 0xd2, 0xa5, 0x55, 0x99, 0xe6, 0xdc, 0xa4, 0x19, 0xab, 0x98, 0xae, 0xfc,
+		0x9f, 0x12, 0xe4, 0x5c, 0x2a, 0xb8, 0xa6, 0xd4, 0xeb, 0xf5, 0xb6, 0x61, 0xdb, 0xbc, 0xf7, 0x8a,
+		0x4f, 0x32, 0x0b, 0x43, 0xad, 0xce, 0x86, 0x26, 0x7a, 0x0a, 0x5e, 0xcf, 0x15, 0xa7, 0xf7, 0x42,
+		0x7d, 0xe8, 0xa9, 0xcb, 0xc1, 0x56, 0x67, 0x03, 0x55, 0xe9, 0x41, 0xc8, 0xc7, 0xf0, 0x32, 0x7c,
+		0xdb, 0x63, 0x83, 0xde, 0x96, 0xcb, 0x2b, 0xa0, 0xb5, 0xda, 0x0d, 0xab, 0xdd, 0x70, 0x76, 0x69,
+		0x70, 0x65, 0x5a, 0x95, 0x45, 0xc6, 0x2a, 0x4f, 0x27, 0x0a, 0x8c, 0x70, 0x5e, 0xb4, 0x8d, 0x5d,
+		0x87, 0xc7, 0xf3, 0xe6, 0xd5, 0x61, 0x56, 0x5c, 0x19, 0x93, 0xc8, 0x14, 0xe4, 0x05, 0x8c, 0x7b,
+		0xcd, 0x42, 0x4e, 0x05, 0x06, 0x82, 0x4b, 0x27, 0xa5, 0x09, 0x63, 0x55, 0xba, 0xcc, 0xf0, 0xaa,
+		0x7f, 0xc9, 0xab, 0xa4, 0xd4, 0x47, 0x25, 0x13, 0x2b, 0x98, 0x8a, 0x54, 0xf0, 0xf4, 0xff, 0x20,
+		0xc1, 0x70, 0xb9, 0x69, 0xd5, 0x6e, 0x2d, 0xcc, 0x5f, 0x6d, 0xea, 0x5b, 0xe4, 0x3c, 0x1c, 0x2c,
+		0x2f, 0xae, 0xcc, 0x3d, 0xaf, 0x2d, 0xcc, 0x6b, 0x57, 0x17, 0x4b, 0xbe, 0x70, 0xf9, 0xe2, 0xa1,
+		0xd7, 0xee, 0x4e, 0x11, 0x1f, 0xec, 0xba, 0x49, 0x97, 0xd5, 0xe4, 0x1c, 0x4c, 0x06, 0x51, 0x4a,
+		0xe5, 0x2a, 0x1e, 0x29, 0x93, 0x8a, 0x07, 0x5f, 0xbb, 0x3b, 0x35, 0xee, 0xc3, 0x28, 0x6d, 0xd8,
+		0x86, 0xe9, 0x44, 0x11, 0xe6, 0x56, 0x96, 0x96, 0xf0, 0xcc, 0x42, 0x04, 0x01, 0xe3, 0x74, 0x1b,
+		0x0e, 0x79, 0x0c, 0xc6, 0x83, 0x08, 0xcb, 0x0b, 0x8b, 0x72, 0xba, 0x48, 0x5e, 0xbb, 0x3b, 0x35,
+		0xea, 0x83, 0x5e, 0x6e, 0x34, 0x8b, 0xd9, 0x8f, 0xff, 0x83, 0xe3, 0x07, 0x3e, 0xfd, 0x0f, 0x8f,
+		0x4b, 0xe5, 0xc5, 0xc4, 0xde, 0x3b, 0xd3, 0x7f, 0xef, 0x15, 0xdd, 0xd3, 0xed, 0xbc, 0x9f, 0x4c,
+		0xc1, 0x09, 0x37, 0xf7, 0xb6, 0xd1, 0xc6, 0xfd, 0x0c, 0xec, 0x87, 0x4c, 0xf5, 0x5d, 0x53, 0x8a,
+		0x37, 0x0e, 0x07, 0x48, 0x1e, 0xda, 0x9e, 0x81, 0x74, 0xa9, 0xd5, 0xc2, 0xfb, 0x0e, 0xe9, 0x77,
+		0xcd, 0x62, 0xd3, 0x60, 0x46, 0x75, 0xbf, 0x31, 0xcf, 0xb6, 0x36, 0x9d, 0x57, 0xf5, 0xb6, 0x7b,
+		0x17, 0xa2, 0xf8, 0x56, 0xae, 0x40, 0x6e, 0xce, 0x32, 0x6d, 0xc3, 0xb4, 0x3b, 0xd4, 0xbd, 0xb2,
+		0x81, 0xc2, 0xe0, 0x14, 0xd8, 0x07, 0x4e, 0x23, 0x7a, 0xab, 0x45, 0x31, 0x33, 0x2a, 0xfe, 0x64,
+		0x66, 0x4b, 0x79, 0x39, 0x51, 0x3c, 0x17, 0xfa, 0x17, 0x8f, 0x27, 0x00, 0x57, 0x40, 0x5f, 0x3a,
+		0xee, 0x1b, 0xf8, 0xdd, 0xd1, 0xcd, 0x2f, 0x9e, 0x98, 0x91, 0xad, 0x87, 0x59, 0x51, 0xec, 0x3d,
+		0x5e, 0x16, 0x7b, 0xb5, 0x4a, 0x82, 0x2d, 0xdb, 0xcb, 0xd9, 0xa5, 0x5c, 0x81, 0x11, 0x3c, 0x99,
+		0x57, 0x35, 0x9c, 0xeb, 0x86, 0x5e, 0x37, 0xda, 0x41, 0x7b, 0x65, 0x44, 0xd8, 0x2b, 0x04, 0x32,
+		0xd4, 0x28, 0x61, 0xd3, 0x35, 0xfd, 0xad, 0x34, 0x20, 0x83, 0xa8, 0x9e, 0x2d, 0xc3, 0x31, 0xe8,
+		0x07, 0x6d, 0x2e, 0x3a, 0x4a, 0x30, 0x14, 0xf6, 0x41, 0x2e, 0x0a, 0x8b, 0x24, 0xdd, 0xc3, 0x22,
+		0xe1, 0x73, 0x38, 0xb7, 0x4b, 0x76, 0x60, 0x88, 0x77, 0x04, 0x97, 0x13, 0xc9, 0xe3, 0x84, 0x2c,
+		0xc3, 0x58, 0x4b, 0x6f, 0x3b, 0xf4, 0x8e, 0xa0, 0x6d, 0x5a, 0x0d, 0x3e, 0x5a, 0x4e, 0xc5, 0x0c,
+		0xde, 0x81, 0xea, 0xf2, 0x62, 0x46, 0x5a, 0xfe, 0x44, 0xe5, 0xad, 0x0c, 0x0c, 0x72, 0x71, 0x3c,
+		0x0b, 0x43, 0x5c, 0xe0, 0x7c, 0x6c, 0x3a, 0x3e, 0x1d, 0xa3, 0xfe, 0xd3, 0xae, 0x9a, 0x72, 0x82,
+		0x02, 0x89, 0x3c, 0x0a, 0xd9, 0xda, 0xb6, 0xde, 0x30, 0xb5, 0x46, 0x9d, 0x5b, 0xe4, 0xc3, 0x5f,
+		0x7a, 0xe3, 0xc4, 0xd0, 0x1c, 0xa6, 0x2d, 0xcc, 0xab, 0x43, 0x34, 0x73, 0xa1, 0x8e, 0x56, 0xd4,
+		0xb6, 0xd1, 0xd8, 0xda, 0x76, 0xf8, 0x30, 0xcd, 0xbf, 0xf0, 0xf6, 0x75, 0x6c, 0x32, 0x7e, 0x0a,
+		0xa3, 0x18, 0x59, 0x6a, 0xb9, 0xde, 0xc2, 0x72, 0x16, 0x0b, 0xfe, 0xc4, 0xbf, 0x3f, 0x21, 0xa9,
+		0x14, 0x83, 0xcc, 0xc3, 0x48, 0x53, 0xb7, 0x1d, 0x8d, 0xf6, 0x13, 0x2c, 0x7e, 0x80, 0x93, 0x88,
+		0x8a, 0x84, 0xcb, 0x96, 0xf3, 0x3e, 0x8c, 0x68, 0x2c, 0xa9, 0x8e, 0x17, 0x67, 0x51, 0x2a, 0x35,
+		0x3a, 0x54, 0x31, 0xcb, 0x74, 0x90, 0x8a, 0x7e, 0x14, 0xd3, 0xd9, 0x08, 0x46, 0xed, 0xd3, 0xa3,
+		0x90, 0xa3, 0x77, 0x61, 0x51, 0x10, 0x76, 0x9a, 0x34, 0x8b, 0x09, 0x34, 0xf3, 0x24, 0x8c, 0x79,
+		0xb3, 0x2c, 0x03, 0xc9, 0x32, 0x2a, 0x5e, 0x32, 0x05, 0x7c, 0x1c, 0x26, 0x4d, 0xe3, 0x8e, 0xa3,
+		0x85, 0xa1, 0x73, 0x14, 0x9a, 0x60, 0xde, 0xcd, 0x20, 0xc6, 0x23, 0x30, 0x5a, 0x13, 0xd2, 0x67,
+		0xb0, 0x40, 0x61, 0x47, 0xdc, 0x54, 0x0a, 0x76, 0x04, 0xb2, 0x7a, 0xab, 0xc5, 0x00, 0x86, 0xf9,
+		0x24, 0xdb, 0x6a, 0xd1, 0xac, 0xd3, 0x30, 0x4e, 0xeb, 0xd8, 0x36, 0x6c, 0x3c, 0xe0, 0xc3, 0x60,
+		0xf2, 0x14, 0x66, 0x0c, 0x33, 0x54, 0x96, 0x4e, 0x61, 0x1f, 0x82, 0x11, 0xe3, 0x36, 0x1e, 0xe5,
+		0xad, 0x19, 0x0c, 0x6e, 0x84, 0xc2, 0xe5, 0x45, 0x22, 0x05, 0x7a, 0x0c, 0xdc, 0xd9, 0x53, 0x13,
+		0x13, 0xfb, 0x28, 0xa3, 0x27, 0xd2, 0x4b, 0x2c, 0x59, 0x29, 0x40, 0x66, 0x5e, 0x77, 0x74, 0x1c,
+		0xc7, 0x9c, 0x3b, 0xcc, 0x5e, 0xc9, 0xab, 0xf8, 0x53, 0xf9, 0x54, 0x06, 0x32, 0x37, 0x2d, 0x07,
+		0x2f, 0x1b, 0xcb, 0xf8, 0xae, 0x43, 0x8a, 0x53, 0xe9, 0x6a, 0x63, 0xcb, 0x34, 0xea, 0x4b, 0xf6,
+		0x96, 0xef, 0x02, 0x5a, 0x4f, 0xa1, 0x52, 0x01, 0x85, 0x9a, 0x84, 0x81, 0xb6, 0xd5, 0x31, 0xeb,
+		0xe2, 0x5c, 0x05, 0xfd, 0x20, 0x57, 0x21, 0xeb, 0xea, 0x49, 0xa6, 0xa7, 0x9e, 0x8c, 0xa1, 0x9e,
+		0xa0, 0x1a, 0xf3, 0x04, 0x75, 0x68, 0x83, 0xab, 0x4b, 0x19, 0x72, 0xee, 0x08, 0x53, 0x18, 0xd8,
+		0x83, 0xce, 0x7a, 0x68, 0x68, 0x94, 0xb8, 0xad, 0xef, 0x8a, 0x8f, 0xe9, 0x9c, 0xec, 0x66, 0x70,
+		0xf9, 0x05, 0x14, 0x8b, 0xdf, 0x86, 0x3b, 0x44, 0x2b, 0xe6, 0x29, 0x16, 0xbb, 0x11, 0xf7, 0x18,
+		0xc6, 0x5f, 0x6e, 0x99, 0xf4, 0x08, 0x11, 0xd7, 0x3d, 0x2f, 0x01, 0x73, 0xbd, 0x53, 0xc9, 0x4c,
+		0xd7, 0xbc, 0x04, 0xbc, 0x5b, 0xc6, 0xfd, 0xd0, 0x3c, 0x2a, 0x4c, 0xcf, 0x88, 0x9b, 0x55, 0x75,
+		0xc9, 0x9d, 0x02, 0xd9, 0xb4, 0x4c, 0xad, 0xdd, 0xf2, 0x9d, 0x75, 0x66, 0x4a, 0x37, 0x6a, 0x5a,
+		0xa6, 0xda, 0x72, 0x63, 0x05, 0xc8, 0x53, 0x50, 0x0c, 0x43, 0xfa, 0x4a, 0x60, 0x4a, 0x78, 0x38,
+		0x88, 0xe3, 0x16, 0xa3, 0xfc, 0xd7, 0x12, 0x0c, 0x72, 0x1b, 0xc2, 0x6b, 0x6e, 0x29, 0xbe, 0xb9,
+		0x53, 0x49, 0xcd, 0x9d, 0xbe, 0xa7, 0xe6, 0x06, 0x97, 0x59, 0x9b, 0xdf, 0xad, 0x1a, 0x67, 0x30,
+		0x33, 0x26, 0xab, 0x8d, 0x2d, 0x3e, 0xc4, 0xf8, 0xb0, 0x94, 0x37, 0x24, 0xc8, 0xb9, 0xf9, 0xa4,
+		0x0c, 0x23, 0x82, 0x33, 0x6d, 0xb3, 0xa9, 0x6f, 0x71, 0xad, 0x3f, 0x9e, 0xcc, 0x1e, 0x9a, 0x46,
+		0xea, 0x30, 0xe7, 0x08, 0x3f, 0xe2, 0x15, 0x28, 0x95, 0xa0, 0x40, 0x01, 0x8d, 0x4d, 0xef, 0x4f,
+		0x63, 0x03, 0xba, 0x95, 0x09, 0xe9, 0x96, 0xf2, 0x65, 0x89, 0xdf, 0xeb, 0x5e, 0x37, 0xea, 0xff,
+		0x9f, 0xb6, 0xd6, 0x7b, 0xb9, 0x1a, 0xa3, 0x57, 0x37, 0xd2, 0x6c, 0x0f, 0xc7, 0x90, 0x0c, 0x72,
+		0xed, 0x35, 0x1f, 0x11, 0x64, 0xaa, 0x5e, 0x33, 0xfe, 0x78, 0x1a, 0xc6, 0x23, 0xf0, 0x7f, 0x0d,
+		0x9b, 0x33, 0x38, 0x54, 0x0c, 0xf4, 0x39, 0x54, 0x0c, 0xee, 0x69, 0xa8, 0x18, 0xda, 0xc7, 0x50,
+		0x91, 0xed, 0x3e, 0x54, 0x7c, 0x2e, 0x45, 0x3d, 0x29, 0x2d, 0xcb, 0xd6, 0x9b, 0x7f, 0x25, 0x33,
+		0xca, 0x51, 0xc8, 0xb5, 0xac, 0xa6, 0xc6, 0x72, 0xd8, 0x79, 0xbd, 0x6c, 0xcb, 0x6a, 0xaa, 0x11,
+		0x8d, 0x1e, 0xb8, 0x5f, 0xd3, 0xcd, 0xe0, 0x7d, 0x68, 0xed, 0xa1, 0x70, 0xe7, 0x75, 0x20, 0xcf,
+		0x64, 0xc1, 0xed, 0xc1, 0xf3, 0x28, 0x04, 0xfc, 0x55, 0x90, 0xc2, 0x16, 0xac, 0xcb, 0x37, 0x03,
+		0x55, 0x07, 0xb7, 0x5d, 0x14, 0x66, 0x3d, 0x15, 0x52, 0x89, 0x28, 0xac, 0xc7, 0xa8, 0x1c, 0x50,
+		0x79, 0x5d, 0x02, 0x58, 0x44, 0xe1, 0xd2, 0x1a, 0xa3, 0x29, 0x67, 0x53, 0x26, 0xb4, 0x40, 0xd9,
+		0x27, 0x12, 0x1b, 0x8e, 0x73, 0x90, 0xb7, 0xfd, 0xac, 0xcf, 0xc3, 0x88, 0xd7, 0x8f, 0x6c, 0x43,
+		0xb0, 0x73, 0xa2, 0x9b, 0x83, 0x03, 0xcf, 0xe4, 0xe6, 0x6f, 0xfb, 0xbe, 0x94, 0x7f, 0x21, 0x41,
+		0x8e, 0x72, 0x85, 0xf7, 0x9b, 0x06, 0x1a, 0x52, 0xba, 0x87, 0x86, 0x7c, 0x00, 0x80, 0xd1, 0xc1,
+		0x90, 0x2e, 0xae, 0x5f, 0x39, 0x9a, 0x82, 0x81, 0x5a, 0xe4, 0xb2, 0x2b, 0xf5, 0x74, 0x0f, 0xa9,
+		0xf3, 0x11, 0x4a, 0xc8, 0xfe, 0x30, 0x0c, 0xd1, 0x9b, 0x3a, 0xee, 0xd8, 0xdc, 0xad, 0x81, 0xf7,
+		0x61, 0xaf, 0xdd, 0xb1, 0x95, 0x5b, 0x30, 0xb4, 0x76, 0x87, 0x79, 0x68, 0x8f, 0x42, 0xae, 0x6d,
+		0x59, 0xdc, 0xb6, 0x65, 0xcb, 0x8a, 0x2c, 0x26, 0x50, 0x53, 0x4e, 0x38, 0x25, 0x53, 0x9e, 0x53,
+		0xd2, 0x73, 0xab, 0xa6, 0xfb, 0x73, 0xab, 0xa2, 0x17, 0x62, 0x24, 0xd0, 0xa3, 0xc8, 0xbb, 0xe0,
+		0x70, 0x75, 0xe1, 0xda, 0x72, 0x65, 0x5e, 0x5b, 0xaa, 0x5e, 0x0b, 0x5d, 0x51, 0x50, 0x1c, 0x7b,
+		0xed, 0xee, 0xd4, 0x30, 0x77, 0x3f, 0x24, 0x41, 0xaf, 0xaa, 0x95, 0x9b, 0x2b, 0x6b, 0x15, 0x59,
+		0x62, 0xd0, 0xab, 0x6d, 0xe3, 0xb6, 0xe5, 0xb0, 0x77, 0x86, 0x1e, 0x87, 0x23, 0x31, 0xd0, 0xae,
+		0x13, 0x62, 0xfc, 0xb5, 0xbb, 0x53, 0x23, 0xab, 0x6d, 0x83, 0xa9, 0x1a, 0xc5, 0x98, 0x86, 0x42,
+		0x14, 0x63, 0x65, 0x75, 0xa5, 0x5a, 0x5a, 0x94, 0xa7, 0x8a, 0xf2, 0x6b, 0x77, 0xa7, 0xf2, 0x62,
+		0xec, 0x40, 0xf8, 0xb7, 0xdf, 0x0b, 0x91, 0x89, 0xc6, 0xae, 0xbc, 0xda, 0xd6, 0x5b, 0x2d, 0xa3,
+		0x6d, 0x27, 0x05, 0x69, 0x3c, 0x04, 0xc3, 0xf3, 0xbe, 0xeb, 0x6d, 0xdc, 0x68, 0x1d, 0x89, 0x5e,
+		0x7d, 0xc3, 0x3e, 0x14, 0x05, 0xe0, 0x6a, 0xd3, 0xd2, 0x9d, 0x18, 0x98, 0x94, 0x0f, 0x66, 0xc1,
+		0x74, 0x2e, 0x5d, 0x88, 0x81, 0x49, 0x0b, 0x98, 0x87, 0x60, 0x78, 0x3d, 0x09, 0x28, 0x13, 0x24,
+		0xf4, 0xc4, 0x4c, 0x0c, 0xcc, 0x40, 0x88, 0x50, 0x2c, 0xd0, 0x88, 0x00, 0x7a, 0x10, 0x72, 0x65,
+		0xcb, 0x6a, 0xc6, 0x80, 0x64, 0x7d, 0x74, 0xaa, 0xbe, 0x9b, 0x7b, 0x02, 0x40, 0x39, 0x1f, 0x43,
+		0xd4, 0x4b, 0x17, 0x03, 0xe3, 0xc6, 0x33, 0xed, 0x39, 0x8c, 0xe7, 0x05, 0xde, 0x2e, 0x7b, 0x0d,
+		0xe3, 0x11, 0xed, 0xb9, 0xbf, 0x30, 0x9e, 0xbc, 0x6f, 0xab, 0xc6, 0xf5, 0x99, 0xb4, 0xf4, 0xb6,
+		0xbe, 0xd3, 0xc5, 0x0d, 0x13, 0xef, 0x22, 0xe9, 0x11, 0x25, 0x55, 0xec, 0xa1, 0x89, 0xca, 0xaf,
+		0xa7, 0x61, 0xcc, 0x5d, 0xfe, 0xaf, 0x52, 0x16, 0xc8, 0x05, 0xbf, 0xaf, 0x6a, 0x38, 0xd9, 0xdc,
+		0x61, 0xe0, 0xc2, 0x97, 0xf5, 0x0c, 0x64, 0xc5, 0x32, 0x92, 0x8f, 0xcd, 0x0f, 0xc6, 0x19, 0x65,
+		0x1c, 0x84, 0xe3, 0xba, 0x28, 0xe4, 0xdd, 0x90, 0x73, 0x47, 0x6a, 0x3e, 0x34, 0x29, 0xdd, 0xc6,
+		0x76, 0x4e, 0xc0, 0x43, 0x42, 0x6f, 0xb3, 0x70, 0x76, 0x64, 0x12, 0xfd, 0x27, 0x37, 0x19, 0x04,
+		0xc7, 0x16, 0x08, 0xe4, 0x22, 0x64, 0xf4, 0x8d, 0x5a, 0xa3, 0x30, 0x10, 0xf6, 0xe0, 0xba, 0x88,
+		0xa5, 0xf2, 0xdc, 0x02, 0xc3, 0xa2, 0x6e, 0x6a, 0x0a, 0x8e, 0x4c, 0xdb, 0xbb, 0x66, 0x6d, 0xbb,
+		0x6d, 0x99, 0xbb, 0x85, 0xc1, 0x44, 0xa6, 0xab, 0x02, 0x46, 0x30, 0xed, 0x22, 0x21, 0xd3, 0x9b,
+		0x86, 0x37, 0x7b, 0xc7, 0x33, 0xcd, 0x6f, 0x94, 0x10, 0x4c, 0x73, 0x04, 0x65, 0x81, 0x7b, 0x87,
+		0x79, 0xb3, 0xd1, 0xbb, 0x9b, 0xef, 0x70, 0xef, 0x36, 0xeb, 0xf0, 0xd9, 0x1d, 0xfd, 0x0e, 0x73,
+		0x6d, 0x1f, 0x86, 0x21, 0xcc, 0xdc, 0xe2, 0x97, 0x28, 0xa6, 0xd5, 0xc1, 0x1d, 0xfd, 0xce, 0x35,
+		0x1d, 0x2f, 0xa9, 0x48, 0xcb, 0x19, 0xe5, 0x67, 0xd1, 0xca, 0x0f, 0x34, 0x0d, 0x39, 0x03, 0x04,
+		0x31, 0x30, 0x7a, 0x00, 0x27, 0x21, 0xda, 0xc8, 0x82, 0x2e, 0xde, 0xff, 0x51, 0xda, 0x32, 0x96,
+		0x3b, 0x3b, 0x94, 0x01, 0x9b, 0x60, 0xa8, 0x01, 0x07, 0x16, 0x0a, 0xe8, 0xda, 0x0b, 0x91, 0xa7,
+		0xa7, 0x38, 0x00, 0x33, 0x68, 0x5e, 0x47, 0x83, 0x66, 0x94, 0xd1, 0x13, 0x39, 0xc1, 0xaa, 0xa4,
+		0x83, 0x55, 0x51, 0x9e, 0x83, 0xb1, 0x90, 0x16, 0xf8, 0x9d, 0xfb, 0x54, 0x68, 0xd4, 0x13, 0x91,
+		0x13, 0xce, 0x7d, 0xda, 0xfb, 0x66, 0xb3, 0xbf, 0x86, 0x3b, 0x48, 0xb8, 0x29, 0x7c, 0x06, 0x46,
+		0x02, 0x6a, 0x20, 0xdc, 0xb0, 0x92, 0xe7, 0x86, 0xf5, 0x80, 0x5f, 0x86, 0x3c, 0x4e, 0xa5, 0x46,
+		0x9d, 0xc3, 0x3e, 0x0a, 0x63, 0x6c, 0xae, 0x0f, 0xcb, 0x9a, 0x2d, 0x15, 0x96, 0x84, 0xc0, 0x15,
+		0x18, 0xf1, 0xe0, 0x3c, 0xb1, 0x0f, 0x0b, 0xa8, 0x6b, 0xba, 0xad, 0xfc, 0xb0, 0x04, 0x63, 0x21,
+		0xdd, 0x20, 0xcf, 0x40, 0xae, 0xd5, 0x36, 0x6a, 0x0d, 0x9f, 0xd3, 0xae, 0x8b, 0x08, 0x33, 0x54,
+		0x7c, 0x1e, 0x06, 0x9a, 0x49, 0x22, 0xe6, 0xa3, 0x6e, 0x34, 0xf5, 0xdd, 0x42, 0xaa, 0x3f, 0x12,
+		0xe2, 0x85, 0xc0, 0x79, 0x44, 0x52, 0x7e, 0x4b, 0x82, 0x91, 0x80, 0xd2, 0x91, 0x3a, 0x3c, 0x80,
+		0x53, 0xb4, 0xff, 0x9c, 0x01, 0xbf, 0x39, 0xdf, 0xb7, 0x14, 0x8c, 0xbb, 0x1e, 0xc5, 0x9b, 0x68,
+		0xa8, 0x71, 0x23, 0xa9, 0x45, 0xa4, 0xe3, 0x1d, 0x37, 0x60, 0x57, 0xec, 0x5f, 0x67, 0xc6, 0xf8,
+		0x0a, 0x90, 0xd6, 0x86, 0x13, 0x26, 0x9d, 0xea, 0x97, 0xb4, 0x8c, 0xc8, 0x7e, 0x82, 0x4a, 0x15,
+		0xc0, 0xeb, 0xb8, 0xa4, 0xd4, 0x4f, 0x25, 0xd2, 0xdd, 0x38, 0x9c, 0x4d, 0x15, 0xa4, 0xf2, 0xea,
+		0xa7, 0xbf, 0x74, 0x5c, 0x7a, 0x5b, 0x4c, 0x87, 0x4f, 0xbc, 0x08, 0xc7, 0x3c, 0xd0, 0x8d, 0x5a,
+		0x23, 0xec, 0x9e, 0x97, 0x45, 0xee, 0x34, 0xe6, 0xf6, 0xe3, 0x9d, 0xef, 0x31, 0xd3, 0xf4, 0xe3,
+		0xbd, 0xdf, 0x9f, 0x73, 0xbe, 0xd7, 0xd4, 0xa4, 0xfc, 0x6e, 0x0e, 0x86, 0x54, 0xe3, 0x03, 0x1d,
+		0xc3, 0x76, 0xc8, 0x13, 0x90, 0x31, 0x6a, 0xdb, 0x56, 0x74, 0x07, 0x8d, 0x57, 0x73, 0xba, 0x52,
+		0xdb, 0xb6, 0x38, 0xf0, 0xf5, 0x03, 0x2a, 0x05, 0x26, 0x97, 0x60, 0x60, 0xb3, 0xd9, 0xe1, 0x7e,
+		0xfd, 0xc0, 0x3c, 0x25, 0xb0, 0xae, 0x62, 0xb6, 0x87, 0xc6, 0xc0, 0xb1, 0x30, 0xfa, 0x76, 0x63,
+		0x3a, 0xa9, 0x30, 0xfa, 0x64, 0xa3, 0x57, 0x18, 0x02, 0x93, 0x39, 0x80, 0x86, 0xd9, 0x70, 0x34,
+		0xea, 0xf2, 0x2e, 0x0c, 0x84, 0xc7, 0x7a, 0x0f, 0xb5, 0xe1, 0x50, 0xf7, 0xb8, 0x87, 0x9f, 0x6b,
+		0x88, 0x34, 0xe4, 0xf8, 0x03, 0x1d, 0xa3, 0x2d, 0xe6, 0x8a, 0x18, 0x8e, 0xdf, 0x83, 0xd9, 0x3e,
+		0x8e, 0x29, 0x38, 0xce, 0xad, 0xec, 0x79, 0x10, 0xe7, 0x4e, 0x21, 0x1b, 0x9e, 0x26, 0x04, 0x2a,
+		0x7d, 0x1d, 0x64, 0xed, 0x8e, 0x87, 0x3c, 0x54, 0x63, 0x29, 0xe4, 0x8a, 0xbb, 0x86, 0x1b, 0x0e,
+		0x2f, 0x9a, 0x5c, 0x64, 0x9a, 0xef, 0xe1, 0x72, 0x04, 0xb2, 0x02, 0xa3, 0xcd, 0x86, 0xed, 0x68,
+		0xb6, 0xa9, 0xb7, 0xec, 0x6d, 0xcb, 0x11, 0x37, 0xf4, 0x3f, 0x1a, 0x25, 0xb1, 0xd8, 0xb0, 0x9d,
+		0xaa, 0x00, 0xf3, 0x28, 0x8d, 0x34, 0xfd, 0xe9, 0x48, 0xd0, 0xda, 0xdc, 0x34, 0xda, 0x2e, 0xc5,
+		0xc2, 0x48, 0x12, 0xc1, 0x15, 0x84, 0x13, 0x98, 0x3e, 0x82, 0x96, 0x3f, 0x9d, 0xbc, 0x0f, 0x26,
+		0x9a, 0x96, 0x5e, 0x77, 0xe9, 0x69, 0xb5, 0xed, 0x8e, 0x79, 0x8b, 0x7a, 0xac, 0x87, 0x67, 0x4e,
+		0xc7, 0xb0, 0x69, 0xe9, 0x75, 0x81, 0x3c, 0x87, 0xa0, 0x1e, 0xe5, 0xf1, 0x66, 0x38, 0x8f, 0x68,
+		0x30, 0xa9, 0xb7, 0x5a, 0xcd, 0xdd, 0x30, 0xf9, 0x31, 0x4a, 0xfe, 0x4c, 0x94, 0x7c, 0x09, 0xa1,
+		0x13, 0xe8, 0x13, 0x3d, 0x92, 0x49, 0xd6, 0xd1, 0xdb, 0x6e, 0xd0, 0x43, 0xc8, 0x2d, 0xbe, 0x4a,
+		0xa1, 0x57, 0xe0, 0xe3, 0x1d, 0x50, 0x11, 0xe2, 0xab, 0x0c, 0x52, 0x2c, 0x67, 0x3c, 0xca, 0x63,
+		0xad, 0x60, 0x0e, 0x23, 0x6b, 0xd5, 0x0c, 0xfa, 0x60, 0x08, 0x27, 0x3b, 0x9e, 0x4c, 0x96, 0x42,
+		0xc6, 0x92, 0x0d, 0xe4, 0x90, 0xab, 0x30, 0xcc, 0xbc, 0x67, 0x18, 0x28, 0x60, 0xd0, 0x97, 0x58,
+		0x86, 0x67, 0x1e, 0x8a, 0xe9, 0xae, 0x14, 0x08, 0x37, 0x00, 0x3c, 0x62, 0x60, 0xb8, 0x89, 0x64,
+		0x03, 0x0e, 0xd2, 0x67, 0x04, 0x76, 0xb5, 0xe0, 0x80, 0x5c, 0x98, 0xe0, 0x57, 0xff, 0x46, 0x28,
+		0xd2, 0x87, 0xf9, 0x76, 0x6f, 0xfa, 0x47, 0x66, 0x8f, 0xf4, 0xc4, 0xed, 0x68, 0x2e, 0x6a, 0xda,
+		0x66, 0xc3, 0xd4, 0x9b, 0xf8, 0x46, 0x0e, 0xb3, 0x67, 0x27, 0x93, 0x34, 0xed, 0x2a, 0x87, 0xa3,
+		0xd6, 0x8c, 0x4f, 0xd3, 0x36, 0xfd, 0xe9, 0xe5, 0x21, 0xbe, 0xe6, 0x70, 0x2f, 0xd8, 0x1e, 0x92,
+		0xb3, 0xec, 0x19, 0x88, 0x1b, 0x99, 0x2c, 0xc8, 0xc3, 0xca, 0x49, 0x18, 0xf6, 0x8d, 0x53, 0x18,
+		0x18, 0xc1, 0x67, 0x55, 0x71, 0x1a, 0x83, 0x7f, 0x2a, 0xa3, 0x90, 0xf7, 0x0f, 0x4d, 0xca, 0x27,
+		0x24, 0x18, 0xf6, 0x0d, 0x3a, 0x88, 0xe9, 0xdf, 0xb7, 0xcb, 0x79, 0x86, 0xea, 0x43, 0xc2, 0xac,
+		0x10, 0xf9, 0x6c, 0xef, 0x38, 0x4f, 0x13, 0xb9, 0x55, 0x83, 0xd7, 0x53, 0xb5, 0x66, 0x5a, 0x2e,
+		0x48, 0x9a, 0x82, 0x40, 0x6b, 0xa6, 0x25, 0x00, 0x1e, 0x84, 0x3c, 0x56, 0x5d, 0xf3, 0xdb, 0xcb,
+		0x39, 0x75, 0x18, 0xd3, 0x38, 0x88, 0xf2, 0x3b, 0x29, 0x90, 0xc3, 0x83, 0x99, 0xbb, 0x9f, 0x27,
+		0xed, 0x79, 0x3f, 0xef, 0x48, 0x78, 0x27, 0xd1, 0xdb, 0x3c, 0x5c, 0x02, 0xd9, 0xdb, 0x02, 0x63,
+		0x73, 0x53, 0x97, 0x05, 0x40, 0x68, 0xb1, 0xa2, 0x8e, 0xd5, 0x82, 0x09, 0xe4, 0x5a, 0x20, 0x84,
+		0x26, 0xe3, 0xc6, 0x2b, 0x87, 0xf5, 0x49, 0xc0, 0xac, 0xb7, 0xea, 0xba, 0x63, 0x08, 0xd7, 0xbe,
+		0x87, 0x8a, 0x96, 0x1e, 0xee, 0xb9, 0xd9, 0x8e, 0xee, 0x18, 0x81, 0x98, 0x91, 0x11, 0xbd, 0xd5,
+		0xa2, 0x6f, 0x43, 0x32, 0x4b, 0xef, 0x11, 0x18, 0xc5, 0x11, 0xbe, 0xa1, 0x37, 0x85, 0x19, 0x31,
+		0xc8, 0x0c, 0x42, 0x9e, 0xca, 0x4d, 0x91, 0x3a, 0xe4, 0xfd, 0x83, 0xbb, 0xeb, 0x9b, 0x91, 0x7c,
+		0xbe, 0x19, 0xc2, 0xef, 0x1b, 0x63, 0x12, 0x12, 0x37, 0xb6, 0xc5, 0xef, 0xad, 0x4e, 0x52, 0x3f,
+		0xce, 0x6d, 0xe6, 0xe3, 0xcd, 0xaa, 0xec, 0x43, 0x79, 0x09, 0x46, 0x83, 0xf3, 0x00, 0x19, 0x85,
+		0x94, 0x73, 0x87, 0x97, 0x92, 0x72, 0xee, 0x90, 0xf3, 0xbe, 0x67, 0x33, 0x47, 0xe3, 0x66, 0x3f,
+		0x8e, 0xef, 0xf9, 0x4e, 0xd9, 0x15, 0x76, 0xca, 0x18, 0x8c, 0x04, 0x66, 0x09, 0xe5, 0x10, 0x4c,
+		0xc6, 0x8d, 0xf9, 0x4a, 0x03, 0x26, 0xe3, 0x86, 0x6e, 0x72, 0x09, 0xb2, 0xee, 0xa0, 0x1f, 0x71,
+		0xb7, 0x89, 0xd2, 0x5d, 0x24, 0x17, 0x36, 0xb0, 0xf9, 0x99, 0x0a, 0x6c, 0x7e, 0x2a, 0xdf, 0x02,
+		0x85, 0xa4, 0xf1, 0x3c, 0xb4, 0x4d, 0x91, 0x71, 0x05, 0x77, 0x08, 0x06, 0xf9, 0x8d, 0xa7, 0x29,
+		0xea, 0xa7, 0xe0, 0x5f, 0x28, 0x50, 0x36, 0xb6, 0xa7, 0x69, 0x32, 0xfb, 0x50, 0x34, 0x38, 0x92,
+		0x38, 0xa4, 0x27, 0x07, 0x0f, 0x30, 0x42, 0x3c, 0x78, 0x80, 0x7e, 0x60, 0xb1, 0x36, 0xee, 0x2f,
+		0xb4, 0x45, 0xa4, 0x21, 0xfb, 0xc2, 0xfb, 0x9c, 0x0f, 0xc5, 0x8f, 0xeb, 0x18, 0x8f, 0x84, 0xab,
+		0x07, 0x27, 0xb8, 0xd0, 0x80, 0x1d, 0xfd, 0xce, 0x1a, 0x5f, 0x65, 0xf0, 0x8d, 0xd7, 0x94, 0xbb,
+		0xf1, 0x4a, 0x6e, 0xc2, 0x78, 0xd3, 0xaa, 0xe9, 0x4d, 0xcd, 0xb7, 0xf1, 0xcd, 0xbb, 0xd3, 0xc3,
+		0x49, 0xe3, 0xb4, 0xd8, 0xf3, 0xc0, 0x21, 0x88, 0x77, 0x84, 0x31, 0x4a, 0x64, 0xd1, 0xdd, 0x24,
+		0x27, 0x15, 0x18, 0xde, 0x69, 0xd8, 0x1b, 0xc6, 0xb6, 0x7e, 0xbb, 0x61, 0xb5, 0x79, 0xbf, 0x8a,
+		0xd1, 0x9e, 0x25, 0x0f, 0x48, 0xec, 0xc8, 0xfb, 0xf0, 0x7c, 0x8d, 0x32, 0x10, 0x1b, 0x29, 0x30,
+		0xb8, 0xe7, 0x91, 0x25, 0x69, 0xcf, 0x7d, 0x28, 0x71, 0xcf, 0x3d, 0x6e, 0x83, 0x3b, 0x1b, 0xbf,
+		0xc1, 0xfd, 0x1a, 0x6d, 0x9c, 0xb8, 0xd9, 0x31, 0xba, 0xe7, 0x4d, 0xd6, 0x60, 0x92, 0xe3, 0xd7,
+		0x03, 0xd2, 0x8f, 0x84, 0xe2, 0x05, 0x8d, 0x2e, 0x9f, 0xd4, 0x89, 0xc0, 0x4f, 0x16, 0x7c, 0x7a,
+		0x9f, 0x82, 0x17, 0x91, 0x27, 0x19, 0x5f, 0xe4, 0xc9, 0x37, 0x59, 0x63, 0x7c, 0xa7, 0xbb, 0x49,
+		0xe7, 0x33, 0x2c, 0x62, 0x43, 0x6a, 0x92, 0x36, 0x7b, 0x44, 0xc5, 0xd2, 0x7b, 0xae, 0x18, 0x6f,
+		0xed, 0x4c, 0xef, 0xd6, 0x1e, 0xb8, 0x9f, 0xad, 0x3d, 0xb8, 0xcf, 0xd6, 0x7e, 0x5b, 0xdb, 0xe1,
+		0x77, 0x25, 0x28, 0x26, 0x9b, 0x63, 0xb1, 0x0d, 0xb2, 0xa7, 0x5d, 0xd0, 0xa4, 0x19, 0xef, 0x11,
+		0x18, 0x0d, 0x59, 0x8b, 0x4c, 0x99, 0x47, 0x02, 0xeb, 0x75, 0x8c, 0x92, 0xe4, 0x3b, 0x8d, 0x21,
+		0xe8, 0x01, 0x5e, 0x79, 0xdc, 0x64, 0x0c, 0x70, 0xae, 0xfc, 0x72, 0x1a, 0xdf, 0x6d, 0x8e, 0xda,
+		0x80, 0x31, 0x9d, 0x5c, 0xc5, 0x97, 0x7a, 0x6a, 0x8d, 0xfa, 0xbe, 0xfb, 0xf8, 0x38, 0x47, 0xff,
+		0xff, 0xbb, 0x78, 0x54, 0xb5, 0x30, 0x98, 0x09, 0x7d, 0xa3, 0x18, 0x15, 0xeb, 0x58, 0xc2, 0x9c,
+		0xca, 0x31, 0x9f, 0x23, 0xcf, 0x58, 0xb3, 0xb8, 0x41, 0xf5, 0xd3, 0x00, 0x59, 0xd5, 0xb0, 0x5b,
+		0x68, 0xff, 0x91, 0x39, 0xdc, 0xd7, 0xae, 0x19, 0x2d, 0xc7, 0x73, 0x9b, 0xc5, 0x2e, 0x4b, 0x38,
+		0x88, 0xc0, 0xc3, 0xe5, 0xb9, 0x8b, 0x87, 0x3b, 0xcb, 0xd4, 0x0b, 0x91, 0xe8, 0x4f, 0x60, 0xd6,
+		0xbd, 0x8b, 0x4a, 0xa1, 0xc9, 0x65, 0xe1, 0x86, 0x48, 0x27, 0x2d, 0xae, 0xb9, 0xad, 0xef, 0xe2,
+		0x31, 0x78, 0x2c, 0x8e, 0xfa, 0x21, 0x32, 0x49, 0xc5, 0xb1, 0x25, 0x81, 0x57, 0x5c, 0x83, 0x5d,
+		0x16, 0xec, 0x77, 0x44, 0x0c, 0x26, 0x55, 0xd5, 0x67, 0xbb, 0x7b, 0x55, 0xf5, 0x3c, 0x11, 0x97,
+		0x85, 0x27, 0x62, 0x28, 0x89, 0x69, 0x6e, 0xac, 0x7a, 0x4c, 0x53, 0x78, 0xf2, 0xac, 0xcf, 0x15,
+		0x91, 0x0b, 0xbb, 0xf9, 0x23, 0xae, 0x08, 0x17, 0xdb, 0xf5, 0x45, 0xcc, 0xba, 0xbe, 0x88, 0x7c,
+		0xa2, 0x23, 0x83, 0x5b, 0x99, 0x2e, 0x32, 0xc7, 0x20, 0xab, 0x11, 0x67, 0x04, 0xf3, 0x1d, 0x9c,
+		0xec, 0xe9, 0x8c, 0x70, 0x49, 0x85, 0xbc, 0x11, 0xab, 0x11, 0x6f, 0xc4, 0x68, 0x12, 0xc5, 0x90,
+		0x49, 0xeb, 0x51, 0x0c, 0xba, 0x23, 0xde, 0x1f, 0xef, 0x8e, 0x48, 0xf4, 0x17, 0xc4, 0x98, 0xaf,
+		0x2e, 0xe9, 0x18, 0x7f, 0xc4, 0xb7, 0x24, 0xf8, 0x23, 0xe4, 0xa4, 0x75, 0x73, 0x9c, 0xf1, 0xea,
+		0x16, 0x10, 0xe7, 0x90, 0xb8, 0x19, 0xe3, 0x90, 0x60, 0x9e, 0x83, 0xc7, 0xfa, 0x70, 0x48, 0xb8,
+		0xa4, 0x23, 0x1e, 0x89, 0x9b, 0x31, 0x1e, 0x09, 0x92, 0x4c, 0x37, 0x64, 0x73, 0xf9, 0xe9, 0x06,
+		0xb2, 0xc8, 0xb5, 0xa0, 0x4b, 0x62, 0xa2, 0xbb, 0xa9, 0xcb, 0x2c, 0x07, 0x97, 0x9a, 0xdf, 0x27,
+		0x51, 0x4b, 0xf2, 0x49, 0x30, 0xb7, 0xc1, 0xd9, 0x3e, 0x7d, 0x12, 0x2e, 0xed, 0x58, 0xa7, 0xc4,
+		0x6a, 0xc4, 0x29, 0x71, 0x30, 0x49, 0xe1, 0x42, 0x13, 0x92, 0xa7, 0x70, 0x89, 0x5e, 0x09, 0xf6,
+		0x44, 0x25, 0x7b, 0x9c, 0x12, 0xe4, 0xe1, 0x1b, 0x99, 0xec, 0xb0, 0x9c, 0x57, 0x1e, 0x43, 0xab,
+		0x29, 0x34, 0xee, 0xe1, 0x1a, 0xc5, 0x68, 0xb7, 0xad, 0xb6, 0xd8, 0x63, 0xa5, 0x1f, 0xca, 0x29,
+		0xc8, 0xfb, 0x87, 0xb8, 0x2e, 0x1e, 0x8c, 0x31, 0x18, 0x09, 0x8c, 0x6a, 0xca, 0xd7, 0x53, 0x90,
+		0xf7, 0x8f, 0x57, 0x81, 0xf5, 0x6d, 0x8e, 0xaf, 0x6f, 0x7d, 0x7e, 0x8d, 0x54, 0xd0, 0xaf, 0x81,
+		0x0f, 0x7d, 0xb5, 0x22, 0x2e, 0x0b, 0xbd, 0xe5, 0xba, 0x2c, 0x44, 0x98, 0x2b, 0xf3, 0x7e, 0xf0,
+		0x99, 0x81, 0x45, 0x45, 0x8c, 0xb9, 0x21, 0xbf, 0x7c, 0x1b, 0xe1, 0x2c, 0x4c, 0xf8, 0x60, 0xdd,
+		0xb5, 0x23, 0x9b, 0xff, 0x65, 0x17, 0xba, 0xc4, 0x23, 0x68, 0xdf, 0x0b, 0x63, 0x4d, 0xdd, 0x34,
+		0xc4, 0x19, 0x92, 0x86, 0x61, 0x73, 0xbb, 0x6b, 0xa6, 0xfb, 0x90, 0x3c, 0xbd, 0xa8, 0x9b, 0xc6,
+		0xaa, 0x8b, 0x44, 0x1f, 0x49, 0xc5, 0xc0, 0x62, 0x7f, 0x22, 0x7d, 0xcc, 0x86, 0xdd, 0x6b, 0xaf,
+		0x61, 0x4e, 0x61, 0x48, 0x1c, 0xeb, 0xa7, 0x69, 0x48, 0xa1, 0x58, 0x82, 0x89, 0x18, 0x4a, 0xf1,
+		0x47, 0xd8, 0xbc, 0x4b, 0x3c, 0xc4, 0x06, 0xfb, 0x6c, 0xea, 0x49, 0x09, 0x37, 0x6c, 0xc6, 0x23,
+		0x23, 0x7e, 0xac, 0x67, 0x45, 0xba, 0x5f, 0x9e, 0x95, 0xd4, 0xfe, 0x3d, 0x2b, 0xfe, 0x05, 0x7d,
+		0x3a, 0xb8, 0xa0, 0xff, 0x4b, 0x09, 0x46, 0x02, 0x33, 0x0f, 0xea, 0x51, 0xcd, 0xaa, 0x8b, 0xa0,
+		0x02, 0xfa, 0x1b, 0x45, 0xd3, 0xb4, 0xc4, 0xf3, 0x88, 0xf8, 0x13, 0xa1, 0xdc, 0xb9, 0x34, 0xc7,
+		0x67, 0x4a, 0x77, 0x75, 0x3e, 0xe0, 0x3f, 0xa6, 0xc8, 0xc5, 0x3a, 0xe8, 0x1d, 0xdd, 0x73, 0xc5,
+		0x3a, 0xe4, 0x8b, 0x25, 0x20, 0x57, 0x70, 0x23, 0xce, 0xb2, 0x36, 0x35, 0xab, 0xc5, 0xcc, 0x95,
+		0x80, 0x79, 0x17, 0x39, 0xb6, 0x67, 0xd3, 0x53, 0x21, 0xf4, 0x97, 0xcf, 0xe8, 0xca, 0x05, 0x8c,
+		0xae, 0x63, 0x90, 0x43, 0xf6, 0xd9, 0x5b, 0xcb, 0xc0, 0x6f, 0xd6, 0x11, 0x09, 0xca, 0xbf, 0x48,
+		0xc1, 0x58, 0x68, 0xe2, 0x8c, 0xad, 0x7c, 0x5c, 0x50, 0x4f, 0x7f, 0x02, 0x39, 0x0e, 0xb0, 0xa5,
+		0xdb, 0xda, 0xab, 0xba, 0xe9, 0x18, 0x75, 0x2e, 0x15, 0x5f, 0x0a, 0x9e, 0x66, 0xc1, 0xaf, 0x8e,
+		0x6d, 0xd4, 0xb9, 0x0f, 0xcb, 0xfd, 0xc6, 0xa3, 0xb9, 0xc6, 0x6d, 0x7a, 0x6b, 0x3f, 0x7b, 0x1e,
+		0xeb, 0x70, 0xcc, 0x08, 0x8b, 0xf9, 0xe5, 0x02, 0x36, 0xf7, 0x9f, 0xbc, 0x71, 0x42, 0x66, 0xe0,
+		0xef, 0x72, 0xcf, 0x6b, 0xab, 0x9c, 0x40, 0x50, 0x0c, 0xd9, 0x90, 0x18, 0x70, 0xa7, 0x9a, 0xf6,
+		0xc6, 0x46, 0x9d, 0x5a, 0x08, 0x39, 0x75, 0x10, 0x3f, 0x17, 0xea, 0xd4, 0xd3, 0x9a, 0x17, 0x6e,
+		0x13, 0x94, 0x36, 0x3b, 0xd9, 0x85, 0xf7, 0x48, 0xec, 0xb4, 0x2c, 0xab, 0xa9, 0xb1, 0x31, 0xac,
+		0x04, 0xa3, 0x41, 0x03, 0x02, 0x7d, 0xa6, 0x6d, 0xc3, 0x41, 0xe7, 0x63, 0x60, 0x59, 0x91, 0x67,
+		0x89, 0x6c, 0xcc, 0xf0, 0x3f, 0xd6, 0xa0, 0xbc, 0x07, 0x0e, 0xc6, 0xda, 0x0f, 0xe4, 0x49, 0xc8,
+		0x79, 0xb6, 0x07, 0x3b, 0x9d, 0xd7, 0xcd, 0x85, 0xe5, 0x01, 0xe3, 0x13, 0x2d, 0xb1, 0x06, 0x04,
+		0x79, 0x06, 0x06, 0x59, 0xe4, 0x3e, 0x8f, 0x67, 0x7c, 0xa4, 0xb7, 0xe5, 0x81, 0x0f, 0x7a, 0x70,
+		0x24, 0xe5, 0x3c, 0x1c, 0x49, 0xb4, 0x20, 0x3c, 0x47, 0x94, 0xe4, 0x73, 0x44, 0x29, 0xbf, 0x24,
+		0x41, 0x31, 0xd9, 0x2a, 0x20, 0xe5, 0x10, 0x43, 0xa7, 0xfb, 0xb4, 0x29, 0x7c, 0x5c, 0xe1, 0x4a,
+		0xad, 0x6d, 0x6c, 0x1a, 0x4e, 0x6d, 0x9b, 0x99, 0x27, 0x6c, 0xb4, 0x18, 0x51, 0x47, 0x78, 0x2a,
+		0xc5, 0xb1, 0x19, 0xd8, 0x2b, 0x46, 0xcd, 0xd1, 0x58, 0xa3, 0xda, 0x74, 0xe9, 0x93, 0x53, 0x47,
+		0x58, 0x6a, 0x95, 0x25, 0x2a, 0x67, 0xe0, 0x70, 0x82, 0x9d, 0x11, 0x73, 0xf0, 0xe0, 0x65, 0x04,
+		0x8e, 0x35, 0x1e, 0xc8, 0x73, 0x30, 0x68, 0x3b, 0xba, 0xd3, 0xb1, 0x79, 0xcd, 0x4e, 0xf6, 0xb4,
+		0x3b, 0xaa, 0x14, 0x5c, 0xe5, 0x68, 0x8a, 0x01, 0x24, 0x6a, 0x45, 0xc4, 0x2c, 0x4b, 0xa5, 0xb8,
+		0x65, 0x69, 0x5c, 0xa8, 0x6c, 0x2a, 0x2e, 0x54, 0x56, 0xd9, 0x80, 0xa3, 0x5d, 0x2c, 0x0b, 0x32,
+		0x17, 0xaa, 0xc6, 0x99, 0xbe, 0x0c, 0x93, 0x50, 0x55, 0x7e, 0x23, 0x0d, 0x07, 0x63, 0x0d, 0x0c,
+		0x5f, 0x47, 0x97, 0xee, 0xb5, 0xa3, 0x3f, 0x03, 0xe0, 0xdc, 0x11, 0x07, 0x53, 0xf8, 0x84, 0x11,
+		0xb7, 0xaa, 0xba, 0x63, 0xd4, 0xd6, 0xee, 0x70, 0x15, 0xca, 0x39, 0xfc, 0x17, 0x7a, 0x58, 0x7c,
+		0x4e, 0x83, 0x0e, 0x9d, 0x4c, 0xec, 0x42, 0x7a, 0x6f, 0xd3, 0x8e, 0x7c, 0x3b, 0x98, 0x6c, 0x93,
+		0x97, 0xe1, 0x70, 0x68, 0x52, 0x74, 0x69, 0x67, 0xfa, 0x9e, 0x1b, 0x0f, 0x06, 0xe7, 0x46, 0x41,
+		0xdb, 0x3f, 0xb1, 0x0d, 0x04, 0x8f, 0xe9, 0x2c, 0x81, 0x4c, 0x97, 0xcd, 0xcc, 0x26, 0x61, 0xb1,
+		0x19, 0x83, 0x7b, 0x88, 0x90, 0x41, 0x64, 0xda, 0x50, 0x2c, 0x42, 0xe3, 0x65, 0x00, 0xcf, 0xb3,
+		0xe0, 0x05, 0x31, 0x4b, 0xfe, 0x20, 0xe6, 0x4b, 0x30, 0x80, 0x2a, 0x28, 0x24, 0x1f, 0x33, 0x52,
+		0xa1, 0x86, 0xf8, 0x5c, 0x13, 0x0c, 0x5c, 0x79, 0x05, 0x48, 0xd4, 0x2f, 0x9c, 0x50, 0xc6, 0xb3,
+		0xc1, 0x32, 0x94, 0x64, 0x17, 0x73, 0x7c, 0x59, 0x1f, 0x82, 0x01, 0xaa, 0x4d, 0xb1, 0xa7, 0xec,
+		0xff, 0x16, 0x80, 0xee, 0x38, 0xed, 0xc6, 0x46, 0xc7, 0x2b, 0x61, 0x2a, 0x41, 0x1d, 0x4b, 0x02,
+		0xb0, 0x7c, 0x8c, 0xeb, 0xe5, 0xa4, 0x87, 0xeb, 0xd3, 0x4d, 0x1f, 0x45, 0x65, 0x19, 0x46, 0x83,
+		0xb8, 0xbd, 0x6c, 0x2e, 0x11, 0x8c, 0xe8, 0x99, 0x16, 0xfc, 0x32, 0x43, 0xfa, 0xa1, 0x7c, 0x7b,
+		0x0a, 0xf2, 0x7e, 0x65, 0xfe, 0x1b, 0x38, 0x7d, 0x2b, 0xdf, 0x25, 0x41, 0xd6, 0xad, 0x7f, 0x97,
+		0x93, 0x22, 0xde, 0x05, 0x12, 0xee, 0xbe, 0x09, 0xdb, 0xa9, 0x4a, 0xbb, 0x3b, 0x55, 0x4f, 0xbb,
+		0x33, 0x51, 0xa2, 0x87, 0xc4, 0x2f, 0x6d, 0x11, 0x3c, 0xcd, 0x67, 0xc6, 0xa7, 0xfa, 0x3b, 0xa3,
+		0x8e, 0xdb, 0x68, 0xbe, 0x03, 0xe6, 0xec, 0x43, 0xb1, 0x7d, 0x31, 0x66, 0xac, 0x73, 0x7b, 0x80,
+		0x29, 0x1f, 0x60, 0xf4, 0x58, 0x79, 0xba, 0xf7, 0xb1, 0xf2, 0x4c, 0xf8, 0x58, 0x39, 0x33, 0x3e,
+		0x94, 0xbf, 0x27, 0x41, 0x56, 0xf4, 0x12, 0xf2, 0x9c, 0x3f, 0x1e, 0x52, 0x04, 0x57, 0x25, 0x0f,
+		0x7a, 0xbc, 0xf2, 0x1e, 0x4e, 0xf4, 0xf0, 0x4a, 0x7a, 0xcf, 0x87, 0x57, 0xb8, 0x39, 0xf4, 0xbf,
+		0xe0, 0x03, 0xc2, 0xa1, 0x5e, 0x7c, 0xef, 0xfc, 0x45, 0xa7, 0xcd, 0x74, 0xdc, 0xb4, 0x99, 0x70,
+		0x24, 0x25, 0x93, 0x78, 0x24, 0x25, 0x52, 0xef, 0x81, 0x3d, 0xd7, 0x3b, 0xd9, 0x85, 0x3c, 0x98,
+		0xe4, 0x42, 0xee, 0x71, 0xbe, 0x65, 0xa8, 0xeb, 0xf9, 0x16, 0x2e, 0xe7, 0xef, 0x4c, 0xc1, 0xb0,
+		0xcf, 0xc5, 0x8b, 0x41, 0xa9, 0xbe, 0x83, 0x2e, 0x0f, 0x76, 0xf5, 0x07, 0xfb, 0x4e, 0xba, 0x04,
+		0x5a, 0x26, 0xb5, 0x8f, 0x96, 0xb9, 0xff, 0xa7, 0x79, 0xe3, 0xaf, 0x97, 0x18, 0x48, 0xb8, 0x5e,
+		0xe2, 0xa3, 0x12, 0x64, 0x5d, 0x7f, 0xda, 0x5e, 0x77, 0x71, 0x0f, 0xc1, 0x20, 0x37, 0x39, 0xd9,
+		0x36, 0x2e, 0xff, 0x8a, 0x75, 0x8e, 0x17, 0x21, 0xbb, 0x63, 0x38, 0x3a, 0x1d, 0x8e, 0xd9, 0x74,
+		0xed, 0x7e, 0x9f, 0xde, 0x80, 0x61, 0xdf, 0x46, 0x38, 0x39, 0x02, 0x07, 0xe7, 0xae, 0x57, 0xe6,
+		0x9e, 0xd7, 0xd6, 0x5e, 0x0c, 0xbf, 0xc9, 0x18, 0xc9, 0x52, 0x2b, 0xf4, 0x9b, 0xbd, 0x60, 0x15,
+		0xcc, 0x62, 0x19, 0xa9, 0x62, 0x06, 0xcf, 0x20, 0x9c, 0xfe, 0x0b, 0x09, 0x26, 0x62, 0x8c, 0x7b,
+		0xf2, 0x20, 0x3c, 0xb0, 0x72, 0xf5, 0x6a, 0x45, 0xd5, 0xaa, 0xcb, 0xa5, 0xd5, 0xea, 0xf5, 0x95,
+		0x35, 0x4d, 0xad, 0x54, 0xd7, 0x17, 0xfd, 0x6f, 0x1a, 0x4e, 0xc1, 0xb1, 0x78, 0x90, 0xd2, 0xdc,
+		0x5c, 0x65, 0x75, 0x8d, 0xbd, 0x6d, 0x9f, 0x00, 0x51, 0xa6, 0x6f, 0x1e, 0x26, 0x93, 0x50, 0x2b,
+		0x37, 0x2a, 0x73, 0x6b, 0x72, 0x9a, 0x9c, 0x84, 0x87, 0xba, 0x41, 0xf0, 0x97, 0x16, 0xe5, 0x4c,
+		0x4f, 0xc0, 0x6a, 0x65, 0x79, 0xbe, 0xa2, 0xca, 0x03, 0xbc, 0xde, 0x9f, 0x4a, 0x41, 0x21, 0x69,
+		0x0d, 0x81, 0xb4, 0x4a, 0xab, 0xab, 0x8b, 0x2f, 0x79, 0xb4, 0xe6, 0xae, 0xaf, 0x2f, 0x3f, 0x1f,
+		0x15, 0xc1, 0xa3, 0xa0, 0x74, 0x03, 0x74, 0x05, 0xf1, 0x08, 0x3c, 0xd8, 0x15, 0x8e, 0x8b, 0xa3,
+		0x07, 0x98, 0x5a, 0xc1, 0x87, 0xf9, 0xd3, 0x64, 0x1a, 0x4e, 0xf7, 0x04, 0x73, 0xf3, 0xe4, 0x0c,
+		0x39, 0x07, 0x67, 0xba, 0xc3, 0x33, 0x01, 0x09, 0x04, 0x21, 0xa2, 0xd7, 0x24, 0x38, 0x18, 0xbb,
+		0x18, 0xc1, 0x37, 0x3d, 0x57, 0xd5, 0x95, 0xb9, 0x4a, 0xb5, 0xea, 0x1e, 0x73, 0xd1, 0xaa, 0x6b,
+		0xa5, 0xb5, 0xf5, 0xaa, 0x4f, 0x36, 0x0a, 0x1c, 0x4f, 0x02, 0x72, 0xe5, 0xd2, 0x05, 0x86, 0x6b,
+		0x80, 0xd0, 0xd3, 0xbb, 0x12, 0x1c, 0x49, 0x5c, 0x52, 0x90, 0x53, 0xf0, 0x30, 0x7b, 0xf6, 0x53,
+		0xc3, 0x33, 0x3d, 0x5a, 0xe5, 0xc5, 0xb5, 0xca, 0x72, 0x15, 0x9f, 0x4f, 0x8b, 0x70, 0x75, 0x12,
+		0x1e, 0xea, 0x0a, 0xe9, 0xb2, 0xd6, 0x0b, 0x30, 0xc4, 0xdf, 0xc7, 0x24, 0x18, 0x0b, 0x8d, 0x85,
+		0xf4, 0x75, 0xd1, 0x85, 0x6a, 0xb9, 0x72, 0xbd, 0x74, 0x73, 0x61, 0x45, 0x0d, 0xf7, 0xd9, 0x87,
+		0xe0, 0x44, 0x24, 0x77, 0x7e, 0x1d, 0x9f, 0x67, 0x2d, 0xad, 0x55, 0x34, 0x76, 0x36, 0x09, 0x2b,
+		0x16, 0x01, 0x5a, 0x5c, 0xb8, 0x76, 0x7d, 0x4d, 0x9b, 0x5b, 0x5c, 0xa8, 0x2c, 0xaf, 0x69, 0xa5,
+		0xb5, 0xb5, 0x92, 0xd7, 0x9d, 0xcb, 0xcf, 0x27, 0xc6, 0x04, 0x9f, 0xef, 0x3f, 0x26, 0x98, 0x47,
+		0xfd, 0xba, 0x21, 0xc1, 0x3f, 0x7c, 0x19, 0x1e, 0xe6, 0x77, 0x7f, 0xd9, 0x8e, 0x7e, 0xab, 0x61,
+		0x6e, 0xb9, 0x17, 0xc4, 0xf1, 0x6f, 0x1e, 0x1a, 0x7c, 0x88, 0x41, 0x4d, 0x8b, 0xd4, 0x1e, 0xd7,
+		0xc4, 0x25, 0xde, 0x2e, 0xdc, 0xf3, 0x48, 0x49, 0xcf, 0xc0, 0xdf, 0x2e, 0x57, 0xd0, 0xf5, 0xb8,
+		0xe8, 0x2e, 0xe6, 0x8a, 0xba, 0x62, 0xf7, 0x0b, 0x4b, 0x8a, 0x5d, 0xe3, 0xa5, 0x95, 0x1f, 0x92,
+		0x60, 0xf4, 0x7a, 0xc3, 0x76, 0xac, 0x76, 0xa3, 0xa6, 0x37, 0xa9, 0xe1, 0xf2, 0x74, 0xdf, 0x67,
+		0x20, 0xcb, 0x39, 0x9c, 0xc6, 0xf8, 0x45, 0x76, 0xdb, 0xe2, 0x18, 0xe2, 0xe0, 0x6d, 0xbd, 0xc9,
+		0xce, 0x1f, 0xfa, 0x6f, 0xc2, 0x0c, 0x8b, 0xdd, 0x37, 0xbf, 0xfa, 0xa9, 0x30, 0x5c, 0xe5, 0x87,
+		0xd1, 0xd5, 0x87, 0x4b, 0x2b, 0x9b, 0xae, 0xec, 0xe9, 0xda, 0xf1, 0x06, 0x64, 0xda, 0xba, 0xc3,
+		0x17, 0x40, 0xe5, 0x4b, 0x7b, 0xbe, 0x54, 0x8e, 0x95, 0x40, 0x69, 0x90, 0xf7, 0x00, 0x1e, 0x97,
+		0xd0, 0x28, 0xbd, 0xd4, 0x3d, 0xd1, 0xc3, 0x53, 0x23, 0xc8, 0x1f, 0xf9, 0x5b, 0xf4, 0xd1, 0x57,
+		0xdc, 0x75, 0x34, 0xb7, 0x0c, 0x46, 0x39, 0x7d, 0x4f, 0x94, 0x47, 0x76, 0xf4, 0x3b, 0x73, 0x94,
+		0x1a, 0xd2, 0xe7, 0xf7, 0xf5, 0xfd, 0xa6, 0x04, 0xe0, 0x09, 0x86, 0xe8, 0xe8, 0xc0, 0x16, 0x5f,
+		0xb4, 0x50, 0xe1, 0xc0, 0x3e, 0x99, 0x24, 0xf7, 0x90, 0x58, 0xcb, 0x23, 0xc8, 0xde, 0x17, 0xde,
+		0x38, 0x21, 0xb1, 0x52, 0xc7, 0x6a, 0x11, 0xb1, 0x0f, 0xb3, 0xe5, 0xbf, 0x46, 0x6d, 0x9b, 0x54,
+		0x4f, 0xdb, 0x66, 0x44, 0xd8, 0x36, 0x8c, 0x20, 0x30, 0x6c, 0xcc, 0xe7, 0x75, 0xf8, 0xb4, 0x04,
+		0xc3, 0xf3, 0xbe, 0x5b, 0x7b, 0x71, 0x13, 0xc5, 0x32, 0x1b, 0xb7, 0x8c, 0xb6, 0xbb, 0x89, 0xc2,
+		0x3e, 0xd1, 0xfe, 0x60, 0xef, 0xea, 0x3b, 0xbb, 0xe2, 0xf6, 0x20, 0xf1, 0x8d, 0x58, 0xaf, 0x1a,
+		0x1b, 0x76, 0x43, 0xc8, 0x59, 0x15, 0x9f, 0xb8, 0x9d, 0x6e, 0x1b, 0xb5, 0x0e, 0xfa, 0x3e, 0xb5,
+		0x9a, 0x65, 0x3a, 0x7a, 0xcd, 0xe1, 0x4b, 0x8a, 0x31, 0x91, 0x3e, 0xc7, 0x92, 0x91, 0x48, 0xdd,
+		0x70, 0xf4, 0x46, 0x93, 0x85, 0x2e, 0xe6, 0x54, 0xf1, 0xc9, 0x59, 0xbd, 0x3b, 0xe4, 0x5f, 0x24,
+		0xcd, 0x81, 0x6c, 0xb5, 0x8c, 0x76, 0x20, 0x46, 0x83, 0x69, 0x63, 0xe1, 0x8b, 0x9f, 0x3d, 0x3b,
+		0xc9, 0x05, 0xce, 0xb7, 0xea, 0xd9, 0x81, 0x3d, 0x75, 0x4c, 0x60, 0xf0, 0x64, 0xf2, 0x52, 0x60,
+		0xcf, 0xa1, 0xb3, 0xe1, 0x5d, 0xfe, 0x35, 0x19, 0x11, 0x6a, 0xc9, 0xdc, 0x2d, 0x17, 0x3e, 0xef,
+		0x91, 0xe6, 0x4e, 0xf5, 0x55, 0xba, 0x30, 0xf2, 0xef, 0x3f, 0x50, 0x32, 0x68, 0xda, 0xbd, 0xa2,
+		0x37, 0x9a, 0x46, 0x9d, 0xaf, 0xba, 0xf9, 0x17, 0x6e, 0x29, 0x73, 0x87, 0x18, 0x7b, 0xc9, 0x58,
+		0x49, 0xd2, 0x8d, 0xb2, 0x65, 0xd6, 0x83, 0x7e, 0x30, 0x74, 0xa6, 0x39, 0xd6, 0x2d, 0xc3, 0xe4,
+		0x02, 0xda, 0xdb, 0xbd, 0x90, 0x1c, 0x95, 0xbc, 0x0f, 0xe4, 0xba, 0xd1, 0x34, 0xb6, 0xa8, 0xe4,
+		0xec, 0x6d, 0xbd, 0x6d, 0xb0, 0x2b, 0x3f, 0xf6, 0x75, 0x37, 0xe4, 0x98, 0x4b, 0xaa, 0x4a, 0x29,
+		0x91, 0xd5, 0xe0, 0xbd, 0xd0, 0x43, 0xee, 0x8e, 0x7f, 0x6c, 0x1d, 0x7d, 0x9a, 0xe7, 0x1f, 0x79,
+		0xfc, 0x24, 0x50, 0x83, 0x3a, 0xe6, 0x86, 0x65, 0xd2, 0x67, 0x79, 0xb9, 0x75, 0x9d, 0x65, 0x5b,
+		0x69, 0x6e, 0x3a, 0xdf, 0x4a, 0x5b, 0x85, 0x51, 0x0f, 0x94, 0xf6, 0x90, 0xdc, 0x5e, 0x7b, 0xc8,
+		0x88, 0x4b, 0x00, 0x41, 0xf0, 0xc5, 0x5e, 0xaf, 0x0f, 0xd2, 0x5d, 0x90, 0xe1, 0x19, 0xa5, 0x77,
+		0x6f, 0xf6, 0x57, 0xc6, 0x47, 0x80, 0x98, 0x30, 0xb1, 0xd3, 0x30, 0x35, 0xdb, 0x68, 0x6e, 0x6a,
+		0x5c, 0x72, 0xe2, 0xbe, 0x92, 0x5c, 0xf9, 0xd9, 0x3d, 0xb4, 0xe6, 0x1f, 0x7e, 0xf6, 0xec, 0x98,
+		0x77, 0xbb, 0xe6, 0xd4, 0xe3, 0xd3, 0x17, 0x2e, 0xa9, 0xe3, 0x3b, 0x0d, 0xb3, 0x6a, 0x34, 0x37,
+		0xe7, 0x5d, 0xc2, 0xe4, 0x69, 0x38, 0xea, 0x09, 0xc4, 0x32, 0xb5, 0x6d, 0xab, 0x59, 0xd7, 0xda,
+		0xc6, 0xa6, 0x56, 0xa3, 0xf7, 0x96, 0xe6, 0xa9, 0x18, 0x0f, 0xbb, 0x20, 0x2b, 0xe6, 0x75, 0xab,
+		0x59, 0x57, 0x8d, 0xcd, 0x39, 0xcc, 0xc6, 0xad, 0x08, 0x0f, 0xbb, 0x51, 0xc7, 0x00, 0x86, 0x34,
+		0x86, 0x6f, 0xbb, 0x89, 0x0b, 0x75, 0x7b, 0x36, 0xfb, 0xf1, 0x4f, 0x9d, 0x38, 0xf0, 0xd6, 0xa7,
+		0x4e, 0x1c, 0x50, 0xae, 0xd2, 0xeb, 0x01, 0x79, 0xd7, 0x32, 0x6c, 0x72, 0x09, 0x72, 0xba, 0xf8,
+		0x60, 0x67, 0xdc, 0xba, 0x74, 0x4d, 0x0f, 0x14, 0x8f, 0xf7, 0x0d, 0xce, 0xdf, 0x5c, 0xd5, 0x1b,
+		0x6d, 0x52, 0xc1, 0x5b, 0x9f, 0x85, 0xae, 0xf6, 0xdb, 0xcb, 0x3d, 0xf5, 0x16, 0xdd, 0x7c, 0x39,
+		0x29, 0xa0, 0x2b, 0x57, 0x7e, 0xf0, 0x8b, 0x9f, 0x3d, 0xfb, 0x00, 0x27, 0x73, 0x33, 0x14, 0xdb,
+		0x25, 0xe8, 0x85, 0x63, 0xbe, 0x7c, 0x75, 0xbe, 0x01, 0x43, 0x8c, 0x55, 0x9b, 0x3c, 0x07, 0x03,
+		0x2d, 0xfc, 0xc1, 0x3d, 0xd1, 0xc7, 0x13, 0x75, 0x9e, 0xc2, 0xfb, 0x35, 0x84, 0xe1, 0x29, 0xdf,
+		0x93, 0x02, 0x98, 0xbf, 0x79, 0x73, 0xad, 0xdd, 0x68, 0x35, 0x0d, 0xe7, 0x7e, 0xd5, 0x7d, 0x1d,
+		0x0e, 0x7a, 0x75, 0xb7, 0xdb, 0xb5, 0xbd, 0xd7, 0x7f, 0xc2, 0xc5, 0xaf, 0xb6, 0x6b, 0xb1, 0x64,
+		0xeb, 0xb6, 0xe3, 0x92, 0x4d, 0xef, 0x9d, 0xec, 0xbc, 0xed, 0x44, 0x25, 0xfb, 0x22, 0x0c, 0x7b,
+		0xc2, 0xb0, 0xc9, 0x02, 0x64, 0x1d, 0xfe, 0x9b, 0x0b, 0x58, 0x49, 0x16, 0xb0, 0x40, 0xf3, 0x0b,
+		0xd9, 0x45, 0xc7, 0x5d, 0x5b, 0xf0, 0xf5, 0x91, 0x77, 0xa6, 0x8e, 0xa1, 0x0f, 0x94, 0x0f, 0xce,
+		0xe9, 0x7d, 0xdf, 0x2e, 0xcc, 0x08, 0xf8, 0x84, 0xfa, 0xbd, 0x29, 0x98, 0x58, 0x17, 0xbd, 0xf7,
+		0x9d, 0x2f, 0x83, 0x75, 0x18, 0x32, 0x4c, 0xa7, 0xdd, 0x70, 0x77, 0x52, 0x1e, 0x4f, 0x6a, 0xf3,
+		0x98, 0x4a, 0xd1, 0xb8, 0x04, 0xbf, 0x06, 0x08, 0x5a, 0x3e, 0x79, 0xfc, 0x58, 0x1a, 0x0a, 0x49,
+		0xa8, 0x78, 0xdf, 0x55, 0xad, 0x6d, 0xd0, 0x84, 0xe0, 0x91, 0xcb, 0x51, 0x91, 0xcc, 0xa7, 0x1d,
+		0x15, 0xd0, 0x50, 0x43, 0xe5, 0x42, 0xd0, 0xfd, 0x59, 0x66, 0xa3, 0x1e, 0x05, 0x3a, 0xf1, 0xac,
+		0xc1, 0x98, 0x38, 0xa7, 0xb1, 0xa1, 0x37, 0x75, 0x3c, 0xa7, 0x9e, 0xde, 0xfb, 0x9c, 0x2f, 0xce,
+		0x7a, 0x94, 0x19, 0x09, 0x52, 0x81, 0x21, 0x41, 0x2d, 0xb3, 0x77, 0x6a, 0x02, 0x17, 0xc3, 0x44,
+		0xfc, 0x13, 0x03, 0xb5, 0x46, 0x32, 0xea, 0xb0, 0x9b, 0xb6, 0x50, 0xef, 0x35, 0xf3, 0x0c, 0x76,
+		0x9d, 0x79, 0xb8, 0xc1, 0xf7, 0x13, 0x69, 0x18, 0x57, 0x8d, 0xfa, 0x5f, 0xff, 0x66, 0x59, 0x05,
+		0x60, 0x5d, 0x15, 0x47, 0xd2, 0x42, 0x66, 0xbf, 0xfd, 0x3d, 0xc7, 0x88, 0xcc, 0xdb, 0xce, 0x5f,
+		0x55, 0x0b, 0xfd, 0xbb, 0x14, 0xe4, 0xfd, 0x2d, 0xf4, 0x37, 0x72, 0xd2, 0x22, 0xcb, 0xde, 0x30,
+		0xc5, 0x4e, 0x9a, 0x3c, 0x96, 0x34, 0x4c, 0x45, 0xb4, 0xb9, 0xc7, 0xf8, 0xf4, 0x8f, 0x07, 0x61,
+		0x90, 0xc7, 0x33, 0xad, 0x44, 0x6c, 0xdb, 0x9e, 0xe7, 0xed, 0x47, 0xc4, 0x86, 0x6c, 0xac, 0x69,
+		0xfb, 0x08, 0xe0, 0x45, 0x06, 0x5a, 0x20, 0x48, 0x0a, 0x7d, 0xd0, 0xb8, 0xd4, 0x75, 0x6b, 0x6f,
+		0x63, 0xac, 0x1b, 0x82, 0x79, 0xe3, 0x30, 0xc2, 0xe0, 0xa9, 0x9e, 0x0a, 0x4b, 0x21, 0x67, 0x81,
+		0x6c, 0xbb, 0x4e, 0x0b, 0xcd, 0x13, 0x04, 0xc2, 0x8d, 0x7b, 0x39, 0x02, 0x1c, 0xaf, 0x1f, 0xb2,
+		0xcc, 0xba, 0xc6, 0x2e, 0xd4, 0xe7, 0xcf, 0x15, 0x60, 0xca, 0x3c, 0x26, 0x90, 0x8f, 0x49, 0xcc,
+		0x44, 0x0e, 0xad, 0xa4, 0xf9, 0x0a, 0x65, 0xad, 0x8f, 0x4e, 0xf1, 0xe7, 0x6f, 0x9c, 0x28, 0xee,
+		0xea, 0x3b, 0xcd, 0x59, 0x25, 0x86, 0x8e, 0x12, 0xb7, 0xb8, 0x47, 0xc3, 0x39, 0xb8, 0x12, 0x27,
+		0x1f, 0xf6, 0x6b, 0x0a, 0xe5, 0x77, 0x53, 0xaf, 0xe1, 0x16, 0x05, 0x8d, 0x88, 0x2b, 0x2f, 0xec,
+		0xb9, 0x73, 0x46, 0x0c, 0xf6, 0x8b, 0x17, 0x58, 0xe1, 0x9e, 0x46, 0xe1, 0x82, 0xef, 0x2a, 0x2d,
+		0x05, 0xa5, 0x70, 0x64, 0xab, 0x69, 0x6d, 0xe0, 0xc9, 0xa8, 0xc6, 0x07, 0x3a, 0x8d, 0xba, 0xc6,
+		0x35, 0x49, 0xab, 0xe9, 0xad, 0x42, 0xf6, 0x7e, 0xf3, 0x70, 0x88, 0x95, 0xb5, 0x48, 0x8b, 0xaa,
+		0xb2, 0x92, 0xe6, 0xf4, 0x16, 0x79, 0x4d, 0x82, 0x63, 0x9e, 0x18, 0x62, 0x38, 0xc9, 0xdd, 0x6f,
+		0x4e, 0x8e, 0xb8, 0xc5, 0x85, 0x99, 0x99, 0x7d, 0x18, 0x47, 0x9c, 0xd7, 0xde, 0xfc, 0xcc, 0xe9,
+		0xa3, 0x1e, 0xe6, 0xb9, 0x3b, 0xae, 0x9f, 0x91, 0x75, 0x13, 0xe5, 0xf7, 0x25, 0x98, 0x58, 0xc3,
+		0x85, 0x6e, 0xe3, 0x83, 0x06, 0x5d, 0x92, 0xaa, 0x46, 0xcd, 0x6a, 0xd7, 0x71, 0xbb, 0x96, 0xdf,
+		0x9b, 0x95, 0x51, 0x53, 0x8d, 0x3a, 0x99, 0x86, 0x01, 0xeb, 0x55, 0x93, 0x6f, 0x90, 0x76, 0x1b,
+		0x9c, 0x18, 0x18, 0xed, 0x2d, 0x56, 0xbd, 0xd3, 0x34, 0x34, 0xbd, 0xc6, 0x06, 0xc8, 0x34, 0x7f,
+		0x17, 0x88, 0xa6, 0x96, 0x58, 0x62, 0x70, 0x3b, 0x2b, 0xd3, 0xef, 0xa8, 0xe2, 0xe1, 0xcc, 0x1e,
+		0xc6, 0x5a, 0x7e, 0x31, 0x2a, 0x1e, 0xe5, 0x37, 0x53, 0x30, 0xc6, 0x64, 0xe2, 0x12, 0x21, 0x8b,
+		0x89, 0x3e, 0x90, 0x3e, 0x0a, 0x8d, 0x38, 0x43, 0xde, 0x0b, 0x23, 0xa2, 0x89, 0x99, 0xe1, 0x79,
+		0x6f, 0xce, 0xb8, 0x3c, 0x23, 0xc6, 0xfd, 0x02, 0xaf, 0x44, 0x3a, 0x54, 0xc0, 0xba, 0xdd, 0x6f,
+		0x21, 0xc1, 0xde, 0xc3, 0xca, 0x4a, 0x96, 0xe1, 0xcf, 0x4a, 0x40, 0x3c, 0x2b, 0xcf, 0x17, 0x79,
+		0x0a, 0xbe, 0xc5, 0xb8, 0xd4, 0x7d, 0x91, 0xef, 0xe1, 0x07, 0x16, 0xf9, 0xbe, 0x39, 0xf0, 0x59,
+		0xcf, 0xc8, 0xf2, 0x6e, 0xa1, 0x8b, 0xbc, 0x88, 0x32, 0x8d, 0x2f, 0x8b, 0x04, 0x86, 0x7f, 0x8e,
+		0x44, 0xa7, 0xd6, 0x03, 0xca, 0xbf, 0x91, 0xe0, 0x48, 0x64, 0xba, 0x70, 0x59, 0xae, 0x01, 0x69,
+		0xfb, 0x32, 0xe9, 0xb0, 0x2b, 0xae, 0x74, 0xdf, 0xdf, 0xec, 0x33, 0xde, 0x0e, 0xe7, 0xde, 0x27,
+		0x6b, 0x91, 0x9b, 0x0a, 0xff, 0x4a, 0x82, 0x49, 0x3f, 0x03, 0x6e, 0x55, 0xaa, 0x90, 0xf7, 0x17,
+		0x5d, 0x90, 0xdc, 0x98, 0xf8, 0x9e, 0x95, 0xf0, 0xf3, 0x1f, 0x20, 0x42, 0x6e, 0x7a, 0x53, 0x32,
+		0x73, 0x7d, 0x9f, 0xef, 0x5b, 0x28, 0x82, 0xb1, 0xd8, 0xa9, 0x99, 0xb5, 0xcd, 0x57, 0x24, 0xc8,
+		0xac, 0x5a, 0x56, 0x93, 0x7c, 0x00, 0xc6, 0x4d, 0xcb, 0xa1, 0xfa, 0x6c, 0xd4, 0x35, 0xee, 0x9b,
+		0x63, 0x3d, 0xb0, 0xd2, 0x55, 0x56, 0x7f, 0xf2, 0xc6, 0x89, 0x28, 0x66, 0xdc, 0x9b, 0x34, 0x63,
+		0xa6, 0xe5, 0x94, 0x29, 0x10, 0x1d, 0xd5, 0x6c, 0xb2, 0x09, 0x23, 0xc1, 0xe2, 0x58, 0x2f, 0x2d,
+		0xf5, 0x2a, 0x6e, 0xa4, 0x67, 0x51, 0xf9, 0x0d, 0x5f, 0x39, 0xec, 0x0d, 0x8b, 0xff, 0xc8, 0x2e,
+		0x15, 0x92, 0x6f, 0x86, 0xe3, 0xd2, 0xae, 0xc2, 0x90, 0x88, 0x43, 0x93, 0xfa, 0x8d, 0x71, 0xf3,
+		0xcb, 0x93, 0x23, 0x9f, 0xfe, 0x55, 0x09, 0xc0, 0x73, 0x74, 0xe2, 0xed, 0x7b, 0xe5, 0x95, 0xe5,
+		0x79, 0x6f, 0x33, 0xce, 0xf7, 0xd8, 0x9b, 0xb8, 0xab, 0xcf, 0x6e, 0x19, 0xb5, 0xc6, 0x66, 0xc3,
+		0xa8, 0x93, 0x47, 0x61, 0x32, 0x08, 0x8d, 0x5f, 0x95, 0x79, 0x59, 0x2a, 0xe6, 0x5f, 0xbb, 0x3b,
+		0x95, 0x65, 0x0b, 0x3b, 0x03, 0x2f, 0xd6, 0x3e, 0x18, 0x85, 0xc3, 0x87, 0xe2, 0x52, 0xc5, 0x91,
+		0xd7, 0xee, 0x4e, 0xe5, 0xdc, 0x15, 0x20, 0x51, 0x80, 0xf8, 0x21, 0x39, 0xbd, 0x74, 0x11, 0x5e,
+		0xbb, 0x3b, 0x35, 0xc8, 0x9a, 0x81, 0xef, 0xe2, 0xbd, 0x1f, 0x6f, 0x9c, 0xdb, 0x6c, 0xeb, 0x35,
+		0xaa, 0x6e, 0x45, 0x38, 0xb4, 0xb0, 0x7c, 0x55, 0x2d, 0xcd, 0xad, 0xe1, 0xbe, 0x5f, 0x80, 0xed,
+		0x50, 0xde, 0xfc, 0xca, 0x7a, 0x79, 0xb1, 0xa2, 0xe1, 0x1d, 0x80, 0x6c, 0xcb, 0x3d, 0x90, 0xf7,
+		0xc2, 0xf2, 0xda, 0xc2, 0x52, 0x45, 0x4e, 0x95, 0xaf, 0x26, 0xee, 0xce, 0xbd, 0xab, 0xeb, 0x33,
+		0x3b, 0xde, 0x7c, 0x18, 0x7c, 0x70, 0xe7, 0xef, 0xa5, 0xe0, 0x30, 0x03, 0x3b, 0xb7, 0x63, 0xe3,
+		0xa6, 0x1c, 0xfe, 0xe3, 0x7b, 0x71, 0x23, 0x2c, 0x63, 0x1a, 0x53, 0x6e, 0x9f, 0xef, 0xe3, 0x89,
+		0xaf, 0xa7, 0xf1, 0x5d, 0xc6, 0xf6, 0xed, 0x46, 0xec, 0x7b, 0x77, 0x55, 0x96, 0xe3, 0xbe, 0xe8,
+		0x2a, 0xde, 0xb5, 0x12, 0x28, 0xb3, 0xe8, 0x00, 0x6f, 0x6c, 0x99, 0xfd, 0x3c, 0xaa, 0x4c, 0x91,
+		0xd3, 0xf4, 0xf8, 0x39, 0xc5, 0x28, 0x5f, 0x4b, 0x14, 0xcb, 0xd9, 0x3e, 0x1e, 0xb3, 0xda, 0xb1,
+		0xb7, 0x38, 0x13, 0xae, 0x5c, 0x3e, 0x37, 0x0d, 0x27, 0x18, 0x5c, 0x64, 0xc3, 0xd2, 0xb9, 0xd3,
+		0x63, 0xaf, 0xb2, 0xcb, 0xae, 0x64, 0xcf, 0x5d, 0xc7, 0x3d, 0x3f, 0x87, 0xd5, 0x6b, 0x2f, 0xb2,
+		0xaf, 0x6d, 0xd7, 0x62, 0x92, 0x0e, 0xc4, 0xbd, 0xb6, 0xf5, 0xf5, 0x0c, 0x90, 0x25, 0x7b, 0x6b,
+		0x0e, 0x17, 0xd5, 0xbe, 0x17, 0x44, 0x42, 0x0e, 0x7e, 0xe9, 0xde, 0x1d, 0xfc, 0x6a, 0xc0, 0xc7,
+		0x9e, 0xda, 0xdb, 0x8e, 0x59, 0x82, 0xa3, 0xfd, 0x5b, 0xe2, 0x1d, 0xed, 0xe9, 0x7d, 0xbe, 0x0c,
+		0x16, 0xe3, 0x5a, 0xbf, 0x16, 0xb7, 0xd2, 0x65, 0xd3, 0x64, 0x31, 0xc9, 0x98, 0x2c, 0x48, 0xfd,
+		0x3a, 0xcd, 0x06, 0xf6, 0xef, 0x34, 0xbb, 0x0a, 0x83, 0x7c, 0x27, 0x6b, 0x70, 0x5f, 0x3b, 0x59,
+		0x1c, 0x9b, 0xcc, 0xfa, 0x0f, 0x9a, 0xf4, 0x6b, 0xc4, 0x30, 0x94, 0xd9, 0x77, 0x8b, 0x15, 0xec,
+		0x47, 0xdf, 0xfc, 0xcc, 0xe9, 0x68, 0xf5, 0xd0, 0x8a, 0x7f, 0xc0, 0xd7, 0x3d, 0xa3, 0x6a, 0xa6,
+		0x1c, 0x83, 0x62, 0x34, 0xd5, 0x3d, 0xbf, 0xf5, 0xd3, 0x69, 0x90, 0x97, 0xec, 0xad, 0x4a, 0xbd,
+		0xe1, 0xbc, 0x9d, 0x9a, 0x79, 0xbf, 0xfd, 0x99, 0x37, 0x61, 0x2c, 0xbc, 0xae, 0x65, 0x1a, 0x79,
+		0x76, 0x6f, 0x8e, 0x9e, 0xd1, 0xe0, 0xbe, 0x30, 0x79, 0x21, 0x5e, 0xdb, 0x99, 0x36, 0x9e, 0xec,
+		0xd7, 0x60, 0x8b, 0x2a, 0xf9, 0xec, 0xb3, 0xbd, 0xdb, 0xf1, 0x68, 0xb0, 0x1d, 0x03, 0x4d, 0xa2,
+		0x14, 0xa1, 0x10, 0x4e, 0x73, 0xdb, 0xf0, 0x2e, 0x06, 0x10, 0xda, 0xc2, 0xeb, 0x6a, 0xbc, 0x53,
+		0x7d, 0xd0, 0x4f, 0xbb, 0xaf, 0xfc, 0xa5, 0xf7, 0xd0, 0x0f, 0x38, 0xce, 0xec, 0x95, 0x80, 0x00,
+		0x23, 0xf5, 0x43, 0x01, 0x1e, 0x0a, 0x0a, 0x50, 0xc8, 0x43, 0x39, 0x08, 0x13, 0xbe, 0x4f, 0x57,
+		0x6c, 0xdf, 0x9d, 0xa6, 0xc3, 0x72, 0xd9, 0xd8, 0xc2, 0xb3, 0x73, 0xf5, 0xfb, 0x2c, 0xbd, 0x6f,
+		0x2e, 0xc7, 0x9b, 0xd7, 0x36, 0x99, 0x7d, 0xb4, 0xcd, 0xbb, 0x7b, 0xb7, 0x4d, 0x68, 0x90, 0x0a,
+		0x09, 0x5d, 0x69, 0x41, 0x31, 0x9a, 0xea, 0x2e, 0x6c, 0x62, 0xfc, 0xcf, 0xd2, 0x3d, 0xfa, 0x9f,
+		0x95, 0x9f, 0x4c, 0xc1, 0xc8, 0x92, 0xbd, 0xb5, 0x6e, 0xd6, 0xff, 0x5a, 0x77, 0x9b, 0xa7, 0x7a,
+		0x37, 0x4d, 0x21, 0xd8, 0x34, 0x9e, 0x44, 0x94, 0x7f, 0x26, 0xc1, 0xc1, 0x40, 0xca, 0xdb, 0xd9,
+		0x22, 0x64, 0x25, 0xf0, 0x0a, 0x68, 0xd7, 0x8a, 0xd2, 0xb3, 0x1e, 0x51, 0xd7, 0xd7, 0xe3, 0x81,
+		0xba, 0xe3, 0xd9, 0xe4, 0x63, 0x38, 0xf5, 0xe1, 0xda, 0xb9, 0xf9, 0x4d, 0xb4, 0x59, 0x77, 0x4f,
+		0x2d, 0x1e, 0xb7, 0xab, 0x93, 0x89, 0xdb, 0xd5, 0x99, 0x7d, 0xbf, 0x50, 0x8d, 0x2f, 0x46, 0x43,
+		0x20, 0x92, 0xb5, 0xe5, 0x64, 0xc8, 0xda, 0x48, 0x12, 0xae, 0xf2, 0x14, 0x3c, 0xdc, 0x2d, 0x5f,
+		0xa8, 0xd2, 0xec, 0x44, 0x4c, 0xf1, 0xca, 0x1f, 0x60, 0x68, 0xa7, 0xbd, 0xc5, 0xd6, 0xbb, 0xdc,
+		0x6f, 0x8f, 0x51, 0x10, 0x1d, 0x67, 0x9b, 0xbd, 0xf7, 0xd7, 0xab, 0x95, 0x3c, 0x50, 0x52, 0x82,
+		0x41, 0x7e, 0x08, 0xda, 0xbb, 0xe0, 0x23, 0xd6, 0x90, 0x61, 0xe5, 0x04, 0x64, 0xca, 0x10, 0x67,
+		0x6f, 0x44, 0x79, 0xbc, 0x8c, 0x22, 0xf2, 0x4a, 0x41, 0xd1, 0x3c, 0x1c, 0xbb, 0x7c, 0x0c, 0x55,
+		0x43, 0x99, 0x86, 0xc3, 0xa1, 0xa4, 0x6e, 0xa2, 0xb8, 0x8c, 0x77, 0x51, 0x8d, 0xe3, 0x0d, 0xfc,
+		0x7e, 0x57, 0xac, 0xfd, 0xd7, 0x53, 0x75, 0x17, 0xe1, 0xa0, 0xc3, 0xab, 0xc9, 0xbd, 0x9a, 0x1a,
+		0x73, 0x2d, 0x67, 0x7a, 0x54, 0x6c, 0xc2, 0x45, 0xa3, 0xe2, 0x59, 0x41, 0xa4, 0x59, 0x35, 0x51,
+		0xbf, 0x2f, 0x5e, 0x48, 0xd6, 0xef, 0x63, 0x41, 0xfd, 0x0e, 0x8a, 0x5d, 0x31, 0xe1, 0x48, 0x24,
+		0xd1, 0x1d, 0x14, 0xbd, 0xca, 0x4b, 0xfb, 0x18, 0xa9, 0x27, 0xe2, 0xfc, 0xac, 0xff, 0x97, 0x44,
+		0xed, 0x3e, 0x9c, 0x13, 0x8d, 0x1d, 0x5a, 0xac, 0x7d, 0xd5, 0x6a, 0xdf, 0x5f, 0x1d, 0x78, 0xba,
+		0xff, 0x71, 0x37, 0x86, 0xed, 0x97, 0xf6, 0x25, 0xe5, 0x87, 0x82, 0x52, 0x8e, 0xad, 0x9f, 0xd2,
+		0x81, 0xa9, 0xa4, 0xbc, 0xb7, 0x53, 0xe6, 0xff, 0x49, 0xa2, 0x4b, 0xa2, 0x9b, 0x7e, 0x7f, 0xf8,
+		0x3b, 0x35, 0x7e, 0xea, 0x3d, 0xfb, 0x92, 0x7e, 0x68, 0xa5, 0x11, 0xa8, 0xa9, 0x72, 0x0e, 0x0a,
+		0xe1, 0xb4, 0x2e, 0x03, 0xd4, 0xc5, 0x0b, 0x33, 0x5f, 0xc9, 0x41, 0x7a, 0xc9, 0xde, 0x22, 0x1f,
+		0x80, 0xb1, 0xb0, 0x8b, 0xe3, 0x74, 0xd2, 0x50, 0x1b, 0x5d, 0x91, 0x16, 0x67, 0xfa, 0x87, 0x75,
+		0x5b, 0xff, 0x16, 0x8c, 0x04, 0x57, 0xae, 0xa7, 0xba, 0x10, 0x09, 0x40, 0x16, 0x1f, 0xef, 0x17,
+		0xd2, 0x2d, 0xec, 0x7d, 0xf8, 0x12, 0x38, 0xb7, 0x15, 0x1f, 0xea, 0x82, 0x2d, 0x80, 0x8a, 0x67,
+		0xfa, 0x00, 0x72, 0xa9, 0x7f, 0x00, 0xc6, 0xc2, 0x2b, 0x91, 0x6e, 0xd2, 0x0b, 0xc1, 0x16, 0x67,
+		0xfa, 0x87, 0x75, 0x8b, 0xdc, 0x00, 0xf0, 0x99, 0xbf, 0x8f, 0x74, 0xa1, 0xe0, 0x81, 0x15, 0xcf,
+		0xf6, 0x05, 0xe6, 0x96, 0xf1, 0x0f, 0x25, 0x38, 0x92, 0x6c, 0x80, 0x5d, 0xe8, 0xd6, 0xe6, 0x49,
+		0x58, 0xc5, 0xa7, 0xf7, 0x83, 0xe5, 0x2e, 0xfb, 0x26, 0x3e, 0x1f, 0xb5, 0x37, 0xc8, 0x87, 0x21,
+		0x1f, 0xb0, 0x35, 0x4e, 0x76, 0xab, 0xa5, 0x0f, 0xb0, 0x78, 0xae, 0x4f, 0xc0, 0x6e, 0xc5, 0x5f,
+		0x26, 0xdf, 0x21, 0xc1, 0x68, 0x68, 0x82, 0x7f, 0xac, 0x0b, 0xe1, 0x20, 0x68, 0xf1, 0x7c, 0xdf,
+		0xa0, 0x5d, 0xb8, 0xb8, 0x78, 0x81, 0xbc, 0x2e, 0xc1, 0xc1, 0xf8, 0x99, 0xa6, 0x5b, 0x67, 0x89,
+		0xc5, 0x28, 0x3e, 0xb9, 0x57, 0x8c, 0xee, 0xac, 0x7d, 0x44, 0x82, 0x91, 0xe0, 0x80, 0xdc, 0xad,
+		0xa7, 0x07, 0x20, 0x8b, 0x8f, 0xf7, 0x0b, 0xd9, 0x95, 0x85, 0xe2, 0xc0, 0x47, 0x70, 0xea, 0xb8,
+		0xef, 0xfb, 0x09, 0xdf, 0x9f, 0x86, 0xd3, 0x7e, 0x8f, 0x34, 0xbd, 0x6e, 0xcd, 0x75, 0x3a, 0xb7,
+		0xf4, 0xad, 0x86, 0xe9, 0x3b, 0x85, 0x43, 0x8e, 0xf8, 0xa7, 0x37, 0x0a, 0xeb, 0x79, 0xd1, 0x13,
+		0x7d, 0xde, 0xca, 0x5d, 0x09, 0x86, 0x57, 0xf5, 0x2d, 0xc3, 0x77, 0xbf, 0x61, 0xe8, 0xd5, 0x7d,
+		0x7c, 0x10, 0x7f, 0x73, 0x53, 0x3c, 0xb0, 0x95, 0x51, 0xf9, 0x17, 0x1e, 0x7f, 0x6e, 0x36, 0xc4,
+		0x5d, 0xb2, 0x19, 0x95, 0x7d, 0x60, 0x28, 0x0b, 0xdd, 0xa5, 0xd7, 0xd8, 0xab, 0xbb, 0xec, 0x2a,
+		0x62, 0xa0, 0x49, 0x6b, 0x98, 0x42, 0xce, 0xc2, 0x50, 0xdb, 0xc0, 0x5b, 0x9d, 0x0c, 0xea, 0x80,
+		0xcd, 0x96, 0x27, 0xa2, 0x71, 0xd6, 0x4f, 0xa8, 0x02, 0x46, 0x79, 0x0e, 0xf2, 0x8c, 0x3d, 0x3e,
+		0x6a, 0x1c, 0x81, 0x2c, 0xbd, 0x35, 0xc1, 0x63, 0x72, 0x08, 0xbf, 0x9f, 0x67, 0x07, 0xf3, 0x59,
+		0xa1, 0x8c, 0x4f, 0xf6, 0x51, 0x2e, 0x27, 0xb6, 0xca, 0xa9, 0x3e, 0xb6, 0x33, 0xa8, 0x1c, 0xdd,
+		0x16, 0xf9, 0x97, 0x03, 0x70, 0x90, 0xef, 0x3a, 0xe0, 0x11, 0xad, 0x6d, 0xc7, 0xe1, 0x7b, 0x0d,
+		0x04, 0x58, 0xf2, 0xb4, 0xde, 0x6a, 0x28, 0xbb, 0x90, 0xb9, 0xee, 0x38, 0x2d, 0x72, 0x1a, 0x06,
+		0xda, 0x9d, 0xa6, 0xbb, 0x7b, 0xe7, 0xfa, 0x88, 0xf5, 0x56, 0x63, 0x1a, 0x01, 0xd4, 0x4e, 0xd3,
+		0x50, 0x19, 0x08, 0xa9, 0xc0, 0x89, 0xcd, 0x4e, 0xb3, 0xb9, 0xab, 0xd5, 0x0d, 0x3c, 0x39, 0xaf,
+		0xb5, 0x0d, 0xdc, 0x45, 0x31, 0xea, 0x9a, 0x71, 0xa7, 0xa5, 0x7b, 0x57, 0x83, 0x64, 0xd5, 0x63,
+		0x14, 0x6c, 0x9e, 0x42, 0xa9, 0x1c, 0xa8, 0x22, 0x60, 0x94, 0x3f, 0x4c, 0x41, 0x56, 0x90, 0xa6,
+		0x4f, 0x51, 0x1b, 0x4d, 0xa3, 0xe6, 0xb8, 0x97, 0x7a, 0xb9, 0xdf, 0x84, 0x40, 0x7a, 0x8b, 0xb7,
+		0x68, 0xee, 0xfa, 0x01, 0x15, 0x3f, 0x30, 0xad, 0xd5, 0xe1, 0x31, 0x17, 0x98, 0xd6, 0xea, 0x60,
+		0x23, 0x67, 0x5a, 0x96, 0x88, 0x97, 0xc3, 0x1b, 0x07, 0xf1, 0x8b, 0x14, 0x60, 0x10, 0x87, 0x73,
+		0x87, 0x35, 0x21, 0xa6, 0xf3, 0x6f, 0x72, 0x08, 0x03, 0xb4, 0x9d, 0x1a, 0x7b, 0x54, 0x17, 0x33,
+		0xd8, 0x27, 0xbe, 0x78, 0x56, 0xeb, 0xd8, 0x8e, 0xb5, 0xc3, 0x2f, 0x4e, 0x7a, 0xc0, 0x2f, 0x8c,
+		0x39, 0x9a, 0x83, 0x7c, 0xaf, 0xea, 0x8e, 0x63, 0xb4, 0x4d, 0x24, 0xc8, 0xc0, 0xf1, 0x94, 0xee,
+		0x86, 0x55, 0xdf, 0xe5, 0xb7, 0x64, 0xd1, 0xdf, 0xec, 0xfa, 0x1e, 0xa6, 0x0f, 0x1a, 0xcd, 0x64,
+		0xd7, 0x02, 0xe5, 0x45, 0x62, 0x19, 0x81, 0x2a, 0x30, 0xa1, 0xd7, 0xeb, 0x0d, 0xec, 0x20, 0x18,
+		0x48, 0xd8, 0xa0, 0x83, 0xbe, 0x5d, 0x18, 0xee, 0xd2, 0x16, 0xc4, 0x43, 0x28, 0x73, 0xf8, 0x72,
+		0x0e, 0x86, 0x5a, 0x8c, 0x29, 0xe5, 0x29, 0x18, 0x8f, 0x70, 0x8a, 0xfc, 0xdd, 0x6a, 0xf0, 0x3b,
+		0x30, 0x72, 0x2a, 0xfd, 0x1d, 0x77, 0x69, 0x77, 0xf9, 0xa3, 0xc9, 0x0f, 0x51, 0x8d, 0xfa, 0x1e,
+		0xa2, 0xd2, 0x5b, 0x8d, 0x72, 0x8e, 0xd2, 0xe7, 0xef, 0x4f, 0x95, 0xa2, 0xef, 0x4f, 0x6d, 0x19,
+		0xa6, 0xd8, 0xd5, 0xc2, 0x2c, 0xbd, 0xd5, 0xb0, 0xa9, 0x3a, 0xea, 0xa6, 0x69, 0x39, 0x74, 0x44,
+		0xb0, 0x9f, 0xf2, 0xfd, 0xa6, 0xef, 0x51, 0x65, 0xae, 0x95, 0x56, 0x17, 0x5c, 0x3d, 0xfe, 0xcd,
+		0x14, 0x1c, 0xf3, 0xe9, 0xb1, 0x0f, 0x38, 0xaa, 0xce, 0xc5, 0x78, 0x8d, 0xef, 0x63, 0x0b, 0xf3,
+		0x79, 0xc8, 0x20, 0x3c, 0x39, 0x1e, 0xb3, 0x05, 0xe9, 0x6c, 0x5b, 0x75, 0xb1, 0x03, 0xf9, 0x99,
+		0xcf, 0xff, 0x33, 0x65, 0x4a, 0x4a, 0x6c, 0x15, 0x4a, 0xa4, 0xfc, 0x5d, 0xfd, 0xcb, 0x4f, 0x2e,
+		0x79, 0xf5, 0xb9, 0x7f, 0x62, 0x0c, 0xcb, 0xf0, 0x2b, 0x12, 0x70, 0x63, 0xd9, 0x1d, 0x98, 0xd9,
+		0x0f, 0xf1, 0xa2, 0x1b, 0xcb, 0x74, 0x47, 0xe2, 0x3e, 0x04, 0xb6, 0x04, 0xe3, 0x3c, 0xfe, 0x89,
+		0x22, 0x69, 0xb6, 0xbe, 0x69, 0xf4, 0x94, 0xde, 0x9f, 0x89, 0xcd, 0xdf, 0x31, 0x86, 0x4b, 0xef,
+		0x4a, 0xab, 0xea, 0x9b, 0xc6, 0x7d, 0x1d, 0xf9, 0x7e, 0xbe, 0x02, 0x4a, 0xc2, 0xee, 0xa7, 0xbf,
+		0xd2, 0x49, 0xdb, 0xb8, 0x7b, 0x98, 0xc7, 0x12, 0xb6, 0x6d, 0xbb, 0xea, 0x6b, 0x9f, 0x3b, 0xb3,
+		0x5d, 0xb6, 0x7e, 0xbb, 0x35, 0x65, 0xdc, 0xc6, 0xed, 0x1d, 0x38, 0x44, 0x05, 0xed, 0x05, 0x71,
+		0xfa, 0x2e, 0x99, 0xf7, 0x5d, 0xc6, 0x94, 0x73, 0xcf, 0x95, 0x5d, 0x05, 0xf0, 0xaa, 0x5a, 0x48,
+		0xb9, 0x0f, 0x4f, 0x24, 0xcc, 0xd9, 0xd3, 0xbe, 0x49, 0x59, 0xf5, 0x61, 0x2a, 0x9f, 0x91, 0xe0,
+		0x70, 0xa4, 0x68, 0x3e, 0x39, 0x2e, 0x06, 0xee, 0xe3, 0x93, 0xf6, 0x71, 0x1e, 0xd5, 0x87, 0x8f,
+		0xb7, 0xfb, 0x45, 0x38, 0x3e, 0xd9, 0x93, 0x63, 0xc6, 0x4a, 0x80, 0x65, 0x1d, 0x0e, 0x06, 0x39,
+		0x16, 0xb2, 0xba, 0x0e, 0xa3, 0xc1, 0x35, 0x6f, 0xff, 0x91, 0x75, 0x23, 0x81, 0x05, 0xaf, 0x52,
+		0x0f, 0xb7, 0x87, 0x2b, 0x93, 0x1b, 0xd1, 0x6b, 0x49, 0xf6, 0x26, 0x12, 0x0f, 0x5d, 0xf9, 0xac,
+		0x04, 0x53, 0xc1, 0x62, 0xbc, 0x55, 0x84, 0x7d, 0xdf, 0x2b, 0x75, 0xdf, 0x54, 0xe6, 0x4f, 0x25,
+		0x78, 0xb0, 0x0b, 0xdb, 0x5c, 0x50, 0xdf, 0x06, 0x93, 0xbe, 0x60, 0x37, 0x31, 0x97, 0x0a, 0x35,
+		0x3a, 0xdd, 0x3b, 0x56, 0xcf, 0x0d, 0xea, 0x9a, 0x42, 0xe1, 0xfd, 0xdc, 0xbf, 0x3f, 0x31, 0x11,
+		0xcd, 0xb3, 0x79, 0x48, 0x61, 0x34, 0x46, 0xed, 0x3e, 0xea, 0xdb, 0x6f, 0x48, 0xf0, 0x58, 0xb0,
+		0xbe, 0x31, 0xab, 0xbe, 0x77, 0x70, 0x7b, 0xbd, 0x21, 0xc1, 0xe9, 0x7e, 0xf8, 0xe7, 0x0d, 0xb7,
+		0x05, 0x13, 0x5e, 0xd4, 0x7a, 0xb8, 0xdd, 0xce, 0xec, 0xe1, 0x34, 0x8f, 0x5f, 0xeb, 0x89, 0x4b,
+		0xf2, 0x6d, 0x68, 0xa0, 0x5f, 0x94, 0x78, 0x77, 0xf5, 0x2b, 0x08, 0x6b, 0x8d, 0xe7, 0x60, 0x34,
+		0xe8, 0x98, 0xea, 0xe9, 0x4a, 0x1b, 0x09, 0xb8, 0xd2, 0x62, 0x9a, 0x33, 0xb5, 0xbf, 0xe6, 0xf4,
+		0x1d, 0x11, 0xb8, 0x0d, 0x87, 0x23, 0xec, 0x72, 0xe1, 0xbf, 0x17, 0x26, 0x62, 0x7a, 0x0d, 0x1f,
+		0x68, 0xf6, 0xd0, 0x69, 0x54, 0x12, 0xed, 0x12, 0xca, 0xaf, 0x4a, 0x70, 0x82, 0x16, 0x1c, 0xeb,
+		0xb5, 0x78, 0x07, 0x0b, 0xac, 0x0d, 0x53, 0xc9, 0x7c, 0x73, 0xc9, 0x2d, 0xc3, 0x20, 0xd3, 0x31,
+		0x2e, 0xac, 0xfd, 0x6a, 0x2a, 0xa7, 0xa2, 0x7c, 0x4e, 0x0c, 0xce, 0xf3, 0xa2, 0x7a, 0x31, 0x9d,
+		0xfd, 0x9e, 0xa5, 0x75, 0x9f, 0xfa, 0xb8, 0x4f, 0x56, 0xbf, 0x2f, 0x46, 0xe7, 0x78, 0xbe, 0xb9,
+		0xb4, 0xb6, 0xef, 0xdb, 0xe8, 0xec, 0x13, 0xdd, 0xdb, 0x3b, 0x0c, 0xff, 0x73, 0x31, 0x0c, 0xbb,
+		0x15, 0xeb, 0x36, 0x0c, 0xbf, 0x03, 0x5b, 0xc6, 0x1d, 0x87, 0x7b, 0x54, 0xe0, 0x9b, 0x76, 0x1c,
+		0xfe, 0x42, 0x0a, 0x8e, 0xf0, 0xbb, 0x95, 0xeb, 0x6f, 0x43, 0x8b, 0xac, 0x00, 0xc1, 0xf0, 0x96,
+		0xfd, 0x8e, 0x2e, 0xb2, 0xdd, 0xae, 0x05, 0xb2, 0x90, 0x20, 0x06, 0xb6, 0x84, 0x08, 0xf6, 0x1d,
+		0xdf, 0x22, 0xd7, 0x6d, 0xe7, 0x66, 0x97, 0x19, 0x3b, 0x73, 0x1f, 0x74, 0xe6, 0xf7, 0x24, 0x28,
+		0xc6, 0x89, 0x94, 0xeb, 0x88, 0x09, 0x87, 0x02, 0x67, 0x0a, 0xc2, 0x6a, 0xf2, 0xae, 0x7e, 0x42,
+		0xe8, 0xe3, 0xba, 0xf2, 0xc1, 0xb6, 0xf1, 0xb6, 0x76, 0xe6, 0xcf, 0x8a, 0xa9, 0xc8, 0xed, 0x0b,
+		0xd1, 0xa5, 0xcf, 0x3b, 0xb0, 0x0b, 0xff, 0x7a, 0x64, 0x52, 0xf8, 0xe6, 0x59, 0x36, 0x7d, 0x4e,
+		0x82, 0xe3, 0x09, 0xbc, 0xbf, 0xa3, 0x27, 0xff, 0x9d, 0x44, 0x4d, 0x79, 0x5b, 0x16, 0x65, 0x17,
+		0x78, 0x87, 0x0b, 0xde, 0xea, 0x14, 0xff, 0xe6, 0x9b, 0x7b, 0x75, 0xa1, 0xf2, 0x12, 0x1c, 0x8d,
+		0xc5, 0xe2, 0x0c, 0xce, 0x42, 0x06, 0x8f, 0x55, 0x16, 0xa4, 0xa0, 0x0e, 0x86, 0x79, 0x0b, 0x61,
+		0x53, 0x1c, 0x85, 0x80, 0x4c, 0x49, 0xe3, 0xe9, 0x15, 0xf1, 0x02, 0xde, 0x2a, 0x8c, 0xfb, 0xd2,
+		0x78, 0x21, 0x4f, 0xa1, 0x73, 0xd5, 0x6a, 0x16, 0x24, 0xf7, 0x2e, 0xf9, 0xd8, 0x42, 0x10, 0xc7,
+		0x5f, 0x77, 0x8a, 0xa4, 0x4c, 0x02, 0x61, 0x14, 0xf9, 0x26, 0x12, 0x2b, 0xe7, 0x45, 0x98, 0x08,
+		0xa4, 0xf2, 0x92, 0xbc, 0x40, 0x17, 0x69, 0x9f, 0x81, 0x2e, 0xca, 0x55, 0xde, 0xaa, 0x31, 0x07,
+		0xff, 0x7c, 0x0f, 0x0b, 0xfa, 0xcf, 0xff, 0xc5, 0xef, 0xa1, 0xff, 0x6d, 0xd1, 0x23, 0x63, 0x09,
+		0x79, 0xb6, 0x61, 0x9b, 0xa6, 0xf4, 0xb2, 0x0d, 0x63, 0x88, 0x04, 0x98, 0x67, 0x54, 0xe2, 0x39,
+		0xf9, 0xcf, 0xb8, 0x0a, 0x84, 0x4e, 0xfc, 0xdd, 0xf7, 0x75, 0x61, 0x7c, 0xe9, 0x7f, 0x57, 0x82,
+		0x63, 0xf1, 0xc5, 0x73, 0x19, 0xbc, 0x1f, 0x64, 0x7e, 0x54, 0x30, 0xdc, 0x55, 0x12, 0xb7, 0x20,
+		0x43, 0xa4, 0xfc, 0x92, 0x18, 0x6b, 0x06, 0xf3, 0xe2, 0x99, 0xba, 0x00, 0x0f, 0xf0, 0xb6, 0x71,
+		0xfc, 0x47, 0x55, 0x0d, 0xd1, 0xc4, 0xf1, 0x58, 0xdf, 0x06, 0xc7, 0x93, 0xb0, 0x78, 0x5d, 0xae,
+		0xbb, 0x97, 0x2a, 0x49, 0xfb, 0x3c, 0x1d, 0xc0, 0xf1, 0xe3, 0xe3, 0x0c, 0x7e, 0xe3, 0x28, 0x0c,
+		0x50, 0x0e, 0xc8, 0x27, 0x25, 0x00, 0xdf, 0xc1, 0xea, 0xe9, 0x24, 0x49, 0xc5, 0x3b, 0xef, 0x8a,
+		0xe7, 0xfa, 0x86, 0xe7, 0x2b, 0xb4, 0x73, 0x1f, 0x47, 0xee, 0x3e, 0xfa, 0xdf, 0xff, 0xd1, 0x0f,
+		0xa6, 0x1e, 0x26, 0xca, 0xb9, 0x04, 0x37, 0xa4, 0x6f, 0x76, 0xf8, 0x05, 0xc9, 0x7f, 0xc1, 0xd6,
+		0xd9, 0xfe, 0xca, 0x13, 0xec, 0x4d, 0xf7, 0x0b, 0xce, 0xb9, 0x7b, 0xb7, 0xc7, 0xdd, 0x45, 0xf2,
+		0x44, 0x6f, 0xee, 0xce, 0x7d, 0x28, 0xa8, 0xf1, 0x1f, 0x26, 0xff, 0xa3, 0x04, 0x93, 0x71, 0x5e,
+		0x23, 0xf2, 0x64, 0x7f, 0xac, 0x44, 0x0d, 0xfd, 0xe2, 0x95, 0x7d, 0x60, 0xf2, 0xfa, 0x2c, 0x7a,
+		0xf5, 0x29, 0x91, 0xe7, 0xf6, 0x51, 0x9f, 0x73, 0x3e, 0x9b, 0x8c, 0xfc, 0x3f, 0x12, 0x3c, 0xd0,
+		0xd5, 0xc3, 0x42, 0x4a, 0xfd, 0xb1, 0xda, 0x65, 0x59, 0x53, 0x2c, 0xdf, 0x0b, 0x09, 0x5e, 0xed,
+		0x9b, 0x5e, 0xb5, 0x9f, 0x27, 0x0b, 0xfb, 0xa9, 0xb6, 0xb7, 0x2e, 0xf1, 0x0b, 0xe0, 0x5f, 0x07,
+		0x2f, 0xe9, 0xe9, 0xae, 0x5d, 0x11, 0xcf, 0x43, 0xf1, 0x5c, 0xdf, 0xf0, 0xbc, 0x1e, 0xef, 0xf7,
+		0xea, 0xa1, 0x92, 0xd5, 0x7b, 0x6c, 0xbe, 0x73, 0x1f, 0x0a, 0x5a, 0x3e, 0x1f, 0x26, 0xff, 0x49,
+		0x8a, 0xbf, 0x78, 0xe7, 0x72, 0x57, 0x3e, 0x93, 0x5d, 0x2b, 0xc5, 0x27, 0xf7, 0x8e, 0xc8, 0x6b,
+		0xda, 0xf6, 0x6a, 0xba, 0x45, 0x8c, 0xfb, 0x5d, 0xd3, 0xd8, 0xe6, 0x24, 0xbf, 0x23, 0xc1, 0x64,
+		0x9c, 0x0b, 0xa1, 0x47, 0x57, 0xed, 0xe2, 0x2d, 0xe9, 0xd1, 0x55, 0xbb, 0xf9, 0x2b, 0x94, 0x92,
+		0x27, 0x81, 0x4b, 0xe4, 0x42, 0x92, 0x04, 0xba, 0xb6, 0x27, 0xf6, 0xcf, 0xae, 0x2b, 0xef, 0x1e,
+		0xfd, 0xb3, 0x1f, 0xb7, 0x43, 0x8f, 0xfe, 0xd9, 0xd7, 0xc2, 0xbf, 0xcf, 0xfe, 0xe9, 0x56, 0xaf,
+		0xcf, 0x06, 0xb5, 0xc9, 0x6f, 0x49, 0x30, 0x12, 0x58, 0x46, 0x92, 0xf3, 0x5d, 0xb9, 0x8d, 0x5b,
+		0xc5, 0x17, 0x67, 0xf6, 0x82, 0xc2, 0x2b, 0xb4, 0xec, 0x55, 0x68, 0x8e, 0x94, 0xf6, 0x53, 0xa1,
+		0x76, 0x80, 0xed, 0xdf, 0x93, 0x60, 0x22, 0x66, 0x01, 0xd6, 0xa3, 0x67, 0x26, 0xaf, 0x34, 0x8b,
+		0x4f, 0xee, 0x1d, 0x91, 0x57, 0xed, 0x79, 0xaf, 0x6a, 0xef, 0x26, 0xcf, 0xee, 0xa7, 0x6a, 0xbe,
+		0xc9, 0xfc, 0xcb, 0xde, 0x5d, 0x07, 0xbe, 0xc2, 0xc8, 0xa5, 0x3d, 0x72, 0x27, 0x6a, 0x75, 0x79,
+		0xcf, 0x78, 0xbc, 0x52, 0xef, 0xf3, 0x2a, 0xf5, 0x1e, 0xb2, 0x72, 0x6f, 0x95, 0x8a, 0xda, 0x00,
+		0xbf, 0x16, 0xbd, 0x32, 0xb7, 0xbb, 0x52, 0xc5, 0xae, 0xc4, 0x8a, 0x4f, 0xec, 0x09, 0x87, 0xd7,
+		0xec, 0x19, 0xaf, 0x66, 0x33, 0xe4, 0xf1, 0xa4, 0x9a, 0xf9, 0xee, 0xc6, 0x69, 0x98, 0x9b, 0xd6,
+		0xb9, 0x0f, 0xb1, 0x45, 0xde, 0x87, 0xc9, 0x77, 0x89, 0x4b, 0x04, 0x4e, 0x75, 0x2d, 0xdc, 0xb7,
+		0x52, 0x2b, 0x3e, 0xd6, 0x07, 0x24, 0x67, 0xee, 0x31, 0x8f, 0xb9, 0xe3, 0xe4, 0x58, 0x12, 0x73,
+		0xb8, 0x5a, 0x23, 0xdf, 0x27, 0xb9, 0x97, 0x0c, 0x9d, 0xee, 0x5e, 0x80, 0x7f, 0x39, 0x57, 0x3c,
+		0xd3, 0x17, 0x2c, 0x67, 0xe7, 0x8c, 0xc7, 0xce, 0x14, 0x39, 0x9e, 0xc8, 0x0e, 0xe3, 0xe2, 0xdf,
+		0x25, 0xdc, 0xe1, 0xd2, 0x5d, 0x07, 0x93, 0x17, 0x7f, 0xc5, 0x27, 0xf7, 0x8e, 0xc8, 0xf9, 0xae,
+		0xc6, 0xc4, 0xd4, 0xf5, 0x65, 0xb8, 0x8a, 0xd0, 0x7e, 0x7e, 0x20, 0x80, 0xad, 0xf5, 0xec, 0x73,
+		0x1f, 0x6a, 0xd4, 0x3f, 0x4c, 0xf0, 0x98, 0x48, 0xf8, 0x2a, 0x97, 0xee, 0x1a, 0x18, 0xbf, 0x0c,
+		0x2c, 0x5e, 0xd8, 0x1b, 0x12, 0xaf, 0xd3, 0xcb, 0x5d, 0xeb, 0xf4, 0x34, 0x99, 0x4d, 0xaa, 0x53,
+		0x78, 0xc9, 0x17, 0xd3, 0x1f, 0x7f, 0x57, 0x82, 0xf1, 0xc8, 0x52, 0x8b, 0x5c, 0xec, 0x21, 0xff,
+		0xf8, 0x05, 0x5d, 0xf1, 0xd2, 0x5e, 0xd1, 0xc4, 0x38, 0xda, 0xb5, 0x82, 0x67, 0xc9, 0x99, 0xe4,
+		0x46, 0x73, 0x82, 0x37, 0x2e, 0x19, 0xf5, 0xfb, 0x1e, 0x39, 0xf9, 0xcb, 0xf9, 0xc4, 0x2b, 0xd2,
+		0xb7, 0x0c, 0xd3, 0xb0, 0x1b, 0xf6, 0xbe, 0xae, 0x48, 0xbf, 0xe7, 0x28, 0x93, 0x98, 0x40, 0x92,
+		0x7f, 0x93, 0x83, 0xfc, 0x35, 0xc6, 0x13, 0xde, 0xcf, 0x71, 0x3f, 0x5c, 0x35, 0xe4, 0x65, 0xa0,
+		0x4f, 0x5a, 0xb2, 0x10, 0x4c, 0xcd, 0x7b, 0xa3, 0x26, 0xbf, 0x8f, 0x75, 0xf7, 0x28, 0x52, 0xa2,
+		0x6a, 0x40, 0x9f, 0xe2, 0x20, 0x0d, 0x38, 0x48, 0x69, 0x7b, 0x4a, 0x49, 0xe9, 0x8b, 0xcb, 0x23,
+		0x13, 0x07, 0x37, 0x7c, 0xdc, 0xda, 0xed, 0x26, 0x94, 0x54, 0x60, 0x23, 0xaa, 0x19, 0xc9, 0xb6,
+		0x43, 0x6e, 0xd9, 0xcc, 0x3d, 0xba, 0x65, 0x57, 0x60, 0x98, 0x4f, 0x7a, 0x34, 0x6c, 0x6a, 0xa0,
+		0xc7, 0xfd, 0xa6, 0xb1, 0x9b, 0x31, 0x7e, 0x0a, 0xe4, 0x16, 0x1c, 0x8c, 0x35, 0xdb, 0xf8, 0x53,
+		0xa1, 0xfb, 0xdd, 0xf0, 0x99, 0xec, 0xc4, 0x59, 0xba, 0xeb, 0x18, 0xf2, 0xe8, 0x2f, 0x84, 0x3d,
+		0xda, 0xb4, 0xe7, 0x1b, 0x7c, 0x82, 0x54, 0x30, 0x44, 0xd4, 0xb8, 0xd3, 0xb2, 0xda, 0x8e, 0x51,
+		0xa7, 0x81, 0x99, 0x59, 0xd5, 0xfd, 0x26, 0xdf, 0x06, 0x87, 0xe2, 0x07, 0xd8, 0x42, 0xae, 0x7b,
+		0x05, 0xe3, 0x7c, 0x72, 0x09, 0xc7, 0x32, 0xf9, 0x8d, 0x64, 0x93, 0x4e, 0x14, 0x05, 0xeb, 0xfc,
+		0x00, 0x57, 0xe3, 0x18, 0x2e, 0xf0, 0xc2, 0x45, 0xbc, 0x2a, 0x3a, 0x13, 0x13, 0x3f, 0x7c, 0xf1,
+		0x82, 0x7a, 0x84, 0xa9, 0x6d, 0x84, 0xea, 0x42, 0x1d, 0x03, 0xc6, 0x8b, 0x31, 0x83, 0x90, 0xb8,
+		0xea, 0x67, 0x98, 0x76, 0x94, 0xb9, 0x7b, 0xb9, 0x27, 0x5a, 0x54, 0xeb, 0xb0, 0x13, 0x1e, 0x3e,
+		0xf9, 0xfd, 0x42, 0x6d, 0x18, 0x8f, 0x8c, 0xf3, 0x85, 0xfc, 0x54, 0x7a, 0x2f, 0xbe, 0xbd, 0xee,
+		0x12, 0x95, 0x43, 0xee, 0x3e, 0x9b, 0x18, 0x30, 0x16, 0xbc, 0x1c, 0x8c, 0x5d, 0x35, 0xdd, 0xfb,
+		0x12, 0xe5, 0xee, 0x05, 0x8d, 0x06, 0x2e, 0x07, 0xb3, 0x95, 0x6d, 0x20, 0xd1, 0xae, 0x4e, 0x66,
+		0x82, 0xaf, 0x6d, 0x75, 0xdb, 0xa0, 0x88, 0xbe, 0xc3, 0xe5, 0x7f, 0x5e, 0xcb, 0xdb, 0x66, 0xb8,
+		0xdf, 0x93, 0xc6, 0xff, 0x3b, 0x00, 0x9d, 0xda, 0xbc, 0xfb, 0x64, 0x02, 0x01, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2468,6 +2792,78 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if !this.ValidatorBondFactor.Equal(that1.ValidatorBondFactor) {
+		return false
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
+	return true
+}
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	return true
+}
+func (this *LiquidValidator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LiquidValidator)
+	if !ok {
+		that2, ok := that.(LiquidValidator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OperatorAddress != that1.OperatorAddress {
+		return false
+	}
+	if !this.LiquidShares.Equal(that1.LiquidShares) {
+		return false
+	}
+	if !this.ValidatorBondShares.Equal(that1.ValidatorBondShares) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -3361,6 +3757,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ValidatorBondFactor.Size()
+		i -= size
+		if _, err := m.ValidatorBondFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -3404,6 +3830,105 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ValidatorBondShares.Size()
+		i -= size
+		if _, err := m.ValidatorBondShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LiquidShares.Size()
+		i -= size
+		if _, err := m.LiquidShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3973,36 +4498,83 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondFactor.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
-func (m *DelegationResponse) Size() (n int) {
+func (m *TokenizeShareRecord) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Delegation.Size()
-	n += 1 + l + sovStaking(uint64(l))
-	l = m.Balance.Size()
-	n += 1 + l + sovStaking(uint64(l))
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
 	return n
 }
 
-func (m *RedelegationEntryResponse) Size() (n int) {
+func (m *LiquidValidator) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.RedelegationEntry.Size()
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.LiquidShares.Size()
 	n += 1 + l + sovStaking(uint64(l))
-	l = m.Balance.Size()
+	l = m.ValidatorBondShares.Size()
 	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
-func (m *RedelegationResponse) Size() (n int) {
+func (m *DelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *RedelegationEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RedelegationEntry.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *RedelegationResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6707,6 +7279,423 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
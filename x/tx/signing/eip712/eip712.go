@@ -0,0 +1,162 @@
+package eip712
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/x/tx/decode"
+	"cosmossdk.io/x/tx/signing"
+)
+
+// SignMode is SIGN_MODE_EIP_712. It is declared by value since x/tx must keep
+// using released versions of cosmossdk.io/api, which do not declare it yet.
+const SignMode = signingv1beta1.SignMode(712)
+
+const (
+	// DefaultDomainName is the default name of the EIP-712 domain.
+	DefaultDomainName = "Cosmos SDK"
+	// DefaultDomainVersion is the default version of the EIP-712 domain.
+	DefaultDomainVersion = "1"
+
+	// txType is the name of the EIP-712 primary type of the typed data of a tx.
+	txType = "Tx"
+)
+
+// SignModeHandler implements the SIGN_MODE_EIP_712 signing mode, which maps
+// the body and auth info of a tx into EIP-712 typed structured data that
+// Ethereum wallets can sign. The sign bytes are the typed data bytes hashed by
+// the wallets with Keccak-256, so the signatures are meant to be verified by
+// pubkeys which hash the signed bytes with Keccak-256, such as Ethereum-style
+// secp256k1 pubkeys.
+type SignModeHandler struct {
+	fileResolver  signing.ProtoFileResolver
+	typeResolver  protoregistry.MessageTypeResolver
+	domainName    string
+	domainVersion string
+	eip155ChainID uint64
+}
+
+// SignModeHandlerOptions are the options for the SignModeHandler.
+type SignModeHandlerOptions struct {
+	FileResolver signing.ProtoFileResolver
+	TypeResolver signing.TypeResolver
+
+	// DomainName is the name of the EIP-712 domain, DefaultDomainName if empty.
+	DomainName string
+	// DomainVersion is the version of the EIP-712 domain, DefaultDomainVersion
+	// if empty.
+	DomainVersion string
+	// EIP155ChainID is the EIP-155 chain id of the EIP-712 domain, which the
+	// wallets check against the chain they are connected to. It is omitted
+	// from the domain if zero.
+	EIP155ChainID uint64
+}
+
+// NewSignModeHandler returns a new SignModeHandler.
+func NewSignModeHandler(options SignModeHandlerOptions) *SignModeHandler {
+	h := &SignModeHandler{
+		domainName:    options.DomainName,
+		domainVersion: options.DomainVersion,
+		eip155ChainID: options.EIP155ChainID,
+	}
+	if options.FileResolver == nil {
+		h.fileResolver = gogoproto.HybridResolver
+	} else {
+		h.fileResolver = options.FileResolver
+	}
+	if options.TypeResolver == nil {
+		h.typeResolver = protoregistry.GlobalTypes
+	} else {
+		h.typeResolver = options.TypeResolver
+	}
+	if h.domainName == "" {
+		h.domainName = DefaultDomainName
+	}
+	if h.domainVersion == "" {
+		h.domainVersion = DefaultDomainVersion
+	}
+	return h
+}
+
+// Mode implements the Mode method of the SignModeHandler interface.
+func (h SignModeHandler) Mode() signingv1beta1.SignMode {
+	return SignMode
+}
+
+// GetSignBytes implements the GetSignBytes method of the SignModeHandler interface.
+func (h SignModeHandler) GetSignBytes(ctx context.Context, signerData signing.SignerData, txData signing.TxData) ([]byte, error) {
+	typedData, err := h.GetTypedData(ctx, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	return typedData.SignBytes()
+}
+
+// GetTypedData returns the EIP-712 typed data of the tx, which is to be
+// signed by the wallets of the signers. Its primary type is Tx, whose fields
+// mirror the SignDoc of SIGN_MODE_DIRECT:
+//
+//	Tx(cosmos_tx_v1beta1_TxBody body,cosmos_tx_v1beta1_AuthInfo auth_info,string chain_id,uint64 account_number)
+func (h SignModeHandler) GetTypedData(_ context.Context, signerData signing.SignerData, txData signing.TxData) (*TypedData, error) {
+	// unknown fields are not part of the typed data, so they are rejected
+	// rather than left unsigned.
+	if err := decode.RejectUnknownFieldsStrict(txData.BodyBytes, txData.Body.ProtoReflect().Descriptor(), h.fileResolver); err != nil {
+		return nil, err
+	}
+	if err := decode.RejectUnknownFieldsStrict(txData.AuthInfoBytes, txData.AuthInfo.ProtoReflect().Descriptor(), h.fileResolver); err != nil {
+		return nil, err
+	}
+
+	enc := &encoder{
+		fileResolver: h.fileResolver,
+		typeResolver: h.typeResolver,
+		types:        map[string][]Type{},
+	}
+	bodyType, body, err := enc.encodeMessage(txData.Body.ProtoReflect())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", h.Mode(), err)
+	}
+	authInfoType, authInfo, err := enc.encodeMessage(txData.AuthInfo.ProtoReflect())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", h.Mode(), err)
+	}
+
+	enc.types[txType] = []Type{
+		{Name: "body", Type: bodyType},
+		{Name: "auth_info", Type: authInfoType},
+		{Name: "chain_id", Type: "string"},
+		{Name: "account_number", Type: "uint64"},
+	}
+	enc.types[DomainType] = []Type{
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+	}
+	domain := map[string]any{
+		"name":    h.domainName,
+		"version": h.domainVersion,
+	}
+	if h.eip155ChainID != 0 {
+		enc.types[DomainType] = append(enc.types[DomainType], Type{Name: "chainId", Type: "uint256"})
+		domain["chainId"] = strconv.FormatUint(h.eip155ChainID, 10)
+	}
+
+	return &TypedData{
+		Types:       enc.types,
+		PrimaryType: txType,
+		Domain:      domain,
+		Message: map[string]any{
+			"body":           body,
+			"auth_info":      authInfo,
+			"chain_id":       signerData.ChainID,
+			"account_number": strconv.FormatUint(signerData.AccountNumber, 10),
+		},
+	}, nil
+}
+
+var _ signing.SignModeHandler = (*SignModeHandler)(nil)
@@ -0,0 +1,174 @@
+package eip712_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-proto/anyutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/x/tx/signing/eip712"
+	"cosmossdk.io/x/tx/signing/testutil"
+)
+
+func TestTypedDataSignBytes(t *testing.T) {
+	// example of the EIP-712 specification
+	typedData := &eip712.TypedData{
+		Types: map[string][]eip712.Type{
+			eip712.DomainType: {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Person": {
+				{Name: "name", Type: "string"},
+				{Name: "wallet", Type: "address"},
+			},
+			"Mail": {
+				{Name: "from", Type: "Person"},
+				{Name: "to", Type: "Person"},
+				{Name: "contents", Type: "string"},
+			},
+		},
+		PrimaryType: "Mail",
+		Domain: map[string]any{
+			"name":              "Ether Mail",
+			"version":           "1",
+			"chainId":           "1",
+			"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+		},
+		Message: map[string]any{
+			"from": map[string]any{
+				"name":   "Cow",
+				"wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826",
+			},
+			"to": map[string]any{
+				"name":   "Bob",
+				"wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB",
+			},
+			"contents": "Hello, Bob!",
+		},
+	}
+
+	encodedType, err := typedData.EncodeType("Mail")
+	require.NoError(t, err)
+	require.Equal(t, "Mail(Person from,Person to,string contents)Person(string name,address wallet)", encodedType)
+
+	signBytes, err := typedData.SignBytes()
+	require.NoError(t, err)
+	require.Equal(t,
+		"1901"+
+			"f2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f"+
+			"c52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e",
+		hex.EncodeToString(signBytes))
+
+	typedData.Message["contents"] = 1
+	_, err = typedData.SignBytes()
+	require.Error(t, err)
+}
+
+func TestSignModeHandler(t *testing.T) {
+	fee := &txv1beta1.Fee{Amount: []*basev1beta1.Coin{{Denom: "uatom", Amount: "1000"}}, GasLimit: 20000}
+	signerData, txData, err := testutil.MakeHandlerArguments(testutil.HandlerArgumentOptions{
+		ChainID: "test-chain",
+		Memo:    "sometestmemo",
+		Msg: &bankv1beta1.MsgSend{
+			FromAddress: "foo",
+			ToAddress:   "bar",
+			Amount:      []*basev1beta1.Coin{{Denom: "uatom", Amount: "10"}},
+		},
+		AccNum: 1,
+		AccSeq: 2,
+		Fee:    fee,
+	})
+	require.NoError(t, err)
+
+	handler := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{EIP155ChainID: 9001})
+	typedData, err := handler.GetTypedData(context.Background(), signerData, txData)
+	require.NoError(t, err)
+
+	require.Equal(t, "Tx", typedData.PrimaryType)
+	require.Equal(t, map[string]any{"name": eip712.DefaultDomainName, "version": eip712.DefaultDomainVersion, "chainId": "9001"}, typedData.Domain)
+	require.Equal(t, "test-chain", typedData.Message["chain_id"])
+	require.Equal(t, "1", typedData.Message["account_number"])
+
+	// the Any messages are replaced by the messages they pack
+	require.Contains(t, typedData.Types["cosmos_tx_v1beta1_TxBody"], eip712.Type{Name: "messages", Type: "cosmos_bank_v1beta1_MsgSend[]"})
+	require.Equal(t, []eip712.Type{
+		{Name: "from_address", Type: "string"},
+		{Name: "to_address", Type: "string"},
+		{Name: "amount", Type: "cosmos_base_v1beta1_Coin[]"},
+	}, typedData.Types["cosmos_bank_v1beta1_MsgSend"])
+	body := typedData.Message["body"].(map[string]any)
+	msgSend := body["messages"].([]any)[0].(map[string]any)
+	require.Equal(t, "foo", msgSend["from_address"])
+	require.Equal(t, "sometestmemo", body["memo"])
+
+	// the typed data is the JSON expected by the wallets
+	bz, err := json.Marshal(typedData)
+	require.NoError(t, err)
+	var decoded eip712.TypedData
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+
+	signBytes, err := handler.GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	decodedSignBytes, err := decoded.SignBytes()
+	require.NoError(t, err)
+	require.Equal(t, signBytes, decodedSignBytes)
+
+	// the sign bytes depend on the domain and the signer data
+	otherSignBytes, err := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{}).GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	require.NotEqual(t, signBytes, otherSignBytes)
+
+	signerData.AccountNumber = 2
+	otherSignBytes, err = handler.GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	require.NotEqual(t, signBytes, otherSignBytes)
+}
+
+func TestSignModeHandlerHeterogeneousMessages(t *testing.T) {
+	signerData, txData, err := testutil.MakeHandlerArguments(testutil.HandlerArgumentOptions{
+		ChainID: "test-chain",
+		Msg:     &bankv1beta1.MsgSend{FromAddress: "foo"},
+		Fee:     &txv1beta1.Fee{GasLimit: 20000},
+	})
+	require.NoError(t, err)
+
+	msg, err := anyutil.New(&stakingv1beta1.MsgDelegate{DelegatorAddress: "foo"})
+	require.NoError(t, err)
+	txData.Body.Messages = append(txData.Body.Messages, msg)
+	txData.BodyBytes, err = proto.Marshal(txData.Body)
+	require.NoError(t, err)
+
+	typedData, err := eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{}).GetTypedData(context.Background(), signerData, txData)
+	require.NoError(t, err)
+
+	// messages of different types are flattened into indexed fields
+	require.Contains(t, typedData.Types["cosmos_tx_v1beta1_TxBody"], eip712.Type{Name: "messages_0", Type: "cosmos_bank_v1beta1_MsgSend"})
+	require.Contains(t, typedData.Types["cosmos_tx_v1beta1_TxBody"], eip712.Type{Name: "messages_1", Type: "cosmos_staking_v1beta1_MsgDelegate"})
+	_, err = typedData.SignBytes()
+	require.NoError(t, err)
+}
+
+func TestSignModeHandlerRejectsUnknownFields(t *testing.T) {
+	signerData, txData, err := testutil.MakeHandlerArguments(testutil.HandlerArgumentOptions{
+		ChainID: "test-chain",
+		Msg:     &bankv1beta1.MsgSend{FromAddress: "foo"},
+		Fee:     &txv1beta1.Fee{GasLimit: 20000},
+	})
+	require.NoError(t, err)
+
+	// unknown field 1050 of type string
+	txData.AuthInfoBytes = append(txData.AuthInfoBytes, 0xd2, 0x41, 0x01, 'x')
+	_, err = eip712.NewSignModeHandler(eip712.SignModeHandlerOptions{}).GetSignBytes(context.Background(), signerData, txData)
+	require.Error(t, err)
+}
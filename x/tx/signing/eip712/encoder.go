@@ -0,0 +1,243 @@
+package eip712
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"cosmossdk.io/x/tx/signing"
+)
+
+const anyFullName = "google.protobuf.Any"
+
+// encoder maps protobuf messages into EIP-712 struct types and values using
+// their protoreflect descriptors.
+//
+// The struct type of a message is named after its full name, with underscores
+// in place of the dots. Its fields are the fields of the message, in the order
+// of their numbers, with the following rules:
+//   - scalar fields are always present, with their default value when unset,
+//     unless they are members of a oneof, in which case they are only present
+//     when set;
+//   - message fields are only present when set;
+//   - google.protobuf.Any values are replaced by the message they pack;
+//   - repeated fields are arrays, unless their elements have different struct
+//     types, in which case they are flattened into fields named <field>_<index>;
+//   - enums are strings with the name of their value;
+//   - float, double and map fields are not supported.
+//
+// Since the struct type of a message depends on which of its message fields
+// are set and on the messages packed in its Any fields, two values of the same
+// message may have different struct types, in which case the later ones are
+// suffixed with _<n>.
+type encoder struct {
+	fileResolver signing.ProtoFileResolver
+	typeResolver protoregistry.MessageTypeResolver
+	types        map[string][]Type
+}
+
+// encodeMessage returns the name of the struct type and the value of msg,
+// registering the struct types of msg and its fields.
+func (e *encoder) encodeMessage(msg protoreflect.Message) (string, map[string]any, error) {
+	if msg.Descriptor().FullName() == anyFullName {
+		packed, err := e.unpackAny(msg)
+		if err != nil {
+			return "", nil, err
+		}
+		msg = packed
+	}
+
+	desc := msg.Descriptor()
+	fields := make([]protoreflect.FieldDescriptor, desc.Fields().Len())
+	for i := range fields {
+		fields[i] = desc.Fields().Get(i)
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Number() < fields[j].Number() })
+
+	var types []Type
+	value := map[string]any{}
+	for _, fd := range fields {
+		name := string(fd.Name())
+
+		switch {
+		case fd.IsMap() || fd.Kind() == protoreflect.FloatKind || fd.Kind() == protoreflect.DoubleKind:
+			if msg.Has(fd) {
+				return "", nil, fmt.Errorf("unsupported field %s", fd.FullName())
+			}
+
+		case fd.IsList() && isMessage(fd):
+			list := msg.Get(fd).List()
+			elemTypes := make([]string, list.Len())
+			elemValues := make([]any, list.Len())
+			for i := 0; i < list.Len(); i++ {
+				var err error
+				elemTypes[i], elemValues[i], err = e.encodeMessage(list.Get(i).Message())
+				if err != nil {
+					return "", nil, err
+				}
+			}
+
+			if len(elemTypes) == 0 {
+				continue
+			}
+			if allEqual(elemTypes) {
+				types = append(types, Type{Name: name, Type: elemTypes[0] + "[]"})
+				value[name] = elemValues
+				continue
+			}
+			for i := range elemTypes {
+				indexedName := name + "_" + strconv.Itoa(i)
+				types = append(types, Type{Name: indexedName, Type: elemTypes[i]})
+				value[indexedName] = elemValues[i]
+			}
+
+		case fd.IsList():
+			list := msg.Get(fd).List()
+			elemValues := make([]any, list.Len())
+			for i := 0; i < list.Len(); i++ {
+				elemValues[i] = encodeScalar(fd, list.Get(i))
+			}
+			types = append(types, Type{Name: name, Type: scalarType(fd) + "[]"})
+			value[name] = elemValues
+
+		case isMessage(fd):
+			if !msg.Has(fd) {
+				continue
+			}
+			typeName, fieldValue, err := e.encodeMessage(msg.Get(fd).Message())
+			if err != nil {
+				return "", nil, err
+			}
+			types = append(types, Type{Name: name, Type: typeName})
+			value[name] = fieldValue
+
+		default:
+			if fd.ContainingOneof() != nil && !msg.Has(fd) {
+				continue
+			}
+			types = append(types, Type{Name: name, Type: scalarType(fd)})
+			value[name] = encodeScalar(fd, msg.Get(fd))
+		}
+	}
+
+	return e.registerType(strings.ReplaceAll(string(desc.FullName()), ".", "_"), types), value, nil
+}
+
+// registerType registers the struct type under the name, or under the name
+// suffixed with _<n> if another struct type is registered under it, and
+// returns the name it is registered under.
+func (e *encoder) registerType(name string, types []Type) string {
+	if types == nil {
+		types = []Type{}
+	}
+
+	for n := 0; ; n++ {
+		typeName := name
+		if n > 0 {
+			typeName = name + "_" + strconv.Itoa(n)
+		}
+
+		registered, ok := e.types[typeName]
+		if !ok {
+			e.types[typeName] = types
+			return typeName
+		}
+		if reflect.DeepEqual(registered, types) {
+			return typeName
+		}
+	}
+}
+
+// unpackAny returns the message packed in an Any message.
+func (e *encoder) unpackAny(anyMsg protoreflect.Message) (protoreflect.Message, error) {
+	fields := anyMsg.Descriptor().Fields()
+	typeURL := anyMsg.Get(fields.ByName("type_url")).String()
+	value := anyMsg.Get(fields.ByName("value")).Bytes()
+
+	var msg protoreflect.Message
+	typ, err := e.typeResolver.FindMessageByURL(typeURL)
+	if err == nil {
+		msg = typ.New()
+	} else {
+		// otherwise we use the dynamicpb API to unmarshal into a dynamic message.
+		desc, err := e.fileResolver.FindDescriptorByName(protoreflect.FullName(typeURL[strings.LastIndexByte(typeURL, '/')+1:]))
+		if err != nil {
+			return nil, fmt.Errorf("can't resolve type URL %s: %w", typeURL, err)
+		}
+		msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("type URL %s is not a message", typeURL)
+		}
+		msg = dynamicpb.NewMessage(msgDesc)
+	}
+
+	if err := proto.Unmarshal(value, msg.Interface()); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+func isMessage(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind
+}
+
+// scalarType returns the EIP-712 type of a scalar field.
+func scalarType(fd protoreflect.FieldDescriptor) string {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return "bool"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int32"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "int64"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "uint32"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "uint64"
+	case protoreflect.BytesKind:
+		return "bytes"
+	default:
+		// strings and enums
+		return "string"
+	}
+}
+
+// encodeScalar returns the EIP-712 value of a scalar field.
+func encodeScalar(fd protoreflect.FieldDescriptor, v protoreflect.Value) any {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return v.Bool()
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return strconv.FormatInt(v.Int(), 10)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(v.Uint(), 10)
+	case protoreflect.BytesKind:
+		return "0x" + hex.EncodeToString(v.Bytes())
+	case protoreflect.EnumKind:
+		if value := fd.Enum().Values().ByNumber(v.Enum()); value != nil {
+			return string(value.Name())
+		}
+		return strconv.FormatInt(int64(v.Enum()), 10)
+	default:
+		return v.String()
+	}
+}
+
+func allEqual(values []string) bool {
+	for _, v := range values[1:] {
+		if v != values[0] {
+			return false
+		}
+	}
+	return true
+}
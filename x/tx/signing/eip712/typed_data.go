@@ -0,0 +1,271 @@
+package eip712
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// DomainType is the name of the EIP-712 domain type.
+const DomainType = "EIP712Domain"
+
+// Type is a field of an EIP-712 struct type.
+type Type struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// TypedData is the EIP-712 typed structured data of a tx, in the JSON format
+// of the eth_signTypedData_v4 method of Ethereum wallets.
+//
+// The values of the domain and the message are decimal strings for the integer
+// types, 0x-prefixed hex strings for the bytes and address types, maps for the
+// struct types and slices for the array types.
+type TypedData struct {
+	Types       map[string][]Type `json:"types"`
+	PrimaryType string            `json:"primaryType"`
+	Domain      map[string]any    `json:"domain"`
+	Message     map[string]any    `json:"message"`
+}
+
+// SignBytes returns the bytes signed by Ethereum wallets for the typed data,
+// which are hashed with Keccak-256 to get the EIP-712 digest:
+//
+//	0x19 0x01 || hashStruct(domain) || hashStruct(message)
+func (td *TypedData) SignBytes() ([]byte, error) {
+	domainHash, err := td.HashStruct(DomainType, td.Domain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash the EIP-712 domain: %w", err)
+	}
+	messageHash, err := td.HashStruct(td.PrimaryType, td.Message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash the EIP-712 message: %w", err)
+	}
+
+	signBytes := make([]byte, 0, 2+len(domainHash)+len(messageHash))
+	signBytes = append(signBytes, 0x19, 0x01)
+	signBytes = append(signBytes, domainHash...)
+	return append(signBytes, messageHash...), nil
+}
+
+// HashStruct returns the EIP-712 hashStruct of the data of the struct type.
+func (td *TypedData) HashStruct(typeName string, data map[string]any) ([]byte, error) {
+	fields, ok := td.Types[typeName]
+	if !ok {
+		return nil, fmt.Errorf("unknown type %s", typeName)
+	}
+
+	encodedType, err := td.EncodeType(typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(keccak256([]byte(encodedType)))
+	for _, field := range fields {
+		value, ok := data[field.Name]
+		if !ok {
+			return nil, fmt.Errorf("missing field %s of type %s", field.Name, typeName)
+		}
+		encoded, err := td.encodeValue(field.Type, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid field %s of type %s: %w", field.Name, typeName, err)
+		}
+		hasher.Write(encoded)
+	}
+	if len(data) != len(fields) {
+		return nil, fmt.Errorf("unexpected fields in value of type %s", typeName)
+	}
+
+	return hasher.Sum(nil), nil
+}
+
+// EncodeType returns the EIP-712 encodeType of the struct type, followed by
+// the struct types it references sorted by name.
+func (td *TypedData) EncodeType(typeName string) (string, error) {
+	if _, ok := td.Types[typeName]; !ok {
+		return "", fmt.Errorf("unknown type %s", typeName)
+	}
+
+	deps := map[string]bool{}
+	td.collectDependencies(typeName, deps)
+	delete(deps, typeName)
+
+	names := make([]string, 0, len(deps))
+	for name := range deps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range append([]string{typeName}, names...) {
+		b.WriteString(name)
+		b.WriteByte('(')
+		for i, field := range td.Types[name] {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(field.Type)
+			b.WriteByte(' ')
+			b.WriteString(field.Name)
+		}
+		b.WriteByte(')')
+	}
+
+	return b.String(), nil
+}
+
+// collectDependencies adds the struct type and the struct types it references
+// to deps.
+func (td *TypedData) collectDependencies(typeName string, deps map[string]bool) {
+	typeName = strings.TrimSuffix(typeName, "[]")
+	fields, ok := td.Types[typeName]
+	if !ok || deps[typeName] {
+		return
+	}
+
+	deps[typeName] = true
+	for _, field := range fields {
+		td.collectDependencies(field.Type, deps)
+	}
+}
+
+// encodeValue returns the 32 bytes EIP-712 encoding of the value of a field.
+func (td *TypedData) encodeValue(typeName string, value any) ([]byte, error) {
+	if _, ok := td.Types[typeName]; ok {
+		data, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("expected a struct value, got %T", value)
+		}
+		return td.HashStruct(typeName, data)
+	}
+
+	if elemType, ok := strings.CutSuffix(typeName, "[]"); ok {
+		values, ok := value.([]any)
+		if !ok {
+			return nil, fmt.Errorf("expected an array value, got %T", value)
+		}
+		hasher := sha3.NewLegacyKeccak256()
+		for _, v := range values {
+			encoded, err := td.encodeValue(elemType, v)
+			if err != nil {
+				return nil, err
+			}
+			hasher.Write(encoded)
+		}
+		return hasher.Sum(nil), nil
+	}
+
+	switch typeName {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string value, got %T", value)
+		}
+		return keccak256([]byte(s)), nil
+
+	case "bytes":
+		s, ok := value.(string)
+		if !ok || !strings.HasPrefix(s, "0x") {
+			return nil, fmt.Errorf("expected a 0x-prefixed hex string value, got %v", value)
+		}
+		bz, err := hex.DecodeString(s[2:])
+		if err != nil {
+			return nil, err
+		}
+		return keccak256(bz), nil
+
+	case "address":
+		s, ok := value.(string)
+		if !ok || !strings.HasPrefix(s, "0x") {
+			return nil, fmt.Errorf("expected a 0x-prefixed hex string value, got %v", value)
+		}
+		bz, err := hex.DecodeString(s[2:])
+		if err != nil {
+			return nil, err
+		}
+		if len(bz) != 20 {
+			return nil, fmt.Errorf("invalid address %s", s)
+		}
+		encoded := make([]byte, 32)
+		copy(encoded[12:], bz)
+		return encoded, nil
+
+	case "bool":
+		b, ok := value.(bool)
+		if !ok {
+			return nil, fmt.Errorf("expected a bool value, got %T", value)
+		}
+		encoded := make([]byte, 32)
+		if b {
+			encoded[31] = 1
+		}
+		return encoded, nil
+	}
+
+	if bits, signed, ok := parseIntegerType(typeName); ok {
+		return encodeInteger(value, bits, signed)
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", typeName)
+}
+
+// parseIntegerType parses the intN and uintN types.
+func parseIntegerType(typeName string) (bits int, signed, ok bool) {
+	size, signed := strings.CutPrefix(typeName, "int")
+	if !signed {
+		var isUint bool
+		size, isUint = strings.CutPrefix(typeName, "uint")
+		if !isUint {
+			return 0, false, false
+		}
+	}
+
+	bits, err := strconv.Atoi(size)
+	if err != nil || bits <= 0 || bits > 256 || bits%8 != 0 {
+		return 0, false, false
+	}
+
+	return bits, signed, true
+}
+
+// encodeInteger returns the 32 bytes two's complement big-endian encoding of
+// an integer given as a decimal string.
+func encodeInteger(value any, bits int, signed bool) ([]byte, error) {
+	s, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected a decimal string value, got %T", value)
+	}
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %s", s)
+	}
+
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	if signed {
+		limit.Rsh(limit, 1)
+		if n.Cmp(limit) >= 0 || n.Cmp(new(big.Int).Neg(limit)) < 0 {
+			return nil, fmt.Errorf("integer %s overflows int%d", s, bits)
+		}
+	} else if n.Sign() < 0 || n.Cmp(limit) >= 0 {
+		return nil, fmt.Errorf("integer %s overflows uint%d", s, bits)
+	}
+
+	if n.Sign() < 0 {
+		// two's complement on 256 bits
+		n.Add(n, new(big.Int).Lsh(big.NewInt(1), 256))
+	}
+
+	return n.FillBytes(make([]byte, 32)), nil
+}
+
+func keccak256(bz []byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(bz)
+	return hasher.Sum(nil)
+}